@@ -1,40 +1,86 @@
 package main
 
 import (
+	analyticsGen "be/gen/analytics"
+	auditGen "be/gen/audit"
+	coachingGen "be/gen/coaching"
+	analyticsGenSvr "be/gen/http/analytics/server"
+	auditGenSvr "be/gen/http/audit/server"
+	coachingGenSvr "be/gen/http/coaching/server"
+	importerGenSvr "be/gen/http/importer/server"
+	progressionGenSvr "be/gen/http/progression/server"
+	recordGenSvr "be/gen/http/record/server"
+	scheduleGenSvr "be/gen/http/schedule/server"
 	trainingPlanGenSvr "be/gen/http/training_plan/server"
 	userGenSvr "be/gen/http/user/server"
+	webhookGenSvr "be/gen/http/webhook/server"
+	workoutSessionGenSvr "be/gen/http/workout_session/server"
+	importerGen "be/gen/importer"
+	progressionGen "be/gen/progression"
+	recordGen "be/gen/record"
+	scheduleGen "be/gen/schedule"
 	trainingPlanGen "be/gen/training_plan"
 	userGen "be/gen/user"
+	webhookGen "be/gen/webhook"
+	workoutSessionGen "be/gen/workout_session"
+	"be/internal/certs"
 	"be/internal/config"
+	"be/internal/features/common"
+	"be/internal/features/idempotency"
+	"be/internal/features/ratelimit"
+	"be/internal/health"
+	"be/internal/metrics"
+	"be/internal/middleware"
+	"be/internal/telemetry"
 	"be/internal/utils"
 	"context"
 	"fmt"
 	"net/http"
 	"net/url"
 	"os"
+	"regexp"
 	"sync"
 	"text/template"
 	"time"
 
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"goa.design/clue/log"
 	goahttp "goa.design/goa/v3/http"
+	goahttpmiddleware "goa.design/goa/v3/http/middleware"
+	goamiddleware "goa.design/goa/v3/middleware"
 
 	"goa.design/clue/debug"
 )
 
-func HandleHttpServer(ctx context.Context, u *url.URL, wg *sync.WaitGroup, errc chan error, dbg bool, epsMap map[config.EndpointName]interface{}) {
+func HandleHttpServer(ctx context.Context, u *url.URL, wg *sync.WaitGroup, errc chan error, cfg *config.Config, epsMap map[config.EndpointName]interface{}) {
 	var handler http.Handler
-	var mux goahttp.Muxer = withMuxer(ctx, dbg, epsMap)
-	mux = withDocsHandler(mux)
+	dbg := cfg.Server.DebugEnabled()
+	checker := health.NewChecker(common.NewKcClient(cfg.Keycloak))
+	var mux goahttp.Muxer = withMuxer(ctx, dbg, cfg.Server.MetricsToken, epsMap, checker)
+	mux = withDocsHandler(mux, cfg.Keycloak)
 	handler = mux
 	handler = withErrorHandler(handler, ctx)
-	handler = enableCORS(handler)
+	handler = idempotency.NewMiddleware(cfg.Idempotency.KeyTTL).Handler(handler)
+	limiter, err := ratelimit.NewMiddleware(cfg.RateLimit.Store, cfg.RateLimit.Limits)
+	if err != nil {
+		log.Fatal(ctx, err)
+	}
+	handler = limiter.Handler(handler)
+	handler = middleware.CORS(cfg.CORS, routeExists(mux))(handler)
 
 	if dbg {
 		handler = debug.HTTP()(handler)
 	}
 
-	handler = log.HTTP(ctx)(handler)
+	handler = withRequestID(handler, ctx)
+	handler = goahttpmiddleware.RequestID(
+		goahttpmiddleware.UseXRequestIDHeaderOption(true),
+		goahttpmiddleware.XRequestHeaderLimitOption(128),
+	)(handler)
+	handler = metrics.HTTP(handler)
+	handler = telemetry.HTTP(handler)
 
 	srv := &http.Server{
 		Addr:              u.Host,
@@ -42,16 +88,38 @@ func HandleHttpServer(ctx context.Context, u *url.URL, wg *sync.WaitGroup, errc
 		ReadHeaderTimeout: time.Second * 60,
 	}
 
+	if cfg.Server.Secure {
+		reloader, err := certs.NewReloader(cfg.Server.TLS)
+		if err != nil {
+			log.Fatal(ctx, err)
+		}
+		srv.TLSConfig = reloader.TLSConfig()
+		reloader.Start(ctx, wg) // Pick up renewed certificates without restarting
+	}
+
 	(*wg).Add(1)
 	go func() {
 		defer (*wg).Done()
 
 		go func() {
+			if srv.TLSConfig != nil {
+				log.Printf(ctx, "Starting HTTPS server on %s", u.Host)
+				errc <- srv.ListenAndServeTLS("", "")
+				return
+			}
 			log.Printf(ctx, "Starting server on %s", u.Host)
 			errc <- srv.ListenAndServe()
 		}()
 
 		<-ctx.Done()
+
+		// Fail readiness first and give the load balancers time to stop
+		// routing requests here before refusing connections.
+		checker.Drain()
+		if delay := cfg.Server.ShutdownDrainDelay; delay > 0 {
+			log.Printf(ctx, "Draining server at %q for %s", u.Host, delay)
+			time.Sleep(delay)
+		}
 		log.Printf(ctx, "Shutting down server at %q", u.Host)
 
 		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -80,19 +148,58 @@ func withErrorHandler(handler http.Handler, logCtx context.Context) http.Handler
 	})
 }
 
-func enableCORS(h http.Handler) http.Handler {
+// probePaths are polled by the orchestrator and the scraper, their requests
+// are not logged.
+var probePaths = regexp.MustCompile(`^/(livez|readyz|metrics)$`)
+
+// feedPath matches the path of the calendar feed, whose last segment is the
+// token authenticating it.
+var feedPath = regexp.MustCompile(`^(/api/v1/calendar/feed/)[^/]+$`)
+
+// withRequestID returns the request ID in the X-Request-ID header of every
+// response and logs the request with it. The ID is the client's X-Request-ID
+// when sent, as picked by goa's RequestID middleware running before.
+func withRequestID(handler http.Handler, logCtx context.Context) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		id, _ := r.Context().Value(goamiddleware.RequestIDKey).(string)
+		w.Header().Set("X-Request-ID", id)
+		trace.SpanFromContext(r.Context()).SetAttributes(attribute.String("http.request_id", id))
+
+		logCtx := log.With(logCtx, log.KV{K: log.RequestIDKey, V: id})
+		// The logger sees the request with the feed token redacted, the
+		// handler gets the original one with the logger's context.
+		serve := http.HandlerFunc(func(w http.ResponseWriter, logged *http.Request) {
+			handler.ServeHTTP(w, r.WithContext(logged.Context()))
+		})
+		log.HTTP(logCtx, log.WithDisableRequestID(), log.WithPathFilter(probePaths))(serve).ServeHTTP(w, redactFeedToken(r))
+	})
+}
 
-		// Handle preflight requests
-		if r.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusOK)
-			return
-		}
-		h.ServeHTTP(w, r)
+// redactFeedToken returns a shallow copy of r whose URL has the calendar
+// feed token redacted, or r itself for the other routes.
+func redactFeedToken(r *http.Request) *http.Request {
+	if !feedPath.MatchString(r.URL.Path) {
+		return r
+	}
+	u := *r.URL
+	u.Path, u.RawPath = feedPath.ReplaceAllString(u.Path, "${1}REDACTED"), ""
+	logged := *r
+	logged.URL = &u
+	return &logged
+}
+
+// routeExists tells whether mux handles method on path, so that preflight
+// requests are only answered for the routes that exist.
+func routeExists(mux goahttp.Muxer) func(method, path string) bool {
+	router, ok := mux.(interface {
+		Match(rctx *chi.Context, method, path string) bool
 	})
+	if !ok {
+		return func(string, string) bool { return true }
+	}
+	return func(method, path string) bool {
+		return router.Match(chi.NewRouteContext(), method, path)
+	}
 }
 
 func errorHandler(logCtx context.Context) func(context.Context, http.ResponseWriter, error) {
@@ -105,6 +212,15 @@ func errorHandler(logCtx context.Context) func(context.Context, http.ResponseWri
 func withMountedService(ctx context.Context, mux goahttp.Muxer, dec func(*http.Request) goahttp.Decoder, enc func(context.Context, http.ResponseWriter) goahttp.Encoder, eh func(context.Context, http.ResponseWriter, error), epsMap map[config.EndpointName]interface{}) {
 	var userGenServer *userGenSvr.Server
 	var trainingPlanGenServer *trainingPlanGenSvr.Server
+	var auditGenServer *auditGenSvr.Server
+	var webhookGenServer *webhookGenSvr.Server
+	var workoutSessionGenServer *workoutSessionGenSvr.Server
+	var analyticsGenServer *analyticsGenSvr.Server
+	var recordGenServer *recordGenSvr.Server
+	var scheduleGenServer *scheduleGenSvr.Server
+	var coachingGenServer *coachingGenSvr.Server
+	var progressionGenServer *progressionGenSvr.Server
+	var importerGenServer *importerGenSvr.Server
 
 	for name, eps := range epsMap {
 		switch name {
@@ -116,19 +232,57 @@ func withMountedService(ctx context.Context, mux goahttp.Muxer, dec func(*http.R
 			trainingPlanEndpoints := eps.(*trainingPlanGen.Endpoints)
 			trainingPlanGenServer = trainingPlanGenSvr.New(trainingPlanEndpoints, mux, dec, enc, eh, nil)
 			trainingPlanGenSvr.Mount(mux, trainingPlanGenServer)
+		case config.AuditEndPoint:
+			auditEndpoints := eps.(*auditGen.Endpoints)
+			auditGenServer = auditGenSvr.New(auditEndpoints, mux, dec, enc, eh, nil)
+			auditGenSvr.Mount(mux, auditGenServer)
+		case config.WebhookEndPoint:
+			webhookEndpoints := eps.(*webhookGen.Endpoints)
+			webhookGenServer = webhookGenSvr.New(webhookEndpoints, mux, dec, enc, eh, nil)
+			webhookGenSvr.Mount(mux, webhookGenServer)
+		case config.WorkoutSessionEndPoint:
+			workoutSessionEndpoints := eps.(*workoutSessionGen.Endpoints)
+			workoutSessionGenServer = workoutSessionGenSvr.New(workoutSessionEndpoints, mux, dec, enc, eh, nil)
+			workoutSessionGenSvr.Mount(mux, workoutSessionGenServer)
+		case config.AnalyticsEndPoint:
+			analyticsEndpoints := eps.(*analyticsGen.Endpoints)
+			analyticsGenServer = analyticsGenSvr.New(analyticsEndpoints, mux, dec, enc, eh, nil)
+			analyticsGenSvr.Mount(mux, analyticsGenServer)
+		case config.RecordEndPoint:
+			recordEndpoints := eps.(*recordGen.Endpoints)
+			recordGenServer = recordGenSvr.New(recordEndpoints, mux, dec, enc, eh, nil)
+			recordGenSvr.Mount(mux, recordGenServer)
+		case config.ScheduleEndPoint:
+			scheduleEndpoints := eps.(*scheduleGen.Endpoints)
+			scheduleGenServer = scheduleGenSvr.New(scheduleEndpoints, mux, dec, enc, eh, nil)
+			scheduleGenSvr.Mount(mux, scheduleGenServer)
+		case config.CoachingEndPoint:
+			coachingEndpoints := eps.(*coachingGen.Endpoints)
+			coachingGenServer = coachingGenSvr.New(coachingEndpoints, mux, dec, enc, eh, nil)
+			coachingGenSvr.Mount(mux, coachingGenServer)
+		case config.ProgressionEndPoint:
+			progressionEndpoints := eps.(*progressionGen.Endpoints)
+			progressionGenServer = progressionGenSvr.New(progressionEndpoints, mux, dec, enc, eh, nil)
+			progressionGenSvr.Mount(mux, progressionGenServer)
+		case config.ImporterEndPoint:
+			importerEndpoints := eps.(*importerGen.Endpoints)
+			importerGenServer = importerGenSvr.New(importerEndpoints, mux, dec, enc, eh, nil)
+			importerGenSvr.Mount(mux, importerGenServer)
 		}
 
 	}
 }
 
-func withMuxer(ctx context.Context, dbg bool, epsMap map[config.EndpointName]interface{}) (mux goahttp.Muxer) {
+func withMuxer(ctx context.Context, dbg bool, metricsToken string, epsMap map[config.EndpointName]interface{}, checker *health.Checker) (mux goahttp.Muxer) {
 
 	var (
 		enc = goahttp.ResponseEncoder
 		dec = goahttp.RequestDecoder
 	)
 
-	mux = goahttp.NewMuxer()
+	resolver := goahttp.NewMuxer()
+	resolver.Use(telemetry.Route(resolver)) // Name the spans after the routes
+	mux = resolver
 
 	if dbg {
 		debug.MountPprofHandlers(debug.Adapt(mux))
@@ -148,25 +302,35 @@ func withMuxer(ctx context.Context, dbg bool, epsMap map[config.EndpointName]int
 		_, _ = w.Write([]byte("OK"))
 	})
 
+	mux.Handle("GET", "/livez", checker.Live)
+	mux.Handle("GET", "/readyz", checker.Ready)
+	// The metrics are only served to the scrapers knowing the token.
+	if metricsToken != "" {
+		mux.Handle("GET", "/metrics", metrics.Protect(metricsToken, metrics.Handler()).ServeHTTP)
+	}
+
 	return
 }
 
-func ServeSwaggerIndex(w http.ResponseWriter, r *http.Request) {
-	tmpl := template.Must(template.ParseFiles("./static/swagger.tmpl"))
-
-	tmpl.Execute(w, map[string]string{
-		"ClientID":     os.Getenv("KC_CLIENT_ID"),
-		"ClientSecret": os.Getenv("KC_CLIENT_SECRET"),
-		"AppName":      "be_service",
-		"Realm":        os.Getenv("KC_REALM"),
-		"RedirectURL":  "http://localhost:9090/docs/oauth2-redirect",
-		"KeycloakHost": os.Getenv("KC_HOST"), // es: http://localhost:8080
-	})
+// ServeSwaggerIndex serves the Swagger UI logging in to the Keycloak of kc.
+func ServeSwaggerIndex(kc common.KeycloakConfig) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tmpl := template.Must(template.ParseFiles("./static/swagger.tmpl"))
+
+		tmpl.Execute(w, map[string]string{
+			"ClientID":     kc.ClientID,
+			"ClientSecret": kc.ClientSecret,
+			"AppName":      "be_service",
+			"Realm":        kc.Realm,
+			"RedirectURL":  "http://localhost:9090/docs/oauth2-redirect",
+			"KeycloakHost": kc.PublicURL, // es: http://localhost:8080
+		})
+	}
 }
 
 // withDocsHandler sets up HTTP handlers for serving Swagger UI, OpenAPI spec, and API documentation.
 // It registers routes for serving static files and dynamically generated documentation pages.
-func withDocsHandler(mux goahttp.Muxer) goahttp.Muxer {
+func withDocsHandler(mux goahttp.Muxer, kc common.KeycloakConfig) goahttp.Muxer {
 	fs := http.FileServer(http.Dir("./swagger-ui"))
 	mux.Handle("GET", "/swagger-ui/*", func(w http.ResponseWriter, r *http.Request) {
 		http.StripPrefix("/swagger-ui/", fs).ServeHTTP(w, r)
@@ -187,7 +351,7 @@ func withDocsHandler(mux goahttp.Muxer) goahttp.Muxer {
 	// 	_, _ = w.Write(swagger)
 	// })
 
-	mux.Handle("GET", "/docs", ServeSwaggerIndex)
+	mux.Handle("GET", "/docs", ServeSwaggerIndex(kc))
 
 	mux.Handle("GET", "/redoc", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "text/html")
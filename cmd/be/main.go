@@ -3,6 +3,13 @@ package main
 import (
 	servConfig "be/internal/config"
 	"be/internal/database/db"
+	"be/internal/events"
+	"be/internal/features/idempotency"
+	"be/internal/features/retention"
+	"be/internal/features/webhook"
+	"be/internal/metrics"
+	"be/internal/middleware"
+	"be/internal/telemetry"
 	"context"
 	"fmt"
 	"os"
@@ -16,7 +23,13 @@ import (
 // main is the entry point for the application.
 // It loads the server configuration, initializes services, and handles environment-specific setups.
 func main() {
-	srvConf := servConfig.LoadServerConfig() // Load server configuration settings from a config file or environment variables.
+	// Load the configuration from the flags, the environment and the optional config file.
+	cfg, err := servConfig.Load()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	srvConf := &cfg.Server
 
 	// Set up logging format based on whether the output is a terminal or a file.
 	format := log.FormatJSON
@@ -25,12 +38,24 @@ func main() {
 	}
 
 	// Create a context for the application with logging format and debug settings.
-	ctx := log.Context(context.Background(), log.WithFormat(format))
-	if srvConf.Debug {
+	ctx := log.Context(context.Background(), log.WithFormat(format), log.WithFunc(log.Span))
+	if srvConf.DebugEnabled() {
 		ctx = log.Context(ctx, log.WithDebug()) // Enable debug logs if debug mode is set
 		log.Debugf(ctx, "debug logs enabled")
 	}
 
+	// Verify the access tokens with the realm's key.
+	if err := middleware.SetPublicKey(cfg.Keycloak.RSAPublicKey); err != nil {
+		log.Fatal(ctx, err)
+	}
+
+	// Install the tracer provider before the database and the HTTP clients are set up.
+	shutdownTracing, err := telemetry.Setup(ctx, cfg.Telemetry)
+	if err != nil {
+		log.Fatal(ctx, err)
+	}
+	defer shutdownTracing()
+
 	var wg sync.WaitGroup    // WaitGroup to manage goroutines
 	errc := make(chan error) // Error channel to listen for fatal errors
 
@@ -46,21 +71,29 @@ func main() {
 	// Set up environment-specific configurations
 	switch srvConf.Domain {
 	case "development":
-		db.ConnectDb()
-		epsMap := servConfig.InitializeServices(ctx)               // Initialize and map services to endpoints
-		u := srvConf.BuildServerURL(srvConf, ctx)                  // Build server URL based on configuration
-		HandleHttpServer(ctx, u, &wg, errc, srvConf.Debug, epsMap) // Start the HTTP server for development
+		db.ConnectDb(cfg.Database)
+		epsMap := servConfig.InitializeServices(ctx, cfg) // Initialize and map services to endpoints
+		u := srvConf.BuildServerURL(srvConf, ctx)         // Build server URL based on configuration
+		HandleHttpServer(ctx, u, &wg, errc, cfg, epsMap)  // Start the HTTP server for development
 
 	case "production":
-		db.ConnectDb()                                             // Connect to the database for production
-		epsMap := servConfig.InitializeServices(ctx)               // Initialize and map services to endpoints
-		u := srvConf.BuildServerURL(srvConf, ctx)                  // Build server URL based on configuration
-		HandleHttpServer(ctx, u, &wg, errc, srvConf.Debug, epsMap) // Start the HTTP server for production
+		db.ConnectDb(cfg.Database)                        // Connect to the database for production
+		epsMap := servConfig.InitializeServices(ctx, cfg) // Initialize and map services to endpoints
+		u := srvConf.BuildServerURL(srvConf, ctx)         // Build server URL based on configuration
+		HandleHttpServer(ctx, u, &wg, errc, cfg, epsMap)  // Start the HTTP server for production
 
 	default:
 		log.Fatal(ctx, fmt.Errorf("invalid host argument: %q (valid hosts: development|production)", srvConf.Domain)) // Fatal error for invalid domain
 	}
 
+	metrics.RegisterDB(db.DB.LD, cfg.Database.Name) // Export the connection pool stats
+
+	// Start the outbox dispatcher alongside the HTTP server to deliver domain events to the sinks.
+	events.NewDispatcher(events.LogSink{}, webhook.NewSink(), metrics.Sink{}).Start(ctx, &wg)
+	webhook.NewWorker().Start(ctx, &wg)                          // POST queued webhook deliveries to the subscribers
+	retention.NewPurger(cfg.Trash.RetentionDays).Start(ctx, &wg) // Hard-delete rows kept in the trash past the retention period
+	idempotency.NewSweeper().Start(ctx, &wg)                     // Delete the expired idempotency keys
+
 	// Wait for an error or signal to exit.
 	log.Printf(ctx, "exiting (%v)", <-errc)
 	cancel()                  // Cancel context to begin shutdown process
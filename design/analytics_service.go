@@ -0,0 +1,179 @@
+package design
+
+import (
+	"be/design/errors"
+
+	. "goa.design/goa/v3/dsl"
+)
+
+var OneRepMaxPoint = Type("OneRepMaxPoint", func() {
+	Attribute("bucket", String, "Start of the time bucket", func() {
+		Format(FormatDateTime)
+		Example("2025-03-24T00:00:00Z")
+	})
+	Attribute("estimatedOneRepMax", Float64, "Best estimated one-rep max in the bucket (kg)", func() {
+		Example(102.5)
+	})
+	Attribute("relativeIntensity", Float64, "Average set weight as a fraction of the bucket's estimated one-rep max", func() {
+		Example(0.78)
+	})
+	Attribute("sets", Int, "Number of sets in the bucket", func() {
+		Example(12)
+	})
+	Required("bucket", "estimatedOneRepMax", "relativeIntensity", "sets")
+})
+
+var TonnagePoint = Type("TonnagePoint", func() {
+	Attribute("bucket", String, "Start of the time bucket, or start of the session when bucketing by session", func() {
+		Format(FormatDateTime)
+		Example("2025-03-24T00:00:00Z")
+	})
+	Attribute("sessionId", String, "Session ID when bucketing by session", func() {
+		Format(FormatUUID)
+		Example("6f7a8b9c-0d1e-4f2a-8b3c-4d5e6f7a8b9c")
+	})
+	Attribute("tonnage", Float64, "Sum of weight x reps (kg)", func() {
+		Example(12450.0)
+	})
+	Attribute("sets", Int, "Number of sets", func() {
+		Example(24)
+	})
+	Attribute("reps", Int, "Number of repetitions", func() {
+		Example(180)
+	})
+	Attribute("averageLoad", Float64, "Average load per repetition (kg)", func() {
+		Example(69.2)
+	})
+	Required("bucket", "tonnage", "sets", "reps", "averageLoad")
+})
+
+var MuscleVolumePoint = Type("MuscleVolumePoint", func() {
+	Attribute("bucket", String, "Start of the time bucket", func() {
+		Format(FormatDateTime)
+		Example("2025-03-24T00:00:00Z")
+	})
+	Attribute("muscleGroup", String, "Muscle group from the exercise-type catalog", func() {
+		Example("chest")
+	})
+	Attribute("sets", Int, "Number of sets", func() {
+		Example(10)
+	})
+	Attribute("tonnage", Float64, "Sum of weight x reps (kg)", func() {
+		Example(5400.0)
+	})
+	Required("bucket", "muscleGroup", "sets", "tonnage")
+})
+
+// analyticsRange declares the attributes shared by the analytics payloads.
+func analyticsRange() {
+	AccessToken("token", String, "OAuth2 access token used to perform authorization")
+	Attribute("userId", String, "Athlete to analyse, defaults to the caller", func() {
+		Format(FormatUUID)
+		Example("550e8400-e29b-41d4-a716-446655440000")
+	})
+	Attribute("from", String, "Only sessions started at or after this time (ISO 8601)", func() {
+		Format(FormatDateTime)
+		Example("2025-01-01T00:00:00Z")
+	})
+	Attribute("to", String, "Only sessions started before this time (ISO 8601)", func() {
+		Format(FormatDateTime)
+		Example("2025-12-31T00:00:00Z")
+	})
+}
+
+var AnalyticsService = Service("analytics", func() {
+	Security(OAuth2, func() {
+		Scope("openid")
+	})
+
+	Description("Strength analytics computed from the performed sets")
+
+	HTTP(func() {
+		Path("/analytics")
+	})
+
+	Error("unauthorized", errors.Unauthorized, "Auth Failed")
+	Error("internalServerError", errors.InternalServerError, "Internal Server Error")
+	Error("notFound", errors.NotFound, "Not Found")
+	Error("badRequest", errors.BadRequest, "Invalid Request")
+	Error("forbidden", errors.Forbidden, "Accesso negato")
+
+	Method("oneRepMax", func() {
+		Description("Estimated one-rep max of an exercise type over time")
+		Payload(func() {
+			analyticsRange()
+			Attribute("exerciseTypeId", String, "Exercise type", func() {
+				Format(FormatUUID)
+				Example("3b4c5d6e-7f8a-4b9c-8d0e-1f2a3b4c5d6e")
+			})
+			Attribute("formula", String, "One-rep max estimation formula", func() {
+				Enum("epley", "brzycki")
+				Default("epley")
+			})
+			Attribute("bucket", String, "Time bucket", func() {
+				Enum("day", "week", "month")
+				Default("week")
+			})
+			Required("exerciseTypeId")
+		})
+		Result(ArrayOf(OneRepMaxPoint))
+		HTTP(func() {
+			GET("/one-rep-max")
+			Param("userId")
+			Param("from")
+			Param("to")
+			Param("exerciseTypeId")
+			Param("formula")
+			Param("bucket")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("tonnage", func() {
+		Description("Tonnage (weight x reps) per session or per time bucket")
+		Payload(func() {
+			analyticsRange()
+			Attribute("exerciseTypeId", String, "Restrict to an exercise type", func() {
+				Format(FormatUUID)
+				Example("3b4c5d6e-7f8a-4b9c-8d0e-1f2a3b4c5d6e")
+			})
+			Attribute("bucket", String, "Time bucket", func() {
+				Enum("session", "day", "week", "month")
+				Default("week")
+			})
+		})
+		Result(ArrayOf(TonnagePoint))
+		HTTP(func() {
+			GET("/tonnage")
+			Param("userId")
+			Param("from")
+			Param("to")
+			Param("exerciseTypeId")
+			Param("bucket")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("muscleVolume", func() {
+		Description("Sets and tonnage per muscle group over time")
+		Payload(func() {
+			analyticsRange()
+			Attribute("bucket", String, "Time bucket", func() {
+				Enum("day", "week", "month")
+				Default("week")
+			})
+		})
+		Result(ArrayOf(MuscleVolumePoint))
+		HTTP(func() {
+			GET("/muscle-volume")
+			Param("userId")
+			Param("from")
+			Param("to")
+			Param("bucket")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+})
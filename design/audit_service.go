@@ -0,0 +1,103 @@
+package design
+
+import (
+	"be/design/errors"
+
+	. "goa.design/goa/v3/dsl"
+)
+
+var AuditEntry = Type("AuditEntry", func() {
+	Attribute("id", String, "Audit entry ID", func() {
+		Format(FormatUUID)
+		Example("8a1c2b3d-4e5f-6789-abcd-ef0123456789")
+	})
+	Attribute("actor", String, "Subject (JWT sub) of the caller", func() {
+		Example("550e8400-e29b-41d4-a716-446655440000")
+	})
+	Attribute("resource", String, "Service that owns the resource", func() {
+		Example("training_plan")
+	})
+	Attribute("method", String, "Method that was called", func() {
+		Example("update")
+	})
+	Attribute("resourceId", String, "ID of the affected resource", func() {
+		Example("11111111-2222-3333-4444-555555555555")
+	})
+	Attribute("before", MapOf(String, Any), "State of the resource before the operation")
+	Attribute("after", MapOf(String, Any), "State of the resource after the operation")
+	Attribute("diff", MapOf(String, Any), "Changed fields with their before and after values")
+	Attribute("requestId", String, "ID of the HTTP request", func() {
+		Example("Aonp24i2")
+	})
+	Attribute("createdAt", String, "When the operation was recorded", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T10:00:00Z")
+	})
+	Required("id", "actor", "resource", "method", "createdAt")
+})
+
+var AuditService = Service("audit", func() {
+	Security(OAuth2, func() {
+		Scope("openid")
+	})
+
+	Description("Audit trail of mutating operations")
+
+	HTTP(func() {
+		Path("/audit")
+	})
+
+	Error("unauthorized", errors.Unauthorized, "Auth Failed")
+	Error("internalServerError", errors.InternalServerError, "Internal Server Error")
+	Error("notFound", errors.NotFound, "Not Found")
+	Error("badRequest", errors.BadRequest, "Invalid Request")
+	Error("forbidden", errors.Forbidden, "Accesso negato")
+
+	Method("list", func() {
+		Description("List audit entries (admin only)")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("actor", String, "Filter by actor (JWT sub)", func() {
+				Example("550e8400-e29b-41d4-a716-446655440000")
+			})
+			Attribute("resource", String, "Filter by resource (service name)", func() {
+				Example("training_plan")
+			})
+			Attribute("resourceId", String, "Filter by resource ID", func() {
+				Example("11111111-2222-3333-4444-555555555555")
+			})
+			Attribute("from", String, "Only entries recorded at or after this time (ISO 8601)", func() {
+				Format(FormatDateTime)
+				Example("2025-01-01T00:00:00Z")
+			})
+			Attribute("to", String, "Only entries recorded before this time (ISO 8601)", func() {
+				Format(FormatDateTime)
+				Example("2025-12-31T00:00:00Z")
+			})
+			Attribute("limit", Int, "Max number of results", func() {
+				Minimum(1)
+				Maximum(100)
+				Default(20)
+				Example(10)
+			})
+			Attribute("offset", Int, "Results to skip", func() {
+				Minimum(0)
+				Default(0)
+				Example(0)
+			})
+		})
+		Result(ArrayOf(AuditEntry))
+		HTTP(func() {
+			GET("")
+			Param("actor")
+			Param("resource")
+			Param("resourceId")
+			Param("from")
+			Param("to")
+			Param("limit")
+			Param("offset")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+})
@@ -0,0 +1,143 @@
+package design
+
+import (
+	"be/design/errors"
+
+	. "goa.design/goa/v3/dsl"
+)
+
+var CoachingRelationship = Type("CoachingRelationship", func() {
+	Attribute("id", String, "Relationship ID", func() {
+		Format(FormatUUID)
+		Example("9d0e1f2a-3b4c-4d5e-8f6a-7b8c9d0e1f2a")
+	})
+	Attribute("coachId", String, "Coach user ID", func() {
+		Format(FormatUUID)
+		Example("550e8400-e29b-41d4-a716-446655440000")
+	})
+	Attribute("athleteId", String, "Athlete user ID", func() {
+		Format(FormatUUID)
+		Example("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	})
+	Attribute("status", String, "Relationship status", func() {
+		Enum("pending", "active", "revoked")
+		Example("pending")
+	})
+	Attribute("createdAt", String, "Invitation time", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T10:00:00Z")
+	})
+	Attribute("acceptedAt", String, "Acceptance time", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T12:00:00Z")
+	})
+	Attribute("revokedAt", String, "Revocation time", func() {
+		Format(FormatDateTime)
+		Example("2025-06-01T09:00:00Z")
+	})
+	Required("id", "coachId", "athleteId", "status", "createdAt")
+})
+
+var CoachingService = Service("coaching", func() {
+	Security(OAuth2, func() {
+		Scope("openid")
+	})
+
+	Description("Coach and athlete relationships")
+
+	HTTP(func() {
+		Path("/coaching")
+	})
+
+	Error("unauthorized", errors.Unauthorized, "Auth Failed")
+	Error("internalServerError", errors.InternalServerError, "Internal Server Error")
+	Error("notFound", errors.NotFound, "Not Found")
+	Error("badRequest", errors.BadRequest, "Invalid Request")
+	Error("forbidden", errors.Forbidden, "Accesso negato")
+
+	Method("invite", func() {
+		Description("Invite an athlete; the caller becomes their coach once accepted")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("athleteId", String, "Athlete to invite", func() {
+				Format(FormatUUID)
+				Example("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+			})
+			Required("athleteId")
+		})
+		Result(CoachingRelationship)
+		HTTP(func() {
+			POST("/invitations")
+			Response(StatusCreated)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("accept", func() {
+		Description("Accept a pending invitation as the invited athlete")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Relationship ID", func() {
+				Format(FormatUUID)
+			})
+			Required("id")
+		})
+		Result(CoachingRelationship)
+		HTTP(func() {
+			POST("/{id}/accept")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("revoke", func() {
+		Description("Decline an invitation or end a relationship, as either the coach or the athlete")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Relationship ID", func() {
+				Format(FormatUUID)
+			})
+			Required("id")
+		})
+		Result(CoachingRelationship)
+		HTTP(func() {
+			POST("/{id}/revoke")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("list", func() {
+		Description("List the caller's relationships as coach or athlete")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("role", String, "Only relationships where the caller has this role", func() {
+				Enum("coach", "athlete")
+			})
+			Attribute("status", String, "Filter by status", func() {
+				Enum("pending", "active", "revoked")
+			})
+			Attribute("limit", Int, "Max number of results", func() {
+				Minimum(1)
+				Maximum(100)
+				Default(20)
+				Example(10)
+			})
+			Attribute("offset", Int, "Results to skip", func() {
+				Minimum(0)
+				Default(0)
+				Example(0)
+			})
+		})
+		Result(ArrayOf(CoachingRelationship))
+		HTTP(func() {
+			GET("")
+			Param("role")
+			Param("status")
+			Param("limit")
+			Param("offset")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+})
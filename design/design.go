@@ -2,8 +2,6 @@
 package design
 
 import (
-	"os"
-
 	. "goa.design/goa/v3/dsl"
 )
 
@@ -24,10 +22,10 @@ var _ = API("be_service", func() {
 		Scope("openid")
 	})
 
+	// The client credentials are filled in at runtime by ServeSwaggerIndex,
+	// from the service configuration, so that they don't end up in the spec.
 	Meta("swagger:settings", `{
 		"swagger-ui-init-oauth": {
-		  "clientId": "`+os.Getenv("KC_CLIENT_ID")+`",
-		  "clientSecret": "`+os.Getenv("KC_CLIENT_SECRET")+`",
 		  "appName": "be_service",
 		  "usePkceWithAuthorizationCodeGrant": true
 		}
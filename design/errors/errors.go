@@ -36,6 +36,17 @@ var NotFound = Type("NotFound", func() {
 	Required("message")
 })
 
+var FieldError = Type("FieldError", func() {
+	Description("Campo della richiesta non valido")
+	Attribute("field", String, "Nome del campo", func() {
+		Example("endDate")
+	})
+	Attribute("message", String, "Motivo per cui il campo non è valido", func() {
+		Example("must not be before startDate")
+	})
+	Required("field", "message")
+})
+
 var BadRequest = Type("BadRequest", func() {
 	Description("Body di risposta per la richiesta non valida (400)")
 	Attribute("name", String, "Nome dell'errore", func() {
@@ -56,6 +67,7 @@ var BadRequest = Type("BadRequest", func() {
 	Attribute("fault", Boolean, "Indica se l'errore è dovuto a un problema del server", func() {
 		Example(false)
 	})
+	Attribute("fields", ArrayOf(FieldError), "Campi non validi, quando l'errore riguarda i dati inviati")
 	Required("name", "id", "message", "temporary", "timeout", "fault")
 })
 
@@ -66,3 +78,11 @@ var Forbidden = Type("Forbidden", func() {
 	})
 	Required("message")
 })
+
+var PreconditionFailed = Type("PreconditionFailed", func() {
+	Description("La risorsa è stata modificata dopo la lettura: l'If-Match non corrisponde all'ETag corrente")
+	Attribute("message", String, "Descrizione dell'errore", func() {
+		Default("La risorsa è stata modificata da un'altra richiesta")
+	})
+	Required("message")
+})
@@ -0,0 +1,160 @@
+package design
+
+import (
+	"be/design/errors"
+
+	. "goa.design/goa/v3/dsl"
+)
+
+var ExerciseMatch = Type("ExerciseMatch", func() {
+	Description("Exercise type an imported exercise name was mapped to, or is suggested for")
+	Attribute("name", String, "Exercise name in the export", func() {
+		Example("Bench Press (Barbell)")
+	})
+	Attribute("exerciseTypeId", String, "Matched exercise type, missing when nothing matched", func() {
+		Format(FormatUUID)
+		Example("3a4b5c6d-7e8f-4a9b-8c0d-1e2f3a4b5c6d")
+	})
+	Attribute("exerciseTypeName", String, "Name of the matched exercise type", func() {
+		Example("Bench Press")
+	})
+	Attribute("score", Float64, "Similarity between the names, from 0 to 1", func() {
+		Example(0.92)
+	})
+	Attribute("exact", Boolean, "Whether the names match once normalized", func() {
+		Example(false)
+	})
+	Attribute("applied", Boolean, "Whether the imported sets are recorded against the exercise type. Only names holding the same words as the type, in any order, are applied; other matches are suggestions and the sets are imported without a type.", func() {
+		Example(true)
+	})
+	Required("name", "score", "exact", "applied")
+})
+
+var ImportedSet = Type("ImportedSet", func() {
+	Description("Set of an imported session")
+	Attribute("weight", Float64, "Weight lifted (kg)", func() {
+		Example(80.0)
+	})
+	Attribute("reps", Int, "Repetitions", func() {
+		Example(5)
+	})
+	Required("weight", "reps")
+})
+
+var ImportedExercise = Type("ImportedExercise", func() {
+	Description("Exercise of an imported session")
+	Attribute("name", String, "Exercise name in the export", func() {
+		Example("Bench Press (Barbell)")
+	})
+	Attribute("exerciseTypeId", String, "Exercise type the sets are recorded against, missing when no match was applied", func() {
+		Format(FormatUUID)
+	})
+	Attribute("sets", ArrayOf(ImportedSet), "Sets in order")
+	Required("name", "sets")
+})
+
+var ImportedSession = Type("ImportedSession", func() {
+	Description("Workout session read from an export")
+	Attribute("startedAt", String, "Start of the session", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T18:00:00Z")
+	})
+	Attribute("finishedAt", String, "End of the session", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T19:05:00Z")
+	})
+	Attribute("workoutName", String, "Workout name in the export", func() {
+		Example("Push Day")
+	})
+	Attribute("duplicate", Boolean, "Whether the session was already imported and is skipped", func() {
+		Example(false)
+	})
+	Attribute("exercises", ArrayOf(ImportedExercise), "Exercises in order")
+	Required("startedAt", "finishedAt", "workoutName", "duplicate", "exercises")
+})
+
+var HistoryImport = Type("HistoryImport", func() {
+	Description("Outcome of a history import, or what it would create in a dry run")
+	Attribute("format", String, "Detected export format", func() {
+		Example("strong")
+	})
+	Attribute("dryRun", Boolean, "Whether nothing was saved", func() {
+		Example(true)
+	})
+	Attribute("trainingPlanId", String, "Plan holding the imported sessions, missing in a dry run", func() {
+		Format(FormatUUID)
+	})
+	Attribute("created", Int, "Sessions created, or that would be created", func() {
+		Example(42)
+	})
+	Attribute("duplicates", Int, "Sessions skipped because they were already imported", func() {
+		Example(0)
+	})
+	Attribute("sets", Int, "Sets created, or that would be created", func() {
+		Example(510)
+	})
+	Attribute("records", Int, "Personal records set by the imported sets", func() {
+		Example(12)
+	})
+	Attribute("sessions", ArrayOf(ImportedSession), "Sessions read from the file")
+	Attribute("matches", ArrayOf(ExerciseMatch), "How each exercise name was mapped to the catalog")
+	Attribute("skippedRows", ArrayOf(String), "Lines that could not be read, and why", func() {
+		Example([]string{"line 12: invalid date \"yesterday\""})
+	})
+	Required("format", "dryRun", "created", "duplicates", "sets", "records", "sessions", "matches", "skippedRows")
+})
+
+var ImporterService = Service("importer", func() {
+	Security(OAuth2, func() {
+		Scope("openid")
+	})
+
+	Description("Import of workout history exported by other apps")
+
+	HTTP(func() {
+		Path("/imports")
+	})
+
+	Error("unauthorized", errors.Unauthorized, "Auth Failed")
+	Error("internalServerError", errors.InternalServerError, "Internal Server Error")
+	Error("notFound", errors.NotFound, "Not Found")
+	Error("badRequest", errors.BadRequest, "Invalid Request")
+	Error("forbidden", errors.Forbidden, "Accesso negato")
+
+	Method("history", func() {
+		Description("Import a CSV export of Strong or Hevy as finished workout sessions. The request body is the raw CSV file, of at most 10 MiB. With dryRun nothing is saved and the response previews the sessions and exercise matches.")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("format", String, "Export format, detected from the header when auto", func() {
+				Enum("auto", "strong", "hevy")
+				Default("auto")
+			})
+			Attribute("dryRun", Boolean, "Preview the import without saving", func() {
+				Default(false)
+			})
+			Attribute("userId", String, "Athlete receiving the history, defaults to the caller", func() {
+				Format(FormatUUID)
+				Example("550e8400-e29b-41d4-a716-446655440000")
+			})
+			Attribute("unit", String, "Unit of weights the export doesn't label", func() {
+				Enum("kg", "lb")
+				Default("kg")
+			})
+			Attribute("timezone", String, "IANA zone of the export's times, defaults to the athlete's", func() {
+				Example("Europe/Rome")
+			})
+		})
+		Result(HistoryImport)
+		HTTP(func() {
+			POST("/history")
+			Param("format")
+			Param("dryRun")
+			Param("userId")
+			Param("unit")
+			Param("timezone")
+			SkipRequestBodyEncodeDecode()
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+})
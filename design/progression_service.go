@@ -0,0 +1,215 @@
+package design
+
+import (
+	"be/design/errors"
+
+	. "goa.design/goa/v3/dsl"
+)
+
+var progressionKinds = []any{"linear", "double_progression", "percentage_of_one_rep_max"}
+
+var ProgressionRule = Type("ProgressionRule", func() {
+	Description("Rule adjusting the targets of a planned exercise after each finished session")
+	Attribute("id", String, "Rule ID", func() {
+		Format(FormatUUID)
+		Example("4e5f6a7b-8c9d-4e0f-a1b2-c3d4e5f6a7b8")
+	})
+	Attribute("exerciseId", String, "Planned exercise", func() {
+		Format(FormatUUID)
+		Example("2d3e4f5a-6b7c-4d8e-9f0a-1b2c3d4e5f6a")
+	})
+	Attribute("kind", String, "Progression scheme", func() {
+		Enum(progressionKinds...)
+		Example("linear")
+	})
+	Attribute("increment", Float64, "Weight added when the target is met (kg)", func() {
+		Example(2.5)
+	})
+	Attribute("minReps", Int, "Bottom of the rep range, for double progression", func() {
+		Example(8)
+	})
+	Attribute("maxReps", Int, "Top of the rep range, for double progression", func() {
+		Example(12)
+	})
+	Attribute("percentage", Float64, "Fraction of the estimated one-rep max, for percentage_of_one_rep_max", func() {
+		Example(0.75)
+	})
+	Attribute("deloadAfter", Int, "Consecutive missed sessions before a deload, 0 to never deload", func() {
+		Example(2)
+	})
+	Attribute("deloadPercentage", Float64, "Fraction of the weight removed by a deload", func() {
+		Example(0.1)
+	})
+	Attribute("misses", Int, "Consecutive sessions the target was missed", func() {
+		Example(0)
+	})
+	Attribute("updatedAt", String, "Last change of the rule", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T18:00:00Z")
+	})
+	Required("id", "exerciseId", "kind", "increment", "deloadAfter", "deloadPercentage", "misses", "updatedAt")
+})
+
+var ProgressionChange = Type("ProgressionChange", func() {
+	Description("Target adjustment made after a session, with the reason")
+	Attribute("id", String, "Change ID", func() {
+		Format(FormatUUID)
+		Example("5f6a7b8c-9d0e-4f1a-b2c3-d4e5f6a7b8c9")
+	})
+	Attribute("sessionId", String, "Session that triggered the change", func() {
+		Format(FormatUUID)
+		Example("6f7a8b9c-0d1e-4f2a-8b3c-4d5e6f7a8b9c")
+	})
+	Attribute("outcome", String, "Effect on the target", func() {
+		Enum("increase", "hold", "decrease", "deload")
+		Example("increase")
+	})
+	Attribute("previousWeight", Float64, "Previous target weight (kg)", func() {
+		Example(80.0)
+	})
+	Attribute("newWeight", Float64, "New target weight (kg)", func() {
+		Example(82.5)
+	})
+	Attribute("previousReps", Int, "Previous target repetitions", func() {
+		Example(5)
+	})
+	Attribute("newReps", Int, "New target repetitions", func() {
+		Example(5)
+	})
+	Attribute("reason", String, "Why the change was made", func() {
+		Example("all 3 sets completed at 80 kg x 5: +2.5 kg")
+	})
+	Attribute("createdAt", String, "When the change was made", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T19:00:00Z")
+	})
+	Required("id", "sessionId", "outcome", "previousWeight", "newWeight", "previousReps", "newReps", "reason", "createdAt")
+})
+
+var ProgressionService = Service("progression", func() {
+	Security(OAuth2, func() {
+		Scope("openid")
+	})
+
+	Description("Automatic progression of planned exercises")
+
+	HTTP(func() {
+		Path("/exercises/{id}/progression")
+	})
+
+	Error("unauthorized", errors.Unauthorized, "Auth Failed")
+	Error("internalServerError", errors.InternalServerError, "Internal Server Error")
+	Error("notFound", errors.NotFound, "Not Found")
+	Error("badRequest", errors.BadRequest, "Invalid Request")
+	Error("forbidden", errors.Forbidden, "Accesso negato")
+
+	Method("set", func() {
+		Description("Attach a progression rule to a planned exercise, replacing the current one")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Planned exercise ID", func() {
+				Format(FormatUUID)
+			})
+			Attribute("kind", String, "Progression scheme", func() {
+				Enum(progressionKinds...)
+				Example("double_progression")
+			})
+			Attribute("increment", Float64, "Weight added when the target is met (kg)", func() {
+				Minimum(0)
+				Default(2.5)
+			})
+			Attribute("minReps", Int, "Bottom of the rep range, required for double progression", func() {
+				Minimum(1)
+				Example(8)
+			})
+			Attribute("maxReps", Int, "Top of the rep range, required for double progression", func() {
+				Minimum(1)
+				Example(12)
+			})
+			Attribute("percentage", Float64, "Fraction of the estimated one-rep max, required for percentage_of_one_rep_max", func() {
+				Minimum(0.3)
+				Maximum(1)
+				Example(0.75)
+			})
+			Attribute("deloadAfter", Int, "Consecutive missed sessions before a deload, 0 to never deload", func() {
+				Minimum(0)
+				Default(2)
+			})
+			Attribute("deloadPercentage", Float64, "Fraction of the weight removed by a deload", func() {
+				Minimum(0)
+				Maximum(0.5)
+				Default(0.1)
+			})
+			Required("id", "kind")
+		})
+		Result(ProgressionRule)
+		HTTP(func() {
+			PUT("")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("get", func() {
+		Description("Get the progression rule of a planned exercise")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Planned exercise ID", func() {
+				Format(FormatUUID)
+			})
+			Required("id")
+		})
+		Result(ProgressionRule)
+		HTTP(func() {
+			GET("")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("delete", func() {
+		Description("Detach the progression rule of a planned exercise")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Planned exercise ID", func() {
+				Format(FormatUUID)
+			})
+			Required("id")
+		})
+		HTTP(func() {
+			DELETE("")
+			Response(StatusNoContent)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("history", func() {
+		Description("Changes made to the exercise's targets, newest first")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Planned exercise ID", func() {
+				Format(FormatUUID)
+			})
+			Attribute("limit", Int, "Max number of results", func() {
+				Minimum(1)
+				Maximum(100)
+				Default(20)
+				Example(10)
+			})
+			Attribute("offset", Int, "Results to skip", func() {
+				Minimum(0)
+				Default(0)
+				Example(0)
+			})
+			Required("id")
+		})
+		Result(ArrayOf(ProgressionChange))
+		HTTP(func() {
+			GET("/history")
+			Param("limit")
+			Param("offset")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+})
@@ -0,0 +1,130 @@
+package design
+
+import (
+	"be/design/errors"
+
+	. "goa.design/goa/v3/dsl"
+)
+
+var recordKinds = []any{"max_weight", "max_reps", "estimated_one_rep_max", "session_volume"}
+
+var PersonalRecord = Type("PersonalRecord", func() {
+	Attribute("id", String, "Record ID", func() {
+		Format(FormatUUID)
+		Example("8c9d0e1f-2a3b-4c4d-9e5f-6a7b8c9d0e1f")
+	})
+	Attribute("userId", String, "Athlete holding the record", func() {
+		Format(FormatUUID)
+		Example("550e8400-e29b-41d4-a716-446655440000")
+	})
+	Attribute("exerciseTypeId", String, "Exercise type", func() {
+		Format(FormatUUID)
+		Example("3b4c5d6e-7f8a-4b9c-8d0e-1f2a3b4c5d6e")
+	})
+	Attribute("kind", String, "Record kind", func() {
+		Enum(recordKinds...)
+		Example("max_weight")
+	})
+	Attribute("value", Float64, "Record value: kg, reps or kg x reps depending on the kind", func() {
+		Example(102.5)
+	})
+	Attribute("previousValue", Float64, "Best value before this record", func() {
+		Example(100.0)
+	})
+	Attribute("weight", Float64, "Weight of the set in kg", func() {
+		Example(100.0)
+	})
+	Attribute("reps", Int, "Repetitions of the set", func() {
+		Example(3)
+	})
+	Attribute("sessionId", String, "Session the record was set in", func() {
+		Format(FormatUUID)
+		Example("6f7a8b9c-0d1e-4f2a-8b3c-4d5e6f7a8b9c")
+	})
+	Attribute("setId", String, "Set that set the record", func() {
+		Format(FormatUUID)
+		Example("5a0f8a0c-6d2b-4b8e-9c1d-2f3e4a5b6c7d")
+	})
+	Attribute("achievedAt", String, "When the record was set", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T18:05:00Z")
+	})
+	Required("id", "userId", "exerciseTypeId", "kind", "value", "achievedAt")
+})
+
+// recordFilters declares the attributes shared by the records payloads.
+func recordFilters() {
+	AccessToken("token", String, "OAuth2 access token used to perform authorization")
+	Attribute("userId", String, "Athlete, defaults to the caller", func() {
+		Format(FormatUUID)
+	})
+	Attribute("exerciseTypeId", String, "Filter by exercise type", func() {
+		Format(FormatUUID)
+	})
+	Attribute("kind", String, "Filter by record kind", func() {
+		Enum(recordKinds...)
+	})
+}
+
+var RecordService = Service("record", func() {
+	Security(OAuth2, func() {
+		Scope("openid")
+	})
+
+	Description("Personal records detected from the logged sets")
+
+	HTTP(func() {
+		Path("/records")
+	})
+
+	Error("unauthorized", errors.Unauthorized, "Auth Failed")
+	Error("internalServerError", errors.InternalServerError, "Internal Server Error")
+	Error("notFound", errors.NotFound, "Not Found")
+	Error("badRequest", errors.BadRequest, "Invalid Request")
+	Error("forbidden", errors.Forbidden, "Accesso negato")
+
+	Method("list", func() {
+		Description("Current personal records, one per exercise type and kind (and per weight for max_reps)")
+		Payload(func() {
+			recordFilters()
+		})
+		Result(ArrayOf(PersonalRecord))
+		HTTP(func() {
+			GET("")
+			Param("userId")
+			Param("exerciseTypeId")
+			Param("kind")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("history", func() {
+		Description("Every personal record set, newest first")
+		Payload(func() {
+			recordFilters()
+			Attribute("limit", Int, "Max number of results", func() {
+				Minimum(1)
+				Maximum(100)
+				Default(20)
+				Example(10)
+			})
+			Attribute("offset", Int, "Results to skip", func() {
+				Minimum(0)
+				Default(0)
+				Example(0)
+			})
+		})
+		Result(ArrayOf(PersonalRecord))
+		HTTP(func() {
+			GET("/history")
+			Param("userId")
+			Param("exerciseTypeId")
+			Param("kind")
+			Param("limit")
+			Param("offset")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+})
@@ -0,0 +1,210 @@
+package design
+
+import (
+	"be/design/errors"
+
+	. "goa.design/goa/v3/dsl"
+)
+
+var WorkoutSchedule = Type("WorkoutSchedule", func() {
+	Attribute("workoutId", String, "Workout ID", func() {
+		Format(FormatUUID)
+		Example("7a8b9c0d-1e2f-4a3b-9c4d-5e6f7a8b9c0d")
+	})
+	Attribute("position", Int, "Order among the workouts of the same day", func() {
+		Example(1)
+	})
+	Attribute("dates", ArrayOf(String, func() {
+		Format(FormatDate)
+	}), "Specific dates the workout is planned on", func() {
+		Example([]string{"2025-03-27"})
+	})
+	Attribute("recurrence", String, "RRULE-style recurrence within the plan range (FREQ=DAILY|WEEKLY, INTERVAL, BYDAY, COUNT, UNTIL)", func() {
+		Example("FREQ=WEEKLY;BYDAY=MO,TH")
+	})
+	Required("workoutId", "position", "dates")
+})
+
+var CalendarEntry = Type("CalendarEntry", func() {
+	Attribute("date", String, "Day of the occurrence", func() {
+		Format(FormatDate)
+		Example("2025-03-27")
+	})
+	Attribute("workoutId", String, "Workout ID", func() {
+		Format(FormatUUID)
+		Example("7a8b9c0d-1e2f-4a3b-9c4d-5e6f7a8b9c0d")
+	})
+	Attribute("workoutName", String, "Workout name", func() {
+		Example("Push day")
+	})
+	Attribute("position", Int, "Order among the workouts of the same day", func() {
+		Example(1)
+	})
+	Attribute("trainingPlanId", String, "Training plan ID", func() {
+		Format(FormatUUID)
+		Example("11111111-2222-3333-4444-555555555555")
+	})
+	Attribute("trainingPlanName", String, "Training plan name", func() {
+		Example("Upper Body Strength")
+	})
+	Required("date", "workoutId", "workoutName", "position", "trainingPlanId", "trainingPlanName")
+})
+
+var TodayWorkouts = Type("TodayWorkouts", func() {
+	Attribute("date", String, "Current day in the user's time zone", func() {
+		Format(FormatDate)
+		Example("2025-03-27")
+	})
+	Attribute("timezone", String, "Time zone used to determine the day", func() {
+		Example("Europe/Rome")
+	})
+	Attribute("workouts", ArrayOf(CalendarEntry), "Workouts planned for the day")
+	Required("date", "timezone", "workouts")
+})
+
+var CalendarFeed = Type("CalendarFeed", func() {
+	Attribute("token", String, "Secret feed token, only returned when it is created", func() {
+		Example("calf_4f9c2b7e1d8a6c3b5e0f2a9d7c4b1e8f")
+	})
+	Attribute("path", String, "Feed path to subscribe to from a calendar app", func() {
+		Example("/api/v1/calendar/feed/calf_4f9c2b7e1d8a6c3b5e0f2a9d7c4b1e8f.ics")
+	})
+	Attribute("createdAt", String, "Creation time", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T10:00:00Z")
+	})
+	Required("token", "path", "createdAt")
+})
+
+var ScheduleService = Service("schedule", func() {
+	Security(OAuth2, func() {
+		Scope("openid")
+	})
+
+	Description("Scheduling of workouts and the athlete's calendar")
+
+	Error("unauthorized", errors.Unauthorized, "Auth Failed")
+	Error("internalServerError", errors.InternalServerError, "Internal Server Error")
+	Error("notFound", errors.NotFound, "Not Found")
+	Error("badRequest", errors.BadRequest, "Invalid Request")
+	Error("forbidden", errors.Forbidden, "Accesso negato")
+
+	Method("set", func() {
+		Description("Replace the schedule of a workout")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Workout ID", func() {
+				Format(FormatUUID)
+			})
+			Attribute("position", Int, "Order among the workouts of the same day", func() {
+				Minimum(0)
+				Default(0)
+				Example(1)
+			})
+			Attribute("dates", ArrayOf(String, func() {
+				Format(FormatDate)
+			}), "Specific dates the workout is planned on", func() {
+				Example([]string{"2025-03-27"})
+			})
+			Attribute("recurrence", String, "RRULE-style recurrence within the plan range", func() {
+				Example("FREQ=WEEKLY;BYDAY=MO,TH")
+			})
+			Required("id")
+		})
+		Result(WorkoutSchedule)
+		HTTP(func() {
+			PUT("/workouts/{id}/schedule")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("calendar", func() {
+		Description("Expand the caller's scheduled workouts between two days")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("from", String, "First day, inclusive", func() {
+				Format(FormatDate)
+				Example("2025-03-24")
+			})
+			Attribute("to", String, "Last day, inclusive", func() {
+				Format(FormatDate)
+				Example("2025-03-30")
+			})
+			Required("from", "to")
+		})
+		Result(ArrayOf(CalendarEntry))
+		HTTP(func() {
+			GET("/calendar")
+			Param("from")
+			Param("to")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("today", func() {
+		Description("Workouts planned for the current day in the caller's time zone")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("timezone", String, "IANA time zone overriding the user's one", func() {
+				Example("Europe/Rome")
+			})
+		})
+		Result(TodayWorkouts)
+		HTTP(func() {
+			GET("/calendar/today")
+			Param("timezone")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("createFeed", func() {
+		Description("Create the caller's ICS feed token, revoking the previous one")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+		})
+		Result(CalendarFeed)
+		HTTP(func() {
+			POST("/calendar/feed")
+			Response(StatusCreated)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("revokeFeed", func() {
+		Description("Revoke the caller's ICS feed token")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+		})
+		HTTP(func() {
+			DELETE("/calendar/feed")
+			Response(StatusNoContent)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("feed", func() {
+		Description("ICS feed of the scheduled workouts, authenticated by the feed token in the URL")
+		NoSecurity()
+		Payload(func() {
+			Attribute("feedToken", String, "Feed token, optionally followed by .ics", func() {
+				Example("calf_4f9c2b7e1d8a6c3b5e0f2a9d7c4b1e8f.ics")
+			})
+			Required("feedToken")
+		})
+		Result(func() {
+			Attribute("contentType", String, "Content type of the feed")
+			Required("contentType")
+		})
+		HTTP(func() {
+			GET("/calendar/feed/{feedToken}")
+			SkipResponseBodyEncodeDecode()
+			Response(StatusOK, func() {
+				Header("contentType:Content-Type")
+			})
+			errors.CommonResponses()
+		})
+	})
+})
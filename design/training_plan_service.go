@@ -29,6 +29,16 @@ var TrainingPlan = Type("TrainingPlan", func() {
 		Format(FormatUUID)
 		Example("550e8400-e29b-41d4-a716-446655440000")
 	})
+	Attribute("warnings", ArrayOf(String), "Non-blocking validation warnings, such as overlaps with the user's other plans", func() {
+		Example([]string{`overlaps plan "Hypertrophy Block" (2025-03-01 to 2025-04-01)`})
+	})
+	Attribute("deletedAt", String, "When the plan was moved to the trash, set only for deleted plans", func() {
+		Format(FormatDateTime)
+		Example("2025-05-01T09:30:00Z")
+	})
+	Attribute("etag", String, "Entity tag of the current version, to send back in If-Match when changing it", func() {
+		Example(`"3"`)
+	})
 	Required("id", "name", "startDate", "endDate", "userId")
 })
 
@@ -55,6 +65,159 @@ var CreateTrainingPlanPayload = Type("CreateTrainingPlanPayload", func() {
 	Required("name", "startDate", "endDate", "userId")
 })
 
+var PlanTemplate = Type("PlanTemplate", func() {
+	Attribute("id", String, "Template ID", func() {
+		Format(FormatUUID)
+		Example("22222222-3333-4444-5555-666666666666")
+	})
+	Attribute("name", String, "Name of the template", func() {
+		Example("5x5 Beginner")
+	})
+	Attribute("description", String, "Description of the template", func() {
+		Example("Linear progression on the main lifts.")
+	})
+	Attribute("startDate", String, "Anchor date of the template's schedule", func() {
+		Format(FormatDateTime)
+		Example("2025-03-24T00:00:00Z")
+	})
+	Attribute("endDate", String, "End of the template's schedule", func() {
+		Format(FormatDateTime)
+		Example("2025-05-19T00:00:00Z")
+	})
+	Attribute("ownerId", String, "Coach owning the template", func() {
+		Format(FormatUUID)
+		Example("550e8400-e29b-41d4-a716-446655440000")
+	})
+	Attribute("public", Boolean, "Whether every user can clone the template", func() {
+		Example(false)
+	})
+	Required("id", "name", "startDate", "endDate", "public")
+})
+
+var PlanDocumentSet = Type("PlanDocumentSet", func() {
+	Attribute("weight", Float64, "Weight in kg", func() {
+		Minimum(0)
+		Example(80.0)
+	})
+	Attribute("reps", Int, "Repetitions", func() {
+		Minimum(0)
+		Example(8)
+	})
+	Attribute("restTime", Int, "Rest time in seconds", func() {
+		Minimum(0)
+		Example(90)
+	})
+	Required("weight", "reps", "restTime")
+})
+
+var PlanDocumentProgression = Type("PlanDocumentProgression", func() {
+	Attribute("kind", String, "Progression scheme", func() {
+		Enum(progressionKinds...)
+		Example("linear")
+	})
+	Attribute("increment", Float64, "Weight added when the target is met (kg)", func() {
+		Minimum(0)
+		Example(2.5)
+	})
+	Attribute("minReps", Int, "Bottom of the rep range", func() {
+		Minimum(1)
+	})
+	Attribute("maxReps", Int, "Top of the rep range", func() {
+		Minimum(1)
+	})
+	Attribute("percentage", Float64, "Fraction of the estimated one-rep max", func() {
+		Minimum(0.3)
+		Maximum(1)
+	})
+	Attribute("deloadAfter", Int, "Consecutive missed sessions before a deload", func() {
+		Minimum(0)
+	})
+	Attribute("deloadPercentage", Float64, "Fraction of the weight removed by a deload", func() {
+		Minimum(0)
+		Maximum(0.5)
+	})
+	Required("kind", "increment", "deloadAfter", "deloadPercentage")
+})
+
+var PlanDocumentExercise = Type("PlanDocumentExercise", func() {
+	Attribute("name", String, "Exercise name", func() {
+		MinLength(1)
+		Example("Bench Press")
+	})
+	Attribute("exerciseType", String, "Name of the exercise type in the catalog, defaults to the exercise name", func() {
+		Example("Bench Press")
+	})
+	Attribute("sets", ArrayOf(PlanDocumentSet), "Planned sets")
+	Attribute("progression", PlanDocumentProgression, "Progression rule of the exercise")
+	Required("name", "sets")
+})
+
+var PlanDocumentWorkout = Type("PlanDocumentWorkout", func() {
+	Attribute("name", String, "Workout name", func() {
+		MinLength(1)
+		Example("Push day")
+	})
+	Attribute("position", Int, "Order among the workouts of the same day", func() {
+		Example(1)
+	})
+	Attribute("scheduledDates", ArrayOf(String, func() {
+		Format(FormatDate)
+	}), "Specific dates the workout is planned on")
+	Attribute("recurrence", String, "RRULE-style recurrence within the plan range", func() {
+		Example("FREQ=WEEKLY;BYDAY=MO,TH")
+	})
+	Attribute("exercises", ArrayOf(PlanDocumentExercise), "Exercises of the workout")
+	Required("name", "exercises")
+})
+
+var PlanDocumentPlan = Type("PlanDocumentPlan", func() {
+	Attribute("name", String, "Name of the plan", func() {
+		MinLength(1)
+		Example("Upper Body Strength")
+	})
+	Attribute("description", String, "Description of the plan")
+	Attribute("startDate", String, "Start date in ISO 8601", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T00:00:00Z")
+	})
+	Attribute("endDate", String, "End date in ISO 8601", func() {
+		Format(FormatDateTime)
+		Example("2025-04-25T00:00:00Z")
+	})
+	Attribute("workouts", ArrayOf(PlanDocumentWorkout), "Workouts of the plan")
+	Required("name", "startDate", "endDate", "workouts")
+})
+
+var PlanDocument = Type("PlanDocument", func() {
+	Description("Versioned document of a training plan with its workouts, exercises and planned sets, as produced by export")
+	Attribute("version", Int, "Document format version", func() {
+		Enum(1)
+		Example(1)
+	})
+	Attribute("exportedAt", String, "When the document was exported", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("plan", PlanDocumentPlan, "The plan")
+	Required("version", "plan")
+})
+
+var PlanImportReport = Type("PlanImportReport", func() {
+	Attribute("plan", TrainingPlan, "The imported plan")
+	Attribute("workouts", Int, "Workouts created", func() {
+		Example(4)
+	})
+	Attribute("exercises", Int, "Exercises created", func() {
+		Example(20)
+	})
+	Attribute("sets", Int, "Planned sets created", func() {
+		Example(72)
+	})
+	Attribute("unmatched", ArrayOf(String), "Exercise type names not found in the catalog; their exercises were skipped", func() {
+		Example([]string{"Landmine Press"})
+	})
+	Required("plan", "workouts", "exercises", "sets", "unmatched")
+})
+
 var TrainingPlanService = Service("training_plan", func() {
 	Security(OAuth2, func() {
 		Scope("openid")
@@ -68,6 +231,9 @@ var TrainingPlanService = Service("training_plan", func() {
 	Error("notFound", errors.NotFound)
 	Error("internalServerError", errors.InternalServerError)
 	Error("badRequest", errors.BadRequest)
+	Error("unauthorized", errors.Unauthorized)
+	Error("forbidden", errors.Forbidden)
+	Error("preconditionFailed", errors.PreconditionFailed)
 
 	Method("create", func() {
 		Payload(func() {
@@ -77,7 +243,10 @@ var TrainingPlanService = Service("training_plan", func() {
 		Result(TrainingPlan)
 		HTTP(func() {
 			POST("")
-			Response(StatusCreated)
+			Response(StatusCreated, func() {
+				Header("etag:ETag")
+			})
+			errors.CommonResponses()
 		})
 	})
 
@@ -93,7 +262,10 @@ var TrainingPlanService = Service("training_plan", func() {
 		Result(TrainingPlan)
 		HTTP(func() {
 			GET("/{id}")
-			Response(StatusOK)
+			Response(StatusOK, func() {
+				Header("etag:ETag")
+			})
+			errors.CommonResponses()
 		})
 	})
 
@@ -131,6 +303,7 @@ var TrainingPlanService = Service("training_plan", func() {
 			Param("limit")
 			Param("offset")
 			Response(StatusOK)
+			errors.CommonResponses()
 		})
 	})
 
@@ -141,12 +314,45 @@ var TrainingPlanService = Service("training_plan", func() {
 				Format(FormatUUID)
 			})
 			Extend(CreateTrainingPlanPayload)
-			Required("id")
+			Attribute("ifMatch", String, "ETag of the version being changed, as returned by get", func() {
+				Example(`"3"`)
+			})
+			Required("id", "ifMatch")
 		})
 		Result(TrainingPlan)
 		HTTP(func() {
 			PUT("/{id}")
-			Response(StatusOK)
+			Header("ifMatch:If-Match")
+			Response(StatusOK, func() {
+				Header("etag:ETag")
+			})
+			Response("preconditionFailed", StatusPreconditionFailed)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("patch", func() {
+		Description("Change some fields of a plan with a JSON Merge Patch (RFC 7396) body: members left out are unchanged and null clears description")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, func() {
+				Format(FormatUUID)
+			})
+			Attribute("ifMatch", String, "ETag of the version being changed, as returned by get", func() {
+				Example(`"3"`)
+			})
+			Required("id", "ifMatch")
+		})
+		Result(TrainingPlan)
+		HTTP(func() {
+			PATCH("/{id}")
+			Header("ifMatch:If-Match")
+			SkipRequestBodyEncodeDecode()
+			Response(StatusOK, func() {
+				Header("etag:ETag")
+			})
+			Response("preconditionFailed", StatusPreconditionFailed)
+			errors.CommonResponses()
 		})
 	})
 
@@ -156,11 +362,203 @@ var TrainingPlanService = Service("training_plan", func() {
 			Field(1, "id", String, func() {
 				Format(FormatUUID)
 			})
-			Required("id")
+			Attribute("ifMatch", String, "ETag of the version being changed, as returned by get", func() {
+				Example(`"3"`)
+			})
+			Required("id", "ifMatch")
 		})
 		HTTP(func() {
 			DELETE("/{id}")
+			Header("ifMatch:If-Match")
 			Response(StatusNoContent)
+			Response("preconditionFailed", StatusPreconditionFailed)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("restore", func() {
+		Description("Restore a deleted plan together with the workouts, exercises and sets deleted with it")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "TrainingPlan ID", func() {
+				Format(FormatUUID)
+			})
+			Required("id")
+		})
+		Result(TrainingPlan)
+		HTTP(func() {
+			POST("/{id}/restore")
+			Response(StatusOK, func() {
+				Header("etag:ETag")
+			})
+			errors.CommonResponses()
+		})
+	})
+
+	Method("listDeleted", func() {
+		Description("List the plans in the trash, most recently deleted first")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("userId", String, "Filter by user ID", func() {
+				Format(FormatUUID)
+				Example("550e8400-e29b-41d4-a716-446655440000")
+			})
+			Attribute("limit", Int, "Max number of results", func() {
+				Minimum(1)
+				Maximum(100)
+				Default(20)
+				Example(10)
+			})
+			Attribute("offset", Int, "Results to skip", func() {
+				Minimum(0)
+				Default(0)
+				Example(0)
+			})
+		})
+		Result(ArrayOf(TrainingPlan))
+		HTTP(func() {
+			GET("/deleted")
+			Param("userId")
+			Param("limit")
+			Param("offset")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("clone", func() {
+		Description("Deep-copy a plan or template into a plan of the target user, shifting its dates to the new start date")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Plan or template to clone", func() {
+				Format(FormatUUID)
+			})
+			Attribute("userId", String, "Athlete receiving the plan, defaults to the caller", func() {
+				Format(FormatUUID)
+				Example("550e8400-e29b-41d4-a716-446655440000")
+			})
+			Attribute("startDate", String, "Start date of the new plan", func() {
+				Format(FormatDateTime)
+				Example("2025-06-02T00:00:00Z")
+			})
+			Attribute("name", String, "Name of the new plan, defaults to the source's", func() {
+				MinLength(1)
+				Example("Upper Body Strength - June")
+			})
+			Required("id", "startDate")
+		})
+		Result(TrainingPlan)
+		HTTP(func() {
+			POST("/{id}/clone")
+			Response(StatusCreated, func() {
+				Header("etag:ETag")
+			})
+			errors.CommonResponses()
+		})
+	})
+
+	Method("createTemplate", func() {
+		Description("Save a copy of a plan as a template owned by the caller")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Plan to copy", func() {
+				Format(FormatUUID)
+			})
+			Attribute("name", String, "Name of the template, defaults to the plan's", func() {
+				MinLength(1)
+				Example("5x5 Beginner")
+			})
+			Attribute("public", Boolean, "Whether every user can clone the template", func() {
+				Default(false)
+			})
+			Required("id")
+		})
+		Result(PlanTemplate)
+		HTTP(func() {
+			POST("/{id}/template")
+			Response(StatusCreated)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("listTemplates", func() {
+		Description("List the public templates and those owned by the caller")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("limit", Int, "Max number of results", func() {
+				Minimum(1)
+				Maximum(100)
+				Default(20)
+				Example(10)
+			})
+			Attribute("offset", Int, "Results to skip", func() {
+				Minimum(0)
+				Default(0)
+				Example(0)
+			})
+		})
+		Result(ArrayOf(PlanTemplate))
+		HTTP(func() {
+			GET("/templates")
+			Param("limit")
+			Param("offset")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("export", func() {
+		Description("Export a plan as a versioned JSON document of the full plan tree, or its logged sets as CSV")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Training plan ID", func() {
+				Format(FormatUUID)
+			})
+			Attribute("format", String, "json for the plan document, csv for the logged sets", func() {
+				Enum("json", "csv")
+				Default("json")
+			})
+			Required("id")
+		})
+		Result(func() {
+			Attribute("contentType", String, "Content type of the export")
+			Attribute("contentDisposition", String, "Attachment file name")
+			Required("contentType", "contentDisposition")
+		})
+		HTTP(func() {
+			GET("/{id}/export")
+			Param("format")
+			SkipResponseBodyEncodeDecode()
+			Response(StatusOK, func() {
+				Header("contentType:Content-Type")
+				Header("contentDisposition:Content-Disposition")
+			})
+			errors.CommonResponses()
+		})
+	})
+
+	Method("import", func() {
+		Description("Import a plan document in one transaction, mapping exercise names to the exercise type catalog")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("userId", String, "Athlete receiving the plan, defaults to the caller", func() {
+				Format(FormatUUID)
+				Example("550e8400-e29b-41d4-a716-446655440000")
+			})
+			Attribute("allowUnmatched", Boolean, "Skip exercises whose type is not in the catalog instead of rejecting the import", func() {
+				Default(false)
+			})
+			Attribute("document", PlanDocument, "Plan document")
+			Required("document")
+		})
+		Result(PlanImportReport)
+		HTTP(func() {
+			POST("/import")
+			Param("userId")
+			Param("allowUnmatched")
+			Body("document")
+			Response(StatusCreated)
+			errors.CommonResponses()
 		})
 	})
 })
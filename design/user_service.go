@@ -28,6 +28,16 @@ var User = Type("User", func() {
 		Default(false)
 		Example(false)
 	})
+	Attribute("timezone", String, "IANA time zone of the user", func() {
+		Example("Europe/Rome")
+	})
+	Attribute("deletedAt", String, "When the user was moved to the trash, set only for deleted users", func() {
+		Format(FormatDateTime)
+		Example("2025-05-01T09:30:00Z")
+	})
+	Attribute("etag", String, "Entity tag of the current version, to send back in If-Match when changing it", func() {
+		Example(`"3"`)
+	})
 	Required("id", "kcId", "firstName", "lastName")
 })
 
@@ -81,6 +91,7 @@ var UserService = Service("user", func() {
 	Error("notFound", errors.NotFound, "Not Found")
 	Error("badRequest", errors.BadRequest, "Invalid Request")
 	Error("forbidden", errors.Forbidden, "Accesso negato")
+	Error("preconditionFailed", errors.PreconditionFailed, "Precondition Failed")
 
 	Method("create", func() {
 		Description("Create a new user")
@@ -91,7 +102,9 @@ var UserService = Service("user", func() {
 		Result(User)
 		HTTP(func() {
 			POST("/")
-			Response(StatusCreated)
+			Response(StatusCreated, func() {
+				Header("etag:ETag")
+			})
 		})
 	})
 
@@ -108,13 +121,15 @@ var UserService = Service("user", func() {
 		Result(UserWithPlans)
 		HTTP(func() {
 			GET("/{id}")
-			Response(StatusOK)
+			Response(StatusOK, func() {
+				Header("etag:ETag")
+			})
 			errors.CommonResponses()
 		})
 	})
 
 	Method("list", func() {
-		Description("List all users with pagination")
+		Description("List users with pagination: every user for admins, the athletes they coach for the others")
 		Payload(func() {
 			AccessToken("token", String, "OAuth2 access token used to perform authorization")
 			Attribute("limit", Int, "Number of users to return per page", func() {
@@ -139,7 +154,7 @@ var UserService = Service("user", func() {
 	})
 
 	Method("update", func() {
-		Description("Update a user")
+		Description("Update a user. Users update themselves and admins anyone; only admins change admin")
 		Payload(func() {
 			AccessToken("token", String, "OAuth2 access token used to perform authorization")
 			Attribute("id", String, "User ID", func() {
@@ -159,12 +174,48 @@ var UserService = Service("user", func() {
 				Default(false)
 				Example(false)
 			})
-			Required("id", "firstName", "lastName")
+			Attribute("timezone", String, "IANA time zone", func() {
+				Example("Europe/Rome")
+			})
+			Attribute("ifMatch", String, "ETag of the version being changed, as returned by get", func() {
+				Example(`"3"`)
+			})
+			Required("id", "firstName", "lastName", "ifMatch")
 		})
 		Result(User)
 		HTTP(func() {
 			PUT("/{id}")
-			Response(StatusOK)
+			Header("ifMatch:If-Match")
+			Response(StatusOK, func() {
+				Header("etag:ETag")
+			})
+			Response("preconditionFailed", StatusPreconditionFailed)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("patch", func() {
+		Description("Change some fields of a user with a JSON Merge Patch (RFC 7396) body: members left out are unchanged, null clears nickname and resets timezone to UTC. Users patch themselves and admins anyone; only admins change admin")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "User ID", func() {
+				Example("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+				Format(FormatUUID)
+			})
+			Attribute("ifMatch", String, "ETag of the version being changed, as returned by get", func() {
+				Example(`"3"`)
+			})
+			Required("id", "ifMatch")
+		})
+		Result(User)
+		HTTP(func() {
+			PATCH("/{id}")
+			Header("ifMatch:If-Match")
+			SkipRequestBodyEncodeDecode()
+			Response(StatusOK, func() {
+				Header("etag:ETag")
+			})
+			Response("preconditionFailed", StatusPreconditionFailed)
 			errors.CommonResponses()
 		})
 	})
@@ -177,11 +228,62 @@ var UserService = Service("user", func() {
 				Example("f47ac10b-58cc-4372-a567-0e02b2c3d479")
 				Format(FormatUUID)
 			})
-			Required("id")
+			Attribute("ifMatch", String, "ETag of the version being changed, as returned by get", func() {
+				Example(`"3"`)
+			})
+			Required("id", "ifMatch")
 		})
 		HTTP(func() {
 			DELETE("/{id}")
+			Header("ifMatch:If-Match")
 			Response(StatusNoContent)
+			Response("preconditionFailed", StatusPreconditionFailed)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("restore", func() {
+		Description("Restore a deleted user together with the plans deleted with them (admin only)")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "User ID", func() {
+				Example("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+				Format(FormatUUID)
+			})
+			Required("id")
+		})
+		Result(User)
+		HTTP(func() {
+			POST("/{id}/restore")
+			Response(StatusOK, func() {
+				Header("etag:ETag")
+			})
+			errors.CommonResponses()
+		})
+	})
+
+	Method("listDeleted", func() {
+		Description("List the deleted users, most recently deleted first (admin only)")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("limit", Int, "Number of users to return per page", func() {
+				Example(10)
+				Minimum(1)
+				Maximum(100)
+				Default(10)
+			})
+			Attribute("offset", Int, "Number of users to skip", func() {
+				Example(0)
+				Minimum(0)
+				Default(0)
+			})
+		})
+		Result(ArrayOf(User))
+		HTTP(func() {
+			GET("/deleted")
+			Param("limit")
+			Param("offset")
+			Response(StatusOK)
 			errors.CommonResponses()
 		})
 	})
@@ -0,0 +1,219 @@
+package design
+
+import (
+	"be/design/errors"
+
+	. "goa.design/goa/v3/dsl"
+)
+
+var webhookEventTypes = []any{
+	"UserCreated", "UserUpdated", "UserDeleted", "UserRestored",
+	"TrainingPlanCreated", "TrainingPlanUpdated", "TrainingPlanDeleted", "TrainingPlanRestored",
+	"WorkoutUpdated",
+	"SetLogged",
+	"WorkoutSessionStarted", "WorkoutSessionFinished", "WorkoutSessionAbandoned",
+	"PersonalRecordAchieved",
+	"AthleteInvited", "CoachingStarted", "CoachingRevoked",
+	"ProgressionApplied",
+	"HistoryImported",
+}
+
+var WebhookSubscription = Type("WebhookSubscription", func() {
+	Attribute("id", String, "Subscription ID", func() {
+		Format(FormatUUID)
+		Example("3f2b1c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d")
+	})
+	Attribute("url", String, "Callback URL receiving the events", func() {
+		Example("https://coach.example.com/hooks/ld")
+	})
+	Attribute("eventTypes", ArrayOf(String), "Event types delivered to the callback", func() {
+		Example([]string{"SetLogged"})
+	})
+	Attribute("active", Boolean, "Whether deliveries are enabled", func() {
+		Example(true)
+	})
+	Attribute("createdAt", String, "Creation time", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T10:00:00Z")
+	})
+	Required("id", "url", "eventTypes", "active", "createdAt")
+})
+
+var CreatedWebhookSubscription = Type("CreatedWebhookSubscription", func() {
+	Description("Webhook subscription including its signing secret, returned only on creation")
+	Extend(WebhookSubscription)
+	Attribute("secret", String, "Secret used to sign deliveries with HMAC-SHA256", func() {
+		Example("whsec_5f0c8a0e3b9e4d0b9a7f1c2e")
+	})
+	Required("secret")
+})
+
+var WebhookDelivery = Type("WebhookDelivery", func() {
+	Attribute("id", String, "Delivery ID", func() {
+		Format(FormatUUID)
+		Example("7c9e6679-7425-40de-944b-e07fc1f90ae7")
+	})
+	Attribute("subscriptionId", String, "Subscription ID", func() {
+		Format(FormatUUID)
+		Example("3f2b1c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d")
+	})
+	Attribute("eventId", String, "ID of the delivered event", func() {
+		Format(FormatUUID)
+		Example("0b8e3c5e-2f55-4b9f-8d43-6f2c3c0b1a9e")
+	})
+	Attribute("eventType", String, "Type of the delivered event", func() {
+		Example("SetLogged")
+	})
+	Attribute("status", String, "Delivery status", func() {
+		Enum("pending", "delivered", "dead")
+		Example("delivered")
+	})
+	Attribute("attempts", Int, "Number of attempts made", func() {
+		Example(1)
+	})
+	Attribute("lastStatusCode", Int, "HTTP status of the last attempt", func() {
+		Example(200)
+	})
+	Attribute("lastError", String, "Error of the last failed attempt", func() {
+		Example("unexpected status 500")
+	})
+	Attribute("nextAttemptAt", String, "When the next attempt is scheduled", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T10:00:30Z")
+	})
+	Attribute("deliveredAt", String, "When the delivery succeeded", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T10:00:01Z")
+	})
+	Attribute("createdAt", String, "When the delivery was queued", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T10:00:00Z")
+	})
+	Required("id", "subscriptionId", "eventId", "eventType", "status", "attempts", "createdAt")
+})
+
+var WebhookService = Service("webhook", func() {
+	Security(OAuth2, func() {
+		Scope("openid")
+	})
+
+	Description("Outgoing webhook subscriptions with signed deliveries")
+
+	HTTP(func() {
+		Path("/webhooks")
+	})
+
+	Error("unauthorized", errors.Unauthorized, "Auth Failed")
+	Error("internalServerError", errors.InternalServerError, "Internal Server Error")
+	Error("notFound", errors.NotFound, "Not Found")
+	Error("badRequest", errors.BadRequest, "Invalid Request")
+	Error("forbidden", errors.Forbidden, "Accesso negato")
+
+	Method("create", func() {
+		Description("Register a callback URL for a set of event types")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("url", String, "Callback URL, served over HTTPS from a public address", func() {
+				Pattern(`^https://`)
+				MaxLength(2048)
+				Example("https://coach.example.com/hooks/ld")
+			})
+			Attribute("eventTypes", ArrayOf(String, func() {
+				Enum(webhookEventTypes...)
+			}), "Event types to deliver", func() {
+				MinLength(1)
+				Example([]string{"SetLogged"})
+			})
+			Required("url", "eventTypes")
+		})
+		Result(CreatedWebhookSubscription)
+		HTTP(func() {
+			POST("")
+			Response(StatusCreated)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("list", func() {
+		Description("List the caller's webhook subscriptions")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+		})
+		Result(ArrayOf(WebhookSubscription))
+		HTTP(func() {
+			GET("")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("delete", func() {
+		Description("Delete a webhook subscription")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Subscription ID", func() {
+				Format(FormatUUID)
+			})
+			Required("id")
+		})
+		HTTP(func() {
+			DELETE("/{id}")
+			Response(StatusNoContent)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("deliveries", func() {
+		Description("Delivery history of a webhook subscription")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Subscription ID", func() {
+				Format(FormatUUID)
+			})
+			Attribute("status", String, "Filter by delivery status", func() {
+				Enum("pending", "delivered", "dead")
+			})
+			Attribute("limit", Int, "Max number of results", func() {
+				Minimum(1)
+				Maximum(100)
+				Default(20)
+				Example(10)
+			})
+			Attribute("offset", Int, "Results to skip", func() {
+				Minimum(0)
+				Default(0)
+				Example(0)
+			})
+			Required("id")
+		})
+		Result(ArrayOf(WebhookDelivery))
+		HTTP(func() {
+			GET("/{id}/deliveries")
+			Param("status")
+			Param("limit")
+			Param("offset")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("redeliver", func() {
+		Description("Queue a dead-lettered delivery for another round of attempts")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Subscription ID", func() {
+				Format(FormatUUID)
+			})
+			Attribute("deliveryId", String, "Delivery ID", func() {
+				Format(FormatUUID)
+			})
+			Required("id", "deliveryId")
+		})
+		Result(WebhookDelivery)
+		HTTP(func() {
+			POST("/{id}/deliveries/{deliveryId}/redeliver")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+})
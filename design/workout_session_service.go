@@ -0,0 +1,276 @@
+package design
+
+import (
+	"be/design/errors"
+
+	. "goa.design/goa/v3/dsl"
+)
+
+var SessionSet = Type("SessionSet", func() {
+	Description("A set of a workout session, with the planned targets and what was performed")
+	Attribute("id", String, "Session set ID", func() {
+		Format(FormatUUID)
+		Example("5a0f8a0c-6d2b-4b8e-9c1d-2f3e4a5b6c7d")
+	})
+	Attribute("plannedSetId", String, "Planned exercise set this set was snapshotted from", func() {
+		Format(FormatUUID)
+		Example("9b2f7c1e-3d4a-4e5b-8f6c-7d8e9f0a1b2c")
+	})
+	Attribute("position", Int, "Order of the set within the exercise", func() {
+		Example(1)
+	})
+	Attribute("plannedWeight", Float64, "Planned weight in kg", func() {
+		Example(80.0)
+	})
+	Attribute("plannedReps", Int, "Planned repetitions", func() {
+		Example(8)
+	})
+	Attribute("plannedRestTime", Int, "Planned rest time in seconds", func() {
+		Example(90)
+	})
+	Attribute("weight", Float64, "Performed weight in kg", func() {
+		Example(80.0)
+	})
+	Attribute("reps", Int, "Performed repetitions", func() {
+		Example(7)
+	})
+	Attribute("restTime", Int, "Actual rest time in seconds", func() {
+		Example(120)
+	})
+	Attribute("completed", Boolean, "Whether the set was performed", func() {
+		Example(true)
+	})
+	Attribute("loggedAt", String, "When the set was logged", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T18:05:00Z")
+	})
+	Attribute("records", ArrayOf(String), "Personal record kinds set by this set, returned when it is logged", func() {
+		Example([]string{"max_weight", "estimated_one_rep_max"})
+	})
+	Required("id", "position", "completed")
+})
+
+var SessionExercise = Type("SessionExercise", func() {
+	Description("Snapshot of a planned exercise taken when the session started")
+	Attribute("id", String, "Session exercise ID", func() {
+		Format(FormatUUID)
+		Example("1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f")
+	})
+	Attribute("exerciseId", String, "Planned exercise ID", func() {
+		Format(FormatUUID)
+		Example("2d3e4f5a-6b7c-4d8e-9f0a-1b2c3d4e5f6a")
+	})
+	Attribute("exerciseTypeId", String, "Exercise type ID", func() {
+		Format(FormatUUID)
+		Example("3b4c5d6e-7f8a-4b9c-8d0e-1f2a3b4c5d6e")
+	})
+	Attribute("name", String, "Exercise name at the time the session started", func() {
+		Example("Bench Press")
+	})
+	Attribute("position", Int, "Order of the exercise within the session", func() {
+		Example(1)
+	})
+	Attribute("sets", ArrayOf(SessionSet), "Planned and performed sets")
+	Required("id", "name", "position", "sets")
+})
+
+var WorkoutSession = Type("WorkoutSession", func() {
+	Attribute("id", String, "Session ID", func() {
+		Format(FormatUUID)
+		Example("6f7a8b9c-0d1e-4f2a-8b3c-4d5e6f7a8b9c")
+	})
+	Attribute("workoutId", String, "Planned workout ID, absent once the workout is purged from the trash", func() {
+		Format(FormatUUID)
+		Example("7a8b9c0d-1e2f-4a3b-9c4d-5e6f7a8b9c0d")
+	})
+	Attribute("userId", String, "Athlete performing the session", func() {
+		Format(FormatUUID)
+		Example("550e8400-e29b-41d4-a716-446655440000")
+	})
+	Attribute("status", String, "Session status", func() {
+		Enum("in_progress", "finished", "abandoned")
+		Example("in_progress")
+	})
+	Attribute("startedAt", String, "Start time", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T18:00:00Z")
+	})
+	Attribute("finishedAt", String, "Finish or abandon time", func() {
+		Format(FormatDateTime)
+		Example("2025-03-25T19:00:00Z")
+	})
+	Attribute("notes", String, "Athlete notes", func() {
+		Example("Felt strong today")
+	})
+	Attribute("exercises", ArrayOf(SessionExercise), "Exercises of the session")
+	Required("id", "userId", "status", "startedAt")
+})
+
+var WorkoutSessionService = Service("workout_session", func() {
+	Security(OAuth2, func() {
+		Scope("openid")
+	})
+
+	Description("Tracking of performed workouts against the plan")
+
+	HTTP(func() {
+		Path("/workout-sessions")
+	})
+
+	Error("unauthorized", errors.Unauthorized, "Auth Failed")
+	Error("internalServerError", errors.InternalServerError, "Internal Server Error")
+	Error("notFound", errors.NotFound, "Not Found")
+	Error("badRequest", errors.BadRequest, "Invalid Request")
+	Error("forbidden", errors.Forbidden, "Accesso negato")
+
+	Method("start", func() {
+		Description("Start a session of a planned workout, snapshotting its exercises and sets")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("workoutId", String, "Planned workout ID", func() {
+				Format(FormatUUID)
+				Example("7a8b9c0d-1e2f-4a3b-9c4d-5e6f7a8b9c0d")
+			})
+			Attribute("notes", String, "Athlete notes", func() {
+				Example("Felt strong today")
+			})
+			Required("workoutId")
+		})
+		Result(WorkoutSession)
+		HTTP(func() {
+			POST("")
+			Response(StatusCreated)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("get", func() {
+		Description("Get a session with its planned and performed sets")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Session ID", func() {
+				Format(FormatUUID)
+			})
+			Required("id")
+		})
+		Result(WorkoutSession)
+		HTTP(func() {
+			GET("/{id}")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("list", func() {
+		Description("List the caller's sessions, or those of one of their athletes")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("userId", String, "Athlete whose sessions to list, defaults to the caller", func() {
+				Format(FormatUUID)
+			})
+			Attribute("workoutId", String, "Filter by planned workout", func() {
+				Format(FormatUUID)
+			})
+			Attribute("status", String, "Filter by status", func() {
+				Enum("in_progress", "finished", "abandoned")
+			})
+			Attribute("limit", Int, "Max number of results", func() {
+				Minimum(1)
+				Maximum(100)
+				Default(20)
+				Example(10)
+			})
+			Attribute("offset", Int, "Results to skip", func() {
+				Minimum(0)
+				Default(0)
+				Example(0)
+			})
+		})
+		Result(ArrayOf(WorkoutSession))
+		HTTP(func() {
+			GET("")
+			Param("userId")
+			Param("workoutId")
+			Param("status")
+			Param("limit")
+			Param("offset")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("log", func() {
+		Description("Log a performed set, either against a planned set or as an extra set")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Session ID", func() {
+				Format(FormatUUID)
+			})
+			Attribute("sessionExerciseId", String, "Session exercise the set belongs to", func() {
+				Format(FormatUUID)
+			})
+			Attribute("setId", String, "Session set to fill in; omit to add an extra set", func() {
+				Format(FormatUUID)
+			})
+			Attribute("weight", Float64, "Performed weight in kg", func() {
+				Minimum(0)
+				Example(80.0)
+			})
+			Attribute("reps", Int, "Performed repetitions", func() {
+				Minimum(0)
+				Example(7)
+			})
+			Attribute("restTime", Int, "Actual rest time in seconds", func() {
+				Minimum(0)
+				Example(120)
+			})
+			Required("id", "sessionExerciseId", "weight", "reps")
+		})
+		Result(SessionSet)
+		HTTP(func() {
+			POST("/{id}/sets")
+			Response(StatusCreated)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("finish", func() {
+		Description("Finish an in-progress session")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Session ID", func() {
+				Format(FormatUUID)
+			})
+			Attribute("notes", String, "Athlete notes", func() {
+				Example("Last set was a grind")
+			})
+			Required("id")
+		})
+		Result(WorkoutSession)
+		HTTP(func() {
+			POST("/{id}/finish")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+
+	Method("abandon", func() {
+		Description("Abandon an in-progress session")
+		Payload(func() {
+			AccessToken("token", String, "OAuth2 access token used to perform authorization")
+			Attribute("id", String, "Session ID", func() {
+				Format(FormatUUID)
+			})
+			Attribute("notes", String, "Athlete notes", func() {
+				Example("Shoulder pain")
+			})
+			Required("id")
+		})
+		Result(WorkoutSession)
+		HTTP(func() {
+			POST("/{id}/abandon")
+			Response(StatusOK)
+			errors.CommonResponses()
+		})
+	})
+})
@@ -0,0 +1,81 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// analytics client
+//
+// Command:
+// $ goa gen be/design
+
+package analytics
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "analytics" service client.
+type Client struct {
+	OneRepMaxEndpoint    goa.Endpoint
+	TonnageEndpoint      goa.Endpoint
+	MuscleVolumeEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "analytics" service client given the endpoints.
+func NewClient(oneRepMax, tonnage, muscleVolume goa.Endpoint) *Client {
+	return &Client{
+		OneRepMaxEndpoint:    oneRepMax,
+		TonnageEndpoint:      tonnage,
+		MuscleVolumeEndpoint: muscleVolume,
+	}
+}
+
+// OneRepMax calls the "oneRepMax" endpoint of the "analytics" service.
+// OneRepMax may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) OneRepMax(ctx context.Context, p *OneRepMaxPayload) (res []*OneRepMaxPoint, err error) {
+	var ires any
+	ires, err = c.OneRepMaxEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*OneRepMaxPoint), nil
+}
+
+// Tonnage calls the "tonnage" endpoint of the "analytics" service.
+// Tonnage may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Tonnage(ctx context.Context, p *TonnagePayload) (res []*TonnagePoint, err error) {
+	var ires any
+	ires, err = c.TonnageEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*TonnagePoint), nil
+}
+
+// MuscleVolume calls the "muscleVolume" endpoint of the "analytics" service.
+// MuscleVolume may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) MuscleVolume(ctx context.Context, p *MuscleVolumePayload) (res []*MuscleVolumePoint, err error) {
+	var ires any
+	ires, err = c.MuscleVolumeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*MuscleVolumePoint), nil
+}
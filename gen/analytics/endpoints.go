@@ -0,0 +1,130 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// analytics endpoints
+//
+// Command:
+// $ goa gen be/design
+
+package analytics
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// Endpoints wraps the "analytics" service endpoints.
+type Endpoints struct {
+	OneRepMax    goa.Endpoint
+	Tonnage      goa.Endpoint
+	MuscleVolume goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "analytics" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	// Casting service to Auther interface
+	a := s.(Auther)
+	return &Endpoints{
+		OneRepMax:    NewOneRepMaxEndpoint(s, a.OAuth2Auth),
+		Tonnage:      NewTonnageEndpoint(s, a.OAuth2Auth),
+		MuscleVolume: NewMuscleVolumeEndpoint(s, a.OAuth2Auth),
+	}
+}
+
+// Use applies the given middleware to all the "analytics" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.OneRepMax = m(e.OneRepMax)
+	e.Tonnage = m(e.Tonnage)
+	e.MuscleVolume = m(e.MuscleVolume)
+}
+
+// NewOneRepMaxEndpoint returns an endpoint function that calls the method
+// "oneRepMax" of service "analytics".
+func NewOneRepMaxEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*OneRepMaxPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.OneRepMax(ctx, p)
+	}
+}
+
+// NewTonnageEndpoint returns an endpoint function that calls the method
+// "tonnage" of service "analytics".
+func NewTonnageEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TonnagePayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Tonnage(ctx, p)
+	}
+}
+
+// NewMuscleVolumeEndpoint returns an endpoint function that calls the method
+// "muscleVolume" of service "analytics".
+func NewMuscleVolumeEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*MuscleVolumePayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.MuscleVolume(ctx, p)
+	}
+}
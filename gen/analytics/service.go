@@ -0,0 +1,285 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// analytics service
+//
+// Command:
+// $ goa gen be/design
+
+package analytics
+
+import (
+	"context"
+
+	"goa.design/goa/v3/security"
+)
+
+// Strength analytics computed from the performed sets
+type Service interface {
+	// Estimated one-rep max of an exercise type over time
+	OneRepMax(context.Context, *OneRepMaxPayload) (res []*OneRepMaxPoint, err error)
+	// Tonnage (weight x reps) per session or per time bucket
+	Tonnage(context.Context, *TonnagePayload) (res []*TonnagePoint, err error)
+	// Sets and tonnage per muscle group over time
+	MuscleVolume(context.Context, *MuscleVolumePayload) (res []*MuscleVolumePoint, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// OAuth2Auth implements the authorization logic for the OAuth2 security scheme.
+	OAuth2Auth(ctx context.Context, token string, schema *security.OAuth2Scheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "be_service"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "analytics"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [3]string{"oneRepMax", "tonnage", "muscleVolume"}
+
+// Body di risposta per la richiesta non valida (400)
+type BadRequest struct {
+	// Nome dell'errore
+	Name string
+	// ID dell'errore
+	ID string
+	// Descrizione dettagliata dell'errore
+	Message string
+	// Indica se l'errore è temporaneo
+	Temporary bool
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldError
+}
+
+// Campo della richiesta non valido
+type FieldError struct {
+	// Nome del campo
+	Field string
+	// Motivo per cui il campo non è valido
+	Message string
+}
+
+// Cannot access the resource
+type Forbidden struct {
+	// Detailed description of the error
+	Message string
+}
+
+// Errore nel server
+type InternalServerError struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// MuscleVolumePayload is the payload type of the analytics service
+// muscleVolume method.
+type MuscleVolumePayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Athlete to analyse, defaults to the caller
+	UserID *string
+	// Only sessions started at or after this time (ISO 8601)
+	From *string
+	// Only sessions started before this time (ISO 8601)
+	To *string
+	// Time bucket
+	Bucket string
+}
+
+type MuscleVolumePoint struct {
+	// Start of the time bucket
+	Bucket string
+	// Muscle group from the exercise-type catalog
+	MuscleGroup string
+	// Number of sets
+	Sets int
+	// Sum of weight x reps (kg)
+	Tonnage float64
+}
+
+// Dato non trovato all'interno del sistema
+type NotFound struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// OneRepMaxPayload is the payload type of the analytics service oneRepMax
+// method.
+type OneRepMaxPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Athlete to analyse, defaults to the caller
+	UserID *string
+	// Only sessions started at or after this time (ISO 8601)
+	From *string
+	// Only sessions started before this time (ISO 8601)
+	To *string
+	// Exercise type
+	ExerciseTypeID string
+	// One-rep max estimation formula
+	Formula string
+	// Time bucket
+	Bucket string
+}
+
+type OneRepMaxPoint struct {
+	// Start of the time bucket
+	Bucket string
+	// Best estimated one-rep max in the bucket (kg)
+	EstimatedOneRepMax float64
+	// Average set weight as a fraction of the bucket's estimated one-rep max
+	RelativeIntensity float64
+	// Number of sets in the bucket
+	Sets int
+}
+
+// TonnagePayload is the payload type of the analytics service tonnage method.
+type TonnagePayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Athlete to analyse, defaults to the caller
+	UserID *string
+	// Only sessions started at or after this time (ISO 8601)
+	From *string
+	// Only sessions started before this time (ISO 8601)
+	To *string
+	// Restrict to an exercise type
+	ExerciseTypeID *string
+	// Time bucket
+	Bucket string
+}
+
+type TonnagePoint struct {
+	// Start of the time bucket, or start of the session when bucketing by session
+	Bucket string
+	// Session ID when bucketing by session
+	SessionID *string
+	// Sum of weight x reps (kg)
+	Tonnage float64
+	// Number of sets
+	Sets int
+	// Number of repetitions
+	Reps int
+	// Average load per repetition (kg)
+	AverageLoad float64
+}
+
+// User not authorized to access the resource
+type Unauthorized struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// Error returns an error description.
+func (e *BadRequest) Error() string {
+	return "Body di risposta per la richiesta non valida (400)"
+}
+
+// ErrorName returns "BadRequest".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *BadRequest) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "BadRequest".
+func (e *BadRequest) GoaErrorName() string {
+	return "badRequest"
+}
+
+// Error returns an error description.
+func (e *FieldError) Error() string {
+	return "Campo della richiesta non valido"
+}
+
+// ErrorName returns "FieldError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *FieldError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "FieldError".
+func (e *FieldError) GoaErrorName() string {
+	return "FieldError"
+}
+
+// Error returns an error description.
+func (e *Forbidden) Error() string {
+	return "Cannot access the resource"
+}
+
+// ErrorName returns "Forbidden".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Forbidden) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Forbidden".
+func (e *Forbidden) GoaErrorName() string {
+	return "forbidden"
+}
+
+// Error returns an error description.
+func (e *InternalServerError) Error() string {
+	return "Errore nel server"
+}
+
+// ErrorName returns "InternalServerError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *InternalServerError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "InternalServerError".
+func (e *InternalServerError) GoaErrorName() string {
+	return "internalServerError"
+}
+
+// Error returns an error description.
+func (e *NotFound) Error() string {
+	return "Dato non trovato all'interno del sistema "
+}
+
+// ErrorName returns "NotFound".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *NotFound) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "NotFound".
+func (e *NotFound) GoaErrorName() string {
+	return "notFound"
+}
+
+// Error returns an error description.
+func (e *Unauthorized) Error() string {
+	return "User not authorized to access the resource"
+}
+
+// ErrorName returns "Unauthorized".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Unauthorized) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Unauthorized".
+func (e *Unauthorized) GoaErrorName() string {
+	return "unauthorized"
+}
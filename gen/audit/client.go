@@ -0,0 +1,43 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// audit client
+//
+// Command:
+// $ goa gen be/design
+
+package audit
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "audit" service client.
+type Client struct {
+	ListEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "audit" service client given the endpoints.
+func NewClient(list goa.Endpoint) *Client {
+	return &Client{
+		ListEndpoint: list,
+	}
+}
+
+// List calls the "list" endpoint of the "audit" service.
+// List may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) List(ctx context.Context, p *ListPayload) (res []*AuditEntry, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*AuditEntry), nil
+}
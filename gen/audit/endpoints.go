@@ -0,0 +1,64 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// audit endpoints
+//
+// Command:
+// $ goa gen be/design
+
+package audit
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// Endpoints wraps the "audit" service endpoints.
+type Endpoints struct {
+	List goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "audit" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	// Casting service to Auther interface
+	a := s.(Auther)
+	return &Endpoints{
+		List: NewListEndpoint(s, a.OAuth2Auth),
+	}
+}
+
+// Use applies the given middleware to all the "audit" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.List = m(e.List)
+}
+
+// NewListEndpoint returns an endpoint function that calls the method "list" of
+// service "audit".
+func NewListEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.List(ctx, p)
+	}
+}
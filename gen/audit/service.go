@@ -0,0 +1,237 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// audit service
+//
+// Command:
+// $ goa gen be/design
+
+package audit
+
+import (
+	"context"
+
+	"goa.design/goa/v3/security"
+)
+
+// Audit trail of mutating operations
+type Service interface {
+	// List audit entries (admin only)
+	List(context.Context, *ListPayload) (res []*AuditEntry, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// OAuth2Auth implements the authorization logic for the OAuth2 security scheme.
+	OAuth2Auth(ctx context.Context, token string, schema *security.OAuth2Scheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "be_service"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "audit"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [1]string{"list"}
+
+type AuditEntry struct {
+	// Audit entry ID
+	ID string
+	// Subject (JWT sub) of the caller
+	Actor string
+	// Service that owns the resource
+	Resource string
+	// Method that was called
+	Method string
+	// ID of the affected resource
+	ResourceID *string
+	// State of the resource before the operation
+	Before map[string]any
+	// State of the resource after the operation
+	After map[string]any
+	// Changed fields with their before and after values
+	Diff map[string]any
+	// ID of the HTTP request
+	RequestID *string
+	// When the operation was recorded
+	CreatedAt string
+}
+
+// Body di risposta per la richiesta non valida (400)
+type BadRequest struct {
+	// Nome dell'errore
+	Name string
+	// ID dell'errore
+	ID string
+	// Descrizione dettagliata dell'errore
+	Message string
+	// Indica se l'errore è temporaneo
+	Temporary bool
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldError
+}
+
+// Campo della richiesta non valido
+type FieldError struct {
+	// Nome del campo
+	Field string
+	// Motivo per cui il campo non è valido
+	Message string
+}
+
+// Cannot access the resource
+type Forbidden struct {
+	// Detailed description of the error
+	Message string
+}
+
+// Errore nel server
+type InternalServerError struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// ListPayload is the payload type of the audit service list method.
+type ListPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Filter by actor (JWT sub)
+	Actor *string
+	// Filter by resource (service name)
+	Resource *string
+	// Filter by resource ID
+	ResourceID *string
+	// Only entries recorded at or after this time (ISO 8601)
+	From *string
+	// Only entries recorded before this time (ISO 8601)
+	To *string
+	// Max number of results
+	Limit int
+	// Results to skip
+	Offset int
+}
+
+// Dato non trovato all'interno del sistema
+type NotFound struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// User not authorized to access the resource
+type Unauthorized struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// Error returns an error description.
+func (e *BadRequest) Error() string {
+	return "Body di risposta per la richiesta non valida (400)"
+}
+
+// ErrorName returns "BadRequest".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *BadRequest) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "BadRequest".
+func (e *BadRequest) GoaErrorName() string {
+	return "badRequest"
+}
+
+// Error returns an error description.
+func (e *FieldError) Error() string {
+	return "Campo della richiesta non valido"
+}
+
+// ErrorName returns "FieldError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *FieldError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "FieldError".
+func (e *FieldError) GoaErrorName() string {
+	return "FieldError"
+}
+
+// Error returns an error description.
+func (e *Forbidden) Error() string {
+	return "Cannot access the resource"
+}
+
+// ErrorName returns "Forbidden".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Forbidden) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Forbidden".
+func (e *Forbidden) GoaErrorName() string {
+	return "forbidden"
+}
+
+// Error returns an error description.
+func (e *InternalServerError) Error() string {
+	return "Errore nel server"
+}
+
+// ErrorName returns "InternalServerError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *InternalServerError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "InternalServerError".
+func (e *InternalServerError) GoaErrorName() string {
+	return "internalServerError"
+}
+
+// Error returns an error description.
+func (e *NotFound) Error() string {
+	return "Dato non trovato all'interno del sistema "
+}
+
+// ErrorName returns "NotFound".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *NotFound) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "NotFound".
+func (e *NotFound) GoaErrorName() string {
+	return "notFound"
+}
+
+// Error returns an error description.
+func (e *Unauthorized) Error() string {
+	return "User not authorized to access the resource"
+}
+
+// ErrorName returns "Unauthorized".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Unauthorized) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Unauthorized".
+func (e *Unauthorized) GoaErrorName() string {
+	return "unauthorized"
+}
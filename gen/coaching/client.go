@@ -0,0 +1,100 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// coaching client
+//
+// Command:
+// $ goa gen be/design
+
+package coaching
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "coaching" service client.
+type Client struct {
+	InviteEndpoint goa.Endpoint
+	AcceptEndpoint goa.Endpoint
+	RevokeEndpoint goa.Endpoint
+	ListEndpoint   goa.Endpoint
+}
+
+// NewClient initializes a "coaching" service client given the endpoints.
+func NewClient(invite, accept, revoke, list goa.Endpoint) *Client {
+	return &Client{
+		InviteEndpoint: invite,
+		AcceptEndpoint: accept,
+		RevokeEndpoint: revoke,
+		ListEndpoint:   list,
+	}
+}
+
+// Invite calls the "invite" endpoint of the "coaching" service.
+// Invite may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Invite(ctx context.Context, p *InvitePayload) (res *CoachingRelationship, err error) {
+	var ires any
+	ires, err = c.InviteEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CoachingRelationship), nil
+}
+
+// Accept calls the "accept" endpoint of the "coaching" service.
+// Accept may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Accept(ctx context.Context, p *AcceptPayload) (res *CoachingRelationship, err error) {
+	var ires any
+	ires, err = c.AcceptEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CoachingRelationship), nil
+}
+
+// Revoke calls the "revoke" endpoint of the "coaching" service.
+// Revoke may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Revoke(ctx context.Context, p *RevokePayload) (res *CoachingRelationship, err error) {
+	var ires any
+	ires, err = c.RevokeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CoachingRelationship), nil
+}
+
+// List calls the "list" endpoint of the "coaching" service.
+// List may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) List(ctx context.Context, p *ListPayload) (res []*CoachingRelationship, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*CoachingRelationship), nil
+}
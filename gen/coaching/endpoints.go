@@ -0,0 +1,163 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// coaching endpoints
+//
+// Command:
+// $ goa gen be/design
+
+package coaching
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// Endpoints wraps the "coaching" service endpoints.
+type Endpoints struct {
+	Invite goa.Endpoint
+	Accept goa.Endpoint
+	Revoke goa.Endpoint
+	List   goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "coaching" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	// Casting service to Auther interface
+	a := s.(Auther)
+	return &Endpoints{
+		Invite: NewInviteEndpoint(s, a.OAuth2Auth),
+		Accept: NewAcceptEndpoint(s, a.OAuth2Auth),
+		Revoke: NewRevokeEndpoint(s, a.OAuth2Auth),
+		List:   NewListEndpoint(s, a.OAuth2Auth),
+	}
+}
+
+// Use applies the given middleware to all the "coaching" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.Invite = m(e.Invite)
+	e.Accept = m(e.Accept)
+	e.Revoke = m(e.Revoke)
+	e.List = m(e.List)
+}
+
+// NewInviteEndpoint returns an endpoint function that calls the method
+// "invite" of service "coaching".
+func NewInviteEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*InvitePayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Invite(ctx, p)
+	}
+}
+
+// NewAcceptEndpoint returns an endpoint function that calls the method
+// "accept" of service "coaching".
+func NewAcceptEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AcceptPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Accept(ctx, p)
+	}
+}
+
+// NewRevokeEndpoint returns an endpoint function that calls the method
+// "revoke" of service "coaching".
+func NewRevokeEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RevokePayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Revoke(ctx, p)
+	}
+}
+
+// NewListEndpoint returns an endpoint function that calls the method "list" of
+// service "coaching".
+func NewListEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.List(ctx, p)
+	}
+}
@@ -0,0 +1,258 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// coaching service
+//
+// Command:
+// $ goa gen be/design
+
+package coaching
+
+import (
+	"context"
+
+	"goa.design/goa/v3/security"
+)
+
+// Coach and athlete relationships
+type Service interface {
+	// Invite an athlete; the caller becomes their coach once accepted
+	Invite(context.Context, *InvitePayload) (res *CoachingRelationship, err error)
+	// Accept a pending invitation as the invited athlete
+	Accept(context.Context, *AcceptPayload) (res *CoachingRelationship, err error)
+	// Decline an invitation or end a relationship, as either the coach or the
+	// athlete
+	Revoke(context.Context, *RevokePayload) (res *CoachingRelationship, err error)
+	// List the caller's relationships as coach or athlete
+	List(context.Context, *ListPayload) (res []*CoachingRelationship, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// OAuth2Auth implements the authorization logic for the OAuth2 security scheme.
+	OAuth2Auth(ctx context.Context, token string, schema *security.OAuth2Scheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "be_service"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "coaching"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [4]string{"invite", "accept", "revoke", "list"}
+
+// AcceptPayload is the payload type of the coaching service accept method.
+type AcceptPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Relationship ID
+	ID string
+}
+
+// Body di risposta per la richiesta non valida (400)
+type BadRequest struct {
+	// Nome dell'errore
+	Name string
+	// ID dell'errore
+	ID string
+	// Descrizione dettagliata dell'errore
+	Message string
+	// Indica se l'errore è temporaneo
+	Temporary bool
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldError
+}
+
+// CoachingRelationship is the result type of the coaching service invite
+// method.
+type CoachingRelationship struct {
+	// Relationship ID
+	ID string
+	// Coach user ID
+	CoachID string
+	// Athlete user ID
+	AthleteID string
+	// Relationship status
+	Status string
+	// Invitation time
+	CreatedAt string
+	// Acceptance time
+	AcceptedAt *string
+	// Revocation time
+	RevokedAt *string
+}
+
+// Campo della richiesta non valido
+type FieldError struct {
+	// Nome del campo
+	Field string
+	// Motivo per cui il campo non è valido
+	Message string
+}
+
+// Cannot access the resource
+type Forbidden struct {
+	// Detailed description of the error
+	Message string
+}
+
+// Errore nel server
+type InternalServerError struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// InvitePayload is the payload type of the coaching service invite method.
+type InvitePayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Athlete to invite
+	AthleteID string
+}
+
+// ListPayload is the payload type of the coaching service list method.
+type ListPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Only relationships where the caller has this role
+	Role *string
+	// Filter by status
+	Status *string
+	// Max number of results
+	Limit int
+	// Results to skip
+	Offset int
+}
+
+// Dato non trovato all'interno del sistema
+type NotFound struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// RevokePayload is the payload type of the coaching service revoke method.
+type RevokePayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Relationship ID
+	ID string
+}
+
+// User not authorized to access the resource
+type Unauthorized struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// Error returns an error description.
+func (e *BadRequest) Error() string {
+	return "Body di risposta per la richiesta non valida (400)"
+}
+
+// ErrorName returns "BadRequest".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *BadRequest) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "BadRequest".
+func (e *BadRequest) GoaErrorName() string {
+	return "badRequest"
+}
+
+// Error returns an error description.
+func (e *FieldError) Error() string {
+	return "Campo della richiesta non valido"
+}
+
+// ErrorName returns "FieldError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *FieldError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "FieldError".
+func (e *FieldError) GoaErrorName() string {
+	return "FieldError"
+}
+
+// Error returns an error description.
+func (e *Forbidden) Error() string {
+	return "Cannot access the resource"
+}
+
+// ErrorName returns "Forbidden".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Forbidden) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Forbidden".
+func (e *Forbidden) GoaErrorName() string {
+	return "forbidden"
+}
+
+// Error returns an error description.
+func (e *InternalServerError) Error() string {
+	return "Errore nel server"
+}
+
+// ErrorName returns "InternalServerError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *InternalServerError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "InternalServerError".
+func (e *InternalServerError) GoaErrorName() string {
+	return "internalServerError"
+}
+
+// Error returns an error description.
+func (e *NotFound) Error() string {
+	return "Dato non trovato all'interno del sistema "
+}
+
+// ErrorName returns "NotFound".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *NotFound) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "NotFound".
+func (e *NotFound) GoaErrorName() string {
+	return "notFound"
+}
+
+// Error returns an error description.
+func (e *Unauthorized) Error() string {
+	return "User not authorized to access the resource"
+}
+
+// ErrorName returns "Unauthorized".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Unauthorized) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Unauthorized".
+func (e *Unauthorized) GoaErrorName() string {
+	return "unauthorized"
+}
@@ -0,0 +1,233 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// analytics HTTP client CLI support package
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	analytics "be/gen/analytics"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildOneRepMaxPayload builds the payload for the analytics oneRepMax
+// endpoint from CLI flags.
+func BuildOneRepMaxPayload(analyticsOneRepMaxUserID string, analyticsOneRepMaxFrom string, analyticsOneRepMaxTo string, analyticsOneRepMaxExerciseTypeID string, analyticsOneRepMaxFormula string, analyticsOneRepMaxBucket string, analyticsOneRepMaxToken string) (*analytics.OneRepMaxPayload, error) {
+	var err error
+	var userID *string
+	{
+		if analyticsOneRepMaxUserID != "" {
+			userID = &analyticsOneRepMaxUserID
+			err = goa.MergeErrors(err, goa.ValidateFormat("userId", *userID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var from *string
+	{
+		if analyticsOneRepMaxFrom != "" {
+			from = &analyticsOneRepMaxFrom
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var to *string
+	{
+		if analyticsOneRepMaxTo != "" {
+			to = &analyticsOneRepMaxTo
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var exerciseTypeID string
+	{
+		exerciseTypeID = analyticsOneRepMaxExerciseTypeID
+		err = goa.MergeErrors(err, goa.ValidateFormat("exerciseTypeId", exerciseTypeID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var formula string
+	{
+		if analyticsOneRepMaxFormula != "" {
+			formula = analyticsOneRepMaxFormula
+			if !(formula == "epley" || formula == "brzycki") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("formula", formula, []any{"epley", "brzycki"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bucket string
+	{
+		if analyticsOneRepMaxBucket != "" {
+			bucket = analyticsOneRepMaxBucket
+			if !(bucket == "day" || bucket == "week" || bucket == "month") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("bucket", bucket, []any{"day", "week", "month"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if analyticsOneRepMaxToken != "" {
+			token = &analyticsOneRepMaxToken
+		}
+	}
+	v := &analytics.OneRepMaxPayload{}
+	v.UserID = userID
+	v.From = from
+	v.To = to
+	v.ExerciseTypeID = exerciseTypeID
+	v.Formula = formula
+	v.Bucket = bucket
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildTonnagePayload builds the payload for the analytics tonnage endpoint
+// from CLI flags.
+func BuildTonnagePayload(analyticsTonnageUserID string, analyticsTonnageFrom string, analyticsTonnageTo string, analyticsTonnageExerciseTypeID string, analyticsTonnageBucket string, analyticsTonnageToken string) (*analytics.TonnagePayload, error) {
+	var err error
+	var userID *string
+	{
+		if analyticsTonnageUserID != "" {
+			userID = &analyticsTonnageUserID
+			err = goa.MergeErrors(err, goa.ValidateFormat("userId", *userID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var from *string
+	{
+		if analyticsTonnageFrom != "" {
+			from = &analyticsTonnageFrom
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var to *string
+	{
+		if analyticsTonnageTo != "" {
+			to = &analyticsTonnageTo
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var exerciseTypeID *string
+	{
+		if analyticsTonnageExerciseTypeID != "" {
+			exerciseTypeID = &analyticsTonnageExerciseTypeID
+			err = goa.MergeErrors(err, goa.ValidateFormat("exerciseTypeId", *exerciseTypeID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bucket string
+	{
+		if analyticsTonnageBucket != "" {
+			bucket = analyticsTonnageBucket
+			if !(bucket == "session" || bucket == "day" || bucket == "week" || bucket == "month") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("bucket", bucket, []any{"session", "day", "week", "month"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if analyticsTonnageToken != "" {
+			token = &analyticsTonnageToken
+		}
+	}
+	v := &analytics.TonnagePayload{}
+	v.UserID = userID
+	v.From = from
+	v.To = to
+	v.ExerciseTypeID = exerciseTypeID
+	v.Bucket = bucket
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildMuscleVolumePayload builds the payload for the analytics muscleVolume
+// endpoint from CLI flags.
+func BuildMuscleVolumePayload(analyticsMuscleVolumeUserID string, analyticsMuscleVolumeFrom string, analyticsMuscleVolumeTo string, analyticsMuscleVolumeBucket string, analyticsMuscleVolumeToken string) (*analytics.MuscleVolumePayload, error) {
+	var err error
+	var userID *string
+	{
+		if analyticsMuscleVolumeUserID != "" {
+			userID = &analyticsMuscleVolumeUserID
+			err = goa.MergeErrors(err, goa.ValidateFormat("userId", *userID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var from *string
+	{
+		if analyticsMuscleVolumeFrom != "" {
+			from = &analyticsMuscleVolumeFrom
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var to *string
+	{
+		if analyticsMuscleVolumeTo != "" {
+			to = &analyticsMuscleVolumeTo
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bucket string
+	{
+		if analyticsMuscleVolumeBucket != "" {
+			bucket = analyticsMuscleVolumeBucket
+			if !(bucket == "day" || bucket == "week" || bucket == "month") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("bucket", bucket, []any{"day", "week", "month"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if analyticsMuscleVolumeToken != "" {
+			token = &analyticsMuscleVolumeToken
+		}
+	}
+	v := &analytics.MuscleVolumePayload{}
+	v.UserID = userID
+	v.From = from
+	v.To = to
+	v.Bucket = bucket
+	v.Token = token
+
+	return v, nil
+}
@@ -0,0 +1,133 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// analytics client HTTP transport
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the analytics service endpoint HTTP clients.
+type Client struct {
+	// OneRepMax Doer is the HTTP client used to make requests to the oneRepMax
+	// endpoint.
+	OneRepMaxDoer goahttp.Doer
+
+	// Tonnage Doer is the HTTP client used to make requests to the tonnage
+	// endpoint.
+	TonnageDoer goahttp.Doer
+
+	// MuscleVolume Doer is the HTTP client used to make requests to the
+	// muscleVolume endpoint.
+	MuscleVolumeDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the analytics service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		OneRepMaxDoer:       doer,
+		TonnageDoer:         doer,
+		MuscleVolumeDoer:    doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// OneRepMax returns an endpoint that makes HTTP requests to the analytics
+// service oneRepMax server.
+func (c *Client) OneRepMax() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeOneRepMaxRequest(c.encoder)
+		decodeResponse = DecodeOneRepMaxResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildOneRepMaxRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.OneRepMaxDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("analytics", "oneRepMax", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Tonnage returns an endpoint that makes HTTP requests to the analytics
+// service tonnage server.
+func (c *Client) Tonnage() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeTonnageRequest(c.encoder)
+		decodeResponse = DecodeTonnageResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildTonnageRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.TonnageDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("analytics", "tonnage", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// MuscleVolume returns an endpoint that makes HTTP requests to the analytics
+// service muscleVolume server.
+func (c *Client) MuscleVolume() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeMuscleVolumeRequest(c.encoder)
+		decodeResponse = DecodeMuscleVolumeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildMuscleVolumeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.MuscleVolumeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("analytics", "muscleVolume", err)
+		}
+		return decodeResponse(resp)
+	}
+}
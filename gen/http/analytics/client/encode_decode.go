@@ -0,0 +1,592 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// analytics HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	analytics "be/gen/analytics"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildOneRepMaxRequest instantiates a HTTP request object with method and
+// path set to call the "analytics" service "oneRepMax" endpoint
+func (c *Client) BuildOneRepMaxRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: OneRepMaxAnalyticsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("analytics", "oneRepMax", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeOneRepMaxRequest returns an encoder for requests sent to the analytics
+// oneRepMax server.
+func EncodeOneRepMaxRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*analytics.OneRepMaxPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("analytics", "oneRepMax", "*analytics.OneRepMaxPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.UserID != nil {
+			values.Add("userId", *p.UserID)
+		}
+		if p.From != nil {
+			values.Add("from", *p.From)
+		}
+		if p.To != nil {
+			values.Add("to", *p.To)
+		}
+		values.Add("exerciseTypeId", p.ExerciseTypeID)
+		values.Add("formula", p.Formula)
+		values.Add("bucket", p.Bucket)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeOneRepMaxResponse returns a decoder for responses returned by the
+// analytics oneRepMax endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeOneRepMaxResponse may return the following errors:
+//   - "badRequest" (type *analytics.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *analytics.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *analytics.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *analytics.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *analytics.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeOneRepMaxResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body OneRepMaxResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "oneRepMax", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateOneRepMaxPointResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "oneRepMax", err)
+			}
+			res := NewOneRepMaxPoint2OK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body OneRepMaxBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "oneRepMax", err)
+			}
+			err = ValidateOneRepMaxBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "oneRepMax", err)
+			}
+			return nil, NewOneRepMaxBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body OneRepMaxForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "oneRepMax", err)
+			}
+			err = ValidateOneRepMaxForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "oneRepMax", err)
+			}
+			return nil, NewOneRepMaxForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body OneRepMaxInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "oneRepMax", err)
+			}
+			err = ValidateOneRepMaxInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "oneRepMax", err)
+			}
+			return nil, NewOneRepMaxInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body OneRepMaxNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "oneRepMax", err)
+			}
+			err = ValidateOneRepMaxNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "oneRepMax", err)
+			}
+			return nil, NewOneRepMaxNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body OneRepMaxUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "oneRepMax", err)
+			}
+			err = ValidateOneRepMaxUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "oneRepMax", err)
+			}
+			return nil, NewOneRepMaxUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("analytics", "oneRepMax", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildTonnageRequest instantiates a HTTP request object with method and path
+// set to call the "analytics" service "tonnage" endpoint
+func (c *Client) BuildTonnageRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: TonnageAnalyticsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("analytics", "tonnage", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeTonnageRequest returns an encoder for requests sent to the analytics
+// tonnage server.
+func EncodeTonnageRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*analytics.TonnagePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("analytics", "tonnage", "*analytics.TonnagePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.UserID != nil {
+			values.Add("userId", *p.UserID)
+		}
+		if p.From != nil {
+			values.Add("from", *p.From)
+		}
+		if p.To != nil {
+			values.Add("to", *p.To)
+		}
+		if p.ExerciseTypeID != nil {
+			values.Add("exerciseTypeId", *p.ExerciseTypeID)
+		}
+		values.Add("bucket", p.Bucket)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeTonnageResponse returns a decoder for responses returned by the
+// analytics tonnage endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeTonnageResponse may return the following errors:
+//   - "badRequest" (type *analytics.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *analytics.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *analytics.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *analytics.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *analytics.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeTonnageResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body TonnageResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "tonnage", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateTonnagePointResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "tonnage", err)
+			}
+			res := NewTonnagePoint2OK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body TonnageBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "tonnage", err)
+			}
+			err = ValidateTonnageBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "tonnage", err)
+			}
+			return nil, NewTonnageBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body TonnageForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "tonnage", err)
+			}
+			err = ValidateTonnageForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "tonnage", err)
+			}
+			return nil, NewTonnageForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body TonnageInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "tonnage", err)
+			}
+			err = ValidateTonnageInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "tonnage", err)
+			}
+			return nil, NewTonnageInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body TonnageNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "tonnage", err)
+			}
+			err = ValidateTonnageNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "tonnage", err)
+			}
+			return nil, NewTonnageNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body TonnageUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "tonnage", err)
+			}
+			err = ValidateTonnageUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "tonnage", err)
+			}
+			return nil, NewTonnageUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("analytics", "tonnage", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildMuscleVolumeRequest instantiates a HTTP request object with method and
+// path set to call the "analytics" service "muscleVolume" endpoint
+func (c *Client) BuildMuscleVolumeRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: MuscleVolumeAnalyticsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("analytics", "muscleVolume", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeMuscleVolumeRequest returns an encoder for requests sent to the
+// analytics muscleVolume server.
+func EncodeMuscleVolumeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*analytics.MuscleVolumePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("analytics", "muscleVolume", "*analytics.MuscleVolumePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.UserID != nil {
+			values.Add("userId", *p.UserID)
+		}
+		if p.From != nil {
+			values.Add("from", *p.From)
+		}
+		if p.To != nil {
+			values.Add("to", *p.To)
+		}
+		values.Add("bucket", p.Bucket)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeMuscleVolumeResponse returns a decoder for responses returned by the
+// analytics muscleVolume endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeMuscleVolumeResponse may return the following errors:
+//   - "badRequest" (type *analytics.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *analytics.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *analytics.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *analytics.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *analytics.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeMuscleVolumeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body MuscleVolumeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "muscleVolume", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateMuscleVolumePointResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "muscleVolume", err)
+			}
+			res := NewMuscleVolumePoint2OK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body MuscleVolumeBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "muscleVolume", err)
+			}
+			err = ValidateMuscleVolumeBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "muscleVolume", err)
+			}
+			return nil, NewMuscleVolumeBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body MuscleVolumeForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "muscleVolume", err)
+			}
+			err = ValidateMuscleVolumeForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "muscleVolume", err)
+			}
+			return nil, NewMuscleVolumeForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body MuscleVolumeInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "muscleVolume", err)
+			}
+			err = ValidateMuscleVolumeInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "muscleVolume", err)
+			}
+			return nil, NewMuscleVolumeInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body MuscleVolumeNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "muscleVolume", err)
+			}
+			err = ValidateMuscleVolumeNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "muscleVolume", err)
+			}
+			return nil, NewMuscleVolumeNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body MuscleVolumeUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("analytics", "muscleVolume", err)
+			}
+			err = ValidateMuscleVolumeUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("analytics", "muscleVolume", err)
+			}
+			return nil, NewMuscleVolumeUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("analytics", "muscleVolume", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalOneRepMaxPointResponseToAnalyticsOneRepMaxPoint builds a value of
+// type *analytics.OneRepMaxPoint from a value of type *OneRepMaxPointResponse.
+func unmarshalOneRepMaxPointResponseToAnalyticsOneRepMaxPoint(v *OneRepMaxPointResponse) *analytics.OneRepMaxPoint {
+	res := &analytics.OneRepMaxPoint{
+		Bucket:             *v.Bucket,
+		EstimatedOneRepMax: *v.EstimatedOneRepMax,
+		RelativeIntensity:  *v.RelativeIntensity,
+		Sets:               *v.Sets,
+	}
+
+	return res
+}
+
+// unmarshalFieldErrorResponseBodyToAnalyticsFieldError builds a value of type
+// *analytics.FieldError from a value of type *FieldErrorResponseBody.
+func unmarshalFieldErrorResponseBodyToAnalyticsFieldError(v *FieldErrorResponseBody) *analytics.FieldError {
+	if v == nil {
+		return nil
+	}
+	res := &analytics.FieldError{
+		Field:   *v.Field,
+		Message: *v.Message,
+	}
+
+	return res
+}
+
+// unmarshalTonnagePointResponseToAnalyticsTonnagePoint builds a value of type
+// *analytics.TonnagePoint from a value of type *TonnagePointResponse.
+func unmarshalTonnagePointResponseToAnalyticsTonnagePoint(v *TonnagePointResponse) *analytics.TonnagePoint {
+	res := &analytics.TonnagePoint{
+		Bucket:      *v.Bucket,
+		SessionID:   v.SessionID,
+		Tonnage:     *v.Tonnage,
+		Sets:        *v.Sets,
+		Reps:        *v.Reps,
+		AverageLoad: *v.AverageLoad,
+	}
+
+	return res
+}
+
+// unmarshalMuscleVolumePointResponseToAnalyticsMuscleVolumePoint builds a
+// value of type *analytics.MuscleVolumePoint from a value of type
+// *MuscleVolumePointResponse.
+func unmarshalMuscleVolumePointResponseToAnalyticsMuscleVolumePoint(v *MuscleVolumePointResponse) *analytics.MuscleVolumePoint {
+	res := &analytics.MuscleVolumePoint{
+		Bucket:      *v.Bucket,
+		MuscleGroup: *v.MuscleGroup,
+		Sets:        *v.Sets,
+		Tonnage:     *v.Tonnage,
+	}
+
+	return res
+}
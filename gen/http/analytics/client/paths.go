@@ -0,0 +1,23 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// HTTP request path constructors for the analytics service.
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+// OneRepMaxAnalyticsPath returns the URL path to the analytics service oneRepMax HTTP endpoint.
+func OneRepMaxAnalyticsPath() string {
+	return "/api/v1/analytics/one-rep-max"
+}
+
+// TonnageAnalyticsPath returns the URL path to the analytics service tonnage HTTP endpoint.
+func TonnageAnalyticsPath() string {
+	return "/api/v1/analytics/tonnage"
+}
+
+// MuscleVolumeAnalyticsPath returns the URL path to the analytics service muscleVolume HTTP endpoint.
+func MuscleVolumeAnalyticsPath() string {
+	return "/api/v1/analytics/muscle-volume"
+}
@@ -0,0 +1,716 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// analytics HTTP client types
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	analytics "be/gen/analytics"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// OneRepMaxResponseBody is the type of the "analytics" service "oneRepMax"
+// endpoint HTTP response body.
+type OneRepMaxResponseBody []*OneRepMaxPointResponse
+
+// TonnageResponseBody is the type of the "analytics" service "tonnage"
+// endpoint HTTP response body.
+type TonnageResponseBody []*TonnagePointResponse
+
+// MuscleVolumeResponseBody is the type of the "analytics" service
+// "muscleVolume" endpoint HTTP response body.
+type MuscleVolumeResponseBody []*MuscleVolumePointResponse
+
+// OneRepMaxBadRequestResponseBody is the type of the "analytics" service
+// "oneRepMax" endpoint HTTP response body for the "badRequest" error.
+type OneRepMaxBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// OneRepMaxForbiddenResponseBody is the type of the "analytics" service
+// "oneRepMax" endpoint HTTP response body for the "forbidden" error.
+type OneRepMaxForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// OneRepMaxInternalServerErrorResponseBody is the type of the "analytics"
+// service "oneRepMax" endpoint HTTP response body for the
+// "internalServerError" error.
+type OneRepMaxInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// OneRepMaxNotFoundResponseBody is the type of the "analytics" service
+// "oneRepMax" endpoint HTTP response body for the "notFound" error.
+type OneRepMaxNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// OneRepMaxUnauthorizedResponseBody is the type of the "analytics" service
+// "oneRepMax" endpoint HTTP response body for the "unauthorized" error.
+type OneRepMaxUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// TonnageBadRequestResponseBody is the type of the "analytics" service
+// "tonnage" endpoint HTTP response body for the "badRequest" error.
+type TonnageBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// TonnageForbiddenResponseBody is the type of the "analytics" service
+// "tonnage" endpoint HTTP response body for the "forbidden" error.
+type TonnageForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// TonnageInternalServerErrorResponseBody is the type of the "analytics"
+// service "tonnage" endpoint HTTP response body for the "internalServerError"
+// error.
+type TonnageInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// TonnageNotFoundResponseBody is the type of the "analytics" service "tonnage"
+// endpoint HTTP response body for the "notFound" error.
+type TonnageNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// TonnageUnauthorizedResponseBody is the type of the "analytics" service
+// "tonnage" endpoint HTTP response body for the "unauthorized" error.
+type TonnageUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// MuscleVolumeBadRequestResponseBody is the type of the "analytics" service
+// "muscleVolume" endpoint HTTP response body for the "badRequest" error.
+type MuscleVolumeBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// MuscleVolumeForbiddenResponseBody is the type of the "analytics" service
+// "muscleVolume" endpoint HTTP response body for the "forbidden" error.
+type MuscleVolumeForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// MuscleVolumeInternalServerErrorResponseBody is the type of the "analytics"
+// service "muscleVolume" endpoint HTTP response body for the
+// "internalServerError" error.
+type MuscleVolumeInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// MuscleVolumeNotFoundResponseBody is the type of the "analytics" service
+// "muscleVolume" endpoint HTTP response body for the "notFound" error.
+type MuscleVolumeNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// MuscleVolumeUnauthorizedResponseBody is the type of the "analytics" service
+// "muscleVolume" endpoint HTTP response body for the "unauthorized" error.
+type MuscleVolumeUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// OneRepMaxPointResponse is used to define fields on response body types.
+type OneRepMaxPointResponse struct {
+	// Start of the time bucket
+	Bucket *string `form:"bucket,omitempty" json:"bucket,omitempty" xml:"bucket,omitempty"`
+	// Best estimated one-rep max in the bucket (kg)
+	EstimatedOneRepMax *float64 `form:"estimatedOneRepMax,omitempty" json:"estimatedOneRepMax,omitempty" xml:"estimatedOneRepMax,omitempty"`
+	// Average set weight as a fraction of the bucket's estimated one-rep max
+	RelativeIntensity *float64 `form:"relativeIntensity,omitempty" json:"relativeIntensity,omitempty" xml:"relativeIntensity,omitempty"`
+	// Number of sets in the bucket
+	Sets *int `form:"sets,omitempty" json:"sets,omitempty" xml:"sets,omitempty"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+	// Motivo per cui il campo non è valido
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// TonnagePointResponse is used to define fields on response body types.
+type TonnagePointResponse struct {
+	// Start of the time bucket, or start of the session when bucketing by session
+	Bucket *string `form:"bucket,omitempty" json:"bucket,omitempty" xml:"bucket,omitempty"`
+	// Session ID when bucketing by session
+	SessionID *string `form:"sessionId,omitempty" json:"sessionId,omitempty" xml:"sessionId,omitempty"`
+	// Sum of weight x reps (kg)
+	Tonnage *float64 `form:"tonnage,omitempty" json:"tonnage,omitempty" xml:"tonnage,omitempty"`
+	// Number of sets
+	Sets *int `form:"sets,omitempty" json:"sets,omitempty" xml:"sets,omitempty"`
+	// Number of repetitions
+	Reps *int `form:"reps,omitempty" json:"reps,omitempty" xml:"reps,omitempty"`
+	// Average load per repetition (kg)
+	AverageLoad *float64 `form:"averageLoad,omitempty" json:"averageLoad,omitempty" xml:"averageLoad,omitempty"`
+}
+
+// MuscleVolumePointResponse is used to define fields on response body types.
+type MuscleVolumePointResponse struct {
+	// Start of the time bucket
+	Bucket *string `form:"bucket,omitempty" json:"bucket,omitempty" xml:"bucket,omitempty"`
+	// Muscle group from the exercise-type catalog
+	MuscleGroup *string `form:"muscleGroup,omitempty" json:"muscleGroup,omitempty" xml:"muscleGroup,omitempty"`
+	// Number of sets
+	Sets *int `form:"sets,omitempty" json:"sets,omitempty" xml:"sets,omitempty"`
+	// Sum of weight x reps (kg)
+	Tonnage *float64 `form:"tonnage,omitempty" json:"tonnage,omitempty" xml:"tonnage,omitempty"`
+}
+
+// NewOneRepMaxPoint2OK builds a "analytics" service "oneRepMax" endpoint
+// result from a HTTP "OK" response.
+func NewOneRepMaxPoint2OK(body []*OneRepMaxPointResponse) []*analytics.OneRepMaxPoint {
+	v := make([]*analytics.OneRepMaxPoint, len(body))
+	for i, val := range body {
+		v[i] = unmarshalOneRepMaxPointResponseToAnalyticsOneRepMaxPoint(val)
+	}
+
+	return v
+}
+
+// NewOneRepMaxBadRequest builds a analytics service oneRepMax endpoint
+// badRequest error.
+func NewOneRepMaxBadRequest(body *OneRepMaxBadRequestResponseBody) *analytics.BadRequest {
+	v := &analytics.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*analytics.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToAnalyticsFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewOneRepMaxForbidden builds a analytics service oneRepMax endpoint
+// forbidden error.
+func NewOneRepMaxForbidden(body *OneRepMaxForbiddenResponseBody) *analytics.Forbidden {
+	v := &analytics.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewOneRepMaxInternalServerError builds a analytics service oneRepMax
+// endpoint internalServerError error.
+func NewOneRepMaxInternalServerError(body *OneRepMaxInternalServerErrorResponseBody) *analytics.InternalServerError {
+	v := &analytics.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewOneRepMaxNotFound builds a analytics service oneRepMax endpoint notFound
+// error.
+func NewOneRepMaxNotFound(body *OneRepMaxNotFoundResponseBody) *analytics.NotFound {
+	v := &analytics.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewOneRepMaxUnauthorized builds a analytics service oneRepMax endpoint
+// unauthorized error.
+func NewOneRepMaxUnauthorized(body *OneRepMaxUnauthorizedResponseBody) *analytics.Unauthorized {
+	v := &analytics.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewTonnagePoint2OK builds a "analytics" service "tonnage" endpoint result
+// from a HTTP "OK" response.
+func NewTonnagePoint2OK(body []*TonnagePointResponse) []*analytics.TonnagePoint {
+	v := make([]*analytics.TonnagePoint, len(body))
+	for i, val := range body {
+		v[i] = unmarshalTonnagePointResponseToAnalyticsTonnagePoint(val)
+	}
+
+	return v
+}
+
+// NewTonnageBadRequest builds a analytics service tonnage endpoint badRequest
+// error.
+func NewTonnageBadRequest(body *TonnageBadRequestResponseBody) *analytics.BadRequest {
+	v := &analytics.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*analytics.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToAnalyticsFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewTonnageForbidden builds a analytics service tonnage endpoint forbidden
+// error.
+func NewTonnageForbidden(body *TonnageForbiddenResponseBody) *analytics.Forbidden {
+	v := &analytics.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewTonnageInternalServerError builds a analytics service tonnage endpoint
+// internalServerError error.
+func NewTonnageInternalServerError(body *TonnageInternalServerErrorResponseBody) *analytics.InternalServerError {
+	v := &analytics.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewTonnageNotFound builds a analytics service tonnage endpoint notFound
+// error.
+func NewTonnageNotFound(body *TonnageNotFoundResponseBody) *analytics.NotFound {
+	v := &analytics.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewTonnageUnauthorized builds a analytics service tonnage endpoint
+// unauthorized error.
+func NewTonnageUnauthorized(body *TonnageUnauthorizedResponseBody) *analytics.Unauthorized {
+	v := &analytics.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewMuscleVolumePoint2OK builds a "analytics" service "muscleVolume" endpoint
+// result from a HTTP "OK" response.
+func NewMuscleVolumePoint2OK(body []*MuscleVolumePointResponse) []*analytics.MuscleVolumePoint {
+	v := make([]*analytics.MuscleVolumePoint, len(body))
+	for i, val := range body {
+		v[i] = unmarshalMuscleVolumePointResponseToAnalyticsMuscleVolumePoint(val)
+	}
+
+	return v
+}
+
+// NewMuscleVolumeBadRequest builds a analytics service muscleVolume endpoint
+// badRequest error.
+func NewMuscleVolumeBadRequest(body *MuscleVolumeBadRequestResponseBody) *analytics.BadRequest {
+	v := &analytics.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*analytics.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToAnalyticsFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewMuscleVolumeForbidden builds a analytics service muscleVolume endpoint
+// forbidden error.
+func NewMuscleVolumeForbidden(body *MuscleVolumeForbiddenResponseBody) *analytics.Forbidden {
+	v := &analytics.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewMuscleVolumeInternalServerError builds a analytics service muscleVolume
+// endpoint internalServerError error.
+func NewMuscleVolumeInternalServerError(body *MuscleVolumeInternalServerErrorResponseBody) *analytics.InternalServerError {
+	v := &analytics.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewMuscleVolumeNotFound builds a analytics service muscleVolume endpoint
+// notFound error.
+func NewMuscleVolumeNotFound(body *MuscleVolumeNotFoundResponseBody) *analytics.NotFound {
+	v := &analytics.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewMuscleVolumeUnauthorized builds a analytics service muscleVolume endpoint
+// unauthorized error.
+func NewMuscleVolumeUnauthorized(body *MuscleVolumeUnauthorizedResponseBody) *analytics.Unauthorized {
+	v := &analytics.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// ValidateOneRepMaxBadRequestResponseBody runs the validations defined on
+// oneRepMax_badRequest_response_body
+func ValidateOneRepMaxBadRequestResponseBody(body *OneRepMaxBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateOneRepMaxForbiddenResponseBody runs the validations defined on
+// oneRepMax_forbidden_response_body
+func ValidateOneRepMaxForbiddenResponseBody(body *OneRepMaxForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateOneRepMaxInternalServerErrorResponseBody runs the validations
+// defined on oneRepMax_internalServerError_response_body
+func ValidateOneRepMaxInternalServerErrorResponseBody(body *OneRepMaxInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateOneRepMaxNotFoundResponseBody runs the validations defined on
+// oneRepMax_notFound_response_body
+func ValidateOneRepMaxNotFoundResponseBody(body *OneRepMaxNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateOneRepMaxUnauthorizedResponseBody runs the validations defined on
+// oneRepMax_unauthorized_response_body
+func ValidateOneRepMaxUnauthorizedResponseBody(body *OneRepMaxUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateTonnageBadRequestResponseBody runs the validations defined on
+// tonnage_badRequest_response_body
+func ValidateTonnageBadRequestResponseBody(body *TonnageBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateTonnageForbiddenResponseBody runs the validations defined on
+// tonnage_forbidden_response_body
+func ValidateTonnageForbiddenResponseBody(body *TonnageForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateTonnageInternalServerErrorResponseBody runs the validations defined
+// on tonnage_internalServerError_response_body
+func ValidateTonnageInternalServerErrorResponseBody(body *TonnageInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateTonnageNotFoundResponseBody runs the validations defined on
+// tonnage_notFound_response_body
+func ValidateTonnageNotFoundResponseBody(body *TonnageNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateTonnageUnauthorizedResponseBody runs the validations defined on
+// tonnage_unauthorized_response_body
+func ValidateTonnageUnauthorizedResponseBody(body *TonnageUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateMuscleVolumeBadRequestResponseBody runs the validations defined on
+// muscleVolume_badRequest_response_body
+func ValidateMuscleVolumeBadRequestResponseBody(body *MuscleVolumeBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateMuscleVolumeForbiddenResponseBody runs the validations defined on
+// muscleVolume_forbidden_response_body
+func ValidateMuscleVolumeForbiddenResponseBody(body *MuscleVolumeForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateMuscleVolumeInternalServerErrorResponseBody runs the validations
+// defined on muscleVolume_internalServerError_response_body
+func ValidateMuscleVolumeInternalServerErrorResponseBody(body *MuscleVolumeInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateMuscleVolumeNotFoundResponseBody runs the validations defined on
+// muscleVolume_notFound_response_body
+func ValidateMuscleVolumeNotFoundResponseBody(body *MuscleVolumeNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateMuscleVolumeUnauthorizedResponseBody runs the validations defined on
+// muscleVolume_unauthorized_response_body
+func ValidateMuscleVolumeUnauthorizedResponseBody(body *MuscleVolumeUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateOneRepMaxPointResponse runs the validations defined on
+// OneRepMaxPointResponse
+func ValidateOneRepMaxPointResponse(body *OneRepMaxPointResponse) (err error) {
+	if body.Bucket == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("bucket", "body"))
+	}
+	if body.EstimatedOneRepMax == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("estimatedOneRepMax", "body"))
+	}
+	if body.RelativeIntensity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("relativeIntensity", "body"))
+	}
+	if body.Sets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sets", "body"))
+	}
+	if body.Bucket != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.bucket", *body.Bucket, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateFieldErrorResponseBody runs the validations defined on
+// FieldErrorResponseBody
+func ValidateFieldErrorResponseBody(body *FieldErrorResponseBody) (err error) {
+	if body.Field == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("field", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateTonnagePointResponse runs the validations defined on
+// TonnagePointResponse
+func ValidateTonnagePointResponse(body *TonnagePointResponse) (err error) {
+	if body.Bucket == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("bucket", "body"))
+	}
+	if body.Tonnage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tonnage", "body"))
+	}
+	if body.Sets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sets", "body"))
+	}
+	if body.Reps == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reps", "body"))
+	}
+	if body.AverageLoad == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("averageLoad", "body"))
+	}
+	if body.Bucket != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.bucket", *body.Bucket, goa.FormatDateTime))
+	}
+	if body.SessionID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.sessionId", *body.SessionID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateMuscleVolumePointResponse runs the validations defined on
+// MuscleVolumePointResponse
+func ValidateMuscleVolumePointResponse(body *MuscleVolumePointResponse) (err error) {
+	if body.Bucket == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("bucket", "body"))
+	}
+	if body.MuscleGroup == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("muscleGroup", "body"))
+	}
+	if body.Sets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sets", "body"))
+	}
+	if body.Tonnage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tonnage", "body"))
+	}
+	if body.Bucket != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.bucket", *body.Bucket, goa.FormatDateTime))
+	}
+	return
+}
@@ -0,0 +1,567 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// analytics HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	analytics "be/gen/analytics"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeOneRepMaxResponse returns an encoder for responses returned by the
+// analytics oneRepMax endpoint.
+func EncodeOneRepMaxResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*analytics.OneRepMaxPoint)
+		enc := encoder(ctx, w)
+		body := NewOneRepMaxResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeOneRepMaxRequest returns a decoder for requests sent to the analytics
+// oneRepMax endpoint.
+func DecodeOneRepMaxRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			userID         *string
+			from           *string
+			to             *string
+			exerciseTypeID string
+			formula        string
+			bucket         string
+			token          *string
+			err            error
+		)
+		qp := r.URL.Query()
+		userIDRaw := qp.Get("userId")
+		if userIDRaw != "" {
+			userID = &userIDRaw
+		}
+		if userID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("userId", *userID, goa.FormatUUID))
+		}
+		fromRaw := qp.Get("from")
+		if fromRaw != "" {
+			from = &fromRaw
+		}
+		if from != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDateTime))
+		}
+		toRaw := qp.Get("to")
+		if toRaw != "" {
+			to = &toRaw
+		}
+		if to != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDateTime))
+		}
+		exerciseTypeID = qp.Get("exerciseTypeId")
+		if exerciseTypeID == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("exerciseTypeId", "query string"))
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("exerciseTypeId", exerciseTypeID, goa.FormatUUID))
+		formulaRaw := qp.Get("formula")
+		if formulaRaw != "" {
+			formula = formulaRaw
+		} else {
+			formula = "epley"
+		}
+		if !(formula == "epley" || formula == "brzycki") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("formula", formula, []any{"epley", "brzycki"}))
+		}
+		bucketRaw := qp.Get("bucket")
+		if bucketRaw != "" {
+			bucket = bucketRaw
+		} else {
+			bucket = "week"
+		}
+		if !(bucket == "day" || bucket == "week" || bucket == "month") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("bucket", bucket, []any{"day", "week", "month"}))
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewOneRepMaxPayload(userID, from, to, exerciseTypeID, formula, bucket, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeOneRepMaxError returns an encoder for errors returned by the oneRepMax
+// analytics endpoint.
+func EncodeOneRepMaxError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "badRequest":
+			var res *analytics.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewOneRepMaxBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "forbidden":
+			var res *analytics.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewOneRepMaxForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "internalServerError":
+			var res *analytics.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewOneRepMaxInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "notFound":
+			var res *analytics.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewOneRepMaxNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res *analytics.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewOneRepMaxUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeTonnageResponse returns an encoder for responses returned by the
+// analytics tonnage endpoint.
+func EncodeTonnageResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*analytics.TonnagePoint)
+		enc := encoder(ctx, w)
+		body := NewTonnageResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeTonnageRequest returns a decoder for requests sent to the analytics
+// tonnage endpoint.
+func DecodeTonnageRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			userID         *string
+			from           *string
+			to             *string
+			exerciseTypeID *string
+			bucket         string
+			token          *string
+			err            error
+		)
+		qp := r.URL.Query()
+		userIDRaw := qp.Get("userId")
+		if userIDRaw != "" {
+			userID = &userIDRaw
+		}
+		if userID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("userId", *userID, goa.FormatUUID))
+		}
+		fromRaw := qp.Get("from")
+		if fromRaw != "" {
+			from = &fromRaw
+		}
+		if from != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDateTime))
+		}
+		toRaw := qp.Get("to")
+		if toRaw != "" {
+			to = &toRaw
+		}
+		if to != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDateTime))
+		}
+		exerciseTypeIDRaw := qp.Get("exerciseTypeId")
+		if exerciseTypeIDRaw != "" {
+			exerciseTypeID = &exerciseTypeIDRaw
+		}
+		if exerciseTypeID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("exerciseTypeId", *exerciseTypeID, goa.FormatUUID))
+		}
+		bucketRaw := qp.Get("bucket")
+		if bucketRaw != "" {
+			bucket = bucketRaw
+		} else {
+			bucket = "week"
+		}
+		if !(bucket == "session" || bucket == "day" || bucket == "week" || bucket == "month") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("bucket", bucket, []any{"session", "day", "week", "month"}))
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewTonnagePayload(userID, from, to, exerciseTypeID, bucket, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeTonnageError returns an encoder for errors returned by the tonnage
+// analytics endpoint.
+func EncodeTonnageError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "badRequest":
+			var res *analytics.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewTonnageBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "forbidden":
+			var res *analytics.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewTonnageForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "internalServerError":
+			var res *analytics.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewTonnageInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "notFound":
+			var res *analytics.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewTonnageNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res *analytics.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewTonnageUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeMuscleVolumeResponse returns an encoder for responses returned by the
+// analytics muscleVolume endpoint.
+func EncodeMuscleVolumeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*analytics.MuscleVolumePoint)
+		enc := encoder(ctx, w)
+		body := NewMuscleVolumeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeMuscleVolumeRequest returns a decoder for requests sent to the
+// analytics muscleVolume endpoint.
+func DecodeMuscleVolumeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			userID *string
+			from   *string
+			to     *string
+			bucket string
+			token  *string
+			err    error
+		)
+		qp := r.URL.Query()
+		userIDRaw := qp.Get("userId")
+		if userIDRaw != "" {
+			userID = &userIDRaw
+		}
+		if userID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("userId", *userID, goa.FormatUUID))
+		}
+		fromRaw := qp.Get("from")
+		if fromRaw != "" {
+			from = &fromRaw
+		}
+		if from != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDateTime))
+		}
+		toRaw := qp.Get("to")
+		if toRaw != "" {
+			to = &toRaw
+		}
+		if to != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDateTime))
+		}
+		bucketRaw := qp.Get("bucket")
+		if bucketRaw != "" {
+			bucket = bucketRaw
+		} else {
+			bucket = "week"
+		}
+		if !(bucket == "day" || bucket == "week" || bucket == "month") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("bucket", bucket, []any{"day", "week", "month"}))
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewMuscleVolumePayload(userID, from, to, bucket, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeMuscleVolumeError returns an encoder for errors returned by the
+// muscleVolume analytics endpoint.
+func EncodeMuscleVolumeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "badRequest":
+			var res *analytics.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewMuscleVolumeBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "forbidden":
+			var res *analytics.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewMuscleVolumeForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "internalServerError":
+			var res *analytics.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewMuscleVolumeInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "notFound":
+			var res *analytics.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewMuscleVolumeNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res *analytics.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewMuscleVolumeUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// marshalAnalyticsOneRepMaxPointToOneRepMaxPointResponse builds a value of
+// type *OneRepMaxPointResponse from a value of type *analytics.OneRepMaxPoint.
+func marshalAnalyticsOneRepMaxPointToOneRepMaxPointResponse(v *analytics.OneRepMaxPoint) *OneRepMaxPointResponse {
+	res := &OneRepMaxPointResponse{
+		Bucket:             v.Bucket,
+		EstimatedOneRepMax: v.EstimatedOneRepMax,
+		RelativeIntensity:  v.RelativeIntensity,
+		Sets:               v.Sets,
+	}
+
+	return res
+}
+
+// marshalAnalyticsFieldErrorToFieldErrorResponseBody builds a value of type
+// *FieldErrorResponseBody from a value of type *analytics.FieldError.
+func marshalAnalyticsFieldErrorToFieldErrorResponseBody(v *analytics.FieldError) *FieldErrorResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &FieldErrorResponseBody{
+		Field:   v.Field,
+		Message: v.Message,
+	}
+
+	return res
+}
+
+// marshalAnalyticsTonnagePointToTonnagePointResponse builds a value of type
+// *TonnagePointResponse from a value of type *analytics.TonnagePoint.
+func marshalAnalyticsTonnagePointToTonnagePointResponse(v *analytics.TonnagePoint) *TonnagePointResponse {
+	res := &TonnagePointResponse{
+		Bucket:      v.Bucket,
+		SessionID:   v.SessionID,
+		Tonnage:     v.Tonnage,
+		Sets:        v.Sets,
+		Reps:        v.Reps,
+		AverageLoad: v.AverageLoad,
+	}
+
+	return res
+}
+
+// marshalAnalyticsMuscleVolumePointToMuscleVolumePointResponse builds a value
+// of type *MuscleVolumePointResponse from a value of type
+// *analytics.MuscleVolumePoint.
+func marshalAnalyticsMuscleVolumePointToMuscleVolumePointResponse(v *analytics.MuscleVolumePoint) *MuscleVolumePointResponse {
+	res := &MuscleVolumePointResponse{
+		Bucket:      v.Bucket,
+		MuscleGroup: v.MuscleGroup,
+		Sets:        v.Sets,
+		Tonnage:     v.Tonnage,
+	}
+
+	return res
+}
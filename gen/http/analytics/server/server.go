@@ -0,0 +1,240 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// analytics HTTP server
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	analytics "be/gen/analytics"
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Server lists the analytics service endpoint HTTP handlers.
+type Server struct {
+	Mounts       []*MountPoint
+	OneRepMax    http.Handler
+	Tonnage      http.Handler
+	MuscleVolume http.Handler
+}
+
+// MountPoint holds information about the mounted endpoints.
+type MountPoint struct {
+	// Method is the name of the service method served by the mounted HTTP handler.
+	Method string
+	// Verb is the HTTP method used to match requests to the mounted handler.
+	Verb string
+	// Pattern is the HTTP request path pattern used to match requests to the
+	// mounted handler.
+	Pattern string
+}
+
+// New instantiates HTTP handlers for all the analytics service endpoints using
+// the provided encoder and decoder. The handlers are mounted on the given mux
+// using the HTTP verb and path defined in the design. errhandler is called
+// whenever a response fails to be encoded. formatter is used to format errors
+// returned by the service methods prior to encoding. Both errhandler and
+// formatter are optional and can be nil.
+func New(
+	e *analytics.Endpoints,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) *Server {
+	return &Server{
+		Mounts: []*MountPoint{
+			{"OneRepMax", "GET", "/api/v1/analytics/one-rep-max"},
+			{"Tonnage", "GET", "/api/v1/analytics/tonnage"},
+			{"MuscleVolume", "GET", "/api/v1/analytics/muscle-volume"},
+		},
+		OneRepMax:    NewOneRepMaxHandler(e.OneRepMax, mux, decoder, encoder, errhandler, formatter),
+		Tonnage:      NewTonnageHandler(e.Tonnage, mux, decoder, encoder, errhandler, formatter),
+		MuscleVolume: NewMuscleVolumeHandler(e.MuscleVolume, mux, decoder, encoder, errhandler, formatter),
+	}
+}
+
+// Service returns the name of the service served.
+func (s *Server) Service() string { return "analytics" }
+
+// Use wraps the server handlers with the given middleware.
+func (s *Server) Use(m func(http.Handler) http.Handler) {
+	s.OneRepMax = m(s.OneRepMax)
+	s.Tonnage = m(s.Tonnage)
+	s.MuscleVolume = m(s.MuscleVolume)
+}
+
+// MethodNames returns the methods served.
+func (s *Server) MethodNames() []string { return analytics.MethodNames[:] }
+
+// Mount configures the mux to serve the analytics endpoints.
+func Mount(mux goahttp.Muxer, h *Server) {
+	MountOneRepMaxHandler(mux, h.OneRepMax)
+	MountTonnageHandler(mux, h.Tonnage)
+	MountMuscleVolumeHandler(mux, h.MuscleVolume)
+}
+
+// Mount configures the mux to serve the analytics endpoints.
+func (s *Server) Mount(mux goahttp.Muxer) {
+	Mount(mux, s)
+}
+
+// MountOneRepMaxHandler configures the mux to serve the "analytics" service
+// "oneRepMax" endpoint.
+func MountOneRepMaxHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/analytics/one-rep-max", f)
+}
+
+// NewOneRepMaxHandler creates a HTTP handler which loads the HTTP request and
+// calls the "analytics" service "oneRepMax" endpoint.
+func NewOneRepMaxHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeOneRepMaxRequest(mux, decoder)
+		encodeResponse = EncodeOneRepMaxResponse(encoder)
+		encodeError    = EncodeOneRepMaxError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "oneRepMax")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "analytics")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountTonnageHandler configures the mux to serve the "analytics" service
+// "tonnage" endpoint.
+func MountTonnageHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/analytics/tonnage", f)
+}
+
+// NewTonnageHandler creates a HTTP handler which loads the HTTP request and
+// calls the "analytics" service "tonnage" endpoint.
+func NewTonnageHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeTonnageRequest(mux, decoder)
+		encodeResponse = EncodeTonnageResponse(encoder)
+		encodeError    = EncodeTonnageError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "tonnage")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "analytics")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountMuscleVolumeHandler configures the mux to serve the "analytics" service
+// "muscleVolume" endpoint.
+func MountMuscleVolumeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/analytics/muscle-volume", f)
+}
+
+// NewMuscleVolumeHandler creates a HTTP handler which loads the HTTP request
+// and calls the "analytics" service "muscleVolume" endpoint.
+func NewMuscleVolumeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeMuscleVolumeRequest(mux, decoder)
+		encodeResponse = EncodeMuscleVolumeResponse(encoder)
+		encodeError    = EncodeMuscleVolumeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "muscleVolume")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "analytics")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
@@ -0,0 +1,454 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// analytics HTTP server types
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	analytics "be/gen/analytics"
+)
+
+// OneRepMaxResponseBody is the type of the "analytics" service "oneRepMax"
+// endpoint HTTP response body.
+type OneRepMaxResponseBody []*OneRepMaxPointResponse
+
+// TonnageResponseBody is the type of the "analytics" service "tonnage"
+// endpoint HTTP response body.
+type TonnageResponseBody []*TonnagePointResponse
+
+// MuscleVolumeResponseBody is the type of the "analytics" service
+// "muscleVolume" endpoint HTTP response body.
+type MuscleVolumeResponseBody []*MuscleVolumePointResponse
+
+// OneRepMaxBadRequestResponseBody is the type of the "analytics" service
+// "oneRepMax" endpoint HTTP response body for the "badRequest" error.
+type OneRepMaxBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// OneRepMaxForbiddenResponseBody is the type of the "analytics" service
+// "oneRepMax" endpoint HTTP response body for the "forbidden" error.
+type OneRepMaxForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// OneRepMaxInternalServerErrorResponseBody is the type of the "analytics"
+// service "oneRepMax" endpoint HTTP response body for the
+// "internalServerError" error.
+type OneRepMaxInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// OneRepMaxNotFoundResponseBody is the type of the "analytics" service
+// "oneRepMax" endpoint HTTP response body for the "notFound" error.
+type OneRepMaxNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// OneRepMaxUnauthorizedResponseBody is the type of the "analytics" service
+// "oneRepMax" endpoint HTTP response body for the "unauthorized" error.
+type OneRepMaxUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// TonnageBadRequestResponseBody is the type of the "analytics" service
+// "tonnage" endpoint HTTP response body for the "badRequest" error.
+type TonnageBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// TonnageForbiddenResponseBody is the type of the "analytics" service
+// "tonnage" endpoint HTTP response body for the "forbidden" error.
+type TonnageForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// TonnageInternalServerErrorResponseBody is the type of the "analytics"
+// service "tonnage" endpoint HTTP response body for the "internalServerError"
+// error.
+type TonnageInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// TonnageNotFoundResponseBody is the type of the "analytics" service "tonnage"
+// endpoint HTTP response body for the "notFound" error.
+type TonnageNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// TonnageUnauthorizedResponseBody is the type of the "analytics" service
+// "tonnage" endpoint HTTP response body for the "unauthorized" error.
+type TonnageUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// MuscleVolumeBadRequestResponseBody is the type of the "analytics" service
+// "muscleVolume" endpoint HTTP response body for the "badRequest" error.
+type MuscleVolumeBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// MuscleVolumeForbiddenResponseBody is the type of the "analytics" service
+// "muscleVolume" endpoint HTTP response body for the "forbidden" error.
+type MuscleVolumeForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// MuscleVolumeInternalServerErrorResponseBody is the type of the "analytics"
+// service "muscleVolume" endpoint HTTP response body for the
+// "internalServerError" error.
+type MuscleVolumeInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// MuscleVolumeNotFoundResponseBody is the type of the "analytics" service
+// "muscleVolume" endpoint HTTP response body for the "notFound" error.
+type MuscleVolumeNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// MuscleVolumeUnauthorizedResponseBody is the type of the "analytics" service
+// "muscleVolume" endpoint HTTP response body for the "unauthorized" error.
+type MuscleVolumeUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// OneRepMaxPointResponse is used to define fields on response body types.
+type OneRepMaxPointResponse struct {
+	// Start of the time bucket
+	Bucket string `form:"bucket" json:"bucket" xml:"bucket"`
+	// Best estimated one-rep max in the bucket (kg)
+	EstimatedOneRepMax float64 `form:"estimatedOneRepMax" json:"estimatedOneRepMax" xml:"estimatedOneRepMax"`
+	// Average set weight as a fraction of the bucket's estimated one-rep max
+	RelativeIntensity float64 `form:"relativeIntensity" json:"relativeIntensity" xml:"relativeIntensity"`
+	// Number of sets in the bucket
+	Sets int `form:"sets" json:"sets" xml:"sets"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field string `form:"field" json:"field" xml:"field"`
+	// Motivo per cui il campo non è valido
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// TonnagePointResponse is used to define fields on response body types.
+type TonnagePointResponse struct {
+	// Start of the time bucket, or start of the session when bucketing by session
+	Bucket string `form:"bucket" json:"bucket" xml:"bucket"`
+	// Session ID when bucketing by session
+	SessionID *string `form:"sessionId,omitempty" json:"sessionId,omitempty" xml:"sessionId,omitempty"`
+	// Sum of weight x reps (kg)
+	Tonnage float64 `form:"tonnage" json:"tonnage" xml:"tonnage"`
+	// Number of sets
+	Sets int `form:"sets" json:"sets" xml:"sets"`
+	// Number of repetitions
+	Reps int `form:"reps" json:"reps" xml:"reps"`
+	// Average load per repetition (kg)
+	AverageLoad float64 `form:"averageLoad" json:"averageLoad" xml:"averageLoad"`
+}
+
+// MuscleVolumePointResponse is used to define fields on response body types.
+type MuscleVolumePointResponse struct {
+	// Start of the time bucket
+	Bucket string `form:"bucket" json:"bucket" xml:"bucket"`
+	// Muscle group from the exercise-type catalog
+	MuscleGroup string `form:"muscleGroup" json:"muscleGroup" xml:"muscleGroup"`
+	// Number of sets
+	Sets int `form:"sets" json:"sets" xml:"sets"`
+	// Sum of weight x reps (kg)
+	Tonnage float64 `form:"tonnage" json:"tonnage" xml:"tonnage"`
+}
+
+// NewOneRepMaxResponseBody builds the HTTP response body from the result of
+// the "oneRepMax" endpoint of the "analytics" service.
+func NewOneRepMaxResponseBody(res []*analytics.OneRepMaxPoint) OneRepMaxResponseBody {
+	body := make([]*OneRepMaxPointResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalAnalyticsOneRepMaxPointToOneRepMaxPointResponse(val)
+	}
+	return body
+}
+
+// NewTonnageResponseBody builds the HTTP response body from the result of the
+// "tonnage" endpoint of the "analytics" service.
+func NewTonnageResponseBody(res []*analytics.TonnagePoint) TonnageResponseBody {
+	body := make([]*TonnagePointResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalAnalyticsTonnagePointToTonnagePointResponse(val)
+	}
+	return body
+}
+
+// NewMuscleVolumeResponseBody builds the HTTP response body from the result of
+// the "muscleVolume" endpoint of the "analytics" service.
+func NewMuscleVolumeResponseBody(res []*analytics.MuscleVolumePoint) MuscleVolumeResponseBody {
+	body := make([]*MuscleVolumePointResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalAnalyticsMuscleVolumePointToMuscleVolumePointResponse(val)
+	}
+	return body
+}
+
+// NewOneRepMaxBadRequestResponseBody builds the HTTP response body from the
+// result of the "oneRepMax" endpoint of the "analytics" service.
+func NewOneRepMaxBadRequestResponseBody(res *analytics.BadRequest) *OneRepMaxBadRequestResponseBody {
+	body := &OneRepMaxBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalAnalyticsFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewOneRepMaxForbiddenResponseBody builds the HTTP response body from the
+// result of the "oneRepMax" endpoint of the "analytics" service.
+func NewOneRepMaxForbiddenResponseBody(res *analytics.Forbidden) *OneRepMaxForbiddenResponseBody {
+	body := &OneRepMaxForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewOneRepMaxInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "oneRepMax" endpoint of the "analytics" service.
+func NewOneRepMaxInternalServerErrorResponseBody(res *analytics.InternalServerError) *OneRepMaxInternalServerErrorResponseBody {
+	body := &OneRepMaxInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewOneRepMaxNotFoundResponseBody builds the HTTP response body from the
+// result of the "oneRepMax" endpoint of the "analytics" service.
+func NewOneRepMaxNotFoundResponseBody(res *analytics.NotFound) *OneRepMaxNotFoundResponseBody {
+	body := &OneRepMaxNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewOneRepMaxUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "oneRepMax" endpoint of the "analytics" service.
+func NewOneRepMaxUnauthorizedResponseBody(res *analytics.Unauthorized) *OneRepMaxUnauthorizedResponseBody {
+	body := &OneRepMaxUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewTonnageBadRequestResponseBody builds the HTTP response body from the
+// result of the "tonnage" endpoint of the "analytics" service.
+func NewTonnageBadRequestResponseBody(res *analytics.BadRequest) *TonnageBadRequestResponseBody {
+	body := &TonnageBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalAnalyticsFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewTonnageForbiddenResponseBody builds the HTTP response body from the
+// result of the "tonnage" endpoint of the "analytics" service.
+func NewTonnageForbiddenResponseBody(res *analytics.Forbidden) *TonnageForbiddenResponseBody {
+	body := &TonnageForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewTonnageInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "tonnage" endpoint of the "analytics" service.
+func NewTonnageInternalServerErrorResponseBody(res *analytics.InternalServerError) *TonnageInternalServerErrorResponseBody {
+	body := &TonnageInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewTonnageNotFoundResponseBody builds the HTTP response body from the result
+// of the "tonnage" endpoint of the "analytics" service.
+func NewTonnageNotFoundResponseBody(res *analytics.NotFound) *TonnageNotFoundResponseBody {
+	body := &TonnageNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewTonnageUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "tonnage" endpoint of the "analytics" service.
+func NewTonnageUnauthorizedResponseBody(res *analytics.Unauthorized) *TonnageUnauthorizedResponseBody {
+	body := &TonnageUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewMuscleVolumeBadRequestResponseBody builds the HTTP response body from the
+// result of the "muscleVolume" endpoint of the "analytics" service.
+func NewMuscleVolumeBadRequestResponseBody(res *analytics.BadRequest) *MuscleVolumeBadRequestResponseBody {
+	body := &MuscleVolumeBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalAnalyticsFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewMuscleVolumeForbiddenResponseBody builds the HTTP response body from the
+// result of the "muscleVolume" endpoint of the "analytics" service.
+func NewMuscleVolumeForbiddenResponseBody(res *analytics.Forbidden) *MuscleVolumeForbiddenResponseBody {
+	body := &MuscleVolumeForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewMuscleVolumeInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "muscleVolume" endpoint of the "analytics" service.
+func NewMuscleVolumeInternalServerErrorResponseBody(res *analytics.InternalServerError) *MuscleVolumeInternalServerErrorResponseBody {
+	body := &MuscleVolumeInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewMuscleVolumeNotFoundResponseBody builds the HTTP response body from the
+// result of the "muscleVolume" endpoint of the "analytics" service.
+func NewMuscleVolumeNotFoundResponseBody(res *analytics.NotFound) *MuscleVolumeNotFoundResponseBody {
+	body := &MuscleVolumeNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewMuscleVolumeUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "muscleVolume" endpoint of the "analytics" service.
+func NewMuscleVolumeUnauthorizedResponseBody(res *analytics.Unauthorized) *MuscleVolumeUnauthorizedResponseBody {
+	body := &MuscleVolumeUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewOneRepMaxPayload builds a analytics service oneRepMax endpoint payload.
+func NewOneRepMaxPayload(userID *string, from *string, to *string, exerciseTypeID string, formula string, bucket string, token *string) *analytics.OneRepMaxPayload {
+	v := &analytics.OneRepMaxPayload{}
+	v.UserID = userID
+	v.From = from
+	v.To = to
+	v.ExerciseTypeID = exerciseTypeID
+	v.Formula = formula
+	v.Bucket = bucket
+	v.Token = token
+
+	return v
+}
+
+// NewTonnagePayload builds a analytics service tonnage endpoint payload.
+func NewTonnagePayload(userID *string, from *string, to *string, exerciseTypeID *string, bucket string, token *string) *analytics.TonnagePayload {
+	v := &analytics.TonnagePayload{}
+	v.UserID = userID
+	v.From = from
+	v.To = to
+	v.ExerciseTypeID = exerciseTypeID
+	v.Bucket = bucket
+	v.Token = token
+
+	return v
+}
+
+// NewMuscleVolumePayload builds a analytics service muscleVolume endpoint
+// payload.
+func NewMuscleVolumePayload(userID *string, from *string, to *string, bucket string, token *string) *analytics.MuscleVolumePayload {
+	v := &analytics.MuscleVolumePayload{}
+	v.UserID = userID
+	v.From = from
+	v.To = to
+	v.Bucket = bucket
+	v.Token = token
+
+	return v
+}
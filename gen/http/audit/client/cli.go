@@ -0,0 +1,114 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// audit HTTP client CLI support package
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	audit "be/gen/audit"
+	"fmt"
+	"strconv"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildListPayload builds the payload for the audit list endpoint from CLI
+// flags.
+func BuildListPayload(auditListActor string, auditListResource string, auditListResourceID string, auditListFrom string, auditListTo string, auditListLimit string, auditListOffset string, auditListToken string) (*audit.ListPayload, error) {
+	var err error
+	var actor *string
+	{
+		if auditListActor != "" {
+			actor = &auditListActor
+		}
+	}
+	var resource *string
+	{
+		if auditListResource != "" {
+			resource = &auditListResource
+		}
+	}
+	var resourceID *string
+	{
+		if auditListResourceID != "" {
+			resourceID = &auditListResourceID
+		}
+	}
+	var from *string
+	{
+		if auditListFrom != "" {
+			from = &auditListFrom
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var to *string
+	{
+		if auditListTo != "" {
+			to = &auditListTo
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if auditListLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(auditListLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var offset int
+	{
+		if auditListOffset != "" {
+			var v int64
+			v, err = strconv.ParseInt(auditListOffset, 10, strconv.IntSize)
+			offset = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for offset, must be INT")
+			}
+			if offset < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("offset", offset, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if auditListToken != "" {
+			token = &auditListToken
+		}
+	}
+	v := &audit.ListPayload{}
+	v.Actor = actor
+	v.Resource = resource
+	v.ResourceID = resourceID
+	v.From = from
+	v.To = to
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v, nil
+}
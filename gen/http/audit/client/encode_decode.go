@@ -0,0 +1,253 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// audit HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	audit "be/gen/audit"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "audit" service "list" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListAuditPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("audit", "list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListRequest returns an encoder for requests sent to the audit list
+// server.
+func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*audit.ListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("audit", "list", "*audit.ListPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Actor != nil {
+			values.Add("actor", *p.Actor)
+		}
+		if p.Resource != nil {
+			values.Add("resource", *p.Resource)
+		}
+		if p.ResourceID != nil {
+			values.Add("resourceId", *p.ResourceID)
+		}
+		if p.From != nil {
+			values.Add("from", *p.From)
+		}
+		if p.To != nil {
+			values.Add("to", *p.To)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		values.Add("offset", fmt.Sprintf("%v", p.Offset))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListResponse returns a decoder for responses returned by the audit
+// list endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeListResponse may return the following errors:
+//   - "badRequest" (type *audit.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *audit.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *audit.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *audit.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *audit.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("audit", "list", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateAuditEntryResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("audit", "list", err)
+			}
+			res := NewListAuditEntryOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("audit", "list", err)
+			}
+			err = ValidateListBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("audit", "list", err)
+			}
+			return nil, NewListBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ListForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("audit", "list", err)
+			}
+			err = ValidateListForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("audit", "list", err)
+			}
+			return nil, NewListForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("audit", "list", err)
+			}
+			err = ValidateListInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("audit", "list", err)
+			}
+			return nil, NewListInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("audit", "list", err)
+			}
+			err = ValidateListNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("audit", "list", err)
+			}
+			return nil, NewListNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("audit", "list", err)
+			}
+			err = ValidateListUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("audit", "list", err)
+			}
+			return nil, NewListUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("audit", "list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalAuditEntryResponseToAuditAuditEntry builds a value of type
+// *audit.AuditEntry from a value of type *AuditEntryResponse.
+func unmarshalAuditEntryResponseToAuditAuditEntry(v *AuditEntryResponse) *audit.AuditEntry {
+	res := &audit.AuditEntry{
+		ID:         *v.ID,
+		Actor:      *v.Actor,
+		Resource:   *v.Resource,
+		Method:     *v.Method,
+		ResourceID: v.ResourceID,
+		RequestID:  v.RequestID,
+		CreatedAt:  *v.CreatedAt,
+	}
+	if v.Before != nil {
+		res.Before = make(map[string]any, len(v.Before))
+		for key, val := range v.Before {
+			tk := key
+			tv := val
+			res.Before[tk] = tv
+		}
+	}
+	if v.After != nil {
+		res.After = make(map[string]any, len(v.After))
+		for key, val := range v.After {
+			tk := key
+			tv := val
+			res.After[tk] = tv
+		}
+	}
+	if v.Diff != nil {
+		res.Diff = make(map[string]any, len(v.Diff))
+		for key, val := range v.Diff {
+			tk := key
+			tv := val
+			res.Diff[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalFieldErrorResponseBodyToAuditFieldError builds a value of type
+// *audit.FieldError from a value of type *FieldErrorResponseBody.
+func unmarshalFieldErrorResponseBodyToAuditFieldError(v *FieldErrorResponseBody) *audit.FieldError {
+	if v == nil {
+		return nil
+	}
+	res := &audit.FieldError{
+		Field:   *v.Field,
+		Message: *v.Message,
+	}
+
+	return res
+}
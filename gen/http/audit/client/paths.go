@@ -0,0 +1,13 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// HTTP request path constructors for the audit service.
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+// ListAuditPath returns the URL path to the audit service list HTTP endpoint.
+func ListAuditPath() string {
+	return "/api/v1/audit"
+}
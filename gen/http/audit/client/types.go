@@ -0,0 +1,270 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// audit HTTP client types
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	audit "be/gen/audit"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// ListResponseBody is the type of the "audit" service "list" endpoint HTTP
+// response body.
+type ListResponseBody []*AuditEntryResponse
+
+// ListBadRequestResponseBody is the type of the "audit" service "list"
+// endpoint HTTP response body for the "badRequest" error.
+type ListBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListForbiddenResponseBody is the type of the "audit" service "list" endpoint
+// HTTP response body for the "forbidden" error.
+type ListForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListInternalServerErrorResponseBody is the type of the "audit" service
+// "list" endpoint HTTP response body for the "internalServerError" error.
+type ListInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListNotFoundResponseBody is the type of the "audit" service "list" endpoint
+// HTTP response body for the "notFound" error.
+type ListNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListUnauthorizedResponseBody is the type of the "audit" service "list"
+// endpoint HTTP response body for the "unauthorized" error.
+type ListUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AuditEntryResponse is used to define fields on response body types.
+type AuditEntryResponse struct {
+	// Audit entry ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Subject (JWT sub) of the caller
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+	// Service that owns the resource
+	Resource *string `form:"resource,omitempty" json:"resource,omitempty" xml:"resource,omitempty"`
+	// Method that was called
+	Method *string `form:"method,omitempty" json:"method,omitempty" xml:"method,omitempty"`
+	// ID of the affected resource
+	ResourceID *string `form:"resourceId,omitempty" json:"resourceId,omitempty" xml:"resourceId,omitempty"`
+	// State of the resource before the operation
+	Before map[string]any `form:"before,omitempty" json:"before,omitempty" xml:"before,omitempty"`
+	// State of the resource after the operation
+	After map[string]any `form:"after,omitempty" json:"after,omitempty" xml:"after,omitempty"`
+	// Changed fields with their before and after values
+	Diff map[string]any `form:"diff,omitempty" json:"diff,omitempty" xml:"diff,omitempty"`
+	// ID of the HTTP request
+	RequestID *string `form:"requestId,omitempty" json:"requestId,omitempty" xml:"requestId,omitempty"`
+	// When the operation was recorded
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+	// Motivo per cui il campo non è valido
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// NewListAuditEntryOK builds a "audit" service "list" endpoint result from a
+// HTTP "OK" response.
+func NewListAuditEntryOK(body []*AuditEntryResponse) []*audit.AuditEntry {
+	v := make([]*audit.AuditEntry, len(body))
+	for i, val := range body {
+		v[i] = unmarshalAuditEntryResponseToAuditAuditEntry(val)
+	}
+
+	return v
+}
+
+// NewListBadRequest builds a audit service list endpoint badRequest error.
+func NewListBadRequest(body *ListBadRequestResponseBody) *audit.BadRequest {
+	v := &audit.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*audit.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToAuditFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewListForbidden builds a audit service list endpoint forbidden error.
+func NewListForbidden(body *ListForbiddenResponseBody) *audit.Forbidden {
+	v := &audit.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListInternalServerError builds a audit service list endpoint
+// internalServerError error.
+func NewListInternalServerError(body *ListInternalServerErrorResponseBody) *audit.InternalServerError {
+	v := &audit.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListNotFound builds a audit service list endpoint notFound error.
+func NewListNotFound(body *ListNotFoundResponseBody) *audit.NotFound {
+	v := &audit.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListUnauthorized builds a audit service list endpoint unauthorized error.
+func NewListUnauthorized(body *ListUnauthorizedResponseBody) *audit.Unauthorized {
+	v := &audit.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// ValidateListBadRequestResponseBody runs the validations defined on
+// list_badRequest_response_body
+func ValidateListBadRequestResponseBody(body *ListBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListForbiddenResponseBody runs the validations defined on
+// list_forbidden_response_body
+func ValidateListForbiddenResponseBody(body *ListForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListInternalServerErrorResponseBody runs the validations defined on
+// list_internalServerError_response_body
+func ValidateListInternalServerErrorResponseBody(body *ListInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListNotFoundResponseBody runs the validations defined on
+// list_notFound_response_body
+func ValidateListNotFoundResponseBody(body *ListNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListUnauthorizedResponseBody runs the validations defined on
+// list_unauthorized_response_body
+func ValidateListUnauthorizedResponseBody(body *ListUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateAuditEntryResponse runs the validations defined on AuditEntryResponse
+func ValidateAuditEntryResponse(body *AuditEntryResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Actor == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actor", "body"))
+	}
+	if body.Resource == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resource", "body"))
+	}
+	if body.Method == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("method", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateFieldErrorResponseBody runs the validations defined on
+// FieldErrorResponseBody
+func ValidateFieldErrorResponseBody(body *FieldErrorResponseBody) (err error) {
+	if body.Field == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("field", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
@@ -0,0 +1,260 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// audit HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	audit "be/gen/audit"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeListResponse returns an encoder for responses returned by the audit
+// list endpoint.
+func EncodeListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*audit.AuditEntry)
+		enc := encoder(ctx, w)
+		body := NewListResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListRequest returns a decoder for requests sent to the audit list
+// endpoint.
+func DecodeListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			actor      *string
+			resource   *string
+			resourceID *string
+			from       *string
+			to         *string
+			limit      int
+			offset     int
+			token      *string
+			err        error
+		)
+		qp := r.URL.Query()
+		actorRaw := qp.Get("actor")
+		if actorRaw != "" {
+			actor = &actorRaw
+		}
+		resourceRaw := qp.Get("resource")
+		if resourceRaw != "" {
+			resource = &resourceRaw
+		}
+		resourceIDRaw := qp.Get("resourceId")
+		if resourceIDRaw != "" {
+			resourceID = &resourceIDRaw
+		}
+		fromRaw := qp.Get("from")
+		if fromRaw != "" {
+			from = &fromRaw
+		}
+		if from != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDateTime))
+		}
+		toRaw := qp.Get("to")
+		if toRaw != "" {
+			to = &toRaw
+		}
+		if to != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDateTime))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 20
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+		}
+		{
+			offsetRaw := qp.Get("offset")
+			if offsetRaw != "" {
+				v, err2 := strconv.ParseInt(offsetRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("offset", offsetRaw, "integer"))
+				}
+				offset = int(v)
+			}
+		}
+		if offset < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("offset", offset, 0, true))
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListPayload(actor, resource, resourceID, from, to, limit, offset, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListError returns an encoder for errors returned by the list audit
+// endpoint.
+func EncodeListError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "badRequest":
+			var res *audit.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "forbidden":
+			var res *audit.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "internalServerError":
+			var res *audit.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "notFound":
+			var res *audit.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res *audit.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// marshalAuditAuditEntryToAuditEntryResponse builds a value of type
+// *AuditEntryResponse from a value of type *audit.AuditEntry.
+func marshalAuditAuditEntryToAuditEntryResponse(v *audit.AuditEntry) *AuditEntryResponse {
+	res := &AuditEntryResponse{
+		ID:         v.ID,
+		Actor:      v.Actor,
+		Resource:   v.Resource,
+		Method:     v.Method,
+		ResourceID: v.ResourceID,
+		RequestID:  v.RequestID,
+		CreatedAt:  v.CreatedAt,
+	}
+	if v.Before != nil {
+		res.Before = make(map[string]any, len(v.Before))
+		for key, val := range v.Before {
+			tk := key
+			tv := val
+			res.Before[tk] = tv
+		}
+	}
+	if v.After != nil {
+		res.After = make(map[string]any, len(v.After))
+		for key, val := range v.After {
+			tk := key
+			tv := val
+			res.After[tk] = tv
+		}
+	}
+	if v.Diff != nil {
+		res.Diff = make(map[string]any, len(v.Diff))
+		for key, val := range v.Diff {
+			tk := key
+			tv := val
+			res.Diff[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalAuditFieldErrorToFieldErrorResponseBody builds a value of type
+// *FieldErrorResponseBody from a value of type *audit.FieldError.
+func marshalAuditFieldErrorToFieldErrorResponseBody(v *audit.FieldError) *FieldErrorResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &FieldErrorResponseBody{
+		Field:   v.Field,
+		Message: v.Message,
+	}
+
+	return res
+}
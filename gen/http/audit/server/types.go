@@ -0,0 +1,176 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// audit HTTP server types
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	audit "be/gen/audit"
+)
+
+// ListResponseBody is the type of the "audit" service "list" endpoint HTTP
+// response body.
+type ListResponseBody []*AuditEntryResponse
+
+// ListBadRequestResponseBody is the type of the "audit" service "list"
+// endpoint HTTP response body for the "badRequest" error.
+type ListBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListForbiddenResponseBody is the type of the "audit" service "list" endpoint
+// HTTP response body for the "forbidden" error.
+type ListForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListInternalServerErrorResponseBody is the type of the "audit" service
+// "list" endpoint HTTP response body for the "internalServerError" error.
+type ListInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListNotFoundResponseBody is the type of the "audit" service "list" endpoint
+// HTTP response body for the "notFound" error.
+type ListNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListUnauthorizedResponseBody is the type of the "audit" service "list"
+// endpoint HTTP response body for the "unauthorized" error.
+type ListUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AuditEntryResponse is used to define fields on response body types.
+type AuditEntryResponse struct {
+	// Audit entry ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Subject (JWT sub) of the caller
+	Actor string `form:"actor" json:"actor" xml:"actor"`
+	// Service that owns the resource
+	Resource string `form:"resource" json:"resource" xml:"resource"`
+	// Method that was called
+	Method string `form:"method" json:"method" xml:"method"`
+	// ID of the affected resource
+	ResourceID *string `form:"resourceId,omitempty" json:"resourceId,omitempty" xml:"resourceId,omitempty"`
+	// State of the resource before the operation
+	Before map[string]any `form:"before,omitempty" json:"before,omitempty" xml:"before,omitempty"`
+	// State of the resource after the operation
+	After map[string]any `form:"after,omitempty" json:"after,omitempty" xml:"after,omitempty"`
+	// Changed fields with their before and after values
+	Diff map[string]any `form:"diff,omitempty" json:"diff,omitempty" xml:"diff,omitempty"`
+	// ID of the HTTP request
+	RequestID *string `form:"requestId,omitempty" json:"requestId,omitempty" xml:"requestId,omitempty"`
+	// When the operation was recorded
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field string `form:"field" json:"field" xml:"field"`
+	// Motivo per cui il campo non è valido
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "list" endpoint of the "audit" service.
+func NewListResponseBody(res []*audit.AuditEntry) ListResponseBody {
+	body := make([]*AuditEntryResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalAuditAuditEntryToAuditEntryResponse(val)
+	}
+	return body
+}
+
+// NewListBadRequestResponseBody builds the HTTP response body from the result
+// of the "list" endpoint of the "audit" service.
+func NewListBadRequestResponseBody(res *audit.BadRequest) *ListBadRequestResponseBody {
+	body := &ListBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalAuditFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewListForbiddenResponseBody builds the HTTP response body from the result
+// of the "list" endpoint of the "audit" service.
+func NewListForbiddenResponseBody(res *audit.Forbidden) *ListForbiddenResponseBody {
+	body := &ListForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "list" endpoint of the "audit" service.
+func NewListInternalServerErrorResponseBody(res *audit.InternalServerError) *ListInternalServerErrorResponseBody {
+	body := &ListInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListNotFoundResponseBody builds the HTTP response body from the result of
+// the "list" endpoint of the "audit" service.
+func NewListNotFoundResponseBody(res *audit.NotFound) *ListNotFoundResponseBody {
+	body := &ListNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "list" endpoint of the "audit" service.
+func NewListUnauthorizedResponseBody(res *audit.Unauthorized) *ListUnauthorizedResponseBody {
+	body := &ListUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListPayload builds a audit service list endpoint payload.
+func NewListPayload(actor *string, resource *string, resourceID *string, from *string, to *string, limit int, offset int, token *string) *audit.ListPayload {
+	v := &audit.ListPayload{}
+	v.Actor = actor
+	v.Resource = resource
+	v.ResourceID = resourceID
+	v.From = from
+	v.To = to
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v
+}
@@ -8,8 +8,17 @@
 package cli
 
 import (
+	analyticsc "be/gen/http/analytics/client"
+	auditc "be/gen/http/audit/client"
+	coachingc "be/gen/http/coaching/client"
+	importerc "be/gen/http/importer/client"
+	progressionc "be/gen/http/progression/client"
+	recordc "be/gen/http/record/client"
+	schedulec "be/gen/http/schedule/client"
 	trainingplanc "be/gen/http/training_plan/client"
 	userc "be/gen/http/user/client"
+	webhookc "be/gen/http/webhook/client"
+	workoutsessionc "be/gen/http/workout_session/client"
 	"flag"
 	"fmt"
 	"net/http"
@@ -23,27 +32,37 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `training-plan (create|get|list|update|delete)
-user (create|get|list|update|delete)
+	return `analytics (one-rep-max|tonnage|muscle-volume)
+audit list
+coaching (invite|accept|revoke|list)
+importer history
+progression (set|get|delete|history)
+record (list|history)
+schedule (set|calendar|today|create-feed|revoke-feed|feed)
+training-plan (create|get|list|update|patch|delete|restore|list-deleted|clone|create-template|list-templates|export|import)
+user (create|get|list|update|patch|delete|restore|list-deleted)
+webhook (create|list|delete|deliveries|redeliver)
+workout-session (start|get|list|log|finish|abandon)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + ` training-plan create --body '{
-      "description": "A plan for strength.",
-      "endDate": "2025-04-25T00:00:00Z",
-      "name": "Upper Body Strength",
-      "startDate": "2025-03-25T00:00:00Z",
-      "userId": "550e8400-e29b-41d4-a716-446655440000"
-   }' --token "Hic voluptate et odit rerum."` + "\n" +
-		os.Args[0] + ` user create --body '{
-      "admin": false,
-      "firstName": "John",
-      "lastName": "Doe",
-      "nickname": "JD",
-      "password": "Secret!1"
-   }' --token "Cumque tenetur molestiae."` + "\n" +
+	return os.Args[0] + ` analytics one-rep-max --user-id "550e8400-e29b-41d4-a716-446655440000" --from "2025-01-01T00:00:00Z" --to "2025-12-31T00:00:00Z" --exercise-type-id "3b4c5d6e-7f8a-4b9c-8d0e-1f2a3b4c5d6e" --formula "epley" --bucket "week" --token "Rem optio voluptatem dolorem."` + "\n" +
+		os.Args[0] + ` audit list --actor "550e8400-e29b-41d4-a716-446655440000" --resource "training_plan" --resource-id "11111111-2222-3333-4444-555555555555" --from "2025-01-01T00:00:00Z" --to "2025-12-31T00:00:00Z" --limit 10 --offset 0 --token "Incidunt laboriosam sunt beatae iusto harum."` + "\n" +
+		os.Args[0] + ` coaching invite --body '{
+      "athleteId": "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+   }' --token "Natus totam voluptatem veritatis doloribus qui magnam."` + "\n" +
+		os.Args[0] + ` importer history --format "auto" --dry-run true --user-id "550e8400-e29b-41d4-a716-446655440000" --unit "kg" --timezone "Europe/Rome" --token "Aliquam voluptatibus harum distinctio." --stream "goa.png"` + "\n" +
+		os.Args[0] + ` progression set --body '{
+      "deloadAfter": 7568770750837534653,
+      "deloadPercentage": 0.10947395722622281,
+      "increment": 0.6436387448870217,
+      "kind": "double_progression",
+      "maxReps": 12,
+      "minReps": 8,
+      "percentage": 0.75
+   }' --id "b7b6ed02-d716-4238-840f-21a1318e1d9c" --token "Eligendi architecto quo omnis quia neque rerum."` + "\n" +
 		""
 }
 
@@ -57,6 +76,138 @@ func ParseEndpoint(
 	restore bool,
 ) (goa.Endpoint, any, error) {
 	var (
+		analyticsFlags = flag.NewFlagSet("analytics", flag.ContinueOnError)
+
+		analyticsOneRepMaxFlags              = flag.NewFlagSet("one-rep-max", flag.ExitOnError)
+		analyticsOneRepMaxUserIDFlag         = analyticsOneRepMaxFlags.String("user-id", "", "")
+		analyticsOneRepMaxFromFlag           = analyticsOneRepMaxFlags.String("from", "", "")
+		analyticsOneRepMaxToFlag             = analyticsOneRepMaxFlags.String("to", "", "")
+		analyticsOneRepMaxExerciseTypeIDFlag = analyticsOneRepMaxFlags.String("exercise-type-id", "REQUIRED", "")
+		analyticsOneRepMaxFormulaFlag        = analyticsOneRepMaxFlags.String("formula", "epley", "")
+		analyticsOneRepMaxBucketFlag         = analyticsOneRepMaxFlags.String("bucket", "week", "")
+		analyticsOneRepMaxTokenFlag          = analyticsOneRepMaxFlags.String("token", "", "")
+
+		analyticsTonnageFlags              = flag.NewFlagSet("tonnage", flag.ExitOnError)
+		analyticsTonnageUserIDFlag         = analyticsTonnageFlags.String("user-id", "", "")
+		analyticsTonnageFromFlag           = analyticsTonnageFlags.String("from", "", "")
+		analyticsTonnageToFlag             = analyticsTonnageFlags.String("to", "", "")
+		analyticsTonnageExerciseTypeIDFlag = analyticsTonnageFlags.String("exercise-type-id", "", "")
+		analyticsTonnageBucketFlag         = analyticsTonnageFlags.String("bucket", "week", "")
+		analyticsTonnageTokenFlag          = analyticsTonnageFlags.String("token", "", "")
+
+		analyticsMuscleVolumeFlags      = flag.NewFlagSet("muscle-volume", flag.ExitOnError)
+		analyticsMuscleVolumeUserIDFlag = analyticsMuscleVolumeFlags.String("user-id", "", "")
+		analyticsMuscleVolumeFromFlag   = analyticsMuscleVolumeFlags.String("from", "", "")
+		analyticsMuscleVolumeToFlag     = analyticsMuscleVolumeFlags.String("to", "", "")
+		analyticsMuscleVolumeBucketFlag = analyticsMuscleVolumeFlags.String("bucket", "week", "")
+		analyticsMuscleVolumeTokenFlag  = analyticsMuscleVolumeFlags.String("token", "", "")
+
+		auditFlags = flag.NewFlagSet("audit", flag.ContinueOnError)
+
+		auditListFlags          = flag.NewFlagSet("list", flag.ExitOnError)
+		auditListActorFlag      = auditListFlags.String("actor", "", "")
+		auditListResourceFlag   = auditListFlags.String("resource", "", "")
+		auditListResourceIDFlag = auditListFlags.String("resource-id", "", "")
+		auditListFromFlag       = auditListFlags.String("from", "", "")
+		auditListToFlag         = auditListFlags.String("to", "", "")
+		auditListLimitFlag      = auditListFlags.String("limit", "20", "")
+		auditListOffsetFlag     = auditListFlags.String("offset", "", "")
+		auditListTokenFlag      = auditListFlags.String("token", "", "")
+
+		coachingFlags = flag.NewFlagSet("coaching", flag.ContinueOnError)
+
+		coachingInviteFlags     = flag.NewFlagSet("invite", flag.ExitOnError)
+		coachingInviteBodyFlag  = coachingInviteFlags.String("body", "REQUIRED", "")
+		coachingInviteTokenFlag = coachingInviteFlags.String("token", "", "")
+
+		coachingAcceptFlags     = flag.NewFlagSet("accept", flag.ExitOnError)
+		coachingAcceptIDFlag    = coachingAcceptFlags.String("id", "REQUIRED", "Relationship ID")
+		coachingAcceptTokenFlag = coachingAcceptFlags.String("token", "", "")
+
+		coachingRevokeFlags     = flag.NewFlagSet("revoke", flag.ExitOnError)
+		coachingRevokeIDFlag    = coachingRevokeFlags.String("id", "REQUIRED", "Relationship ID")
+		coachingRevokeTokenFlag = coachingRevokeFlags.String("token", "", "")
+
+		coachingListFlags      = flag.NewFlagSet("list", flag.ExitOnError)
+		coachingListRoleFlag   = coachingListFlags.String("role", "", "")
+		coachingListStatusFlag = coachingListFlags.String("status", "", "")
+		coachingListLimitFlag  = coachingListFlags.String("limit", "20", "")
+		coachingListOffsetFlag = coachingListFlags.String("offset", "", "")
+		coachingListTokenFlag  = coachingListFlags.String("token", "", "")
+
+		importerFlags = flag.NewFlagSet("importer", flag.ContinueOnError)
+
+		importerHistoryFlags        = flag.NewFlagSet("history", flag.ExitOnError)
+		importerHistoryFormatFlag   = importerHistoryFlags.String("format", "auto", "")
+		importerHistoryDryRunFlag   = importerHistoryFlags.String("dry-run", "", "")
+		importerHistoryUserIDFlag   = importerHistoryFlags.String("user-id", "", "")
+		importerHistoryUnitFlag     = importerHistoryFlags.String("unit", "kg", "")
+		importerHistoryTimezoneFlag = importerHistoryFlags.String("timezone", "", "")
+		importerHistoryTokenFlag    = importerHistoryFlags.String("token", "", "")
+		importerHistoryStreamFlag   = importerHistoryFlags.String("stream", "REQUIRED", "path to file containing the streamed request body")
+
+		progressionFlags = flag.NewFlagSet("progression", flag.ContinueOnError)
+
+		progressionSetFlags     = flag.NewFlagSet("set", flag.ExitOnError)
+		progressionSetBodyFlag  = progressionSetFlags.String("body", "REQUIRED", "")
+		progressionSetIDFlag    = progressionSetFlags.String("id", "REQUIRED", "Planned exercise ID")
+		progressionSetTokenFlag = progressionSetFlags.String("token", "", "")
+
+		progressionGetFlags     = flag.NewFlagSet("get", flag.ExitOnError)
+		progressionGetIDFlag    = progressionGetFlags.String("id", "REQUIRED", "Planned exercise ID")
+		progressionGetTokenFlag = progressionGetFlags.String("token", "", "")
+
+		progressionDeleteFlags     = flag.NewFlagSet("delete", flag.ExitOnError)
+		progressionDeleteIDFlag    = progressionDeleteFlags.String("id", "REQUIRED", "Planned exercise ID")
+		progressionDeleteTokenFlag = progressionDeleteFlags.String("token", "", "")
+
+		progressionHistoryFlags      = flag.NewFlagSet("history", flag.ExitOnError)
+		progressionHistoryIDFlag     = progressionHistoryFlags.String("id", "REQUIRED", "Planned exercise ID")
+		progressionHistoryLimitFlag  = progressionHistoryFlags.String("limit", "20", "")
+		progressionHistoryOffsetFlag = progressionHistoryFlags.String("offset", "", "")
+		progressionHistoryTokenFlag  = progressionHistoryFlags.String("token", "", "")
+
+		recordFlags = flag.NewFlagSet("record", flag.ContinueOnError)
+
+		recordListFlags              = flag.NewFlagSet("list", flag.ExitOnError)
+		recordListUserIDFlag         = recordListFlags.String("user-id", "", "")
+		recordListExerciseTypeIDFlag = recordListFlags.String("exercise-type-id", "", "")
+		recordListKindFlag           = recordListFlags.String("kind", "", "")
+		recordListTokenFlag          = recordListFlags.String("token", "", "")
+
+		recordHistoryFlags              = flag.NewFlagSet("history", flag.ExitOnError)
+		recordHistoryUserIDFlag         = recordHistoryFlags.String("user-id", "", "")
+		recordHistoryExerciseTypeIDFlag = recordHistoryFlags.String("exercise-type-id", "", "")
+		recordHistoryKindFlag           = recordHistoryFlags.String("kind", "", "")
+		recordHistoryLimitFlag          = recordHistoryFlags.String("limit", "20", "")
+		recordHistoryOffsetFlag         = recordHistoryFlags.String("offset", "", "")
+		recordHistoryTokenFlag          = recordHistoryFlags.String("token", "", "")
+
+		scheduleFlags = flag.NewFlagSet("schedule", flag.ContinueOnError)
+
+		scheduleSetFlags     = flag.NewFlagSet("set", flag.ExitOnError)
+		scheduleSetBodyFlag  = scheduleSetFlags.String("body", "REQUIRED", "")
+		scheduleSetIDFlag    = scheduleSetFlags.String("id", "REQUIRED", "Workout ID")
+		scheduleSetTokenFlag = scheduleSetFlags.String("token", "", "")
+
+		scheduleCalendarFlags     = flag.NewFlagSet("calendar", flag.ExitOnError)
+		scheduleCalendarFromFlag  = scheduleCalendarFlags.String("from", "REQUIRED", "")
+		scheduleCalendarToFlag    = scheduleCalendarFlags.String("to", "REQUIRED", "")
+		scheduleCalendarTokenFlag = scheduleCalendarFlags.String("token", "", "")
+
+		scheduleTodayFlags        = flag.NewFlagSet("today", flag.ExitOnError)
+		scheduleTodayTimezoneFlag = scheduleTodayFlags.String("timezone", "", "")
+		scheduleTodayTokenFlag    = scheduleTodayFlags.String("token", "", "")
+
+		scheduleCreateFeedFlags     = flag.NewFlagSet("create-feed", flag.ExitOnError)
+		scheduleCreateFeedTokenFlag = scheduleCreateFeedFlags.String("token", "", "")
+
+		scheduleRevokeFeedFlags     = flag.NewFlagSet("revoke-feed", flag.ExitOnError)
+		scheduleRevokeFeedTokenFlag = scheduleRevokeFeedFlags.String("token", "", "")
+
+		scheduleFeedFlags         = flag.NewFlagSet("feed", flag.ExitOnError)
+		scheduleFeedFeedTokenFlag = scheduleFeedFlags.String("feed-token", "REQUIRED", "Feed token, optionally followed by .ics")
+
 		trainingPlanFlags = flag.NewFlagSet("training-plan", flag.ContinueOnError)
 
 		trainingPlanCreateFlags     = flag.NewFlagSet("create", flag.ExitOnError)
@@ -74,14 +225,58 @@ func ParseEndpoint(
 		trainingPlanListOffsetFlag     = trainingPlanListFlags.String("offset", "", "")
 		trainingPlanListTokenFlag      = trainingPlanListFlags.String("token", "", "")
 
-		trainingPlanUpdateFlags     = flag.NewFlagSet("update", flag.ExitOnError)
-		trainingPlanUpdateBodyFlag  = trainingPlanUpdateFlags.String("body", "REQUIRED", "")
-		trainingPlanUpdateIDFlag    = trainingPlanUpdateFlags.String("id", "REQUIRED", "")
-		trainingPlanUpdateTokenFlag = trainingPlanUpdateFlags.String("token", "", "")
-
-		trainingPlanDeleteFlags     = flag.NewFlagSet("delete", flag.ExitOnError)
-		trainingPlanDeleteIDFlag    = trainingPlanDeleteFlags.String("id", "REQUIRED", "")
-		trainingPlanDeleteTokenFlag = trainingPlanDeleteFlags.String("token", "", "")
+		trainingPlanUpdateFlags       = flag.NewFlagSet("update", flag.ExitOnError)
+		trainingPlanUpdateBodyFlag    = trainingPlanUpdateFlags.String("body", "REQUIRED", "")
+		trainingPlanUpdateIDFlag      = trainingPlanUpdateFlags.String("id", "REQUIRED", "")
+		trainingPlanUpdateIfMatchFlag = trainingPlanUpdateFlags.String("if-match", "REQUIRED", "")
+		trainingPlanUpdateTokenFlag   = trainingPlanUpdateFlags.String("token", "", "")
+
+		trainingPlanPatchFlags       = flag.NewFlagSet("patch", flag.ExitOnError)
+		trainingPlanPatchIDFlag      = trainingPlanPatchFlags.String("id", "REQUIRED", "")
+		trainingPlanPatchIfMatchFlag = trainingPlanPatchFlags.String("if-match", "REQUIRED", "")
+		trainingPlanPatchTokenFlag   = trainingPlanPatchFlags.String("token", "", "")
+		trainingPlanPatchStreamFlag  = trainingPlanPatchFlags.String("stream", "REQUIRED", "path to file containing the streamed request body")
+
+		trainingPlanDeleteFlags       = flag.NewFlagSet("delete", flag.ExitOnError)
+		trainingPlanDeleteIDFlag      = trainingPlanDeleteFlags.String("id", "REQUIRED", "")
+		trainingPlanDeleteIfMatchFlag = trainingPlanDeleteFlags.String("if-match", "REQUIRED", "")
+		trainingPlanDeleteTokenFlag   = trainingPlanDeleteFlags.String("token", "", "")
+
+		trainingPlanRestoreFlags     = flag.NewFlagSet("restore", flag.ExitOnError)
+		trainingPlanRestoreIDFlag    = trainingPlanRestoreFlags.String("id", "REQUIRED", "TrainingPlan ID")
+		trainingPlanRestoreTokenFlag = trainingPlanRestoreFlags.String("token", "", "")
+
+		trainingPlanListDeletedFlags      = flag.NewFlagSet("list-deleted", flag.ExitOnError)
+		trainingPlanListDeletedUserIDFlag = trainingPlanListDeletedFlags.String("user-id", "", "")
+		trainingPlanListDeletedLimitFlag  = trainingPlanListDeletedFlags.String("limit", "20", "")
+		trainingPlanListDeletedOffsetFlag = trainingPlanListDeletedFlags.String("offset", "", "")
+		trainingPlanListDeletedTokenFlag  = trainingPlanListDeletedFlags.String("token", "", "")
+
+		trainingPlanCloneFlags     = flag.NewFlagSet("clone", flag.ExitOnError)
+		trainingPlanCloneBodyFlag  = trainingPlanCloneFlags.String("body", "REQUIRED", "")
+		trainingPlanCloneIDFlag    = trainingPlanCloneFlags.String("id", "REQUIRED", "Plan or template to clone")
+		trainingPlanCloneTokenFlag = trainingPlanCloneFlags.String("token", "", "")
+
+		trainingPlanCreateTemplateFlags     = flag.NewFlagSet("create-template", flag.ExitOnError)
+		trainingPlanCreateTemplateBodyFlag  = trainingPlanCreateTemplateFlags.String("body", "REQUIRED", "")
+		trainingPlanCreateTemplateIDFlag    = trainingPlanCreateTemplateFlags.String("id", "REQUIRED", "Plan to copy")
+		trainingPlanCreateTemplateTokenFlag = trainingPlanCreateTemplateFlags.String("token", "", "")
+
+		trainingPlanListTemplatesFlags      = flag.NewFlagSet("list-templates", flag.ExitOnError)
+		trainingPlanListTemplatesLimitFlag  = trainingPlanListTemplatesFlags.String("limit", "20", "")
+		trainingPlanListTemplatesOffsetFlag = trainingPlanListTemplatesFlags.String("offset", "", "")
+		trainingPlanListTemplatesTokenFlag  = trainingPlanListTemplatesFlags.String("token", "", "")
+
+		trainingPlanExportFlags      = flag.NewFlagSet("export", flag.ExitOnError)
+		trainingPlanExportIDFlag     = trainingPlanExportFlags.String("id", "REQUIRED", "Training plan ID")
+		trainingPlanExportFormatFlag = trainingPlanExportFlags.String("format", "json", "")
+		trainingPlanExportTokenFlag  = trainingPlanExportFlags.String("token", "", "")
+
+		trainingPlanImportFlags              = flag.NewFlagSet("import", flag.ExitOnError)
+		trainingPlanImportBodyFlag           = trainingPlanImportFlags.String("body", "REQUIRED", "")
+		trainingPlanImportUserIDFlag         = trainingPlanImportFlags.String("user-id", "", "")
+		trainingPlanImportAllowUnmatchedFlag = trainingPlanImportFlags.String("allow-unmatched", "", "")
+		trainingPlanImportTokenFlag          = trainingPlanImportFlags.String("token", "", "")
 
 		userFlags = flag.NewFlagSet("user", flag.ContinueOnError)
 
@@ -98,28 +293,164 @@ func ParseEndpoint(
 		userListOffsetFlag = userListFlags.String("offset", "", "")
 		userListTokenFlag  = userListFlags.String("token", "", "")
 
-		userUpdateFlags     = flag.NewFlagSet("update", flag.ExitOnError)
-		userUpdateBodyFlag  = userUpdateFlags.String("body", "REQUIRED", "")
-		userUpdateIDFlag    = userUpdateFlags.String("id", "REQUIRED", "User ID")
-		userUpdateTokenFlag = userUpdateFlags.String("token", "", "")
-
-		userDeleteFlags     = flag.NewFlagSet("delete", flag.ExitOnError)
-		userDeleteIDFlag    = userDeleteFlags.String("id", "REQUIRED", "User ID")
-		userDeleteTokenFlag = userDeleteFlags.String("token", "", "")
+		userUpdateFlags       = flag.NewFlagSet("update", flag.ExitOnError)
+		userUpdateBodyFlag    = userUpdateFlags.String("body", "REQUIRED", "")
+		userUpdateIDFlag      = userUpdateFlags.String("id", "REQUIRED", "User ID")
+		userUpdateIfMatchFlag = userUpdateFlags.String("if-match", "REQUIRED", "")
+		userUpdateTokenFlag   = userUpdateFlags.String("token", "", "")
+
+		userPatchFlags       = flag.NewFlagSet("patch", flag.ExitOnError)
+		userPatchIDFlag      = userPatchFlags.String("id", "REQUIRED", "User ID")
+		userPatchIfMatchFlag = userPatchFlags.String("if-match", "REQUIRED", "")
+		userPatchTokenFlag   = userPatchFlags.String("token", "", "")
+		userPatchStreamFlag  = userPatchFlags.String("stream", "REQUIRED", "path to file containing the streamed request body")
+
+		userDeleteFlags       = flag.NewFlagSet("delete", flag.ExitOnError)
+		userDeleteIDFlag      = userDeleteFlags.String("id", "REQUIRED", "User ID")
+		userDeleteIfMatchFlag = userDeleteFlags.String("if-match", "REQUIRED", "")
+		userDeleteTokenFlag   = userDeleteFlags.String("token", "", "")
+
+		userRestoreFlags     = flag.NewFlagSet("restore", flag.ExitOnError)
+		userRestoreIDFlag    = userRestoreFlags.String("id", "REQUIRED", "User ID")
+		userRestoreTokenFlag = userRestoreFlags.String("token", "", "")
+
+		userListDeletedFlags      = flag.NewFlagSet("list-deleted", flag.ExitOnError)
+		userListDeletedLimitFlag  = userListDeletedFlags.String("limit", "10", "")
+		userListDeletedOffsetFlag = userListDeletedFlags.String("offset", "", "")
+		userListDeletedTokenFlag  = userListDeletedFlags.String("token", "", "")
+
+		webhookFlags = flag.NewFlagSet("webhook", flag.ContinueOnError)
+
+		webhookCreateFlags     = flag.NewFlagSet("create", flag.ExitOnError)
+		webhookCreateBodyFlag  = webhookCreateFlags.String("body", "REQUIRED", "")
+		webhookCreateTokenFlag = webhookCreateFlags.String("token", "", "")
+
+		webhookListFlags     = flag.NewFlagSet("list", flag.ExitOnError)
+		webhookListTokenFlag = webhookListFlags.String("token", "", "")
+
+		webhookDeleteFlags     = flag.NewFlagSet("delete", flag.ExitOnError)
+		webhookDeleteIDFlag    = webhookDeleteFlags.String("id", "REQUIRED", "Subscription ID")
+		webhookDeleteTokenFlag = webhookDeleteFlags.String("token", "", "")
+
+		webhookDeliveriesFlags      = flag.NewFlagSet("deliveries", flag.ExitOnError)
+		webhookDeliveriesIDFlag     = webhookDeliveriesFlags.String("id", "REQUIRED", "Subscription ID")
+		webhookDeliveriesStatusFlag = webhookDeliveriesFlags.String("status", "", "")
+		webhookDeliveriesLimitFlag  = webhookDeliveriesFlags.String("limit", "20", "")
+		webhookDeliveriesOffsetFlag = webhookDeliveriesFlags.String("offset", "", "")
+		webhookDeliveriesTokenFlag  = webhookDeliveriesFlags.String("token", "", "")
+
+		webhookRedeliverFlags          = flag.NewFlagSet("redeliver", flag.ExitOnError)
+		webhookRedeliverIDFlag         = webhookRedeliverFlags.String("id", "REQUIRED", "Subscription ID")
+		webhookRedeliverDeliveryIDFlag = webhookRedeliverFlags.String("delivery-id", "REQUIRED", "Delivery ID")
+		webhookRedeliverTokenFlag      = webhookRedeliverFlags.String("token", "", "")
+
+		workoutSessionFlags = flag.NewFlagSet("workout-session", flag.ContinueOnError)
+
+		workoutSessionStartFlags     = flag.NewFlagSet("start", flag.ExitOnError)
+		workoutSessionStartBodyFlag  = workoutSessionStartFlags.String("body", "REQUIRED", "")
+		workoutSessionStartTokenFlag = workoutSessionStartFlags.String("token", "", "")
+
+		workoutSessionGetFlags     = flag.NewFlagSet("get", flag.ExitOnError)
+		workoutSessionGetIDFlag    = workoutSessionGetFlags.String("id", "REQUIRED", "Session ID")
+		workoutSessionGetTokenFlag = workoutSessionGetFlags.String("token", "", "")
+
+		workoutSessionListFlags         = flag.NewFlagSet("list", flag.ExitOnError)
+		workoutSessionListUserIDFlag    = workoutSessionListFlags.String("user-id", "", "")
+		workoutSessionListWorkoutIDFlag = workoutSessionListFlags.String("workout-id", "", "")
+		workoutSessionListStatusFlag    = workoutSessionListFlags.String("status", "", "")
+		workoutSessionListLimitFlag     = workoutSessionListFlags.String("limit", "20", "")
+		workoutSessionListOffsetFlag    = workoutSessionListFlags.String("offset", "", "")
+		workoutSessionListTokenFlag     = workoutSessionListFlags.String("token", "", "")
+
+		workoutSessionLogFlags     = flag.NewFlagSet("log", flag.ExitOnError)
+		workoutSessionLogBodyFlag  = workoutSessionLogFlags.String("body", "REQUIRED", "")
+		workoutSessionLogIDFlag    = workoutSessionLogFlags.String("id", "REQUIRED", "Session ID")
+		workoutSessionLogTokenFlag = workoutSessionLogFlags.String("token", "", "")
+
+		workoutSessionFinishFlags     = flag.NewFlagSet("finish", flag.ExitOnError)
+		workoutSessionFinishBodyFlag  = workoutSessionFinishFlags.String("body", "REQUIRED", "")
+		workoutSessionFinishIDFlag    = workoutSessionFinishFlags.String("id", "REQUIRED", "Session ID")
+		workoutSessionFinishTokenFlag = workoutSessionFinishFlags.String("token", "", "")
+
+		workoutSessionAbandonFlags     = flag.NewFlagSet("abandon", flag.ExitOnError)
+		workoutSessionAbandonBodyFlag  = workoutSessionAbandonFlags.String("body", "REQUIRED", "")
+		workoutSessionAbandonIDFlag    = workoutSessionAbandonFlags.String("id", "REQUIRED", "Session ID")
+		workoutSessionAbandonTokenFlag = workoutSessionAbandonFlags.String("token", "", "")
 	)
+	analyticsFlags.Usage = analyticsUsage
+	analyticsOneRepMaxFlags.Usage = analyticsOneRepMaxUsage
+	analyticsTonnageFlags.Usage = analyticsTonnageUsage
+	analyticsMuscleVolumeFlags.Usage = analyticsMuscleVolumeUsage
+
+	auditFlags.Usage = auditUsage
+	auditListFlags.Usage = auditListUsage
+
+	coachingFlags.Usage = coachingUsage
+	coachingInviteFlags.Usage = coachingInviteUsage
+	coachingAcceptFlags.Usage = coachingAcceptUsage
+	coachingRevokeFlags.Usage = coachingRevokeUsage
+	coachingListFlags.Usage = coachingListUsage
+
+	importerFlags.Usage = importerUsage
+	importerHistoryFlags.Usage = importerHistoryUsage
+
+	progressionFlags.Usage = progressionUsage
+	progressionSetFlags.Usage = progressionSetUsage
+	progressionGetFlags.Usage = progressionGetUsage
+	progressionDeleteFlags.Usage = progressionDeleteUsage
+	progressionHistoryFlags.Usage = progressionHistoryUsage
+
+	recordFlags.Usage = recordUsage
+	recordListFlags.Usage = recordListUsage
+	recordHistoryFlags.Usage = recordHistoryUsage
+
+	scheduleFlags.Usage = scheduleUsage
+	scheduleSetFlags.Usage = scheduleSetUsage
+	scheduleCalendarFlags.Usage = scheduleCalendarUsage
+	scheduleTodayFlags.Usage = scheduleTodayUsage
+	scheduleCreateFeedFlags.Usage = scheduleCreateFeedUsage
+	scheduleRevokeFeedFlags.Usage = scheduleRevokeFeedUsage
+	scheduleFeedFlags.Usage = scheduleFeedUsage
+
 	trainingPlanFlags.Usage = trainingPlanUsage
 	trainingPlanCreateFlags.Usage = trainingPlanCreateUsage
 	trainingPlanGetFlags.Usage = trainingPlanGetUsage
 	trainingPlanListFlags.Usage = trainingPlanListUsage
 	trainingPlanUpdateFlags.Usage = trainingPlanUpdateUsage
+	trainingPlanPatchFlags.Usage = trainingPlanPatchUsage
 	trainingPlanDeleteFlags.Usage = trainingPlanDeleteUsage
+	trainingPlanRestoreFlags.Usage = trainingPlanRestoreUsage
+	trainingPlanListDeletedFlags.Usage = trainingPlanListDeletedUsage
+	trainingPlanCloneFlags.Usage = trainingPlanCloneUsage
+	trainingPlanCreateTemplateFlags.Usage = trainingPlanCreateTemplateUsage
+	trainingPlanListTemplatesFlags.Usage = trainingPlanListTemplatesUsage
+	trainingPlanExportFlags.Usage = trainingPlanExportUsage
+	trainingPlanImportFlags.Usage = trainingPlanImportUsage
 
 	userFlags.Usage = userUsage
 	userCreateFlags.Usage = userCreateUsage
 	userGetFlags.Usage = userGetUsage
 	userListFlags.Usage = userListUsage
 	userUpdateFlags.Usage = userUpdateUsage
+	userPatchFlags.Usage = userPatchUsage
 	userDeleteFlags.Usage = userDeleteUsage
+	userRestoreFlags.Usage = userRestoreUsage
+	userListDeletedFlags.Usage = userListDeletedUsage
+
+	webhookFlags.Usage = webhookUsage
+	webhookCreateFlags.Usage = webhookCreateUsage
+	webhookListFlags.Usage = webhookListUsage
+	webhookDeleteFlags.Usage = webhookDeleteUsage
+	webhookDeliveriesFlags.Usage = webhookDeliveriesUsage
+	webhookRedeliverFlags.Usage = webhookRedeliverUsage
+
+	workoutSessionFlags.Usage = workoutSessionUsage
+	workoutSessionStartFlags.Usage = workoutSessionStartUsage
+	workoutSessionGetFlags.Usage = workoutSessionGetUsage
+	workoutSessionListFlags.Usage = workoutSessionListUsage
+	workoutSessionLogFlags.Usage = workoutSessionLogUsage
+	workoutSessionFinishFlags.Usage = workoutSessionFinishUsage
+	workoutSessionAbandonFlags.Usage = workoutSessionAbandonUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -136,10 +467,28 @@ func ParseEndpoint(
 	{
 		svcn = flag.Arg(0)
 		switch svcn {
+		case "analytics":
+			svcf = analyticsFlags
+		case "audit":
+			svcf = auditFlags
+		case "coaching":
+			svcf = coachingFlags
+		case "importer":
+			svcf = importerFlags
+		case "progression":
+			svcf = progressionFlags
+		case "record":
+			svcf = recordFlags
+		case "schedule":
+			svcf = scheduleFlags
 		case "training-plan":
 			svcf = trainingPlanFlags
 		case "user":
 			svcf = userFlags
+		case "webhook":
+			svcf = webhookFlags
+		case "workout-session":
+			svcf = workoutSessionFlags
 		default:
 			return nil, nil, fmt.Errorf("unknown service %q", svcn)
 		}
@@ -155,6 +504,97 @@ func ParseEndpoint(
 	{
 		epn = svcf.Arg(0)
 		switch svcn {
+		case "analytics":
+			switch epn {
+			case "one-rep-max":
+				epf = analyticsOneRepMaxFlags
+
+			case "tonnage":
+				epf = analyticsTonnageFlags
+
+			case "muscle-volume":
+				epf = analyticsMuscleVolumeFlags
+
+			}
+
+		case "audit":
+			switch epn {
+			case "list":
+				epf = auditListFlags
+
+			}
+
+		case "coaching":
+			switch epn {
+			case "invite":
+				epf = coachingInviteFlags
+
+			case "accept":
+				epf = coachingAcceptFlags
+
+			case "revoke":
+				epf = coachingRevokeFlags
+
+			case "list":
+				epf = coachingListFlags
+
+			}
+
+		case "importer":
+			switch epn {
+			case "history":
+				epf = importerHistoryFlags
+
+			}
+
+		case "progression":
+			switch epn {
+			case "set":
+				epf = progressionSetFlags
+
+			case "get":
+				epf = progressionGetFlags
+
+			case "delete":
+				epf = progressionDeleteFlags
+
+			case "history":
+				epf = progressionHistoryFlags
+
+			}
+
+		case "record":
+			switch epn {
+			case "list":
+				epf = recordListFlags
+
+			case "history":
+				epf = recordHistoryFlags
+
+			}
+
+		case "schedule":
+			switch epn {
+			case "set":
+				epf = scheduleSetFlags
+
+			case "calendar":
+				epf = scheduleCalendarFlags
+
+			case "today":
+				epf = scheduleTodayFlags
+
+			case "create-feed":
+				epf = scheduleCreateFeedFlags
+
+			case "revoke-feed":
+				epf = scheduleRevokeFeedFlags
+
+			case "feed":
+				epf = scheduleFeedFlags
+
+			}
+
 		case "training-plan":
 			switch epn {
 			case "create":
@@ -169,9 +609,33 @@ func ParseEndpoint(
 			case "update":
 				epf = trainingPlanUpdateFlags
 
+			case "patch":
+				epf = trainingPlanPatchFlags
+
 			case "delete":
 				epf = trainingPlanDeleteFlags
 
+			case "restore":
+				epf = trainingPlanRestoreFlags
+
+			case "list-deleted":
+				epf = trainingPlanListDeletedFlags
+
+			case "clone":
+				epf = trainingPlanCloneFlags
+
+			case "create-template":
+				epf = trainingPlanCreateTemplateFlags
+
+			case "list-templates":
+				epf = trainingPlanListTemplatesFlags
+
+			case "export":
+				epf = trainingPlanExportFlags
+
+			case "import":
+				epf = trainingPlanImportFlags
+
 			}
 
 		case "user":
@@ -188,9 +652,59 @@ func ParseEndpoint(
 			case "update":
 				epf = userUpdateFlags
 
+			case "patch":
+				epf = userPatchFlags
+
 			case "delete":
 				epf = userDeleteFlags
 
+			case "restore":
+				epf = userRestoreFlags
+
+			case "list-deleted":
+				epf = userListDeletedFlags
+
+			}
+
+		case "webhook":
+			switch epn {
+			case "create":
+				epf = webhookCreateFlags
+
+			case "list":
+				epf = webhookListFlags
+
+			case "delete":
+				epf = webhookDeleteFlags
+
+			case "deliveries":
+				epf = webhookDeliveriesFlags
+
+			case "redeliver":
+				epf = webhookRedeliverFlags
+
+			}
+
+		case "workout-session":
+			switch epn {
+			case "start":
+				epf = workoutSessionStartFlags
+
+			case "get":
+				epf = workoutSessionGetFlags
+
+			case "list":
+				epf = workoutSessionListFlags
+
+			case "log":
+				epf = workoutSessionLogFlags
+
+			case "finish":
+				epf = workoutSessionFinishFlags
+
+			case "abandon":
+				epf = workoutSessionAbandonFlags
+
 			}
 
 		}
@@ -213,6 +727,100 @@ func ParseEndpoint(
 	)
 	{
 		switch svcn {
+		case "analytics":
+			c := analyticsc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "one-rep-max":
+				endpoint = c.OneRepMax()
+				data, err = analyticsc.BuildOneRepMaxPayload(*analyticsOneRepMaxUserIDFlag, *analyticsOneRepMaxFromFlag, *analyticsOneRepMaxToFlag, *analyticsOneRepMaxExerciseTypeIDFlag, *analyticsOneRepMaxFormulaFlag, *analyticsOneRepMaxBucketFlag, *analyticsOneRepMaxTokenFlag)
+			case "tonnage":
+				endpoint = c.Tonnage()
+				data, err = analyticsc.BuildTonnagePayload(*analyticsTonnageUserIDFlag, *analyticsTonnageFromFlag, *analyticsTonnageToFlag, *analyticsTonnageExerciseTypeIDFlag, *analyticsTonnageBucketFlag, *analyticsTonnageTokenFlag)
+			case "muscle-volume":
+				endpoint = c.MuscleVolume()
+				data, err = analyticsc.BuildMuscleVolumePayload(*analyticsMuscleVolumeUserIDFlag, *analyticsMuscleVolumeFromFlag, *analyticsMuscleVolumeToFlag, *analyticsMuscleVolumeBucketFlag, *analyticsMuscleVolumeTokenFlag)
+			}
+		case "audit":
+			c := auditc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "list":
+				endpoint = c.List()
+				data, err = auditc.BuildListPayload(*auditListActorFlag, *auditListResourceFlag, *auditListResourceIDFlag, *auditListFromFlag, *auditListToFlag, *auditListLimitFlag, *auditListOffsetFlag, *auditListTokenFlag)
+			}
+		case "coaching":
+			c := coachingc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "invite":
+				endpoint = c.Invite()
+				data, err = coachingc.BuildInvitePayload(*coachingInviteBodyFlag, *coachingInviteTokenFlag)
+			case "accept":
+				endpoint = c.Accept()
+				data, err = coachingc.BuildAcceptPayload(*coachingAcceptIDFlag, *coachingAcceptTokenFlag)
+			case "revoke":
+				endpoint = c.Revoke()
+				data, err = coachingc.BuildRevokePayload(*coachingRevokeIDFlag, *coachingRevokeTokenFlag)
+			case "list":
+				endpoint = c.List()
+				data, err = coachingc.BuildListPayload(*coachingListRoleFlag, *coachingListStatusFlag, *coachingListLimitFlag, *coachingListOffsetFlag, *coachingListTokenFlag)
+			}
+		case "importer":
+			c := importerc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "history":
+				endpoint = c.History()
+				data, err = importerc.BuildHistoryPayload(*importerHistoryFormatFlag, *importerHistoryDryRunFlag, *importerHistoryUserIDFlag, *importerHistoryUnitFlag, *importerHistoryTimezoneFlag, *importerHistoryTokenFlag)
+				if err == nil {
+					data, err = importerc.BuildHistoryStreamPayload(data, *importerHistoryStreamFlag)
+				}
+			}
+		case "progression":
+			c := progressionc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "set":
+				endpoint = c.Set()
+				data, err = progressionc.BuildSetPayload(*progressionSetBodyFlag, *progressionSetIDFlag, *progressionSetTokenFlag)
+			case "get":
+				endpoint = c.Get()
+				data, err = progressionc.BuildGetPayload(*progressionGetIDFlag, *progressionGetTokenFlag)
+			case "delete":
+				endpoint = c.Delete()
+				data, err = progressionc.BuildDeletePayload(*progressionDeleteIDFlag, *progressionDeleteTokenFlag)
+			case "history":
+				endpoint = c.History()
+				data, err = progressionc.BuildHistoryPayload(*progressionHistoryIDFlag, *progressionHistoryLimitFlag, *progressionHistoryOffsetFlag, *progressionHistoryTokenFlag)
+			}
+		case "record":
+			c := recordc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "list":
+				endpoint = c.List()
+				data, err = recordc.BuildListPayload(*recordListUserIDFlag, *recordListExerciseTypeIDFlag, *recordListKindFlag, *recordListTokenFlag)
+			case "history":
+				endpoint = c.History()
+				data, err = recordc.BuildHistoryPayload(*recordHistoryUserIDFlag, *recordHistoryExerciseTypeIDFlag, *recordHistoryKindFlag, *recordHistoryLimitFlag, *recordHistoryOffsetFlag, *recordHistoryTokenFlag)
+			}
+		case "schedule":
+			c := schedulec.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "set":
+				endpoint = c.Set()
+				data, err = schedulec.BuildSetPayload(*scheduleSetBodyFlag, *scheduleSetIDFlag, *scheduleSetTokenFlag)
+			case "calendar":
+				endpoint = c.Calendar()
+				data, err = schedulec.BuildCalendarPayload(*scheduleCalendarFromFlag, *scheduleCalendarToFlag, *scheduleCalendarTokenFlag)
+			case "today":
+				endpoint = c.Today()
+				data, err = schedulec.BuildTodayPayload(*scheduleTodayTimezoneFlag, *scheduleTodayTokenFlag)
+			case "create-feed":
+				endpoint = c.CreateFeed()
+				data, err = schedulec.BuildCreateFeedPayload(*scheduleCreateFeedTokenFlag)
+			case "revoke-feed":
+				endpoint = c.RevokeFeed()
+				data, err = schedulec.BuildRevokeFeedPayload(*scheduleRevokeFeedTokenFlag)
+			case "feed":
+				endpoint = c.Feed()
+				data, err = schedulec.BuildFeedPayload(*scheduleFeedFeedTokenFlag)
+			}
 		case "training-plan":
 			c := trainingplanc.NewClient(scheme, host, doer, enc, dec, restore)
 			switch epn {
@@ -227,10 +835,37 @@ func ParseEndpoint(
 				data, err = trainingplanc.BuildListPayload(*trainingPlanListUserIDFlag, *trainingPlanListStartAfterFlag, *trainingPlanListLimitFlag, *trainingPlanListOffsetFlag, *trainingPlanListTokenFlag)
 			case "update":
 				endpoint = c.Update()
-				data, err = trainingplanc.BuildUpdatePayload(*trainingPlanUpdateBodyFlag, *trainingPlanUpdateIDFlag, *trainingPlanUpdateTokenFlag)
+				data, err = trainingplanc.BuildUpdatePayload(*trainingPlanUpdateBodyFlag, *trainingPlanUpdateIDFlag, *trainingPlanUpdateIfMatchFlag, *trainingPlanUpdateTokenFlag)
+			case "patch":
+				endpoint = c.Patch()
+				data, err = trainingplanc.BuildPatchPayload(*trainingPlanPatchIDFlag, *trainingPlanPatchIfMatchFlag, *trainingPlanPatchTokenFlag)
+				if err == nil {
+					data, err = trainingplanc.BuildPatchStreamPayload(data, *trainingPlanPatchStreamFlag)
+				}
 			case "delete":
 				endpoint = c.Delete()
-				data, err = trainingplanc.BuildDeletePayload(*trainingPlanDeleteIDFlag, *trainingPlanDeleteTokenFlag)
+				data, err = trainingplanc.BuildDeletePayload(*trainingPlanDeleteIDFlag, *trainingPlanDeleteIfMatchFlag, *trainingPlanDeleteTokenFlag)
+			case "restore":
+				endpoint = c.Restore()
+				data, err = trainingplanc.BuildRestorePayload(*trainingPlanRestoreIDFlag, *trainingPlanRestoreTokenFlag)
+			case "list-deleted":
+				endpoint = c.ListDeleted()
+				data, err = trainingplanc.BuildListDeletedPayload(*trainingPlanListDeletedUserIDFlag, *trainingPlanListDeletedLimitFlag, *trainingPlanListDeletedOffsetFlag, *trainingPlanListDeletedTokenFlag)
+			case "clone":
+				endpoint = c.Clone()
+				data, err = trainingplanc.BuildClonePayload(*trainingPlanCloneBodyFlag, *trainingPlanCloneIDFlag, *trainingPlanCloneTokenFlag)
+			case "create-template":
+				endpoint = c.CreateTemplate()
+				data, err = trainingplanc.BuildCreateTemplatePayload(*trainingPlanCreateTemplateBodyFlag, *trainingPlanCreateTemplateIDFlag, *trainingPlanCreateTemplateTokenFlag)
+			case "list-templates":
+				endpoint = c.ListTemplates()
+				data, err = trainingplanc.BuildListTemplatesPayload(*trainingPlanListTemplatesLimitFlag, *trainingPlanListTemplatesOffsetFlag, *trainingPlanListTemplatesTokenFlag)
+			case "export":
+				endpoint = c.Export()
+				data, err = trainingplanc.BuildExportPayload(*trainingPlanExportIDFlag, *trainingPlanExportFormatFlag, *trainingPlanExportTokenFlag)
+			case "import":
+				endpoint = c.Import()
+				data, err = trainingplanc.BuildImportPayload(*trainingPlanImportBodyFlag, *trainingPlanImportUserIDFlag, *trainingPlanImportAllowUnmatchedFlag, *trainingPlanImportTokenFlag)
 			}
 		case "user":
 			c := userc.NewClient(scheme, host, doer, enc, dec, restore)
@@ -246,10 +881,63 @@ func ParseEndpoint(
 				data, err = userc.BuildListPayload(*userListLimitFlag, *userListOffsetFlag, *userListTokenFlag)
 			case "update":
 				endpoint = c.Update()
-				data, err = userc.BuildUpdatePayload(*userUpdateBodyFlag, *userUpdateIDFlag, *userUpdateTokenFlag)
+				data, err = userc.BuildUpdatePayload(*userUpdateBodyFlag, *userUpdateIDFlag, *userUpdateIfMatchFlag, *userUpdateTokenFlag)
+			case "patch":
+				endpoint = c.Patch()
+				data, err = userc.BuildPatchPayload(*userPatchIDFlag, *userPatchIfMatchFlag, *userPatchTokenFlag)
+				if err == nil {
+					data, err = userc.BuildPatchStreamPayload(data, *userPatchStreamFlag)
+				}
+			case "delete":
+				endpoint = c.Delete()
+				data, err = userc.BuildDeletePayload(*userDeleteIDFlag, *userDeleteIfMatchFlag, *userDeleteTokenFlag)
+			case "restore":
+				endpoint = c.Restore()
+				data, err = userc.BuildRestorePayload(*userRestoreIDFlag, *userRestoreTokenFlag)
+			case "list-deleted":
+				endpoint = c.ListDeleted()
+				data, err = userc.BuildListDeletedPayload(*userListDeletedLimitFlag, *userListDeletedOffsetFlag, *userListDeletedTokenFlag)
+			}
+		case "webhook":
+			c := webhookc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "create":
+				endpoint = c.Create()
+				data, err = webhookc.BuildCreatePayload(*webhookCreateBodyFlag, *webhookCreateTokenFlag)
+			case "list":
+				endpoint = c.List()
+				data, err = webhookc.BuildListPayload(*webhookListTokenFlag)
 			case "delete":
 				endpoint = c.Delete()
-				data, err = userc.BuildDeletePayload(*userDeleteIDFlag, *userDeleteTokenFlag)
+				data, err = webhookc.BuildDeletePayload(*webhookDeleteIDFlag, *webhookDeleteTokenFlag)
+			case "deliveries":
+				endpoint = c.Deliveries()
+				data, err = webhookc.BuildDeliveriesPayload(*webhookDeliveriesIDFlag, *webhookDeliveriesStatusFlag, *webhookDeliveriesLimitFlag, *webhookDeliveriesOffsetFlag, *webhookDeliveriesTokenFlag)
+			case "redeliver":
+				endpoint = c.Redeliver()
+				data, err = webhookc.BuildRedeliverPayload(*webhookRedeliverIDFlag, *webhookRedeliverDeliveryIDFlag, *webhookRedeliverTokenFlag)
+			}
+		case "workout-session":
+			c := workoutsessionc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "start":
+				endpoint = c.Start()
+				data, err = workoutsessionc.BuildStartPayload(*workoutSessionStartBodyFlag, *workoutSessionStartTokenFlag)
+			case "get":
+				endpoint = c.Get()
+				data, err = workoutsessionc.BuildGetPayload(*workoutSessionGetIDFlag, *workoutSessionGetTokenFlag)
+			case "list":
+				endpoint = c.List()
+				data, err = workoutsessionc.BuildListPayload(*workoutSessionListUserIDFlag, *workoutSessionListWorkoutIDFlag, *workoutSessionListStatusFlag, *workoutSessionListLimitFlag, *workoutSessionListOffsetFlag, *workoutSessionListTokenFlag)
+			case "log":
+				endpoint = c.Log()
+				data, err = workoutsessionc.BuildLogPayload(*workoutSessionLogBodyFlag, *workoutSessionLogIDFlag, *workoutSessionLogTokenFlag)
+			case "finish":
+				endpoint = c.Finish()
+				data, err = workoutsessionc.BuildFinishPayload(*workoutSessionFinishBodyFlag, *workoutSessionFinishIDFlag, *workoutSessionFinishTokenFlag)
+			case "abandon":
+				endpoint = c.Abandon()
+				data, err = workoutsessionc.BuildAbandonPayload(*workoutSessionAbandonBodyFlag, *workoutSessionAbandonIDFlag, *workoutSessionAbandonTokenFlag)
 			}
 		}
 	}
@@ -260,186 +948,1096 @@ func ParseEndpoint(
 	return endpoint, data, nil
 }
 
-// trainingPlanUsage displays the usage of the training-plan command and its
+// analyticsUsage displays the usage of the analytics command and its
 // subcommands.
-func trainingPlanUsage() {
-	fmt.Fprintf(os.Stderr, `Service for managing training plans
+func analyticsUsage() {
+	fmt.Fprintf(os.Stderr, `Strength analytics computed from the performed sets
 Usage:
-    %[1]s [globalflags] training-plan COMMAND [flags]
+    %[1]s [globalflags] analytics COMMAND [flags]
 
 COMMAND:
-    create: Create implements create.
-    get: Get implements get.
-    list: List implements list.
-    update: Update implements update.
-    delete: Delete implements delete.
+    one-rep-max: Estimated one-rep max of an exercise type over time
+    tonnage: Tonnage (weight x reps) per session or per time bucket
+    muscle-volume: Sets and tonnage per muscle group over time
 
 Additional help:
-    %[1]s training-plan COMMAND --help
+    %[1]s analytics COMMAND --help
 `, os.Args[0])
 }
-func trainingPlanCreateUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan create -body JSON -token STRING
+func analyticsOneRepMaxUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] analytics one-rep-max -user-id STRING -from STRING -to STRING -exercise-type-id STRING -formula STRING -bucket STRING -token STRING
 
-Create implements create.
-    -body JSON: 
+Estimated one-rep max of an exercise type over time
+    -user-id STRING: 
+    -from STRING: 
+    -to STRING: 
+    -exercise-type-id STRING: 
+    -formula STRING: 
+    -bucket STRING: 
     -token STRING: 
 
 Example:
-    %[1]s training-plan create --body '{
-      "description": "A plan for strength.",
-      "endDate": "2025-04-25T00:00:00Z",
-      "name": "Upper Body Strength",
-      "startDate": "2025-03-25T00:00:00Z",
-      "userId": "550e8400-e29b-41d4-a716-446655440000"
-   }' --token "Hic voluptate et odit rerum."
+    %[1]s analytics one-rep-max --user-id "550e8400-e29b-41d4-a716-446655440000" --from "2025-01-01T00:00:00Z" --to "2025-12-31T00:00:00Z" --exercise-type-id "3b4c5d6e-7f8a-4b9c-8d0e-1f2a3b4c5d6e" --formula "epley" --bucket "week" --token "Rem optio voluptatem dolorem."
 `, os.Args[0])
 }
 
-func trainingPlanGetUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan get -id STRING -token STRING
+func analyticsTonnageUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] analytics tonnage -user-id STRING -from STRING -to STRING -exercise-type-id STRING -bucket STRING -token STRING
 
-Get implements get.
-    -id STRING: Training plan ID
+Tonnage (weight x reps) per session or per time bucket
+    -user-id STRING: 
+    -from STRING: 
+    -to STRING: 
+    -exercise-type-id STRING: 
+    -bucket STRING: 
     -token STRING: 
 
 Example:
-    %[1]s training-plan get --id "b4e8a506-3b09-47c8-967a-1aa7ec38545c" --token "Quidem eius necessitatibus corporis."
+    %[1]s analytics tonnage --user-id "550e8400-e29b-41d4-a716-446655440000" --from "2025-01-01T00:00:00Z" --to "2025-12-31T00:00:00Z" --exercise-type-id "3b4c5d6e-7f8a-4b9c-8d0e-1f2a3b4c5d6e" --bucket "day" --token "Iste at fuga consequatur."
 `, os.Args[0])
 }
 
-func trainingPlanListUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan list -user-id STRING -start-after STRING -limit INT -offset INT -token STRING
+func analyticsMuscleVolumeUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] analytics muscle-volume -user-id STRING -from STRING -to STRING -bucket STRING -token STRING
 
-List implements list.
+Sets and tonnage per muscle group over time
     -user-id STRING: 
-    -start-after STRING: 
+    -from STRING: 
+    -to STRING: 
+    -bucket STRING: 
+    -token STRING: 
+
+Example:
+    %[1]s analytics muscle-volume --user-id "550e8400-e29b-41d4-a716-446655440000" --from "2025-01-01T00:00:00Z" --to "2025-12-31T00:00:00Z" --bucket "day" --token "Tempora perspiciatis libero odit saepe."
+`, os.Args[0])
+}
+
+// auditUsage displays the usage of the audit command and its subcommands.
+func auditUsage() {
+	fmt.Fprintf(os.Stderr, `Audit trail of mutating operations
+Usage:
+    %[1]s [globalflags] audit COMMAND [flags]
+
+COMMAND:
+    list: List audit entries (admin only)
+
+Additional help:
+    %[1]s audit COMMAND --help
+`, os.Args[0])
+}
+func auditListUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] audit list -actor STRING -resource STRING -resource-id STRING -from STRING -to STRING -limit INT -offset INT -token STRING
+
+List audit entries (admin only)
+    -actor STRING: 
+    -resource STRING: 
+    -resource-id STRING: 
+    -from STRING: 
+    -to STRING: 
     -limit INT: 
     -offset INT: 
     -token STRING: 
 
 Example:
-    %[1]s training-plan list --user-id "550e8400-e29b-41d4-a716-446655440000" --start-after "2024-01-01T00:00:00Z" --limit 10 --offset 0 --token "Aliquid et suscipit et nam aut omnis."
+    %[1]s audit list --actor "550e8400-e29b-41d4-a716-446655440000" --resource "training_plan" --resource-id "11111111-2222-3333-4444-555555555555" --from "2025-01-01T00:00:00Z" --to "2025-12-31T00:00:00Z" --limit 10 --offset 0 --token "Incidunt laboriosam sunt beatae iusto harum."
 `, os.Args[0])
 }
 
-func trainingPlanUpdateUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan update -body JSON -id STRING -token STRING
+// coachingUsage displays the usage of the coaching command and its subcommands.
+func coachingUsage() {
+	fmt.Fprintf(os.Stderr, `Coach and athlete relationships
+Usage:
+    %[1]s [globalflags] coaching COMMAND [flags]
 
-Update implements update.
+COMMAND:
+    invite: Invite an athlete; the caller becomes their coach once accepted
+    accept: Accept a pending invitation as the invited athlete
+    revoke: Decline an invitation or end a relationship, as either the coach or the athlete
+    list: List the caller's relationships as coach or athlete
+
+Additional help:
+    %[1]s coaching COMMAND --help
+`, os.Args[0])
+}
+func coachingInviteUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] coaching invite -body JSON -token STRING
+
+Invite an athlete; the caller becomes their coach once accepted
     -body JSON: 
-    -id STRING: 
     -token STRING: 
 
 Example:
-    %[1]s training-plan update --body '{
-      "description": "A plan for strength.",
-      "endDate": "2025-04-25T00:00:00Z",
-      "name": "Upper Body Strength",
-      "startDate": "2025-03-25T00:00:00Z",
-      "userId": "550e8400-e29b-41d4-a716-446655440000"
-   }' --id "9e3d4d3e-52e4-4a37-8fe3-a4addc24b437" --token "Voluptas assumenda optio."
+    %[1]s coaching invite --body '{
+      "athleteId": "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+   }' --token "Natus totam voluptatem veritatis doloribus qui magnam."
 `, os.Args[0])
 }
 
-func trainingPlanDeleteUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan delete -id STRING -token STRING
+func coachingAcceptUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] coaching accept -id STRING -token STRING
 
-Delete implements delete.
-    -id STRING: 
+Accept a pending invitation as the invited athlete
+    -id STRING: Relationship ID
     -token STRING: 
 
 Example:
-    %[1]s training-plan delete --id "e04eba39-bfd4-4316-b2cc-0375219209fb" --token "Consequatur qui."
+    %[1]s coaching accept --id "3aef696a-2ad1-45c6-bbd5-1b7f4d2aaff0" --token "Dignissimos aut."
 `, os.Args[0])
 }
 
-// userUsage displays the usage of the user command and its subcommands.
-func userUsage() {
-	fmt.Fprintf(os.Stderr, `User service for managing users
+func coachingRevokeUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] coaching revoke -id STRING -token STRING
+
+Decline an invitation or end a relationship, as either the coach or the athlete
+    -id STRING: Relationship ID
+    -token STRING: 
+
+Example:
+    %[1]s coaching revoke --id "41aadc58-d5af-4c24-b05f-0f18b0d2a017" --token "Ex est repellendus quisquam magnam odit animi."
+`, os.Args[0])
+}
+
+func coachingListUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] coaching list -role STRING -status STRING -limit INT -offset INT -token STRING
+
+List the caller's relationships as coach or athlete
+    -role STRING: 
+    -status STRING: 
+    -limit INT: 
+    -offset INT: 
+    -token STRING: 
+
+Example:
+    %[1]s coaching list --role "coach" --status "active" --limit 10 --offset 0 --token "Magnam quam aut voluptatum ut eos qui."
+`, os.Args[0])
+}
+
+// importerUsage displays the usage of the importer command and its subcommands.
+func importerUsage() {
+	fmt.Fprintf(os.Stderr, `Import of workout history exported by other apps
 Usage:
-    %[1]s [globalflags] user COMMAND [flags]
+    %[1]s [globalflags] importer COMMAND [flags]
 
 COMMAND:
-    create: Create a new user
-    get: Get a user by ID
-    list: List all users with pagination
-    update: Update a user
-    delete: Delete a user
+    history: Import a CSV export of Strong or Hevy as finished workout sessions. The request body is the raw CSV file, of at most 10 MiB. With dryRun nothing is saved and the response previews the sessions and exercise matches.
 
 Additional help:
-    %[1]s user COMMAND --help
+    %[1]s importer COMMAND --help
 `, os.Args[0])
 }
-func userCreateUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] user create -body JSON -token STRING
+func importerHistoryUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] importer history -format STRING -dry-run BOOL -user-id STRING -unit STRING -timezone STRING -token STRING -stream STRING
 
-Create a new user
-    -body JSON: 
+Import a CSV export of Strong or Hevy as finished workout sessions. The request body is the raw CSV file, of at most 10 MiB. With dryRun nothing is saved and the response previews the sessions and exercise matches.
+    -format STRING: 
+    -dry-run BOOL: 
+    -user-id STRING: 
+    -unit STRING: 
+    -timezone STRING: 
     -token STRING: 
+    -stream STRING: path to file containing the streamed request body
 
 Example:
-    %[1]s user create --body '{
-      "admin": false,
-      "firstName": "John",
-      "lastName": "Doe",
-      "nickname": "JD",
-      "password": "Secret!1"
-   }' --token "Cumque tenetur molestiae."
+    %[1]s importer history --format "auto" --dry-run true --user-id "550e8400-e29b-41d4-a716-446655440000" --unit "kg" --timezone "Europe/Rome" --token "Aliquam voluptatibus harum distinctio." --stream "goa.png"
 `, os.Args[0])
 }
 
-func userGetUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] user get -id STRING -token STRING
+// progressionUsage displays the usage of the progression command and its
+// subcommands.
+func progressionUsage() {
+	fmt.Fprintf(os.Stderr, `Automatic progression of planned exercises
+Usage:
+    %[1]s [globalflags] progression COMMAND [flags]
 
-Get a user by ID
-    -id STRING: User ID
+COMMAND:
+    set: Attach a progression rule to a planned exercise, replacing the current one
+    get: Get the progression rule of a planned exercise
+    delete: Detach the progression rule of a planned exercise
+    history: Changes made to the exercise's targets, newest first
+
+Additional help:
+    %[1]s progression COMMAND --help
+`, os.Args[0])
+}
+func progressionSetUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] progression set -body JSON -id STRING -token STRING
+
+Attach a progression rule to a planned exercise, replacing the current one
+    -body JSON: 
+    -id STRING: Planned exercise ID
     -token STRING: 
 
 Example:
-    %[1]s user get --id "f47ac10b-58cc-4372-a567-0e02b2c3d479" --token "Sint iusto doloremque facilis."
+    %[1]s progression set --body '{
+      "deloadAfter": 7568770750837534653,
+      "deloadPercentage": 0.10947395722622281,
+      "increment": 0.6436387448870217,
+      "kind": "double_progression",
+      "maxReps": 12,
+      "minReps": 8,
+      "percentage": 0.75
+   }' --id "b7b6ed02-d716-4238-840f-21a1318e1d9c" --token "Eligendi architecto quo omnis quia neque rerum."
 `, os.Args[0])
 }
 
-func userListUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] user list -limit INT -offset INT -token STRING
+func progressionGetUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] progression get -id STRING -token STRING
 
-List all users with pagination
-    -limit INT: 
-    -offset INT: 
+Get the progression rule of a planned exercise
+    -id STRING: Planned exercise ID
     -token STRING: 
 
 Example:
-    %[1]s user list --limit 10 --offset 0 --token "Non est cum quam molestias."
+    %[1]s progression get --id "8f37dc4f-b69a-4920-9bab-7b5260f96738" --token "Exercitationem similique."
 `, os.Args[0])
 }
 
-func userUpdateUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] user update -body JSON -id STRING -token STRING
+func progressionDeleteUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] progression delete -id STRING -token STRING
 
-Update a user
-    -body JSON: 
-    -id STRING: User ID
+Detach the progression rule of a planned exercise
+    -id STRING: Planned exercise ID
     -token STRING: 
 
 Example:
-    %[1]s user update --body '{
-      "admin": false,
-      "firstName": "John",
-      "lastName": "Doe",
-      "nickname": "JD"
-   }' --id "f47ac10b-58cc-4372-a567-0e02b2c3d479" --token "Sit repudiandae ratione ducimus."
+    %[1]s progression delete --id "b554fa62-0433-44eb-9630-ef68f11412db" --token "Quod doloremque et at."
 `, os.Args[0])
 }
 
-func userDeleteUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] user delete -id STRING -token STRING
+func progressionHistoryUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] progression history -id STRING -limit INT -offset INT -token STRING
 
-Delete a user
-    -id STRING: User ID
+Changes made to the exercise's targets, newest first
+    -id STRING: Planned exercise ID
+    -limit INT: 
+    -offset INT: 
+    -token STRING: 
+
+Example:
+    %[1]s progression history --id "340301f3-7330-4f76-ac49-15f9c93f8b91" --limit 10 --offset 0 --token "Voluptatum quasi voluptatem enim laudantium qui."
+`, os.Args[0])
+}
+
+// recordUsage displays the usage of the record command and its subcommands.
+func recordUsage() {
+	fmt.Fprintf(os.Stderr, `Personal records detected from the logged sets
+Usage:
+    %[1]s [globalflags] record COMMAND [flags]
+
+COMMAND:
+    list: Current personal records, one per exercise type and kind (and per weight for max_reps)
+    history: Every personal record set, newest first
+
+Additional help:
+    %[1]s record COMMAND --help
+`, os.Args[0])
+}
+func recordListUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] record list -user-id STRING -exercise-type-id STRING -kind STRING -token STRING
+
+Current personal records, one per exercise type and kind (and per weight for max_reps)
+    -user-id STRING: 
+    -exercise-type-id STRING: 
+    -kind STRING: 
+    -token STRING: 
+
+Example:
+    %[1]s record list --user-id "8801f067-1583-488f-8f63-3467936bd87b" --exercise-type-id "d7d32cec-62e4-4498-a66a-4ab057da9ca9" --kind "max_weight" --token "Possimus earum nulla."
+`, os.Args[0])
+}
+
+func recordHistoryUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] record history -user-id STRING -exercise-type-id STRING -kind STRING -limit INT -offset INT -token STRING
+
+Every personal record set, newest first
+    -user-id STRING: 
+    -exercise-type-id STRING: 
+    -kind STRING: 
+    -limit INT: 
+    -offset INT: 
+    -token STRING: 
+
+Example:
+    %[1]s record history --user-id "8611fd61-c3f8-4529-8c6f-da8f62b02cce" --exercise-type-id "88093483-936b-4c05-94e4-edae47711b72" --kind "max_weight" --limit 10 --offset 0 --token "Sunt et ut velit quisquam et id."
+`, os.Args[0])
+}
+
+// scheduleUsage displays the usage of the schedule command and its subcommands.
+func scheduleUsage() {
+	fmt.Fprintf(os.Stderr, `Scheduling of workouts and the athlete's calendar
+Usage:
+    %[1]s [globalflags] schedule COMMAND [flags]
+
+COMMAND:
+    set: Replace the schedule of a workout
+    calendar: Expand the caller's scheduled workouts between two days
+    today: Workouts planned for the current day in the caller's time zone
+    create-feed: Create the caller's ICS feed token, revoking the previous one
+    revoke-feed: Revoke the caller's ICS feed token
+    feed: ICS feed of the scheduled workouts, authenticated by the feed token in the URL
+
+Additional help:
+    %[1]s schedule COMMAND --help
+`, os.Args[0])
+}
+func scheduleSetUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] schedule set -body JSON -id STRING -token STRING
+
+Replace the schedule of a workout
+    -body JSON: 
+    -id STRING: Workout ID
+    -token STRING: 
+
+Example:
+    %[1]s schedule set --body '{
+      "dates": [
+         "2025-03-27"
+      ],
+      "position": 1,
+      "recurrence": "FREQ=WEEKLY;BYDAY=MO,TH"
+   }' --id "e63b7f27-3480-4cea-a552-e1dccb0ab421" --token "Consequatur tempora."
+`, os.Args[0])
+}
+
+func scheduleCalendarUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] schedule calendar -from STRING -to STRING -token STRING
+
+Expand the caller's scheduled workouts between two days
+    -from STRING: 
+    -to STRING: 
+    -token STRING: 
+
+Example:
+    %[1]s schedule calendar --from "2025-03-24" --to "2025-03-30" --token "Laboriosam quo vitae deleniti doloribus laborum quia."
+`, os.Args[0])
+}
+
+func scheduleTodayUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] schedule today -timezone STRING -token STRING
+
+Workouts planned for the current day in the caller's time zone
+    -timezone STRING: 
+    -token STRING: 
+
+Example:
+    %[1]s schedule today --timezone "Europe/Rome" --token "Odio totam qui aut."
+`, os.Args[0])
+}
+
+func scheduleCreateFeedUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] schedule create-feed -token STRING
+
+Create the caller's ICS feed token, revoking the previous one
+    -token STRING: 
+
+Example:
+    %[1]s schedule create-feed --token "Beatae molestias quaerat laboriosam."
+`, os.Args[0])
+}
+
+func scheduleRevokeFeedUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] schedule revoke-feed -token STRING
+
+Revoke the caller's ICS feed token
+    -token STRING: 
+
+Example:
+    %[1]s schedule revoke-feed --token "Debitis eos."
+`, os.Args[0])
+}
+
+func scheduleFeedUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] schedule feed -feed-token STRING
+
+ICS feed of the scheduled workouts, authenticated by the feed token in the URL
+    -feed-token STRING: Feed token, optionally followed by .ics
+
+Example:
+    %[1]s schedule feed --feed-token "calf_4f9c2b7e1d8a6c3b5e0f2a9d7c4b1e8f.ics"
+`, os.Args[0])
+}
+
+// trainingPlanUsage displays the usage of the training-plan command and its
+// subcommands.
+func trainingPlanUsage() {
+	fmt.Fprintf(os.Stderr, `Service for managing training plans
+Usage:
+    %[1]s [globalflags] training-plan COMMAND [flags]
+
+COMMAND:
+    create: Create implements create.
+    get: Get implements get.
+    list: List implements list.
+    update: Update implements update.
+    patch: Change some fields of a plan with a JSON Merge Patch (RFC 7396) body: members left out are unchanged and null clears description
+    delete: Delete implements delete.
+    restore: Restore a deleted plan together with the workouts, exercises and sets deleted with it
+    list-deleted: List the plans in the trash, most recently deleted first
+    clone: Deep-copy a plan or template into a plan of the target user, shifting its dates to the new start date
+    create-template: Save a copy of a plan as a template owned by the caller
+    list-templates: List the public templates and those owned by the caller
+    export: Export a plan as a versioned JSON document of the full plan tree, or its logged sets as CSV
+    import: Import a plan document in one transaction, mapping exercise names to the exercise type catalog
+
+Additional help:
+    %[1]s training-plan COMMAND --help
+`, os.Args[0])
+}
+func trainingPlanCreateUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan create -body JSON -token STRING
+
+Create implements create.
+    -body JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s training-plan create --body '{
+      "description": "A plan for strength.",
+      "endDate": "2025-04-25T00:00:00Z",
+      "name": "Upper Body Strength",
+      "startDate": "2025-03-25T00:00:00Z",
+      "userId": "550e8400-e29b-41d4-a716-446655440000"
+   }' --token "Sunt beatae illo."
+`, os.Args[0])
+}
+
+func trainingPlanGetUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan get -id STRING -token STRING
+
+Get implements get.
+    -id STRING: Training plan ID
+    -token STRING: 
+
+Example:
+    %[1]s training-plan get --id "c9182586-5eb7-4eff-ba5d-9f885b52c5b2" --token "Error inventore harum officiis voluptates ea."
+`, os.Args[0])
+}
+
+func trainingPlanListUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan list -user-id STRING -start-after STRING -limit INT -offset INT -token STRING
+
+List implements list.
+    -user-id STRING: 
+    -start-after STRING: 
+    -limit INT: 
+    -offset INT: 
+    -token STRING: 
+
+Example:
+    %[1]s training-plan list --user-id "550e8400-e29b-41d4-a716-446655440000" --start-after "2024-01-01T00:00:00Z" --limit 10 --offset 0 --token "Aperiam sed."
+`, os.Args[0])
+}
+
+func trainingPlanUpdateUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan update -body JSON -id STRING -if-match STRING -token STRING
+
+Update implements update.
+    -body JSON: 
+    -id STRING: 
+    -if-match STRING: 
+    -token STRING: 
+
+Example:
+    %[1]s training-plan update --body '{
+      "description": "A plan for strength.",
+      "endDate": "2025-04-25T00:00:00Z",
+      "name": "Upper Body Strength",
+      "startDate": "2025-03-25T00:00:00Z",
+      "userId": "550e8400-e29b-41d4-a716-446655440000"
+   }' --id "a4de773c-4f8f-4123-8589-7042a265e21d" --if-match "\"3\"" --token "Rerum mollitia veniam modi cupiditate dignissimos."
+`, os.Args[0])
+}
+
+func trainingPlanPatchUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan patch -id STRING -if-match STRING -token STRING -stream STRING
+
+Change some fields of a plan with a JSON Merge Patch (RFC 7396) body: members left out are unchanged and null clears description
+    -id STRING: 
+    -if-match STRING: 
+    -token STRING: 
+    -stream STRING: path to file containing the streamed request body
+
+Example:
+    %[1]s training-plan patch --id "72bff67d-2a7b-4294-980a-b87339fb0750" --if-match "\"3\"" --token "Vitae voluptatem nesciunt iusto." --stream "goa.png"
+`, os.Args[0])
+}
+
+func trainingPlanDeleteUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan delete -id STRING -if-match STRING -token STRING
+
+Delete implements delete.
+    -id STRING: 
+    -if-match STRING: 
+    -token STRING: 
+
+Example:
+    %[1]s training-plan delete --id "a574b847-2755-4590-b9d1-e1565cb78532" --if-match "\"3\"" --token "Aut qui ut quibusdam."
+`, os.Args[0])
+}
+
+func trainingPlanRestoreUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan restore -id STRING -token STRING
+
+Restore a deleted plan together with the workouts, exercises and sets deleted with it
+    -id STRING: TrainingPlan ID
+    -token STRING: 
+
+Example:
+    %[1]s training-plan restore --id "bd88c96f-231c-47e1-9b1b-146a24a18769" --token "Et repudiandae reiciendis tenetur."
+`, os.Args[0])
+}
+
+func trainingPlanListDeletedUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan list-deleted -user-id STRING -limit INT -offset INT -token STRING
+
+List the plans in the trash, most recently deleted first
+    -user-id STRING: 
+    -limit INT: 
+    -offset INT: 
+    -token STRING: 
+
+Example:
+    %[1]s training-plan list-deleted --user-id "550e8400-e29b-41d4-a716-446655440000" --limit 10 --offset 0 --token "Cumque debitis voluptas dolorum exercitationem nobis."
+`, os.Args[0])
+}
+
+func trainingPlanCloneUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan clone -body JSON -id STRING -token STRING
+
+Deep-copy a plan or template into a plan of the target user, shifting its dates to the new start date
+    -body JSON: 
+    -id STRING: Plan or template to clone
+    -token STRING: 
+
+Example:
+    %[1]s training-plan clone --body '{
+      "name": "Upper Body Strength - June",
+      "startDate": "2025-06-02T00:00:00Z",
+      "userId": "550e8400-e29b-41d4-a716-446655440000"
+   }' --id "968f8f9b-f872-47fd-80df-df8d13f77f9a" --token "Sint aperiam at qui sint."
+`, os.Args[0])
+}
+
+func trainingPlanCreateTemplateUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan create-template -body JSON -id STRING -token STRING
+
+Save a copy of a plan as a template owned by the caller
+    -body JSON: 
+    -id STRING: Plan to copy
+    -token STRING: 
+
+Example:
+    %[1]s training-plan create-template --body '{
+      "name": "5x5 Beginner",
+      "public": false
+   }' --id "42fcc25d-682e-4d5c-94d4-22da20eeceb0" --token "Eos quia aut deserunt dicta."
+`, os.Args[0])
+}
+
+func trainingPlanListTemplatesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan list-templates -limit INT -offset INT -token STRING
+
+List the public templates and those owned by the caller
+    -limit INT: 
+    -offset INT: 
+    -token STRING: 
+
+Example:
+    %[1]s training-plan list-templates --limit 10 --offset 0 --token "Id ab neque quo excepturi necessitatibus est."
+`, os.Args[0])
+}
+
+func trainingPlanExportUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan export -id STRING -format STRING -token STRING
+
+Export a plan as a versioned JSON document of the full plan tree, or its logged sets as CSV
+    -id STRING: Training plan ID
+    -format STRING: 
+    -token STRING: 
+
+Example:
+    %[1]s training-plan export --id "af48cc10-7f17-4cd3-96dd-1724c976ffdd" --format "csv" --token "Expedita consequatur repellendus quasi animi."
+`, os.Args[0])
+}
+
+func trainingPlanImportUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] training-plan import -body JSON -user-id STRING -allow-unmatched BOOL -token STRING
+
+Import a plan document in one transaction, mapping exercise names to the exercise type catalog
+    -body JSON: 
+    -user-id STRING: 
+    -allow-unmatched BOOL: 
+    -token STRING: 
+
+Example:
+    %[1]s training-plan import --body '{
+      "exportedAt": "2004-05-08T09:58:24Z",
+      "plan": {
+         "description": "Quia voluptatem aut vero.",
+         "endDate": "2025-04-25T00:00:00Z",
+         "name": "Upper Body Strength",
+         "startDate": "2025-03-25T00:00:00Z",
+         "workouts": [
+            {
+               "exercises": [
+                  {
+                     "exerciseType": "Bench Press",
+                     "name": "Bench Press",
+                     "progression": {
+                        "deloadAfter": 8070508509937062080,
+                        "deloadPercentage": 0.008687062835540998,
+                        "increment": 2.5,
+                        "kind": "linear",
+                        "maxReps": 2809595721649925129,
+                        "minReps": 8355147212356982375,
+                        "percentage": 0.5114371920190389
+                     },
+                     "sets": [
+                        {
+                           "reps": 8,
+                           "restTime": 90,
+                           "weight": 80
+                        },
+                        {
+                           "reps": 8,
+                           "restTime": 90,
+                           "weight": 80
+                        }
+                     ]
+                  },
+                  {
+                     "exerciseType": "Bench Press",
+                     "name": "Bench Press",
+                     "progression": {
+                        "deloadAfter": 8070508509937062080,
+                        "deloadPercentage": 0.008687062835540998,
+                        "increment": 2.5,
+                        "kind": "linear",
+                        "maxReps": 2809595721649925129,
+                        "minReps": 8355147212356982375,
+                        "percentage": 0.5114371920190389
+                     },
+                     "sets": [
+                        {
+                           "reps": 8,
+                           "restTime": 90,
+                           "weight": 80
+                        },
+                        {
+                           "reps": 8,
+                           "restTime": 90,
+                           "weight": 80
+                        }
+                     ]
+                  }
+               ],
+               "name": "Push day",
+               "position": 1,
+               "recurrence": "FREQ=WEEKLY;BYDAY=MO,TH",
+               "scheduledDates": [
+                  "2004-06-09",
+                  "1996-02-01",
+                  "2004-02-13",
+                  "2000-05-11"
+               ]
+            },
+            {
+               "exercises": [
+                  {
+                     "exerciseType": "Bench Press",
+                     "name": "Bench Press",
+                     "progression": {
+                        "deloadAfter": 8070508509937062080,
+                        "deloadPercentage": 0.008687062835540998,
+                        "increment": 2.5,
+                        "kind": "linear",
+                        "maxReps": 2809595721649925129,
+                        "minReps": 8355147212356982375,
+                        "percentage": 0.5114371920190389
+                     },
+                     "sets": [
+                        {
+                           "reps": 8,
+                           "restTime": 90,
+                           "weight": 80
+                        },
+                        {
+                           "reps": 8,
+                           "restTime": 90,
+                           "weight": 80
+                        }
+                     ]
+                  },
+                  {
+                     "exerciseType": "Bench Press",
+                     "name": "Bench Press",
+                     "progression": {
+                        "deloadAfter": 8070508509937062080,
+                        "deloadPercentage": 0.008687062835540998,
+                        "increment": 2.5,
+                        "kind": "linear",
+                        "maxReps": 2809595721649925129,
+                        "minReps": 8355147212356982375,
+                        "percentage": 0.5114371920190389
+                     },
+                     "sets": [
+                        {
+                           "reps": 8,
+                           "restTime": 90,
+                           "weight": 80
+                        },
+                        {
+                           "reps": 8,
+                           "restTime": 90,
+                           "weight": 80
+                        }
+                     ]
+                  }
+               ],
+               "name": "Push day",
+               "position": 1,
+               "recurrence": "FREQ=WEEKLY;BYDAY=MO,TH",
+               "scheduledDates": [
+                  "2004-06-09",
+                  "1996-02-01",
+                  "2004-02-13",
+                  "2000-05-11"
+               ]
+            }
+         ]
+      },
+      "version": 1
+   }' --user-id "550e8400-e29b-41d4-a716-446655440000" --allow-unmatched false --token "Quis odit neque non eum molestiae voluptas."
+`, os.Args[0])
+}
+
+// userUsage displays the usage of the user command and its subcommands.
+func userUsage() {
+	fmt.Fprintf(os.Stderr, `User service for managing users
+Usage:
+    %[1]s [globalflags] user COMMAND [flags]
+
+COMMAND:
+    create: Create a new user
+    get: Get a user by ID
+    list: List users with pagination: every user for admins, the athletes they coach for the others
+    update: Update a user. Users update themselves and admins anyone; only admins change admin
+    patch: Change some fields of a user with a JSON Merge Patch (RFC 7396) body: members left out are unchanged, null clears nickname and resets timezone to UTC. Users patch themselves and admins anyone; only admins change admin
+    delete: Delete a user
+    restore: Restore a deleted user together with the plans deleted with them (admin only)
+    list-deleted: List the deleted users, most recently deleted first (admin only)
+
+Additional help:
+    %[1]s user COMMAND --help
+`, os.Args[0])
+}
+func userCreateUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] user create -body JSON -token STRING
+
+Create a new user
+    -body JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s user create --body '{
+      "admin": false,
+      "firstName": "John",
+      "lastName": "Doe",
+      "nickname": "JD",
+      "password": "Secret!1"
+   }' --token "Sapiente quo."
+`, os.Args[0])
+}
+
+func userGetUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] user get -id STRING -token STRING
+
+Get a user by ID
+    -id STRING: User ID
+    -token STRING: 
+
+Example:
+    %[1]s user get --id "f47ac10b-58cc-4372-a567-0e02b2c3d479" --token "Temporibus mollitia quam."
+`, os.Args[0])
+}
+
+func userListUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] user list -limit INT -offset INT -token STRING
+
+List users with pagination: every user for admins, the athletes they coach for the others
+    -limit INT: 
+    -offset INT: 
+    -token STRING: 
+
+Example:
+    %[1]s user list --limit 10 --offset 0 --token "Qui et accusamus saepe corporis officia quo."
+`, os.Args[0])
+}
+
+func userUpdateUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] user update -body JSON -id STRING -if-match STRING -token STRING
+
+Update a user. Users update themselves and admins anyone; only admins change admin
+    -body JSON: 
+    -id STRING: User ID
+    -if-match STRING: 
+    -token STRING: 
+
+Example:
+    %[1]s user update --body '{
+      "admin": false,
+      "firstName": "John",
+      "lastName": "Doe",
+      "nickname": "JD",
+      "timezone": "Europe/Rome"
+   }' --id "f47ac10b-58cc-4372-a567-0e02b2c3d479" --if-match "\"3\"" --token "Id numquam est."
+`, os.Args[0])
+}
+
+func userPatchUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] user patch -id STRING -if-match STRING -token STRING -stream STRING
+
+Change some fields of a user with a JSON Merge Patch (RFC 7396) body: members left out are unchanged, null clears nickname and resets timezone to UTC. Users patch themselves and admins anyone; only admins change admin
+    -id STRING: User ID
+    -if-match STRING: 
+    -token STRING: 
+    -stream STRING: path to file containing the streamed request body
+
+Example:
+    %[1]s user patch --id "f47ac10b-58cc-4372-a567-0e02b2c3d479" --if-match "\"3\"" --token "Dolorum quia reiciendis illo dignissimos vero officia." --stream "goa.png"
+`, os.Args[0])
+}
+
+func userDeleteUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] user delete -id STRING -if-match STRING -token STRING
+
+Delete a user
+    -id STRING: User ID
+    -if-match STRING: 
+    -token STRING: 
+
+Example:
+    %[1]s user delete --id "f47ac10b-58cc-4372-a567-0e02b2c3d479" --if-match "\"3\"" --token "Nostrum error sit."
+`, os.Args[0])
+}
+
+func userRestoreUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] user restore -id STRING -token STRING
+
+Restore a deleted user together with the plans deleted with them (admin only)
+    -id STRING: User ID
+    -token STRING: 
+
+Example:
+    %[1]s user restore --id "f47ac10b-58cc-4372-a567-0e02b2c3d479" --token "Dolores voluptatem amet voluptas nihil ad."
+`, os.Args[0])
+}
+
+func userListDeletedUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] user list-deleted -limit INT -offset INT -token STRING
+
+List the deleted users, most recently deleted first (admin only)
+    -limit INT: 
+    -offset INT: 
+    -token STRING: 
+
+Example:
+    %[1]s user list-deleted --limit 10 --offset 0 --token "Blanditiis quaerat consectetur voluptates sint eligendi."
+`, os.Args[0])
+}
+
+// webhookUsage displays the usage of the webhook command and its subcommands.
+func webhookUsage() {
+	fmt.Fprintf(os.Stderr, `Outgoing webhook subscriptions with signed deliveries
+Usage:
+    %[1]s [globalflags] webhook COMMAND [flags]
+
+COMMAND:
+    create: Register a callback URL for a set of event types
+    list: List the caller's webhook subscriptions
+    delete: Delete a webhook subscription
+    deliveries: Delivery history of a webhook subscription
+    redeliver: Queue a dead-lettered delivery for another round of attempts
+
+Additional help:
+    %[1]s webhook COMMAND --help
+`, os.Args[0])
+}
+func webhookCreateUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] webhook create -body JSON -token STRING
+
+Register a callback URL for a set of event types
+    -body JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s webhook create --body '{
+      "eventTypes": [
+         "SetLogged"
+      ],
+      "url": "https://coach.example.com/hooks/ld"
+   }' --token "Fugiat officiis laudantium ipsum."
+`, os.Args[0])
+}
+
+func webhookListUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] webhook list -token STRING
+
+List the caller's webhook subscriptions
+    -token STRING: 
+
+Example:
+    %[1]s webhook list --token "Occaecati consectetur a quo id quia."
+`, os.Args[0])
+}
+
+func webhookDeleteUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] webhook delete -id STRING -token STRING
+
+Delete a webhook subscription
+    -id STRING: Subscription ID
+    -token STRING: 
+
+Example:
+    %[1]s webhook delete --id "a075265b-8421-4237-8ed6-4528c570330b" --token "Saepe sunt eum."
+`, os.Args[0])
+}
+
+func webhookDeliveriesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] webhook deliveries -id STRING -status STRING -limit INT -offset INT -token STRING
+
+Delivery history of a webhook subscription
+    -id STRING: Subscription ID
+    -status STRING: 
+    -limit INT: 
+    -offset INT: 
+    -token STRING: 
+
+Example:
+    %[1]s webhook deliveries --id "d708b117-8740-4e2b-a76c-3b45db5fe1f7" --status "dead" --limit 10 --offset 0 --token "In est qui."
+`, os.Args[0])
+}
+
+func webhookRedeliverUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] webhook redeliver -id STRING -delivery-id STRING -token STRING
+
+Queue a dead-lettered delivery for another round of attempts
+    -id STRING: Subscription ID
+    -delivery-id STRING: Delivery ID
+    -token STRING: 
+
+Example:
+    %[1]s webhook redeliver --id "f586c174-74af-4f44-9564-07b07944253b" --delivery-id "5c3d33a0-d7f8-4368-bb03-b1c0b8ab5cdd" --token "Deleniti ratione minima."
+`, os.Args[0])
+}
+
+// workoutSessionUsage displays the usage of the workout-session command and
+// its subcommands.
+func workoutSessionUsage() {
+	fmt.Fprintf(os.Stderr, `Tracking of performed workouts against the plan
+Usage:
+    %[1]s [globalflags] workout-session COMMAND [flags]
+
+COMMAND:
+    start: Start a session of a planned workout, snapshotting its exercises and sets
+    get: Get a session with its planned and performed sets
+    list: List the caller's sessions, or those of one of their athletes
+    log: Log a performed set, either against a planned set or as an extra set
+    finish: Finish an in-progress session
+    abandon: Abandon an in-progress session
+
+Additional help:
+    %[1]s workout-session COMMAND --help
+`, os.Args[0])
+}
+func workoutSessionStartUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] workout-session start -body JSON -token STRING
+
+Start a session of a planned workout, snapshotting its exercises and sets
+    -body JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s workout-session start --body '{
+      "notes": "Felt strong today",
+      "workoutId": "7a8b9c0d-1e2f-4a3b-9c4d-5e6f7a8b9c0d"
+   }' --token "Illum et quasi earum minus."
+`, os.Args[0])
+}
+
+func workoutSessionGetUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] workout-session get -id STRING -token STRING
+
+Get a session with its planned and performed sets
+    -id STRING: Session ID
+    -token STRING: 
+
+Example:
+    %[1]s workout-session get --id "d9bc565f-34a1-47b8-bc31-7be4fd41794a" --token "Architecto consequatur."
+`, os.Args[0])
+}
+
+func workoutSessionListUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] workout-session list -user-id STRING -workout-id STRING -status STRING -limit INT -offset INT -token STRING
+
+List the caller's sessions, or those of one of their athletes
+    -user-id STRING: 
+    -workout-id STRING: 
+    -status STRING: 
+    -limit INT: 
+    -offset INT: 
+    -token STRING: 
+
+Example:
+    %[1]s workout-session list --user-id "61fd44ba-b106-49e7-b81d-389e4ce5480d" --workout-id "bae71249-ded5-4eba-9a8f-629f69f032cd" --status "in_progress" --limit 10 --offset 0 --token "Distinctio sint fuga hic quia."
+`, os.Args[0])
+}
+
+func workoutSessionLogUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] workout-session log -body JSON -id STRING -token STRING
+
+Log a performed set, either against a planned set or as an extra set
+    -body JSON: 
+    -id STRING: Session ID
+    -token STRING: 
+
+Example:
+    %[1]s workout-session log --body '{
+      "reps": 7,
+      "restTime": 120,
+      "sessionExerciseId": "49de5f7e-3319-43c3-a2f5-62687a21489e",
+      "setId": "03919ce0-f661-4001-bdc6-5b12dddfdfbb",
+      "weight": 80
+   }' --id "1ba9b362-bb80-4483-9f3e-4a064f0f36d4" --token "Rem ut soluta hic sequi quia numquam."
+`, os.Args[0])
+}
+
+func workoutSessionFinishUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] workout-session finish -body JSON -id STRING -token STRING
+
+Finish an in-progress session
+    -body JSON: 
+    -id STRING: Session ID
+    -token STRING: 
+
+Example:
+    %[1]s workout-session finish --body '{
+      "notes": "Last set was a grind"
+   }' --id "98a653e3-7c01-4ac1-b0ed-77ce898d5de3" --token "Laudantium ea ipsa quibusdam eligendi et."
+`, os.Args[0])
+}
+
+func workoutSessionAbandonUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] workout-session abandon -body JSON -id STRING -token STRING
+
+Abandon an in-progress session
+    -body JSON: 
+    -id STRING: Session ID
     -token STRING: 
 
 Example:
-    %[1]s user delete --id "f47ac10b-58cc-4372-a567-0e02b2c3d479" --token "Eum enim et maiores."
+    %[1]s workout-session abandon --body '{
+      "notes": "Shoulder pain"
+   }' --id "029d2052-ee1c-4fa4-ad33-c1853a7317ce" --token "Ullam et vitae."
 `, os.Args[0])
 }
@@ -0,0 +1,177 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// coaching HTTP client CLI support package
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	coaching "be/gen/coaching"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildInvitePayload builds the payload for the coaching invite endpoint from
+// CLI flags.
+func BuildInvitePayload(coachingInviteBody string, coachingInviteToken string) (*coaching.InvitePayload, error) {
+	var err error
+	var body InviteRequestBody
+	{
+		err = json.Unmarshal([]byte(coachingInviteBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"athleteId\": \"f47ac10b-58cc-4372-a567-0e02b2c3d479\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.athleteId", body.AthleteID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if coachingInviteToken != "" {
+			token = &coachingInviteToken
+		}
+	}
+	v := &coaching.InvitePayload{
+		AthleteID: body.AthleteID,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildAcceptPayload builds the payload for the coaching accept endpoint from
+// CLI flags.
+func BuildAcceptPayload(coachingAcceptID string, coachingAcceptToken string) (*coaching.AcceptPayload, error) {
+	var err error
+	var id string
+	{
+		id = coachingAcceptID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if coachingAcceptToken != "" {
+			token = &coachingAcceptToken
+		}
+	}
+	v := &coaching.AcceptPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRevokePayload builds the payload for the coaching revoke endpoint from
+// CLI flags.
+func BuildRevokePayload(coachingRevokeID string, coachingRevokeToken string) (*coaching.RevokePayload, error) {
+	var err error
+	var id string
+	{
+		id = coachingRevokeID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if coachingRevokeToken != "" {
+			token = &coachingRevokeToken
+		}
+	}
+	v := &coaching.RevokePayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListPayload builds the payload for the coaching list endpoint from CLI
+// flags.
+func BuildListPayload(coachingListRole string, coachingListStatus string, coachingListLimit string, coachingListOffset string, coachingListToken string) (*coaching.ListPayload, error) {
+	var err error
+	var role *string
+	{
+		if coachingListRole != "" {
+			role = &coachingListRole
+			if !(*role == "coach" || *role == "athlete") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("role", *role, []any{"coach", "athlete"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var status *string
+	{
+		if coachingListStatus != "" {
+			status = &coachingListStatus
+			if !(*status == "pending" || *status == "active" || *status == "revoked") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"pending", "active", "revoked"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if coachingListLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(coachingListLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var offset int
+	{
+		if coachingListOffset != "" {
+			var v int64
+			v, err = strconv.ParseInt(coachingListOffset, 10, strconv.IntSize)
+			offset = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for offset, must be INT")
+			}
+			if offset < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("offset", offset, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if coachingListToken != "" {
+			token = &coachingListToken
+		}
+	}
+	v := &coaching.ListPayload{}
+	v.Role = role
+	v.Status = status
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v, nil
+}
@@ -0,0 +1,158 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// coaching client HTTP transport
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the coaching service endpoint HTTP clients.
+type Client struct {
+	// Invite Doer is the HTTP client used to make requests to the invite endpoint.
+	InviteDoer goahttp.Doer
+
+	// Accept Doer is the HTTP client used to make requests to the accept endpoint.
+	AcceptDoer goahttp.Doer
+
+	// Revoke Doer is the HTTP client used to make requests to the revoke endpoint.
+	RevokeDoer goahttp.Doer
+
+	// List Doer is the HTTP client used to make requests to the list endpoint.
+	ListDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the coaching service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		InviteDoer:          doer,
+		AcceptDoer:          doer,
+		RevokeDoer:          doer,
+		ListDoer:            doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// Invite returns an endpoint that makes HTTP requests to the coaching service
+// invite server.
+func (c *Client) Invite() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeInviteRequest(c.encoder)
+		decodeResponse = DecodeInviteResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildInviteRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.InviteDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("coaching", "invite", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Accept returns an endpoint that makes HTTP requests to the coaching service
+// accept server.
+func (c *Client) Accept() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAcceptRequest(c.encoder)
+		decodeResponse = DecodeAcceptResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAcceptRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AcceptDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("coaching", "accept", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Revoke returns an endpoint that makes HTTP requests to the coaching service
+// revoke server.
+func (c *Client) Revoke() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRevokeRequest(c.encoder)
+		decodeResponse = DecodeRevokeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRevokeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RevokeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("coaching", "revoke", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// List returns an endpoint that makes HTTP requests to the coaching service
+// list server.
+func (c *Client) List() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListRequest(c.encoder)
+		decodeResponse = DecodeListResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("coaching", "list", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,701 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// coaching HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	coaching "be/gen/coaching"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildInviteRequest instantiates a HTTP request object with method and path
+// set to call the "coaching" service "invite" endpoint
+func (c *Client) BuildInviteRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: InviteCoachingPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("coaching", "invite", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeInviteRequest returns an encoder for requests sent to the coaching
+// invite server.
+func EncodeInviteRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*coaching.InvitePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("coaching", "invite", "*coaching.InvitePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewInviteRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("coaching", "invite", err)
+		}
+		return nil
+	}
+}
+
+// DecodeInviteResponse returns a decoder for responses returned by the
+// coaching invite endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeInviteResponse may return the following errors:
+//   - "badRequest" (type *coaching.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *coaching.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *coaching.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *coaching.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *coaching.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeInviteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body InviteResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "invite", err)
+			}
+			err = ValidateInviteResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "invite", err)
+			}
+			res := NewInviteCoachingRelationshipCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body InviteBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "invite", err)
+			}
+			err = ValidateInviteBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "invite", err)
+			}
+			return nil, NewInviteBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body InviteForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "invite", err)
+			}
+			err = ValidateInviteForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "invite", err)
+			}
+			return nil, NewInviteForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body InviteInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "invite", err)
+			}
+			err = ValidateInviteInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "invite", err)
+			}
+			return nil, NewInviteInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body InviteNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "invite", err)
+			}
+			err = ValidateInviteNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "invite", err)
+			}
+			return nil, NewInviteNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body InviteUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "invite", err)
+			}
+			err = ValidateInviteUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "invite", err)
+			}
+			return nil, NewInviteUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("coaching", "invite", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAcceptRequest instantiates a HTTP request object with method and path
+// set to call the "coaching" service "accept" endpoint
+func (c *Client) BuildAcceptRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*coaching.AcceptPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("coaching", "accept", "*coaching.AcceptPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AcceptCoachingPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("coaching", "accept", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAcceptRequest returns an encoder for requests sent to the coaching
+// accept server.
+func EncodeAcceptRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*coaching.AcceptPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("coaching", "accept", "*coaching.AcceptPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeAcceptResponse returns a decoder for responses returned by the
+// coaching accept endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeAcceptResponse may return the following errors:
+//   - "badRequest" (type *coaching.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *coaching.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *coaching.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *coaching.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *coaching.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeAcceptResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body AcceptResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "accept", err)
+			}
+			err = ValidateAcceptResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "accept", err)
+			}
+			res := NewAcceptCoachingRelationshipOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body AcceptBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "accept", err)
+			}
+			err = ValidateAcceptBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "accept", err)
+			}
+			return nil, NewAcceptBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body AcceptForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "accept", err)
+			}
+			err = ValidateAcceptForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "accept", err)
+			}
+			return nil, NewAcceptForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body AcceptInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "accept", err)
+			}
+			err = ValidateAcceptInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "accept", err)
+			}
+			return nil, NewAcceptInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body AcceptNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "accept", err)
+			}
+			err = ValidateAcceptNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "accept", err)
+			}
+			return nil, NewAcceptNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body AcceptUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "accept", err)
+			}
+			err = ValidateAcceptUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "accept", err)
+			}
+			return nil, NewAcceptUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("coaching", "accept", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRevokeRequest instantiates a HTTP request object with method and path
+// set to call the "coaching" service "revoke" endpoint
+func (c *Client) BuildRevokeRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*coaching.RevokePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("coaching", "revoke", "*coaching.RevokePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RevokeCoachingPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("coaching", "revoke", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRevokeRequest returns an encoder for requests sent to the coaching
+// revoke server.
+func EncodeRevokeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*coaching.RevokePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("coaching", "revoke", "*coaching.RevokePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeRevokeResponse returns a decoder for responses returned by the
+// coaching revoke endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeRevokeResponse may return the following errors:
+//   - "badRequest" (type *coaching.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *coaching.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *coaching.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *coaching.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *coaching.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRevokeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RevokeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "revoke", err)
+			}
+			err = ValidateRevokeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "revoke", err)
+			}
+			res := NewRevokeCoachingRelationshipOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body RevokeBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "revoke", err)
+			}
+			err = ValidateRevokeBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "revoke", err)
+			}
+			return nil, NewRevokeBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body RevokeForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "revoke", err)
+			}
+			err = ValidateRevokeForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "revoke", err)
+			}
+			return nil, NewRevokeForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RevokeInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "revoke", err)
+			}
+			err = ValidateRevokeInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "revoke", err)
+			}
+			return nil, NewRevokeInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body RevokeNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "revoke", err)
+			}
+			err = ValidateRevokeNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "revoke", err)
+			}
+			return nil, NewRevokeNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body RevokeUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "revoke", err)
+			}
+			err = ValidateRevokeUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "revoke", err)
+			}
+			return nil, NewRevokeUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("coaching", "revoke", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "coaching" service "list" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListCoachingPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("coaching", "list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListRequest returns an encoder for requests sent to the coaching list
+// server.
+func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*coaching.ListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("coaching", "list", "*coaching.ListPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Role != nil {
+			values.Add("role", *p.Role)
+		}
+		if p.Status != nil {
+			values.Add("status", *p.Status)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		values.Add("offset", fmt.Sprintf("%v", p.Offset))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListResponse returns a decoder for responses returned by the coaching
+// list endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeListResponse may return the following errors:
+//   - "badRequest" (type *coaching.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *coaching.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *coaching.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *coaching.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *coaching.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "list", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateCoachingRelationshipResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "list", err)
+			}
+			res := NewListCoachingRelationshipOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "list", err)
+			}
+			err = ValidateListBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "list", err)
+			}
+			return nil, NewListBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ListForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "list", err)
+			}
+			err = ValidateListForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "list", err)
+			}
+			return nil, NewListForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "list", err)
+			}
+			err = ValidateListInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "list", err)
+			}
+			return nil, NewListInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "list", err)
+			}
+			err = ValidateListNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "list", err)
+			}
+			return nil, NewListNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("coaching", "list", err)
+			}
+			err = ValidateListUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("coaching", "list", err)
+			}
+			return nil, NewListUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("coaching", "list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalFieldErrorResponseBodyToCoachingFieldError builds a value of type
+// *coaching.FieldError from a value of type *FieldErrorResponseBody.
+func unmarshalFieldErrorResponseBodyToCoachingFieldError(v *FieldErrorResponseBody) *coaching.FieldError {
+	if v == nil {
+		return nil
+	}
+	res := &coaching.FieldError{
+		Field:   *v.Field,
+		Message: *v.Message,
+	}
+
+	return res
+}
+
+// unmarshalCoachingRelationshipResponseToCoachingCoachingRelationship builds a
+// value of type *coaching.CoachingRelationship from a value of type
+// *CoachingRelationshipResponse.
+func unmarshalCoachingRelationshipResponseToCoachingCoachingRelationship(v *CoachingRelationshipResponse) *coaching.CoachingRelationship {
+	res := &coaching.CoachingRelationship{
+		ID:         *v.ID,
+		CoachID:    *v.CoachID,
+		AthleteID:  *v.AthleteID,
+		Status:     *v.Status,
+		CreatedAt:  *v.CreatedAt,
+		AcceptedAt: v.AcceptedAt,
+		RevokedAt:  v.RevokedAt,
+	}
+
+	return res
+}
@@ -0,0 +1,32 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// HTTP request path constructors for the coaching service.
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	"fmt"
+)
+
+// InviteCoachingPath returns the URL path to the coaching service invite HTTP endpoint.
+func InviteCoachingPath() string {
+	return "/api/v1/coaching/invitations"
+}
+
+// AcceptCoachingPath returns the URL path to the coaching service accept HTTP endpoint.
+func AcceptCoachingPath(id string) string {
+	return fmt.Sprintf("/api/v1/coaching/%v/accept", id)
+}
+
+// RevokeCoachingPath returns the URL path to the coaching service revoke HTTP endpoint.
+func RevokeCoachingPath(id string) string {
+	return fmt.Sprintf("/api/v1/coaching/%v/revoke", id)
+}
+
+// ListCoachingPath returns the URL path to the coaching service list HTTP endpoint.
+func ListCoachingPath() string {
+	return "/api/v1/coaching"
+}
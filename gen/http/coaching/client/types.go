@@ -0,0 +1,1052 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// coaching HTTP client types
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	coaching "be/gen/coaching"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// InviteRequestBody is the type of the "coaching" service "invite" endpoint
+// HTTP request body.
+type InviteRequestBody struct {
+	// Athlete to invite
+	AthleteID string `form:"athleteId" json:"athleteId" xml:"athleteId"`
+}
+
+// InviteResponseBody is the type of the "coaching" service "invite" endpoint
+// HTTP response body.
+type InviteResponseBody struct {
+	// Relationship ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Coach user ID
+	CoachID *string `form:"coachId,omitempty" json:"coachId,omitempty" xml:"coachId,omitempty"`
+	// Athlete user ID
+	AthleteID *string `form:"athleteId,omitempty" json:"athleteId,omitempty" xml:"athleteId,omitempty"`
+	// Relationship status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Invitation time
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Acceptance time
+	AcceptedAt *string `form:"acceptedAt,omitempty" json:"acceptedAt,omitempty" xml:"acceptedAt,omitempty"`
+	// Revocation time
+	RevokedAt *string `form:"revokedAt,omitempty" json:"revokedAt,omitempty" xml:"revokedAt,omitempty"`
+}
+
+// AcceptResponseBody is the type of the "coaching" service "accept" endpoint
+// HTTP response body.
+type AcceptResponseBody struct {
+	// Relationship ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Coach user ID
+	CoachID *string `form:"coachId,omitempty" json:"coachId,omitempty" xml:"coachId,omitempty"`
+	// Athlete user ID
+	AthleteID *string `form:"athleteId,omitempty" json:"athleteId,omitempty" xml:"athleteId,omitempty"`
+	// Relationship status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Invitation time
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Acceptance time
+	AcceptedAt *string `form:"acceptedAt,omitempty" json:"acceptedAt,omitempty" xml:"acceptedAt,omitempty"`
+	// Revocation time
+	RevokedAt *string `form:"revokedAt,omitempty" json:"revokedAt,omitempty" xml:"revokedAt,omitempty"`
+}
+
+// RevokeResponseBody is the type of the "coaching" service "revoke" endpoint
+// HTTP response body.
+type RevokeResponseBody struct {
+	// Relationship ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Coach user ID
+	CoachID *string `form:"coachId,omitempty" json:"coachId,omitempty" xml:"coachId,omitempty"`
+	// Athlete user ID
+	AthleteID *string `form:"athleteId,omitempty" json:"athleteId,omitempty" xml:"athleteId,omitempty"`
+	// Relationship status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Invitation time
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Acceptance time
+	AcceptedAt *string `form:"acceptedAt,omitempty" json:"acceptedAt,omitempty" xml:"acceptedAt,omitempty"`
+	// Revocation time
+	RevokedAt *string `form:"revokedAt,omitempty" json:"revokedAt,omitempty" xml:"revokedAt,omitempty"`
+}
+
+// ListResponseBody is the type of the "coaching" service "list" endpoint HTTP
+// response body.
+type ListResponseBody []*CoachingRelationshipResponse
+
+// InviteBadRequestResponseBody is the type of the "coaching" service "invite"
+// endpoint HTTP response body for the "badRequest" error.
+type InviteBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// InviteForbiddenResponseBody is the type of the "coaching" service "invite"
+// endpoint HTTP response body for the "forbidden" error.
+type InviteForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// InviteInternalServerErrorResponseBody is the type of the "coaching" service
+// "invite" endpoint HTTP response body for the "internalServerError" error.
+type InviteInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// InviteNotFoundResponseBody is the type of the "coaching" service "invite"
+// endpoint HTTP response body for the "notFound" error.
+type InviteNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// InviteUnauthorizedResponseBody is the type of the "coaching" service
+// "invite" endpoint HTTP response body for the "unauthorized" error.
+type InviteUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AcceptBadRequestResponseBody is the type of the "coaching" service "accept"
+// endpoint HTTP response body for the "badRequest" error.
+type AcceptBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// AcceptForbiddenResponseBody is the type of the "coaching" service "accept"
+// endpoint HTTP response body for the "forbidden" error.
+type AcceptForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AcceptInternalServerErrorResponseBody is the type of the "coaching" service
+// "accept" endpoint HTTP response body for the "internalServerError" error.
+type AcceptInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AcceptNotFoundResponseBody is the type of the "coaching" service "accept"
+// endpoint HTTP response body for the "notFound" error.
+type AcceptNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AcceptUnauthorizedResponseBody is the type of the "coaching" service
+// "accept" endpoint HTTP response body for the "unauthorized" error.
+type AcceptUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RevokeBadRequestResponseBody is the type of the "coaching" service "revoke"
+// endpoint HTTP response body for the "badRequest" error.
+type RevokeBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// RevokeForbiddenResponseBody is the type of the "coaching" service "revoke"
+// endpoint HTTP response body for the "forbidden" error.
+type RevokeForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RevokeInternalServerErrorResponseBody is the type of the "coaching" service
+// "revoke" endpoint HTTP response body for the "internalServerError" error.
+type RevokeInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RevokeNotFoundResponseBody is the type of the "coaching" service "revoke"
+// endpoint HTTP response body for the "notFound" error.
+type RevokeNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RevokeUnauthorizedResponseBody is the type of the "coaching" service
+// "revoke" endpoint HTTP response body for the "unauthorized" error.
+type RevokeUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListBadRequestResponseBody is the type of the "coaching" service "list"
+// endpoint HTTP response body for the "badRequest" error.
+type ListBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListForbiddenResponseBody is the type of the "coaching" service "list"
+// endpoint HTTP response body for the "forbidden" error.
+type ListForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListInternalServerErrorResponseBody is the type of the "coaching" service
+// "list" endpoint HTTP response body for the "internalServerError" error.
+type ListInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListNotFoundResponseBody is the type of the "coaching" service "list"
+// endpoint HTTP response body for the "notFound" error.
+type ListNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListUnauthorizedResponseBody is the type of the "coaching" service "list"
+// endpoint HTTP response body for the "unauthorized" error.
+type ListUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+	// Motivo per cui il campo non è valido
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CoachingRelationshipResponse is used to define fields on response body types.
+type CoachingRelationshipResponse struct {
+	// Relationship ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Coach user ID
+	CoachID *string `form:"coachId,omitempty" json:"coachId,omitempty" xml:"coachId,omitempty"`
+	// Athlete user ID
+	AthleteID *string `form:"athleteId,omitempty" json:"athleteId,omitempty" xml:"athleteId,omitempty"`
+	// Relationship status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Invitation time
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Acceptance time
+	AcceptedAt *string `form:"acceptedAt,omitempty" json:"acceptedAt,omitempty" xml:"acceptedAt,omitempty"`
+	// Revocation time
+	RevokedAt *string `form:"revokedAt,omitempty" json:"revokedAt,omitempty" xml:"revokedAt,omitempty"`
+}
+
+// NewInviteRequestBody builds the HTTP request body from the payload of the
+// "invite" endpoint of the "coaching" service.
+func NewInviteRequestBody(p *coaching.InvitePayload) *InviteRequestBody {
+	body := &InviteRequestBody{
+		AthleteID: p.AthleteID,
+	}
+	return body
+}
+
+// NewInviteCoachingRelationshipCreated builds a "coaching" service "invite"
+// endpoint result from a HTTP "Created" response.
+func NewInviteCoachingRelationshipCreated(body *InviteResponseBody) *coaching.CoachingRelationship {
+	v := &coaching.CoachingRelationship{
+		ID:         *body.ID,
+		CoachID:    *body.CoachID,
+		AthleteID:  *body.AthleteID,
+		Status:     *body.Status,
+		CreatedAt:  *body.CreatedAt,
+		AcceptedAt: body.AcceptedAt,
+		RevokedAt:  body.RevokedAt,
+	}
+
+	return v
+}
+
+// NewInviteBadRequest builds a coaching service invite endpoint badRequest
+// error.
+func NewInviteBadRequest(body *InviteBadRequestResponseBody) *coaching.BadRequest {
+	v := &coaching.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*coaching.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToCoachingFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewInviteForbidden builds a coaching service invite endpoint forbidden error.
+func NewInviteForbidden(body *InviteForbiddenResponseBody) *coaching.Forbidden {
+	v := &coaching.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewInviteInternalServerError builds a coaching service invite endpoint
+// internalServerError error.
+func NewInviteInternalServerError(body *InviteInternalServerErrorResponseBody) *coaching.InternalServerError {
+	v := &coaching.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewInviteNotFound builds a coaching service invite endpoint notFound error.
+func NewInviteNotFound(body *InviteNotFoundResponseBody) *coaching.NotFound {
+	v := &coaching.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewInviteUnauthorized builds a coaching service invite endpoint unauthorized
+// error.
+func NewInviteUnauthorized(body *InviteUnauthorizedResponseBody) *coaching.Unauthorized {
+	v := &coaching.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewAcceptCoachingRelationshipOK builds a "coaching" service "accept"
+// endpoint result from a HTTP "OK" response.
+func NewAcceptCoachingRelationshipOK(body *AcceptResponseBody) *coaching.CoachingRelationship {
+	v := &coaching.CoachingRelationship{
+		ID:         *body.ID,
+		CoachID:    *body.CoachID,
+		AthleteID:  *body.AthleteID,
+		Status:     *body.Status,
+		CreatedAt:  *body.CreatedAt,
+		AcceptedAt: body.AcceptedAt,
+		RevokedAt:  body.RevokedAt,
+	}
+
+	return v
+}
+
+// NewAcceptBadRequest builds a coaching service accept endpoint badRequest
+// error.
+func NewAcceptBadRequest(body *AcceptBadRequestResponseBody) *coaching.BadRequest {
+	v := &coaching.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*coaching.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToCoachingFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewAcceptForbidden builds a coaching service accept endpoint forbidden error.
+func NewAcceptForbidden(body *AcceptForbiddenResponseBody) *coaching.Forbidden {
+	v := &coaching.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewAcceptInternalServerError builds a coaching service accept endpoint
+// internalServerError error.
+func NewAcceptInternalServerError(body *AcceptInternalServerErrorResponseBody) *coaching.InternalServerError {
+	v := &coaching.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewAcceptNotFound builds a coaching service accept endpoint notFound error.
+func NewAcceptNotFound(body *AcceptNotFoundResponseBody) *coaching.NotFound {
+	v := &coaching.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewAcceptUnauthorized builds a coaching service accept endpoint unauthorized
+// error.
+func NewAcceptUnauthorized(body *AcceptUnauthorizedResponseBody) *coaching.Unauthorized {
+	v := &coaching.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRevokeCoachingRelationshipOK builds a "coaching" service "revoke"
+// endpoint result from a HTTP "OK" response.
+func NewRevokeCoachingRelationshipOK(body *RevokeResponseBody) *coaching.CoachingRelationship {
+	v := &coaching.CoachingRelationship{
+		ID:         *body.ID,
+		CoachID:    *body.CoachID,
+		AthleteID:  *body.AthleteID,
+		Status:     *body.Status,
+		CreatedAt:  *body.CreatedAt,
+		AcceptedAt: body.AcceptedAt,
+		RevokedAt:  body.RevokedAt,
+	}
+
+	return v
+}
+
+// NewRevokeBadRequest builds a coaching service revoke endpoint badRequest
+// error.
+func NewRevokeBadRequest(body *RevokeBadRequestResponseBody) *coaching.BadRequest {
+	v := &coaching.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*coaching.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToCoachingFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewRevokeForbidden builds a coaching service revoke endpoint forbidden error.
+func NewRevokeForbidden(body *RevokeForbiddenResponseBody) *coaching.Forbidden {
+	v := &coaching.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRevokeInternalServerError builds a coaching service revoke endpoint
+// internalServerError error.
+func NewRevokeInternalServerError(body *RevokeInternalServerErrorResponseBody) *coaching.InternalServerError {
+	v := &coaching.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRevokeNotFound builds a coaching service revoke endpoint notFound error.
+func NewRevokeNotFound(body *RevokeNotFoundResponseBody) *coaching.NotFound {
+	v := &coaching.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRevokeUnauthorized builds a coaching service revoke endpoint unauthorized
+// error.
+func NewRevokeUnauthorized(body *RevokeUnauthorizedResponseBody) *coaching.Unauthorized {
+	v := &coaching.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListCoachingRelationshipOK builds a "coaching" service "list" endpoint
+// result from a HTTP "OK" response.
+func NewListCoachingRelationshipOK(body []*CoachingRelationshipResponse) []*coaching.CoachingRelationship {
+	v := make([]*coaching.CoachingRelationship, len(body))
+	for i, val := range body {
+		v[i] = unmarshalCoachingRelationshipResponseToCoachingCoachingRelationship(val)
+	}
+
+	return v
+}
+
+// NewListBadRequest builds a coaching service list endpoint badRequest error.
+func NewListBadRequest(body *ListBadRequestResponseBody) *coaching.BadRequest {
+	v := &coaching.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*coaching.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToCoachingFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewListForbidden builds a coaching service list endpoint forbidden error.
+func NewListForbidden(body *ListForbiddenResponseBody) *coaching.Forbidden {
+	v := &coaching.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListInternalServerError builds a coaching service list endpoint
+// internalServerError error.
+func NewListInternalServerError(body *ListInternalServerErrorResponseBody) *coaching.InternalServerError {
+	v := &coaching.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListNotFound builds a coaching service list endpoint notFound error.
+func NewListNotFound(body *ListNotFoundResponseBody) *coaching.NotFound {
+	v := &coaching.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListUnauthorized builds a coaching service list endpoint unauthorized
+// error.
+func NewListUnauthorized(body *ListUnauthorizedResponseBody) *coaching.Unauthorized {
+	v := &coaching.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// ValidateInviteResponseBody runs the validations defined on InviteResponseBody
+func ValidateInviteResponseBody(body *InviteResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.CoachID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("coachId", "body"))
+	}
+	if body.AthleteID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("athleteId", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.CoachID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.coachId", *body.CoachID, goa.FormatUUID))
+	}
+	if body.AthleteID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.athleteId", *body.AthleteID, goa.FormatUUID))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "active" || *body.Status == "revoked") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "active", "revoked"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.AcceptedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.acceptedAt", *body.AcceptedAt, goa.FormatDateTime))
+	}
+	if body.RevokedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.revokedAt", *body.RevokedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateAcceptResponseBody runs the validations defined on AcceptResponseBody
+func ValidateAcceptResponseBody(body *AcceptResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.CoachID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("coachId", "body"))
+	}
+	if body.AthleteID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("athleteId", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.CoachID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.coachId", *body.CoachID, goa.FormatUUID))
+	}
+	if body.AthleteID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.athleteId", *body.AthleteID, goa.FormatUUID))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "active" || *body.Status == "revoked") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "active", "revoked"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.AcceptedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.acceptedAt", *body.AcceptedAt, goa.FormatDateTime))
+	}
+	if body.RevokedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.revokedAt", *body.RevokedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateRevokeResponseBody runs the validations defined on RevokeResponseBody
+func ValidateRevokeResponseBody(body *RevokeResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.CoachID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("coachId", "body"))
+	}
+	if body.AthleteID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("athleteId", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.CoachID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.coachId", *body.CoachID, goa.FormatUUID))
+	}
+	if body.AthleteID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.athleteId", *body.AthleteID, goa.FormatUUID))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "active" || *body.Status == "revoked") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "active", "revoked"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.AcceptedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.acceptedAt", *body.AcceptedAt, goa.FormatDateTime))
+	}
+	if body.RevokedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.revokedAt", *body.RevokedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateInviteBadRequestResponseBody runs the validations defined on
+// invite_badRequest_response_body
+func ValidateInviteBadRequestResponseBody(body *InviteBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateInviteForbiddenResponseBody runs the validations defined on
+// invite_forbidden_response_body
+func ValidateInviteForbiddenResponseBody(body *InviteForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateInviteInternalServerErrorResponseBody runs the validations defined
+// on invite_internalServerError_response_body
+func ValidateInviteInternalServerErrorResponseBody(body *InviteInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateInviteNotFoundResponseBody runs the validations defined on
+// invite_notFound_response_body
+func ValidateInviteNotFoundResponseBody(body *InviteNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateInviteUnauthorizedResponseBody runs the validations defined on
+// invite_unauthorized_response_body
+func ValidateInviteUnauthorizedResponseBody(body *InviteUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateAcceptBadRequestResponseBody runs the validations defined on
+// accept_badRequest_response_body
+func ValidateAcceptBadRequestResponseBody(body *AcceptBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAcceptForbiddenResponseBody runs the validations defined on
+// accept_forbidden_response_body
+func ValidateAcceptForbiddenResponseBody(body *AcceptForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateAcceptInternalServerErrorResponseBody runs the validations defined
+// on accept_internalServerError_response_body
+func ValidateAcceptInternalServerErrorResponseBody(body *AcceptInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateAcceptNotFoundResponseBody runs the validations defined on
+// accept_notFound_response_body
+func ValidateAcceptNotFoundResponseBody(body *AcceptNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateAcceptUnauthorizedResponseBody runs the validations defined on
+// accept_unauthorized_response_body
+func ValidateAcceptUnauthorizedResponseBody(body *AcceptUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRevokeBadRequestResponseBody runs the validations defined on
+// revoke_badRequest_response_body
+func ValidateRevokeBadRequestResponseBody(body *RevokeBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRevokeForbiddenResponseBody runs the validations defined on
+// revoke_forbidden_response_body
+func ValidateRevokeForbiddenResponseBody(body *RevokeForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRevokeInternalServerErrorResponseBody runs the validations defined
+// on revoke_internalServerError_response_body
+func ValidateRevokeInternalServerErrorResponseBody(body *RevokeInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRevokeNotFoundResponseBody runs the validations defined on
+// revoke_notFound_response_body
+func ValidateRevokeNotFoundResponseBody(body *RevokeNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRevokeUnauthorizedResponseBody runs the validations defined on
+// revoke_unauthorized_response_body
+func ValidateRevokeUnauthorizedResponseBody(body *RevokeUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListBadRequestResponseBody runs the validations defined on
+// list_badRequest_response_body
+func ValidateListBadRequestResponseBody(body *ListBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListForbiddenResponseBody runs the validations defined on
+// list_forbidden_response_body
+func ValidateListForbiddenResponseBody(body *ListForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListInternalServerErrorResponseBody runs the validations defined on
+// list_internalServerError_response_body
+func ValidateListInternalServerErrorResponseBody(body *ListInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListNotFoundResponseBody runs the validations defined on
+// list_notFound_response_body
+func ValidateListNotFoundResponseBody(body *ListNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListUnauthorizedResponseBody runs the validations defined on
+// list_unauthorized_response_body
+func ValidateListUnauthorizedResponseBody(body *ListUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFieldErrorResponseBody runs the validations defined on
+// FieldErrorResponseBody
+func ValidateFieldErrorResponseBody(body *FieldErrorResponseBody) (err error) {
+	if body.Field == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("field", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCoachingRelationshipResponse runs the validations defined on
+// CoachingRelationshipResponse
+func ValidateCoachingRelationshipResponse(body *CoachingRelationshipResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.CoachID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("coachId", "body"))
+	}
+	if body.AthleteID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("athleteId", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.CoachID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.coachId", *body.CoachID, goa.FormatUUID))
+	}
+	if body.AthleteID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.athleteId", *body.AthleteID, goa.FormatUUID))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "active" || *body.Status == "revoked") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "active", "revoked"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.AcceptedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.acceptedAt", *body.AcceptedAt, goa.FormatDateTime))
+	}
+	if body.RevokedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.revokedAt", *body.RevokedAt, goa.FormatDateTime))
+	}
+	return
+}
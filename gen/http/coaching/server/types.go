@@ -0,0 +1,626 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// coaching HTTP server types
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	coaching "be/gen/coaching"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// InviteRequestBody is the type of the "coaching" service "invite" endpoint
+// HTTP request body.
+type InviteRequestBody struct {
+	// Athlete to invite
+	AthleteID *string `form:"athleteId,omitempty" json:"athleteId,omitempty" xml:"athleteId,omitempty"`
+}
+
+// InviteResponseBody is the type of the "coaching" service "invite" endpoint
+// HTTP response body.
+type InviteResponseBody struct {
+	// Relationship ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Coach user ID
+	CoachID string `form:"coachId" json:"coachId" xml:"coachId"`
+	// Athlete user ID
+	AthleteID string `form:"athleteId" json:"athleteId" xml:"athleteId"`
+	// Relationship status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Invitation time
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// Acceptance time
+	AcceptedAt *string `form:"acceptedAt,omitempty" json:"acceptedAt,omitempty" xml:"acceptedAt,omitempty"`
+	// Revocation time
+	RevokedAt *string `form:"revokedAt,omitempty" json:"revokedAt,omitempty" xml:"revokedAt,omitempty"`
+}
+
+// AcceptResponseBody is the type of the "coaching" service "accept" endpoint
+// HTTP response body.
+type AcceptResponseBody struct {
+	// Relationship ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Coach user ID
+	CoachID string `form:"coachId" json:"coachId" xml:"coachId"`
+	// Athlete user ID
+	AthleteID string `form:"athleteId" json:"athleteId" xml:"athleteId"`
+	// Relationship status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Invitation time
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// Acceptance time
+	AcceptedAt *string `form:"acceptedAt,omitempty" json:"acceptedAt,omitempty" xml:"acceptedAt,omitempty"`
+	// Revocation time
+	RevokedAt *string `form:"revokedAt,omitempty" json:"revokedAt,omitempty" xml:"revokedAt,omitempty"`
+}
+
+// RevokeResponseBody is the type of the "coaching" service "revoke" endpoint
+// HTTP response body.
+type RevokeResponseBody struct {
+	// Relationship ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Coach user ID
+	CoachID string `form:"coachId" json:"coachId" xml:"coachId"`
+	// Athlete user ID
+	AthleteID string `form:"athleteId" json:"athleteId" xml:"athleteId"`
+	// Relationship status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Invitation time
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// Acceptance time
+	AcceptedAt *string `form:"acceptedAt,omitempty" json:"acceptedAt,omitempty" xml:"acceptedAt,omitempty"`
+	// Revocation time
+	RevokedAt *string `form:"revokedAt,omitempty" json:"revokedAt,omitempty" xml:"revokedAt,omitempty"`
+}
+
+// ListResponseBody is the type of the "coaching" service "list" endpoint HTTP
+// response body.
+type ListResponseBody []*CoachingRelationshipResponse
+
+// InviteBadRequestResponseBody is the type of the "coaching" service "invite"
+// endpoint HTTP response body for the "badRequest" error.
+type InviteBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// InviteForbiddenResponseBody is the type of the "coaching" service "invite"
+// endpoint HTTP response body for the "forbidden" error.
+type InviteForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// InviteInternalServerErrorResponseBody is the type of the "coaching" service
+// "invite" endpoint HTTP response body for the "internalServerError" error.
+type InviteInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// InviteNotFoundResponseBody is the type of the "coaching" service "invite"
+// endpoint HTTP response body for the "notFound" error.
+type InviteNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// InviteUnauthorizedResponseBody is the type of the "coaching" service
+// "invite" endpoint HTTP response body for the "unauthorized" error.
+type InviteUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AcceptBadRequestResponseBody is the type of the "coaching" service "accept"
+// endpoint HTTP response body for the "badRequest" error.
+type AcceptBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// AcceptForbiddenResponseBody is the type of the "coaching" service "accept"
+// endpoint HTTP response body for the "forbidden" error.
+type AcceptForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AcceptInternalServerErrorResponseBody is the type of the "coaching" service
+// "accept" endpoint HTTP response body for the "internalServerError" error.
+type AcceptInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AcceptNotFoundResponseBody is the type of the "coaching" service "accept"
+// endpoint HTTP response body for the "notFound" error.
+type AcceptNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AcceptUnauthorizedResponseBody is the type of the "coaching" service
+// "accept" endpoint HTTP response body for the "unauthorized" error.
+type AcceptUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RevokeBadRequestResponseBody is the type of the "coaching" service "revoke"
+// endpoint HTTP response body for the "badRequest" error.
+type RevokeBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// RevokeForbiddenResponseBody is the type of the "coaching" service "revoke"
+// endpoint HTTP response body for the "forbidden" error.
+type RevokeForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RevokeInternalServerErrorResponseBody is the type of the "coaching" service
+// "revoke" endpoint HTTP response body for the "internalServerError" error.
+type RevokeInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RevokeNotFoundResponseBody is the type of the "coaching" service "revoke"
+// endpoint HTTP response body for the "notFound" error.
+type RevokeNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RevokeUnauthorizedResponseBody is the type of the "coaching" service
+// "revoke" endpoint HTTP response body for the "unauthorized" error.
+type RevokeUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListBadRequestResponseBody is the type of the "coaching" service "list"
+// endpoint HTTP response body for the "badRequest" error.
+type ListBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListForbiddenResponseBody is the type of the "coaching" service "list"
+// endpoint HTTP response body for the "forbidden" error.
+type ListForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListInternalServerErrorResponseBody is the type of the "coaching" service
+// "list" endpoint HTTP response body for the "internalServerError" error.
+type ListInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListNotFoundResponseBody is the type of the "coaching" service "list"
+// endpoint HTTP response body for the "notFound" error.
+type ListNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListUnauthorizedResponseBody is the type of the "coaching" service "list"
+// endpoint HTTP response body for the "unauthorized" error.
+type ListUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field string `form:"field" json:"field" xml:"field"`
+	// Motivo per cui il campo non è valido
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CoachingRelationshipResponse is used to define fields on response body types.
+type CoachingRelationshipResponse struct {
+	// Relationship ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Coach user ID
+	CoachID string `form:"coachId" json:"coachId" xml:"coachId"`
+	// Athlete user ID
+	AthleteID string `form:"athleteId" json:"athleteId" xml:"athleteId"`
+	// Relationship status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Invitation time
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// Acceptance time
+	AcceptedAt *string `form:"acceptedAt,omitempty" json:"acceptedAt,omitempty" xml:"acceptedAt,omitempty"`
+	// Revocation time
+	RevokedAt *string `form:"revokedAt,omitempty" json:"revokedAt,omitempty" xml:"revokedAt,omitempty"`
+}
+
+// NewInviteResponseBody builds the HTTP response body from the result of the
+// "invite" endpoint of the "coaching" service.
+func NewInviteResponseBody(res *coaching.CoachingRelationship) *InviteResponseBody {
+	body := &InviteResponseBody{
+		ID:         res.ID,
+		CoachID:    res.CoachID,
+		AthleteID:  res.AthleteID,
+		Status:     res.Status,
+		CreatedAt:  res.CreatedAt,
+		AcceptedAt: res.AcceptedAt,
+		RevokedAt:  res.RevokedAt,
+	}
+	return body
+}
+
+// NewAcceptResponseBody builds the HTTP response body from the result of the
+// "accept" endpoint of the "coaching" service.
+func NewAcceptResponseBody(res *coaching.CoachingRelationship) *AcceptResponseBody {
+	body := &AcceptResponseBody{
+		ID:         res.ID,
+		CoachID:    res.CoachID,
+		AthleteID:  res.AthleteID,
+		Status:     res.Status,
+		CreatedAt:  res.CreatedAt,
+		AcceptedAt: res.AcceptedAt,
+		RevokedAt:  res.RevokedAt,
+	}
+	return body
+}
+
+// NewRevokeResponseBody builds the HTTP response body from the result of the
+// "revoke" endpoint of the "coaching" service.
+func NewRevokeResponseBody(res *coaching.CoachingRelationship) *RevokeResponseBody {
+	body := &RevokeResponseBody{
+		ID:         res.ID,
+		CoachID:    res.CoachID,
+		AthleteID:  res.AthleteID,
+		Status:     res.Status,
+		CreatedAt:  res.CreatedAt,
+		AcceptedAt: res.AcceptedAt,
+		RevokedAt:  res.RevokedAt,
+	}
+	return body
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "list" endpoint of the "coaching" service.
+func NewListResponseBody(res []*coaching.CoachingRelationship) ListResponseBody {
+	body := make([]*CoachingRelationshipResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalCoachingCoachingRelationshipToCoachingRelationshipResponse(val)
+	}
+	return body
+}
+
+// NewInviteBadRequestResponseBody builds the HTTP response body from the
+// result of the "invite" endpoint of the "coaching" service.
+func NewInviteBadRequestResponseBody(res *coaching.BadRequest) *InviteBadRequestResponseBody {
+	body := &InviteBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalCoachingFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewInviteForbiddenResponseBody builds the HTTP response body from the result
+// of the "invite" endpoint of the "coaching" service.
+func NewInviteForbiddenResponseBody(res *coaching.Forbidden) *InviteForbiddenResponseBody {
+	body := &InviteForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewInviteInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "invite" endpoint of the "coaching" service.
+func NewInviteInternalServerErrorResponseBody(res *coaching.InternalServerError) *InviteInternalServerErrorResponseBody {
+	body := &InviteInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewInviteNotFoundResponseBody builds the HTTP response body from the result
+// of the "invite" endpoint of the "coaching" service.
+func NewInviteNotFoundResponseBody(res *coaching.NotFound) *InviteNotFoundResponseBody {
+	body := &InviteNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewInviteUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "invite" endpoint of the "coaching" service.
+func NewInviteUnauthorizedResponseBody(res *coaching.Unauthorized) *InviteUnauthorizedResponseBody {
+	body := &InviteUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAcceptBadRequestResponseBody builds the HTTP response body from the
+// result of the "accept" endpoint of the "coaching" service.
+func NewAcceptBadRequestResponseBody(res *coaching.BadRequest) *AcceptBadRequestResponseBody {
+	body := &AcceptBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalCoachingFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewAcceptForbiddenResponseBody builds the HTTP response body from the result
+// of the "accept" endpoint of the "coaching" service.
+func NewAcceptForbiddenResponseBody(res *coaching.Forbidden) *AcceptForbiddenResponseBody {
+	body := &AcceptForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAcceptInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "accept" endpoint of the "coaching" service.
+func NewAcceptInternalServerErrorResponseBody(res *coaching.InternalServerError) *AcceptInternalServerErrorResponseBody {
+	body := &AcceptInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAcceptNotFoundResponseBody builds the HTTP response body from the result
+// of the "accept" endpoint of the "coaching" service.
+func NewAcceptNotFoundResponseBody(res *coaching.NotFound) *AcceptNotFoundResponseBody {
+	body := &AcceptNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAcceptUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "accept" endpoint of the "coaching" service.
+func NewAcceptUnauthorizedResponseBody(res *coaching.Unauthorized) *AcceptUnauthorizedResponseBody {
+	body := &AcceptUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRevokeBadRequestResponseBody builds the HTTP response body from the
+// result of the "revoke" endpoint of the "coaching" service.
+func NewRevokeBadRequestResponseBody(res *coaching.BadRequest) *RevokeBadRequestResponseBody {
+	body := &RevokeBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalCoachingFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewRevokeForbiddenResponseBody builds the HTTP response body from the result
+// of the "revoke" endpoint of the "coaching" service.
+func NewRevokeForbiddenResponseBody(res *coaching.Forbidden) *RevokeForbiddenResponseBody {
+	body := &RevokeForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRevokeInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "revoke" endpoint of the "coaching" service.
+func NewRevokeInternalServerErrorResponseBody(res *coaching.InternalServerError) *RevokeInternalServerErrorResponseBody {
+	body := &RevokeInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRevokeNotFoundResponseBody builds the HTTP response body from the result
+// of the "revoke" endpoint of the "coaching" service.
+func NewRevokeNotFoundResponseBody(res *coaching.NotFound) *RevokeNotFoundResponseBody {
+	body := &RevokeNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRevokeUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "revoke" endpoint of the "coaching" service.
+func NewRevokeUnauthorizedResponseBody(res *coaching.Unauthorized) *RevokeUnauthorizedResponseBody {
+	body := &RevokeUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListBadRequestResponseBody builds the HTTP response body from the result
+// of the "list" endpoint of the "coaching" service.
+func NewListBadRequestResponseBody(res *coaching.BadRequest) *ListBadRequestResponseBody {
+	body := &ListBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalCoachingFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewListForbiddenResponseBody builds the HTTP response body from the result
+// of the "list" endpoint of the "coaching" service.
+func NewListForbiddenResponseBody(res *coaching.Forbidden) *ListForbiddenResponseBody {
+	body := &ListForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "list" endpoint of the "coaching" service.
+func NewListInternalServerErrorResponseBody(res *coaching.InternalServerError) *ListInternalServerErrorResponseBody {
+	body := &ListInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListNotFoundResponseBody builds the HTTP response body from the result of
+// the "list" endpoint of the "coaching" service.
+func NewListNotFoundResponseBody(res *coaching.NotFound) *ListNotFoundResponseBody {
+	body := &ListNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "list" endpoint of the "coaching" service.
+func NewListUnauthorizedResponseBody(res *coaching.Unauthorized) *ListUnauthorizedResponseBody {
+	body := &ListUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewInvitePayload builds a coaching service invite endpoint payload.
+func NewInvitePayload(body *InviteRequestBody, token *string) *coaching.InvitePayload {
+	v := &coaching.InvitePayload{
+		AthleteID: *body.AthleteID,
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewAcceptPayload builds a coaching service accept endpoint payload.
+func NewAcceptPayload(id string, token *string) *coaching.AcceptPayload {
+	v := &coaching.AcceptPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewRevokePayload builds a coaching service revoke endpoint payload.
+func NewRevokePayload(id string, token *string) *coaching.RevokePayload {
+	v := &coaching.RevokePayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewListPayload builds a coaching service list endpoint payload.
+func NewListPayload(role *string, status *string, limit int, offset int, token *string) *coaching.ListPayload {
+	v := &coaching.ListPayload{}
+	v.Role = role
+	v.Status = status
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v
+}
+
+// ValidateInviteRequestBody runs the validations defined on InviteRequestBody
+func ValidateInviteRequestBody(body *InviteRequestBody) (err error) {
+	if body.AthleteID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("athleteId", "body"))
+	}
+	if body.AthleteID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.athleteId", *body.AthleteID, goa.FormatUUID))
+	}
+	return
+}
@@ -0,0 +1,86 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// importer HTTP client CLI support package
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	importer "be/gen/importer"
+	"fmt"
+	"strconv"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildHistoryPayload builds the payload for the importer history endpoint
+// from CLI flags.
+func BuildHistoryPayload(importerHistoryFormat string, importerHistoryDryRun string, importerHistoryUserID string, importerHistoryUnit string, importerHistoryTimezone string, importerHistoryToken string) (*importer.HistoryPayload, error) {
+	var err error
+	var format string
+	{
+		if importerHistoryFormat != "" {
+			format = importerHistoryFormat
+			if !(format == "auto" || format == "strong" || format == "hevy") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"auto", "strong", "hevy"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var dryRun bool
+	{
+		if importerHistoryDryRun != "" {
+			dryRun, err = strconv.ParseBool(importerHistoryDryRun)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for dryRun, must be BOOL")
+			}
+		}
+	}
+	var userID *string
+	{
+		if importerHistoryUserID != "" {
+			userID = &importerHistoryUserID
+			err = goa.MergeErrors(err, goa.ValidateFormat("userId", *userID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var unit string
+	{
+		if importerHistoryUnit != "" {
+			unit = importerHistoryUnit
+			if !(unit == "kg" || unit == "lb") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("unit", unit, []any{"kg", "lb"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var timezone *string
+	{
+		if importerHistoryTimezone != "" {
+			timezone = &importerHistoryTimezone
+		}
+	}
+	var token *string
+	{
+		if importerHistoryToken != "" {
+			token = &importerHistoryToken
+		}
+	}
+	v := &importer.HistoryPayload{}
+	v.Format = format
+	v.DryRun = dryRun
+	v.UserID = userID
+	v.Unit = unit
+	v.Timezone = timezone
+	v.Token = token
+
+	return v, nil
+}
@@ -0,0 +1,75 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// importer client HTTP transport
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the importer service endpoint HTTP clients.
+type Client struct {
+	// History Doer is the HTTP client used to make requests to the history
+	// endpoint.
+	HistoryDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the importer service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		HistoryDoer:         doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// History returns an endpoint that makes HTTP requests to the importer service
+// history server.
+func (c *Client) History() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeHistoryRequest(c.encoder)
+		decodeResponse = DecodeHistoryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildHistoryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.HistoryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("importer", "history", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,281 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// importer HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	importer "be/gen/importer"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildHistoryRequest instantiates a HTTP request object with method and path
+// set to call the "importer" service "history" endpoint
+func (c *Client) BuildHistoryRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		body io.Reader
+	)
+	rd, ok := v.(*importer.HistoryRequestData)
+	if !ok {
+		return nil, goahttp.ErrInvalidType("importer", "history", "importer.HistoryRequestData", v)
+	}
+	body = rd.Body
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: HistoryImporterPath()}
+	req, err := http.NewRequest("POST", u.String(), body)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("importer", "history", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeHistoryRequest returns an encoder for requests sent to the importer
+// history server.
+func EncodeHistoryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		data, ok := v.(*importer.HistoryRequestData)
+		if !ok {
+			return goahttp.ErrInvalidType("importer", "history", "*importer.HistoryRequestData", v)
+		}
+		p := data.Payload
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("format", p.Format)
+		values.Add("dryRun", fmt.Sprintf("%v", p.DryRun))
+		if p.UserID != nil {
+			values.Add("userId", *p.UserID)
+		}
+		values.Add("unit", p.Unit)
+		if p.Timezone != nil {
+			values.Add("timezone", *p.Timezone)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeHistoryResponse returns a decoder for responses returned by the
+// importer history endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeHistoryResponse may return the following errors:
+//   - "badRequest" (type *importer.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *importer.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *importer.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *importer.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *importer.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeHistoryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body HistoryResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("importer", "history", err)
+			}
+			err = ValidateHistoryResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("importer", "history", err)
+			}
+			res := NewHistoryImportOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body HistoryBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("importer", "history", err)
+			}
+			err = ValidateHistoryBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("importer", "history", err)
+			}
+			return nil, NewHistoryBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body HistoryForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("importer", "history", err)
+			}
+			err = ValidateHistoryForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("importer", "history", err)
+			}
+			return nil, NewHistoryForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body HistoryInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("importer", "history", err)
+			}
+			err = ValidateHistoryInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("importer", "history", err)
+			}
+			return nil, NewHistoryInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body HistoryNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("importer", "history", err)
+			}
+			err = ValidateHistoryNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("importer", "history", err)
+			}
+			return nil, NewHistoryNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body HistoryUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("importer", "history", err)
+			}
+			err = ValidateHistoryUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("importer", "history", err)
+			}
+			return nil, NewHistoryUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("importer", "history", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// // BuildHistoryStreamPayload creates a streaming endpoint request payload from
+// the method payload and the path to the file to be streamed
+func BuildHistoryStreamPayload(payload any, fpath string) (*importer.HistoryRequestData, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return &importer.HistoryRequestData{
+		Payload: payload.(*importer.HistoryPayload),
+		Body:    f,
+	}, nil
+}
+
+// unmarshalImportedSessionResponseBodyToImporterImportedSession builds a value
+// of type *importer.ImportedSession from a value of type
+// *ImportedSessionResponseBody.
+func unmarshalImportedSessionResponseBodyToImporterImportedSession(v *ImportedSessionResponseBody) *importer.ImportedSession {
+	res := &importer.ImportedSession{
+		StartedAt:   *v.StartedAt,
+		FinishedAt:  *v.FinishedAt,
+		WorkoutName: *v.WorkoutName,
+		Duplicate:   *v.Duplicate,
+	}
+	res.Exercises = make([]*importer.ImportedExercise, len(v.Exercises))
+	for i, val := range v.Exercises {
+		res.Exercises[i] = unmarshalImportedExerciseResponseBodyToImporterImportedExercise(val)
+	}
+
+	return res
+}
+
+// unmarshalImportedExerciseResponseBodyToImporterImportedExercise builds a
+// value of type *importer.ImportedExercise from a value of type
+// *ImportedExerciseResponseBody.
+func unmarshalImportedExerciseResponseBodyToImporterImportedExercise(v *ImportedExerciseResponseBody) *importer.ImportedExercise {
+	res := &importer.ImportedExercise{
+		Name:           *v.Name,
+		ExerciseTypeID: v.ExerciseTypeID,
+	}
+	res.Sets = make([]*importer.ImportedSet, len(v.Sets))
+	for i, val := range v.Sets {
+		res.Sets[i] = unmarshalImportedSetResponseBodyToImporterImportedSet(val)
+	}
+
+	return res
+}
+
+// unmarshalImportedSetResponseBodyToImporterImportedSet builds a value of type
+// *importer.ImportedSet from a value of type *ImportedSetResponseBody.
+func unmarshalImportedSetResponseBodyToImporterImportedSet(v *ImportedSetResponseBody) *importer.ImportedSet {
+	res := &importer.ImportedSet{
+		Weight: *v.Weight,
+		Reps:   *v.Reps,
+	}
+
+	return res
+}
+
+// unmarshalExerciseMatchResponseBodyToImporterExerciseMatch builds a value of
+// type *importer.ExerciseMatch from a value of type *ExerciseMatchResponseBody.
+func unmarshalExerciseMatchResponseBodyToImporterExerciseMatch(v *ExerciseMatchResponseBody) *importer.ExerciseMatch {
+	res := &importer.ExerciseMatch{
+		Name:             *v.Name,
+		ExerciseTypeID:   v.ExerciseTypeID,
+		ExerciseTypeName: v.ExerciseTypeName,
+		Score:            *v.Score,
+		Exact:            *v.Exact,
+		Applied:          *v.Applied,
+	}
+
+	return res
+}
+
+// unmarshalFieldErrorResponseBodyToImporterFieldError builds a value of type
+// *importer.FieldError from a value of type *FieldErrorResponseBody.
+func unmarshalFieldErrorResponseBodyToImporterFieldError(v *FieldErrorResponseBody) *importer.FieldError {
+	if v == nil {
+		return nil
+	}
+	res := &importer.FieldError{
+		Field:   *v.Field,
+		Message: *v.Message,
+	}
+
+	return res
+}
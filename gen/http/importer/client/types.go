@@ -0,0 +1,451 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// importer HTTP client types
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	importer "be/gen/importer"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// HistoryResponseBody is the type of the "importer" service "history" endpoint
+// HTTP response body.
+type HistoryResponseBody struct {
+	// Detected export format
+	Format *string `form:"format,omitempty" json:"format,omitempty" xml:"format,omitempty"`
+	// Whether nothing was saved
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty" xml:"dryRun,omitempty"`
+	// Plan holding the imported sessions, missing in a dry run
+	TrainingPlanID *string `form:"trainingPlanId,omitempty" json:"trainingPlanId,omitempty" xml:"trainingPlanId,omitempty"`
+	// Sessions created, or that would be created
+	Created *int `form:"created,omitempty" json:"created,omitempty" xml:"created,omitempty"`
+	// Sessions skipped because they were already imported
+	Duplicates *int `form:"duplicates,omitempty" json:"duplicates,omitempty" xml:"duplicates,omitempty"`
+	// Sets created, or that would be created
+	Sets *int `form:"sets,omitempty" json:"sets,omitempty" xml:"sets,omitempty"`
+	// Personal records set by the imported sets
+	Records *int `form:"records,omitempty" json:"records,omitempty" xml:"records,omitempty"`
+	// Sessions read from the file
+	Sessions []*ImportedSessionResponseBody `form:"sessions,omitempty" json:"sessions,omitempty" xml:"sessions,omitempty"`
+	// How each exercise name was mapped to the catalog
+	Matches []*ExerciseMatchResponseBody `form:"matches,omitempty" json:"matches,omitempty" xml:"matches,omitempty"`
+	// Lines that could not be read, and why
+	SkippedRows []string `form:"skippedRows,omitempty" json:"skippedRows,omitempty" xml:"skippedRows,omitempty"`
+}
+
+// HistoryBadRequestResponseBody is the type of the "importer" service
+// "history" endpoint HTTP response body for the "badRequest" error.
+type HistoryBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// HistoryForbiddenResponseBody is the type of the "importer" service "history"
+// endpoint HTTP response body for the "forbidden" error.
+type HistoryForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// HistoryInternalServerErrorResponseBody is the type of the "importer" service
+// "history" endpoint HTTP response body for the "internalServerError" error.
+type HistoryInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// HistoryNotFoundResponseBody is the type of the "importer" service "history"
+// endpoint HTTP response body for the "notFound" error.
+type HistoryNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// HistoryUnauthorizedResponseBody is the type of the "importer" service
+// "history" endpoint HTTP response body for the "unauthorized" error.
+type HistoryUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ImportedSessionResponseBody is used to define fields on response body types.
+type ImportedSessionResponseBody struct {
+	// Start of the session
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// End of the session
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Workout name in the export
+	WorkoutName *string `form:"workoutName,omitempty" json:"workoutName,omitempty" xml:"workoutName,omitempty"`
+	// Whether the session was already imported and is skipped
+	Duplicate *bool `form:"duplicate,omitempty" json:"duplicate,omitempty" xml:"duplicate,omitempty"`
+	// Exercises in order
+	Exercises []*ImportedExerciseResponseBody `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+}
+
+// ImportedExerciseResponseBody is used to define fields on response body types.
+type ImportedExerciseResponseBody struct {
+	// Exercise name in the export
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Exercise type the sets are recorded against, missing when no match was
+	// applied
+	ExerciseTypeID *string `form:"exerciseTypeId,omitempty" json:"exerciseTypeId,omitempty" xml:"exerciseTypeId,omitempty"`
+	// Sets in order
+	Sets []*ImportedSetResponseBody `form:"sets,omitempty" json:"sets,omitempty" xml:"sets,omitempty"`
+}
+
+// ImportedSetResponseBody is used to define fields on response body types.
+type ImportedSetResponseBody struct {
+	// Weight lifted (kg)
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Repetitions
+	Reps *int `form:"reps,omitempty" json:"reps,omitempty" xml:"reps,omitempty"`
+}
+
+// ExerciseMatchResponseBody is used to define fields on response body types.
+type ExerciseMatchResponseBody struct {
+	// Exercise name in the export
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Matched exercise type, missing when nothing matched
+	ExerciseTypeID *string `form:"exerciseTypeId,omitempty" json:"exerciseTypeId,omitempty" xml:"exerciseTypeId,omitempty"`
+	// Name of the matched exercise type
+	ExerciseTypeName *string `form:"exerciseTypeName,omitempty" json:"exerciseTypeName,omitempty" xml:"exerciseTypeName,omitempty"`
+	// Similarity between the names, from 0 to 1
+	Score *float64 `form:"score,omitempty" json:"score,omitempty" xml:"score,omitempty"`
+	// Whether the names match once normalized
+	Exact *bool `form:"exact,omitempty" json:"exact,omitempty" xml:"exact,omitempty"`
+	// Whether the imported sets are recorded against the exercise type. Only names
+	// holding the same words as the type, in any order, are applied; other matches
+	// are suggestions and the sets are imported without a type.
+	Applied *bool `form:"applied,omitempty" json:"applied,omitempty" xml:"applied,omitempty"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+	// Motivo per cui il campo non è valido
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// NewHistoryImportOK builds a "importer" service "history" endpoint result
+// from a HTTP "OK" response.
+func NewHistoryImportOK(body *HistoryResponseBody) *importer.HistoryImport {
+	v := &importer.HistoryImport{
+		Format:         *body.Format,
+		DryRun:         *body.DryRun,
+		TrainingPlanID: body.TrainingPlanID,
+		Created:        *body.Created,
+		Duplicates:     *body.Duplicates,
+		Sets:           *body.Sets,
+		Records:        *body.Records,
+	}
+	v.Sessions = make([]*importer.ImportedSession, len(body.Sessions))
+	for i, val := range body.Sessions {
+		v.Sessions[i] = unmarshalImportedSessionResponseBodyToImporterImportedSession(val)
+	}
+	v.Matches = make([]*importer.ExerciseMatch, len(body.Matches))
+	for i, val := range body.Matches {
+		v.Matches[i] = unmarshalExerciseMatchResponseBodyToImporterExerciseMatch(val)
+	}
+	v.SkippedRows = make([]string, len(body.SkippedRows))
+	for i, val := range body.SkippedRows {
+		v.SkippedRows[i] = val
+	}
+
+	return v
+}
+
+// NewHistoryBadRequest builds a importer service history endpoint badRequest
+// error.
+func NewHistoryBadRequest(body *HistoryBadRequestResponseBody) *importer.BadRequest {
+	v := &importer.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*importer.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToImporterFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewHistoryForbidden builds a importer service history endpoint forbidden
+// error.
+func NewHistoryForbidden(body *HistoryForbiddenResponseBody) *importer.Forbidden {
+	v := &importer.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewHistoryInternalServerError builds a importer service history endpoint
+// internalServerError error.
+func NewHistoryInternalServerError(body *HistoryInternalServerErrorResponseBody) *importer.InternalServerError {
+	v := &importer.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewHistoryNotFound builds a importer service history endpoint notFound error.
+func NewHistoryNotFound(body *HistoryNotFoundResponseBody) *importer.NotFound {
+	v := &importer.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewHistoryUnauthorized builds a importer service history endpoint
+// unauthorized error.
+func NewHistoryUnauthorized(body *HistoryUnauthorizedResponseBody) *importer.Unauthorized {
+	v := &importer.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// ValidateHistoryResponseBody runs the validations defined on
+// HistoryResponseBody
+func ValidateHistoryResponseBody(body *HistoryResponseBody) (err error) {
+	if body.Format == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("format", "body"))
+	}
+	if body.DryRun == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dryRun", "body"))
+	}
+	if body.Created == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created", "body"))
+	}
+	if body.Duplicates == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("duplicates", "body"))
+	}
+	if body.Sets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sets", "body"))
+	}
+	if body.Records == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("records", "body"))
+	}
+	if body.Sessions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sessions", "body"))
+	}
+	if body.Matches == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("matches", "body"))
+	}
+	if body.SkippedRows == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("skippedRows", "body"))
+	}
+	if body.TrainingPlanID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.trainingPlanId", *body.TrainingPlanID, goa.FormatUUID))
+	}
+	for _, e := range body.Sessions {
+		if e != nil {
+			if err2 := ValidateImportedSessionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Matches {
+		if e != nil {
+			if err2 := ValidateExerciseMatchResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateHistoryBadRequestResponseBody runs the validations defined on
+// history_badRequest_response_body
+func ValidateHistoryBadRequestResponseBody(body *HistoryBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateHistoryForbiddenResponseBody runs the validations defined on
+// history_forbidden_response_body
+func ValidateHistoryForbiddenResponseBody(body *HistoryForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateHistoryInternalServerErrorResponseBody runs the validations defined
+// on history_internalServerError_response_body
+func ValidateHistoryInternalServerErrorResponseBody(body *HistoryInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateHistoryNotFoundResponseBody runs the validations defined on
+// history_notFound_response_body
+func ValidateHistoryNotFoundResponseBody(body *HistoryNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateHistoryUnauthorizedResponseBody runs the validations defined on
+// history_unauthorized_response_body
+func ValidateHistoryUnauthorizedResponseBody(body *HistoryUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateImportedSessionResponseBody runs the validations defined on
+// ImportedSessionResponseBody
+func ValidateImportedSessionResponseBody(body *ImportedSessionResponseBody) (err error) {
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startedAt", "body"))
+	}
+	if body.FinishedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("finishedAt", "body"))
+	}
+	if body.WorkoutName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("workoutName", "body"))
+	}
+	if body.Duplicate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("duplicate", "body"))
+	}
+	if body.Exercises == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercises", "body"))
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startedAt", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finishedAt", *body.FinishedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.Exercises {
+		if e != nil {
+			if err2 := ValidateImportedExerciseResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateImportedExerciseResponseBody runs the validations defined on
+// ImportedExerciseResponseBody
+func ValidateImportedExerciseResponseBody(body *ImportedExerciseResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Sets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sets", "body"))
+	}
+	if body.ExerciseTypeID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.exerciseTypeId", *body.ExerciseTypeID, goa.FormatUUID))
+	}
+	for _, e := range body.Sets {
+		if e != nil {
+			if err2 := ValidateImportedSetResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateImportedSetResponseBody runs the validations defined on
+// ImportedSetResponseBody
+func ValidateImportedSetResponseBody(body *ImportedSetResponseBody) (err error) {
+	if body.Weight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("weight", "body"))
+	}
+	if body.Reps == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reps", "body"))
+	}
+	return
+}
+
+// ValidateExerciseMatchResponseBody runs the validations defined on
+// ExerciseMatchResponseBody
+func ValidateExerciseMatchResponseBody(body *ExerciseMatchResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Score == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("score", "body"))
+	}
+	if body.Exact == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exact", "body"))
+	}
+	if body.Applied == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("applied", "body"))
+	}
+	if body.ExerciseTypeID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.exerciseTypeId", *body.ExerciseTypeID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateFieldErrorResponseBody runs the validations defined on
+// FieldErrorResponseBody
+func ValidateFieldErrorResponseBody(body *FieldErrorResponseBody) (err error) {
+	if body.Field == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("field", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
@@ -0,0 +1,268 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// importer HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	importer "be/gen/importer"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeHistoryResponse returns an encoder for responses returned by the
+// importer history endpoint.
+func EncodeHistoryResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*importer.HistoryImport)
+		enc := encoder(ctx, w)
+		body := NewHistoryResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeHistoryRequest returns a decoder for requests sent to the importer
+// history endpoint.
+func DecodeHistoryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			format   string
+			dryRun   bool
+			userID   *string
+			unit     string
+			timezone *string
+			token    *string
+			err      error
+		)
+		qp := r.URL.Query()
+		formatRaw := qp.Get("format")
+		if formatRaw != "" {
+			format = formatRaw
+		} else {
+			format = "auto"
+		}
+		if !(format == "auto" || format == "strong" || format == "hevy") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"auto", "strong", "hevy"}))
+		}
+		{
+			dryRunRaw := qp.Get("dryRun")
+			if dryRunRaw != "" {
+				v, err2 := strconv.ParseBool(dryRunRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("dryRun", dryRunRaw, "boolean"))
+				}
+				dryRun = v
+			}
+		}
+		userIDRaw := qp.Get("userId")
+		if userIDRaw != "" {
+			userID = &userIDRaw
+		}
+		if userID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("userId", *userID, goa.FormatUUID))
+		}
+		unitRaw := qp.Get("unit")
+		if unitRaw != "" {
+			unit = unitRaw
+		} else {
+			unit = "kg"
+		}
+		if !(unit == "kg" || unit == "lb") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("unit", unit, []any{"kg", "lb"}))
+		}
+		timezoneRaw := qp.Get("timezone")
+		if timezoneRaw != "" {
+			timezone = &timezoneRaw
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewHistoryPayload(format, dryRun, userID, unit, timezone, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeHistoryError returns an encoder for errors returned by the history
+// importer endpoint.
+func EncodeHistoryError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "badRequest":
+			var res *importer.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewHistoryBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "forbidden":
+			var res *importer.Forbidden
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewHistoryForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "internalServerError":
+			var res *importer.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewHistoryInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "notFound":
+			var res *importer.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewHistoryNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res *importer.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewHistoryUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// marshalImporterImportedSessionToImportedSessionResponseBody builds a value
+// of type *ImportedSessionResponseBody from a value of type
+// *importer.ImportedSession.
+func marshalImporterImportedSessionToImportedSessionResponseBody(v *importer.ImportedSession) *ImportedSessionResponseBody {
+	res := &ImportedSessionResponseBody{
+		StartedAt:   v.StartedAt,
+		FinishedAt:  v.FinishedAt,
+		WorkoutName: v.WorkoutName,
+		Duplicate:   v.Duplicate,
+	}
+	if v.Exercises != nil {
+		res.Exercises = make([]*ImportedExerciseResponseBody, len(v.Exercises))
+		for i, val := range v.Exercises {
+			res.Exercises[i] = marshalImporterImportedExerciseToImportedExerciseResponseBody(val)
+		}
+	} else {
+		res.Exercises = []*ImportedExerciseResponseBody{}
+	}
+
+	return res
+}
+
+// marshalImporterImportedExerciseToImportedExerciseResponseBody builds a value
+// of type *ImportedExerciseResponseBody from a value of type
+// *importer.ImportedExercise.
+func marshalImporterImportedExerciseToImportedExerciseResponseBody(v *importer.ImportedExercise) *ImportedExerciseResponseBody {
+	res := &ImportedExerciseResponseBody{
+		Name:           v.Name,
+		ExerciseTypeID: v.ExerciseTypeID,
+	}
+	if v.Sets != nil {
+		res.Sets = make([]*ImportedSetResponseBody, len(v.Sets))
+		for i, val := range v.Sets {
+			res.Sets[i] = marshalImporterImportedSetToImportedSetResponseBody(val)
+		}
+	} else {
+		res.Sets = []*ImportedSetResponseBody{}
+	}
+
+	return res
+}
+
+// marshalImporterImportedSetToImportedSetResponseBody builds a value of type
+// *ImportedSetResponseBody from a value of type *importer.ImportedSet.
+func marshalImporterImportedSetToImportedSetResponseBody(v *importer.ImportedSet) *ImportedSetResponseBody {
+	res := &ImportedSetResponseBody{
+		Weight: v.Weight,
+		Reps:   v.Reps,
+	}
+
+	return res
+}
+
+// marshalImporterExerciseMatchToExerciseMatchResponseBody builds a value of
+// type *ExerciseMatchResponseBody from a value of type *importer.ExerciseMatch.
+func marshalImporterExerciseMatchToExerciseMatchResponseBody(v *importer.ExerciseMatch) *ExerciseMatchResponseBody {
+	res := &ExerciseMatchResponseBody{
+		Name:             v.Name,
+		ExerciseTypeID:   v.ExerciseTypeID,
+		ExerciseTypeName: v.ExerciseTypeName,
+		Score:            v.Score,
+		Exact:            v.Exact,
+		Applied:          v.Applied,
+	}
+
+	return res
+}
+
+// marshalImporterFieldErrorToFieldErrorResponseBody builds a value of type
+// *FieldErrorResponseBody from a value of type *importer.FieldError.
+func marshalImporterFieldErrorToFieldErrorResponseBody(v *importer.FieldError) *FieldErrorResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &FieldErrorResponseBody{
+		Field:   v.Field,
+		Message: v.Message,
+	}
+
+	return res
+}
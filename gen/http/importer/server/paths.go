@@ -0,0 +1,13 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// HTTP request path constructors for the importer service.
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+// HistoryImporterPath returns the URL path to the importer service history HTTP endpoint.
+func HistoryImporterPath() string {
+	return "/api/v1/imports/history"
+}
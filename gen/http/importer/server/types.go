@@ -0,0 +1,251 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// importer HTTP server types
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	importer "be/gen/importer"
+)
+
+// HistoryResponseBody is the type of the "importer" service "history" endpoint
+// HTTP response body.
+type HistoryResponseBody struct {
+	// Detected export format
+	Format string `form:"format" json:"format" xml:"format"`
+	// Whether nothing was saved
+	DryRun bool `form:"dryRun" json:"dryRun" xml:"dryRun"`
+	// Plan holding the imported sessions, missing in a dry run
+	TrainingPlanID *string `form:"trainingPlanId,omitempty" json:"trainingPlanId,omitempty" xml:"trainingPlanId,omitempty"`
+	// Sessions created, or that would be created
+	Created int `form:"created" json:"created" xml:"created"`
+	// Sessions skipped because they were already imported
+	Duplicates int `form:"duplicates" json:"duplicates" xml:"duplicates"`
+	// Sets created, or that would be created
+	Sets int `form:"sets" json:"sets" xml:"sets"`
+	// Personal records set by the imported sets
+	Records int `form:"records" json:"records" xml:"records"`
+	// Sessions read from the file
+	Sessions []*ImportedSessionResponseBody `form:"sessions" json:"sessions" xml:"sessions"`
+	// How each exercise name was mapped to the catalog
+	Matches []*ExerciseMatchResponseBody `form:"matches" json:"matches" xml:"matches"`
+	// Lines that could not be read, and why
+	SkippedRows []string `form:"skippedRows" json:"skippedRows" xml:"skippedRows"`
+}
+
+// HistoryBadRequestResponseBody is the type of the "importer" service
+// "history" endpoint HTTP response body for the "badRequest" error.
+type HistoryBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// HistoryForbiddenResponseBody is the type of the "importer" service "history"
+// endpoint HTTP response body for the "forbidden" error.
+type HistoryForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// HistoryInternalServerErrorResponseBody is the type of the "importer" service
+// "history" endpoint HTTP response body for the "internalServerError" error.
+type HistoryInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// HistoryNotFoundResponseBody is the type of the "importer" service "history"
+// endpoint HTTP response body for the "notFound" error.
+type HistoryNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// HistoryUnauthorizedResponseBody is the type of the "importer" service
+// "history" endpoint HTTP response body for the "unauthorized" error.
+type HistoryUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ImportedSessionResponseBody is used to define fields on response body types.
+type ImportedSessionResponseBody struct {
+	// Start of the session
+	StartedAt string `form:"startedAt" json:"startedAt" xml:"startedAt"`
+	// End of the session
+	FinishedAt string `form:"finishedAt" json:"finishedAt" xml:"finishedAt"`
+	// Workout name in the export
+	WorkoutName string `form:"workoutName" json:"workoutName" xml:"workoutName"`
+	// Whether the session was already imported and is skipped
+	Duplicate bool `form:"duplicate" json:"duplicate" xml:"duplicate"`
+	// Exercises in order
+	Exercises []*ImportedExerciseResponseBody `form:"exercises" json:"exercises" xml:"exercises"`
+}
+
+// ImportedExerciseResponseBody is used to define fields on response body types.
+type ImportedExerciseResponseBody struct {
+	// Exercise name in the export
+	Name string `form:"name" json:"name" xml:"name"`
+	// Exercise type the sets are recorded against, missing when no match was
+	// applied
+	ExerciseTypeID *string `form:"exerciseTypeId,omitempty" json:"exerciseTypeId,omitempty" xml:"exerciseTypeId,omitempty"`
+	// Sets in order
+	Sets []*ImportedSetResponseBody `form:"sets" json:"sets" xml:"sets"`
+}
+
+// ImportedSetResponseBody is used to define fields on response body types.
+type ImportedSetResponseBody struct {
+	// Weight lifted (kg)
+	Weight float64 `form:"weight" json:"weight" xml:"weight"`
+	// Repetitions
+	Reps int `form:"reps" json:"reps" xml:"reps"`
+}
+
+// ExerciseMatchResponseBody is used to define fields on response body types.
+type ExerciseMatchResponseBody struct {
+	// Exercise name in the export
+	Name string `form:"name" json:"name" xml:"name"`
+	// Matched exercise type, missing when nothing matched
+	ExerciseTypeID *string `form:"exerciseTypeId,omitempty" json:"exerciseTypeId,omitempty" xml:"exerciseTypeId,omitempty"`
+	// Name of the matched exercise type
+	ExerciseTypeName *string `form:"exerciseTypeName,omitempty" json:"exerciseTypeName,omitempty" xml:"exerciseTypeName,omitempty"`
+	// Similarity between the names, from 0 to 1
+	Score float64 `form:"score" json:"score" xml:"score"`
+	// Whether the names match once normalized
+	Exact bool `form:"exact" json:"exact" xml:"exact"`
+	// Whether the imported sets are recorded against the exercise type. Only names
+	// holding the same words as the type, in any order, are applied; other matches
+	// are suggestions and the sets are imported without a type.
+	Applied bool `form:"applied" json:"applied" xml:"applied"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field string `form:"field" json:"field" xml:"field"`
+	// Motivo per cui il campo non è valido
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// NewHistoryResponseBody builds the HTTP response body from the result of the
+// "history" endpoint of the "importer" service.
+func NewHistoryResponseBody(res *importer.HistoryImport) *HistoryResponseBody {
+	body := &HistoryResponseBody{
+		Format:         res.Format,
+		DryRun:         res.DryRun,
+		TrainingPlanID: res.TrainingPlanID,
+		Created:        res.Created,
+		Duplicates:     res.Duplicates,
+		Sets:           res.Sets,
+		Records:        res.Records,
+	}
+	if res.Sessions != nil {
+		body.Sessions = make([]*ImportedSessionResponseBody, len(res.Sessions))
+		for i, val := range res.Sessions {
+			body.Sessions[i] = marshalImporterImportedSessionToImportedSessionResponseBody(val)
+		}
+	} else {
+		body.Sessions = []*ImportedSessionResponseBody{}
+	}
+	if res.Matches != nil {
+		body.Matches = make([]*ExerciseMatchResponseBody, len(res.Matches))
+		for i, val := range res.Matches {
+			body.Matches[i] = marshalImporterExerciseMatchToExerciseMatchResponseBody(val)
+		}
+	} else {
+		body.Matches = []*ExerciseMatchResponseBody{}
+	}
+	if res.SkippedRows != nil {
+		body.SkippedRows = make([]string, len(res.SkippedRows))
+		for i, val := range res.SkippedRows {
+			body.SkippedRows[i] = val
+		}
+	} else {
+		body.SkippedRows = []string{}
+	}
+	return body
+}
+
+// NewHistoryBadRequestResponseBody builds the HTTP response body from the
+// result of the "history" endpoint of the "importer" service.
+func NewHistoryBadRequestResponseBody(res *importer.BadRequest) *HistoryBadRequestResponseBody {
+	body := &HistoryBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalImporterFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewHistoryForbiddenResponseBody builds the HTTP response body from the
+// result of the "history" endpoint of the "importer" service.
+func NewHistoryForbiddenResponseBody(res *importer.Forbidden) *HistoryForbiddenResponseBody {
+	body := &HistoryForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewHistoryInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "history" endpoint of the "importer" service.
+func NewHistoryInternalServerErrorResponseBody(res *importer.InternalServerError) *HistoryInternalServerErrorResponseBody {
+	body := &HistoryInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewHistoryNotFoundResponseBody builds the HTTP response body from the result
+// of the "history" endpoint of the "importer" service.
+func NewHistoryNotFoundResponseBody(res *importer.NotFound) *HistoryNotFoundResponseBody {
+	body := &HistoryNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewHistoryUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "history" endpoint of the "importer" service.
+func NewHistoryUnauthorizedResponseBody(res *importer.Unauthorized) *HistoryUnauthorizedResponseBody {
+	body := &HistoryUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewHistoryPayload builds a importer service history endpoint payload.
+func NewHistoryPayload(format string, dryRun bool, userID *string, unit string, timezone *string, token *string) *importer.HistoryPayload {
+	v := &importer.HistoryPayload{}
+	v.Format = format
+	v.DryRun = dryRun
+	v.UserID = userID
+	v.Unit = unit
+	v.Timezone = timezone
+	v.Token = token
+
+	return v
+}
@@ -0,0 +1,227 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// progression HTTP client CLI support package
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	progression "be/gen/progression"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildSetPayload builds the payload for the progression set endpoint from CLI
+// flags.
+func BuildSetPayload(progressionSetBody string, progressionSetID string, progressionSetToken string) (*progression.SetPayload, error) {
+	var err error
+	var body SetRequestBody
+	{
+		err = json.Unmarshal([]byte(progressionSetBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"deloadAfter\": 7568770750837534653,\n      \"deloadPercentage\": 0.10947395722622281,\n      \"increment\": 0.6436387448870217,\n      \"kind\": \"double_progression\",\n      \"maxReps\": 12,\n      \"minReps\": 8,\n      \"percentage\": 0.75\n   }'")
+		}
+		if !(body.Kind == "linear" || body.Kind == "double_progression" || body.Kind == "percentage_of_one_rep_max") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", body.Kind, []any{"linear", "double_progression", "percentage_of_one_rep_max"}))
+		}
+		if body.Increment < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.increment", body.Increment, 0, true))
+		}
+		if body.MinReps != nil {
+			if *body.MinReps < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.minReps", *body.MinReps, 1, true))
+			}
+		}
+		if body.MaxReps != nil {
+			if *body.MaxReps < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.maxReps", *body.MaxReps, 1, true))
+			}
+		}
+		if body.Percentage != nil {
+			if *body.Percentage < 0.3 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.percentage", *body.Percentage, 0.3, true))
+			}
+		}
+		if body.Percentage != nil {
+			if *body.Percentage > 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.percentage", *body.Percentage, 1, false))
+			}
+		}
+		if body.DeloadAfter < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.deloadAfter", body.DeloadAfter, 0, true))
+		}
+		if body.DeloadPercentage < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.deloadPercentage", body.DeloadPercentage, 0, true))
+		}
+		if body.DeloadPercentage > 0.5 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.deloadPercentage", body.DeloadPercentage, 0.5, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = progressionSetID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if progressionSetToken != "" {
+			token = &progressionSetToken
+		}
+	}
+	v := &progression.SetPayload{
+		Kind:             body.Kind,
+		Increment:        body.Increment,
+		MinReps:          body.MinReps,
+		MaxReps:          body.MaxReps,
+		Percentage:       body.Percentage,
+		DeloadAfter:      body.DeloadAfter,
+		DeloadPercentage: body.DeloadPercentage,
+	}
+	{
+		var zero float64
+		if v.Increment == zero {
+			v.Increment = 2.5
+		}
+	}
+	{
+		var zero int
+		if v.DeloadAfter == zero {
+			v.DeloadAfter = 2
+		}
+	}
+	{
+		var zero float64
+		if v.DeloadPercentage == zero {
+			v.DeloadPercentage = 0.1
+		}
+	}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetPayload builds the payload for the progression get endpoint from CLI
+// flags.
+func BuildGetPayload(progressionGetID string, progressionGetToken string) (*progression.GetPayload, error) {
+	var err error
+	var id string
+	{
+		id = progressionGetID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if progressionGetToken != "" {
+			token = &progressionGetToken
+		}
+	}
+	v := &progression.GetPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDeletePayload builds the payload for the progression delete endpoint
+// from CLI flags.
+func BuildDeletePayload(progressionDeleteID string, progressionDeleteToken string) (*progression.DeletePayload, error) {
+	var err error
+	var id string
+	{
+		id = progressionDeleteID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if progressionDeleteToken != "" {
+			token = &progressionDeleteToken
+		}
+	}
+	v := &progression.DeletePayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildHistoryPayload builds the payload for the progression history endpoint
+// from CLI flags.
+func BuildHistoryPayload(progressionHistoryID string, progressionHistoryLimit string, progressionHistoryOffset string, progressionHistoryToken string) (*progression.HistoryPayload, error) {
+	var err error
+	var id string
+	{
+		id = progressionHistoryID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var limit int
+	{
+		if progressionHistoryLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(progressionHistoryLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var offset int
+	{
+		if progressionHistoryOffset != "" {
+			var v int64
+			v, err = strconv.ParseInt(progressionHistoryOffset, 10, strconv.IntSize)
+			offset = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for offset, must be INT")
+			}
+			if offset < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("offset", offset, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if progressionHistoryToken != "" {
+			token = &progressionHistoryToken
+		}
+	}
+	v := &progression.HistoryPayload{}
+	v.ID = id
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v, nil
+}
@@ -0,0 +1,704 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// progression HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	progression "be/gen/progression"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildSetRequest instantiates a HTTP request object with method and path set
+// to call the "progression" service "set" endpoint
+func (c *Client) BuildSetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*progression.SetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("progression", "set", "*progression.SetPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SetProgressionPath(id)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("progression", "set", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSetRequest returns an encoder for requests sent to the progression set
+// server.
+func EncodeSetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*progression.SetPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("progression", "set", "*progression.SetPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewSetRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("progression", "set", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSetResponse returns a decoder for responses returned by the
+// progression set endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeSetResponse may return the following errors:
+//   - "badRequest" (type *progression.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *progression.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *progression.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *progression.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *progression.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeSetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "set", err)
+			}
+			err = ValidateSetResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "set", err)
+			}
+			res := NewSetProgressionRuleOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body SetBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "set", err)
+			}
+			err = ValidateSetBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "set", err)
+			}
+			return nil, NewSetBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body SetForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "set", err)
+			}
+			err = ValidateSetForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "set", err)
+			}
+			return nil, NewSetForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body SetInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "set", err)
+			}
+			err = ValidateSetInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "set", err)
+			}
+			return nil, NewSetInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body SetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "set", err)
+			}
+			err = ValidateSetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "set", err)
+			}
+			return nil, NewSetNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body SetUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "set", err)
+			}
+			err = ValidateSetUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "set", err)
+			}
+			return nil, NewSetUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("progression", "set", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetRequest instantiates a HTTP request object with method and path set
+// to call the "progression" service "get" endpoint
+func (c *Client) BuildGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*progression.GetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("progression", "get", "*progression.GetPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProgressionPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("progression", "get", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetRequest returns an encoder for requests sent to the progression get
+// server.
+func EncodeGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*progression.GetPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("progression", "get", "*progression.GetPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetResponse returns a decoder for responses returned by the
+// progression get endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeGetResponse may return the following errors:
+//   - "badRequest" (type *progression.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *progression.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *progression.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *progression.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *progression.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "get", err)
+			}
+			err = ValidateGetResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "get", err)
+			}
+			res := NewGetProgressionRuleOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "get", err)
+			}
+			err = ValidateGetBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "get", err)
+			}
+			return nil, NewGetBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "get", err)
+			}
+			err = ValidateGetForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "get", err)
+			}
+			return nil, NewGetForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "get", err)
+			}
+			err = ValidateGetInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "get", err)
+			}
+			return nil, NewGetInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "get", err)
+			}
+			err = ValidateGetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "get", err)
+			}
+			return nil, NewGetNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "get", err)
+			}
+			err = ValidateGetUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "get", err)
+			}
+			return nil, NewGetUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("progression", "get", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteRequest instantiates a HTTP request object with method and path
+// set to call the "progression" service "delete" endpoint
+func (c *Client) BuildDeleteRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*progression.DeletePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("progression", "delete", "*progression.DeletePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteProgressionPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("progression", "delete", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteRequest returns an encoder for requests sent to the progression
+// delete server.
+func EncodeDeleteRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*progression.DeletePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("progression", "delete", "*progression.DeletePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteResponse returns a decoder for responses returned by the
+// progression delete endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDeleteResponse may return the following errors:
+//   - "badRequest" (type *progression.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *progression.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *progression.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *progression.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *progression.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body DeleteBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "delete", err)
+			}
+			err = ValidateDeleteBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "delete", err)
+			}
+			return nil, NewDeleteBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "delete", err)
+			}
+			err = ValidateDeleteForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "delete", err)
+			}
+			return nil, NewDeleteForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "delete", err)
+			}
+			err = ValidateDeleteInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "delete", err)
+			}
+			return nil, NewDeleteInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "delete", err)
+			}
+			err = ValidateDeleteNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "delete", err)
+			}
+			return nil, NewDeleteNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeleteUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "delete", err)
+			}
+			err = ValidateDeleteUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "delete", err)
+			}
+			return nil, NewDeleteUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("progression", "delete", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildHistoryRequest instantiates a HTTP request object with method and path
+// set to call the "progression" service "history" endpoint
+func (c *Client) BuildHistoryRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*progression.HistoryPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("progression", "history", "*progression.HistoryPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: HistoryProgressionPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("progression", "history", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeHistoryRequest returns an encoder for requests sent to the progression
+// history server.
+func EncodeHistoryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*progression.HistoryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("progression", "history", "*progression.HistoryPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		values.Add("offset", fmt.Sprintf("%v", p.Offset))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeHistoryResponse returns a decoder for responses returned by the
+// progression history endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeHistoryResponse may return the following errors:
+//   - "badRequest" (type *progression.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *progression.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *progression.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *progression.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *progression.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeHistoryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body HistoryResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "history", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateProgressionChangeResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "history", err)
+			}
+			res := NewHistoryProgressionChangeOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body HistoryBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "history", err)
+			}
+			err = ValidateHistoryBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "history", err)
+			}
+			return nil, NewHistoryBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body HistoryForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "history", err)
+			}
+			err = ValidateHistoryForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "history", err)
+			}
+			return nil, NewHistoryForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body HistoryInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "history", err)
+			}
+			err = ValidateHistoryInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "history", err)
+			}
+			return nil, NewHistoryInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body HistoryNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "history", err)
+			}
+			err = ValidateHistoryNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "history", err)
+			}
+			return nil, NewHistoryNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body HistoryUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("progression", "history", err)
+			}
+			err = ValidateHistoryUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("progression", "history", err)
+			}
+			return nil, NewHistoryUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("progression", "history", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalFieldErrorResponseBodyToProgressionFieldError builds a value of
+// type *progression.FieldError from a value of type *FieldErrorResponseBody.
+func unmarshalFieldErrorResponseBodyToProgressionFieldError(v *FieldErrorResponseBody) *progression.FieldError {
+	if v == nil {
+		return nil
+	}
+	res := &progression.FieldError{
+		Field:   *v.Field,
+		Message: *v.Message,
+	}
+
+	return res
+}
+
+// unmarshalProgressionChangeResponseToProgressionProgressionChange builds a
+// value of type *progression.ProgressionChange from a value of type
+// *ProgressionChangeResponse.
+func unmarshalProgressionChangeResponseToProgressionProgressionChange(v *ProgressionChangeResponse) *progression.ProgressionChange {
+	res := &progression.ProgressionChange{
+		ID:             *v.ID,
+		SessionID:      *v.SessionID,
+		Outcome:        *v.Outcome,
+		PreviousWeight: *v.PreviousWeight,
+		NewWeight:      *v.NewWeight,
+		PreviousReps:   *v.PreviousReps,
+		NewReps:        *v.NewReps,
+		Reason:         *v.Reason,
+		CreatedAt:      *v.CreatedAt,
+	}
+
+	return res
+}
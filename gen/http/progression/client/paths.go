@@ -0,0 +1,32 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// HTTP request path constructors for the progression service.
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	"fmt"
+)
+
+// SetProgressionPath returns the URL path to the progression service set HTTP endpoint.
+func SetProgressionPath(id string) string {
+	return fmt.Sprintf("/api/v1/exercises/%v/progression", id)
+}
+
+// GetProgressionPath returns the URL path to the progression service get HTTP endpoint.
+func GetProgressionPath(id string) string {
+	return fmt.Sprintf("/api/v1/exercises/%v/progression", id)
+}
+
+// DeleteProgressionPath returns the URL path to the progression service delete HTTP endpoint.
+func DeleteProgressionPath(id string) string {
+	return fmt.Sprintf("/api/v1/exercises/%v/progression", id)
+}
+
+// HistoryProgressionPath returns the URL path to the progression service history HTTP endpoint.
+func HistoryProgressionPath(id string) string {
+	return fmt.Sprintf("/api/v1/exercises/%v/progression/history", id)
+}
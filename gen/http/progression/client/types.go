@@ -0,0 +1,1046 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// progression HTTP client types
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	progression "be/gen/progression"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// SetRequestBody is the type of the "progression" service "set" endpoint HTTP
+// request body.
+type SetRequestBody struct {
+	// Progression scheme
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Weight added when the target is met (kg)
+	Increment float64 `form:"increment" json:"increment" xml:"increment"`
+	// Bottom of the rep range, required for double progression
+	MinReps *int `form:"minReps,omitempty" json:"minReps,omitempty" xml:"minReps,omitempty"`
+	// Top of the rep range, required for double progression
+	MaxReps *int `form:"maxReps,omitempty" json:"maxReps,omitempty" xml:"maxReps,omitempty"`
+	// Fraction of the estimated one-rep max, required for percentage_of_one_rep_max
+	Percentage *float64 `form:"percentage,omitempty" json:"percentage,omitempty" xml:"percentage,omitempty"`
+	// Consecutive missed sessions before a deload, 0 to never deload
+	DeloadAfter int `form:"deloadAfter" json:"deloadAfter" xml:"deloadAfter"`
+	// Fraction of the weight removed by a deload
+	DeloadPercentage float64 `form:"deloadPercentage" json:"deloadPercentage" xml:"deloadPercentage"`
+}
+
+// SetResponseBody is the type of the "progression" service "set" endpoint HTTP
+// response body.
+type SetResponseBody struct {
+	// Rule ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Planned exercise
+	ExerciseID *string `form:"exerciseId,omitempty" json:"exerciseId,omitempty" xml:"exerciseId,omitempty"`
+	// Progression scheme
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Weight added when the target is met (kg)
+	Increment *float64 `form:"increment,omitempty" json:"increment,omitempty" xml:"increment,omitempty"`
+	// Bottom of the rep range, for double progression
+	MinReps *int `form:"minReps,omitempty" json:"minReps,omitempty" xml:"minReps,omitempty"`
+	// Top of the rep range, for double progression
+	MaxReps *int `form:"maxReps,omitempty" json:"maxReps,omitempty" xml:"maxReps,omitempty"`
+	// Fraction of the estimated one-rep max, for percentage_of_one_rep_max
+	Percentage *float64 `form:"percentage,omitempty" json:"percentage,omitempty" xml:"percentage,omitempty"`
+	// Consecutive missed sessions before a deload, 0 to never deload
+	DeloadAfter *int `form:"deloadAfter,omitempty" json:"deloadAfter,omitempty" xml:"deloadAfter,omitempty"`
+	// Fraction of the weight removed by a deload
+	DeloadPercentage *float64 `form:"deloadPercentage,omitempty" json:"deloadPercentage,omitempty" xml:"deloadPercentage,omitempty"`
+	// Consecutive sessions the target was missed
+	Misses *int `form:"misses,omitempty" json:"misses,omitempty" xml:"misses,omitempty"`
+	// Last change of the rule
+	UpdatedAt *string `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// GetResponseBody is the type of the "progression" service "get" endpoint HTTP
+// response body.
+type GetResponseBody struct {
+	// Rule ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Planned exercise
+	ExerciseID *string `form:"exerciseId,omitempty" json:"exerciseId,omitempty" xml:"exerciseId,omitempty"`
+	// Progression scheme
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Weight added when the target is met (kg)
+	Increment *float64 `form:"increment,omitempty" json:"increment,omitempty" xml:"increment,omitempty"`
+	// Bottom of the rep range, for double progression
+	MinReps *int `form:"minReps,omitempty" json:"minReps,omitempty" xml:"minReps,omitempty"`
+	// Top of the rep range, for double progression
+	MaxReps *int `form:"maxReps,omitempty" json:"maxReps,omitempty" xml:"maxReps,omitempty"`
+	// Fraction of the estimated one-rep max, for percentage_of_one_rep_max
+	Percentage *float64 `form:"percentage,omitempty" json:"percentage,omitempty" xml:"percentage,omitempty"`
+	// Consecutive missed sessions before a deload, 0 to never deload
+	DeloadAfter *int `form:"deloadAfter,omitempty" json:"deloadAfter,omitempty" xml:"deloadAfter,omitempty"`
+	// Fraction of the weight removed by a deload
+	DeloadPercentage *float64 `form:"deloadPercentage,omitempty" json:"deloadPercentage,omitempty" xml:"deloadPercentage,omitempty"`
+	// Consecutive sessions the target was missed
+	Misses *int `form:"misses,omitempty" json:"misses,omitempty" xml:"misses,omitempty"`
+	// Last change of the rule
+	UpdatedAt *string `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// HistoryResponseBody is the type of the "progression" service "history"
+// endpoint HTTP response body.
+type HistoryResponseBody []*ProgressionChangeResponse
+
+// SetBadRequestResponseBody is the type of the "progression" service "set"
+// endpoint HTTP response body for the "badRequest" error.
+type SetBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// SetForbiddenResponseBody is the type of the "progression" service "set"
+// endpoint HTTP response body for the "forbidden" error.
+type SetForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// SetInternalServerErrorResponseBody is the type of the "progression" service
+// "set" endpoint HTTP response body for the "internalServerError" error.
+type SetInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// SetNotFoundResponseBody is the type of the "progression" service "set"
+// endpoint HTTP response body for the "notFound" error.
+type SetNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// SetUnauthorizedResponseBody is the type of the "progression" service "set"
+// endpoint HTTP response body for the "unauthorized" error.
+type SetUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetBadRequestResponseBody is the type of the "progression" service "get"
+// endpoint HTTP response body for the "badRequest" error.
+type GetBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// GetForbiddenResponseBody is the type of the "progression" service "get"
+// endpoint HTTP response body for the "forbidden" error.
+type GetForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetInternalServerErrorResponseBody is the type of the "progression" service
+// "get" endpoint HTTP response body for the "internalServerError" error.
+type GetInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetNotFoundResponseBody is the type of the "progression" service "get"
+// endpoint HTTP response body for the "notFound" error.
+type GetNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetUnauthorizedResponseBody is the type of the "progression" service "get"
+// endpoint HTTP response body for the "unauthorized" error.
+type GetUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteBadRequestResponseBody is the type of the "progression" service
+// "delete" endpoint HTTP response body for the "badRequest" error.
+type DeleteBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// DeleteForbiddenResponseBody is the type of the "progression" service
+// "delete" endpoint HTTP response body for the "forbidden" error.
+type DeleteForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteInternalServerErrorResponseBody is the type of the "progression"
+// service "delete" endpoint HTTP response body for the "internalServerError"
+// error.
+type DeleteInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteNotFoundResponseBody is the type of the "progression" service "delete"
+// endpoint HTTP response body for the "notFound" error.
+type DeleteNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteUnauthorizedResponseBody is the type of the "progression" service
+// "delete" endpoint HTTP response body for the "unauthorized" error.
+type DeleteUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// HistoryBadRequestResponseBody is the type of the "progression" service
+// "history" endpoint HTTP response body for the "badRequest" error.
+type HistoryBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// HistoryForbiddenResponseBody is the type of the "progression" service
+// "history" endpoint HTTP response body for the "forbidden" error.
+type HistoryForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// HistoryInternalServerErrorResponseBody is the type of the "progression"
+// service "history" endpoint HTTP response body for the "internalServerError"
+// error.
+type HistoryInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// HistoryNotFoundResponseBody is the type of the "progression" service
+// "history" endpoint HTTP response body for the "notFound" error.
+type HistoryNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// HistoryUnauthorizedResponseBody is the type of the "progression" service
+// "history" endpoint HTTP response body for the "unauthorized" error.
+type HistoryUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+	// Motivo per cui il campo non è valido
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ProgressionChangeResponse is used to define fields on response body types.
+type ProgressionChangeResponse struct {
+	// Change ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Session that triggered the change
+	SessionID *string `form:"sessionId,omitempty" json:"sessionId,omitempty" xml:"sessionId,omitempty"`
+	// Effect on the target
+	Outcome *string `form:"outcome,omitempty" json:"outcome,omitempty" xml:"outcome,omitempty"`
+	// Previous target weight (kg)
+	PreviousWeight *float64 `form:"previousWeight,omitempty" json:"previousWeight,omitempty" xml:"previousWeight,omitempty"`
+	// New target weight (kg)
+	NewWeight *float64 `form:"newWeight,omitempty" json:"newWeight,omitempty" xml:"newWeight,omitempty"`
+	// Previous target repetitions
+	PreviousReps *int `form:"previousReps,omitempty" json:"previousReps,omitempty" xml:"previousReps,omitempty"`
+	// New target repetitions
+	NewReps *int `form:"newReps,omitempty" json:"newReps,omitempty" xml:"newReps,omitempty"`
+	// Why the change was made
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// When the change was made
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// NewSetRequestBody builds the HTTP request body from the payload of the "set"
+// endpoint of the "progression" service.
+func NewSetRequestBody(p *progression.SetPayload) *SetRequestBody {
+	body := &SetRequestBody{
+		Kind:             p.Kind,
+		Increment:        p.Increment,
+		MinReps:          p.MinReps,
+		MaxReps:          p.MaxReps,
+		Percentage:       p.Percentage,
+		DeloadAfter:      p.DeloadAfter,
+		DeloadPercentage: p.DeloadPercentage,
+	}
+	{
+		var zero float64
+		if body.Increment == zero {
+			body.Increment = 2.5
+		}
+	}
+	{
+		var zero int
+		if body.DeloadAfter == zero {
+			body.DeloadAfter = 2
+		}
+	}
+	{
+		var zero float64
+		if body.DeloadPercentage == zero {
+			body.DeloadPercentage = 0.1
+		}
+	}
+	return body
+}
+
+// NewSetProgressionRuleOK builds a "progression" service "set" endpoint result
+// from a HTTP "OK" response.
+func NewSetProgressionRuleOK(body *SetResponseBody) *progression.ProgressionRule {
+	v := &progression.ProgressionRule{
+		ID:               *body.ID,
+		ExerciseID:       *body.ExerciseID,
+		Kind:             *body.Kind,
+		Increment:        *body.Increment,
+		MinReps:          body.MinReps,
+		MaxReps:          body.MaxReps,
+		Percentage:       body.Percentage,
+		DeloadAfter:      *body.DeloadAfter,
+		DeloadPercentage: *body.DeloadPercentage,
+		Misses:           *body.Misses,
+		UpdatedAt:        *body.UpdatedAt,
+	}
+
+	return v
+}
+
+// NewSetBadRequest builds a progression service set endpoint badRequest error.
+func NewSetBadRequest(body *SetBadRequestResponseBody) *progression.BadRequest {
+	v := &progression.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*progression.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToProgressionFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewSetForbidden builds a progression service set endpoint forbidden error.
+func NewSetForbidden(body *SetForbiddenResponseBody) *progression.Forbidden {
+	v := &progression.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSetInternalServerError builds a progression service set endpoint
+// internalServerError error.
+func NewSetInternalServerError(body *SetInternalServerErrorResponseBody) *progression.InternalServerError {
+	v := &progression.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSetNotFound builds a progression service set endpoint notFound error.
+func NewSetNotFound(body *SetNotFoundResponseBody) *progression.NotFound {
+	v := &progression.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSetUnauthorized builds a progression service set endpoint unauthorized
+// error.
+func NewSetUnauthorized(body *SetUnauthorizedResponseBody) *progression.Unauthorized {
+	v := &progression.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetProgressionRuleOK builds a "progression" service "get" endpoint result
+// from a HTTP "OK" response.
+func NewGetProgressionRuleOK(body *GetResponseBody) *progression.ProgressionRule {
+	v := &progression.ProgressionRule{
+		ID:               *body.ID,
+		ExerciseID:       *body.ExerciseID,
+		Kind:             *body.Kind,
+		Increment:        *body.Increment,
+		MinReps:          body.MinReps,
+		MaxReps:          body.MaxReps,
+		Percentage:       body.Percentage,
+		DeloadAfter:      *body.DeloadAfter,
+		DeloadPercentage: *body.DeloadPercentage,
+		Misses:           *body.Misses,
+		UpdatedAt:        *body.UpdatedAt,
+	}
+
+	return v
+}
+
+// NewGetBadRequest builds a progression service get endpoint badRequest error.
+func NewGetBadRequest(body *GetBadRequestResponseBody) *progression.BadRequest {
+	v := &progression.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*progression.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToProgressionFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetForbidden builds a progression service get endpoint forbidden error.
+func NewGetForbidden(body *GetForbiddenResponseBody) *progression.Forbidden {
+	v := &progression.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetInternalServerError builds a progression service get endpoint
+// internalServerError error.
+func NewGetInternalServerError(body *GetInternalServerErrorResponseBody) *progression.InternalServerError {
+	v := &progression.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetNotFound builds a progression service get endpoint notFound error.
+func NewGetNotFound(body *GetNotFoundResponseBody) *progression.NotFound {
+	v := &progression.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetUnauthorized builds a progression service get endpoint unauthorized
+// error.
+func NewGetUnauthorized(body *GetUnauthorizedResponseBody) *progression.Unauthorized {
+	v := &progression.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteBadRequest builds a progression service delete endpoint badRequest
+// error.
+func NewDeleteBadRequest(body *DeleteBadRequestResponseBody) *progression.BadRequest {
+	v := &progression.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*progression.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToProgressionFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewDeleteForbidden builds a progression service delete endpoint forbidden
+// error.
+func NewDeleteForbidden(body *DeleteForbiddenResponseBody) *progression.Forbidden {
+	v := &progression.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteInternalServerError builds a progression service delete endpoint
+// internalServerError error.
+func NewDeleteInternalServerError(body *DeleteInternalServerErrorResponseBody) *progression.InternalServerError {
+	v := &progression.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteNotFound builds a progression service delete endpoint notFound
+// error.
+func NewDeleteNotFound(body *DeleteNotFoundResponseBody) *progression.NotFound {
+	v := &progression.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteUnauthorized builds a progression service delete endpoint
+// unauthorized error.
+func NewDeleteUnauthorized(body *DeleteUnauthorizedResponseBody) *progression.Unauthorized {
+	v := &progression.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewHistoryProgressionChangeOK builds a "progression" service "history"
+// endpoint result from a HTTP "OK" response.
+func NewHistoryProgressionChangeOK(body []*ProgressionChangeResponse) []*progression.ProgressionChange {
+	v := make([]*progression.ProgressionChange, len(body))
+	for i, val := range body {
+		v[i] = unmarshalProgressionChangeResponseToProgressionProgressionChange(val)
+	}
+
+	return v
+}
+
+// NewHistoryBadRequest builds a progression service history endpoint
+// badRequest error.
+func NewHistoryBadRequest(body *HistoryBadRequestResponseBody) *progression.BadRequest {
+	v := &progression.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*progression.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToProgressionFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewHistoryForbidden builds a progression service history endpoint forbidden
+// error.
+func NewHistoryForbidden(body *HistoryForbiddenResponseBody) *progression.Forbidden {
+	v := &progression.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewHistoryInternalServerError builds a progression service history endpoint
+// internalServerError error.
+func NewHistoryInternalServerError(body *HistoryInternalServerErrorResponseBody) *progression.InternalServerError {
+	v := &progression.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewHistoryNotFound builds a progression service history endpoint notFound
+// error.
+func NewHistoryNotFound(body *HistoryNotFoundResponseBody) *progression.NotFound {
+	v := &progression.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewHistoryUnauthorized builds a progression service history endpoint
+// unauthorized error.
+func NewHistoryUnauthorized(body *HistoryUnauthorizedResponseBody) *progression.Unauthorized {
+	v := &progression.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// ValidateSetResponseBody runs the validations defined on SetResponseBody
+func ValidateSetResponseBody(body *SetResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exerciseId", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Increment == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("increment", "body"))
+	}
+	if body.DeloadAfter == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deloadAfter", "body"))
+	}
+	if body.DeloadPercentage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deloadPercentage", "body"))
+	}
+	if body.Misses == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("misses", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.ExerciseID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.exerciseId", *body.ExerciseID, goa.FormatUUID))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "linear" || *body.Kind == "double_progression" || *body.Kind == "percentage_of_one_rep_max") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"linear", "double_progression", "percentage_of_one_rep_max"}))
+		}
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updatedAt", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateGetResponseBody runs the validations defined on GetResponseBody
+func ValidateGetResponseBody(body *GetResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exerciseId", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Increment == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("increment", "body"))
+	}
+	if body.DeloadAfter == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deloadAfter", "body"))
+	}
+	if body.DeloadPercentage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deloadPercentage", "body"))
+	}
+	if body.Misses == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("misses", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.ExerciseID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.exerciseId", *body.ExerciseID, goa.FormatUUID))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "linear" || *body.Kind == "double_progression" || *body.Kind == "percentage_of_one_rep_max") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"linear", "double_progression", "percentage_of_one_rep_max"}))
+		}
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updatedAt", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSetBadRequestResponseBody runs the validations defined on
+// set_badRequest_response_body
+func ValidateSetBadRequestResponseBody(body *SetBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSetForbiddenResponseBody runs the validations defined on
+// set_forbidden_response_body
+func ValidateSetForbiddenResponseBody(body *SetForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSetInternalServerErrorResponseBody runs the validations defined on
+// set_internalServerError_response_body
+func ValidateSetInternalServerErrorResponseBody(body *SetInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSetNotFoundResponseBody runs the validations defined on
+// set_notFound_response_body
+func ValidateSetNotFoundResponseBody(body *SetNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSetUnauthorizedResponseBody runs the validations defined on
+// set_unauthorized_response_body
+func ValidateSetUnauthorizedResponseBody(body *SetUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetBadRequestResponseBody runs the validations defined on
+// get_badRequest_response_body
+func ValidateGetBadRequestResponseBody(body *GetBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetForbiddenResponseBody runs the validations defined on
+// get_forbidden_response_body
+func ValidateGetForbiddenResponseBody(body *GetForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetInternalServerErrorResponseBody runs the validations defined on
+// get_internalServerError_response_body
+func ValidateGetInternalServerErrorResponseBody(body *GetInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetNotFoundResponseBody runs the validations defined on
+// get_notFound_response_body
+func ValidateGetNotFoundResponseBody(body *GetNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetUnauthorizedResponseBody runs the validations defined on
+// get_unauthorized_response_body
+func ValidateGetUnauthorizedResponseBody(body *GetUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteBadRequestResponseBody runs the validations defined on
+// delete_badRequest_response_body
+func ValidateDeleteBadRequestResponseBody(body *DeleteBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateDeleteForbiddenResponseBody runs the validations defined on
+// delete_forbidden_response_body
+func ValidateDeleteForbiddenResponseBody(body *DeleteForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteInternalServerErrorResponseBody runs the validations defined
+// on delete_internalServerError_response_body
+func ValidateDeleteInternalServerErrorResponseBody(body *DeleteInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteNotFoundResponseBody runs the validations defined on
+// delete_notFound_response_body
+func ValidateDeleteNotFoundResponseBody(body *DeleteNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteUnauthorizedResponseBody runs the validations defined on
+// delete_unauthorized_response_body
+func ValidateDeleteUnauthorizedResponseBody(body *DeleteUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateHistoryBadRequestResponseBody runs the validations defined on
+// history_badRequest_response_body
+func ValidateHistoryBadRequestResponseBody(body *HistoryBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateHistoryForbiddenResponseBody runs the validations defined on
+// history_forbidden_response_body
+func ValidateHistoryForbiddenResponseBody(body *HistoryForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateHistoryInternalServerErrorResponseBody runs the validations defined
+// on history_internalServerError_response_body
+func ValidateHistoryInternalServerErrorResponseBody(body *HistoryInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateHistoryNotFoundResponseBody runs the validations defined on
+// history_notFound_response_body
+func ValidateHistoryNotFoundResponseBody(body *HistoryNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateHistoryUnauthorizedResponseBody runs the validations defined on
+// history_unauthorized_response_body
+func ValidateHistoryUnauthorizedResponseBody(body *HistoryUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFieldErrorResponseBody runs the validations defined on
+// FieldErrorResponseBody
+func ValidateFieldErrorResponseBody(body *FieldErrorResponseBody) (err error) {
+	if body.Field == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("field", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateProgressionChangeResponse runs the validations defined on
+// ProgressionChangeResponse
+func ValidateProgressionChangeResponse(body *ProgressionChangeResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.SessionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sessionId", "body"))
+	}
+	if body.Outcome == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("outcome", "body"))
+	}
+	if body.PreviousWeight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("previousWeight", "body"))
+	}
+	if body.NewWeight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("newWeight", "body"))
+	}
+	if body.PreviousReps == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("previousReps", "body"))
+	}
+	if body.NewReps == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("newReps", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.SessionID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.sessionId", *body.SessionID, goa.FormatUUID))
+	}
+	if body.Outcome != nil {
+		if !(*body.Outcome == "increase" || *body.Outcome == "hold" || *body.Outcome == "decrease" || *body.Outcome == "deload") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.outcome", *body.Outcome, []any{"increase", "hold", "decrease", "deload"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
@@ -0,0 +1,697 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// progression HTTP server types
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	progression "be/gen/progression"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// SetRequestBody is the type of the "progression" service "set" endpoint HTTP
+// request body.
+type SetRequestBody struct {
+	// Progression scheme
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Weight added when the target is met (kg)
+	Increment *float64 `form:"increment,omitempty" json:"increment,omitempty" xml:"increment,omitempty"`
+	// Bottom of the rep range, required for double progression
+	MinReps *int `form:"minReps,omitempty" json:"minReps,omitempty" xml:"minReps,omitempty"`
+	// Top of the rep range, required for double progression
+	MaxReps *int `form:"maxReps,omitempty" json:"maxReps,omitempty" xml:"maxReps,omitempty"`
+	// Fraction of the estimated one-rep max, required for percentage_of_one_rep_max
+	Percentage *float64 `form:"percentage,omitempty" json:"percentage,omitempty" xml:"percentage,omitempty"`
+	// Consecutive missed sessions before a deload, 0 to never deload
+	DeloadAfter *int `form:"deloadAfter,omitempty" json:"deloadAfter,omitempty" xml:"deloadAfter,omitempty"`
+	// Fraction of the weight removed by a deload
+	DeloadPercentage *float64 `form:"deloadPercentage,omitempty" json:"deloadPercentage,omitempty" xml:"deloadPercentage,omitempty"`
+}
+
+// SetResponseBody is the type of the "progression" service "set" endpoint HTTP
+// response body.
+type SetResponseBody struct {
+	// Rule ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Planned exercise
+	ExerciseID string `form:"exerciseId" json:"exerciseId" xml:"exerciseId"`
+	// Progression scheme
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Weight added when the target is met (kg)
+	Increment float64 `form:"increment" json:"increment" xml:"increment"`
+	// Bottom of the rep range, for double progression
+	MinReps *int `form:"minReps,omitempty" json:"minReps,omitempty" xml:"minReps,omitempty"`
+	// Top of the rep range, for double progression
+	MaxReps *int `form:"maxReps,omitempty" json:"maxReps,omitempty" xml:"maxReps,omitempty"`
+	// Fraction of the estimated one-rep max, for percentage_of_one_rep_max
+	Percentage *float64 `form:"percentage,omitempty" json:"percentage,omitempty" xml:"percentage,omitempty"`
+	// Consecutive missed sessions before a deload, 0 to never deload
+	DeloadAfter int `form:"deloadAfter" json:"deloadAfter" xml:"deloadAfter"`
+	// Fraction of the weight removed by a deload
+	DeloadPercentage float64 `form:"deloadPercentage" json:"deloadPercentage" xml:"deloadPercentage"`
+	// Consecutive sessions the target was missed
+	Misses int `form:"misses" json:"misses" xml:"misses"`
+	// Last change of the rule
+	UpdatedAt string `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// GetResponseBody is the type of the "progression" service "get" endpoint HTTP
+// response body.
+type GetResponseBody struct {
+	// Rule ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Planned exercise
+	ExerciseID string `form:"exerciseId" json:"exerciseId" xml:"exerciseId"`
+	// Progression scheme
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Weight added when the target is met (kg)
+	Increment float64 `form:"increment" json:"increment" xml:"increment"`
+	// Bottom of the rep range, for double progression
+	MinReps *int `form:"minReps,omitempty" json:"minReps,omitempty" xml:"minReps,omitempty"`
+	// Top of the rep range, for double progression
+	MaxReps *int `form:"maxReps,omitempty" json:"maxReps,omitempty" xml:"maxReps,omitempty"`
+	// Fraction of the estimated one-rep max, for percentage_of_one_rep_max
+	Percentage *float64 `form:"percentage,omitempty" json:"percentage,omitempty" xml:"percentage,omitempty"`
+	// Consecutive missed sessions before a deload, 0 to never deload
+	DeloadAfter int `form:"deloadAfter" json:"deloadAfter" xml:"deloadAfter"`
+	// Fraction of the weight removed by a deload
+	DeloadPercentage float64 `form:"deloadPercentage" json:"deloadPercentage" xml:"deloadPercentage"`
+	// Consecutive sessions the target was missed
+	Misses int `form:"misses" json:"misses" xml:"misses"`
+	// Last change of the rule
+	UpdatedAt string `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// HistoryResponseBody is the type of the "progression" service "history"
+// endpoint HTTP response body.
+type HistoryResponseBody []*ProgressionChangeResponse
+
+// SetBadRequestResponseBody is the type of the "progression" service "set"
+// endpoint HTTP response body for the "badRequest" error.
+type SetBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// SetForbiddenResponseBody is the type of the "progression" service "set"
+// endpoint HTTP response body for the "forbidden" error.
+type SetForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// SetInternalServerErrorResponseBody is the type of the "progression" service
+// "set" endpoint HTTP response body for the "internalServerError" error.
+type SetInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// SetNotFoundResponseBody is the type of the "progression" service "set"
+// endpoint HTTP response body for the "notFound" error.
+type SetNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// SetUnauthorizedResponseBody is the type of the "progression" service "set"
+// endpoint HTTP response body for the "unauthorized" error.
+type SetUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetBadRequestResponseBody is the type of the "progression" service "get"
+// endpoint HTTP response body for the "badRequest" error.
+type GetBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// GetForbiddenResponseBody is the type of the "progression" service "get"
+// endpoint HTTP response body for the "forbidden" error.
+type GetForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetInternalServerErrorResponseBody is the type of the "progression" service
+// "get" endpoint HTTP response body for the "internalServerError" error.
+type GetInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetNotFoundResponseBody is the type of the "progression" service "get"
+// endpoint HTTP response body for the "notFound" error.
+type GetNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetUnauthorizedResponseBody is the type of the "progression" service "get"
+// endpoint HTTP response body for the "unauthorized" error.
+type GetUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteBadRequestResponseBody is the type of the "progression" service
+// "delete" endpoint HTTP response body for the "badRequest" error.
+type DeleteBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// DeleteForbiddenResponseBody is the type of the "progression" service
+// "delete" endpoint HTTP response body for the "forbidden" error.
+type DeleteForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteInternalServerErrorResponseBody is the type of the "progression"
+// service "delete" endpoint HTTP response body for the "internalServerError"
+// error.
+type DeleteInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteNotFoundResponseBody is the type of the "progression" service "delete"
+// endpoint HTTP response body for the "notFound" error.
+type DeleteNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteUnauthorizedResponseBody is the type of the "progression" service
+// "delete" endpoint HTTP response body for the "unauthorized" error.
+type DeleteUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// HistoryBadRequestResponseBody is the type of the "progression" service
+// "history" endpoint HTTP response body for the "badRequest" error.
+type HistoryBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// HistoryForbiddenResponseBody is the type of the "progression" service
+// "history" endpoint HTTP response body for the "forbidden" error.
+type HistoryForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// HistoryInternalServerErrorResponseBody is the type of the "progression"
+// service "history" endpoint HTTP response body for the "internalServerError"
+// error.
+type HistoryInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// HistoryNotFoundResponseBody is the type of the "progression" service
+// "history" endpoint HTTP response body for the "notFound" error.
+type HistoryNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// HistoryUnauthorizedResponseBody is the type of the "progression" service
+// "history" endpoint HTTP response body for the "unauthorized" error.
+type HistoryUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field string `form:"field" json:"field" xml:"field"`
+	// Motivo per cui il campo non è valido
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ProgressionChangeResponse is used to define fields on response body types.
+type ProgressionChangeResponse struct {
+	// Change ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Session that triggered the change
+	SessionID string `form:"sessionId" json:"sessionId" xml:"sessionId"`
+	// Effect on the target
+	Outcome string `form:"outcome" json:"outcome" xml:"outcome"`
+	// Previous target weight (kg)
+	PreviousWeight float64 `form:"previousWeight" json:"previousWeight" xml:"previousWeight"`
+	// New target weight (kg)
+	NewWeight float64 `form:"newWeight" json:"newWeight" xml:"newWeight"`
+	// Previous target repetitions
+	PreviousReps int `form:"previousReps" json:"previousReps" xml:"previousReps"`
+	// New target repetitions
+	NewReps int `form:"newReps" json:"newReps" xml:"newReps"`
+	// Why the change was made
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// When the change was made
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
+// NewSetResponseBody builds the HTTP response body from the result of the
+// "set" endpoint of the "progression" service.
+func NewSetResponseBody(res *progression.ProgressionRule) *SetResponseBody {
+	body := &SetResponseBody{
+		ID:               res.ID,
+		ExerciseID:       res.ExerciseID,
+		Kind:             res.Kind,
+		Increment:        res.Increment,
+		MinReps:          res.MinReps,
+		MaxReps:          res.MaxReps,
+		Percentage:       res.Percentage,
+		DeloadAfter:      res.DeloadAfter,
+		DeloadPercentage: res.DeloadPercentage,
+		Misses:           res.Misses,
+		UpdatedAt:        res.UpdatedAt,
+	}
+	return body
+}
+
+// NewGetResponseBody builds the HTTP response body from the result of the
+// "get" endpoint of the "progression" service.
+func NewGetResponseBody(res *progression.ProgressionRule) *GetResponseBody {
+	body := &GetResponseBody{
+		ID:               res.ID,
+		ExerciseID:       res.ExerciseID,
+		Kind:             res.Kind,
+		Increment:        res.Increment,
+		MinReps:          res.MinReps,
+		MaxReps:          res.MaxReps,
+		Percentage:       res.Percentage,
+		DeloadAfter:      res.DeloadAfter,
+		DeloadPercentage: res.DeloadPercentage,
+		Misses:           res.Misses,
+		UpdatedAt:        res.UpdatedAt,
+	}
+	return body
+}
+
+// NewHistoryResponseBody builds the HTTP response body from the result of the
+// "history" endpoint of the "progression" service.
+func NewHistoryResponseBody(res []*progression.ProgressionChange) HistoryResponseBody {
+	body := make([]*ProgressionChangeResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalProgressionProgressionChangeToProgressionChangeResponse(val)
+	}
+	return body
+}
+
+// NewSetBadRequestResponseBody builds the HTTP response body from the result
+// of the "set" endpoint of the "progression" service.
+func NewSetBadRequestResponseBody(res *progression.BadRequest) *SetBadRequestResponseBody {
+	body := &SetBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalProgressionFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewSetForbiddenResponseBody builds the HTTP response body from the result of
+// the "set" endpoint of the "progression" service.
+func NewSetForbiddenResponseBody(res *progression.Forbidden) *SetForbiddenResponseBody {
+	body := &SetForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSetInternalServerErrorResponseBody builds the HTTP response body from the
+// result of the "set" endpoint of the "progression" service.
+func NewSetInternalServerErrorResponseBody(res *progression.InternalServerError) *SetInternalServerErrorResponseBody {
+	body := &SetInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSetNotFoundResponseBody builds the HTTP response body from the result of
+// the "set" endpoint of the "progression" service.
+func NewSetNotFoundResponseBody(res *progression.NotFound) *SetNotFoundResponseBody {
+	body := &SetNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSetUnauthorizedResponseBody builds the HTTP response body from the result
+// of the "set" endpoint of the "progression" service.
+func NewSetUnauthorizedResponseBody(res *progression.Unauthorized) *SetUnauthorizedResponseBody {
+	body := &SetUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetBadRequestResponseBody builds the HTTP response body from the result
+// of the "get" endpoint of the "progression" service.
+func NewGetBadRequestResponseBody(res *progression.BadRequest) *GetBadRequestResponseBody {
+	body := &GetBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalProgressionFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetForbiddenResponseBody builds the HTTP response body from the result of
+// the "get" endpoint of the "progression" service.
+func NewGetForbiddenResponseBody(res *progression.Forbidden) *GetForbiddenResponseBody {
+	body := &GetForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetInternalServerErrorResponseBody builds the HTTP response body from the
+// result of the "get" endpoint of the "progression" service.
+func NewGetInternalServerErrorResponseBody(res *progression.InternalServerError) *GetInternalServerErrorResponseBody {
+	body := &GetInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetNotFoundResponseBody builds the HTTP response body from the result of
+// the "get" endpoint of the "progression" service.
+func NewGetNotFoundResponseBody(res *progression.NotFound) *GetNotFoundResponseBody {
+	body := &GetNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetUnauthorizedResponseBody builds the HTTP response body from the result
+// of the "get" endpoint of the "progression" service.
+func NewGetUnauthorizedResponseBody(res *progression.Unauthorized) *GetUnauthorizedResponseBody {
+	body := &GetUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteBadRequestResponseBody builds the HTTP response body from the
+// result of the "delete" endpoint of the "progression" service.
+func NewDeleteBadRequestResponseBody(res *progression.BadRequest) *DeleteBadRequestResponseBody {
+	body := &DeleteBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalProgressionFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewDeleteForbiddenResponseBody builds the HTTP response body from the result
+// of the "delete" endpoint of the "progression" service.
+func NewDeleteForbiddenResponseBody(res *progression.Forbidden) *DeleteForbiddenResponseBody {
+	body := &DeleteForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "delete" endpoint of the "progression" service.
+func NewDeleteInternalServerErrorResponseBody(res *progression.InternalServerError) *DeleteInternalServerErrorResponseBody {
+	body := &DeleteInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteNotFoundResponseBody builds the HTTP response body from the result
+// of the "delete" endpoint of the "progression" service.
+func NewDeleteNotFoundResponseBody(res *progression.NotFound) *DeleteNotFoundResponseBody {
+	body := &DeleteNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "delete" endpoint of the "progression" service.
+func NewDeleteUnauthorizedResponseBody(res *progression.Unauthorized) *DeleteUnauthorizedResponseBody {
+	body := &DeleteUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewHistoryBadRequestResponseBody builds the HTTP response body from the
+// result of the "history" endpoint of the "progression" service.
+func NewHistoryBadRequestResponseBody(res *progression.BadRequest) *HistoryBadRequestResponseBody {
+	body := &HistoryBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalProgressionFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewHistoryForbiddenResponseBody builds the HTTP response body from the
+// result of the "history" endpoint of the "progression" service.
+func NewHistoryForbiddenResponseBody(res *progression.Forbidden) *HistoryForbiddenResponseBody {
+	body := &HistoryForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewHistoryInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "history" endpoint of the "progression" service.
+func NewHistoryInternalServerErrorResponseBody(res *progression.InternalServerError) *HistoryInternalServerErrorResponseBody {
+	body := &HistoryInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewHistoryNotFoundResponseBody builds the HTTP response body from the result
+// of the "history" endpoint of the "progression" service.
+func NewHistoryNotFoundResponseBody(res *progression.NotFound) *HistoryNotFoundResponseBody {
+	body := &HistoryNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewHistoryUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "history" endpoint of the "progression" service.
+func NewHistoryUnauthorizedResponseBody(res *progression.Unauthorized) *HistoryUnauthorizedResponseBody {
+	body := &HistoryUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSetPayload builds a progression service set endpoint payload.
+func NewSetPayload(body *SetRequestBody, id string, token *string) *progression.SetPayload {
+	v := &progression.SetPayload{
+		Kind:       *body.Kind,
+		MinReps:    body.MinReps,
+		MaxReps:    body.MaxReps,
+		Percentage: body.Percentage,
+	}
+	if body.Increment != nil {
+		v.Increment = *body.Increment
+	}
+	if body.DeloadAfter != nil {
+		v.DeloadAfter = *body.DeloadAfter
+	}
+	if body.DeloadPercentage != nil {
+		v.DeloadPercentage = *body.DeloadPercentage
+	}
+	if body.Increment == nil {
+		v.Increment = 2.5
+	}
+	if body.DeloadAfter == nil {
+		v.DeloadAfter = 2
+	}
+	if body.DeloadPercentage == nil {
+		v.DeloadPercentage = 0.1
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewGetPayload builds a progression service get endpoint payload.
+func NewGetPayload(id string, token *string) *progression.GetPayload {
+	v := &progression.GetPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewDeletePayload builds a progression service delete endpoint payload.
+func NewDeletePayload(id string, token *string) *progression.DeletePayload {
+	v := &progression.DeletePayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewHistoryPayload builds a progression service history endpoint payload.
+func NewHistoryPayload(id string, limit int, offset int, token *string) *progression.HistoryPayload {
+	v := &progression.HistoryPayload{}
+	v.ID = id
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v
+}
+
+// ValidateSetRequestBody runs the validations defined on SetRequestBody
+func ValidateSetRequestBody(body *SetRequestBody) (err error) {
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "linear" || *body.Kind == "double_progression" || *body.Kind == "percentage_of_one_rep_max") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"linear", "double_progression", "percentage_of_one_rep_max"}))
+		}
+	}
+	if body.Increment != nil {
+		if *body.Increment < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.increment", *body.Increment, 0, true))
+		}
+	}
+	if body.MinReps != nil {
+		if *body.MinReps < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.minReps", *body.MinReps, 1, true))
+		}
+	}
+	if body.MaxReps != nil {
+		if *body.MaxReps < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.maxReps", *body.MaxReps, 1, true))
+		}
+	}
+	if body.Percentage != nil {
+		if *body.Percentage < 0.3 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.percentage", *body.Percentage, 0.3, true))
+		}
+	}
+	if body.Percentage != nil {
+		if *body.Percentage > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.percentage", *body.Percentage, 1, false))
+		}
+	}
+	if body.DeloadAfter != nil {
+		if *body.DeloadAfter < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.deloadAfter", *body.DeloadAfter, 0, true))
+		}
+	}
+	if body.DeloadPercentage != nil {
+		if *body.DeloadPercentage < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.deloadPercentage", *body.DeloadPercentage, 0, true))
+		}
+	}
+	if body.DeloadPercentage != nil {
+		if *body.DeloadPercentage > 0.5 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.deloadPercentage", *body.DeloadPercentage, 0.5, false))
+		}
+	}
+	return
+}
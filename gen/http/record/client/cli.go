@@ -0,0 +1,157 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// record HTTP client CLI support package
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	record "be/gen/record"
+	"fmt"
+	"strconv"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildListPayload builds the payload for the record list endpoint from CLI
+// flags.
+func BuildListPayload(recordListUserID string, recordListExerciseTypeID string, recordListKind string, recordListToken string) (*record.ListPayload, error) {
+	var err error
+	var userID *string
+	{
+		if recordListUserID != "" {
+			userID = &recordListUserID
+			err = goa.MergeErrors(err, goa.ValidateFormat("userId", *userID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var exerciseTypeID *string
+	{
+		if recordListExerciseTypeID != "" {
+			exerciseTypeID = &recordListExerciseTypeID
+			err = goa.MergeErrors(err, goa.ValidateFormat("exerciseTypeId", *exerciseTypeID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var kind *string
+	{
+		if recordListKind != "" {
+			kind = &recordListKind
+			if !(*kind == "max_weight" || *kind == "max_reps" || *kind == "estimated_one_rep_max" || *kind == "session_volume") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", *kind, []any{"max_weight", "max_reps", "estimated_one_rep_max", "session_volume"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if recordListToken != "" {
+			token = &recordListToken
+		}
+	}
+	v := &record.ListPayload{}
+	v.UserID = userID
+	v.ExerciseTypeID = exerciseTypeID
+	v.Kind = kind
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildHistoryPayload builds the payload for the record history endpoint from
+// CLI flags.
+func BuildHistoryPayload(recordHistoryUserID string, recordHistoryExerciseTypeID string, recordHistoryKind string, recordHistoryLimit string, recordHistoryOffset string, recordHistoryToken string) (*record.HistoryPayload, error) {
+	var err error
+	var userID *string
+	{
+		if recordHistoryUserID != "" {
+			userID = &recordHistoryUserID
+			err = goa.MergeErrors(err, goa.ValidateFormat("userId", *userID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var exerciseTypeID *string
+	{
+		if recordHistoryExerciseTypeID != "" {
+			exerciseTypeID = &recordHistoryExerciseTypeID
+			err = goa.MergeErrors(err, goa.ValidateFormat("exerciseTypeId", *exerciseTypeID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var kind *string
+	{
+		if recordHistoryKind != "" {
+			kind = &recordHistoryKind
+			if !(*kind == "max_weight" || *kind == "max_reps" || *kind == "estimated_one_rep_max" || *kind == "session_volume") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", *kind, []any{"max_weight", "max_reps", "estimated_one_rep_max", "session_volume"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if recordHistoryLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(recordHistoryLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var offset int
+	{
+		if recordHistoryOffset != "" {
+			var v int64
+			v, err = strconv.ParseInt(recordHistoryOffset, 10, strconv.IntSize)
+			offset = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for offset, must be INT")
+			}
+			if offset < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("offset", offset, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if recordHistoryToken != "" {
+			token = &recordHistoryToken
+		}
+	}
+	v := &record.HistoryPayload{}
+	v.UserID = userID
+	v.ExerciseTypeID = exerciseTypeID
+	v.Kind = kind
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v, nil
+}
@@ -0,0 +1,396 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// record HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	record "be/gen/record"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "record" service "list" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListRecordPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("record", "list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListRequest returns an encoder for requests sent to the record list
+// server.
+func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*record.ListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("record", "list", "*record.ListPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.UserID != nil {
+			values.Add("userId", *p.UserID)
+		}
+		if p.ExerciseTypeID != nil {
+			values.Add("exerciseTypeId", *p.ExerciseTypeID)
+		}
+		if p.Kind != nil {
+			values.Add("kind", *p.Kind)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListResponse returns a decoder for responses returned by the record
+// list endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeListResponse may return the following errors:
+//   - "badRequest" (type *record.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *record.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *record.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *record.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *record.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("record", "list", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidatePersonalRecordResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("record", "list", err)
+			}
+			res := NewListPersonalRecordOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("record", "list", err)
+			}
+			err = ValidateListBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("record", "list", err)
+			}
+			return nil, NewListBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ListForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("record", "list", err)
+			}
+			err = ValidateListForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("record", "list", err)
+			}
+			return nil, NewListForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("record", "list", err)
+			}
+			err = ValidateListInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("record", "list", err)
+			}
+			return nil, NewListInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("record", "list", err)
+			}
+			err = ValidateListNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("record", "list", err)
+			}
+			return nil, NewListNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("record", "list", err)
+			}
+			err = ValidateListUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("record", "list", err)
+			}
+			return nil, NewListUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("record", "list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildHistoryRequest instantiates a HTTP request object with method and path
+// set to call the "record" service "history" endpoint
+func (c *Client) BuildHistoryRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: HistoryRecordPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("record", "history", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeHistoryRequest returns an encoder for requests sent to the record
+// history server.
+func EncodeHistoryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*record.HistoryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("record", "history", "*record.HistoryPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.UserID != nil {
+			values.Add("userId", *p.UserID)
+		}
+		if p.ExerciseTypeID != nil {
+			values.Add("exerciseTypeId", *p.ExerciseTypeID)
+		}
+		if p.Kind != nil {
+			values.Add("kind", *p.Kind)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		values.Add("offset", fmt.Sprintf("%v", p.Offset))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeHistoryResponse returns a decoder for responses returned by the record
+// history endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeHistoryResponse may return the following errors:
+//   - "badRequest" (type *record.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *record.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *record.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *record.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *record.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeHistoryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body HistoryResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("record", "history", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidatePersonalRecordResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("record", "history", err)
+			}
+			res := NewHistoryPersonalRecordOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body HistoryBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("record", "history", err)
+			}
+			err = ValidateHistoryBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("record", "history", err)
+			}
+			return nil, NewHistoryBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body HistoryForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("record", "history", err)
+			}
+			err = ValidateHistoryForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("record", "history", err)
+			}
+			return nil, NewHistoryForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body HistoryInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("record", "history", err)
+			}
+			err = ValidateHistoryInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("record", "history", err)
+			}
+			return nil, NewHistoryInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body HistoryNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("record", "history", err)
+			}
+			err = ValidateHistoryNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("record", "history", err)
+			}
+			return nil, NewHistoryNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body HistoryUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("record", "history", err)
+			}
+			err = ValidateHistoryUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("record", "history", err)
+			}
+			return nil, NewHistoryUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("record", "history", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalPersonalRecordResponseToRecordPersonalRecord builds a value of type
+// *record.PersonalRecord from a value of type *PersonalRecordResponse.
+func unmarshalPersonalRecordResponseToRecordPersonalRecord(v *PersonalRecordResponse) *record.PersonalRecord {
+	res := &record.PersonalRecord{
+		ID:             *v.ID,
+		UserID:         *v.UserID,
+		ExerciseTypeID: *v.ExerciseTypeID,
+		Kind:           *v.Kind,
+		Value:          *v.Value,
+		PreviousValue:  v.PreviousValue,
+		Weight:         v.Weight,
+		Reps:           v.Reps,
+		SessionID:      v.SessionID,
+		SetID:          v.SetID,
+		AchievedAt:     *v.AchievedAt,
+	}
+
+	return res
+}
+
+// unmarshalFieldErrorResponseBodyToRecordFieldError builds a value of type
+// *record.FieldError from a value of type *FieldErrorResponseBody.
+func unmarshalFieldErrorResponseBodyToRecordFieldError(v *FieldErrorResponseBody) *record.FieldError {
+	if v == nil {
+		return nil
+	}
+	res := &record.FieldError{
+		Field:   *v.Field,
+		Message: *v.Message,
+	}
+
+	return res
+}
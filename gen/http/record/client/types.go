@@ -0,0 +1,481 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// record HTTP client types
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	record "be/gen/record"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// ListResponseBody is the type of the "record" service "list" endpoint HTTP
+// response body.
+type ListResponseBody []*PersonalRecordResponse
+
+// HistoryResponseBody is the type of the "record" service "history" endpoint
+// HTTP response body.
+type HistoryResponseBody []*PersonalRecordResponse
+
+// ListBadRequestResponseBody is the type of the "record" service "list"
+// endpoint HTTP response body for the "badRequest" error.
+type ListBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListForbiddenResponseBody is the type of the "record" service "list"
+// endpoint HTTP response body for the "forbidden" error.
+type ListForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListInternalServerErrorResponseBody is the type of the "record" service
+// "list" endpoint HTTP response body for the "internalServerError" error.
+type ListInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListNotFoundResponseBody is the type of the "record" service "list" endpoint
+// HTTP response body for the "notFound" error.
+type ListNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListUnauthorizedResponseBody is the type of the "record" service "list"
+// endpoint HTTP response body for the "unauthorized" error.
+type ListUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// HistoryBadRequestResponseBody is the type of the "record" service "history"
+// endpoint HTTP response body for the "badRequest" error.
+type HistoryBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// HistoryForbiddenResponseBody is the type of the "record" service "history"
+// endpoint HTTP response body for the "forbidden" error.
+type HistoryForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// HistoryInternalServerErrorResponseBody is the type of the "record" service
+// "history" endpoint HTTP response body for the "internalServerError" error.
+type HistoryInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// HistoryNotFoundResponseBody is the type of the "record" service "history"
+// endpoint HTTP response body for the "notFound" error.
+type HistoryNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// HistoryUnauthorizedResponseBody is the type of the "record" service
+// "history" endpoint HTTP response body for the "unauthorized" error.
+type HistoryUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PersonalRecordResponse is used to define fields on response body types.
+type PersonalRecordResponse struct {
+	// Record ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Athlete holding the record
+	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Exercise type
+	ExerciseTypeID *string `form:"exerciseTypeId,omitempty" json:"exerciseTypeId,omitempty" xml:"exerciseTypeId,omitempty"`
+	// Record kind
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Record value: kg, reps or kg x reps depending on the kind
+	Value *float64 `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// Best value before this record
+	PreviousValue *float64 `form:"previousValue,omitempty" json:"previousValue,omitempty" xml:"previousValue,omitempty"`
+	// Weight of the set in kg
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Repetitions of the set
+	Reps *int `form:"reps,omitempty" json:"reps,omitempty" xml:"reps,omitempty"`
+	// Session the record was set in
+	SessionID *string `form:"sessionId,omitempty" json:"sessionId,omitempty" xml:"sessionId,omitempty"`
+	// Set that set the record
+	SetID *string `form:"setId,omitempty" json:"setId,omitempty" xml:"setId,omitempty"`
+	// When the record was set
+	AchievedAt *string `form:"achievedAt,omitempty" json:"achievedAt,omitempty" xml:"achievedAt,omitempty"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+	// Motivo per cui il campo non è valido
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// NewListPersonalRecordOK builds a "record" service "list" endpoint result
+// from a HTTP "OK" response.
+func NewListPersonalRecordOK(body []*PersonalRecordResponse) []*record.PersonalRecord {
+	v := make([]*record.PersonalRecord, len(body))
+	for i, val := range body {
+		v[i] = unmarshalPersonalRecordResponseToRecordPersonalRecord(val)
+	}
+
+	return v
+}
+
+// NewListBadRequest builds a record service list endpoint badRequest error.
+func NewListBadRequest(body *ListBadRequestResponseBody) *record.BadRequest {
+	v := &record.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*record.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToRecordFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewListForbidden builds a record service list endpoint forbidden error.
+func NewListForbidden(body *ListForbiddenResponseBody) *record.Forbidden {
+	v := &record.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListInternalServerError builds a record service list endpoint
+// internalServerError error.
+func NewListInternalServerError(body *ListInternalServerErrorResponseBody) *record.InternalServerError {
+	v := &record.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListNotFound builds a record service list endpoint notFound error.
+func NewListNotFound(body *ListNotFoundResponseBody) *record.NotFound {
+	v := &record.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListUnauthorized builds a record service list endpoint unauthorized error.
+func NewListUnauthorized(body *ListUnauthorizedResponseBody) *record.Unauthorized {
+	v := &record.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewHistoryPersonalRecordOK builds a "record" service "history" endpoint
+// result from a HTTP "OK" response.
+func NewHistoryPersonalRecordOK(body []*PersonalRecordResponse) []*record.PersonalRecord {
+	v := make([]*record.PersonalRecord, len(body))
+	for i, val := range body {
+		v[i] = unmarshalPersonalRecordResponseToRecordPersonalRecord(val)
+	}
+
+	return v
+}
+
+// NewHistoryBadRequest builds a record service history endpoint badRequest
+// error.
+func NewHistoryBadRequest(body *HistoryBadRequestResponseBody) *record.BadRequest {
+	v := &record.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*record.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToRecordFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewHistoryForbidden builds a record service history endpoint forbidden error.
+func NewHistoryForbidden(body *HistoryForbiddenResponseBody) *record.Forbidden {
+	v := &record.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewHistoryInternalServerError builds a record service history endpoint
+// internalServerError error.
+func NewHistoryInternalServerError(body *HistoryInternalServerErrorResponseBody) *record.InternalServerError {
+	v := &record.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewHistoryNotFound builds a record service history endpoint notFound error.
+func NewHistoryNotFound(body *HistoryNotFoundResponseBody) *record.NotFound {
+	v := &record.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewHistoryUnauthorized builds a record service history endpoint unauthorized
+// error.
+func NewHistoryUnauthorized(body *HistoryUnauthorizedResponseBody) *record.Unauthorized {
+	v := &record.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// ValidateListBadRequestResponseBody runs the validations defined on
+// list_badRequest_response_body
+func ValidateListBadRequestResponseBody(body *ListBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListForbiddenResponseBody runs the validations defined on
+// list_forbidden_response_body
+func ValidateListForbiddenResponseBody(body *ListForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListInternalServerErrorResponseBody runs the validations defined on
+// list_internalServerError_response_body
+func ValidateListInternalServerErrorResponseBody(body *ListInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListNotFoundResponseBody runs the validations defined on
+// list_notFound_response_body
+func ValidateListNotFoundResponseBody(body *ListNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListUnauthorizedResponseBody runs the validations defined on
+// list_unauthorized_response_body
+func ValidateListUnauthorizedResponseBody(body *ListUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateHistoryBadRequestResponseBody runs the validations defined on
+// history_badRequest_response_body
+func ValidateHistoryBadRequestResponseBody(body *HistoryBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateHistoryForbiddenResponseBody runs the validations defined on
+// history_forbidden_response_body
+func ValidateHistoryForbiddenResponseBody(body *HistoryForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateHistoryInternalServerErrorResponseBody runs the validations defined
+// on history_internalServerError_response_body
+func ValidateHistoryInternalServerErrorResponseBody(body *HistoryInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateHistoryNotFoundResponseBody runs the validations defined on
+// history_notFound_response_body
+func ValidateHistoryNotFoundResponseBody(body *HistoryNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateHistoryUnauthorizedResponseBody runs the validations defined on
+// history_unauthorized_response_body
+func ValidateHistoryUnauthorizedResponseBody(body *HistoryUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePersonalRecordResponse runs the validations defined on
+// PersonalRecordResponse
+func ValidatePersonalRecordResponse(body *PersonalRecordResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	}
+	if body.ExerciseTypeID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exerciseTypeId", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
+	}
+	if body.AchievedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("achievedAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.UserID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
+	}
+	if body.ExerciseTypeID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.exerciseTypeId", *body.ExerciseTypeID, goa.FormatUUID))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "max_weight" || *body.Kind == "max_reps" || *body.Kind == "estimated_one_rep_max" || *body.Kind == "session_volume") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"max_weight", "max_reps", "estimated_one_rep_max", "session_volume"}))
+		}
+	}
+	if body.SessionID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.sessionId", *body.SessionID, goa.FormatUUID))
+	}
+	if body.SetID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.setId", *body.SetID, goa.FormatUUID))
+	}
+	if body.AchievedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.achievedAt", *body.AchievedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateFieldErrorResponseBody runs the validations defined on
+// FieldErrorResponseBody
+func ValidateFieldErrorResponseBody(body *FieldErrorResponseBody) (err error) {
+	if body.Field == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("field", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
@@ -0,0 +1,18 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// HTTP request path constructors for the record service.
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+// ListRecordPath returns the URL path to the record service list HTTP endpoint.
+func ListRecordPath() string {
+	return "/api/v1/records"
+}
+
+// HistoryRecordPath returns the URL path to the record service history HTTP endpoint.
+func HistoryRecordPath() string {
+	return "/api/v1/records/history"
+}
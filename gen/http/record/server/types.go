@@ -0,0 +1,304 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// record HTTP server types
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	record "be/gen/record"
+)
+
+// ListResponseBody is the type of the "record" service "list" endpoint HTTP
+// response body.
+type ListResponseBody []*PersonalRecordResponse
+
+// HistoryResponseBody is the type of the "record" service "history" endpoint
+// HTTP response body.
+type HistoryResponseBody []*PersonalRecordResponse
+
+// ListBadRequestResponseBody is the type of the "record" service "list"
+// endpoint HTTP response body for the "badRequest" error.
+type ListBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListForbiddenResponseBody is the type of the "record" service "list"
+// endpoint HTTP response body for the "forbidden" error.
+type ListForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListInternalServerErrorResponseBody is the type of the "record" service
+// "list" endpoint HTTP response body for the "internalServerError" error.
+type ListInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListNotFoundResponseBody is the type of the "record" service "list" endpoint
+// HTTP response body for the "notFound" error.
+type ListNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListUnauthorizedResponseBody is the type of the "record" service "list"
+// endpoint HTTP response body for the "unauthorized" error.
+type ListUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// HistoryBadRequestResponseBody is the type of the "record" service "history"
+// endpoint HTTP response body for the "badRequest" error.
+type HistoryBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// HistoryForbiddenResponseBody is the type of the "record" service "history"
+// endpoint HTTP response body for the "forbidden" error.
+type HistoryForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// HistoryInternalServerErrorResponseBody is the type of the "record" service
+// "history" endpoint HTTP response body for the "internalServerError" error.
+type HistoryInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// HistoryNotFoundResponseBody is the type of the "record" service "history"
+// endpoint HTTP response body for the "notFound" error.
+type HistoryNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// HistoryUnauthorizedResponseBody is the type of the "record" service
+// "history" endpoint HTTP response body for the "unauthorized" error.
+type HistoryUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PersonalRecordResponse is used to define fields on response body types.
+type PersonalRecordResponse struct {
+	// Record ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Athlete holding the record
+	UserID string `form:"userId" json:"userId" xml:"userId"`
+	// Exercise type
+	ExerciseTypeID string `form:"exerciseTypeId" json:"exerciseTypeId" xml:"exerciseTypeId"`
+	// Record kind
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Record value: kg, reps or kg x reps depending on the kind
+	Value float64 `form:"value" json:"value" xml:"value"`
+	// Best value before this record
+	PreviousValue *float64 `form:"previousValue,omitempty" json:"previousValue,omitempty" xml:"previousValue,omitempty"`
+	// Weight of the set in kg
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Repetitions of the set
+	Reps *int `form:"reps,omitempty" json:"reps,omitempty" xml:"reps,omitempty"`
+	// Session the record was set in
+	SessionID *string `form:"sessionId,omitempty" json:"sessionId,omitempty" xml:"sessionId,omitempty"`
+	// Set that set the record
+	SetID *string `form:"setId,omitempty" json:"setId,omitempty" xml:"setId,omitempty"`
+	// When the record was set
+	AchievedAt string `form:"achievedAt" json:"achievedAt" xml:"achievedAt"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field string `form:"field" json:"field" xml:"field"`
+	// Motivo per cui il campo non è valido
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "list" endpoint of the "record" service.
+func NewListResponseBody(res []*record.PersonalRecord) ListResponseBody {
+	body := make([]*PersonalRecordResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalRecordPersonalRecordToPersonalRecordResponse(val)
+	}
+	return body
+}
+
+// NewHistoryResponseBody builds the HTTP response body from the result of the
+// "history" endpoint of the "record" service.
+func NewHistoryResponseBody(res []*record.PersonalRecord) HistoryResponseBody {
+	body := make([]*PersonalRecordResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalRecordPersonalRecordToPersonalRecordResponse(val)
+	}
+	return body
+}
+
+// NewListBadRequestResponseBody builds the HTTP response body from the result
+// of the "list" endpoint of the "record" service.
+func NewListBadRequestResponseBody(res *record.BadRequest) *ListBadRequestResponseBody {
+	body := &ListBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalRecordFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewListForbiddenResponseBody builds the HTTP response body from the result
+// of the "list" endpoint of the "record" service.
+func NewListForbiddenResponseBody(res *record.Forbidden) *ListForbiddenResponseBody {
+	body := &ListForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "list" endpoint of the "record" service.
+func NewListInternalServerErrorResponseBody(res *record.InternalServerError) *ListInternalServerErrorResponseBody {
+	body := &ListInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListNotFoundResponseBody builds the HTTP response body from the result of
+// the "list" endpoint of the "record" service.
+func NewListNotFoundResponseBody(res *record.NotFound) *ListNotFoundResponseBody {
+	body := &ListNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "list" endpoint of the "record" service.
+func NewListUnauthorizedResponseBody(res *record.Unauthorized) *ListUnauthorizedResponseBody {
+	body := &ListUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewHistoryBadRequestResponseBody builds the HTTP response body from the
+// result of the "history" endpoint of the "record" service.
+func NewHistoryBadRequestResponseBody(res *record.BadRequest) *HistoryBadRequestResponseBody {
+	body := &HistoryBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalRecordFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewHistoryForbiddenResponseBody builds the HTTP response body from the
+// result of the "history" endpoint of the "record" service.
+func NewHistoryForbiddenResponseBody(res *record.Forbidden) *HistoryForbiddenResponseBody {
+	body := &HistoryForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewHistoryInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "history" endpoint of the "record" service.
+func NewHistoryInternalServerErrorResponseBody(res *record.InternalServerError) *HistoryInternalServerErrorResponseBody {
+	body := &HistoryInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewHistoryNotFoundResponseBody builds the HTTP response body from the result
+// of the "history" endpoint of the "record" service.
+func NewHistoryNotFoundResponseBody(res *record.NotFound) *HistoryNotFoundResponseBody {
+	body := &HistoryNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewHistoryUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "history" endpoint of the "record" service.
+func NewHistoryUnauthorizedResponseBody(res *record.Unauthorized) *HistoryUnauthorizedResponseBody {
+	body := &HistoryUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListPayload builds a record service list endpoint payload.
+func NewListPayload(userID *string, exerciseTypeID *string, kind *string, token *string) *record.ListPayload {
+	v := &record.ListPayload{}
+	v.UserID = userID
+	v.ExerciseTypeID = exerciseTypeID
+	v.Kind = kind
+	v.Token = token
+
+	return v
+}
+
+// NewHistoryPayload builds a record service history endpoint payload.
+func NewHistoryPayload(userID *string, exerciseTypeID *string, kind *string, limit int, offset int, token *string) *record.HistoryPayload {
+	v := &record.HistoryPayload{}
+	v.UserID = userID
+	v.ExerciseTypeID = exerciseTypeID
+	v.Kind = kind
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v
+}
@@ -0,0 +1,171 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// schedule HTTP client CLI support package
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	schedule "be/gen/schedule"
+	"encoding/json"
+	"fmt"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildSetPayload builds the payload for the schedule set endpoint from CLI
+// flags.
+func BuildSetPayload(scheduleSetBody string, scheduleSetID string, scheduleSetToken string) (*schedule.SetPayload, error) {
+	var err error
+	var body SetRequestBody
+	{
+		err = json.Unmarshal([]byte(scheduleSetBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"dates\": [\n         \"2025-03-27\"\n      ],\n      \"position\": 1,\n      \"recurrence\": \"FREQ=WEEKLY;BYDAY=MO,TH\"\n   }'")
+		}
+		if body.Position < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.position", body.Position, 0, true))
+		}
+		for _, e := range body.Dates {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.dates[*]", e, goa.FormatDate))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = scheduleSetID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if scheduleSetToken != "" {
+			token = &scheduleSetToken
+		}
+	}
+	v := &schedule.SetPayload{
+		Position:   body.Position,
+		Recurrence: body.Recurrence,
+	}
+	{
+		var zero int
+		if v.Position == zero {
+			v.Position = 0
+		}
+	}
+	if body.Dates != nil {
+		v.Dates = make([]string, len(body.Dates))
+		for i, val := range body.Dates {
+			v.Dates[i] = val
+		}
+	}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCalendarPayload builds the payload for the schedule calendar endpoint
+// from CLI flags.
+func BuildCalendarPayload(scheduleCalendarFrom string, scheduleCalendarTo string, scheduleCalendarToken string) (*schedule.CalendarPayload, error) {
+	var err error
+	var from string
+	{
+		from = scheduleCalendarFrom
+		err = goa.MergeErrors(err, goa.ValidateFormat("from", from, goa.FormatDate))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var to string
+	{
+		to = scheduleCalendarTo
+		err = goa.MergeErrors(err, goa.ValidateFormat("to", to, goa.FormatDate))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if scheduleCalendarToken != "" {
+			token = &scheduleCalendarToken
+		}
+	}
+	v := &schedule.CalendarPayload{}
+	v.From = from
+	v.To = to
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildTodayPayload builds the payload for the schedule today endpoint from
+// CLI flags.
+func BuildTodayPayload(scheduleTodayTimezone string, scheduleTodayToken string) (*schedule.TodayPayload, error) {
+	var timezone *string
+	{
+		if scheduleTodayTimezone != "" {
+			timezone = &scheduleTodayTimezone
+		}
+	}
+	var token *string
+	{
+		if scheduleTodayToken != "" {
+			token = &scheduleTodayToken
+		}
+	}
+	v := &schedule.TodayPayload{}
+	v.Timezone = timezone
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCreateFeedPayload builds the payload for the schedule createFeed
+// endpoint from CLI flags.
+func BuildCreateFeedPayload(scheduleCreateFeedToken string) (*schedule.CreateFeedPayload, error) {
+	var token *string
+	{
+		if scheduleCreateFeedToken != "" {
+			token = &scheduleCreateFeedToken
+		}
+	}
+	v := &schedule.CreateFeedPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRevokeFeedPayload builds the payload for the schedule revokeFeed
+// endpoint from CLI flags.
+func BuildRevokeFeedPayload(scheduleRevokeFeedToken string) (*schedule.RevokeFeedPayload, error) {
+	var token *string
+	{
+		if scheduleRevokeFeedToken != "" {
+			token = &scheduleRevokeFeedToken
+		}
+	}
+	v := &schedule.RevokeFeedPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildFeedPayload builds the payload for the schedule feed endpoint from CLI
+// flags.
+func BuildFeedPayload(scheduleFeedFeedToken string) (*schedule.FeedPayload, error) {
+	var feedToken string
+	{
+		feedToken = scheduleFeedFeedToken
+	}
+	v := &schedule.FeedPayload{}
+	v.FeedToken = feedToken
+
+	return v, nil
+}
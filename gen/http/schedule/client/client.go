@@ -0,0 +1,218 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// schedule client HTTP transport
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	schedule "be/gen/schedule"
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the schedule service endpoint HTTP clients.
+type Client struct {
+	// Set Doer is the HTTP client used to make requests to the set endpoint.
+	SetDoer goahttp.Doer
+
+	// Calendar Doer is the HTTP client used to make requests to the calendar
+	// endpoint.
+	CalendarDoer goahttp.Doer
+
+	// Today Doer is the HTTP client used to make requests to the today endpoint.
+	TodayDoer goahttp.Doer
+
+	// CreateFeed Doer is the HTTP client used to make requests to the createFeed
+	// endpoint.
+	CreateFeedDoer goahttp.Doer
+
+	// RevokeFeed Doer is the HTTP client used to make requests to the revokeFeed
+	// endpoint.
+	RevokeFeedDoer goahttp.Doer
+
+	// Feed Doer is the HTTP client used to make requests to the feed endpoint.
+	FeedDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the schedule service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		SetDoer:             doer,
+		CalendarDoer:        doer,
+		TodayDoer:           doer,
+		CreateFeedDoer:      doer,
+		RevokeFeedDoer:      doer,
+		FeedDoer:            doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// Set returns an endpoint that makes HTTP requests to the schedule service set
+// server.
+func (c *Client) Set() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSetRequest(c.encoder)
+		decodeResponse = DecodeSetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("schedule", "set", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Calendar returns an endpoint that makes HTTP requests to the schedule
+// service calendar server.
+func (c *Client) Calendar() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCalendarRequest(c.encoder)
+		decodeResponse = DecodeCalendarResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCalendarRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CalendarDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("schedule", "calendar", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Today returns an endpoint that makes HTTP requests to the schedule service
+// today server.
+func (c *Client) Today() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeTodayRequest(c.encoder)
+		decodeResponse = DecodeTodayResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildTodayRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.TodayDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("schedule", "today", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateFeed returns an endpoint that makes HTTP requests to the schedule
+// service createFeed server.
+func (c *Client) CreateFeed() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateFeedRequest(c.encoder)
+		decodeResponse = DecodeCreateFeedResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateFeedRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateFeedDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("schedule", "createFeed", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RevokeFeed returns an endpoint that makes HTTP requests to the schedule
+// service revokeFeed server.
+func (c *Client) RevokeFeed() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRevokeFeedRequest(c.encoder)
+		decodeResponse = DecodeRevokeFeedResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRevokeFeedRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RevokeFeedDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("schedule", "revokeFeed", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Feed returns an endpoint that makes HTTP requests to the schedule service
+// feed server.
+func (c *Client) Feed() goa.Endpoint {
+	var (
+		decodeResponse = DecodeFeedResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildFeedRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.FeedDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("schedule", "feed", err)
+		}
+		res, err := decodeResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &schedule.FeedResponseData{Result: res.(*schedule.FeedResult), Body: resp.Body}, nil
+	}
+}
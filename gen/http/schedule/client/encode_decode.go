@@ -0,0 +1,981 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// schedule HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	schedule "be/gen/schedule"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildSetRequest instantiates a HTTP request object with method and path set
+// to call the "schedule" service "set" endpoint
+func (c *Client) BuildSetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*schedule.SetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("schedule", "set", "*schedule.SetPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SetSchedulePath(id)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("schedule", "set", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSetRequest returns an encoder for requests sent to the schedule set
+// server.
+func EncodeSetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*schedule.SetPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("schedule", "set", "*schedule.SetPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewSetRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("schedule", "set", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSetResponse returns a decoder for responses returned by the schedule
+// set endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeSetResponse may return the following errors:
+//   - "badRequest" (type *schedule.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *schedule.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *schedule.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *schedule.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *schedule.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeSetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "set", err)
+			}
+			err = ValidateSetResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "set", err)
+			}
+			res := NewSetWorkoutScheduleOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body SetBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "set", err)
+			}
+			err = ValidateSetBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "set", err)
+			}
+			return nil, NewSetBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body SetForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "set", err)
+			}
+			err = ValidateSetForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "set", err)
+			}
+			return nil, NewSetForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body SetInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "set", err)
+			}
+			err = ValidateSetInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "set", err)
+			}
+			return nil, NewSetInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body SetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "set", err)
+			}
+			err = ValidateSetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "set", err)
+			}
+			return nil, NewSetNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body SetUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "set", err)
+			}
+			err = ValidateSetUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "set", err)
+			}
+			return nil, NewSetUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("schedule", "set", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCalendarRequest instantiates a HTTP request object with method and path
+// set to call the "schedule" service "calendar" endpoint
+func (c *Client) BuildCalendarRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CalendarSchedulePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("schedule", "calendar", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCalendarRequest returns an encoder for requests sent to the schedule
+// calendar server.
+func EncodeCalendarRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*schedule.CalendarPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("schedule", "calendar", "*schedule.CalendarPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("from", p.From)
+		values.Add("to", p.To)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeCalendarResponse returns a decoder for responses returned by the
+// schedule calendar endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCalendarResponse may return the following errors:
+//   - "badRequest" (type *schedule.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *schedule.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *schedule.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *schedule.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *schedule.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCalendarResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CalendarResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "calendar", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateCalendarEntryResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "calendar", err)
+			}
+			res := NewCalendarEntry2OK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CalendarBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "calendar", err)
+			}
+			err = ValidateCalendarBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "calendar", err)
+			}
+			return nil, NewCalendarBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body CalendarForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "calendar", err)
+			}
+			err = ValidateCalendarForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "calendar", err)
+			}
+			return nil, NewCalendarForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CalendarInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "calendar", err)
+			}
+			err = ValidateCalendarInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "calendar", err)
+			}
+			return nil, NewCalendarInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body CalendarNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "calendar", err)
+			}
+			err = ValidateCalendarNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "calendar", err)
+			}
+			return nil, NewCalendarNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CalendarUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "calendar", err)
+			}
+			err = ValidateCalendarUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "calendar", err)
+			}
+			return nil, NewCalendarUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("schedule", "calendar", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildTodayRequest instantiates a HTTP request object with method and path
+// set to call the "schedule" service "today" endpoint
+func (c *Client) BuildTodayRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: TodaySchedulePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("schedule", "today", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeTodayRequest returns an encoder for requests sent to the schedule
+// today server.
+func EncodeTodayRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*schedule.TodayPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("schedule", "today", "*schedule.TodayPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Timezone != nil {
+			values.Add("timezone", *p.Timezone)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeTodayResponse returns a decoder for responses returned by the schedule
+// today endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeTodayResponse may return the following errors:
+//   - "badRequest" (type *schedule.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *schedule.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *schedule.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *schedule.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *schedule.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeTodayResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body TodayResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "today", err)
+			}
+			err = ValidateTodayResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "today", err)
+			}
+			res := NewTodayWorkoutsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body TodayBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "today", err)
+			}
+			err = ValidateTodayBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "today", err)
+			}
+			return nil, NewTodayBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body TodayForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "today", err)
+			}
+			err = ValidateTodayForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "today", err)
+			}
+			return nil, NewTodayForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body TodayInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "today", err)
+			}
+			err = ValidateTodayInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "today", err)
+			}
+			return nil, NewTodayInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body TodayNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "today", err)
+			}
+			err = ValidateTodayNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "today", err)
+			}
+			return nil, NewTodayNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body TodayUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "today", err)
+			}
+			err = ValidateTodayUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "today", err)
+			}
+			return nil, NewTodayUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("schedule", "today", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateFeedRequest instantiates a HTTP request object with method and
+// path set to call the "schedule" service "createFeed" endpoint
+func (c *Client) BuildCreateFeedRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateFeedSchedulePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("schedule", "createFeed", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateFeedRequest returns an encoder for requests sent to the schedule
+// createFeed server.
+func EncodeCreateFeedRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*schedule.CreateFeedPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("schedule", "createFeed", "*schedule.CreateFeedPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeCreateFeedResponse returns a decoder for responses returned by the
+// schedule createFeed endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCreateFeedResponse may return the following errors:
+//   - "badRequest" (type *schedule.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *schedule.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *schedule.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *schedule.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *schedule.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateFeedResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateFeedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "createFeed", err)
+			}
+			err = ValidateCreateFeedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "createFeed", err)
+			}
+			res := NewCreateFeedCalendarFeedCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateFeedBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "createFeed", err)
+			}
+			err = ValidateCreateFeedBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "createFeed", err)
+			}
+			return nil, NewCreateFeedBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateFeedForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "createFeed", err)
+			}
+			err = ValidateCreateFeedForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "createFeed", err)
+			}
+			return nil, NewCreateFeedForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateFeedInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "createFeed", err)
+			}
+			err = ValidateCreateFeedInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "createFeed", err)
+			}
+			return nil, NewCreateFeedInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body CreateFeedNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "createFeed", err)
+			}
+			err = ValidateCreateFeedNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "createFeed", err)
+			}
+			return nil, NewCreateFeedNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CreateFeedUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "createFeed", err)
+			}
+			err = ValidateCreateFeedUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "createFeed", err)
+			}
+			return nil, NewCreateFeedUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("schedule", "createFeed", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRevokeFeedRequest instantiates a HTTP request object with method and
+// path set to call the "schedule" service "revokeFeed" endpoint
+func (c *Client) BuildRevokeFeedRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RevokeFeedSchedulePath()}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("schedule", "revokeFeed", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRevokeFeedRequest returns an encoder for requests sent to the schedule
+// revokeFeed server.
+func EncodeRevokeFeedRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*schedule.RevokeFeedPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("schedule", "revokeFeed", "*schedule.RevokeFeedPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeRevokeFeedResponse returns a decoder for responses returned by the
+// schedule revokeFeed endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeRevokeFeedResponse may return the following errors:
+//   - "badRequest" (type *schedule.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *schedule.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *schedule.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *schedule.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *schedule.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRevokeFeedResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body RevokeFeedBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "revokeFeed", err)
+			}
+			err = ValidateRevokeFeedBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "revokeFeed", err)
+			}
+			return nil, NewRevokeFeedBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body RevokeFeedForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "revokeFeed", err)
+			}
+			err = ValidateRevokeFeedForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "revokeFeed", err)
+			}
+			return nil, NewRevokeFeedForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RevokeFeedInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "revokeFeed", err)
+			}
+			err = ValidateRevokeFeedInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "revokeFeed", err)
+			}
+			return nil, NewRevokeFeedInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body RevokeFeedNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "revokeFeed", err)
+			}
+			err = ValidateRevokeFeedNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "revokeFeed", err)
+			}
+			return nil, NewRevokeFeedNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body RevokeFeedUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "revokeFeed", err)
+			}
+			err = ValidateRevokeFeedUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "revokeFeed", err)
+			}
+			return nil, NewRevokeFeedUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("schedule", "revokeFeed", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildFeedRequest instantiates a HTTP request object with method and path set
+// to call the "schedule" service "feed" endpoint
+func (c *Client) BuildFeedRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		feedToken string
+	)
+	{
+		p, ok := v.(*schedule.FeedPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("schedule", "feed", "*schedule.FeedPayload", v)
+		}
+		feedToken = p.FeedToken
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: FeedSchedulePath(feedToken)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("schedule", "feed", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeFeedResponse returns a decoder for responses returned by the schedule
+// feed endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeFeedResponse may return the following errors:
+//   - "badRequest" (type *schedule.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *schedule.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *schedule.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *schedule.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *schedule.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeFeedResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				contentType string
+				err         error
+			)
+			contentTypeRaw := resp.Header.Get("Content-Type")
+			if contentTypeRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("contentType", "header"))
+			}
+			contentType = contentTypeRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "feed", err)
+			}
+			res := NewFeedResultOK(contentType)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body FeedBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "feed", err)
+			}
+			err = ValidateFeedBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "feed", err)
+			}
+			return nil, NewFeedBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body FeedForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "feed", err)
+			}
+			err = ValidateFeedForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "feed", err)
+			}
+			return nil, NewFeedForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body FeedInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "feed", err)
+			}
+			err = ValidateFeedInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "feed", err)
+			}
+			return nil, NewFeedInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body FeedNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "feed", err)
+			}
+			err = ValidateFeedNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "feed", err)
+			}
+			return nil, NewFeedNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body FeedUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("schedule", "feed", err)
+			}
+			err = ValidateFeedUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("schedule", "feed", err)
+			}
+			return nil, NewFeedUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("schedule", "feed", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalFieldErrorResponseBodyToScheduleFieldError builds a value of type
+// *schedule.FieldError from a value of type *FieldErrorResponseBody.
+func unmarshalFieldErrorResponseBodyToScheduleFieldError(v *FieldErrorResponseBody) *schedule.FieldError {
+	if v == nil {
+		return nil
+	}
+	res := &schedule.FieldError{
+		Field:   *v.Field,
+		Message: *v.Message,
+	}
+
+	return res
+}
+
+// unmarshalCalendarEntryResponseToScheduleCalendarEntry builds a value of type
+// *schedule.CalendarEntry from a value of type *CalendarEntryResponse.
+func unmarshalCalendarEntryResponseToScheduleCalendarEntry(v *CalendarEntryResponse) *schedule.CalendarEntry {
+	res := &schedule.CalendarEntry{
+		Date:             *v.Date,
+		WorkoutID:        *v.WorkoutID,
+		WorkoutName:      *v.WorkoutName,
+		Position:         *v.Position,
+		TrainingPlanID:   *v.TrainingPlanID,
+		TrainingPlanName: *v.TrainingPlanName,
+	}
+
+	return res
+}
+
+// unmarshalCalendarEntryResponseBodyToScheduleCalendarEntry builds a value of
+// type *schedule.CalendarEntry from a value of type *CalendarEntryResponseBody.
+func unmarshalCalendarEntryResponseBodyToScheduleCalendarEntry(v *CalendarEntryResponseBody) *schedule.CalendarEntry {
+	res := &schedule.CalendarEntry{
+		Date:             *v.Date,
+		WorkoutID:        *v.WorkoutID,
+		WorkoutName:      *v.WorkoutName,
+		Position:         *v.Position,
+		TrainingPlanID:   *v.TrainingPlanID,
+		TrainingPlanName: *v.TrainingPlanName,
+	}
+
+	return res
+}
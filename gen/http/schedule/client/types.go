@@ -0,0 +1,1373 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// schedule HTTP client types
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	schedule "be/gen/schedule"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// SetRequestBody is the type of the "schedule" service "set" endpoint HTTP
+// request body.
+type SetRequestBody struct {
+	// Order among the workouts of the same day
+	Position int `form:"position" json:"position" xml:"position"`
+	// Specific dates the workout is planned on
+	Dates []string `form:"dates,omitempty" json:"dates,omitempty" xml:"dates,omitempty"`
+	// RRULE-style recurrence within the plan range
+	Recurrence *string `form:"recurrence,omitempty" json:"recurrence,omitempty" xml:"recurrence,omitempty"`
+}
+
+// SetResponseBody is the type of the "schedule" service "set" endpoint HTTP
+// response body.
+type SetResponseBody struct {
+	// Workout ID
+	WorkoutID *string `form:"workoutId,omitempty" json:"workoutId,omitempty" xml:"workoutId,omitempty"`
+	// Order among the workouts of the same day
+	Position *int `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	// Specific dates the workout is planned on
+	Dates []string `form:"dates,omitempty" json:"dates,omitempty" xml:"dates,omitempty"`
+	// RRULE-style recurrence within the plan range (FREQ=DAILY|WEEKLY, INTERVAL,
+	// BYDAY, COUNT, UNTIL)
+	Recurrence *string `form:"recurrence,omitempty" json:"recurrence,omitempty" xml:"recurrence,omitempty"`
+}
+
+// CalendarResponseBody is the type of the "schedule" service "calendar"
+// endpoint HTTP response body.
+type CalendarResponseBody []*CalendarEntryResponse
+
+// TodayResponseBody is the type of the "schedule" service "today" endpoint
+// HTTP response body.
+type TodayResponseBody struct {
+	// Current day in the user's time zone
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// Time zone used to determine the day
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// Workouts planned for the day
+	Workouts []*CalendarEntryResponseBody `form:"workouts,omitempty" json:"workouts,omitempty" xml:"workouts,omitempty"`
+}
+
+// CreateFeedResponseBody is the type of the "schedule" service "createFeed"
+// endpoint HTTP response body.
+type CreateFeedResponseBody struct {
+	// Secret feed token, only returned when it is created
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+	// Feed path to subscribe to from a calendar app
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// Creation time
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// SetBadRequestResponseBody is the type of the "schedule" service "set"
+// endpoint HTTP response body for the "badRequest" error.
+type SetBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// SetForbiddenResponseBody is the type of the "schedule" service "set"
+// endpoint HTTP response body for the "forbidden" error.
+type SetForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// SetInternalServerErrorResponseBody is the type of the "schedule" service
+// "set" endpoint HTTP response body for the "internalServerError" error.
+type SetInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// SetNotFoundResponseBody is the type of the "schedule" service "set" endpoint
+// HTTP response body for the "notFound" error.
+type SetNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// SetUnauthorizedResponseBody is the type of the "schedule" service "set"
+// endpoint HTTP response body for the "unauthorized" error.
+type SetUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CalendarBadRequestResponseBody is the type of the "schedule" service
+// "calendar" endpoint HTTP response body for the "badRequest" error.
+type CalendarBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// CalendarForbiddenResponseBody is the type of the "schedule" service
+// "calendar" endpoint HTTP response body for the "forbidden" error.
+type CalendarForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CalendarInternalServerErrorResponseBody is the type of the "schedule"
+// service "calendar" endpoint HTTP response body for the "internalServerError"
+// error.
+type CalendarInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CalendarNotFoundResponseBody is the type of the "schedule" service
+// "calendar" endpoint HTTP response body for the "notFound" error.
+type CalendarNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CalendarUnauthorizedResponseBody is the type of the "schedule" service
+// "calendar" endpoint HTTP response body for the "unauthorized" error.
+type CalendarUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// TodayBadRequestResponseBody is the type of the "schedule" service "today"
+// endpoint HTTP response body for the "badRequest" error.
+type TodayBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// TodayForbiddenResponseBody is the type of the "schedule" service "today"
+// endpoint HTTP response body for the "forbidden" error.
+type TodayForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// TodayInternalServerErrorResponseBody is the type of the "schedule" service
+// "today" endpoint HTTP response body for the "internalServerError" error.
+type TodayInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// TodayNotFoundResponseBody is the type of the "schedule" service "today"
+// endpoint HTTP response body for the "notFound" error.
+type TodayNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// TodayUnauthorizedResponseBody is the type of the "schedule" service "today"
+// endpoint HTTP response body for the "unauthorized" error.
+type TodayUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateFeedBadRequestResponseBody is the type of the "schedule" service
+// "createFeed" endpoint HTTP response body for the "badRequest" error.
+type CreateFeedBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// CreateFeedForbiddenResponseBody is the type of the "schedule" service
+// "createFeed" endpoint HTTP response body for the "forbidden" error.
+type CreateFeedForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateFeedInternalServerErrorResponseBody is the type of the "schedule"
+// service "createFeed" endpoint HTTP response body for the
+// "internalServerError" error.
+type CreateFeedInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateFeedNotFoundResponseBody is the type of the "schedule" service
+// "createFeed" endpoint HTTP response body for the "notFound" error.
+type CreateFeedNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateFeedUnauthorizedResponseBody is the type of the "schedule" service
+// "createFeed" endpoint HTTP response body for the "unauthorized" error.
+type CreateFeedUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RevokeFeedBadRequestResponseBody is the type of the "schedule" service
+// "revokeFeed" endpoint HTTP response body for the "badRequest" error.
+type RevokeFeedBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// RevokeFeedForbiddenResponseBody is the type of the "schedule" service
+// "revokeFeed" endpoint HTTP response body for the "forbidden" error.
+type RevokeFeedForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RevokeFeedInternalServerErrorResponseBody is the type of the "schedule"
+// service "revokeFeed" endpoint HTTP response body for the
+// "internalServerError" error.
+type RevokeFeedInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RevokeFeedNotFoundResponseBody is the type of the "schedule" service
+// "revokeFeed" endpoint HTTP response body for the "notFound" error.
+type RevokeFeedNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RevokeFeedUnauthorizedResponseBody is the type of the "schedule" service
+// "revokeFeed" endpoint HTTP response body for the "unauthorized" error.
+type RevokeFeedUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FeedBadRequestResponseBody is the type of the "schedule" service "feed"
+// endpoint HTTP response body for the "badRequest" error.
+type FeedBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// FeedForbiddenResponseBody is the type of the "schedule" service "feed"
+// endpoint HTTP response body for the "forbidden" error.
+type FeedForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FeedInternalServerErrorResponseBody is the type of the "schedule" service
+// "feed" endpoint HTTP response body for the "internalServerError" error.
+type FeedInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FeedNotFoundResponseBody is the type of the "schedule" service "feed"
+// endpoint HTTP response body for the "notFound" error.
+type FeedNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FeedUnauthorizedResponseBody is the type of the "schedule" service "feed"
+// endpoint HTTP response body for the "unauthorized" error.
+type FeedUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+	// Motivo per cui il campo non è valido
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CalendarEntryResponse is used to define fields on response body types.
+type CalendarEntryResponse struct {
+	// Day of the occurrence
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// Workout ID
+	WorkoutID *string `form:"workoutId,omitempty" json:"workoutId,omitempty" xml:"workoutId,omitempty"`
+	// Workout name
+	WorkoutName *string `form:"workoutName,omitempty" json:"workoutName,omitempty" xml:"workoutName,omitempty"`
+	// Order among the workouts of the same day
+	Position *int `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	// Training plan ID
+	TrainingPlanID *string `form:"trainingPlanId,omitempty" json:"trainingPlanId,omitempty" xml:"trainingPlanId,omitempty"`
+	// Training plan name
+	TrainingPlanName *string `form:"trainingPlanName,omitempty" json:"trainingPlanName,omitempty" xml:"trainingPlanName,omitempty"`
+}
+
+// CalendarEntryResponseBody is used to define fields on response body types.
+type CalendarEntryResponseBody struct {
+	// Day of the occurrence
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// Workout ID
+	WorkoutID *string `form:"workoutId,omitempty" json:"workoutId,omitempty" xml:"workoutId,omitempty"`
+	// Workout name
+	WorkoutName *string `form:"workoutName,omitempty" json:"workoutName,omitempty" xml:"workoutName,omitempty"`
+	// Order among the workouts of the same day
+	Position *int `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	// Training plan ID
+	TrainingPlanID *string `form:"trainingPlanId,omitempty" json:"trainingPlanId,omitempty" xml:"trainingPlanId,omitempty"`
+	// Training plan name
+	TrainingPlanName *string `form:"trainingPlanName,omitempty" json:"trainingPlanName,omitempty" xml:"trainingPlanName,omitempty"`
+}
+
+// NewSetRequestBody builds the HTTP request body from the payload of the "set"
+// endpoint of the "schedule" service.
+func NewSetRequestBody(p *schedule.SetPayload) *SetRequestBody {
+	body := &SetRequestBody{
+		Position:   p.Position,
+		Recurrence: p.Recurrence,
+	}
+	{
+		var zero int
+		if body.Position == zero {
+			body.Position = 0
+		}
+	}
+	if p.Dates != nil {
+		body.Dates = make([]string, len(p.Dates))
+		for i, val := range p.Dates {
+			body.Dates[i] = val
+		}
+	}
+	return body
+}
+
+// NewSetWorkoutScheduleOK builds a "schedule" service "set" endpoint result
+// from a HTTP "OK" response.
+func NewSetWorkoutScheduleOK(body *SetResponseBody) *schedule.WorkoutSchedule {
+	v := &schedule.WorkoutSchedule{
+		WorkoutID:  *body.WorkoutID,
+		Position:   *body.Position,
+		Recurrence: body.Recurrence,
+	}
+	v.Dates = make([]string, len(body.Dates))
+	for i, val := range body.Dates {
+		v.Dates[i] = val
+	}
+
+	return v
+}
+
+// NewSetBadRequest builds a schedule service set endpoint badRequest error.
+func NewSetBadRequest(body *SetBadRequestResponseBody) *schedule.BadRequest {
+	v := &schedule.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*schedule.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToScheduleFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewSetForbidden builds a schedule service set endpoint forbidden error.
+func NewSetForbidden(body *SetForbiddenResponseBody) *schedule.Forbidden {
+	v := &schedule.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSetInternalServerError builds a schedule service set endpoint
+// internalServerError error.
+func NewSetInternalServerError(body *SetInternalServerErrorResponseBody) *schedule.InternalServerError {
+	v := &schedule.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSetNotFound builds a schedule service set endpoint notFound error.
+func NewSetNotFound(body *SetNotFoundResponseBody) *schedule.NotFound {
+	v := &schedule.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSetUnauthorized builds a schedule service set endpoint unauthorized error.
+func NewSetUnauthorized(body *SetUnauthorizedResponseBody) *schedule.Unauthorized {
+	v := &schedule.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCalendarEntry2OK builds a "schedule" service "calendar" endpoint result
+// from a HTTP "OK" response.
+func NewCalendarEntry2OK(body []*CalendarEntryResponse) []*schedule.CalendarEntry {
+	v := make([]*schedule.CalendarEntry, len(body))
+	for i, val := range body {
+		v[i] = unmarshalCalendarEntryResponseToScheduleCalendarEntry(val)
+	}
+
+	return v
+}
+
+// NewCalendarBadRequest builds a schedule service calendar endpoint badRequest
+// error.
+func NewCalendarBadRequest(body *CalendarBadRequestResponseBody) *schedule.BadRequest {
+	v := &schedule.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*schedule.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToScheduleFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewCalendarForbidden builds a schedule service calendar endpoint forbidden
+// error.
+func NewCalendarForbidden(body *CalendarForbiddenResponseBody) *schedule.Forbidden {
+	v := &schedule.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCalendarInternalServerError builds a schedule service calendar endpoint
+// internalServerError error.
+func NewCalendarInternalServerError(body *CalendarInternalServerErrorResponseBody) *schedule.InternalServerError {
+	v := &schedule.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCalendarNotFound builds a schedule service calendar endpoint notFound
+// error.
+func NewCalendarNotFound(body *CalendarNotFoundResponseBody) *schedule.NotFound {
+	v := &schedule.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCalendarUnauthorized builds a schedule service calendar endpoint
+// unauthorized error.
+func NewCalendarUnauthorized(body *CalendarUnauthorizedResponseBody) *schedule.Unauthorized {
+	v := &schedule.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewTodayWorkoutsOK builds a "schedule" service "today" endpoint result from
+// a HTTP "OK" response.
+func NewTodayWorkoutsOK(body *TodayResponseBody) *schedule.TodayWorkouts {
+	v := &schedule.TodayWorkouts{
+		Date:     *body.Date,
+		Timezone: *body.Timezone,
+	}
+	v.Workouts = make([]*schedule.CalendarEntry, len(body.Workouts))
+	for i, val := range body.Workouts {
+		v.Workouts[i] = unmarshalCalendarEntryResponseBodyToScheduleCalendarEntry(val)
+	}
+
+	return v
+}
+
+// NewTodayBadRequest builds a schedule service today endpoint badRequest error.
+func NewTodayBadRequest(body *TodayBadRequestResponseBody) *schedule.BadRequest {
+	v := &schedule.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*schedule.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToScheduleFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewTodayForbidden builds a schedule service today endpoint forbidden error.
+func NewTodayForbidden(body *TodayForbiddenResponseBody) *schedule.Forbidden {
+	v := &schedule.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewTodayInternalServerError builds a schedule service today endpoint
+// internalServerError error.
+func NewTodayInternalServerError(body *TodayInternalServerErrorResponseBody) *schedule.InternalServerError {
+	v := &schedule.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewTodayNotFound builds a schedule service today endpoint notFound error.
+func NewTodayNotFound(body *TodayNotFoundResponseBody) *schedule.NotFound {
+	v := &schedule.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewTodayUnauthorized builds a schedule service today endpoint unauthorized
+// error.
+func NewTodayUnauthorized(body *TodayUnauthorizedResponseBody) *schedule.Unauthorized {
+	v := &schedule.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateFeedCalendarFeedCreated builds a "schedule" service "createFeed"
+// endpoint result from a HTTP "Created" response.
+func NewCreateFeedCalendarFeedCreated(body *CreateFeedResponseBody) *schedule.CalendarFeed {
+	v := &schedule.CalendarFeed{
+		Token:     *body.Token,
+		Path:      *body.Path,
+		CreatedAt: *body.CreatedAt,
+	}
+
+	return v
+}
+
+// NewCreateFeedBadRequest builds a schedule service createFeed endpoint
+// badRequest error.
+func NewCreateFeedBadRequest(body *CreateFeedBadRequestResponseBody) *schedule.BadRequest {
+	v := &schedule.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*schedule.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToScheduleFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewCreateFeedForbidden builds a schedule service createFeed endpoint
+// forbidden error.
+func NewCreateFeedForbidden(body *CreateFeedForbiddenResponseBody) *schedule.Forbidden {
+	v := &schedule.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateFeedInternalServerError builds a schedule service createFeed
+// endpoint internalServerError error.
+func NewCreateFeedInternalServerError(body *CreateFeedInternalServerErrorResponseBody) *schedule.InternalServerError {
+	v := &schedule.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateFeedNotFound builds a schedule service createFeed endpoint notFound
+// error.
+func NewCreateFeedNotFound(body *CreateFeedNotFoundResponseBody) *schedule.NotFound {
+	v := &schedule.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateFeedUnauthorized builds a schedule service createFeed endpoint
+// unauthorized error.
+func NewCreateFeedUnauthorized(body *CreateFeedUnauthorizedResponseBody) *schedule.Unauthorized {
+	v := &schedule.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRevokeFeedBadRequest builds a schedule service revokeFeed endpoint
+// badRequest error.
+func NewRevokeFeedBadRequest(body *RevokeFeedBadRequestResponseBody) *schedule.BadRequest {
+	v := &schedule.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*schedule.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToScheduleFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewRevokeFeedForbidden builds a schedule service revokeFeed endpoint
+// forbidden error.
+func NewRevokeFeedForbidden(body *RevokeFeedForbiddenResponseBody) *schedule.Forbidden {
+	v := &schedule.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRevokeFeedInternalServerError builds a schedule service revokeFeed
+// endpoint internalServerError error.
+func NewRevokeFeedInternalServerError(body *RevokeFeedInternalServerErrorResponseBody) *schedule.InternalServerError {
+	v := &schedule.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRevokeFeedNotFound builds a schedule service revokeFeed endpoint notFound
+// error.
+func NewRevokeFeedNotFound(body *RevokeFeedNotFoundResponseBody) *schedule.NotFound {
+	v := &schedule.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRevokeFeedUnauthorized builds a schedule service revokeFeed endpoint
+// unauthorized error.
+func NewRevokeFeedUnauthorized(body *RevokeFeedUnauthorizedResponseBody) *schedule.Unauthorized {
+	v := &schedule.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewFeedResultOK builds a "schedule" service "feed" endpoint result from a
+// HTTP "OK" response.
+func NewFeedResultOK(contentType string) *schedule.FeedResult {
+	v := &schedule.FeedResult{}
+	v.ContentType = contentType
+
+	return v
+}
+
+// NewFeedBadRequest builds a schedule service feed endpoint badRequest error.
+func NewFeedBadRequest(body *FeedBadRequestResponseBody) *schedule.BadRequest {
+	v := &schedule.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*schedule.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToScheduleFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewFeedForbidden builds a schedule service feed endpoint forbidden error.
+func NewFeedForbidden(body *FeedForbiddenResponseBody) *schedule.Forbidden {
+	v := &schedule.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewFeedInternalServerError builds a schedule service feed endpoint
+// internalServerError error.
+func NewFeedInternalServerError(body *FeedInternalServerErrorResponseBody) *schedule.InternalServerError {
+	v := &schedule.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewFeedNotFound builds a schedule service feed endpoint notFound error.
+func NewFeedNotFound(body *FeedNotFoundResponseBody) *schedule.NotFound {
+	v := &schedule.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewFeedUnauthorized builds a schedule service feed endpoint unauthorized
+// error.
+func NewFeedUnauthorized(body *FeedUnauthorizedResponseBody) *schedule.Unauthorized {
+	v := &schedule.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// ValidateSetResponseBody runs the validations defined on SetResponseBody
+func ValidateSetResponseBody(body *SetResponseBody) (err error) {
+	if body.WorkoutID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("workoutId", "body"))
+	}
+	if body.Position == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position", "body"))
+	}
+	if body.Dates == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dates", "body"))
+	}
+	if body.WorkoutID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.workoutId", *body.WorkoutID, goa.FormatUUID))
+	}
+	for _, e := range body.Dates {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.dates[*]", e, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateTodayResponseBody runs the validations defined on TodayResponseBody
+func ValidateTodayResponseBody(body *TodayResponseBody) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.Timezone == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timezone", "body"))
+	}
+	if body.Workouts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("workouts", "body"))
+	}
+	if body.Date != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.date", *body.Date, goa.FormatDate))
+	}
+	for _, e := range body.Workouts {
+		if e != nil {
+			if err2 := ValidateCalendarEntryResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateFeedResponseBody runs the validations defined on
+// CreateFeedResponseBody
+func ValidateCreateFeedResponseBody(body *CreateFeedResponseBody) (err error) {
+	if body.Token == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token", "body"))
+	}
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSetBadRequestResponseBody runs the validations defined on
+// set_badRequest_response_body
+func ValidateSetBadRequestResponseBody(body *SetBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSetForbiddenResponseBody runs the validations defined on
+// set_forbidden_response_body
+func ValidateSetForbiddenResponseBody(body *SetForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSetInternalServerErrorResponseBody runs the validations defined on
+// set_internalServerError_response_body
+func ValidateSetInternalServerErrorResponseBody(body *SetInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSetNotFoundResponseBody runs the validations defined on
+// set_notFound_response_body
+func ValidateSetNotFoundResponseBody(body *SetNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSetUnauthorizedResponseBody runs the validations defined on
+// set_unauthorized_response_body
+func ValidateSetUnauthorizedResponseBody(body *SetUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCalendarBadRequestResponseBody runs the validations defined on
+// calendar_badRequest_response_body
+func ValidateCalendarBadRequestResponseBody(body *CalendarBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCalendarForbiddenResponseBody runs the validations defined on
+// calendar_forbidden_response_body
+func ValidateCalendarForbiddenResponseBody(body *CalendarForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCalendarInternalServerErrorResponseBody runs the validations defined
+// on calendar_internalServerError_response_body
+func ValidateCalendarInternalServerErrorResponseBody(body *CalendarInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCalendarNotFoundResponseBody runs the validations defined on
+// calendar_notFound_response_body
+func ValidateCalendarNotFoundResponseBody(body *CalendarNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCalendarUnauthorizedResponseBody runs the validations defined on
+// calendar_unauthorized_response_body
+func ValidateCalendarUnauthorizedResponseBody(body *CalendarUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateTodayBadRequestResponseBody runs the validations defined on
+// today_badRequest_response_body
+func ValidateTodayBadRequestResponseBody(body *TodayBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateTodayForbiddenResponseBody runs the validations defined on
+// today_forbidden_response_body
+func ValidateTodayForbiddenResponseBody(body *TodayForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateTodayInternalServerErrorResponseBody runs the validations defined on
+// today_internalServerError_response_body
+func ValidateTodayInternalServerErrorResponseBody(body *TodayInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateTodayNotFoundResponseBody runs the validations defined on
+// today_notFound_response_body
+func ValidateTodayNotFoundResponseBody(body *TodayNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateTodayUnauthorizedResponseBody runs the validations defined on
+// today_unauthorized_response_body
+func ValidateTodayUnauthorizedResponseBody(body *TodayUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateFeedBadRequestResponseBody runs the validations defined on
+// createFeed_badRequest_response_body
+func ValidateCreateFeedBadRequestResponseBody(body *CreateFeedBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateFeedForbiddenResponseBody runs the validations defined on
+// createFeed_forbidden_response_body
+func ValidateCreateFeedForbiddenResponseBody(body *CreateFeedForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateFeedInternalServerErrorResponseBody runs the validations
+// defined on createFeed_internalServerError_response_body
+func ValidateCreateFeedInternalServerErrorResponseBody(body *CreateFeedInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateFeedNotFoundResponseBody runs the validations defined on
+// createFeed_notFound_response_body
+func ValidateCreateFeedNotFoundResponseBody(body *CreateFeedNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateFeedUnauthorizedResponseBody runs the validations defined on
+// createFeed_unauthorized_response_body
+func ValidateCreateFeedUnauthorizedResponseBody(body *CreateFeedUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRevokeFeedBadRequestResponseBody runs the validations defined on
+// revokeFeed_badRequest_response_body
+func ValidateRevokeFeedBadRequestResponseBody(body *RevokeFeedBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRevokeFeedForbiddenResponseBody runs the validations defined on
+// revokeFeed_forbidden_response_body
+func ValidateRevokeFeedForbiddenResponseBody(body *RevokeFeedForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRevokeFeedInternalServerErrorResponseBody runs the validations
+// defined on revokeFeed_internalServerError_response_body
+func ValidateRevokeFeedInternalServerErrorResponseBody(body *RevokeFeedInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRevokeFeedNotFoundResponseBody runs the validations defined on
+// revokeFeed_notFound_response_body
+func ValidateRevokeFeedNotFoundResponseBody(body *RevokeFeedNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRevokeFeedUnauthorizedResponseBody runs the validations defined on
+// revokeFeed_unauthorized_response_body
+func ValidateRevokeFeedUnauthorizedResponseBody(body *RevokeFeedUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFeedBadRequestResponseBody runs the validations defined on
+// feed_badRequest_response_body
+func ValidateFeedBadRequestResponseBody(body *FeedBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateFeedForbiddenResponseBody runs the validations defined on
+// feed_forbidden_response_body
+func ValidateFeedForbiddenResponseBody(body *FeedForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFeedInternalServerErrorResponseBody runs the validations defined on
+// feed_internalServerError_response_body
+func ValidateFeedInternalServerErrorResponseBody(body *FeedInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFeedNotFoundResponseBody runs the validations defined on
+// feed_notFound_response_body
+func ValidateFeedNotFoundResponseBody(body *FeedNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFeedUnauthorizedResponseBody runs the validations defined on
+// feed_unauthorized_response_body
+func ValidateFeedUnauthorizedResponseBody(body *FeedUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFieldErrorResponseBody runs the validations defined on
+// FieldErrorResponseBody
+func ValidateFieldErrorResponseBody(body *FieldErrorResponseBody) (err error) {
+	if body.Field == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("field", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCalendarEntryResponse runs the validations defined on
+// CalendarEntryResponse
+func ValidateCalendarEntryResponse(body *CalendarEntryResponse) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.WorkoutID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("workoutId", "body"))
+	}
+	if body.WorkoutName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("workoutName", "body"))
+	}
+	if body.Position == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position", "body"))
+	}
+	if body.TrainingPlanID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trainingPlanId", "body"))
+	}
+	if body.TrainingPlanName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trainingPlanName", "body"))
+	}
+	if body.Date != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.date", *body.Date, goa.FormatDate))
+	}
+	if body.WorkoutID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.workoutId", *body.WorkoutID, goa.FormatUUID))
+	}
+	if body.TrainingPlanID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.trainingPlanId", *body.TrainingPlanID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateCalendarEntryResponseBody runs the validations defined on
+// CalendarEntryResponseBody
+func ValidateCalendarEntryResponseBody(body *CalendarEntryResponseBody) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.WorkoutID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("workoutId", "body"))
+	}
+	if body.WorkoutName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("workoutName", "body"))
+	}
+	if body.Position == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position", "body"))
+	}
+	if body.TrainingPlanID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trainingPlanId", "body"))
+	}
+	if body.TrainingPlanName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trainingPlanName", "body"))
+	}
+	if body.Date != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.date", *body.Date, goa.FormatDate))
+	}
+	if body.WorkoutID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.workoutId", *body.WorkoutID, goa.FormatUUID))
+	}
+	if body.TrainingPlanID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.trainingPlanId", *body.TrainingPlanID, goa.FormatUUID))
+	}
+	return
+}
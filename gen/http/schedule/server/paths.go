@@ -0,0 +1,42 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// HTTP request path constructors for the schedule service.
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	"fmt"
+)
+
+// SetSchedulePath returns the URL path to the schedule service set HTTP endpoint.
+func SetSchedulePath(id string) string {
+	return fmt.Sprintf("/api/v1/workouts/%v/schedule", id)
+}
+
+// CalendarSchedulePath returns the URL path to the schedule service calendar HTTP endpoint.
+func CalendarSchedulePath() string {
+	return "/api/v1/calendar"
+}
+
+// TodaySchedulePath returns the URL path to the schedule service today HTTP endpoint.
+func TodaySchedulePath() string {
+	return "/api/v1/calendar/today"
+}
+
+// CreateFeedSchedulePath returns the URL path to the schedule service createFeed HTTP endpoint.
+func CreateFeedSchedulePath() string {
+	return "/api/v1/calendar/feed"
+}
+
+// RevokeFeedSchedulePath returns the URL path to the schedule service revokeFeed HTTP endpoint.
+func RevokeFeedSchedulePath() string {
+	return "/api/v1/calendar/feed"
+}
+
+// FeedSchedulePath returns the URL path to the schedule service feed HTTP endpoint.
+func FeedSchedulePath(feedToken string) string {
+	return fmt.Sprintf("/api/v1/calendar/feed/%v", feedToken)
+}
@@ -0,0 +1,447 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// schedule HTTP server
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	schedule "be/gen/schedule"
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Server lists the schedule service endpoint HTTP handlers.
+type Server struct {
+	Mounts     []*MountPoint
+	Set        http.Handler
+	Calendar   http.Handler
+	Today      http.Handler
+	CreateFeed http.Handler
+	RevokeFeed http.Handler
+	Feed       http.Handler
+}
+
+// MountPoint holds information about the mounted endpoints.
+type MountPoint struct {
+	// Method is the name of the service method served by the mounted HTTP handler.
+	Method string
+	// Verb is the HTTP method used to match requests to the mounted handler.
+	Verb string
+	// Pattern is the HTTP request path pattern used to match requests to the
+	// mounted handler.
+	Pattern string
+}
+
+// New instantiates HTTP handlers for all the schedule service endpoints using
+// the provided encoder and decoder. The handlers are mounted on the given mux
+// using the HTTP verb and path defined in the design. errhandler is called
+// whenever a response fails to be encoded. formatter is used to format errors
+// returned by the service methods prior to encoding. Both errhandler and
+// formatter are optional and can be nil.
+func New(
+	e *schedule.Endpoints,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) *Server {
+	return &Server{
+		Mounts: []*MountPoint{
+			{"Set", "PUT", "/api/v1/workouts/{id}/schedule"},
+			{"Calendar", "GET", "/api/v1/calendar"},
+			{"Today", "GET", "/api/v1/calendar/today"},
+			{"CreateFeed", "POST", "/api/v1/calendar/feed"},
+			{"RevokeFeed", "DELETE", "/api/v1/calendar/feed"},
+			{"Feed", "GET", "/api/v1/calendar/feed/{feedToken}"},
+		},
+		Set:        NewSetHandler(e.Set, mux, decoder, encoder, errhandler, formatter),
+		Calendar:   NewCalendarHandler(e.Calendar, mux, decoder, encoder, errhandler, formatter),
+		Today:      NewTodayHandler(e.Today, mux, decoder, encoder, errhandler, formatter),
+		CreateFeed: NewCreateFeedHandler(e.CreateFeed, mux, decoder, encoder, errhandler, formatter),
+		RevokeFeed: NewRevokeFeedHandler(e.RevokeFeed, mux, decoder, encoder, errhandler, formatter),
+		Feed:       NewFeedHandler(e.Feed, mux, decoder, encoder, errhandler, formatter),
+	}
+}
+
+// Service returns the name of the service served.
+func (s *Server) Service() string { return "schedule" }
+
+// Use wraps the server handlers with the given middleware.
+func (s *Server) Use(m func(http.Handler) http.Handler) {
+	s.Set = m(s.Set)
+	s.Calendar = m(s.Calendar)
+	s.Today = m(s.Today)
+	s.CreateFeed = m(s.CreateFeed)
+	s.RevokeFeed = m(s.RevokeFeed)
+	s.Feed = m(s.Feed)
+}
+
+// MethodNames returns the methods served.
+func (s *Server) MethodNames() []string { return schedule.MethodNames[:] }
+
+// Mount configures the mux to serve the schedule endpoints.
+func Mount(mux goahttp.Muxer, h *Server) {
+	MountSetHandler(mux, h.Set)
+	MountCalendarHandler(mux, h.Calendar)
+	MountTodayHandler(mux, h.Today)
+	MountCreateFeedHandler(mux, h.CreateFeed)
+	MountRevokeFeedHandler(mux, h.RevokeFeed)
+	MountFeedHandler(mux, h.Feed)
+}
+
+// Mount configures the mux to serve the schedule endpoints.
+func (s *Server) Mount(mux goahttp.Muxer) {
+	Mount(mux, s)
+}
+
+// MountSetHandler configures the mux to serve the "schedule" service "set"
+// endpoint.
+func MountSetHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/api/v1/workouts/{id}/schedule", f)
+}
+
+// NewSetHandler creates a HTTP handler which loads the HTTP request and calls
+// the "schedule" service "set" endpoint.
+func NewSetHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSetRequest(mux, decoder)
+		encodeResponse = EncodeSetResponse(encoder)
+		encodeError    = EncodeSetError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "set")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "schedule")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCalendarHandler configures the mux to serve the "schedule" service
+// "calendar" endpoint.
+func MountCalendarHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/calendar", f)
+}
+
+// NewCalendarHandler creates a HTTP handler which loads the HTTP request and
+// calls the "schedule" service "calendar" endpoint.
+func NewCalendarHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCalendarRequest(mux, decoder)
+		encodeResponse = EncodeCalendarResponse(encoder)
+		encodeError    = EncodeCalendarError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "calendar")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "schedule")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountTodayHandler configures the mux to serve the "schedule" service "today"
+// endpoint.
+func MountTodayHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/calendar/today", f)
+}
+
+// NewTodayHandler creates a HTTP handler which loads the HTTP request and
+// calls the "schedule" service "today" endpoint.
+func NewTodayHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeTodayRequest(mux, decoder)
+		encodeResponse = EncodeTodayResponse(encoder)
+		encodeError    = EncodeTodayError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "today")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "schedule")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCreateFeedHandler configures the mux to serve the "schedule" service
+// "createFeed" endpoint.
+func MountCreateFeedHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/v1/calendar/feed", f)
+}
+
+// NewCreateFeedHandler creates a HTTP handler which loads the HTTP request and
+// calls the "schedule" service "createFeed" endpoint.
+func NewCreateFeedHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateFeedRequest(mux, decoder)
+		encodeResponse = EncodeCreateFeedResponse(encoder)
+		encodeError    = EncodeCreateFeedError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "createFeed")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "schedule")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountRevokeFeedHandler configures the mux to serve the "schedule" service
+// "revokeFeed" endpoint.
+func MountRevokeFeedHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/api/v1/calendar/feed", f)
+}
+
+// NewRevokeFeedHandler creates a HTTP handler which loads the HTTP request and
+// calls the "schedule" service "revokeFeed" endpoint.
+func NewRevokeFeedHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRevokeFeedRequest(mux, decoder)
+		encodeResponse = EncodeRevokeFeedResponse(encoder)
+		encodeError    = EncodeRevokeFeedError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "revokeFeed")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "schedule")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountFeedHandler configures the mux to serve the "schedule" service "feed"
+// endpoint.
+func MountFeedHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/calendar/feed/{feedToken}", f)
+}
+
+// NewFeedHandler creates a HTTP handler which loads the HTTP request and calls
+// the "schedule" service "feed" endpoint.
+func NewFeedHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeFeedRequest(mux, decoder)
+		encodeResponse = EncodeFeedResponse(encoder)
+		encodeError    = EncodeFeedError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "feed")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "schedule")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		o := res.(*schedule.FeedResponseData)
+		defer o.Body.Close()
+		if wt, ok := o.Body.(io.WriterTo); ok {
+			if err := encodeResponse(ctx, w, o.Result); err != nil {
+				errhandler(ctx, w, err)
+				return
+			}
+			n, err := wt.WriteTo(w)
+			if err != nil {
+				if n == 0 {
+					if err := encodeError(ctx, w, err); err != nil {
+						errhandler(ctx, w, err)
+					}
+				} else {
+					if f, ok := w.(http.Flusher); ok {
+						f.Flush()
+					}
+					panic(http.ErrAbortHandler) // too late to write an error
+				}
+			}
+			return
+		}
+		// handle immediate read error like a returned error
+		buf := bufio.NewReader(o.Body)
+		if _, err := buf.Peek(1); err != nil && err != io.EOF {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, o.Result); err != nil {
+			errhandler(ctx, w, err)
+			return
+		}
+		if _, err := io.Copy(w, buf); err != nil {
+			if f, ok := w.(http.Flusher); ok {
+				f.Flush()
+			}
+			panic(http.ErrAbortHandler) // too late to write an error
+		}
+	})
+}
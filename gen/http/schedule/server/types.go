@@ -0,0 +1,863 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// schedule HTTP server types
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	schedule "be/gen/schedule"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// SetRequestBody is the type of the "schedule" service "set" endpoint HTTP
+// request body.
+type SetRequestBody struct {
+	// Order among the workouts of the same day
+	Position *int `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	// Specific dates the workout is planned on
+	Dates []string `form:"dates,omitempty" json:"dates,omitempty" xml:"dates,omitempty"`
+	// RRULE-style recurrence within the plan range
+	Recurrence *string `form:"recurrence,omitempty" json:"recurrence,omitempty" xml:"recurrence,omitempty"`
+}
+
+// SetResponseBody is the type of the "schedule" service "set" endpoint HTTP
+// response body.
+type SetResponseBody struct {
+	// Workout ID
+	WorkoutID string `form:"workoutId" json:"workoutId" xml:"workoutId"`
+	// Order among the workouts of the same day
+	Position int `form:"position" json:"position" xml:"position"`
+	// Specific dates the workout is planned on
+	Dates []string `form:"dates" json:"dates" xml:"dates"`
+	// RRULE-style recurrence within the plan range (FREQ=DAILY|WEEKLY, INTERVAL,
+	// BYDAY, COUNT, UNTIL)
+	Recurrence *string `form:"recurrence,omitempty" json:"recurrence,omitempty" xml:"recurrence,omitempty"`
+}
+
+// CalendarResponseBody is the type of the "schedule" service "calendar"
+// endpoint HTTP response body.
+type CalendarResponseBody []*CalendarEntryResponse
+
+// TodayResponseBody is the type of the "schedule" service "today" endpoint
+// HTTP response body.
+type TodayResponseBody struct {
+	// Current day in the user's time zone
+	Date string `form:"date" json:"date" xml:"date"`
+	// Time zone used to determine the day
+	Timezone string `form:"timezone" json:"timezone" xml:"timezone"`
+	// Workouts planned for the day
+	Workouts []*CalendarEntryResponseBody `form:"workouts" json:"workouts" xml:"workouts"`
+}
+
+// CreateFeedResponseBody is the type of the "schedule" service "createFeed"
+// endpoint HTTP response body.
+type CreateFeedResponseBody struct {
+	// Secret feed token, only returned when it is created
+	Token string `form:"token" json:"token" xml:"token"`
+	// Feed path to subscribe to from a calendar app
+	Path string `form:"path" json:"path" xml:"path"`
+	// Creation time
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
+// SetBadRequestResponseBody is the type of the "schedule" service "set"
+// endpoint HTTP response body for the "badRequest" error.
+type SetBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// SetForbiddenResponseBody is the type of the "schedule" service "set"
+// endpoint HTTP response body for the "forbidden" error.
+type SetForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// SetInternalServerErrorResponseBody is the type of the "schedule" service
+// "set" endpoint HTTP response body for the "internalServerError" error.
+type SetInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// SetNotFoundResponseBody is the type of the "schedule" service "set" endpoint
+// HTTP response body for the "notFound" error.
+type SetNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// SetUnauthorizedResponseBody is the type of the "schedule" service "set"
+// endpoint HTTP response body for the "unauthorized" error.
+type SetUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CalendarBadRequestResponseBody is the type of the "schedule" service
+// "calendar" endpoint HTTP response body for the "badRequest" error.
+type CalendarBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// CalendarForbiddenResponseBody is the type of the "schedule" service
+// "calendar" endpoint HTTP response body for the "forbidden" error.
+type CalendarForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CalendarInternalServerErrorResponseBody is the type of the "schedule"
+// service "calendar" endpoint HTTP response body for the "internalServerError"
+// error.
+type CalendarInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CalendarNotFoundResponseBody is the type of the "schedule" service
+// "calendar" endpoint HTTP response body for the "notFound" error.
+type CalendarNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CalendarUnauthorizedResponseBody is the type of the "schedule" service
+// "calendar" endpoint HTTP response body for the "unauthorized" error.
+type CalendarUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// TodayBadRequestResponseBody is the type of the "schedule" service "today"
+// endpoint HTTP response body for the "badRequest" error.
+type TodayBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// TodayForbiddenResponseBody is the type of the "schedule" service "today"
+// endpoint HTTP response body for the "forbidden" error.
+type TodayForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// TodayInternalServerErrorResponseBody is the type of the "schedule" service
+// "today" endpoint HTTP response body for the "internalServerError" error.
+type TodayInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// TodayNotFoundResponseBody is the type of the "schedule" service "today"
+// endpoint HTTP response body for the "notFound" error.
+type TodayNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// TodayUnauthorizedResponseBody is the type of the "schedule" service "today"
+// endpoint HTTP response body for the "unauthorized" error.
+type TodayUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateFeedBadRequestResponseBody is the type of the "schedule" service
+// "createFeed" endpoint HTTP response body for the "badRequest" error.
+type CreateFeedBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// CreateFeedForbiddenResponseBody is the type of the "schedule" service
+// "createFeed" endpoint HTTP response body for the "forbidden" error.
+type CreateFeedForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateFeedInternalServerErrorResponseBody is the type of the "schedule"
+// service "createFeed" endpoint HTTP response body for the
+// "internalServerError" error.
+type CreateFeedInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateFeedNotFoundResponseBody is the type of the "schedule" service
+// "createFeed" endpoint HTTP response body for the "notFound" error.
+type CreateFeedNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateFeedUnauthorizedResponseBody is the type of the "schedule" service
+// "createFeed" endpoint HTTP response body for the "unauthorized" error.
+type CreateFeedUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RevokeFeedBadRequestResponseBody is the type of the "schedule" service
+// "revokeFeed" endpoint HTTP response body for the "badRequest" error.
+type RevokeFeedBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// RevokeFeedForbiddenResponseBody is the type of the "schedule" service
+// "revokeFeed" endpoint HTTP response body for the "forbidden" error.
+type RevokeFeedForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RevokeFeedInternalServerErrorResponseBody is the type of the "schedule"
+// service "revokeFeed" endpoint HTTP response body for the
+// "internalServerError" error.
+type RevokeFeedInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RevokeFeedNotFoundResponseBody is the type of the "schedule" service
+// "revokeFeed" endpoint HTTP response body for the "notFound" error.
+type RevokeFeedNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RevokeFeedUnauthorizedResponseBody is the type of the "schedule" service
+// "revokeFeed" endpoint HTTP response body for the "unauthorized" error.
+type RevokeFeedUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FeedBadRequestResponseBody is the type of the "schedule" service "feed"
+// endpoint HTTP response body for the "badRequest" error.
+type FeedBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// FeedForbiddenResponseBody is the type of the "schedule" service "feed"
+// endpoint HTTP response body for the "forbidden" error.
+type FeedForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FeedInternalServerErrorResponseBody is the type of the "schedule" service
+// "feed" endpoint HTTP response body for the "internalServerError" error.
+type FeedInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FeedNotFoundResponseBody is the type of the "schedule" service "feed"
+// endpoint HTTP response body for the "notFound" error.
+type FeedNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FeedUnauthorizedResponseBody is the type of the "schedule" service "feed"
+// endpoint HTTP response body for the "unauthorized" error.
+type FeedUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field string `form:"field" json:"field" xml:"field"`
+	// Motivo per cui il campo non è valido
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CalendarEntryResponse is used to define fields on response body types.
+type CalendarEntryResponse struct {
+	// Day of the occurrence
+	Date string `form:"date" json:"date" xml:"date"`
+	// Workout ID
+	WorkoutID string `form:"workoutId" json:"workoutId" xml:"workoutId"`
+	// Workout name
+	WorkoutName string `form:"workoutName" json:"workoutName" xml:"workoutName"`
+	// Order among the workouts of the same day
+	Position int `form:"position" json:"position" xml:"position"`
+	// Training plan ID
+	TrainingPlanID string `form:"trainingPlanId" json:"trainingPlanId" xml:"trainingPlanId"`
+	// Training plan name
+	TrainingPlanName string `form:"trainingPlanName" json:"trainingPlanName" xml:"trainingPlanName"`
+}
+
+// CalendarEntryResponseBody is used to define fields on response body types.
+type CalendarEntryResponseBody struct {
+	// Day of the occurrence
+	Date string `form:"date" json:"date" xml:"date"`
+	// Workout ID
+	WorkoutID string `form:"workoutId" json:"workoutId" xml:"workoutId"`
+	// Workout name
+	WorkoutName string `form:"workoutName" json:"workoutName" xml:"workoutName"`
+	// Order among the workouts of the same day
+	Position int `form:"position" json:"position" xml:"position"`
+	// Training plan ID
+	TrainingPlanID string `form:"trainingPlanId" json:"trainingPlanId" xml:"trainingPlanId"`
+	// Training plan name
+	TrainingPlanName string `form:"trainingPlanName" json:"trainingPlanName" xml:"trainingPlanName"`
+}
+
+// NewSetResponseBody builds the HTTP response body from the result of the
+// "set" endpoint of the "schedule" service.
+func NewSetResponseBody(res *schedule.WorkoutSchedule) *SetResponseBody {
+	body := &SetResponseBody{
+		WorkoutID:  res.WorkoutID,
+		Position:   res.Position,
+		Recurrence: res.Recurrence,
+	}
+	if res.Dates != nil {
+		body.Dates = make([]string, len(res.Dates))
+		for i, val := range res.Dates {
+			body.Dates[i] = val
+		}
+	} else {
+		body.Dates = []string{}
+	}
+	return body
+}
+
+// NewCalendarResponseBody builds the HTTP response body from the result of the
+// "calendar" endpoint of the "schedule" service.
+func NewCalendarResponseBody(res []*schedule.CalendarEntry) CalendarResponseBody {
+	body := make([]*CalendarEntryResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalScheduleCalendarEntryToCalendarEntryResponse(val)
+	}
+	return body
+}
+
+// NewTodayResponseBody builds the HTTP response body from the result of the
+// "today" endpoint of the "schedule" service.
+func NewTodayResponseBody(res *schedule.TodayWorkouts) *TodayResponseBody {
+	body := &TodayResponseBody{
+		Date:     res.Date,
+		Timezone: res.Timezone,
+	}
+	if res.Workouts != nil {
+		body.Workouts = make([]*CalendarEntryResponseBody, len(res.Workouts))
+		for i, val := range res.Workouts {
+			body.Workouts[i] = marshalScheduleCalendarEntryToCalendarEntryResponseBody(val)
+		}
+	} else {
+		body.Workouts = []*CalendarEntryResponseBody{}
+	}
+	return body
+}
+
+// NewCreateFeedResponseBody builds the HTTP response body from the result of
+// the "createFeed" endpoint of the "schedule" service.
+func NewCreateFeedResponseBody(res *schedule.CalendarFeed) *CreateFeedResponseBody {
+	body := &CreateFeedResponseBody{
+		Token:     res.Token,
+		Path:      res.Path,
+		CreatedAt: res.CreatedAt,
+	}
+	return body
+}
+
+// NewSetBadRequestResponseBody builds the HTTP response body from the result
+// of the "set" endpoint of the "schedule" service.
+func NewSetBadRequestResponseBody(res *schedule.BadRequest) *SetBadRequestResponseBody {
+	body := &SetBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalScheduleFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewSetForbiddenResponseBody builds the HTTP response body from the result of
+// the "set" endpoint of the "schedule" service.
+func NewSetForbiddenResponseBody(res *schedule.Forbidden) *SetForbiddenResponseBody {
+	body := &SetForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSetInternalServerErrorResponseBody builds the HTTP response body from the
+// result of the "set" endpoint of the "schedule" service.
+func NewSetInternalServerErrorResponseBody(res *schedule.InternalServerError) *SetInternalServerErrorResponseBody {
+	body := &SetInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSetNotFoundResponseBody builds the HTTP response body from the result of
+// the "set" endpoint of the "schedule" service.
+func NewSetNotFoundResponseBody(res *schedule.NotFound) *SetNotFoundResponseBody {
+	body := &SetNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSetUnauthorizedResponseBody builds the HTTP response body from the result
+// of the "set" endpoint of the "schedule" service.
+func NewSetUnauthorizedResponseBody(res *schedule.Unauthorized) *SetUnauthorizedResponseBody {
+	body := &SetUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCalendarBadRequestResponseBody builds the HTTP response body from the
+// result of the "calendar" endpoint of the "schedule" service.
+func NewCalendarBadRequestResponseBody(res *schedule.BadRequest) *CalendarBadRequestResponseBody {
+	body := &CalendarBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalScheduleFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewCalendarForbiddenResponseBody builds the HTTP response body from the
+// result of the "calendar" endpoint of the "schedule" service.
+func NewCalendarForbiddenResponseBody(res *schedule.Forbidden) *CalendarForbiddenResponseBody {
+	body := &CalendarForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCalendarInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "calendar" endpoint of the "schedule" service.
+func NewCalendarInternalServerErrorResponseBody(res *schedule.InternalServerError) *CalendarInternalServerErrorResponseBody {
+	body := &CalendarInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCalendarNotFoundResponseBody builds the HTTP response body from the
+// result of the "calendar" endpoint of the "schedule" service.
+func NewCalendarNotFoundResponseBody(res *schedule.NotFound) *CalendarNotFoundResponseBody {
+	body := &CalendarNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCalendarUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "calendar" endpoint of the "schedule" service.
+func NewCalendarUnauthorizedResponseBody(res *schedule.Unauthorized) *CalendarUnauthorizedResponseBody {
+	body := &CalendarUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewTodayBadRequestResponseBody builds the HTTP response body from the result
+// of the "today" endpoint of the "schedule" service.
+func NewTodayBadRequestResponseBody(res *schedule.BadRequest) *TodayBadRequestResponseBody {
+	body := &TodayBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalScheduleFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewTodayForbiddenResponseBody builds the HTTP response body from the result
+// of the "today" endpoint of the "schedule" service.
+func NewTodayForbiddenResponseBody(res *schedule.Forbidden) *TodayForbiddenResponseBody {
+	body := &TodayForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewTodayInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "today" endpoint of the "schedule" service.
+func NewTodayInternalServerErrorResponseBody(res *schedule.InternalServerError) *TodayInternalServerErrorResponseBody {
+	body := &TodayInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewTodayNotFoundResponseBody builds the HTTP response body from the result
+// of the "today" endpoint of the "schedule" service.
+func NewTodayNotFoundResponseBody(res *schedule.NotFound) *TodayNotFoundResponseBody {
+	body := &TodayNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewTodayUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "today" endpoint of the "schedule" service.
+func NewTodayUnauthorizedResponseBody(res *schedule.Unauthorized) *TodayUnauthorizedResponseBody {
+	body := &TodayUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateFeedBadRequestResponseBody builds the HTTP response body from the
+// result of the "createFeed" endpoint of the "schedule" service.
+func NewCreateFeedBadRequestResponseBody(res *schedule.BadRequest) *CreateFeedBadRequestResponseBody {
+	body := &CreateFeedBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalScheduleFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewCreateFeedForbiddenResponseBody builds the HTTP response body from the
+// result of the "createFeed" endpoint of the "schedule" service.
+func NewCreateFeedForbiddenResponseBody(res *schedule.Forbidden) *CreateFeedForbiddenResponseBody {
+	body := &CreateFeedForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateFeedInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "createFeed" endpoint of the "schedule" service.
+func NewCreateFeedInternalServerErrorResponseBody(res *schedule.InternalServerError) *CreateFeedInternalServerErrorResponseBody {
+	body := &CreateFeedInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateFeedNotFoundResponseBody builds the HTTP response body from the
+// result of the "createFeed" endpoint of the "schedule" service.
+func NewCreateFeedNotFoundResponseBody(res *schedule.NotFound) *CreateFeedNotFoundResponseBody {
+	body := &CreateFeedNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateFeedUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "createFeed" endpoint of the "schedule" service.
+func NewCreateFeedUnauthorizedResponseBody(res *schedule.Unauthorized) *CreateFeedUnauthorizedResponseBody {
+	body := &CreateFeedUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRevokeFeedBadRequestResponseBody builds the HTTP response body from the
+// result of the "revokeFeed" endpoint of the "schedule" service.
+func NewRevokeFeedBadRequestResponseBody(res *schedule.BadRequest) *RevokeFeedBadRequestResponseBody {
+	body := &RevokeFeedBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalScheduleFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewRevokeFeedForbiddenResponseBody builds the HTTP response body from the
+// result of the "revokeFeed" endpoint of the "schedule" service.
+func NewRevokeFeedForbiddenResponseBody(res *schedule.Forbidden) *RevokeFeedForbiddenResponseBody {
+	body := &RevokeFeedForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRevokeFeedInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "revokeFeed" endpoint of the "schedule" service.
+func NewRevokeFeedInternalServerErrorResponseBody(res *schedule.InternalServerError) *RevokeFeedInternalServerErrorResponseBody {
+	body := &RevokeFeedInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRevokeFeedNotFoundResponseBody builds the HTTP response body from the
+// result of the "revokeFeed" endpoint of the "schedule" service.
+func NewRevokeFeedNotFoundResponseBody(res *schedule.NotFound) *RevokeFeedNotFoundResponseBody {
+	body := &RevokeFeedNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRevokeFeedUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "revokeFeed" endpoint of the "schedule" service.
+func NewRevokeFeedUnauthorizedResponseBody(res *schedule.Unauthorized) *RevokeFeedUnauthorizedResponseBody {
+	body := &RevokeFeedUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewFeedBadRequestResponseBody builds the HTTP response body from the result
+// of the "feed" endpoint of the "schedule" service.
+func NewFeedBadRequestResponseBody(res *schedule.BadRequest) *FeedBadRequestResponseBody {
+	body := &FeedBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalScheduleFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewFeedForbiddenResponseBody builds the HTTP response body from the result
+// of the "feed" endpoint of the "schedule" service.
+func NewFeedForbiddenResponseBody(res *schedule.Forbidden) *FeedForbiddenResponseBody {
+	body := &FeedForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewFeedInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "feed" endpoint of the "schedule" service.
+func NewFeedInternalServerErrorResponseBody(res *schedule.InternalServerError) *FeedInternalServerErrorResponseBody {
+	body := &FeedInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewFeedNotFoundResponseBody builds the HTTP response body from the result of
+// the "feed" endpoint of the "schedule" service.
+func NewFeedNotFoundResponseBody(res *schedule.NotFound) *FeedNotFoundResponseBody {
+	body := &FeedNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewFeedUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "feed" endpoint of the "schedule" service.
+func NewFeedUnauthorizedResponseBody(res *schedule.Unauthorized) *FeedUnauthorizedResponseBody {
+	body := &FeedUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSetPayload builds a schedule service set endpoint payload.
+func NewSetPayload(body *SetRequestBody, id string, token *string) *schedule.SetPayload {
+	v := &schedule.SetPayload{
+		Recurrence: body.Recurrence,
+	}
+	if body.Position != nil {
+		v.Position = *body.Position
+	}
+	if body.Position == nil {
+		v.Position = 0
+	}
+	if body.Dates != nil {
+		v.Dates = make([]string, len(body.Dates))
+		for i, val := range body.Dates {
+			v.Dates[i] = val
+		}
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewCalendarPayload builds a schedule service calendar endpoint payload.
+func NewCalendarPayload(from string, to string, token *string) *schedule.CalendarPayload {
+	v := &schedule.CalendarPayload{}
+	v.From = from
+	v.To = to
+	v.Token = token
+
+	return v
+}
+
+// NewTodayPayload builds a schedule service today endpoint payload.
+func NewTodayPayload(timezone *string, token *string) *schedule.TodayPayload {
+	v := &schedule.TodayPayload{}
+	v.Timezone = timezone
+	v.Token = token
+
+	return v
+}
+
+// NewCreateFeedPayload builds a schedule service createFeed endpoint payload.
+func NewCreateFeedPayload(token *string) *schedule.CreateFeedPayload {
+	v := &schedule.CreateFeedPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewRevokeFeedPayload builds a schedule service revokeFeed endpoint payload.
+func NewRevokeFeedPayload(token *string) *schedule.RevokeFeedPayload {
+	v := &schedule.RevokeFeedPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewFeedPayload builds a schedule service feed endpoint payload.
+func NewFeedPayload(feedToken string) *schedule.FeedPayload {
+	v := &schedule.FeedPayload{}
+	v.FeedToken = feedToken
+
+	return v
+}
+
+// ValidateSetRequestBody runs the validations defined on SetRequestBody
+func ValidateSetRequestBody(body *SetRequestBody) (err error) {
+	if body.Position != nil {
+		if *body.Position < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.position", *body.Position, 0, true))
+		}
+	}
+	for _, e := range body.Dates {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.dates[*]", e, goa.FormatDate))
+	}
+	return
+}
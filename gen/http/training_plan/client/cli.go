@@ -157,7 +157,7 @@ func BuildListPayload(trainingPlanListUserID string, trainingPlanListStartAfter
 
 // BuildUpdatePayload builds the payload for the training_plan update endpoint
 // from CLI flags.
-func BuildUpdatePayload(trainingPlanUpdateBody string, trainingPlanUpdateID string, trainingPlanUpdateToken string) (*trainingplan.UpdatePayload, error) {
+func BuildUpdatePayload(trainingPlanUpdateBody string, trainingPlanUpdateID string, trainingPlanUpdateIfMatch string, trainingPlanUpdateToken string) (*trainingplan.UpdatePayload, error) {
 	var err error
 	var body UpdateRequestBody
 	{
@@ -183,6 +183,10 @@ func BuildUpdatePayload(trainingPlanUpdateBody string, trainingPlanUpdateID stri
 			return nil, err
 		}
 	}
+	var ifMatch string
+	{
+		ifMatch = trainingPlanUpdateIfMatch
+	}
 	var token *string
 	{
 		if trainingPlanUpdateToken != "" {
@@ -197,6 +201,37 @@ func BuildUpdatePayload(trainingPlanUpdateBody string, trainingPlanUpdateID stri
 		UserID:      body.UserID,
 	}
 	v.ID = id
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildPatchPayload builds the payload for the training_plan patch endpoint
+// from CLI flags.
+func BuildPatchPayload(trainingPlanPatchID string, trainingPlanPatchIfMatch string, trainingPlanPatchToken string) (*trainingplan.PatchPayload, error) {
+	var err error
+	var id string
+	{
+		id = trainingPlanPatchID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var ifMatch string
+	{
+		ifMatch = trainingPlanPatchIfMatch
+	}
+	var token *string
+	{
+		if trainingPlanPatchToken != "" {
+			token = &trainingPlanPatchToken
+		}
+	}
+	v := &trainingplan.PatchPayload{}
+	v.ID = id
+	v.IfMatch = ifMatch
 	v.Token = token
 
 	return v, nil
@@ -204,7 +239,7 @@ func BuildUpdatePayload(trainingPlanUpdateBody string, trainingPlanUpdateID stri
 
 // BuildDeletePayload builds the payload for the training_plan delete endpoint
 // from CLI flags.
-func BuildDeletePayload(trainingPlanDeleteID string, trainingPlanDeleteToken string) (*trainingplan.DeletePayload, error) {
+func BuildDeletePayload(trainingPlanDeleteID string, trainingPlanDeleteIfMatch string, trainingPlanDeleteToken string) (*trainingplan.DeletePayload, error) {
 	var err error
 	var id string
 	{
@@ -214,6 +249,10 @@ func BuildDeletePayload(trainingPlanDeleteID string, trainingPlanDeleteToken str
 			return nil, err
 		}
 	}
+	var ifMatch string
+	{
+		ifMatch = trainingPlanDeleteIfMatch
+	}
 	var token *string
 	{
 		if trainingPlanDeleteToken != "" {
@@ -222,7 +261,359 @@ func BuildDeletePayload(trainingPlanDeleteID string, trainingPlanDeleteToken str
 	}
 	v := &trainingplan.DeletePayload{}
 	v.ID = id
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRestorePayload builds the payload for the training_plan restore
+// endpoint from CLI flags.
+func BuildRestorePayload(trainingPlanRestoreID string, trainingPlanRestoreToken string) (*trainingplan.RestorePayload, error) {
+	var err error
+	var id string
+	{
+		id = trainingPlanRestoreID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if trainingPlanRestoreToken != "" {
+			token = &trainingPlanRestoreToken
+		}
+	}
+	v := &trainingplan.RestorePayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListDeletedPayload builds the payload for the training_plan listDeleted
+// endpoint from CLI flags.
+func BuildListDeletedPayload(trainingPlanListDeletedUserID string, trainingPlanListDeletedLimit string, trainingPlanListDeletedOffset string, trainingPlanListDeletedToken string) (*trainingplan.ListDeletedPayload, error) {
+	var err error
+	var userID *string
+	{
+		if trainingPlanListDeletedUserID != "" {
+			userID = &trainingPlanListDeletedUserID
+			err = goa.MergeErrors(err, goa.ValidateFormat("userId", *userID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if trainingPlanListDeletedLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(trainingPlanListDeletedLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var offset int
+	{
+		if trainingPlanListDeletedOffset != "" {
+			var v int64
+			v, err = strconv.ParseInt(trainingPlanListDeletedOffset, 10, strconv.IntSize)
+			offset = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for offset, must be INT")
+			}
+			if offset < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("offset", offset, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if trainingPlanListDeletedToken != "" {
+			token = &trainingPlanListDeletedToken
+		}
+	}
+	v := &trainingplan.ListDeletedPayload{}
+	v.UserID = userID
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildClonePayload builds the payload for the training_plan clone endpoint
+// from CLI flags.
+func BuildClonePayload(trainingPlanCloneBody string, trainingPlanCloneID string, trainingPlanCloneToken string) (*trainingplan.ClonePayload, error) {
+	var err error
+	var body CloneRequestBody
+	{
+		err = json.Unmarshal([]byte(trainingPlanCloneBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"Upper Body Strength - June\",\n      \"startDate\": \"2025-06-02T00:00:00Z\",\n      \"userId\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }'")
+		}
+		if body.UserID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startDate", body.StartDate, goa.FormatDateTime))
+		if body.Name != nil {
+			if utf8.RuneCountInString(*body.Name) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = trainingPlanCloneID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if trainingPlanCloneToken != "" {
+			token = &trainingPlanCloneToken
+		}
+	}
+	v := &trainingplan.ClonePayload{
+		UserID:    body.UserID,
+		StartDate: body.StartDate,
+		Name:      body.Name,
+	}
+	v.ID = id
 	v.Token = token
 
 	return v, nil
 }
+
+// BuildCreateTemplatePayload builds the payload for the training_plan
+// createTemplate endpoint from CLI flags.
+func BuildCreateTemplatePayload(trainingPlanCreateTemplateBody string, trainingPlanCreateTemplateID string, trainingPlanCreateTemplateToken string) (*trainingplan.CreateTemplatePayload, error) {
+	var err error
+	var body CreateTemplateRequestBody
+	{
+		err = json.Unmarshal([]byte(trainingPlanCreateTemplateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"5x5 Beginner\",\n      \"public\": false\n   }'")
+		}
+		if body.Name != nil {
+			if utf8.RuneCountInString(*body.Name) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = trainingPlanCreateTemplateID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if trainingPlanCreateTemplateToken != "" {
+			token = &trainingPlanCreateTemplateToken
+		}
+	}
+	v := &trainingplan.CreateTemplatePayload{
+		Name:   body.Name,
+		Public: body.Public,
+	}
+	{
+		var zero bool
+		if v.Public == zero {
+			v.Public = false
+		}
+	}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListTemplatesPayload builds the payload for the training_plan
+// listTemplates endpoint from CLI flags.
+func BuildListTemplatesPayload(trainingPlanListTemplatesLimit string, trainingPlanListTemplatesOffset string, trainingPlanListTemplatesToken string) (*trainingplan.ListTemplatesPayload, error) {
+	var err error
+	var limit int
+	{
+		if trainingPlanListTemplatesLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(trainingPlanListTemplatesLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var offset int
+	{
+		if trainingPlanListTemplatesOffset != "" {
+			var v int64
+			v, err = strconv.ParseInt(trainingPlanListTemplatesOffset, 10, strconv.IntSize)
+			offset = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for offset, must be INT")
+			}
+			if offset < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("offset", offset, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if trainingPlanListTemplatesToken != "" {
+			token = &trainingPlanListTemplatesToken
+		}
+	}
+	v := &trainingplan.ListTemplatesPayload{}
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildExportPayload builds the payload for the training_plan export endpoint
+// from CLI flags.
+func BuildExportPayload(trainingPlanExportID string, trainingPlanExportFormat string, trainingPlanExportToken string) (*trainingplan.ExportPayload, error) {
+	var err error
+	var id string
+	{
+		id = trainingPlanExportID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var format string
+	{
+		if trainingPlanExportFormat != "" {
+			format = trainingPlanExportFormat
+			if !(format == "json" || format == "csv") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"json", "csv"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if trainingPlanExportToken != "" {
+			token = &trainingPlanExportToken
+		}
+	}
+	v := &trainingplan.ExportPayload{}
+	v.ID = id
+	v.Format = format
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildImportPayload builds the payload for the training_plan import endpoint
+// from CLI flags.
+func BuildImportPayload(trainingPlanImportBody string, trainingPlanImportUserID string, trainingPlanImportAllowUnmatched string, trainingPlanImportToken string) (*trainingplan.ImportPayload, error) {
+	var err error
+	var body ImportRequestBody
+	{
+		err = json.Unmarshal([]byte(trainingPlanImportBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"exportedAt\": \"2004-05-08T09:58:24Z\",\n      \"plan\": {\n         \"description\": \"Quia voluptatem aut vero.\",\n         \"endDate\": \"2025-04-25T00:00:00Z\",\n         \"name\": \"Upper Body Strength\",\n         \"startDate\": \"2025-03-25T00:00:00Z\",\n         \"workouts\": [\n            {\n               \"exercises\": [\n                  {\n                     \"exerciseType\": \"Bench Press\",\n                     \"name\": \"Bench Press\",\n                     \"progression\": {\n                        \"deloadAfter\": 8070508509937062080,\n                        \"deloadPercentage\": 0.008687062835540998,\n                        \"increment\": 2.5,\n                        \"kind\": \"linear\",\n                        \"maxReps\": 2809595721649925129,\n                        \"minReps\": 8355147212356982375,\n                        \"percentage\": 0.5114371920190389\n                     },\n                     \"sets\": [\n                        {\n                           \"reps\": 8,\n                           \"restTime\": 90,\n                           \"weight\": 80\n                        },\n                        {\n                           \"reps\": 8,\n                           \"restTime\": 90,\n                           \"weight\": 80\n                        }\n                     ]\n                  },\n                  {\n                     \"exerciseType\": \"Bench Press\",\n                     \"name\": \"Bench Press\",\n                     \"progression\": {\n                        \"deloadAfter\": 8070508509937062080,\n                        \"deloadPercentage\": 0.008687062835540998,\n                        \"increment\": 2.5,\n                        \"kind\": \"linear\",\n                        \"maxReps\": 2809595721649925129,\n                        \"minReps\": 8355147212356982375,\n                        \"percentage\": 0.5114371920190389\n                     },\n                     \"sets\": [\n                        {\n                           \"reps\": 8,\n                           \"restTime\": 90,\n                           \"weight\": 80\n                        },\n                        {\n                           \"reps\": 8,\n                           \"restTime\": 90,\n                           \"weight\": 80\n                        }\n                     ]\n                  }\n               ],\n               \"name\": \"Push day\",\n               \"position\": 1,\n               \"recurrence\": \"FREQ=WEEKLY;BYDAY=MO,TH\",\n               \"scheduledDates\": [\n                  \"2004-06-09\",\n                  \"1996-02-01\",\n                  \"2004-02-13\",\n                  \"2000-05-11\"\n               ]\n            },\n            {\n               \"exercises\": [\n                  {\n                     \"exerciseType\": \"Bench Press\",\n                     \"name\": \"Bench Press\",\n                     \"progression\": {\n                        \"deloadAfter\": 8070508509937062080,\n                        \"deloadPercentage\": 0.008687062835540998,\n                        \"increment\": 2.5,\n                        \"kind\": \"linear\",\n                        \"maxReps\": 2809595721649925129,\n                        \"minReps\": 8355147212356982375,\n                        \"percentage\": 0.5114371920190389\n                     },\n                     \"sets\": [\n                        {\n                           \"reps\": 8,\n                           \"restTime\": 90,\n                           \"weight\": 80\n                        },\n                        {\n                           \"reps\": 8,\n                           \"restTime\": 90,\n                           \"weight\": 80\n                        }\n                     ]\n                  },\n                  {\n                     \"exerciseType\": \"Bench Press\",\n                     \"name\": \"Bench Press\",\n                     \"progression\": {\n                        \"deloadAfter\": 8070508509937062080,\n                        \"deloadPercentage\": 0.008687062835540998,\n                        \"increment\": 2.5,\n                        \"kind\": \"linear\",\n                        \"maxReps\": 2809595721649925129,\n                        \"minReps\": 8355147212356982375,\n                        \"percentage\": 0.5114371920190389\n                     },\n                     \"sets\": [\n                        {\n                           \"reps\": 8,\n                           \"restTime\": 90,\n                           \"weight\": 80\n                        },\n                        {\n                           \"reps\": 8,\n                           \"restTime\": 90,\n                           \"weight\": 80\n                        }\n                     ]\n                  }\n               ],\n               \"name\": \"Push day\",\n               \"position\": 1,\n               \"recurrence\": \"FREQ=WEEKLY;BYDAY=MO,TH\",\n               \"scheduledDates\": [\n                  \"2004-06-09\",\n                  \"1996-02-01\",\n                  \"2004-02-13\",\n                  \"2000-05-11\"\n               ]\n            }\n         ]\n      },\n      \"version\": 1\n   }'")
+		}
+		if body.Plan == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("plan", "body"))
+		}
+		if !(body.Version == 1) {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.version", body.Version, []any{1}))
+		}
+		if body.ExportedAt != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.exportedAt", *body.ExportedAt, goa.FormatDateTime))
+		}
+		if body.Plan != nil {
+			if err2 := ValidatePlanDocumentPlanRequestBodyRequestBody(body.Plan); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var userID *string
+	{
+		if trainingPlanImportUserID != "" {
+			userID = &trainingPlanImportUserID
+			err = goa.MergeErrors(err, goa.ValidateFormat("userId", *userID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var allowUnmatched bool
+	{
+		if trainingPlanImportAllowUnmatched != "" {
+			allowUnmatched, err = strconv.ParseBool(trainingPlanImportAllowUnmatched)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for allowUnmatched, must be BOOL")
+			}
+		}
+	}
+	var token *string
+	{
+		if trainingPlanImportToken != "" {
+			token = &trainingPlanImportToken
+		}
+	}
+	v := &trainingplan.PlanDocument{
+		Version:    body.Version,
+		ExportedAt: body.ExportedAt,
+	}
+	if body.Plan != nil {
+		v.Plan = marshalPlanDocumentPlanRequestBodyRequestBodyToTrainingplanPlanDocumentPlan(body.Plan)
+	}
+	res := &trainingplan.ImportPayload{
+		Document: v,
+	}
+	res.UserID = userID
+	res.AllowUnmatched = allowUnmatched
+	res.Token = token
+
+	return res, nil
+}
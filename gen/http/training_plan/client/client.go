@@ -8,6 +8,7 @@
 package client
 
 import (
+	trainingplan "be/gen/training_plan"
 	"context"
 	"net/http"
 
@@ -29,9 +30,37 @@ type Client struct {
 	// Update Doer is the HTTP client used to make requests to the update endpoint.
 	UpdateDoer goahttp.Doer
 
+	// Patch Doer is the HTTP client used to make requests to the patch endpoint.
+	PatchDoer goahttp.Doer
+
 	// Delete Doer is the HTTP client used to make requests to the delete endpoint.
 	DeleteDoer goahttp.Doer
 
+	// Restore Doer is the HTTP client used to make requests to the restore
+	// endpoint.
+	RestoreDoer goahttp.Doer
+
+	// ListDeleted Doer is the HTTP client used to make requests to the listDeleted
+	// endpoint.
+	ListDeletedDoer goahttp.Doer
+
+	// Clone Doer is the HTTP client used to make requests to the clone endpoint.
+	CloneDoer goahttp.Doer
+
+	// CreateTemplate Doer is the HTTP client used to make requests to the
+	// createTemplate endpoint.
+	CreateTemplateDoer goahttp.Doer
+
+	// ListTemplates Doer is the HTTP client used to make requests to the
+	// listTemplates endpoint.
+	ListTemplatesDoer goahttp.Doer
+
+	// Export Doer is the HTTP client used to make requests to the export endpoint.
+	ExportDoer goahttp.Doer
+
+	// Import Doer is the HTTP client used to make requests to the import endpoint.
+	ImportDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -57,7 +86,15 @@ func NewClient(
 		GetDoer:             doer,
 		ListDoer:            doer,
 		UpdateDoer:          doer,
+		PatchDoer:           doer,
 		DeleteDoer:          doer,
+		RestoreDoer:         doer,
+		ListDeletedDoer:     doer,
+		CloneDoer:           doer,
+		CreateTemplateDoer:  doer,
+		ListTemplatesDoer:   doer,
+		ExportDoer:          doer,
+		ImportDoer:          doer,
 		RestoreResponseBody: restoreBody,
 		scheme:              scheme,
 		host:                host,
@@ -162,6 +199,30 @@ func (c *Client) Update() goa.Endpoint {
 	}
 }
 
+// Patch returns an endpoint that makes HTTP requests to the training_plan
+// service patch server.
+func (c *Client) Patch() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePatchRequest(c.encoder)
+		decodeResponse = DecodePatchResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPatchRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PatchDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("training_plan", "patch", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // Delete returns an endpoint that makes HTTP requests to the training_plan
 // service delete server.
 func (c *Client) Delete() goa.Endpoint {
@@ -185,3 +246,176 @@ func (c *Client) Delete() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// Restore returns an endpoint that makes HTTP requests to the training_plan
+// service restore server.
+func (c *Client) Restore() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRestoreRequest(c.encoder)
+		decodeResponse = DecodeRestoreResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRestoreRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RestoreDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("training_plan", "restore", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListDeleted returns an endpoint that makes HTTP requests to the
+// training_plan service listDeleted server.
+func (c *Client) ListDeleted() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListDeletedRequest(c.encoder)
+		decodeResponse = DecodeListDeletedResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListDeletedRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListDeletedDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("training_plan", "listDeleted", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Clone returns an endpoint that makes HTTP requests to the training_plan
+// service clone server.
+func (c *Client) Clone() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCloneRequest(c.encoder)
+		decodeResponse = DecodeCloneResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCloneRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CloneDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("training_plan", "clone", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateTemplate returns an endpoint that makes HTTP requests to the
+// training_plan service createTemplate server.
+func (c *Client) CreateTemplate() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateTemplateRequest(c.encoder)
+		decodeResponse = DecodeCreateTemplateResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateTemplateRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateTemplateDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("training_plan", "createTemplate", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListTemplates returns an endpoint that makes HTTP requests to the
+// training_plan service listTemplates server.
+func (c *Client) ListTemplates() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListTemplatesRequest(c.encoder)
+		decodeResponse = DecodeListTemplatesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListTemplatesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListTemplatesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("training_plan", "listTemplates", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Export returns an endpoint that makes HTTP requests to the training_plan
+// service export server.
+func (c *Client) Export() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeExportRequest(c.encoder)
+		decodeResponse = DecodeExportResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildExportRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ExportDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("training_plan", "export", err)
+		}
+		res, err := decodeResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &trainingplan.ExportResponseData{Result: res.(*trainingplan.ExportResult), Body: resp.Body}, nil
+	}
+}
+
+// Import returns an endpoint that makes HTTP requests to the training_plan
+// service import server.
+func (c *Client) Import() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeImportRequest(c.encoder)
+		decodeResponse = DecodeImportResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildImportRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ImportDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("training_plan", "import", err)
+		}
+		return decodeResponse(resp)
+	}
+}
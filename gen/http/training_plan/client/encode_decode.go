@@ -15,6 +15,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"strings"
 
 	goahttp "goa.design/goa/v3/http"
@@ -63,6 +64,13 @@ func EncodeCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 // DecodeCreateResponse returns a decoder for responses returned by the
 // training_plan create endpoint. restoreBody controls whether the response
 // body should be restored after having been read.
+// DecodeCreateResponse may return the following errors:
+//   - "badRequest" (type *trainingplan.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *trainingplan.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *trainingplan.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *trainingplan.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *trainingplan.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
 func DecodeCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -91,8 +99,85 @@ func DecodeCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 			if err != nil {
 				return nil, goahttp.ErrValidationError("training_plan", "create", err)
 			}
-			res := NewCreateTrainingPlanCreated(&body)
+			var (
+				etag *string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			res := NewCreateTrainingPlanCreated(&body, etag)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "create", err)
+			}
+			err = ValidateCreateBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "create", err)
+			}
+			return nil, NewCreateBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "create", err)
+			}
+			err = ValidateCreateForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "create", err)
+			}
+			return nil, NewCreateForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "create", err)
+			}
+			err = ValidateCreateInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "create", err)
+			}
+			return nil, NewCreateInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body CreateNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "create", err)
+			}
+			err = ValidateCreateNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "create", err)
+			}
+			return nil, NewCreateNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CreateUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "create", err)
+			}
+			err = ValidateCreateUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "create", err)
+			}
+			return nil, NewCreateUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("training_plan", "create", resp.StatusCode, string(body))
@@ -148,6 +233,13 @@ func EncodeGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Re
 // DecodeGetResponse returns a decoder for responses returned by the
 // training_plan get endpoint. restoreBody controls whether the response body
 // should be restored after having been read.
+// DecodeGetResponse may return the following errors:
+//   - "badRequest" (type *trainingplan.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *trainingplan.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *trainingplan.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *trainingplan.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *trainingplan.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
 func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -176,8 +268,85 @@ func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody
 			if err != nil {
 				return nil, goahttp.ErrValidationError("training_plan", "get", err)
 			}
-			res := NewGetTrainingPlanOK(&body)
+			var (
+				etag *string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			res := NewGetTrainingPlanOK(&body, etag)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "get", err)
+			}
+			err = ValidateGetBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "get", err)
+			}
+			return nil, NewGetBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "get", err)
+			}
+			err = ValidateGetForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "get", err)
+			}
+			return nil, NewGetForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "get", err)
+			}
+			err = ValidateGetInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "get", err)
+			}
+			return nil, NewGetInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "get", err)
+			}
+			err = ValidateGetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "get", err)
+			}
+			return nil, NewGetNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "get", err)
+			}
+			err = ValidateGetUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "get", err)
+			}
+			return nil, NewGetUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("training_plan", "get", resp.StatusCode, string(body))
@@ -233,6 +402,13 @@ func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.R
 // DecodeListResponse returns a decoder for responses returned by the
 // training_plan list endpoint. restoreBody controls whether the response body
 // should be restored after having been read.
+// DecodeListResponse may return the following errors:
+//   - "badRequest" (type *trainingplan.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *trainingplan.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *trainingplan.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *trainingplan.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *trainingplan.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
 func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -269,6 +445,76 @@ func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBod
 			}
 			res := NewListTrainingPlanOK(body)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "list", err)
+			}
+			err = ValidateListBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "list", err)
+			}
+			return nil, NewListBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ListForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "list", err)
+			}
+			err = ValidateListForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "list", err)
+			}
+			return nil, NewListForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "list", err)
+			}
+			err = ValidateListInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "list", err)
+			}
+			return nil, NewListInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "list", err)
+			}
+			err = ValidateListNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "list", err)
+			}
+			return nil, NewListNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "list", err)
+			}
+			err = ValidateListUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "list", err)
+			}
+			return nil, NewListUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("training_plan", "list", resp.StatusCode, string(body))
@@ -309,6 +555,10 @@ func EncodeUpdateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 		if !ok {
 			return goahttp.ErrInvalidType("training_plan", "update", "*trainingplan.UpdatePayload", v)
 		}
+		{
+			head := p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
 		if p.Token != nil {
 			head := *p.Token
 			if !strings.Contains(head, " ") {
@@ -328,6 +578,14 @@ func EncodeUpdateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 // DecodeUpdateResponse returns a decoder for responses returned by the
 // training_plan update endpoint. restoreBody controls whether the response
 // body should be restored after having been read.
+// DecodeUpdateResponse may return the following errors:
+//   - "badRequest" (type *trainingplan.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *trainingplan.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *trainingplan.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *trainingplan.NotFound): http.StatusNotFound
+//   - "preconditionFailed" (type *trainingplan.PreconditionFailed): http.StatusPreconditionFailed
+//   - "unauthorized" (type *trainingplan.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
 func DecodeUpdateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -356,8 +614,99 @@ func DecodeUpdateResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 			if err != nil {
 				return nil, goahttp.ErrValidationError("training_plan", "update", err)
 			}
-			res := NewUpdateTrainingPlanOK(&body)
+			var (
+				etag *string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			res := NewUpdateTrainingPlanOK(&body, etag)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "update", err)
+			}
+			err = ValidateUpdateBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "update", err)
+			}
+			return nil, NewUpdateBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "update", err)
+			}
+			err = ValidateUpdateForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "update", err)
+			}
+			return nil, NewUpdateForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "update", err)
+			}
+			err = ValidateUpdateInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "update", err)
+			}
+			return nil, NewUpdateInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "update", err)
+			}
+			err = ValidateUpdateNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "update", err)
+			}
+			return nil, NewUpdateNotFound(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body UpdatePreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "update", err)
+			}
+			err = ValidateUpdatePreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "update", err)
+			}
+			return nil, NewUpdatePreconditionFailed(&body)
+		case http.StatusUnauthorized:
+			var (
+				body UpdateUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "update", err)
+			}
+			err = ValidateUpdateUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "update", err)
+			}
+			return nil, NewUpdateUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("training_plan", "update", resp.StatusCode, string(body))
@@ -365,23 +714,26 @@ func DecodeUpdateResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 	}
 }
 
-// BuildDeleteRequest instantiates a HTTP request object with method and path
-// set to call the "training_plan" service "delete" endpoint
-func (c *Client) BuildDeleteRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildPatchRequest instantiates a HTTP request object with method and path
+// set to call the "training_plan" service "patch" endpoint
+func (c *Client) BuildPatchRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		id string
+		id   string
+		body io.Reader
 	)
 	{
-		p, ok := v.(*trainingplan.DeletePayload)
+		rd, ok := v.(*trainingplan.PatchRequestData)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("training_plan", "delete", "*trainingplan.DeletePayload", v)
+			return nil, goahttp.ErrInvalidType("training_plan", "patch", "trainingplan.PatchRequestData", v)
 		}
+		p := rd.Payload
+		body = rd.Body
 		id = p.ID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteTrainingPlanPath(id)}
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PatchTrainingPlanPath(id)}
+	req, err := http.NewRequest("PATCH", u.String(), body)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("training_plan", "delete", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("training_plan", "patch", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -390,13 +742,18 @@ func (c *Client) BuildDeleteRequest(ctx context.Context, v any) (*http.Request,
 	return req, nil
 }
 
-// EncodeDeleteRequest returns an encoder for requests sent to the
-// training_plan delete server.
-func EncodeDeleteRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodePatchRequest returns an encoder for requests sent to the training_plan
+// patch server.
+func EncodePatchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*trainingplan.DeletePayload)
+		data, ok := v.(*trainingplan.PatchRequestData)
 		if !ok {
-			return goahttp.ErrInvalidType("training_plan", "delete", "*trainingplan.DeletePayload", v)
+			return goahttp.ErrInvalidType("training_plan", "patch", "*trainingplan.PatchRequestData", v)
+		}
+		p := data.Payload
+		{
+			head := p.IfMatch
+			req.Header.Set("If-Match", head)
 		}
 		if p.Token != nil {
 			head := *p.Token
@@ -410,10 +767,18 @@ func EncodeDeleteRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 	}
 }
 
-// DecodeDeleteResponse returns a decoder for responses returned by the
-// training_plan delete endpoint. restoreBody controls whether the response
-// body should be restored after having been read.
-func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+// DecodePatchResponse returns a decoder for responses returned by the
+// training_plan patch endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodePatchResponse may return the following errors:
+//   - "badRequest" (type *trainingplan.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *trainingplan.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *trainingplan.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *trainingplan.NotFound): http.StatusNotFound
+//   - "preconditionFailed" (type *trainingplan.PreconditionFailed): http.StatusPreconditionFailed
+//   - "unauthorized" (type *trainingplan.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodePatchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -428,25 +793,1748 @@ func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusNoContent:
-			return nil, nil
-		default:
-			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("training_plan", "delete", resp.StatusCode, string(body))
-		}
-	}
-}
-
-// unmarshalTrainingPlanResponseToTrainingplanTrainingPlan builds a value of
-// type *trainingplan.TrainingPlan from a value of type *TrainingPlanResponse.
-func unmarshalTrainingPlanResponseToTrainingplanTrainingPlan(v *TrainingPlanResponse) *trainingplan.TrainingPlan {
-	res := &trainingplan.TrainingPlan{
-		ID:          *v.ID,
+		case http.StatusOK:
+			var (
+				body PatchResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "patch", err)
+			}
+			err = ValidatePatchResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "patch", err)
+			}
+			var (
+				etag *string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			res := NewPatchTrainingPlanOK(&body, etag)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body PatchBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "patch", err)
+			}
+			err = ValidatePatchBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "patch", err)
+			}
+			return nil, NewPatchBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body PatchForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "patch", err)
+			}
+			err = ValidatePatchForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "patch", err)
+			}
+			return nil, NewPatchForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body PatchInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "patch", err)
+			}
+			err = ValidatePatchInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "patch", err)
+			}
+			return nil, NewPatchInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body PatchNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "patch", err)
+			}
+			err = ValidatePatchNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "patch", err)
+			}
+			return nil, NewPatchNotFound(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body PatchPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "patch", err)
+			}
+			err = ValidatePatchPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "patch", err)
+			}
+			return nil, NewPatchPreconditionFailed(&body)
+		case http.StatusUnauthorized:
+			var (
+				body PatchUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "patch", err)
+			}
+			err = ValidatePatchUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "patch", err)
+			}
+			return nil, NewPatchUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("training_plan", "patch", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// // BuildPatchStreamPayload creates a streaming endpoint request payload from
+// the method payload and the path to the file to be streamed
+func BuildPatchStreamPayload(payload any, fpath string) (*trainingplan.PatchRequestData, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return &trainingplan.PatchRequestData{
+		Payload: payload.(*trainingplan.PatchPayload),
+		Body:    f,
+	}, nil
+}
+
+// BuildDeleteRequest instantiates a HTTP request object with method and path
+// set to call the "training_plan" service "delete" endpoint
+func (c *Client) BuildDeleteRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*trainingplan.DeletePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("training_plan", "delete", "*trainingplan.DeletePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteTrainingPlanPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("training_plan", "delete", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteRequest returns an encoder for requests sent to the
+// training_plan delete server.
+func EncodeDeleteRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*trainingplan.DeletePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("training_plan", "delete", "*trainingplan.DeletePayload", v)
+		}
+		{
+			head := p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteResponse returns a decoder for responses returned by the
+// training_plan delete endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeDeleteResponse may return the following errors:
+//   - "badRequest" (type *trainingplan.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *trainingplan.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *trainingplan.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *trainingplan.NotFound): http.StatusNotFound
+//   - "preconditionFailed" (type *trainingplan.PreconditionFailed): http.StatusPreconditionFailed
+//   - "unauthorized" (type *trainingplan.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body DeleteBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "delete", err)
+			}
+			err = ValidateDeleteBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "delete", err)
+			}
+			return nil, NewDeleteBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "delete", err)
+			}
+			err = ValidateDeleteForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "delete", err)
+			}
+			return nil, NewDeleteForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "delete", err)
+			}
+			err = ValidateDeleteInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "delete", err)
+			}
+			return nil, NewDeleteInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "delete", err)
+			}
+			err = ValidateDeleteNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "delete", err)
+			}
+			return nil, NewDeleteNotFound(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body DeletePreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "delete", err)
+			}
+			err = ValidateDeletePreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "delete", err)
+			}
+			return nil, NewDeletePreconditionFailed(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeleteUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "delete", err)
+			}
+			err = ValidateDeleteUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "delete", err)
+			}
+			return nil, NewDeleteUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("training_plan", "delete", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRestoreRequest instantiates a HTTP request object with method and path
+// set to call the "training_plan" service "restore" endpoint
+func (c *Client) BuildRestoreRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*trainingplan.RestorePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("training_plan", "restore", "*trainingplan.RestorePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RestoreTrainingPlanPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("training_plan", "restore", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRestoreRequest returns an encoder for requests sent to the
+// training_plan restore server.
+func EncodeRestoreRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*trainingplan.RestorePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("training_plan", "restore", "*trainingplan.RestorePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeRestoreResponse returns a decoder for responses returned by the
+// training_plan restore endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeRestoreResponse may return the following errors:
+//   - "badRequest" (type *trainingplan.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *trainingplan.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *trainingplan.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *trainingplan.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *trainingplan.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRestoreResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RestoreResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "restore", err)
+			}
+			err = ValidateRestoreResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "restore", err)
+			}
+			var (
+				etag *string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			res := NewRestoreTrainingPlanOK(&body, etag)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body RestoreBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "restore", err)
+			}
+			err = ValidateRestoreBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "restore", err)
+			}
+			return nil, NewRestoreBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body RestoreForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "restore", err)
+			}
+			err = ValidateRestoreForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "restore", err)
+			}
+			return nil, NewRestoreForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RestoreInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "restore", err)
+			}
+			err = ValidateRestoreInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "restore", err)
+			}
+			return nil, NewRestoreInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body RestoreNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "restore", err)
+			}
+			err = ValidateRestoreNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "restore", err)
+			}
+			return nil, NewRestoreNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body RestoreUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "restore", err)
+			}
+			err = ValidateRestoreUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "restore", err)
+			}
+			return nil, NewRestoreUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("training_plan", "restore", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListDeletedRequest instantiates a HTTP request object with method and
+// path set to call the "training_plan" service "listDeleted" endpoint
+func (c *Client) BuildListDeletedRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListDeletedTrainingPlanPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("training_plan", "listDeleted", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListDeletedRequest returns an encoder for requests sent to the
+// training_plan listDeleted server.
+func EncodeListDeletedRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*trainingplan.ListDeletedPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("training_plan", "listDeleted", "*trainingplan.ListDeletedPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.UserID != nil {
+			values.Add("userId", *p.UserID)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		values.Add("offset", fmt.Sprintf("%v", p.Offset))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListDeletedResponse returns a decoder for responses returned by the
+// training_plan listDeleted endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListDeletedResponse may return the following errors:
+//   - "badRequest" (type *trainingplan.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *trainingplan.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *trainingplan.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *trainingplan.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *trainingplan.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListDeletedResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListDeletedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "listDeleted", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateTrainingPlanResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "listDeleted", err)
+			}
+			res := NewListDeletedTrainingPlanOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListDeletedBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "listDeleted", err)
+			}
+			err = ValidateListDeletedBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "listDeleted", err)
+			}
+			return nil, NewListDeletedBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ListDeletedForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "listDeleted", err)
+			}
+			err = ValidateListDeletedForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "listDeleted", err)
+			}
+			return nil, NewListDeletedForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListDeletedInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "listDeleted", err)
+			}
+			err = ValidateListDeletedInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "listDeleted", err)
+			}
+			return nil, NewListDeletedInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListDeletedNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "listDeleted", err)
+			}
+			err = ValidateListDeletedNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "listDeleted", err)
+			}
+			return nil, NewListDeletedNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListDeletedUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "listDeleted", err)
+			}
+			err = ValidateListDeletedUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "listDeleted", err)
+			}
+			return nil, NewListDeletedUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("training_plan", "listDeleted", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCloneRequest instantiates a HTTP request object with method and path
+// set to call the "training_plan" service "clone" endpoint
+func (c *Client) BuildCloneRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*trainingplan.ClonePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("training_plan", "clone", "*trainingplan.ClonePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CloneTrainingPlanPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("training_plan", "clone", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCloneRequest returns an encoder for requests sent to the training_plan
+// clone server.
+func EncodeCloneRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*trainingplan.ClonePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("training_plan", "clone", "*trainingplan.ClonePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCloneRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("training_plan", "clone", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCloneResponse returns a decoder for responses returned by the
+// training_plan clone endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCloneResponse may return the following errors:
+//   - "badRequest" (type *trainingplan.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *trainingplan.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *trainingplan.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *trainingplan.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *trainingplan.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCloneResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CloneResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "clone", err)
+			}
+			err = ValidateCloneResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "clone", err)
+			}
+			var (
+				etag *string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			res := NewCloneTrainingPlanCreated(&body, etag)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CloneBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "clone", err)
+			}
+			err = ValidateCloneBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "clone", err)
+			}
+			return nil, NewCloneBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body CloneForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "clone", err)
+			}
+			err = ValidateCloneForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "clone", err)
+			}
+			return nil, NewCloneForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CloneInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "clone", err)
+			}
+			err = ValidateCloneInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "clone", err)
+			}
+			return nil, NewCloneInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body CloneNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "clone", err)
+			}
+			err = ValidateCloneNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "clone", err)
+			}
+			return nil, NewCloneNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CloneUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "clone", err)
+			}
+			err = ValidateCloneUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "clone", err)
+			}
+			return nil, NewCloneUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("training_plan", "clone", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateTemplateRequest instantiates a HTTP request object with method
+// and path set to call the "training_plan" service "createTemplate" endpoint
+func (c *Client) BuildCreateTemplateRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*trainingplan.CreateTemplatePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("training_plan", "createTemplate", "*trainingplan.CreateTemplatePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateTemplateTrainingPlanPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("training_plan", "createTemplate", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateTemplateRequest returns an encoder for requests sent to the
+// training_plan createTemplate server.
+func EncodeCreateTemplateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*trainingplan.CreateTemplatePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("training_plan", "createTemplate", "*trainingplan.CreateTemplatePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCreateTemplateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("training_plan", "createTemplate", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateTemplateResponse returns a decoder for responses returned by the
+// training_plan createTemplate endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeCreateTemplateResponse may return the following errors:
+//   - "badRequest" (type *trainingplan.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *trainingplan.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *trainingplan.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *trainingplan.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *trainingplan.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateTemplateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateTemplateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "createTemplate", err)
+			}
+			err = ValidateCreateTemplateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "createTemplate", err)
+			}
+			res := NewCreateTemplatePlanTemplateCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateTemplateBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "createTemplate", err)
+			}
+			err = ValidateCreateTemplateBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "createTemplate", err)
+			}
+			return nil, NewCreateTemplateBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateTemplateForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "createTemplate", err)
+			}
+			err = ValidateCreateTemplateForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "createTemplate", err)
+			}
+			return nil, NewCreateTemplateForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateTemplateInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "createTemplate", err)
+			}
+			err = ValidateCreateTemplateInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "createTemplate", err)
+			}
+			return nil, NewCreateTemplateInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body CreateTemplateNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "createTemplate", err)
+			}
+			err = ValidateCreateTemplateNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "createTemplate", err)
+			}
+			return nil, NewCreateTemplateNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CreateTemplateUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "createTemplate", err)
+			}
+			err = ValidateCreateTemplateUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "createTemplate", err)
+			}
+			return nil, NewCreateTemplateUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("training_plan", "createTemplate", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListTemplatesRequest instantiates a HTTP request object with method and
+// path set to call the "training_plan" service "listTemplates" endpoint
+func (c *Client) BuildListTemplatesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListTemplatesTrainingPlanPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("training_plan", "listTemplates", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListTemplatesRequest returns an encoder for requests sent to the
+// training_plan listTemplates server.
+func EncodeListTemplatesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*trainingplan.ListTemplatesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("training_plan", "listTemplates", "*trainingplan.ListTemplatesPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		values.Add("offset", fmt.Sprintf("%v", p.Offset))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListTemplatesResponse returns a decoder for responses returned by the
+// training_plan listTemplates endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListTemplatesResponse may return the following errors:
+//   - "badRequest" (type *trainingplan.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *trainingplan.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *trainingplan.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *trainingplan.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *trainingplan.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListTemplatesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListTemplatesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "listTemplates", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidatePlanTemplateResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "listTemplates", err)
+			}
+			res := NewListTemplatesPlanTemplateOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListTemplatesBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "listTemplates", err)
+			}
+			err = ValidateListTemplatesBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "listTemplates", err)
+			}
+			return nil, NewListTemplatesBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ListTemplatesForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "listTemplates", err)
+			}
+			err = ValidateListTemplatesForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "listTemplates", err)
+			}
+			return nil, NewListTemplatesForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListTemplatesInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "listTemplates", err)
+			}
+			err = ValidateListTemplatesInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "listTemplates", err)
+			}
+			return nil, NewListTemplatesInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListTemplatesNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "listTemplates", err)
+			}
+			err = ValidateListTemplatesNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "listTemplates", err)
+			}
+			return nil, NewListTemplatesNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListTemplatesUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "listTemplates", err)
+			}
+			err = ValidateListTemplatesUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "listTemplates", err)
+			}
+			return nil, NewListTemplatesUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("training_plan", "listTemplates", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildExportRequest instantiates a HTTP request object with method and path
+// set to call the "training_plan" service "export" endpoint
+func (c *Client) BuildExportRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*trainingplan.ExportPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("training_plan", "export", "*trainingplan.ExportPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ExportTrainingPlanPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("training_plan", "export", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeExportRequest returns an encoder for requests sent to the
+// training_plan export server.
+func EncodeExportRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*trainingplan.ExportPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("training_plan", "export", "*trainingplan.ExportPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("format", p.Format)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeExportResponse returns a decoder for responses returned by the
+// training_plan export endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeExportResponse may return the following errors:
+//   - "badRequest" (type *trainingplan.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *trainingplan.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *trainingplan.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *trainingplan.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *trainingplan.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeExportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				contentType        string
+				contentDisposition string
+				err                error
+			)
+			contentTypeRaw := resp.Header.Get("Content-Type")
+			if contentTypeRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("contentType", "header"))
+			}
+			contentType = contentTypeRaw
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("contentDisposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "export", err)
+			}
+			res := NewExportResultOK(contentType, contentDisposition)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ExportBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "export", err)
+			}
+			err = ValidateExportBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "export", err)
+			}
+			return nil, NewExportBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ExportForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "export", err)
+			}
+			err = ValidateExportForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "export", err)
+			}
+			return nil, NewExportForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ExportInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "export", err)
+			}
+			err = ValidateExportInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "export", err)
+			}
+			return nil, NewExportInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ExportNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "export", err)
+			}
+			err = ValidateExportNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "export", err)
+			}
+			return nil, NewExportNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ExportUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "export", err)
+			}
+			err = ValidateExportUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "export", err)
+			}
+			return nil, NewExportUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("training_plan", "export", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildImportRequest instantiates a HTTP request object with method and path
+// set to call the "training_plan" service "import" endpoint
+func (c *Client) BuildImportRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ImportTrainingPlanPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("training_plan", "import", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeImportRequest returns an encoder for requests sent to the
+// training_plan import server.
+func EncodeImportRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*trainingplan.ImportPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("training_plan", "import", "*trainingplan.ImportPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.UserID != nil {
+			values.Add("userId", *p.UserID)
+		}
+		values.Add("allowUnmatched", fmt.Sprintf("%v", p.AllowUnmatched))
+		req.URL.RawQuery = values.Encode()
+		body := NewImportRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("training_plan", "import", err)
+		}
+		return nil
+	}
+}
+
+// DecodeImportResponse returns a decoder for responses returned by the
+// training_plan import endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeImportResponse may return the following errors:
+//   - "badRequest" (type *trainingplan.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *trainingplan.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *trainingplan.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *trainingplan.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *trainingplan.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeImportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body ImportResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "import", err)
+			}
+			err = ValidateImportResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "import", err)
+			}
+			res := NewImportPlanImportReportCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ImportBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "import", err)
+			}
+			err = ValidateImportBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "import", err)
+			}
+			return nil, NewImportBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ImportForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "import", err)
+			}
+			err = ValidateImportForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "import", err)
+			}
+			return nil, NewImportForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ImportInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "import", err)
+			}
+			err = ValidateImportInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "import", err)
+			}
+			return nil, NewImportInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ImportNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "import", err)
+			}
+			err = ValidateImportNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "import", err)
+			}
+			return nil, NewImportNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ImportUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("training_plan", "import", err)
+			}
+			err = ValidateImportUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("training_plan", "import", err)
+			}
+			return nil, NewImportUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("training_plan", "import", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalFieldErrorResponseBodyToTrainingplanFieldError builds a value of
+// type *trainingplan.FieldError from a value of type *FieldErrorResponseBody.
+func unmarshalFieldErrorResponseBodyToTrainingplanFieldError(v *FieldErrorResponseBody) *trainingplan.FieldError {
+	if v == nil {
+		return nil
+	}
+	res := &trainingplan.FieldError{
+		Field:   *v.Field,
+		Message: *v.Message,
+	}
+
+	return res
+}
+
+// unmarshalTrainingPlanResponseToTrainingplanTrainingPlan builds a value of
+// type *trainingplan.TrainingPlan from a value of type *TrainingPlanResponse.
+func unmarshalTrainingPlanResponseToTrainingplanTrainingPlan(v *TrainingPlanResponse) *trainingplan.TrainingPlan {
+	res := &trainingplan.TrainingPlan{
+		ID:          *v.ID,
+		Name:        *v.Name,
+		Description: v.Description,
+		StartDate:   *v.StartDate,
+		EndDate:     *v.EndDate,
+		UserID:      *v.UserID,
+		DeletedAt:   v.DeletedAt,
+		Etag:        v.Etag,
+	}
+	if v.Warnings != nil {
+		res.Warnings = make([]string, len(v.Warnings))
+		for i, val := range v.Warnings {
+			res.Warnings[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalPlanTemplateResponseToTrainingplanPlanTemplate builds a value of
+// type *trainingplan.PlanTemplate from a value of type *PlanTemplateResponse.
+func unmarshalPlanTemplateResponseToTrainingplanPlanTemplate(v *PlanTemplateResponse) *trainingplan.PlanTemplate {
+	res := &trainingplan.PlanTemplate{
+		ID:          *v.ID,
+		Name:        *v.Name,
+		Description: v.Description,
+		StartDate:   *v.StartDate,
+		EndDate:     *v.EndDate,
+		OwnerID:     v.OwnerID,
+		Public:      *v.Public,
+	}
+
+	return res
+}
+
+// marshalTrainingplanPlanDocumentPlanToPlanDocumentPlanRequestBodyRequestBody
+// builds a value of type *PlanDocumentPlanRequestBodyRequestBody from a value
+// of type *trainingplan.PlanDocumentPlan.
+func marshalTrainingplanPlanDocumentPlanToPlanDocumentPlanRequestBodyRequestBody(v *trainingplan.PlanDocumentPlan) *PlanDocumentPlanRequestBodyRequestBody {
+	res := &PlanDocumentPlanRequestBodyRequestBody{
+		Name:        v.Name,
+		Description: v.Description,
+		StartDate:   v.StartDate,
+		EndDate:     v.EndDate,
+	}
+	if v.Workouts != nil {
+		res.Workouts = make([]*PlanDocumentWorkoutRequestBodyRequestBody, len(v.Workouts))
+		for i, val := range v.Workouts {
+			res.Workouts[i] = marshalTrainingplanPlanDocumentWorkoutToPlanDocumentWorkoutRequestBodyRequestBody(val)
+		}
+	} else {
+		res.Workouts = []*PlanDocumentWorkoutRequestBodyRequestBody{}
+	}
+
+	return res
+}
+
+// marshalTrainingplanPlanDocumentWorkoutToPlanDocumentWorkoutRequestBodyRequestBody
+// builds a value of type *PlanDocumentWorkoutRequestBodyRequestBody from a
+// value of type *trainingplan.PlanDocumentWorkout.
+func marshalTrainingplanPlanDocumentWorkoutToPlanDocumentWorkoutRequestBodyRequestBody(v *trainingplan.PlanDocumentWorkout) *PlanDocumentWorkoutRequestBodyRequestBody {
+	res := &PlanDocumentWorkoutRequestBodyRequestBody{
+		Name:       v.Name,
+		Position:   v.Position,
+		Recurrence: v.Recurrence,
+	}
+	if v.ScheduledDates != nil {
+		res.ScheduledDates = make([]string, len(v.ScheduledDates))
+		for i, val := range v.ScheduledDates {
+			res.ScheduledDates[i] = val
+		}
+	}
+	if v.Exercises != nil {
+		res.Exercises = make([]*PlanDocumentExerciseRequestBodyRequestBody, len(v.Exercises))
+		for i, val := range v.Exercises {
+			res.Exercises[i] = marshalTrainingplanPlanDocumentExerciseToPlanDocumentExerciseRequestBodyRequestBody(val)
+		}
+	} else {
+		res.Exercises = []*PlanDocumentExerciseRequestBodyRequestBody{}
+	}
+
+	return res
+}
+
+// marshalTrainingplanPlanDocumentExerciseToPlanDocumentExerciseRequestBodyRequestBody
+// builds a value of type *PlanDocumentExerciseRequestBodyRequestBody from a
+// value of type *trainingplan.PlanDocumentExercise.
+func marshalTrainingplanPlanDocumentExerciseToPlanDocumentExerciseRequestBodyRequestBody(v *trainingplan.PlanDocumentExercise) *PlanDocumentExerciseRequestBodyRequestBody {
+	res := &PlanDocumentExerciseRequestBodyRequestBody{
+		Name:         v.Name,
+		ExerciseType: v.ExerciseType,
+	}
+	if v.Sets != nil {
+		res.Sets = make([]*PlanDocumentSetRequestBodyRequestBody, len(v.Sets))
+		for i, val := range v.Sets {
+			res.Sets[i] = marshalTrainingplanPlanDocumentSetToPlanDocumentSetRequestBodyRequestBody(val)
+		}
+	} else {
+		res.Sets = []*PlanDocumentSetRequestBodyRequestBody{}
+	}
+	if v.Progression != nil {
+		res.Progression = marshalTrainingplanPlanDocumentProgressionToPlanDocumentProgressionRequestBodyRequestBody(v.Progression)
+	}
+
+	return res
+}
+
+// marshalTrainingplanPlanDocumentSetToPlanDocumentSetRequestBodyRequestBody
+// builds a value of type *PlanDocumentSetRequestBodyRequestBody from a value
+// of type *trainingplan.PlanDocumentSet.
+func marshalTrainingplanPlanDocumentSetToPlanDocumentSetRequestBodyRequestBody(v *trainingplan.PlanDocumentSet) *PlanDocumentSetRequestBodyRequestBody {
+	res := &PlanDocumentSetRequestBodyRequestBody{
+		Weight:   v.Weight,
+		Reps:     v.Reps,
+		RestTime: v.RestTime,
+	}
+
+	return res
+}
+
+// marshalTrainingplanPlanDocumentProgressionToPlanDocumentProgressionRequestBodyRequestBody
+// builds a value of type *PlanDocumentProgressionRequestBodyRequestBody from a
+// value of type *trainingplan.PlanDocumentProgression.
+func marshalTrainingplanPlanDocumentProgressionToPlanDocumentProgressionRequestBodyRequestBody(v *trainingplan.PlanDocumentProgression) *PlanDocumentProgressionRequestBodyRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &PlanDocumentProgressionRequestBodyRequestBody{
+		Kind:             v.Kind,
+		Increment:        v.Increment,
+		MinReps:          v.MinReps,
+		MaxReps:          v.MaxReps,
+		Percentage:       v.Percentage,
+		DeloadAfter:      v.DeloadAfter,
+		DeloadPercentage: v.DeloadPercentage,
+	}
+
+	return res
+}
+
+// marshalPlanDocumentPlanRequestBodyRequestBodyToTrainingplanPlanDocumentPlan
+// builds a value of type *trainingplan.PlanDocumentPlan from a value of type
+// *PlanDocumentPlanRequestBodyRequestBody.
+func marshalPlanDocumentPlanRequestBodyRequestBodyToTrainingplanPlanDocumentPlan(v *PlanDocumentPlanRequestBodyRequestBody) *trainingplan.PlanDocumentPlan {
+	res := &trainingplan.PlanDocumentPlan{
+		Name:        v.Name,
+		Description: v.Description,
+		StartDate:   v.StartDate,
+		EndDate:     v.EndDate,
+	}
+	if v.Workouts != nil {
+		res.Workouts = make([]*trainingplan.PlanDocumentWorkout, len(v.Workouts))
+		for i, val := range v.Workouts {
+			res.Workouts[i] = marshalPlanDocumentWorkoutRequestBodyRequestBodyToTrainingplanPlanDocumentWorkout(val)
+		}
+	} else {
+		res.Workouts = []*trainingplan.PlanDocumentWorkout{}
+	}
+
+	return res
+}
+
+// marshalPlanDocumentWorkoutRequestBodyRequestBodyToTrainingplanPlanDocumentWorkout
+// builds a value of type *trainingplan.PlanDocumentWorkout from a value of
+// type *PlanDocumentWorkoutRequestBodyRequestBody.
+func marshalPlanDocumentWorkoutRequestBodyRequestBodyToTrainingplanPlanDocumentWorkout(v *PlanDocumentWorkoutRequestBodyRequestBody) *trainingplan.PlanDocumentWorkout {
+	res := &trainingplan.PlanDocumentWorkout{
+		Name:       v.Name,
+		Position:   v.Position,
+		Recurrence: v.Recurrence,
+	}
+	if v.ScheduledDates != nil {
+		res.ScheduledDates = make([]string, len(v.ScheduledDates))
+		for i, val := range v.ScheduledDates {
+			res.ScheduledDates[i] = val
+		}
+	}
+	if v.Exercises != nil {
+		res.Exercises = make([]*trainingplan.PlanDocumentExercise, len(v.Exercises))
+		for i, val := range v.Exercises {
+			res.Exercises[i] = marshalPlanDocumentExerciseRequestBodyRequestBodyToTrainingplanPlanDocumentExercise(val)
+		}
+	} else {
+		res.Exercises = []*trainingplan.PlanDocumentExercise{}
+	}
+
+	return res
+}
+
+// marshalPlanDocumentExerciseRequestBodyRequestBodyToTrainingplanPlanDocumentExercise
+// builds a value of type *trainingplan.PlanDocumentExercise from a value of
+// type *PlanDocumentExerciseRequestBodyRequestBody.
+func marshalPlanDocumentExerciseRequestBodyRequestBodyToTrainingplanPlanDocumentExercise(v *PlanDocumentExerciseRequestBodyRequestBody) *trainingplan.PlanDocumentExercise {
+	res := &trainingplan.PlanDocumentExercise{
+		Name:         v.Name,
+		ExerciseType: v.ExerciseType,
+	}
+	if v.Sets != nil {
+		res.Sets = make([]*trainingplan.PlanDocumentSet, len(v.Sets))
+		for i, val := range v.Sets {
+			res.Sets[i] = marshalPlanDocumentSetRequestBodyRequestBodyToTrainingplanPlanDocumentSet(val)
+		}
+	} else {
+		res.Sets = []*trainingplan.PlanDocumentSet{}
+	}
+	if v.Progression != nil {
+		res.Progression = marshalPlanDocumentProgressionRequestBodyRequestBodyToTrainingplanPlanDocumentProgression(v.Progression)
+	}
+
+	return res
+}
+
+// marshalPlanDocumentSetRequestBodyRequestBodyToTrainingplanPlanDocumentSet
+// builds a value of type *trainingplan.PlanDocumentSet from a value of type
+// *PlanDocumentSetRequestBodyRequestBody.
+func marshalPlanDocumentSetRequestBodyRequestBodyToTrainingplanPlanDocumentSet(v *PlanDocumentSetRequestBodyRequestBody) *trainingplan.PlanDocumentSet {
+	res := &trainingplan.PlanDocumentSet{
+		Weight:   v.Weight,
+		Reps:     v.Reps,
+		RestTime: v.RestTime,
+	}
+
+	return res
+}
+
+// marshalPlanDocumentProgressionRequestBodyRequestBodyToTrainingplanPlanDocumentProgression
+// builds a value of type *trainingplan.PlanDocumentProgression from a value of
+// type *PlanDocumentProgressionRequestBodyRequestBody.
+func marshalPlanDocumentProgressionRequestBodyRequestBodyToTrainingplanPlanDocumentProgression(v *PlanDocumentProgressionRequestBodyRequestBody) *trainingplan.PlanDocumentProgression {
+	if v == nil {
+		return nil
+	}
+	res := &trainingplan.PlanDocumentProgression{
+		Kind:             v.Kind,
+		Increment:        v.Increment,
+		MinReps:          v.MinReps,
+		MaxReps:          v.MaxReps,
+		Percentage:       v.Percentage,
+		DeloadAfter:      v.DeloadAfter,
+		DeloadPercentage: v.DeloadPercentage,
+	}
+
+	return res
+}
+
+// unmarshalTrainingPlanResponseBodyToTrainingplanTrainingPlan builds a value
+// of type *trainingplan.TrainingPlan from a value of type
+// *TrainingPlanResponseBody.
+func unmarshalTrainingPlanResponseBodyToTrainingplanTrainingPlan(v *TrainingPlanResponseBody) *trainingplan.TrainingPlan {
+	res := &trainingplan.TrainingPlan{
+		ID:          *v.ID,
 		Name:        *v.Name,
 		Description: v.Description,
 		StartDate:   *v.StartDate,
 		EndDate:     *v.EndDate,
 		UserID:      *v.UserID,
+		DeletedAt:   v.DeletedAt,
+		Etag:        v.Etag,
+	}
+	if v.Warnings != nil {
+		res.Warnings = make([]string, len(v.Warnings))
+		for i, val := range v.Warnings {
+			res.Warnings[i] = val
+		}
 	}
 
 	return res
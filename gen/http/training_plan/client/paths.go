@@ -31,7 +31,47 @@ func UpdateTrainingPlanPath(id string) string {
 	return fmt.Sprintf("/api/v1/training-plans/%v", id)
 }
 
+// PatchTrainingPlanPath returns the URL path to the training_plan service patch HTTP endpoint.
+func PatchTrainingPlanPath(id string) string {
+	return fmt.Sprintf("/api/v1/training-plans/%v", id)
+}
+
 // DeleteTrainingPlanPath returns the URL path to the training_plan service delete HTTP endpoint.
 func DeleteTrainingPlanPath(id string) string {
 	return fmt.Sprintf("/api/v1/training-plans/%v", id)
 }
+
+// RestoreTrainingPlanPath returns the URL path to the training_plan service restore HTTP endpoint.
+func RestoreTrainingPlanPath(id string) string {
+	return fmt.Sprintf("/api/v1/training-plans/%v/restore", id)
+}
+
+// ListDeletedTrainingPlanPath returns the URL path to the training_plan service listDeleted HTTP endpoint.
+func ListDeletedTrainingPlanPath() string {
+	return "/api/v1/training-plans/deleted"
+}
+
+// CloneTrainingPlanPath returns the URL path to the training_plan service clone HTTP endpoint.
+func CloneTrainingPlanPath(id string) string {
+	return fmt.Sprintf("/api/v1/training-plans/%v/clone", id)
+}
+
+// CreateTemplateTrainingPlanPath returns the URL path to the training_plan service createTemplate HTTP endpoint.
+func CreateTemplateTrainingPlanPath(id string) string {
+	return fmt.Sprintf("/api/v1/training-plans/%v/template", id)
+}
+
+// ListTemplatesTrainingPlanPath returns the URL path to the training_plan service listTemplates HTTP endpoint.
+func ListTemplatesTrainingPlanPath() string {
+	return "/api/v1/training-plans/templates"
+}
+
+// ExportTrainingPlanPath returns the URL path to the training_plan service export HTTP endpoint.
+func ExportTrainingPlanPath(id string) string {
+	return fmt.Sprintf("/api/v1/training-plans/%v/export", id)
+}
+
+// ImportTrainingPlanPath returns the URL path to the training_plan service import HTTP endpoint.
+func ImportTrainingPlanPath() string {
+	return "/api/v1/training-plans/import"
+}
@@ -9,6 +9,7 @@ package client
 
 import (
 	trainingplan "be/gen/training_plan"
+	"unicode/utf8"
 
 	goa "goa.design/goa/v3/pkg"
 )
@@ -37,6 +38,37 @@ type UpdateRequestBody struct {
 	UserID      string  `form:"userId" json:"userId" xml:"userId"`
 }
 
+// CloneRequestBody is the type of the "training_plan" service "clone" endpoint
+// HTTP request body.
+type CloneRequestBody struct {
+	// Athlete receiving the plan, defaults to the caller
+	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Start date of the new plan
+	StartDate string `form:"startDate" json:"startDate" xml:"startDate"`
+	// Name of the new plan, defaults to the source's
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+}
+
+// CreateTemplateRequestBody is the type of the "training_plan" service
+// "createTemplate" endpoint HTTP request body.
+type CreateTemplateRequestBody struct {
+	// Name of the template, defaults to the plan's
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Whether every user can clone the template
+	Public bool `form:"public" json:"public" xml:"public"`
+}
+
+// ImportRequestBody is the type of the "training_plan" service "import"
+// endpoint HTTP request body.
+type ImportRequestBody struct {
+	// Document format version
+	Version int `form:"version" json:"version" xml:"version"`
+	// When the document was exported
+	ExportedAt *string `form:"exportedAt,omitempty" json:"exportedAt,omitempty" xml:"exportedAt,omitempty"`
+	// The plan
+	Plan *PlanDocumentPlanRequestBodyRequestBody `form:"plan" json:"plan" xml:"plan"`
+}
+
 // CreateResponseBody is the type of the "training_plan" service "create"
 // endpoint HTTP response body.
 type CreateResponseBody struct {
@@ -52,6 +84,11 @@ type CreateResponseBody struct {
 	EndDate *string `form:"endDate,omitempty" json:"endDate,omitempty" xml:"endDate,omitempty"`
 	// ID of the user who owns the plan
 	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
 }
 
 // GetResponseBody is the type of the "training_plan" service "get" endpoint
@@ -69,161 +106,3230 @@ type GetResponseBody struct {
 	EndDate *string `form:"endDate,omitempty" json:"endDate,omitempty" xml:"endDate,omitempty"`
 	// ID of the user who owns the plan
 	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+}
+
+// ListResponseBody is the type of the "training_plan" service "list" endpoint
+// HTTP response body.
+type ListResponseBody []*TrainingPlanResponse
+
+// UpdateResponseBody is the type of the "training_plan" service "update"
+// endpoint HTTP response body.
+type UpdateResponseBody struct {
+	// TrainingPlan ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the training plan
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Description of the plan
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Start date in ISO 8601
+	StartDate *string `form:"startDate,omitempty" json:"startDate,omitempty" xml:"startDate,omitempty"`
+	// End date in ISO 8601
+	EndDate *string `form:"endDate,omitempty" json:"endDate,omitempty" xml:"endDate,omitempty"`
+	// ID of the user who owns the plan
+	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+}
+
+// PatchResponseBody is the type of the "training_plan" service "patch"
+// endpoint HTTP response body.
+type PatchResponseBody struct {
+	// TrainingPlan ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the training plan
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Description of the plan
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Start date in ISO 8601
+	StartDate *string `form:"startDate,omitempty" json:"startDate,omitempty" xml:"startDate,omitempty"`
+	// End date in ISO 8601
+	EndDate *string `form:"endDate,omitempty" json:"endDate,omitempty" xml:"endDate,omitempty"`
+	// ID of the user who owns the plan
+	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+}
+
+// RestoreResponseBody is the type of the "training_plan" service "restore"
+// endpoint HTTP response body.
+type RestoreResponseBody struct {
+	// TrainingPlan ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the training plan
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Description of the plan
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Start date in ISO 8601
+	StartDate *string `form:"startDate,omitempty" json:"startDate,omitempty" xml:"startDate,omitempty"`
+	// End date in ISO 8601
+	EndDate *string `form:"endDate,omitempty" json:"endDate,omitempty" xml:"endDate,omitempty"`
+	// ID of the user who owns the plan
+	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+}
+
+// ListDeletedResponseBody is the type of the "training_plan" service
+// "listDeleted" endpoint HTTP response body.
+type ListDeletedResponseBody []*TrainingPlanResponse
+
+// CloneResponseBody is the type of the "training_plan" service "clone"
+// endpoint HTTP response body.
+type CloneResponseBody struct {
+	// TrainingPlan ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the training plan
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Description of the plan
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Start date in ISO 8601
+	StartDate *string `form:"startDate,omitempty" json:"startDate,omitempty" xml:"startDate,omitempty"`
+	// End date in ISO 8601
+	EndDate *string `form:"endDate,omitempty" json:"endDate,omitempty" xml:"endDate,omitempty"`
+	// ID of the user who owns the plan
+	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+}
+
+// CreateTemplateResponseBody is the type of the "training_plan" service
+// "createTemplate" endpoint HTTP response body.
+type CreateTemplateResponseBody struct {
+	// Template ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the template
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Description of the template
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Anchor date of the template's schedule
+	StartDate *string `form:"startDate,omitempty" json:"startDate,omitempty" xml:"startDate,omitempty"`
+	// End of the template's schedule
+	EndDate *string `form:"endDate,omitempty" json:"endDate,omitempty" xml:"endDate,omitempty"`
+	// Coach owning the template
+	OwnerID *string `form:"ownerId,omitempty" json:"ownerId,omitempty" xml:"ownerId,omitempty"`
+	// Whether every user can clone the template
+	Public *bool `form:"public,omitempty" json:"public,omitempty" xml:"public,omitempty"`
+}
+
+// ListTemplatesResponseBody is the type of the "training_plan" service
+// "listTemplates" endpoint HTTP response body.
+type ListTemplatesResponseBody []*PlanTemplateResponse
+
+// ImportResponseBody is the type of the "training_plan" service "import"
+// endpoint HTTP response body.
+type ImportResponseBody struct {
+	// The imported plan
+	Plan *TrainingPlanResponseBody `form:"plan,omitempty" json:"plan,omitempty" xml:"plan,omitempty"`
+	// Workouts created
+	Workouts *int `form:"workouts,omitempty" json:"workouts,omitempty" xml:"workouts,omitempty"`
+	// Exercises created
+	Exercises *int `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+	// Planned sets created
+	Sets *int `form:"sets,omitempty" json:"sets,omitempty" xml:"sets,omitempty"`
+	// Exercise type names not found in the catalog; their exercises were skipped
+	Unmatched []string `form:"unmatched,omitempty" json:"unmatched,omitempty" xml:"unmatched,omitempty"`
+}
+
+// CreateBadRequestResponseBody is the type of the "training_plan" service
+// "create" endpoint HTTP response body for the "badRequest" error.
+type CreateBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// CreateForbiddenResponseBody is the type of the "training_plan" service
+// "create" endpoint HTTP response body for the "forbidden" error.
+type CreateForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateInternalServerErrorResponseBody is the type of the "training_plan"
+// service "create" endpoint HTTP response body for the "internalServerError"
+// error.
+type CreateInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateNotFoundResponseBody is the type of the "training_plan" service
+// "create" endpoint HTTP response body for the "notFound" error.
+type CreateNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateUnauthorizedResponseBody is the type of the "training_plan" service
+// "create" endpoint HTTP response body for the "unauthorized" error.
+type CreateUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetBadRequestResponseBody is the type of the "training_plan" service "get"
+// endpoint HTTP response body for the "badRequest" error.
+type GetBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// GetForbiddenResponseBody is the type of the "training_plan" service "get"
+// endpoint HTTP response body for the "forbidden" error.
+type GetForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetInternalServerErrorResponseBody is the type of the "training_plan"
+// service "get" endpoint HTTP response body for the "internalServerError"
+// error.
+type GetInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetNotFoundResponseBody is the type of the "training_plan" service "get"
+// endpoint HTTP response body for the "notFound" error.
+type GetNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetUnauthorizedResponseBody is the type of the "training_plan" service "get"
+// endpoint HTTP response body for the "unauthorized" error.
+type GetUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListBadRequestResponseBody is the type of the "training_plan" service "list"
+// endpoint HTTP response body for the "badRequest" error.
+type ListBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListForbiddenResponseBody is the type of the "training_plan" service "list"
+// endpoint HTTP response body for the "forbidden" error.
+type ListForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListInternalServerErrorResponseBody is the type of the "training_plan"
+// service "list" endpoint HTTP response body for the "internalServerError"
+// error.
+type ListInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListNotFoundResponseBody is the type of the "training_plan" service "list"
+// endpoint HTTP response body for the "notFound" error.
+type ListNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListUnauthorizedResponseBody is the type of the "training_plan" service
+// "list" endpoint HTTP response body for the "unauthorized" error.
+type ListUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateBadRequestResponseBody is the type of the "training_plan" service
+// "update" endpoint HTTP response body for the "badRequest" error.
+type UpdateBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// UpdateForbiddenResponseBody is the type of the "training_plan" service
+// "update" endpoint HTTP response body for the "forbidden" error.
+type UpdateForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateInternalServerErrorResponseBody is the type of the "training_plan"
+// service "update" endpoint HTTP response body for the "internalServerError"
+// error.
+type UpdateInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateNotFoundResponseBody is the type of the "training_plan" service
+// "update" endpoint HTTP response body for the "notFound" error.
+type UpdateNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdatePreconditionFailedResponseBody is the type of the "training_plan"
+// service "update" endpoint HTTP response body for the "preconditionFailed"
+// error.
+type UpdatePreconditionFailedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateUnauthorizedResponseBody is the type of the "training_plan" service
+// "update" endpoint HTTP response body for the "unauthorized" error.
+type UpdateUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PatchBadRequestResponseBody is the type of the "training_plan" service
+// "patch" endpoint HTTP response body for the "badRequest" error.
+type PatchBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// PatchForbiddenResponseBody is the type of the "training_plan" service
+// "patch" endpoint HTTP response body for the "forbidden" error.
+type PatchForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PatchInternalServerErrorResponseBody is the type of the "training_plan"
+// service "patch" endpoint HTTP response body for the "internalServerError"
+// error.
+type PatchInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PatchNotFoundResponseBody is the type of the "training_plan" service "patch"
+// endpoint HTTP response body for the "notFound" error.
+type PatchNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PatchPreconditionFailedResponseBody is the type of the "training_plan"
+// service "patch" endpoint HTTP response body for the "preconditionFailed"
+// error.
+type PatchPreconditionFailedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PatchUnauthorizedResponseBody is the type of the "training_plan" service
+// "patch" endpoint HTTP response body for the "unauthorized" error.
+type PatchUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteBadRequestResponseBody is the type of the "training_plan" service
+// "delete" endpoint HTTP response body for the "badRequest" error.
+type DeleteBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// DeleteForbiddenResponseBody is the type of the "training_plan" service
+// "delete" endpoint HTTP response body for the "forbidden" error.
+type DeleteForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteInternalServerErrorResponseBody is the type of the "training_plan"
+// service "delete" endpoint HTTP response body for the "internalServerError"
+// error.
+type DeleteInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteNotFoundResponseBody is the type of the "training_plan" service
+// "delete" endpoint HTTP response body for the "notFound" error.
+type DeleteNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeletePreconditionFailedResponseBody is the type of the "training_plan"
+// service "delete" endpoint HTTP response body for the "preconditionFailed"
+// error.
+type DeletePreconditionFailedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteUnauthorizedResponseBody is the type of the "training_plan" service
+// "delete" endpoint HTTP response body for the "unauthorized" error.
+type DeleteUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RestoreBadRequestResponseBody is the type of the "training_plan" service
+// "restore" endpoint HTTP response body for the "badRequest" error.
+type RestoreBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// RestoreForbiddenResponseBody is the type of the "training_plan" service
+// "restore" endpoint HTTP response body for the "forbidden" error.
+type RestoreForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RestoreInternalServerErrorResponseBody is the type of the "training_plan"
+// service "restore" endpoint HTTP response body for the "internalServerError"
+// error.
+type RestoreInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RestoreNotFoundResponseBody is the type of the "training_plan" service
+// "restore" endpoint HTTP response body for the "notFound" error.
+type RestoreNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RestoreUnauthorizedResponseBody is the type of the "training_plan" service
+// "restore" endpoint HTTP response body for the "unauthorized" error.
+type RestoreUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListDeletedBadRequestResponseBody is the type of the "training_plan" service
+// "listDeleted" endpoint HTTP response body for the "badRequest" error.
+type ListDeletedBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListDeletedForbiddenResponseBody is the type of the "training_plan" service
+// "listDeleted" endpoint HTTP response body for the "forbidden" error.
+type ListDeletedForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListDeletedInternalServerErrorResponseBody is the type of the
+// "training_plan" service "listDeleted" endpoint HTTP response body for the
+// "internalServerError" error.
+type ListDeletedInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListDeletedNotFoundResponseBody is the type of the "training_plan" service
+// "listDeleted" endpoint HTTP response body for the "notFound" error.
+type ListDeletedNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListDeletedUnauthorizedResponseBody is the type of the "training_plan"
+// service "listDeleted" endpoint HTTP response body for the "unauthorized"
+// error.
+type ListDeletedUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CloneBadRequestResponseBody is the type of the "training_plan" service
+// "clone" endpoint HTTP response body for the "badRequest" error.
+type CloneBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// CloneForbiddenResponseBody is the type of the "training_plan" service
+// "clone" endpoint HTTP response body for the "forbidden" error.
+type CloneForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CloneInternalServerErrorResponseBody is the type of the "training_plan"
+// service "clone" endpoint HTTP response body for the "internalServerError"
+// error.
+type CloneInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CloneNotFoundResponseBody is the type of the "training_plan" service "clone"
+// endpoint HTTP response body for the "notFound" error.
+type CloneNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CloneUnauthorizedResponseBody is the type of the "training_plan" service
+// "clone" endpoint HTTP response body for the "unauthorized" error.
+type CloneUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateTemplateBadRequestResponseBody is the type of the "training_plan"
+// service "createTemplate" endpoint HTTP response body for the "badRequest"
+// error.
+type CreateTemplateBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// CreateTemplateForbiddenResponseBody is the type of the "training_plan"
+// service "createTemplate" endpoint HTTP response body for the "forbidden"
+// error.
+type CreateTemplateForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateTemplateInternalServerErrorResponseBody is the type of the
+// "training_plan" service "createTemplate" endpoint HTTP response body for the
+// "internalServerError" error.
+type CreateTemplateInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateTemplateNotFoundResponseBody is the type of the "training_plan"
+// service "createTemplate" endpoint HTTP response body for the "notFound"
+// error.
+type CreateTemplateNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateTemplateUnauthorizedResponseBody is the type of the "training_plan"
+// service "createTemplate" endpoint HTTP response body for the "unauthorized"
+// error.
+type CreateTemplateUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListTemplatesBadRequestResponseBody is the type of the "training_plan"
+// service "listTemplates" endpoint HTTP response body for the "badRequest"
+// error.
+type ListTemplatesBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListTemplatesForbiddenResponseBody is the type of the "training_plan"
+// service "listTemplates" endpoint HTTP response body for the "forbidden"
+// error.
+type ListTemplatesForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListTemplatesInternalServerErrorResponseBody is the type of the
+// "training_plan" service "listTemplates" endpoint HTTP response body for the
+// "internalServerError" error.
+type ListTemplatesInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListTemplatesNotFoundResponseBody is the type of the "training_plan" service
+// "listTemplates" endpoint HTTP response body for the "notFound" error.
+type ListTemplatesNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListTemplatesUnauthorizedResponseBody is the type of the "training_plan"
+// service "listTemplates" endpoint HTTP response body for the "unauthorized"
+// error.
+type ListTemplatesUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ExportBadRequestResponseBody is the type of the "training_plan" service
+// "export" endpoint HTTP response body for the "badRequest" error.
+type ExportBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ExportForbiddenResponseBody is the type of the "training_plan" service
+// "export" endpoint HTTP response body for the "forbidden" error.
+type ExportForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ExportInternalServerErrorResponseBody is the type of the "training_plan"
+// service "export" endpoint HTTP response body for the "internalServerError"
+// error.
+type ExportInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ExportNotFoundResponseBody is the type of the "training_plan" service
+// "export" endpoint HTTP response body for the "notFound" error.
+type ExportNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ExportUnauthorizedResponseBody is the type of the "training_plan" service
+// "export" endpoint HTTP response body for the "unauthorized" error.
+type ExportUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ImportBadRequestResponseBody is the type of the "training_plan" service
+// "import" endpoint HTTP response body for the "badRequest" error.
+type ImportBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ImportForbiddenResponseBody is the type of the "training_plan" service
+// "import" endpoint HTTP response body for the "forbidden" error.
+type ImportForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ImportInternalServerErrorResponseBody is the type of the "training_plan"
+// service "import" endpoint HTTP response body for the "internalServerError"
+// error.
+type ImportInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ImportNotFoundResponseBody is the type of the "training_plan" service
+// "import" endpoint HTTP response body for the "notFound" error.
+type ImportNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ImportUnauthorizedResponseBody is the type of the "training_plan" service
+// "import" endpoint HTTP response body for the "unauthorized" error.
+type ImportUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+	// Motivo per cui il campo non è valido
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// TrainingPlanResponse is used to define fields on response body types.
+type TrainingPlanResponse struct {
+	// TrainingPlan ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the training plan
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Description of the plan
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Start date in ISO 8601
+	StartDate *string `form:"startDate,omitempty" json:"startDate,omitempty" xml:"startDate,omitempty"`
+	// End date in ISO 8601
+	EndDate *string `form:"endDate,omitempty" json:"endDate,omitempty" xml:"endDate,omitempty"`
+	// ID of the user who owns the plan
+	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+	// Entity tag of the current version, to send back in If-Match when changing it
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty" xml:"etag,omitempty"`
+}
+
+// PlanTemplateResponse is used to define fields on response body types.
+type PlanTemplateResponse struct {
+	// Template ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the template
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Description of the template
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Anchor date of the template's schedule
+	StartDate *string `form:"startDate,omitempty" json:"startDate,omitempty" xml:"startDate,omitempty"`
+	// End of the template's schedule
+	EndDate *string `form:"endDate,omitempty" json:"endDate,omitempty" xml:"endDate,omitempty"`
+	// Coach owning the template
+	OwnerID *string `form:"ownerId,omitempty" json:"ownerId,omitempty" xml:"ownerId,omitempty"`
+	// Whether every user can clone the template
+	Public *bool `form:"public,omitempty" json:"public,omitempty" xml:"public,omitempty"`
+}
+
+// PlanDocumentPlanRequestBodyRequestBody is used to define fields on request
+// body types.
+type PlanDocumentPlanRequestBodyRequestBody struct {
+	// Name of the plan
+	Name string `form:"name" json:"name" xml:"name"`
+	// Description of the plan
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Start date in ISO 8601
+	StartDate string `form:"startDate" json:"startDate" xml:"startDate"`
+	// End date in ISO 8601
+	EndDate string `form:"endDate" json:"endDate" xml:"endDate"`
+	// Workouts of the plan
+	Workouts []*PlanDocumentWorkoutRequestBodyRequestBody `form:"workouts" json:"workouts" xml:"workouts"`
+}
+
+// PlanDocumentWorkoutRequestBodyRequestBody is used to define fields on
+// request body types.
+type PlanDocumentWorkoutRequestBodyRequestBody struct {
+	// Workout name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Order among the workouts of the same day
+	Position *int `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	// Specific dates the workout is planned on
+	ScheduledDates []string `form:"scheduledDates,omitempty" json:"scheduledDates,omitempty" xml:"scheduledDates,omitempty"`
+	// RRULE-style recurrence within the plan range
+	Recurrence *string `form:"recurrence,omitempty" json:"recurrence,omitempty" xml:"recurrence,omitempty"`
+	// Exercises of the workout
+	Exercises []*PlanDocumentExerciseRequestBodyRequestBody `form:"exercises" json:"exercises" xml:"exercises"`
+}
+
+// PlanDocumentExerciseRequestBodyRequestBody is used to define fields on
+// request body types.
+type PlanDocumentExerciseRequestBodyRequestBody struct {
+	// Exercise name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Name of the exercise type in the catalog, defaults to the exercise name
+	ExerciseType *string `form:"exerciseType,omitempty" json:"exerciseType,omitempty" xml:"exerciseType,omitempty"`
+	// Planned sets
+	Sets []*PlanDocumentSetRequestBodyRequestBody `form:"sets" json:"sets" xml:"sets"`
+	// Progression rule of the exercise
+	Progression *PlanDocumentProgressionRequestBodyRequestBody `form:"progression,omitempty" json:"progression,omitempty" xml:"progression,omitempty"`
+}
+
+// PlanDocumentSetRequestBodyRequestBody is used to define fields on request
+// body types.
+type PlanDocumentSetRequestBodyRequestBody struct {
+	// Weight in kg
+	Weight float64 `form:"weight" json:"weight" xml:"weight"`
+	// Repetitions
+	Reps int `form:"reps" json:"reps" xml:"reps"`
+	// Rest time in seconds
+	RestTime int `form:"restTime" json:"restTime" xml:"restTime"`
+}
+
+// PlanDocumentProgressionRequestBodyRequestBody is used to define fields on
+// request body types.
+type PlanDocumentProgressionRequestBodyRequestBody struct {
+	// Progression scheme
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Weight added when the target is met (kg)
+	Increment float64 `form:"increment" json:"increment" xml:"increment"`
+	// Bottom of the rep range
+	MinReps *int `form:"minReps,omitempty" json:"minReps,omitempty" xml:"minReps,omitempty"`
+	// Top of the rep range
+	MaxReps *int `form:"maxReps,omitempty" json:"maxReps,omitempty" xml:"maxReps,omitempty"`
+	// Fraction of the estimated one-rep max
+	Percentage *float64 `form:"percentage,omitempty" json:"percentage,omitempty" xml:"percentage,omitempty"`
+	// Consecutive missed sessions before a deload
+	DeloadAfter int `form:"deloadAfter" json:"deloadAfter" xml:"deloadAfter"`
+	// Fraction of the weight removed by a deload
+	DeloadPercentage float64 `form:"deloadPercentage" json:"deloadPercentage" xml:"deloadPercentage"`
+}
+
+// TrainingPlanResponseBody is used to define fields on response body types.
+type TrainingPlanResponseBody struct {
+	// TrainingPlan ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the training plan
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Description of the plan
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Start date in ISO 8601
+	StartDate *string `form:"startDate,omitempty" json:"startDate,omitempty" xml:"startDate,omitempty"`
+	// End date in ISO 8601
+	EndDate *string `form:"endDate,omitempty" json:"endDate,omitempty" xml:"endDate,omitempty"`
+	// ID of the user who owns the plan
+	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+	// Entity tag of the current version, to send back in If-Match when changing it
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty" xml:"etag,omitempty"`
+}
+
+// NewCreateRequestBody builds the HTTP request body from the payload of the
+// "create" endpoint of the "training_plan" service.
+func NewCreateRequestBody(p *trainingplan.CreatePayload) *CreateRequestBody {
+	body := &CreateRequestBody{
+		Name:        p.Name,
+		Description: p.Description,
+		StartDate:   p.StartDate,
+		EndDate:     p.EndDate,
+		UserID:      p.UserID,
+	}
+	return body
+}
+
+// NewUpdateRequestBody builds the HTTP request body from the payload of the
+// "update" endpoint of the "training_plan" service.
+func NewUpdateRequestBody(p *trainingplan.UpdatePayload) *UpdateRequestBody {
+	body := &UpdateRequestBody{
+		Name:        p.Name,
+		Description: p.Description,
+		StartDate:   p.StartDate,
+		EndDate:     p.EndDate,
+		UserID:      p.UserID,
+	}
+	return body
+}
+
+// NewCloneRequestBody builds the HTTP request body from the payload of the
+// "clone" endpoint of the "training_plan" service.
+func NewCloneRequestBody(p *trainingplan.ClonePayload) *CloneRequestBody {
+	body := &CloneRequestBody{
+		UserID:    p.UserID,
+		StartDate: p.StartDate,
+		Name:      p.Name,
+	}
+	return body
+}
+
+// NewCreateTemplateRequestBody builds the HTTP request body from the payload
+// of the "createTemplate" endpoint of the "training_plan" service.
+func NewCreateTemplateRequestBody(p *trainingplan.CreateTemplatePayload) *CreateTemplateRequestBody {
+	body := &CreateTemplateRequestBody{
+		Name:   p.Name,
+		Public: p.Public,
+	}
+	{
+		var zero bool
+		if body.Public == zero {
+			body.Public = false
+		}
+	}
+	return body
+}
+
+// NewImportRequestBody builds the HTTP request body from the payload of the
+// "import" endpoint of the "training_plan" service.
+func NewImportRequestBody(p *trainingplan.ImportPayload) *ImportRequestBody {
+	body := &ImportRequestBody{
+		Version:    p.Document.Version,
+		ExportedAt: p.Document.ExportedAt,
+	}
+	if p.Document.Plan != nil {
+		body.Plan = marshalTrainingplanPlanDocumentPlanToPlanDocumentPlanRequestBodyRequestBody(p.Document.Plan)
+	}
+	return body
+}
+
+// NewCreateTrainingPlanCreated builds a "training_plan" service "create"
+// endpoint result from a HTTP "Created" response.
+func NewCreateTrainingPlanCreated(body *CreateResponseBody, etag *string) *trainingplan.TrainingPlan {
+	v := &trainingplan.TrainingPlan{
+		ID:          *body.ID,
+		Name:        *body.Name,
+		Description: body.Description,
+		StartDate:   *body.StartDate,
+		EndDate:     *body.EndDate,
+		UserID:      *body.UserID,
+		DeletedAt:   body.DeletedAt,
+	}
+	if body.Warnings != nil {
+		v.Warnings = make([]string, len(body.Warnings))
+		for i, val := range body.Warnings {
+			v.Warnings[i] = val
+		}
+	}
+	v.Etag = etag
+
+	return v
+}
+
+// NewCreateBadRequest builds a training_plan service create endpoint
+// badRequest error.
+func NewCreateBadRequest(body *CreateBadRequestResponseBody) *trainingplan.BadRequest {
+	v := &trainingplan.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*trainingplan.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToTrainingplanFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewCreateForbidden builds a training_plan service create endpoint forbidden
+// error.
+func NewCreateForbidden(body *CreateForbiddenResponseBody) *trainingplan.Forbidden {
+	v := &trainingplan.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateInternalServerError builds a training_plan service create endpoint
+// internalServerError error.
+func NewCreateInternalServerError(body *CreateInternalServerErrorResponseBody) *trainingplan.InternalServerError {
+	v := &trainingplan.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateNotFound builds a training_plan service create endpoint notFound
+// error.
+func NewCreateNotFound(body *CreateNotFoundResponseBody) *trainingplan.NotFound {
+	v := &trainingplan.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateUnauthorized builds a training_plan service create endpoint
+// unauthorized error.
+func NewCreateUnauthorized(body *CreateUnauthorizedResponseBody) *trainingplan.Unauthorized {
+	v := &trainingplan.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetTrainingPlanOK builds a "training_plan" service "get" endpoint result
+// from a HTTP "OK" response.
+func NewGetTrainingPlanOK(body *GetResponseBody, etag *string) *trainingplan.TrainingPlan {
+	v := &trainingplan.TrainingPlan{
+		ID:          *body.ID,
+		Name:        *body.Name,
+		Description: body.Description,
+		StartDate:   *body.StartDate,
+		EndDate:     *body.EndDate,
+		UserID:      *body.UserID,
+		DeletedAt:   body.DeletedAt,
+	}
+	if body.Warnings != nil {
+		v.Warnings = make([]string, len(body.Warnings))
+		for i, val := range body.Warnings {
+			v.Warnings[i] = val
+		}
+	}
+	v.Etag = etag
+
+	return v
+}
+
+// NewGetBadRequest builds a training_plan service get endpoint badRequest
+// error.
+func NewGetBadRequest(body *GetBadRequestResponseBody) *trainingplan.BadRequest {
+	v := &trainingplan.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*trainingplan.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToTrainingplanFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetForbidden builds a training_plan service get endpoint forbidden error.
+func NewGetForbidden(body *GetForbiddenResponseBody) *trainingplan.Forbidden {
+	v := &trainingplan.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetInternalServerError builds a training_plan service get endpoint
+// internalServerError error.
+func NewGetInternalServerError(body *GetInternalServerErrorResponseBody) *trainingplan.InternalServerError {
+	v := &trainingplan.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetNotFound builds a training_plan service get endpoint notFound error.
+func NewGetNotFound(body *GetNotFoundResponseBody) *trainingplan.NotFound {
+	v := &trainingplan.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetUnauthorized builds a training_plan service get endpoint unauthorized
+// error.
+func NewGetUnauthorized(body *GetUnauthorizedResponseBody) *trainingplan.Unauthorized {
+	v := &trainingplan.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListTrainingPlanOK builds a "training_plan" service "list" endpoint
+// result from a HTTP "OK" response.
+func NewListTrainingPlanOK(body []*TrainingPlanResponse) []*trainingplan.TrainingPlan {
+	v := make([]*trainingplan.TrainingPlan, len(body))
+	for i, val := range body {
+		v[i] = unmarshalTrainingPlanResponseToTrainingplanTrainingPlan(val)
+	}
+
+	return v
+}
+
+// NewListBadRequest builds a training_plan service list endpoint badRequest
+// error.
+func NewListBadRequest(body *ListBadRequestResponseBody) *trainingplan.BadRequest {
+	v := &trainingplan.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*trainingplan.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToTrainingplanFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewListForbidden builds a training_plan service list endpoint forbidden
+// error.
+func NewListForbidden(body *ListForbiddenResponseBody) *trainingplan.Forbidden {
+	v := &trainingplan.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListInternalServerError builds a training_plan service list endpoint
+// internalServerError error.
+func NewListInternalServerError(body *ListInternalServerErrorResponseBody) *trainingplan.InternalServerError {
+	v := &trainingplan.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListNotFound builds a training_plan service list endpoint notFound error.
+func NewListNotFound(body *ListNotFoundResponseBody) *trainingplan.NotFound {
+	v := &trainingplan.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListUnauthorized builds a training_plan service list endpoint
+// unauthorized error.
+func NewListUnauthorized(body *ListUnauthorizedResponseBody) *trainingplan.Unauthorized {
+	v := &trainingplan.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateTrainingPlanOK builds a "training_plan" service "update" endpoint
+// result from a HTTP "OK" response.
+func NewUpdateTrainingPlanOK(body *UpdateResponseBody, etag *string) *trainingplan.TrainingPlan {
+	v := &trainingplan.TrainingPlan{
+		ID:          *body.ID,
+		Name:        *body.Name,
+		Description: body.Description,
+		StartDate:   *body.StartDate,
+		EndDate:     *body.EndDate,
+		UserID:      *body.UserID,
+		DeletedAt:   body.DeletedAt,
+	}
+	if body.Warnings != nil {
+		v.Warnings = make([]string, len(body.Warnings))
+		for i, val := range body.Warnings {
+			v.Warnings[i] = val
+		}
+	}
+	v.Etag = etag
+
+	return v
+}
+
+// NewUpdateBadRequest builds a training_plan service update endpoint
+// badRequest error.
+func NewUpdateBadRequest(body *UpdateBadRequestResponseBody) *trainingplan.BadRequest {
+	v := &trainingplan.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*trainingplan.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToTrainingplanFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewUpdateForbidden builds a training_plan service update endpoint forbidden
+// error.
+func NewUpdateForbidden(body *UpdateForbiddenResponseBody) *trainingplan.Forbidden {
+	v := &trainingplan.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateInternalServerError builds a training_plan service update endpoint
+// internalServerError error.
+func NewUpdateInternalServerError(body *UpdateInternalServerErrorResponseBody) *trainingplan.InternalServerError {
+	v := &trainingplan.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateNotFound builds a training_plan service update endpoint notFound
+// error.
+func NewUpdateNotFound(body *UpdateNotFoundResponseBody) *trainingplan.NotFound {
+	v := &trainingplan.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdatePreconditionFailed builds a training_plan service update endpoint
+// preconditionFailed error.
+func NewUpdatePreconditionFailed(body *UpdatePreconditionFailedResponseBody) *trainingplan.PreconditionFailed {
+	v := &trainingplan.PreconditionFailed{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateUnauthorized builds a training_plan service update endpoint
+// unauthorized error.
+func NewUpdateUnauthorized(body *UpdateUnauthorizedResponseBody) *trainingplan.Unauthorized {
+	v := &trainingplan.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPatchTrainingPlanOK builds a "training_plan" service "patch" endpoint
+// result from a HTTP "OK" response.
+func NewPatchTrainingPlanOK(body *PatchResponseBody, etag *string) *trainingplan.TrainingPlan {
+	v := &trainingplan.TrainingPlan{
+		ID:          *body.ID,
+		Name:        *body.Name,
+		Description: body.Description,
+		StartDate:   *body.StartDate,
+		EndDate:     *body.EndDate,
+		UserID:      *body.UserID,
+		DeletedAt:   body.DeletedAt,
+	}
+	if body.Warnings != nil {
+		v.Warnings = make([]string, len(body.Warnings))
+		for i, val := range body.Warnings {
+			v.Warnings[i] = val
+		}
+	}
+	v.Etag = etag
+
+	return v
+}
+
+// NewPatchBadRequest builds a training_plan service patch endpoint badRequest
+// error.
+func NewPatchBadRequest(body *PatchBadRequestResponseBody) *trainingplan.BadRequest {
+	v := &trainingplan.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*trainingplan.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToTrainingplanFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewPatchForbidden builds a training_plan service patch endpoint forbidden
+// error.
+func NewPatchForbidden(body *PatchForbiddenResponseBody) *trainingplan.Forbidden {
+	v := &trainingplan.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPatchInternalServerError builds a training_plan service patch endpoint
+// internalServerError error.
+func NewPatchInternalServerError(body *PatchInternalServerErrorResponseBody) *trainingplan.InternalServerError {
+	v := &trainingplan.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPatchNotFound builds a training_plan service patch endpoint notFound
+// error.
+func NewPatchNotFound(body *PatchNotFoundResponseBody) *trainingplan.NotFound {
+	v := &trainingplan.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPatchPreconditionFailed builds a training_plan service patch endpoint
+// preconditionFailed error.
+func NewPatchPreconditionFailed(body *PatchPreconditionFailedResponseBody) *trainingplan.PreconditionFailed {
+	v := &trainingplan.PreconditionFailed{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPatchUnauthorized builds a training_plan service patch endpoint
+// unauthorized error.
+func NewPatchUnauthorized(body *PatchUnauthorizedResponseBody) *trainingplan.Unauthorized {
+	v := &trainingplan.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteBadRequest builds a training_plan service delete endpoint
+// badRequest error.
+func NewDeleteBadRequest(body *DeleteBadRequestResponseBody) *trainingplan.BadRequest {
+	v := &trainingplan.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*trainingplan.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToTrainingplanFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewDeleteForbidden builds a training_plan service delete endpoint forbidden
+// error.
+func NewDeleteForbidden(body *DeleteForbiddenResponseBody) *trainingplan.Forbidden {
+	v := &trainingplan.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteInternalServerError builds a training_plan service delete endpoint
+// internalServerError error.
+func NewDeleteInternalServerError(body *DeleteInternalServerErrorResponseBody) *trainingplan.InternalServerError {
+	v := &trainingplan.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteNotFound builds a training_plan service delete endpoint notFound
+// error.
+func NewDeleteNotFound(body *DeleteNotFoundResponseBody) *trainingplan.NotFound {
+	v := &trainingplan.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeletePreconditionFailed builds a training_plan service delete endpoint
+// preconditionFailed error.
+func NewDeletePreconditionFailed(body *DeletePreconditionFailedResponseBody) *trainingplan.PreconditionFailed {
+	v := &trainingplan.PreconditionFailed{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteUnauthorized builds a training_plan service delete endpoint
+// unauthorized error.
+func NewDeleteUnauthorized(body *DeleteUnauthorizedResponseBody) *trainingplan.Unauthorized {
+	v := &trainingplan.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRestoreTrainingPlanOK builds a "training_plan" service "restore" endpoint
+// result from a HTTP "OK" response.
+func NewRestoreTrainingPlanOK(body *RestoreResponseBody, etag *string) *trainingplan.TrainingPlan {
+	v := &trainingplan.TrainingPlan{
+		ID:          *body.ID,
+		Name:        *body.Name,
+		Description: body.Description,
+		StartDate:   *body.StartDate,
+		EndDate:     *body.EndDate,
+		UserID:      *body.UserID,
+		DeletedAt:   body.DeletedAt,
+	}
+	if body.Warnings != nil {
+		v.Warnings = make([]string, len(body.Warnings))
+		for i, val := range body.Warnings {
+			v.Warnings[i] = val
+		}
+	}
+	v.Etag = etag
+
+	return v
+}
+
+// NewRestoreBadRequest builds a training_plan service restore endpoint
+// badRequest error.
+func NewRestoreBadRequest(body *RestoreBadRequestResponseBody) *trainingplan.BadRequest {
+	v := &trainingplan.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*trainingplan.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToTrainingplanFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewRestoreForbidden builds a training_plan service restore endpoint
+// forbidden error.
+func NewRestoreForbidden(body *RestoreForbiddenResponseBody) *trainingplan.Forbidden {
+	v := &trainingplan.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRestoreInternalServerError builds a training_plan service restore
+// endpoint internalServerError error.
+func NewRestoreInternalServerError(body *RestoreInternalServerErrorResponseBody) *trainingplan.InternalServerError {
+	v := &trainingplan.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRestoreNotFound builds a training_plan service restore endpoint notFound
+// error.
+func NewRestoreNotFound(body *RestoreNotFoundResponseBody) *trainingplan.NotFound {
+	v := &trainingplan.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRestoreUnauthorized builds a training_plan service restore endpoint
+// unauthorized error.
+func NewRestoreUnauthorized(body *RestoreUnauthorizedResponseBody) *trainingplan.Unauthorized {
+	v := &trainingplan.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListDeletedTrainingPlanOK builds a "training_plan" service "listDeleted"
+// endpoint result from a HTTP "OK" response.
+func NewListDeletedTrainingPlanOK(body []*TrainingPlanResponse) []*trainingplan.TrainingPlan {
+	v := make([]*trainingplan.TrainingPlan, len(body))
+	for i, val := range body {
+		v[i] = unmarshalTrainingPlanResponseToTrainingplanTrainingPlan(val)
+	}
+
+	return v
+}
+
+// NewListDeletedBadRequest builds a training_plan service listDeleted endpoint
+// badRequest error.
+func NewListDeletedBadRequest(body *ListDeletedBadRequestResponseBody) *trainingplan.BadRequest {
+	v := &trainingplan.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*trainingplan.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToTrainingplanFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewListDeletedForbidden builds a training_plan service listDeleted endpoint
+// forbidden error.
+func NewListDeletedForbidden(body *ListDeletedForbiddenResponseBody) *trainingplan.Forbidden {
+	v := &trainingplan.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListDeletedInternalServerError builds a training_plan service listDeleted
+// endpoint internalServerError error.
+func NewListDeletedInternalServerError(body *ListDeletedInternalServerErrorResponseBody) *trainingplan.InternalServerError {
+	v := &trainingplan.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListDeletedNotFound builds a training_plan service listDeleted endpoint
+// notFound error.
+func NewListDeletedNotFound(body *ListDeletedNotFoundResponseBody) *trainingplan.NotFound {
+	v := &trainingplan.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListDeletedUnauthorized builds a training_plan service listDeleted
+// endpoint unauthorized error.
+func NewListDeletedUnauthorized(body *ListDeletedUnauthorizedResponseBody) *trainingplan.Unauthorized {
+	v := &trainingplan.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCloneTrainingPlanCreated builds a "training_plan" service "clone"
+// endpoint result from a HTTP "Created" response.
+func NewCloneTrainingPlanCreated(body *CloneResponseBody, etag *string) *trainingplan.TrainingPlan {
+	v := &trainingplan.TrainingPlan{
+		ID:          *body.ID,
+		Name:        *body.Name,
+		Description: body.Description,
+		StartDate:   *body.StartDate,
+		EndDate:     *body.EndDate,
+		UserID:      *body.UserID,
+		DeletedAt:   body.DeletedAt,
+	}
+	if body.Warnings != nil {
+		v.Warnings = make([]string, len(body.Warnings))
+		for i, val := range body.Warnings {
+			v.Warnings[i] = val
+		}
+	}
+	v.Etag = etag
+
+	return v
+}
+
+// NewCloneBadRequest builds a training_plan service clone endpoint badRequest
+// error.
+func NewCloneBadRequest(body *CloneBadRequestResponseBody) *trainingplan.BadRequest {
+	v := &trainingplan.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*trainingplan.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToTrainingplanFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewCloneForbidden builds a training_plan service clone endpoint forbidden
+// error.
+func NewCloneForbidden(body *CloneForbiddenResponseBody) *trainingplan.Forbidden {
+	v := &trainingplan.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCloneInternalServerError builds a training_plan service clone endpoint
+// internalServerError error.
+func NewCloneInternalServerError(body *CloneInternalServerErrorResponseBody) *trainingplan.InternalServerError {
+	v := &trainingplan.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCloneNotFound builds a training_plan service clone endpoint notFound
+// error.
+func NewCloneNotFound(body *CloneNotFoundResponseBody) *trainingplan.NotFound {
+	v := &trainingplan.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCloneUnauthorized builds a training_plan service clone endpoint
+// unauthorized error.
+func NewCloneUnauthorized(body *CloneUnauthorizedResponseBody) *trainingplan.Unauthorized {
+	v := &trainingplan.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateTemplatePlanTemplateCreated builds a "training_plan" service
+// "createTemplate" endpoint result from a HTTP "Created" response.
+func NewCreateTemplatePlanTemplateCreated(body *CreateTemplateResponseBody) *trainingplan.PlanTemplate {
+	v := &trainingplan.PlanTemplate{
+		ID:          *body.ID,
+		Name:        *body.Name,
+		Description: body.Description,
+		StartDate:   *body.StartDate,
+		EndDate:     *body.EndDate,
+		OwnerID:     body.OwnerID,
+		Public:      *body.Public,
+	}
+
+	return v
+}
+
+// NewCreateTemplateBadRequest builds a training_plan service createTemplate
+// endpoint badRequest error.
+func NewCreateTemplateBadRequest(body *CreateTemplateBadRequestResponseBody) *trainingplan.BadRequest {
+	v := &trainingplan.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*trainingplan.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToTrainingplanFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewCreateTemplateForbidden builds a training_plan service createTemplate
+// endpoint forbidden error.
+func NewCreateTemplateForbidden(body *CreateTemplateForbiddenResponseBody) *trainingplan.Forbidden {
+	v := &trainingplan.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateTemplateInternalServerError builds a training_plan service
+// createTemplate endpoint internalServerError error.
+func NewCreateTemplateInternalServerError(body *CreateTemplateInternalServerErrorResponseBody) *trainingplan.InternalServerError {
+	v := &trainingplan.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateTemplateNotFound builds a training_plan service createTemplate
+// endpoint notFound error.
+func NewCreateTemplateNotFound(body *CreateTemplateNotFoundResponseBody) *trainingplan.NotFound {
+	v := &trainingplan.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateTemplateUnauthorized builds a training_plan service createTemplate
+// endpoint unauthorized error.
+func NewCreateTemplateUnauthorized(body *CreateTemplateUnauthorizedResponseBody) *trainingplan.Unauthorized {
+	v := &trainingplan.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListTemplatesPlanTemplateOK builds a "training_plan" service
+// "listTemplates" endpoint result from a HTTP "OK" response.
+func NewListTemplatesPlanTemplateOK(body []*PlanTemplateResponse) []*trainingplan.PlanTemplate {
+	v := make([]*trainingplan.PlanTemplate, len(body))
+	for i, val := range body {
+		v[i] = unmarshalPlanTemplateResponseToTrainingplanPlanTemplate(val)
+	}
+
+	return v
+}
+
+// NewListTemplatesBadRequest builds a training_plan service listTemplates
+// endpoint badRequest error.
+func NewListTemplatesBadRequest(body *ListTemplatesBadRequestResponseBody) *trainingplan.BadRequest {
+	v := &trainingplan.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*trainingplan.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToTrainingplanFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewListTemplatesForbidden builds a training_plan service listTemplates
+// endpoint forbidden error.
+func NewListTemplatesForbidden(body *ListTemplatesForbiddenResponseBody) *trainingplan.Forbidden {
+	v := &trainingplan.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListTemplatesInternalServerError builds a training_plan service
+// listTemplates endpoint internalServerError error.
+func NewListTemplatesInternalServerError(body *ListTemplatesInternalServerErrorResponseBody) *trainingplan.InternalServerError {
+	v := &trainingplan.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListTemplatesNotFound builds a training_plan service listTemplates
+// endpoint notFound error.
+func NewListTemplatesNotFound(body *ListTemplatesNotFoundResponseBody) *trainingplan.NotFound {
+	v := &trainingplan.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListTemplatesUnauthorized builds a training_plan service listTemplates
+// endpoint unauthorized error.
+func NewListTemplatesUnauthorized(body *ListTemplatesUnauthorizedResponseBody) *trainingplan.Unauthorized {
+	v := &trainingplan.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewExportResultOK builds a "training_plan" service "export" endpoint result
+// from a HTTP "OK" response.
+func NewExportResultOK(contentType string, contentDisposition string) *trainingplan.ExportResult {
+	v := &trainingplan.ExportResult{}
+	v.ContentType = contentType
+	v.ContentDisposition = contentDisposition
+
+	return v
+}
+
+// NewExportBadRequest builds a training_plan service export endpoint
+// badRequest error.
+func NewExportBadRequest(body *ExportBadRequestResponseBody) *trainingplan.BadRequest {
+	v := &trainingplan.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*trainingplan.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToTrainingplanFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewExportForbidden builds a training_plan service export endpoint forbidden
+// error.
+func NewExportForbidden(body *ExportForbiddenResponseBody) *trainingplan.Forbidden {
+	v := &trainingplan.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewExportInternalServerError builds a training_plan service export endpoint
+// internalServerError error.
+func NewExportInternalServerError(body *ExportInternalServerErrorResponseBody) *trainingplan.InternalServerError {
+	v := &trainingplan.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewExportNotFound builds a training_plan service export endpoint notFound
+// error.
+func NewExportNotFound(body *ExportNotFoundResponseBody) *trainingplan.NotFound {
+	v := &trainingplan.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewExportUnauthorized builds a training_plan service export endpoint
+// unauthorized error.
+func NewExportUnauthorized(body *ExportUnauthorizedResponseBody) *trainingplan.Unauthorized {
+	v := &trainingplan.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewImportPlanImportReportCreated builds a "training_plan" service "import"
+// endpoint result from a HTTP "Created" response.
+func NewImportPlanImportReportCreated(body *ImportResponseBody) *trainingplan.PlanImportReport {
+	v := &trainingplan.PlanImportReport{
+		Workouts:  *body.Workouts,
+		Exercises: *body.Exercises,
+		Sets:      *body.Sets,
+	}
+	v.Plan = unmarshalTrainingPlanResponseBodyToTrainingplanTrainingPlan(body.Plan)
+	v.Unmatched = make([]string, len(body.Unmatched))
+	for i, val := range body.Unmatched {
+		v.Unmatched[i] = val
+	}
+
+	return v
+}
+
+// NewImportBadRequest builds a training_plan service import endpoint
+// badRequest error.
+func NewImportBadRequest(body *ImportBadRequestResponseBody) *trainingplan.BadRequest {
+	v := &trainingplan.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*trainingplan.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToTrainingplanFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewImportForbidden builds a training_plan service import endpoint forbidden
+// error.
+func NewImportForbidden(body *ImportForbiddenResponseBody) *trainingplan.Forbidden {
+	v := &trainingplan.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewImportInternalServerError builds a training_plan service import endpoint
+// internalServerError error.
+func NewImportInternalServerError(body *ImportInternalServerErrorResponseBody) *trainingplan.InternalServerError {
+	v := &trainingplan.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewImportNotFound builds a training_plan service import endpoint notFound
+// error.
+func NewImportNotFound(body *ImportNotFoundResponseBody) *trainingplan.NotFound {
+	v := &trainingplan.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewImportUnauthorized builds a training_plan service import endpoint
+// unauthorized error.
+func NewImportUnauthorized(body *ImportUnauthorizedResponseBody) *trainingplan.Unauthorized {
+	v := &trainingplan.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// ValidateCreateResponseBody runs the validations defined on CreateResponseBody
+func ValidateCreateResponseBody(body *CreateResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.StartDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startDate", "body"))
+	}
+	if body.EndDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endDate", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.StartDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startDate", *body.StartDate, goa.FormatDateTime))
+	}
+	if body.EndDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.endDate", *body.EndDate, goa.FormatDateTime))
+	}
+	if body.UserID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
+	}
+	if body.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deletedAt", *body.DeletedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateGetResponseBody runs the validations defined on GetResponseBody
+func ValidateGetResponseBody(body *GetResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.StartDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startDate", "body"))
+	}
+	if body.EndDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endDate", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.StartDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startDate", *body.StartDate, goa.FormatDateTime))
+	}
+	if body.EndDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.endDate", *body.EndDate, goa.FormatDateTime))
+	}
+	if body.UserID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
+	}
+	if body.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deletedAt", *body.DeletedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateUpdateResponseBody runs the validations defined on UpdateResponseBody
+func ValidateUpdateResponseBody(body *UpdateResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.StartDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startDate", "body"))
+	}
+	if body.EndDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endDate", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.StartDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startDate", *body.StartDate, goa.FormatDateTime))
+	}
+	if body.EndDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.endDate", *body.EndDate, goa.FormatDateTime))
+	}
+	if body.UserID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
+	}
+	if body.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deletedAt", *body.DeletedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidatePatchResponseBody runs the validations defined on PatchResponseBody
+func ValidatePatchResponseBody(body *PatchResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.StartDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startDate", "body"))
+	}
+	if body.EndDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endDate", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.StartDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startDate", *body.StartDate, goa.FormatDateTime))
+	}
+	if body.EndDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.endDate", *body.EndDate, goa.FormatDateTime))
+	}
+	if body.UserID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
+	}
+	if body.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deletedAt", *body.DeletedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateRestoreResponseBody runs the validations defined on
+// RestoreResponseBody
+func ValidateRestoreResponseBody(body *RestoreResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.StartDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startDate", "body"))
+	}
+	if body.EndDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endDate", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.StartDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startDate", *body.StartDate, goa.FormatDateTime))
+	}
+	if body.EndDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.endDate", *body.EndDate, goa.FormatDateTime))
+	}
+	if body.UserID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
+	}
+	if body.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deletedAt", *body.DeletedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCloneResponseBody runs the validations defined on CloneResponseBody
+func ValidateCloneResponseBody(body *CloneResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.StartDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startDate", "body"))
+	}
+	if body.EndDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endDate", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.StartDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startDate", *body.StartDate, goa.FormatDateTime))
+	}
+	if body.EndDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.endDate", *body.EndDate, goa.FormatDateTime))
+	}
+	if body.UserID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
+	}
+	if body.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deletedAt", *body.DeletedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCreateTemplateResponseBody runs the validations defined on
+// CreateTemplateResponseBody
+func ValidateCreateTemplateResponseBody(body *CreateTemplateResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.StartDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startDate", "body"))
+	}
+	if body.EndDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endDate", "body"))
+	}
+	if body.Public == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("public", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.StartDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startDate", *body.StartDate, goa.FormatDateTime))
+	}
+	if body.EndDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.endDate", *body.EndDate, goa.FormatDateTime))
+	}
+	if body.OwnerID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.ownerId", *body.OwnerID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateImportResponseBody runs the validations defined on ImportResponseBody
+func ValidateImportResponseBody(body *ImportResponseBody) (err error) {
+	if body.Plan == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("plan", "body"))
+	}
+	if body.Workouts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("workouts", "body"))
+	}
+	if body.Exercises == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercises", "body"))
+	}
+	if body.Sets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sets", "body"))
+	}
+	if body.Unmatched == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("unmatched", "body"))
+	}
+	if body.Plan != nil {
+		if err2 := ValidateTrainingPlanResponseBody(body.Plan); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateCreateBadRequestResponseBody runs the validations defined on
+// create_badRequest_response_body
+func ValidateCreateBadRequestResponseBody(body *CreateBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateForbiddenResponseBody runs the validations defined on
+// create_forbidden_response_body
+func ValidateCreateForbiddenResponseBody(body *CreateForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateInternalServerErrorResponseBody runs the validations defined
+// on create_internalServerError_response_body
+func ValidateCreateInternalServerErrorResponseBody(body *CreateInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateNotFoundResponseBody runs the validations defined on
+// create_notFound_response_body
+func ValidateCreateNotFoundResponseBody(body *CreateNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateUnauthorizedResponseBody runs the validations defined on
+// create_unauthorized_response_body
+func ValidateCreateUnauthorizedResponseBody(body *CreateUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetBadRequestResponseBody runs the validations defined on
+// get_badRequest_response_body
+func ValidateGetBadRequestResponseBody(body *GetBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetForbiddenResponseBody runs the validations defined on
+// get_forbidden_response_body
+func ValidateGetForbiddenResponseBody(body *GetForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetInternalServerErrorResponseBody runs the validations defined on
+// get_internalServerError_response_body
+func ValidateGetInternalServerErrorResponseBody(body *GetInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetNotFoundResponseBody runs the validations defined on
+// get_notFound_response_body
+func ValidateGetNotFoundResponseBody(body *GetNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetUnauthorizedResponseBody runs the validations defined on
+// get_unauthorized_response_body
+func ValidateGetUnauthorizedResponseBody(body *GetUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListBadRequestResponseBody runs the validations defined on
+// list_badRequest_response_body
+func ValidateListBadRequestResponseBody(body *ListBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListForbiddenResponseBody runs the validations defined on
+// list_forbidden_response_body
+func ValidateListForbiddenResponseBody(body *ListForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListInternalServerErrorResponseBody runs the validations defined on
+// list_internalServerError_response_body
+func ValidateListInternalServerErrorResponseBody(body *ListInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListNotFoundResponseBody runs the validations defined on
+// list_notFound_response_body
+func ValidateListNotFoundResponseBody(body *ListNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListUnauthorizedResponseBody runs the validations defined on
+// list_unauthorized_response_body
+func ValidateListUnauthorizedResponseBody(body *ListUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateBadRequestResponseBody runs the validations defined on
+// update_badRequest_response_body
+func ValidateUpdateBadRequestResponseBody(body *UpdateBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateUpdateForbiddenResponseBody runs the validations defined on
+// update_forbidden_response_body
+func ValidateUpdateForbiddenResponseBody(body *UpdateForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateInternalServerErrorResponseBody runs the validations defined
+// on update_internalServerError_response_body
+func ValidateUpdateInternalServerErrorResponseBody(body *UpdateInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateNotFoundResponseBody runs the validations defined on
+// update_notFound_response_body
+func ValidateUpdateNotFoundResponseBody(body *UpdateNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdatePreconditionFailedResponseBody runs the validations defined on
+// update_preconditionFailed_response_body
+func ValidateUpdatePreconditionFailedResponseBody(body *UpdatePreconditionFailedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateUnauthorizedResponseBody runs the validations defined on
+// update_unauthorized_response_body
+func ValidateUpdateUnauthorizedResponseBody(body *UpdateUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchBadRequestResponseBody runs the validations defined on
+// patch_badRequest_response_body
+func ValidatePatchBadRequestResponseBody(body *PatchBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePatchForbiddenResponseBody runs the validations defined on
+// patch_forbidden_response_body
+func ValidatePatchForbiddenResponseBody(body *PatchForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchInternalServerErrorResponseBody runs the validations defined on
+// patch_internalServerError_response_body
+func ValidatePatchInternalServerErrorResponseBody(body *PatchInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchNotFoundResponseBody runs the validations defined on
+// patch_notFound_response_body
+func ValidatePatchNotFoundResponseBody(body *PatchNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchPreconditionFailedResponseBody runs the validations defined on
+// patch_preconditionFailed_response_body
+func ValidatePatchPreconditionFailedResponseBody(body *PatchPreconditionFailedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchUnauthorizedResponseBody runs the validations defined on
+// patch_unauthorized_response_body
+func ValidatePatchUnauthorizedResponseBody(body *PatchUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteBadRequestResponseBody runs the validations defined on
+// delete_badRequest_response_body
+func ValidateDeleteBadRequestResponseBody(body *DeleteBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateDeleteForbiddenResponseBody runs the validations defined on
+// delete_forbidden_response_body
+func ValidateDeleteForbiddenResponseBody(body *DeleteForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteInternalServerErrorResponseBody runs the validations defined
+// on delete_internalServerError_response_body
+func ValidateDeleteInternalServerErrorResponseBody(body *DeleteInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteNotFoundResponseBody runs the validations defined on
+// delete_notFound_response_body
+func ValidateDeleteNotFoundResponseBody(body *DeleteNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeletePreconditionFailedResponseBody runs the validations defined on
+// delete_preconditionFailed_response_body
+func ValidateDeletePreconditionFailedResponseBody(body *DeletePreconditionFailedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteUnauthorizedResponseBody runs the validations defined on
+// delete_unauthorized_response_body
+func ValidateDeleteUnauthorizedResponseBody(body *DeleteUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRestoreBadRequestResponseBody runs the validations defined on
+// restore_badRequest_response_body
+func ValidateRestoreBadRequestResponseBody(body *RestoreBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRestoreForbiddenResponseBody runs the validations defined on
+// restore_forbidden_response_body
+func ValidateRestoreForbiddenResponseBody(body *RestoreForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRestoreInternalServerErrorResponseBody runs the validations defined
+// on restore_internalServerError_response_body
+func ValidateRestoreInternalServerErrorResponseBody(body *RestoreInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRestoreNotFoundResponseBody runs the validations defined on
+// restore_notFound_response_body
+func ValidateRestoreNotFoundResponseBody(body *RestoreNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRestoreUnauthorizedResponseBody runs the validations defined on
+// restore_unauthorized_response_body
+func ValidateRestoreUnauthorizedResponseBody(body *RestoreUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListDeletedBadRequestResponseBody runs the validations defined on
+// listDeleted_badRequest_response_body
+func ValidateListDeletedBadRequestResponseBody(body *ListDeletedBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListDeletedForbiddenResponseBody runs the validations defined on
+// listDeleted_forbidden_response_body
+func ValidateListDeletedForbiddenResponseBody(body *ListDeletedForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListDeletedInternalServerErrorResponseBody runs the validations
+// defined on listDeleted_internalServerError_response_body
+func ValidateListDeletedInternalServerErrorResponseBody(body *ListDeletedInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListDeletedNotFoundResponseBody runs the validations defined on
+// listDeleted_notFound_response_body
+func ValidateListDeletedNotFoundResponseBody(body *ListDeletedNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListDeletedUnauthorizedResponseBody runs the validations defined on
+// listDeleted_unauthorized_response_body
+func ValidateListDeletedUnauthorizedResponseBody(body *ListDeletedUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCloneBadRequestResponseBody runs the validations defined on
+// clone_badRequest_response_body
+func ValidateCloneBadRequestResponseBody(body *CloneBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCloneForbiddenResponseBody runs the validations defined on
+// clone_forbidden_response_body
+func ValidateCloneForbiddenResponseBody(body *CloneForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// ListResponseBody is the type of the "training_plan" service "list" endpoint
-// HTTP response body.
-type ListResponseBody []*TrainingPlanResponse
+// ValidateCloneInternalServerErrorResponseBody runs the validations defined on
+// clone_internalServerError_response_body
+func ValidateCloneInternalServerErrorResponseBody(body *CloneInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
 
-// UpdateResponseBody is the type of the "training_plan" service "update"
-// endpoint HTTP response body.
-type UpdateResponseBody struct {
-	// TrainingPlan ID
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Name of the training plan
-	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// Description of the plan
-	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
-	// Start date in ISO 8601
-	StartDate *string `form:"startDate,omitempty" json:"startDate,omitempty" xml:"startDate,omitempty"`
-	// End date in ISO 8601
-	EndDate *string `form:"endDate,omitempty" json:"endDate,omitempty" xml:"endDate,omitempty"`
-	// ID of the user who owns the plan
-	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+// ValidateCloneNotFoundResponseBody runs the validations defined on
+// clone_notFound_response_body
+func ValidateCloneNotFoundResponseBody(body *CloneNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// TrainingPlanResponse is used to define fields on response body types.
-type TrainingPlanResponse struct {
-	// TrainingPlan ID
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Name of the training plan
-	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// Description of the plan
-	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
-	// Start date in ISO 8601
-	StartDate *string `form:"startDate,omitempty" json:"startDate,omitempty" xml:"startDate,omitempty"`
-	// End date in ISO 8601
-	EndDate *string `form:"endDate,omitempty" json:"endDate,omitempty" xml:"endDate,omitempty"`
-	// ID of the user who owns the plan
-	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+// ValidateCloneUnauthorizedResponseBody runs the validations defined on
+// clone_unauthorized_response_body
+func ValidateCloneUnauthorizedResponseBody(body *CloneUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewCreateRequestBody builds the HTTP request body from the payload of the
-// "create" endpoint of the "training_plan" service.
-func NewCreateRequestBody(p *trainingplan.CreatePayload) *CreateRequestBody {
-	body := &CreateRequestBody{
-		Name:        p.Name,
-		Description: p.Description,
-		StartDate:   p.StartDate,
-		EndDate:     p.EndDate,
-		UserID:      p.UserID,
+// ValidateCreateTemplateBadRequestResponseBody runs the validations defined on
+// createTemplate_badRequest_response_body
+func ValidateCreateTemplateBadRequestResponseBody(body *CreateTemplateBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	return body
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
 }
 
-// NewUpdateRequestBody builds the HTTP request body from the payload of the
-// "update" endpoint of the "training_plan" service.
-func NewUpdateRequestBody(p *trainingplan.UpdatePayload) *UpdateRequestBody {
-	body := &UpdateRequestBody{
-		Name:        p.Name,
-		Description: p.Description,
-		StartDate:   p.StartDate,
-		EndDate:     p.EndDate,
-		UserID:      p.UserID,
+// ValidateCreateTemplateForbiddenResponseBody runs the validations defined on
+// createTemplate_forbidden_response_body
+func ValidateCreateTemplateForbiddenResponseBody(body *CreateTemplateForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	return body
+	return
 }
 
-// NewCreateTrainingPlanCreated builds a "training_plan" service "create"
-// endpoint result from a HTTP "Created" response.
-func NewCreateTrainingPlanCreated(body *CreateResponseBody) *trainingplan.TrainingPlan {
-	v := &trainingplan.TrainingPlan{
-		ID:          *body.ID,
-		Name:        *body.Name,
-		Description: body.Description,
-		StartDate:   *body.StartDate,
-		EndDate:     *body.EndDate,
-		UserID:      *body.UserID,
+// ValidateCreateTemplateInternalServerErrorResponseBody runs the validations
+// defined on createTemplate_internalServerError_response_body
+func ValidateCreateTemplateInternalServerErrorResponseBody(body *CreateTemplateInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	return
+}
 
-	return v
+// ValidateCreateTemplateNotFoundResponseBody runs the validations defined on
+// createTemplate_notFound_response_body
+func ValidateCreateTemplateNotFoundResponseBody(body *CreateTemplateNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewGetTrainingPlanOK builds a "training_plan" service "get" endpoint result
-// from a HTTP "OK" response.
-func NewGetTrainingPlanOK(body *GetResponseBody) *trainingplan.TrainingPlan {
-	v := &trainingplan.TrainingPlan{
-		ID:          *body.ID,
-		Name:        *body.Name,
-		Description: body.Description,
-		StartDate:   *body.StartDate,
-		EndDate:     *body.EndDate,
-		UserID:      *body.UserID,
+// ValidateCreateTemplateUnauthorizedResponseBody runs the validations defined
+// on createTemplate_unauthorized_response_body
+func ValidateCreateTemplateUnauthorizedResponseBody(body *CreateTemplateUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	return
+}
 
-	return v
+// ValidateListTemplatesBadRequestResponseBody runs the validations defined on
+// listTemplates_badRequest_response_body
+func ValidateListTemplatesBadRequestResponseBody(body *ListTemplatesBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
 }
 
-// NewListTrainingPlanOK builds a "training_plan" service "list" endpoint
-// result from a HTTP "OK" response.
-func NewListTrainingPlanOK(body []*TrainingPlanResponse) []*trainingplan.TrainingPlan {
-	v := make([]*trainingplan.TrainingPlan, len(body))
-	for i, val := range body {
-		v[i] = unmarshalTrainingPlanResponseToTrainingplanTrainingPlan(val)
+// ValidateListTemplatesForbiddenResponseBody runs the validations defined on
+// listTemplates_forbidden_response_body
+func ValidateListTemplatesForbiddenResponseBody(body *ListTemplatesForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	return
+}
 
-	return v
+// ValidateListTemplatesInternalServerErrorResponseBody runs the validations
+// defined on listTemplates_internalServerError_response_body
+func ValidateListTemplatesInternalServerErrorResponseBody(body *ListTemplatesInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewUpdateTrainingPlanOK builds a "training_plan" service "update" endpoint
-// result from a HTTP "OK" response.
-func NewUpdateTrainingPlanOK(body *UpdateResponseBody) *trainingplan.TrainingPlan {
-	v := &trainingplan.TrainingPlan{
-		ID:          *body.ID,
-		Name:        *body.Name,
-		Description: body.Description,
-		StartDate:   *body.StartDate,
-		EndDate:     *body.EndDate,
-		UserID:      *body.UserID,
+// ValidateListTemplatesNotFoundResponseBody runs the validations defined on
+// listTemplates_notFound_response_body
+func ValidateListTemplatesNotFoundResponseBody(body *ListTemplatesNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	return
+}
 
-	return v
+// ValidateListTemplatesUnauthorizedResponseBody runs the validations defined
+// on listTemplates_unauthorized_response_body
+func ValidateListTemplatesUnauthorizedResponseBody(body *ListTemplatesUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// ValidateCreateResponseBody runs the validations defined on CreateResponseBody
-func ValidateCreateResponseBody(body *CreateResponseBody) (err error) {
+// ValidateExportBadRequestResponseBody runs the validations defined on
+// export_badRequest_response_body
+func ValidateExportBadRequestResponseBody(body *ExportBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateExportForbiddenResponseBody runs the validations defined on
+// export_forbidden_response_body
+func ValidateExportForbiddenResponseBody(body *ExportForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateExportInternalServerErrorResponseBody runs the validations defined
+// on export_internalServerError_response_body
+func ValidateExportInternalServerErrorResponseBody(body *ExportInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateExportNotFoundResponseBody runs the validations defined on
+// export_notFound_response_body
+func ValidateExportNotFoundResponseBody(body *ExportNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateExportUnauthorizedResponseBody runs the validations defined on
+// export_unauthorized_response_body
+func ValidateExportUnauthorizedResponseBody(body *ExportUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateImportBadRequestResponseBody runs the validations defined on
+// import_badRequest_response_body
+func ValidateImportBadRequestResponseBody(body *ImportBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.StartDate == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("startDate", "body"))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.EndDate == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("endDate", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.UserID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.ID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.StartDate != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.startDate", *body.StartDate, goa.FormatDateTime))
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.EndDate != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.endDate", *body.EndDate, goa.FormatDateTime))
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
-	if body.UserID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
+	return
+}
+
+// ValidateImportForbiddenResponseBody runs the validations defined on
+// import_forbidden_response_body
+func ValidateImportForbiddenResponseBody(body *ImportForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetResponseBody runs the validations defined on GetResponseBody
-func ValidateGetResponseBody(body *GetResponseBody) (err error) {
+// ValidateImportInternalServerErrorResponseBody runs the validations defined
+// on import_internalServerError_response_body
+func ValidateImportInternalServerErrorResponseBody(body *ImportInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateImportNotFoundResponseBody runs the validations defined on
+// import_notFound_response_body
+func ValidateImportNotFoundResponseBody(body *ImportNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateImportUnauthorizedResponseBody runs the validations defined on
+// import_unauthorized_response_body
+func ValidateImportUnauthorizedResponseBody(body *ImportUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFieldErrorResponseBody runs the validations defined on
+// FieldErrorResponseBody
+func ValidateFieldErrorResponseBody(body *FieldErrorResponseBody) (err error) {
+	if body.Field == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("field", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateTrainingPlanResponse runs the validations defined on
+// TrainingPlanResponse
+func ValidateTrainingPlanResponse(body *TrainingPlanResponse) (err error) {
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
@@ -251,11 +3357,15 @@ func ValidateGetResponseBody(body *GetResponseBody) (err error) {
 	if body.UserID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
 	}
+	if body.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deletedAt", *body.DeletedAt, goa.FormatDateTime))
+	}
 	return
 }
 
-// ValidateUpdateResponseBody runs the validations defined on UpdateResponseBody
-func ValidateUpdateResponseBody(body *UpdateResponseBody) (err error) {
+// ValidatePlanTemplateResponse runs the validations defined on
+// PlanTemplateResponse
+func ValidatePlanTemplateResponse(body *PlanTemplateResponse) (err error) {
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
@@ -268,8 +3378,8 @@ func ValidateUpdateResponseBody(body *UpdateResponseBody) (err error) {
 	if body.EndDate == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("endDate", "body"))
 	}
-	if body.UserID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	if body.Public == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("public", "body"))
 	}
 	if body.ID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
@@ -280,15 +3390,138 @@ func ValidateUpdateResponseBody(body *UpdateResponseBody) (err error) {
 	if body.EndDate != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.endDate", *body.EndDate, goa.FormatDateTime))
 	}
-	if body.UserID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
+	if body.OwnerID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.ownerId", *body.OwnerID, goa.FormatUUID))
 	}
 	return
 }
 
-// ValidateTrainingPlanResponse runs the validations defined on
-// TrainingPlanResponse
-func ValidateTrainingPlanResponse(body *TrainingPlanResponse) (err error) {
+// ValidatePlanDocumentPlanRequestBodyRequestBody runs the validations defined
+// on PlanDocumentPlanRequestBodyRequestBody
+func ValidatePlanDocumentPlanRequestBodyRequestBody(body *PlanDocumentPlanRequestBodyRequestBody) (err error) {
+	if body.Workouts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("workouts", "body"))
+	}
+	if utf8.RuneCountInString(body.Name) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("body.startDate", body.StartDate, goa.FormatDateTime))
+	err = goa.MergeErrors(err, goa.ValidateFormat("body.endDate", body.EndDate, goa.FormatDateTime))
+	for _, e := range body.Workouts {
+		if e != nil {
+			if err2 := ValidatePlanDocumentWorkoutRequestBodyRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePlanDocumentWorkoutRequestBodyRequestBody runs the validations
+// defined on PlanDocumentWorkoutRequestBodyRequestBody
+func ValidatePlanDocumentWorkoutRequestBodyRequestBody(body *PlanDocumentWorkoutRequestBodyRequestBody) (err error) {
+	if body.Exercises == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercises", "body"))
+	}
+	if utf8.RuneCountInString(body.Name) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+	}
+	for _, e := range body.ScheduledDates {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.scheduledDates[*]", e, goa.FormatDate))
+	}
+	for _, e := range body.Exercises {
+		if e != nil {
+			if err2 := ValidatePlanDocumentExerciseRequestBodyRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePlanDocumentExerciseRequestBodyRequestBody runs the validations
+// defined on PlanDocumentExerciseRequestBodyRequestBody
+func ValidatePlanDocumentExerciseRequestBodyRequestBody(body *PlanDocumentExerciseRequestBodyRequestBody) (err error) {
+	if body.Sets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sets", "body"))
+	}
+	if utf8.RuneCountInString(body.Name) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+	}
+	for _, e := range body.Sets {
+		if e != nil {
+			if err2 := ValidatePlanDocumentSetRequestBodyRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Progression != nil {
+		if err2 := ValidatePlanDocumentProgressionRequestBodyRequestBody(body.Progression); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidatePlanDocumentSetRequestBodyRequestBody runs the validations defined
+// on PlanDocumentSetRequestBodyRequestBody
+func ValidatePlanDocumentSetRequestBodyRequestBody(body *PlanDocumentSetRequestBodyRequestBody) (err error) {
+	if body.Weight < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", body.Weight, 0, true))
+	}
+	if body.Reps < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.reps", body.Reps, 0, true))
+	}
+	if body.RestTime < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.restTime", body.RestTime, 0, true))
+	}
+	return
+}
+
+// ValidatePlanDocumentProgressionRequestBodyRequestBody runs the validations
+// defined on PlanDocumentProgressionRequestBodyRequestBody
+func ValidatePlanDocumentProgressionRequestBodyRequestBody(body *PlanDocumentProgressionRequestBodyRequestBody) (err error) {
+	if !(body.Kind == "linear" || body.Kind == "double_progression" || body.Kind == "percentage_of_one_rep_max") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", body.Kind, []any{"linear", "double_progression", "percentage_of_one_rep_max"}))
+	}
+	if body.Increment < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.increment", body.Increment, 0, true))
+	}
+	if body.MinReps != nil {
+		if *body.MinReps < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.minReps", *body.MinReps, 1, true))
+		}
+	}
+	if body.MaxReps != nil {
+		if *body.MaxReps < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.maxReps", *body.MaxReps, 1, true))
+		}
+	}
+	if body.Percentage != nil {
+		if *body.Percentage < 0.3 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.percentage", *body.Percentage, 0.3, true))
+		}
+	}
+	if body.Percentage != nil {
+		if *body.Percentage > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.percentage", *body.Percentage, 1, false))
+		}
+	}
+	if body.DeloadAfter < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.deloadAfter", body.DeloadAfter, 0, true))
+	}
+	if body.DeloadPercentage < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.deloadPercentage", body.DeloadPercentage, 0, true))
+	}
+	if body.DeloadPercentage > 0.5 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.deloadPercentage", body.DeloadPercentage, 0.5, false))
+	}
+	return
+}
+
+// ValidateTrainingPlanResponseBody runs the validations defined on
+// TrainingPlanResponseBody
+func ValidateTrainingPlanResponseBody(body *TrainingPlanResponseBody) (err error) {
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
@@ -316,5 +3549,8 @@ func ValidateTrainingPlanResponse(body *TrainingPlanResponse) (err error) {
 	if body.UserID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
 	}
+	if body.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deletedAt", *body.DeletedAt, goa.FormatDateTime))
+	}
 	return
 }
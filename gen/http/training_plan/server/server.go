@@ -9,7 +9,9 @@ package server
 
 import (
 	trainingplan "be/gen/training_plan"
+	"bufio"
 	"context"
+	"io"
 	"net/http"
 
 	goahttp "goa.design/goa/v3/http"
@@ -18,12 +20,20 @@ import (
 
 // Server lists the training_plan service endpoint HTTP handlers.
 type Server struct {
-	Mounts []*MountPoint
-	Create http.Handler
-	Get    http.Handler
-	List   http.Handler
-	Update http.Handler
-	Delete http.Handler
+	Mounts         []*MountPoint
+	Create         http.Handler
+	Get            http.Handler
+	List           http.Handler
+	Update         http.Handler
+	Patch          http.Handler
+	Delete         http.Handler
+	Restore        http.Handler
+	ListDeleted    http.Handler
+	Clone          http.Handler
+	CreateTemplate http.Handler
+	ListTemplates  http.Handler
+	Export         http.Handler
+	Import         http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -57,13 +67,29 @@ func New(
 			{"Get", "GET", "/api/v1/training-plans/{id}"},
 			{"List", "GET", "/api/v1/training-plans"},
 			{"Update", "PUT", "/api/v1/training-plans/{id}"},
+			{"Patch", "PATCH", "/api/v1/training-plans/{id}"},
 			{"Delete", "DELETE", "/api/v1/training-plans/{id}"},
+			{"Restore", "POST", "/api/v1/training-plans/{id}/restore"},
+			{"ListDeleted", "GET", "/api/v1/training-plans/deleted"},
+			{"Clone", "POST", "/api/v1/training-plans/{id}/clone"},
+			{"CreateTemplate", "POST", "/api/v1/training-plans/{id}/template"},
+			{"ListTemplates", "GET", "/api/v1/training-plans/templates"},
+			{"Export", "GET", "/api/v1/training-plans/{id}/export"},
+			{"Import", "POST", "/api/v1/training-plans/import"},
 		},
-		Create: NewCreateHandler(e.Create, mux, decoder, encoder, errhandler, formatter),
-		Get:    NewGetHandler(e.Get, mux, decoder, encoder, errhandler, formatter),
-		List:   NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
-		Update: NewUpdateHandler(e.Update, mux, decoder, encoder, errhandler, formatter),
-		Delete: NewDeleteHandler(e.Delete, mux, decoder, encoder, errhandler, formatter),
+		Create:         NewCreateHandler(e.Create, mux, decoder, encoder, errhandler, formatter),
+		Get:            NewGetHandler(e.Get, mux, decoder, encoder, errhandler, formatter),
+		List:           NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
+		Update:         NewUpdateHandler(e.Update, mux, decoder, encoder, errhandler, formatter),
+		Patch:          NewPatchHandler(e.Patch, mux, decoder, encoder, errhandler, formatter),
+		Delete:         NewDeleteHandler(e.Delete, mux, decoder, encoder, errhandler, formatter),
+		Restore:        NewRestoreHandler(e.Restore, mux, decoder, encoder, errhandler, formatter),
+		ListDeleted:    NewListDeletedHandler(e.ListDeleted, mux, decoder, encoder, errhandler, formatter),
+		Clone:          NewCloneHandler(e.Clone, mux, decoder, encoder, errhandler, formatter),
+		CreateTemplate: NewCreateTemplateHandler(e.CreateTemplate, mux, decoder, encoder, errhandler, formatter),
+		ListTemplates:  NewListTemplatesHandler(e.ListTemplates, mux, decoder, encoder, errhandler, formatter),
+		Export:         NewExportHandler(e.Export, mux, decoder, encoder, errhandler, formatter),
+		Import:         NewImportHandler(e.Import, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -76,7 +102,15 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Get = m(s.Get)
 	s.List = m(s.List)
 	s.Update = m(s.Update)
+	s.Patch = m(s.Patch)
 	s.Delete = m(s.Delete)
+	s.Restore = m(s.Restore)
+	s.ListDeleted = m(s.ListDeleted)
+	s.Clone = m(s.Clone)
+	s.CreateTemplate = m(s.CreateTemplate)
+	s.ListTemplates = m(s.ListTemplates)
+	s.Export = m(s.Export)
+	s.Import = m(s.Import)
 }
 
 // MethodNames returns the methods served.
@@ -88,7 +122,15 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountGetHandler(mux, h.Get)
 	MountListHandler(mux, h.List)
 	MountUpdateHandler(mux, h.Update)
+	MountPatchHandler(mux, h.Patch)
 	MountDeleteHandler(mux, h.Delete)
+	MountRestoreHandler(mux, h.Restore)
+	MountListDeletedHandler(mux, h.ListDeleted)
+	MountCloneHandler(mux, h.Clone)
+	MountCreateTemplateHandler(mux, h.CreateTemplate)
+	MountListTemplatesHandler(mux, h.ListTemplates)
+	MountExportHandler(mux, h.Export)
+	MountImportHandler(mux, h.Import)
 }
 
 // Mount configures the mux to serve the training_plan endpoints.
@@ -121,7 +163,7 @@ func NewCreateHandler(
 	var (
 		decodeRequest  = DecodeCreateRequest(mux, decoder)
 		encodeResponse = EncodeCreateResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeCreateError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -172,7 +214,7 @@ func NewGetHandler(
 	var (
 		decodeRequest  = DecodeGetRequest(mux, decoder)
 		encodeResponse = EncodeGetResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeGetError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -223,7 +265,7 @@ func NewListHandler(
 	var (
 		decodeRequest  = DecodeListRequest(mux, decoder)
 		encodeResponse = EncodeListResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeListError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -274,7 +316,7 @@ func NewUpdateHandler(
 	var (
 		decodeRequest  = DecodeUpdateRequest(mux, decoder)
 		encodeResponse = EncodeUpdateResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeUpdateError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -300,6 +342,58 @@ func NewUpdateHandler(
 	})
 }
 
+// MountPatchHandler configures the mux to serve the "training_plan" service
+// "patch" endpoint.
+func MountPatchHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PATCH", "/api/v1/training-plans/{id}", f)
+}
+
+// NewPatchHandler creates a HTTP handler which loads the HTTP request and
+// calls the "training_plan" service "patch" endpoint.
+func NewPatchHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePatchRequest(mux, decoder)
+		encodeResponse = EncodePatchResponse(encoder)
+		encodeError    = EncodePatchError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "patch")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "training_plan")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		data := &trainingplan.PatchRequestData{Payload: payload.(*trainingplan.PatchPayload), Body: r.Body}
+		res, err := endpoint(ctx, data)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountDeleteHandler configures the mux to serve the "training_plan" service
 // "delete" endpoint.
 func MountDeleteHandler(mux goahttp.Muxer, h http.Handler) {
@@ -325,7 +419,7 @@ func NewDeleteHandler(
 	var (
 		decodeRequest  = DecodeDeleteRequest(mux, decoder)
 		encodeResponse = EncodeDeleteResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeDeleteError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -350,3 +444,397 @@ func NewDeleteHandler(
 		}
 	})
 }
+
+// MountRestoreHandler configures the mux to serve the "training_plan" service
+// "restore" endpoint.
+func MountRestoreHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/v1/training-plans/{id}/restore", f)
+}
+
+// NewRestoreHandler creates a HTTP handler which loads the HTTP request and
+// calls the "training_plan" service "restore" endpoint.
+func NewRestoreHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRestoreRequest(mux, decoder)
+		encodeResponse = EncodeRestoreResponse(encoder)
+		encodeError    = EncodeRestoreError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "restore")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "training_plan")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListDeletedHandler configures the mux to serve the "training_plan"
+// service "listDeleted" endpoint.
+func MountListDeletedHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/training-plans/deleted", f)
+}
+
+// NewListDeletedHandler creates a HTTP handler which loads the HTTP request
+// and calls the "training_plan" service "listDeleted" endpoint.
+func NewListDeletedHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListDeletedRequest(mux, decoder)
+		encodeResponse = EncodeListDeletedResponse(encoder)
+		encodeError    = EncodeListDeletedError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listDeleted")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "training_plan")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCloneHandler configures the mux to serve the "training_plan" service
+// "clone" endpoint.
+func MountCloneHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/v1/training-plans/{id}/clone", f)
+}
+
+// NewCloneHandler creates a HTTP handler which loads the HTTP request and
+// calls the "training_plan" service "clone" endpoint.
+func NewCloneHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCloneRequest(mux, decoder)
+		encodeResponse = EncodeCloneResponse(encoder)
+		encodeError    = EncodeCloneError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "clone")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "training_plan")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCreateTemplateHandler configures the mux to serve the "training_plan"
+// service "createTemplate" endpoint.
+func MountCreateTemplateHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/v1/training-plans/{id}/template", f)
+}
+
+// NewCreateTemplateHandler creates a HTTP handler which loads the HTTP request
+// and calls the "training_plan" service "createTemplate" endpoint.
+func NewCreateTemplateHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateTemplateRequest(mux, decoder)
+		encodeResponse = EncodeCreateTemplateResponse(encoder)
+		encodeError    = EncodeCreateTemplateError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "createTemplate")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "training_plan")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListTemplatesHandler configures the mux to serve the "training_plan"
+// service "listTemplates" endpoint.
+func MountListTemplatesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/training-plans/templates", f)
+}
+
+// NewListTemplatesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "training_plan" service "listTemplates" endpoint.
+func NewListTemplatesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListTemplatesRequest(mux, decoder)
+		encodeResponse = EncodeListTemplatesResponse(encoder)
+		encodeError    = EncodeListTemplatesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listTemplates")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "training_plan")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountExportHandler configures the mux to serve the "training_plan" service
+// "export" endpoint.
+func MountExportHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/training-plans/{id}/export", f)
+}
+
+// NewExportHandler creates a HTTP handler which loads the HTTP request and
+// calls the "training_plan" service "export" endpoint.
+func NewExportHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeExportRequest(mux, decoder)
+		encodeResponse = EncodeExportResponse(encoder)
+		encodeError    = EncodeExportError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "export")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "training_plan")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		o := res.(*trainingplan.ExportResponseData)
+		defer o.Body.Close()
+		if wt, ok := o.Body.(io.WriterTo); ok {
+			if err := encodeResponse(ctx, w, o.Result); err != nil {
+				errhandler(ctx, w, err)
+				return
+			}
+			n, err := wt.WriteTo(w)
+			if err != nil {
+				if n == 0 {
+					if err := encodeError(ctx, w, err); err != nil {
+						errhandler(ctx, w, err)
+					}
+				} else {
+					if f, ok := w.(http.Flusher); ok {
+						f.Flush()
+					}
+					panic(http.ErrAbortHandler) // too late to write an error
+				}
+			}
+			return
+		}
+		// handle immediate read error like a returned error
+		buf := bufio.NewReader(o.Body)
+		if _, err := buf.Peek(1); err != nil && err != io.EOF {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, o.Result); err != nil {
+			errhandler(ctx, w, err)
+			return
+		}
+		if _, err := io.Copy(w, buf); err != nil {
+			if f, ok := w.(http.Flusher); ok {
+				f.Flush()
+			}
+			panic(http.ErrAbortHandler) // too late to write an error
+		}
+	})
+}
+
+// MountImportHandler configures the mux to serve the "training_plan" service
+// "import" endpoint.
+func MountImportHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/v1/training-plans/import", f)
+}
+
+// NewImportHandler creates a HTTP handler which loads the HTTP request and
+// calls the "training_plan" service "import" endpoint.
+func NewImportHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeImportRequest(mux, decoder)
+		encodeResponse = EncodeImportResponse(encoder)
+		encodeError    = EncodeImportError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "import")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "training_plan")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
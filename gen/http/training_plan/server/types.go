@@ -38,6 +38,37 @@ type UpdateRequestBody struct {
 	UserID      *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
 }
 
+// CloneRequestBody is the type of the "training_plan" service "clone" endpoint
+// HTTP request body.
+type CloneRequestBody struct {
+	// Athlete receiving the plan, defaults to the caller
+	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Start date of the new plan
+	StartDate *string `form:"startDate,omitempty" json:"startDate,omitempty" xml:"startDate,omitempty"`
+	// Name of the new plan, defaults to the source's
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+}
+
+// CreateTemplateRequestBody is the type of the "training_plan" service
+// "createTemplate" endpoint HTTP request body.
+type CreateTemplateRequestBody struct {
+	// Name of the template, defaults to the plan's
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Whether every user can clone the template
+	Public *bool `form:"public,omitempty" json:"public,omitempty" xml:"public,omitempty"`
+}
+
+// ImportRequestBody is the type of the "training_plan" service "import"
+// endpoint HTTP request body.
+type ImportRequestBody struct {
+	// Document format version
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// When the document was exported
+	ExportedAt *string `form:"exportedAt,omitempty" json:"exportedAt,omitempty" xml:"exportedAt,omitempty"`
+	// The plan
+	Plan *PlanDocumentPlanRequestBodyRequestBody `form:"plan,omitempty" json:"plan,omitempty" xml:"plan,omitempty"`
+}
+
 // CreateResponseBody is the type of the "training_plan" service "create"
 // endpoint HTTP response body.
 type CreateResponseBody struct {
@@ -53,6 +84,11 @@ type CreateResponseBody struct {
 	EndDate string `form:"endDate" json:"endDate" xml:"endDate"`
 	// ID of the user who owns the plan
 	UserID string `form:"userId" json:"userId" xml:"userId"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
 }
 
 // GetResponseBody is the type of the "training_plan" service "get" endpoint
@@ -70,93 +106,1894 @@ type GetResponseBody struct {
 	EndDate string `form:"endDate" json:"endDate" xml:"endDate"`
 	// ID of the user who owns the plan
 	UserID string `form:"userId" json:"userId" xml:"userId"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
 }
 
 // ListResponseBody is the type of the "training_plan" service "list" endpoint
 // HTTP response body.
 type ListResponseBody []*TrainingPlanResponse
 
-// UpdateResponseBody is the type of the "training_plan" service "update"
-// endpoint HTTP response body.
-type UpdateResponseBody struct {
-	// TrainingPlan ID
-	ID string `form:"id" json:"id" xml:"id"`
-	// Name of the training plan
-	Name string `form:"name" json:"name" xml:"name"`
-	// Description of the plan
-	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
-	// Start date in ISO 8601
-	StartDate string `form:"startDate" json:"startDate" xml:"startDate"`
-	// End date in ISO 8601
-	EndDate string `form:"endDate" json:"endDate" xml:"endDate"`
-	// ID of the user who owns the plan
-	UserID string `form:"userId" json:"userId" xml:"userId"`
+// UpdateResponseBody is the type of the "training_plan" service "update"
+// endpoint HTTP response body.
+type UpdateResponseBody struct {
+	// TrainingPlan ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Name of the training plan
+	Name string `form:"name" json:"name" xml:"name"`
+	// Description of the plan
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Start date in ISO 8601
+	StartDate string `form:"startDate" json:"startDate" xml:"startDate"`
+	// End date in ISO 8601
+	EndDate string `form:"endDate" json:"endDate" xml:"endDate"`
+	// ID of the user who owns the plan
+	UserID string `form:"userId" json:"userId" xml:"userId"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+}
+
+// PatchResponseBody is the type of the "training_plan" service "patch"
+// endpoint HTTP response body.
+type PatchResponseBody struct {
+	// TrainingPlan ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Name of the training plan
+	Name string `form:"name" json:"name" xml:"name"`
+	// Description of the plan
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Start date in ISO 8601
+	StartDate string `form:"startDate" json:"startDate" xml:"startDate"`
+	// End date in ISO 8601
+	EndDate string `form:"endDate" json:"endDate" xml:"endDate"`
+	// ID of the user who owns the plan
+	UserID string `form:"userId" json:"userId" xml:"userId"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+}
+
+// RestoreResponseBody is the type of the "training_plan" service "restore"
+// endpoint HTTP response body.
+type RestoreResponseBody struct {
+	// TrainingPlan ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Name of the training plan
+	Name string `form:"name" json:"name" xml:"name"`
+	// Description of the plan
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Start date in ISO 8601
+	StartDate string `form:"startDate" json:"startDate" xml:"startDate"`
+	// End date in ISO 8601
+	EndDate string `form:"endDate" json:"endDate" xml:"endDate"`
+	// ID of the user who owns the plan
+	UserID string `form:"userId" json:"userId" xml:"userId"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+}
+
+// ListDeletedResponseBody is the type of the "training_plan" service
+// "listDeleted" endpoint HTTP response body.
+type ListDeletedResponseBody []*TrainingPlanResponse
+
+// CloneResponseBody is the type of the "training_plan" service "clone"
+// endpoint HTTP response body.
+type CloneResponseBody struct {
+	// TrainingPlan ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Name of the training plan
+	Name string `form:"name" json:"name" xml:"name"`
+	// Description of the plan
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Start date in ISO 8601
+	StartDate string `form:"startDate" json:"startDate" xml:"startDate"`
+	// End date in ISO 8601
+	EndDate string `form:"endDate" json:"endDate" xml:"endDate"`
+	// ID of the user who owns the plan
+	UserID string `form:"userId" json:"userId" xml:"userId"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+}
+
+// CreateTemplateResponseBody is the type of the "training_plan" service
+// "createTemplate" endpoint HTTP response body.
+type CreateTemplateResponseBody struct {
+	// Template ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Name of the template
+	Name string `form:"name" json:"name" xml:"name"`
+	// Description of the template
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Anchor date of the template's schedule
+	StartDate string `form:"startDate" json:"startDate" xml:"startDate"`
+	// End of the template's schedule
+	EndDate string `form:"endDate" json:"endDate" xml:"endDate"`
+	// Coach owning the template
+	OwnerID *string `form:"ownerId,omitempty" json:"ownerId,omitempty" xml:"ownerId,omitempty"`
+	// Whether every user can clone the template
+	Public bool `form:"public" json:"public" xml:"public"`
+}
+
+// ListTemplatesResponseBody is the type of the "training_plan" service
+// "listTemplates" endpoint HTTP response body.
+type ListTemplatesResponseBody []*PlanTemplateResponse
+
+// ImportResponseBody is the type of the "training_plan" service "import"
+// endpoint HTTP response body.
+type ImportResponseBody struct {
+	// The imported plan
+	Plan *TrainingPlanResponseBody `form:"plan" json:"plan" xml:"plan"`
+	// Workouts created
+	Workouts int `form:"workouts" json:"workouts" xml:"workouts"`
+	// Exercises created
+	Exercises int `form:"exercises" json:"exercises" xml:"exercises"`
+	// Planned sets created
+	Sets int `form:"sets" json:"sets" xml:"sets"`
+	// Exercise type names not found in the catalog; their exercises were skipped
+	Unmatched []string `form:"unmatched" json:"unmatched" xml:"unmatched"`
+}
+
+// CreateBadRequestResponseBody is the type of the "training_plan" service
+// "create" endpoint HTTP response body for the "badRequest" error.
+type CreateBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// CreateForbiddenResponseBody is the type of the "training_plan" service
+// "create" endpoint HTTP response body for the "forbidden" error.
+type CreateForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateInternalServerErrorResponseBody is the type of the "training_plan"
+// service "create" endpoint HTTP response body for the "internalServerError"
+// error.
+type CreateInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateNotFoundResponseBody is the type of the "training_plan" service
+// "create" endpoint HTTP response body for the "notFound" error.
+type CreateNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateUnauthorizedResponseBody is the type of the "training_plan" service
+// "create" endpoint HTTP response body for the "unauthorized" error.
+type CreateUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetBadRequestResponseBody is the type of the "training_plan" service "get"
+// endpoint HTTP response body for the "badRequest" error.
+type GetBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// GetForbiddenResponseBody is the type of the "training_plan" service "get"
+// endpoint HTTP response body for the "forbidden" error.
+type GetForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetInternalServerErrorResponseBody is the type of the "training_plan"
+// service "get" endpoint HTTP response body for the "internalServerError"
+// error.
+type GetInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetNotFoundResponseBody is the type of the "training_plan" service "get"
+// endpoint HTTP response body for the "notFound" error.
+type GetNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetUnauthorizedResponseBody is the type of the "training_plan" service "get"
+// endpoint HTTP response body for the "unauthorized" error.
+type GetUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListBadRequestResponseBody is the type of the "training_plan" service "list"
+// endpoint HTTP response body for the "badRequest" error.
+type ListBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListForbiddenResponseBody is the type of the "training_plan" service "list"
+// endpoint HTTP response body for the "forbidden" error.
+type ListForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListInternalServerErrorResponseBody is the type of the "training_plan"
+// service "list" endpoint HTTP response body for the "internalServerError"
+// error.
+type ListInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListNotFoundResponseBody is the type of the "training_plan" service "list"
+// endpoint HTTP response body for the "notFound" error.
+type ListNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListUnauthorizedResponseBody is the type of the "training_plan" service
+// "list" endpoint HTTP response body for the "unauthorized" error.
+type ListUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateBadRequestResponseBody is the type of the "training_plan" service
+// "update" endpoint HTTP response body for the "badRequest" error.
+type UpdateBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// UpdateForbiddenResponseBody is the type of the "training_plan" service
+// "update" endpoint HTTP response body for the "forbidden" error.
+type UpdateForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateInternalServerErrorResponseBody is the type of the "training_plan"
+// service "update" endpoint HTTP response body for the "internalServerError"
+// error.
+type UpdateInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateNotFoundResponseBody is the type of the "training_plan" service
+// "update" endpoint HTTP response body for the "notFound" error.
+type UpdateNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdatePreconditionFailedResponseBody is the type of the "training_plan"
+// service "update" endpoint HTTP response body for the "preconditionFailed"
+// error.
+type UpdatePreconditionFailedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateUnauthorizedResponseBody is the type of the "training_plan" service
+// "update" endpoint HTTP response body for the "unauthorized" error.
+type UpdateUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PatchBadRequestResponseBody is the type of the "training_plan" service
+// "patch" endpoint HTTP response body for the "badRequest" error.
+type PatchBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// PatchForbiddenResponseBody is the type of the "training_plan" service
+// "patch" endpoint HTTP response body for the "forbidden" error.
+type PatchForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PatchInternalServerErrorResponseBody is the type of the "training_plan"
+// service "patch" endpoint HTTP response body for the "internalServerError"
+// error.
+type PatchInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PatchNotFoundResponseBody is the type of the "training_plan" service "patch"
+// endpoint HTTP response body for the "notFound" error.
+type PatchNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PatchPreconditionFailedResponseBody is the type of the "training_plan"
+// service "patch" endpoint HTTP response body for the "preconditionFailed"
+// error.
+type PatchPreconditionFailedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PatchUnauthorizedResponseBody is the type of the "training_plan" service
+// "patch" endpoint HTTP response body for the "unauthorized" error.
+type PatchUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteBadRequestResponseBody is the type of the "training_plan" service
+// "delete" endpoint HTTP response body for the "badRequest" error.
+type DeleteBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// DeleteForbiddenResponseBody is the type of the "training_plan" service
+// "delete" endpoint HTTP response body for the "forbidden" error.
+type DeleteForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteInternalServerErrorResponseBody is the type of the "training_plan"
+// service "delete" endpoint HTTP response body for the "internalServerError"
+// error.
+type DeleteInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteNotFoundResponseBody is the type of the "training_plan" service
+// "delete" endpoint HTTP response body for the "notFound" error.
+type DeleteNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeletePreconditionFailedResponseBody is the type of the "training_plan"
+// service "delete" endpoint HTTP response body for the "preconditionFailed"
+// error.
+type DeletePreconditionFailedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteUnauthorizedResponseBody is the type of the "training_plan" service
+// "delete" endpoint HTTP response body for the "unauthorized" error.
+type DeleteUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RestoreBadRequestResponseBody is the type of the "training_plan" service
+// "restore" endpoint HTTP response body for the "badRequest" error.
+type RestoreBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// RestoreForbiddenResponseBody is the type of the "training_plan" service
+// "restore" endpoint HTTP response body for the "forbidden" error.
+type RestoreForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RestoreInternalServerErrorResponseBody is the type of the "training_plan"
+// service "restore" endpoint HTTP response body for the "internalServerError"
+// error.
+type RestoreInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RestoreNotFoundResponseBody is the type of the "training_plan" service
+// "restore" endpoint HTTP response body for the "notFound" error.
+type RestoreNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RestoreUnauthorizedResponseBody is the type of the "training_plan" service
+// "restore" endpoint HTTP response body for the "unauthorized" error.
+type RestoreUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListDeletedBadRequestResponseBody is the type of the "training_plan" service
+// "listDeleted" endpoint HTTP response body for the "badRequest" error.
+type ListDeletedBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListDeletedForbiddenResponseBody is the type of the "training_plan" service
+// "listDeleted" endpoint HTTP response body for the "forbidden" error.
+type ListDeletedForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListDeletedInternalServerErrorResponseBody is the type of the
+// "training_plan" service "listDeleted" endpoint HTTP response body for the
+// "internalServerError" error.
+type ListDeletedInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListDeletedNotFoundResponseBody is the type of the "training_plan" service
+// "listDeleted" endpoint HTTP response body for the "notFound" error.
+type ListDeletedNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListDeletedUnauthorizedResponseBody is the type of the "training_plan"
+// service "listDeleted" endpoint HTTP response body for the "unauthorized"
+// error.
+type ListDeletedUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CloneBadRequestResponseBody is the type of the "training_plan" service
+// "clone" endpoint HTTP response body for the "badRequest" error.
+type CloneBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// CloneForbiddenResponseBody is the type of the "training_plan" service
+// "clone" endpoint HTTP response body for the "forbidden" error.
+type CloneForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CloneInternalServerErrorResponseBody is the type of the "training_plan"
+// service "clone" endpoint HTTP response body for the "internalServerError"
+// error.
+type CloneInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CloneNotFoundResponseBody is the type of the "training_plan" service "clone"
+// endpoint HTTP response body for the "notFound" error.
+type CloneNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CloneUnauthorizedResponseBody is the type of the "training_plan" service
+// "clone" endpoint HTTP response body for the "unauthorized" error.
+type CloneUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateTemplateBadRequestResponseBody is the type of the "training_plan"
+// service "createTemplate" endpoint HTTP response body for the "badRequest"
+// error.
+type CreateTemplateBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// CreateTemplateForbiddenResponseBody is the type of the "training_plan"
+// service "createTemplate" endpoint HTTP response body for the "forbidden"
+// error.
+type CreateTemplateForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateTemplateInternalServerErrorResponseBody is the type of the
+// "training_plan" service "createTemplate" endpoint HTTP response body for the
+// "internalServerError" error.
+type CreateTemplateInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateTemplateNotFoundResponseBody is the type of the "training_plan"
+// service "createTemplate" endpoint HTTP response body for the "notFound"
+// error.
+type CreateTemplateNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateTemplateUnauthorizedResponseBody is the type of the "training_plan"
+// service "createTemplate" endpoint HTTP response body for the "unauthorized"
+// error.
+type CreateTemplateUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListTemplatesBadRequestResponseBody is the type of the "training_plan"
+// service "listTemplates" endpoint HTTP response body for the "badRequest"
+// error.
+type ListTemplatesBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListTemplatesForbiddenResponseBody is the type of the "training_plan"
+// service "listTemplates" endpoint HTTP response body for the "forbidden"
+// error.
+type ListTemplatesForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListTemplatesInternalServerErrorResponseBody is the type of the
+// "training_plan" service "listTemplates" endpoint HTTP response body for the
+// "internalServerError" error.
+type ListTemplatesInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListTemplatesNotFoundResponseBody is the type of the "training_plan" service
+// "listTemplates" endpoint HTTP response body for the "notFound" error.
+type ListTemplatesNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListTemplatesUnauthorizedResponseBody is the type of the "training_plan"
+// service "listTemplates" endpoint HTTP response body for the "unauthorized"
+// error.
+type ListTemplatesUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ExportBadRequestResponseBody is the type of the "training_plan" service
+// "export" endpoint HTTP response body for the "badRequest" error.
+type ExportBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ExportForbiddenResponseBody is the type of the "training_plan" service
+// "export" endpoint HTTP response body for the "forbidden" error.
+type ExportForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ExportInternalServerErrorResponseBody is the type of the "training_plan"
+// service "export" endpoint HTTP response body for the "internalServerError"
+// error.
+type ExportInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ExportNotFoundResponseBody is the type of the "training_plan" service
+// "export" endpoint HTTP response body for the "notFound" error.
+type ExportNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ExportUnauthorizedResponseBody is the type of the "training_plan" service
+// "export" endpoint HTTP response body for the "unauthorized" error.
+type ExportUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ImportBadRequestResponseBody is the type of the "training_plan" service
+// "import" endpoint HTTP response body for the "badRequest" error.
+type ImportBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ImportForbiddenResponseBody is the type of the "training_plan" service
+// "import" endpoint HTTP response body for the "forbidden" error.
+type ImportForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ImportInternalServerErrorResponseBody is the type of the "training_plan"
+// service "import" endpoint HTTP response body for the "internalServerError"
+// error.
+type ImportInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ImportNotFoundResponseBody is the type of the "training_plan" service
+// "import" endpoint HTTP response body for the "notFound" error.
+type ImportNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ImportUnauthorizedResponseBody is the type of the "training_plan" service
+// "import" endpoint HTTP response body for the "unauthorized" error.
+type ImportUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field string `form:"field" json:"field" xml:"field"`
+	// Motivo per cui il campo non è valido
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// TrainingPlanResponse is used to define fields on response body types.
+type TrainingPlanResponse struct {
+	// TrainingPlan ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Name of the training plan
+	Name string `form:"name" json:"name" xml:"name"`
+	// Description of the plan
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Start date in ISO 8601
+	StartDate string `form:"startDate" json:"startDate" xml:"startDate"`
+	// End date in ISO 8601
+	EndDate string `form:"endDate" json:"endDate" xml:"endDate"`
+	// ID of the user who owns the plan
+	UserID string `form:"userId" json:"userId" xml:"userId"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+	// Entity tag of the current version, to send back in If-Match when changing it
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty" xml:"etag,omitempty"`
+}
+
+// PlanTemplateResponse is used to define fields on response body types.
+type PlanTemplateResponse struct {
+	// Template ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Name of the template
+	Name string `form:"name" json:"name" xml:"name"`
+	// Description of the template
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Anchor date of the template's schedule
+	StartDate string `form:"startDate" json:"startDate" xml:"startDate"`
+	// End of the template's schedule
+	EndDate string `form:"endDate" json:"endDate" xml:"endDate"`
+	// Coach owning the template
+	OwnerID *string `form:"ownerId,omitempty" json:"ownerId,omitempty" xml:"ownerId,omitempty"`
+	// Whether every user can clone the template
+	Public bool `form:"public" json:"public" xml:"public"`
+}
+
+// TrainingPlanResponseBody is used to define fields on response body types.
+type TrainingPlanResponseBody struct {
+	// TrainingPlan ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Name of the training plan
+	Name string `form:"name" json:"name" xml:"name"`
+	// Description of the plan
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Start date in ISO 8601
+	StartDate string `form:"startDate" json:"startDate" xml:"startDate"`
+	// End date in ISO 8601
+	EndDate string `form:"endDate" json:"endDate" xml:"endDate"`
+	// ID of the user who owns the plan
+	UserID string `form:"userId" json:"userId" xml:"userId"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+	// Entity tag of the current version, to send back in If-Match when changing it
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty" xml:"etag,omitempty"`
+}
+
+// PlanDocumentPlanRequestBodyRequestBody is used to define fields on request
+// body types.
+type PlanDocumentPlanRequestBodyRequestBody struct {
+	// Name of the plan
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Description of the plan
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Start date in ISO 8601
+	StartDate *string `form:"startDate,omitempty" json:"startDate,omitempty" xml:"startDate,omitempty"`
+	// End date in ISO 8601
+	EndDate *string `form:"endDate,omitempty" json:"endDate,omitempty" xml:"endDate,omitempty"`
+	// Workouts of the plan
+	Workouts []*PlanDocumentWorkoutRequestBodyRequestBody `form:"workouts,omitempty" json:"workouts,omitempty" xml:"workouts,omitempty"`
+}
+
+// PlanDocumentWorkoutRequestBodyRequestBody is used to define fields on
+// request body types.
+type PlanDocumentWorkoutRequestBodyRequestBody struct {
+	// Workout name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Order among the workouts of the same day
+	Position *int `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	// Specific dates the workout is planned on
+	ScheduledDates []string `form:"scheduledDates,omitempty" json:"scheduledDates,omitempty" xml:"scheduledDates,omitempty"`
+	// RRULE-style recurrence within the plan range
+	Recurrence *string `form:"recurrence,omitempty" json:"recurrence,omitempty" xml:"recurrence,omitempty"`
+	// Exercises of the workout
+	Exercises []*PlanDocumentExerciseRequestBodyRequestBody `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+}
+
+// PlanDocumentExerciseRequestBodyRequestBody is used to define fields on
+// request body types.
+type PlanDocumentExerciseRequestBodyRequestBody struct {
+	// Exercise name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Name of the exercise type in the catalog, defaults to the exercise name
+	ExerciseType *string `form:"exerciseType,omitempty" json:"exerciseType,omitempty" xml:"exerciseType,omitempty"`
+	// Planned sets
+	Sets []*PlanDocumentSetRequestBodyRequestBody `form:"sets,omitempty" json:"sets,omitempty" xml:"sets,omitempty"`
+	// Progression rule of the exercise
+	Progression *PlanDocumentProgressionRequestBodyRequestBody `form:"progression,omitempty" json:"progression,omitempty" xml:"progression,omitempty"`
+}
+
+// PlanDocumentSetRequestBodyRequestBody is used to define fields on request
+// body types.
+type PlanDocumentSetRequestBodyRequestBody struct {
+	// Weight in kg
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Repetitions
+	Reps *int `form:"reps,omitempty" json:"reps,omitempty" xml:"reps,omitempty"`
+	// Rest time in seconds
+	RestTime *int `form:"restTime,omitempty" json:"restTime,omitempty" xml:"restTime,omitempty"`
+}
+
+// PlanDocumentProgressionRequestBodyRequestBody is used to define fields on
+// request body types.
+type PlanDocumentProgressionRequestBodyRequestBody struct {
+	// Progression scheme
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Weight added when the target is met (kg)
+	Increment *float64 `form:"increment,omitempty" json:"increment,omitempty" xml:"increment,omitempty"`
+	// Bottom of the rep range
+	MinReps *int `form:"minReps,omitempty" json:"minReps,omitempty" xml:"minReps,omitempty"`
+	// Top of the rep range
+	MaxReps *int `form:"maxReps,omitempty" json:"maxReps,omitempty" xml:"maxReps,omitempty"`
+	// Fraction of the estimated one-rep max
+	Percentage *float64 `form:"percentage,omitempty" json:"percentage,omitempty" xml:"percentage,omitempty"`
+	// Consecutive missed sessions before a deload
+	DeloadAfter *int `form:"deloadAfter,omitempty" json:"deloadAfter,omitempty" xml:"deloadAfter,omitempty"`
+	// Fraction of the weight removed by a deload
+	DeloadPercentage *float64 `form:"deloadPercentage,omitempty" json:"deloadPercentage,omitempty" xml:"deloadPercentage,omitempty"`
+}
+
+// NewCreateResponseBody builds the HTTP response body from the result of the
+// "create" endpoint of the "training_plan" service.
+func NewCreateResponseBody(res *trainingplan.TrainingPlan) *CreateResponseBody {
+	body := &CreateResponseBody{
+		ID:          res.ID,
+		Name:        res.Name,
+		Description: res.Description,
+		StartDate:   res.StartDate,
+		EndDate:     res.EndDate,
+		UserID:      res.UserID,
+		DeletedAt:   res.DeletedAt,
+	}
+	if res.Warnings != nil {
+		body.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			body.Warnings[i] = val
+		}
+	}
+	return body
+}
+
+// NewGetResponseBody builds the HTTP response body from the result of the
+// "get" endpoint of the "training_plan" service.
+func NewGetResponseBody(res *trainingplan.TrainingPlan) *GetResponseBody {
+	body := &GetResponseBody{
+		ID:          res.ID,
+		Name:        res.Name,
+		Description: res.Description,
+		StartDate:   res.StartDate,
+		EndDate:     res.EndDate,
+		UserID:      res.UserID,
+		DeletedAt:   res.DeletedAt,
+	}
+	if res.Warnings != nil {
+		body.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			body.Warnings[i] = val
+		}
+	}
+	return body
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "list" endpoint of the "training_plan" service.
+func NewListResponseBody(res []*trainingplan.TrainingPlan) ListResponseBody {
+	body := make([]*TrainingPlanResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalTrainingplanTrainingPlanToTrainingPlanResponse(val)
+	}
+	return body
+}
+
+// NewUpdateResponseBody builds the HTTP response body from the result of the
+// "update" endpoint of the "training_plan" service.
+func NewUpdateResponseBody(res *trainingplan.TrainingPlan) *UpdateResponseBody {
+	body := &UpdateResponseBody{
+		ID:          res.ID,
+		Name:        res.Name,
+		Description: res.Description,
+		StartDate:   res.StartDate,
+		EndDate:     res.EndDate,
+		UserID:      res.UserID,
+		DeletedAt:   res.DeletedAt,
+	}
+	if res.Warnings != nil {
+		body.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			body.Warnings[i] = val
+		}
+	}
+	return body
+}
+
+// NewPatchResponseBody builds the HTTP response body from the result of the
+// "patch" endpoint of the "training_plan" service.
+func NewPatchResponseBody(res *trainingplan.TrainingPlan) *PatchResponseBody {
+	body := &PatchResponseBody{
+		ID:          res.ID,
+		Name:        res.Name,
+		Description: res.Description,
+		StartDate:   res.StartDate,
+		EndDate:     res.EndDate,
+		UserID:      res.UserID,
+		DeletedAt:   res.DeletedAt,
+	}
+	if res.Warnings != nil {
+		body.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			body.Warnings[i] = val
+		}
+	}
+	return body
+}
+
+// NewRestoreResponseBody builds the HTTP response body from the result of the
+// "restore" endpoint of the "training_plan" service.
+func NewRestoreResponseBody(res *trainingplan.TrainingPlan) *RestoreResponseBody {
+	body := &RestoreResponseBody{
+		ID:          res.ID,
+		Name:        res.Name,
+		Description: res.Description,
+		StartDate:   res.StartDate,
+		EndDate:     res.EndDate,
+		UserID:      res.UserID,
+		DeletedAt:   res.DeletedAt,
+	}
+	if res.Warnings != nil {
+		body.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			body.Warnings[i] = val
+		}
+	}
+	return body
+}
+
+// NewListDeletedResponseBody builds the HTTP response body from the result of
+// the "listDeleted" endpoint of the "training_plan" service.
+func NewListDeletedResponseBody(res []*trainingplan.TrainingPlan) ListDeletedResponseBody {
+	body := make([]*TrainingPlanResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalTrainingplanTrainingPlanToTrainingPlanResponse(val)
+	}
+	return body
+}
+
+// NewCloneResponseBody builds the HTTP response body from the result of the
+// "clone" endpoint of the "training_plan" service.
+func NewCloneResponseBody(res *trainingplan.TrainingPlan) *CloneResponseBody {
+	body := &CloneResponseBody{
+		ID:          res.ID,
+		Name:        res.Name,
+		Description: res.Description,
+		StartDate:   res.StartDate,
+		EndDate:     res.EndDate,
+		UserID:      res.UserID,
+		DeletedAt:   res.DeletedAt,
+	}
+	if res.Warnings != nil {
+		body.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			body.Warnings[i] = val
+		}
+	}
+	return body
+}
+
+// NewCreateTemplateResponseBody builds the HTTP response body from the result
+// of the "createTemplate" endpoint of the "training_plan" service.
+func NewCreateTemplateResponseBody(res *trainingplan.PlanTemplate) *CreateTemplateResponseBody {
+	body := &CreateTemplateResponseBody{
+		ID:          res.ID,
+		Name:        res.Name,
+		Description: res.Description,
+		StartDate:   res.StartDate,
+		EndDate:     res.EndDate,
+		OwnerID:     res.OwnerID,
+		Public:      res.Public,
+	}
+	return body
+}
+
+// NewListTemplatesResponseBody builds the HTTP response body from the result
+// of the "listTemplates" endpoint of the "training_plan" service.
+func NewListTemplatesResponseBody(res []*trainingplan.PlanTemplate) ListTemplatesResponseBody {
+	body := make([]*PlanTemplateResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalTrainingplanPlanTemplateToPlanTemplateResponse(val)
+	}
+	return body
+}
+
+// NewImportResponseBody builds the HTTP response body from the result of the
+// "import" endpoint of the "training_plan" service.
+func NewImportResponseBody(res *trainingplan.PlanImportReport) *ImportResponseBody {
+	body := &ImportResponseBody{
+		Workouts:  res.Workouts,
+		Exercises: res.Exercises,
+		Sets:      res.Sets,
+	}
+	if res.Plan != nil {
+		body.Plan = marshalTrainingplanTrainingPlanToTrainingPlanResponseBody(res.Plan)
+	}
+	if res.Unmatched != nil {
+		body.Unmatched = make([]string, len(res.Unmatched))
+		for i, val := range res.Unmatched {
+			body.Unmatched[i] = val
+		}
+	} else {
+		body.Unmatched = []string{}
+	}
+	return body
+}
+
+// NewCreateBadRequestResponseBody builds the HTTP response body from the
+// result of the "create" endpoint of the "training_plan" service.
+func NewCreateBadRequestResponseBody(res *trainingplan.BadRequest) *CreateBadRequestResponseBody {
+	body := &CreateBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalTrainingplanFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewCreateForbiddenResponseBody builds the HTTP response body from the result
+// of the "create" endpoint of the "training_plan" service.
+func NewCreateForbiddenResponseBody(res *trainingplan.Forbidden) *CreateForbiddenResponseBody {
+	body := &CreateForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "create" endpoint of the "training_plan" service.
+func NewCreateInternalServerErrorResponseBody(res *trainingplan.InternalServerError) *CreateInternalServerErrorResponseBody {
+	body := &CreateInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateNotFoundResponseBody builds the HTTP response body from the result
+// of the "create" endpoint of the "training_plan" service.
+func NewCreateNotFoundResponseBody(res *trainingplan.NotFound) *CreateNotFoundResponseBody {
+	body := &CreateNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "create" endpoint of the "training_plan" service.
+func NewCreateUnauthorizedResponseBody(res *trainingplan.Unauthorized) *CreateUnauthorizedResponseBody {
+	body := &CreateUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetBadRequestResponseBody builds the HTTP response body from the result
+// of the "get" endpoint of the "training_plan" service.
+func NewGetBadRequestResponseBody(res *trainingplan.BadRequest) *GetBadRequestResponseBody {
+	body := &GetBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalTrainingplanFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetForbiddenResponseBody builds the HTTP response body from the result of
+// the "get" endpoint of the "training_plan" service.
+func NewGetForbiddenResponseBody(res *trainingplan.Forbidden) *GetForbiddenResponseBody {
+	body := &GetForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetInternalServerErrorResponseBody builds the HTTP response body from the
+// result of the "get" endpoint of the "training_plan" service.
+func NewGetInternalServerErrorResponseBody(res *trainingplan.InternalServerError) *GetInternalServerErrorResponseBody {
+	body := &GetInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetNotFoundResponseBody builds the HTTP response body from the result of
+// the "get" endpoint of the "training_plan" service.
+func NewGetNotFoundResponseBody(res *trainingplan.NotFound) *GetNotFoundResponseBody {
+	body := &GetNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetUnauthorizedResponseBody builds the HTTP response body from the result
+// of the "get" endpoint of the "training_plan" service.
+func NewGetUnauthorizedResponseBody(res *trainingplan.Unauthorized) *GetUnauthorizedResponseBody {
+	body := &GetUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListBadRequestResponseBody builds the HTTP response body from the result
+// of the "list" endpoint of the "training_plan" service.
+func NewListBadRequestResponseBody(res *trainingplan.BadRequest) *ListBadRequestResponseBody {
+	body := &ListBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalTrainingplanFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewListForbiddenResponseBody builds the HTTP response body from the result
+// of the "list" endpoint of the "training_plan" service.
+func NewListForbiddenResponseBody(res *trainingplan.Forbidden) *ListForbiddenResponseBody {
+	body := &ListForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "list" endpoint of the "training_plan" service.
+func NewListInternalServerErrorResponseBody(res *trainingplan.InternalServerError) *ListInternalServerErrorResponseBody {
+	body := &ListInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListNotFoundResponseBody builds the HTTP response body from the result of
+// the "list" endpoint of the "training_plan" service.
+func NewListNotFoundResponseBody(res *trainingplan.NotFound) *ListNotFoundResponseBody {
+	body := &ListNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "list" endpoint of the "training_plan" service.
+func NewListUnauthorizedResponseBody(res *trainingplan.Unauthorized) *ListUnauthorizedResponseBody {
+	body := &ListUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateBadRequestResponseBody builds the HTTP response body from the
+// result of the "update" endpoint of the "training_plan" service.
+func NewUpdateBadRequestResponseBody(res *trainingplan.BadRequest) *UpdateBadRequestResponseBody {
+	body := &UpdateBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalTrainingplanFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewUpdateForbiddenResponseBody builds the HTTP response body from the result
+// of the "update" endpoint of the "training_plan" service.
+func NewUpdateForbiddenResponseBody(res *trainingplan.Forbidden) *UpdateForbiddenResponseBody {
+	body := &UpdateForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "update" endpoint of the "training_plan" service.
+func NewUpdateInternalServerErrorResponseBody(res *trainingplan.InternalServerError) *UpdateInternalServerErrorResponseBody {
+	body := &UpdateInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateNotFoundResponseBody builds the HTTP response body from the result
+// of the "update" endpoint of the "training_plan" service.
+func NewUpdateNotFoundResponseBody(res *trainingplan.NotFound) *UpdateNotFoundResponseBody {
+	body := &UpdateNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdatePreconditionFailedResponseBody builds the HTTP response body from
+// the result of the "update" endpoint of the "training_plan" service.
+func NewUpdatePreconditionFailedResponseBody(res *trainingplan.PreconditionFailed) *UpdatePreconditionFailedResponseBody {
+	body := &UpdatePreconditionFailedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "update" endpoint of the "training_plan" service.
+func NewUpdateUnauthorizedResponseBody(res *trainingplan.Unauthorized) *UpdateUnauthorizedResponseBody {
+	body := &UpdateUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPatchBadRequestResponseBody builds the HTTP response body from the result
+// of the "patch" endpoint of the "training_plan" service.
+func NewPatchBadRequestResponseBody(res *trainingplan.BadRequest) *PatchBadRequestResponseBody {
+	body := &PatchBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalTrainingplanFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewPatchForbiddenResponseBody builds the HTTP response body from the result
+// of the "patch" endpoint of the "training_plan" service.
+func NewPatchForbiddenResponseBody(res *trainingplan.Forbidden) *PatchForbiddenResponseBody {
+	body := &PatchForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPatchInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "patch" endpoint of the "training_plan" service.
+func NewPatchInternalServerErrorResponseBody(res *trainingplan.InternalServerError) *PatchInternalServerErrorResponseBody {
+	body := &PatchInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPatchNotFoundResponseBody builds the HTTP response body from the result
+// of the "patch" endpoint of the "training_plan" service.
+func NewPatchNotFoundResponseBody(res *trainingplan.NotFound) *PatchNotFoundResponseBody {
+	body := &PatchNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPatchPreconditionFailedResponseBody builds the HTTP response body from
+// the result of the "patch" endpoint of the "training_plan" service.
+func NewPatchPreconditionFailedResponseBody(res *trainingplan.PreconditionFailed) *PatchPreconditionFailedResponseBody {
+	body := &PatchPreconditionFailedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPatchUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "patch" endpoint of the "training_plan" service.
+func NewPatchUnauthorizedResponseBody(res *trainingplan.Unauthorized) *PatchUnauthorizedResponseBody {
+	body := &PatchUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteBadRequestResponseBody builds the HTTP response body from the
+// result of the "delete" endpoint of the "training_plan" service.
+func NewDeleteBadRequestResponseBody(res *trainingplan.BadRequest) *DeleteBadRequestResponseBody {
+	body := &DeleteBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalTrainingplanFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewDeleteForbiddenResponseBody builds the HTTP response body from the result
+// of the "delete" endpoint of the "training_plan" service.
+func NewDeleteForbiddenResponseBody(res *trainingplan.Forbidden) *DeleteForbiddenResponseBody {
+	body := &DeleteForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "delete" endpoint of the "training_plan" service.
+func NewDeleteInternalServerErrorResponseBody(res *trainingplan.InternalServerError) *DeleteInternalServerErrorResponseBody {
+	body := &DeleteInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteNotFoundResponseBody builds the HTTP response body from the result
+// of the "delete" endpoint of the "training_plan" service.
+func NewDeleteNotFoundResponseBody(res *trainingplan.NotFound) *DeleteNotFoundResponseBody {
+	body := &DeleteNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeletePreconditionFailedResponseBody builds the HTTP response body from
+// the result of the "delete" endpoint of the "training_plan" service.
+func NewDeletePreconditionFailedResponseBody(res *trainingplan.PreconditionFailed) *DeletePreconditionFailedResponseBody {
+	body := &DeletePreconditionFailedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "delete" endpoint of the "training_plan" service.
+func NewDeleteUnauthorizedResponseBody(res *trainingplan.Unauthorized) *DeleteUnauthorizedResponseBody {
+	body := &DeleteUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRestoreBadRequestResponseBody builds the HTTP response body from the
+// result of the "restore" endpoint of the "training_plan" service.
+func NewRestoreBadRequestResponseBody(res *trainingplan.BadRequest) *RestoreBadRequestResponseBody {
+	body := &RestoreBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalTrainingplanFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewRestoreForbiddenResponseBody builds the HTTP response body from the
+// result of the "restore" endpoint of the "training_plan" service.
+func NewRestoreForbiddenResponseBody(res *trainingplan.Forbidden) *RestoreForbiddenResponseBody {
+	body := &RestoreForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRestoreInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "restore" endpoint of the "training_plan" service.
+func NewRestoreInternalServerErrorResponseBody(res *trainingplan.InternalServerError) *RestoreInternalServerErrorResponseBody {
+	body := &RestoreInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRestoreNotFoundResponseBody builds the HTTP response body from the result
+// of the "restore" endpoint of the "training_plan" service.
+func NewRestoreNotFoundResponseBody(res *trainingplan.NotFound) *RestoreNotFoundResponseBody {
+	body := &RestoreNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRestoreUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "restore" endpoint of the "training_plan" service.
+func NewRestoreUnauthorizedResponseBody(res *trainingplan.Unauthorized) *RestoreUnauthorizedResponseBody {
+	body := &RestoreUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListDeletedBadRequestResponseBody builds the HTTP response body from the
+// result of the "listDeleted" endpoint of the "training_plan" service.
+func NewListDeletedBadRequestResponseBody(res *trainingplan.BadRequest) *ListDeletedBadRequestResponseBody {
+	body := &ListDeletedBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalTrainingplanFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewListDeletedForbiddenResponseBody builds the HTTP response body from the
+// result of the "listDeleted" endpoint of the "training_plan" service.
+func NewListDeletedForbiddenResponseBody(res *trainingplan.Forbidden) *ListDeletedForbiddenResponseBody {
+	body := &ListDeletedForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListDeletedInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "listDeleted" endpoint of the "training_plan" service.
+func NewListDeletedInternalServerErrorResponseBody(res *trainingplan.InternalServerError) *ListDeletedInternalServerErrorResponseBody {
+	body := &ListDeletedInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListDeletedNotFoundResponseBody builds the HTTP response body from the
+// result of the "listDeleted" endpoint of the "training_plan" service.
+func NewListDeletedNotFoundResponseBody(res *trainingplan.NotFound) *ListDeletedNotFoundResponseBody {
+	body := &ListDeletedNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListDeletedUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "listDeleted" endpoint of the "training_plan" service.
+func NewListDeletedUnauthorizedResponseBody(res *trainingplan.Unauthorized) *ListDeletedUnauthorizedResponseBody {
+	body := &ListDeletedUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCloneBadRequestResponseBody builds the HTTP response body from the result
+// of the "clone" endpoint of the "training_plan" service.
+func NewCloneBadRequestResponseBody(res *trainingplan.BadRequest) *CloneBadRequestResponseBody {
+	body := &CloneBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalTrainingplanFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewCloneForbiddenResponseBody builds the HTTP response body from the result
+// of the "clone" endpoint of the "training_plan" service.
+func NewCloneForbiddenResponseBody(res *trainingplan.Forbidden) *CloneForbiddenResponseBody {
+	body := &CloneForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCloneInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "clone" endpoint of the "training_plan" service.
+func NewCloneInternalServerErrorResponseBody(res *trainingplan.InternalServerError) *CloneInternalServerErrorResponseBody {
+	body := &CloneInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCloneNotFoundResponseBody builds the HTTP response body from the result
+// of the "clone" endpoint of the "training_plan" service.
+func NewCloneNotFoundResponseBody(res *trainingplan.NotFound) *CloneNotFoundResponseBody {
+	body := &CloneNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCloneUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "clone" endpoint of the "training_plan" service.
+func NewCloneUnauthorizedResponseBody(res *trainingplan.Unauthorized) *CloneUnauthorizedResponseBody {
+	body := &CloneUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateTemplateBadRequestResponseBody builds the HTTP response body from
+// the result of the "createTemplate" endpoint of the "training_plan" service.
+func NewCreateTemplateBadRequestResponseBody(res *trainingplan.BadRequest) *CreateTemplateBadRequestResponseBody {
+	body := &CreateTemplateBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalTrainingplanFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewCreateTemplateForbiddenResponseBody builds the HTTP response body from
+// the result of the "createTemplate" endpoint of the "training_plan" service.
+func NewCreateTemplateForbiddenResponseBody(res *trainingplan.Forbidden) *CreateTemplateForbiddenResponseBody {
+	body := &CreateTemplateForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateTemplateInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "createTemplate" endpoint of the "training_plan"
+// service.
+func NewCreateTemplateInternalServerErrorResponseBody(res *trainingplan.InternalServerError) *CreateTemplateInternalServerErrorResponseBody {
+	body := &CreateTemplateInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateTemplateNotFoundResponseBody builds the HTTP response body from the
+// result of the "createTemplate" endpoint of the "training_plan" service.
+func NewCreateTemplateNotFoundResponseBody(res *trainingplan.NotFound) *CreateTemplateNotFoundResponseBody {
+	body := &CreateTemplateNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateTemplateUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "createTemplate" endpoint of the "training_plan" service.
+func NewCreateTemplateUnauthorizedResponseBody(res *trainingplan.Unauthorized) *CreateTemplateUnauthorizedResponseBody {
+	body := &CreateTemplateUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListTemplatesBadRequestResponseBody builds the HTTP response body from
+// the result of the "listTemplates" endpoint of the "training_plan" service.
+func NewListTemplatesBadRequestResponseBody(res *trainingplan.BadRequest) *ListTemplatesBadRequestResponseBody {
+	body := &ListTemplatesBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalTrainingplanFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewListTemplatesForbiddenResponseBody builds the HTTP response body from the
+// result of the "listTemplates" endpoint of the "training_plan" service.
+func NewListTemplatesForbiddenResponseBody(res *trainingplan.Forbidden) *ListTemplatesForbiddenResponseBody {
+	body := &ListTemplatesForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListTemplatesInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "listTemplates" endpoint of the "training_plan"
+// service.
+func NewListTemplatesInternalServerErrorResponseBody(res *trainingplan.InternalServerError) *ListTemplatesInternalServerErrorResponseBody {
+	body := &ListTemplatesInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListTemplatesNotFoundResponseBody builds the HTTP response body from the
+// result of the "listTemplates" endpoint of the "training_plan" service.
+func NewListTemplatesNotFoundResponseBody(res *trainingplan.NotFound) *ListTemplatesNotFoundResponseBody {
+	body := &ListTemplatesNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListTemplatesUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "listTemplates" endpoint of the "training_plan" service.
+func NewListTemplatesUnauthorizedResponseBody(res *trainingplan.Unauthorized) *ListTemplatesUnauthorizedResponseBody {
+	body := &ListTemplatesUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewExportBadRequestResponseBody builds the HTTP response body from the
+// result of the "export" endpoint of the "training_plan" service.
+func NewExportBadRequestResponseBody(res *trainingplan.BadRequest) *ExportBadRequestResponseBody {
+	body := &ExportBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalTrainingplanFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewExportForbiddenResponseBody builds the HTTP response body from the result
+// of the "export" endpoint of the "training_plan" service.
+func NewExportForbiddenResponseBody(res *trainingplan.Forbidden) *ExportForbiddenResponseBody {
+	body := &ExportForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewExportInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "export" endpoint of the "training_plan" service.
+func NewExportInternalServerErrorResponseBody(res *trainingplan.InternalServerError) *ExportInternalServerErrorResponseBody {
+	body := &ExportInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewExportNotFoundResponseBody builds the HTTP response body from the result
+// of the "export" endpoint of the "training_plan" service.
+func NewExportNotFoundResponseBody(res *trainingplan.NotFound) *ExportNotFoundResponseBody {
+	body := &ExportNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewExportUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "export" endpoint of the "training_plan" service.
+func NewExportUnauthorizedResponseBody(res *trainingplan.Unauthorized) *ExportUnauthorizedResponseBody {
+	body := &ExportUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
 }
 
-// TrainingPlanResponse is used to define fields on response body types.
-type TrainingPlanResponse struct {
-	// TrainingPlan ID
-	ID string `form:"id" json:"id" xml:"id"`
-	// Name of the training plan
-	Name string `form:"name" json:"name" xml:"name"`
-	// Description of the plan
-	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
-	// Start date in ISO 8601
-	StartDate string `form:"startDate" json:"startDate" xml:"startDate"`
-	// End date in ISO 8601
-	EndDate string `form:"endDate" json:"endDate" xml:"endDate"`
-	// ID of the user who owns the plan
-	UserID string `form:"userId" json:"userId" xml:"userId"`
+// NewImportBadRequestResponseBody builds the HTTP response body from the
+// result of the "import" endpoint of the "training_plan" service.
+func NewImportBadRequestResponseBody(res *trainingplan.BadRequest) *ImportBadRequestResponseBody {
+	body := &ImportBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalTrainingplanFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
 }
 
-// NewCreateResponseBody builds the HTTP response body from the result of the
-// "create" endpoint of the "training_plan" service.
-func NewCreateResponseBody(res *trainingplan.TrainingPlan) *CreateResponseBody {
-	body := &CreateResponseBody{
-		ID:          res.ID,
-		Name:        res.Name,
-		Description: res.Description,
-		StartDate:   res.StartDate,
-		EndDate:     res.EndDate,
-		UserID:      res.UserID,
+// NewImportForbiddenResponseBody builds the HTTP response body from the result
+// of the "import" endpoint of the "training_plan" service.
+func NewImportForbiddenResponseBody(res *trainingplan.Forbidden) *ImportForbiddenResponseBody {
+	body := &ImportForbiddenResponseBody{
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetResponseBody builds the HTTP response body from the result of the
-// "get" endpoint of the "training_plan" service.
-func NewGetResponseBody(res *trainingplan.TrainingPlan) *GetResponseBody {
-	body := &GetResponseBody{
-		ID:          res.ID,
-		Name:        res.Name,
-		Description: res.Description,
-		StartDate:   res.StartDate,
-		EndDate:     res.EndDate,
-		UserID:      res.UserID,
+// NewImportInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "import" endpoint of the "training_plan" service.
+func NewImportInternalServerErrorResponseBody(res *trainingplan.InternalServerError) *ImportInternalServerErrorResponseBody {
+	body := &ImportInternalServerErrorResponseBody{
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewListResponseBody builds the HTTP response body from the result of the
-// "list" endpoint of the "training_plan" service.
-func NewListResponseBody(res []*trainingplan.TrainingPlan) ListResponseBody {
-	body := make([]*TrainingPlanResponse, len(res))
-	for i, val := range res {
-		body[i] = marshalTrainingplanTrainingPlanToTrainingPlanResponse(val)
+// NewImportNotFoundResponseBody builds the HTTP response body from the result
+// of the "import" endpoint of the "training_plan" service.
+func NewImportNotFoundResponseBody(res *trainingplan.NotFound) *ImportNotFoundResponseBody {
+	body := &ImportNotFoundResponseBody{
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateResponseBody builds the HTTP response body from the result of the
-// "update" endpoint of the "training_plan" service.
-func NewUpdateResponseBody(res *trainingplan.TrainingPlan) *UpdateResponseBody {
-	body := &UpdateResponseBody{
-		ID:          res.ID,
-		Name:        res.Name,
-		Description: res.Description,
-		StartDate:   res.StartDate,
-		EndDate:     res.EndDate,
-		UserID:      res.UserID,
+// NewImportUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "import" endpoint of the "training_plan" service.
+func NewImportUnauthorizedResponseBody(res *trainingplan.Unauthorized) *ImportUnauthorizedResponseBody {
+	body := &ImportUnauthorizedResponseBody{
+		Message: res.Message,
 	}
 	return body
 }
@@ -197,7 +2034,7 @@ func NewListPayload(userID *string, startAfter *string, limit int, offset int, t
 }
 
 // NewUpdatePayload builds a training_plan service update endpoint payload.
-func NewUpdatePayload(body *UpdateRequestBody, id string, token *string) *trainingplan.UpdatePayload {
+func NewUpdatePayload(body *UpdateRequestBody, id string, ifMatch string, token *string) *trainingplan.UpdatePayload {
 	v := &trainingplan.UpdatePayload{
 		Name:        *body.Name,
 		Description: body.Description,
@@ -206,20 +2043,122 @@ func NewUpdatePayload(body *UpdateRequestBody, id string, token *string) *traini
 		UserID:      *body.UserID,
 	}
 	v.ID = id
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v
+}
+
+// NewPatchPayload builds a training_plan service patch endpoint payload.
+func NewPatchPayload(id string, ifMatch string, token *string) *trainingplan.PatchPayload {
+	v := &trainingplan.PatchPayload{}
+	v.ID = id
+	v.IfMatch = ifMatch
 	v.Token = token
 
 	return v
 }
 
 // NewDeletePayload builds a training_plan service delete endpoint payload.
-func NewDeletePayload(id string, token *string) *trainingplan.DeletePayload {
+func NewDeletePayload(id string, ifMatch string, token *string) *trainingplan.DeletePayload {
 	v := &trainingplan.DeletePayload{}
 	v.ID = id
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v
+}
+
+// NewRestorePayload builds a training_plan service restore endpoint payload.
+func NewRestorePayload(id string, token *string) *trainingplan.RestorePayload {
+	v := &trainingplan.RestorePayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewListDeletedPayload builds a training_plan service listDeleted endpoint
+// payload.
+func NewListDeletedPayload(userID *string, limit int, offset int, token *string) *trainingplan.ListDeletedPayload {
+	v := &trainingplan.ListDeletedPayload{}
+	v.UserID = userID
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v
+}
+
+// NewClonePayload builds a training_plan service clone endpoint payload.
+func NewClonePayload(body *CloneRequestBody, id string, token *string) *trainingplan.ClonePayload {
+	v := &trainingplan.ClonePayload{
+		UserID:    body.UserID,
+		StartDate: *body.StartDate,
+		Name:      body.Name,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewCreateTemplatePayload builds a training_plan service createTemplate
+// endpoint payload.
+func NewCreateTemplatePayload(body *CreateTemplateRequestBody, id string, token *string) *trainingplan.CreateTemplatePayload {
+	v := &trainingplan.CreateTemplatePayload{
+		Name: body.Name,
+	}
+	if body.Public != nil {
+		v.Public = *body.Public
+	}
+	if body.Public == nil {
+		v.Public = false
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewListTemplatesPayload builds a training_plan service listTemplates
+// endpoint payload.
+func NewListTemplatesPayload(limit int, offset int, token *string) *trainingplan.ListTemplatesPayload {
+	v := &trainingplan.ListTemplatesPayload{}
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v
+}
+
+// NewExportPayload builds a training_plan service export endpoint payload.
+func NewExportPayload(id string, format string, token *string) *trainingplan.ExportPayload {
+	v := &trainingplan.ExportPayload{}
+	v.ID = id
+	v.Format = format
 	v.Token = token
 
 	return v
 }
 
+// NewImportPayload builds a training_plan service import endpoint payload.
+func NewImportPayload(body *ImportRequestBody, userID *string, allowUnmatched bool, token *string) *trainingplan.ImportPayload {
+	v := &trainingplan.PlanDocument{
+		Version:    *body.Version,
+		ExportedAt: body.ExportedAt,
+	}
+	v.Plan = unmarshalPlanDocumentPlanRequestBodyRequestBodyToTrainingplanPlanDocumentPlan(body.Plan)
+	res := &trainingplan.ImportPayload{
+		Document: v,
+	}
+	res.UserID = userID
+	res.AllowUnmatched = allowUnmatched
+	res.Token = token
+
+	return res
+}
+
 // ValidateCreateRequestBody runs the validations defined on CreateRequestBody
 func ValidateCreateRequestBody(body *CreateRequestBody) (err error) {
 	if body.Name == nil {
@@ -281,3 +2220,242 @@ func ValidateUpdateRequestBody(body *UpdateRequestBody) (err error) {
 	}
 	return
 }
+
+// ValidateCloneRequestBody runs the validations defined on CloneRequestBody
+func ValidateCloneRequestBody(body *CloneRequestBody) (err error) {
+	if body.StartDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startDate", "body"))
+	}
+	if body.UserID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
+	}
+	if body.StartDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startDate", *body.StartDate, goa.FormatDateTime))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	return
+}
+
+// ValidateCreateTemplateRequestBody runs the validations defined on
+// CreateTemplateRequestBody
+func ValidateCreateTemplateRequestBody(body *CreateTemplateRequestBody) (err error) {
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	return
+}
+
+// ValidateImportRequestBody runs the validations defined on ImportRequestBody
+func ValidateImportRequestBody(body *ImportRequestBody) (err error) {
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.Plan == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("plan", "body"))
+	}
+	if body.Version != nil {
+		if !(*body.Version == 1) {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.version", *body.Version, []any{1}))
+		}
+	}
+	if body.ExportedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.exportedAt", *body.ExportedAt, goa.FormatDateTime))
+	}
+	if body.Plan != nil {
+		if err2 := ValidatePlanDocumentPlanRequestBodyRequestBody(body.Plan); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidatePlanDocumentPlanRequestBodyRequestBody runs the validations defined
+// on PlanDocumentPlanRequestBodyRequestBody
+func ValidatePlanDocumentPlanRequestBodyRequestBody(body *PlanDocumentPlanRequestBodyRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.StartDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startDate", "body"))
+	}
+	if body.EndDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endDate", "body"))
+	}
+	if body.Workouts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("workouts", "body"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	if body.StartDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startDate", *body.StartDate, goa.FormatDateTime))
+	}
+	if body.EndDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.endDate", *body.EndDate, goa.FormatDateTime))
+	}
+	for _, e := range body.Workouts {
+		if e != nil {
+			if err2 := ValidatePlanDocumentWorkoutRequestBodyRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePlanDocumentWorkoutRequestBodyRequestBody runs the validations
+// defined on PlanDocumentWorkoutRequestBodyRequestBody
+func ValidatePlanDocumentWorkoutRequestBodyRequestBody(body *PlanDocumentWorkoutRequestBodyRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Exercises == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercises", "body"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	for _, e := range body.ScheduledDates {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.scheduledDates[*]", e, goa.FormatDate))
+	}
+	for _, e := range body.Exercises {
+		if e != nil {
+			if err2 := ValidatePlanDocumentExerciseRequestBodyRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePlanDocumentExerciseRequestBodyRequestBody runs the validations
+// defined on PlanDocumentExerciseRequestBodyRequestBody
+func ValidatePlanDocumentExerciseRequestBodyRequestBody(body *PlanDocumentExerciseRequestBodyRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Sets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sets", "body"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	for _, e := range body.Sets {
+		if e != nil {
+			if err2 := ValidatePlanDocumentSetRequestBodyRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Progression != nil {
+		if err2 := ValidatePlanDocumentProgressionRequestBodyRequestBody(body.Progression); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidatePlanDocumentSetRequestBodyRequestBody runs the validations defined
+// on PlanDocumentSetRequestBodyRequestBody
+func ValidatePlanDocumentSetRequestBodyRequestBody(body *PlanDocumentSetRequestBodyRequestBody) (err error) {
+	if body.Weight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("weight", "body"))
+	}
+	if body.Reps == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reps", "body"))
+	}
+	if body.RestTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("restTime", "body"))
+	}
+	if body.Weight != nil {
+		if *body.Weight < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 0, true))
+		}
+	}
+	if body.Reps != nil {
+		if *body.Reps < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.reps", *body.Reps, 0, true))
+		}
+	}
+	if body.RestTime != nil {
+		if *body.RestTime < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.restTime", *body.RestTime, 0, true))
+		}
+	}
+	return
+}
+
+// ValidatePlanDocumentProgressionRequestBodyRequestBody runs the validations
+// defined on PlanDocumentProgressionRequestBodyRequestBody
+func ValidatePlanDocumentProgressionRequestBodyRequestBody(body *PlanDocumentProgressionRequestBodyRequestBody) (err error) {
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Increment == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("increment", "body"))
+	}
+	if body.DeloadAfter == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deloadAfter", "body"))
+	}
+	if body.DeloadPercentage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deloadPercentage", "body"))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "linear" || *body.Kind == "double_progression" || *body.Kind == "percentage_of_one_rep_max") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"linear", "double_progression", "percentage_of_one_rep_max"}))
+		}
+	}
+	if body.Increment != nil {
+		if *body.Increment < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.increment", *body.Increment, 0, true))
+		}
+	}
+	if body.MinReps != nil {
+		if *body.MinReps < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.minReps", *body.MinReps, 1, true))
+		}
+	}
+	if body.MaxReps != nil {
+		if *body.MaxReps < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.maxReps", *body.MaxReps, 1, true))
+		}
+	}
+	if body.Percentage != nil {
+		if *body.Percentage < 0.3 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.percentage", *body.Percentage, 0.3, true))
+		}
+	}
+	if body.Percentage != nil {
+		if *body.Percentage > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.percentage", *body.Percentage, 1, false))
+		}
+	}
+	if body.DeloadAfter != nil {
+		if *body.DeloadAfter < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.deloadAfter", *body.DeloadAfter, 0, true))
+		}
+	}
+	if body.DeloadPercentage != nil {
+		if *body.DeloadPercentage < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.deloadPercentage", *body.DeloadPercentage, 0, true))
+		}
+	}
+	if body.DeloadPercentage != nil {
+		if *body.DeloadPercentage > 0.5 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.deloadPercentage", *body.DeloadPercentage, 0.5, false))
+		}
+	}
+	return
+}
@@ -149,13 +149,13 @@ func BuildListPayload(userListLimit string, userListOffset string, userListToken
 
 // BuildUpdatePayload builds the payload for the user update endpoint from CLI
 // flags.
-func BuildUpdatePayload(userUpdateBody string, userUpdateID string, userUpdateToken string) (*user.UpdatePayload, error) {
+func BuildUpdatePayload(userUpdateBody string, userUpdateID string, userUpdateIfMatch string, userUpdateToken string) (*user.UpdatePayload, error) {
 	var err error
 	var body UpdateRequestBody
 	{
 		err = json.Unmarshal([]byte(userUpdateBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"admin\": false,\n      \"firstName\": \"John\",\n      \"lastName\": \"Doe\",\n      \"nickname\": \"JD\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"admin\": false,\n      \"firstName\": \"John\",\n      \"lastName\": \"Doe\",\n      \"nickname\": \"JD\",\n      \"timezone\": \"Europe/Rome\"\n   }'")
 		}
 	}
 	var id string
@@ -166,6 +166,10 @@ func BuildUpdatePayload(userUpdateBody string, userUpdateID string, userUpdateTo
 			return nil, err
 		}
 	}
+	var ifMatch string
+	{
+		ifMatch = userUpdateIfMatch
+	}
 	var token *string
 	{
 		if userUpdateToken != "" {
@@ -177,6 +181,7 @@ func BuildUpdatePayload(userUpdateBody string, userUpdateID string, userUpdateTo
 		LastName:  body.LastName,
 		Nickname:  body.Nickname,
 		Admin:     body.Admin,
+		Timezone:  body.Timezone,
 	}
 	{
 		var zero bool
@@ -185,6 +190,37 @@ func BuildUpdatePayload(userUpdateBody string, userUpdateID string, userUpdateTo
 		}
 	}
 	v.ID = id
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildPatchPayload builds the payload for the user patch endpoint from CLI
+// flags.
+func BuildPatchPayload(userPatchID string, userPatchIfMatch string, userPatchToken string) (*user.PatchPayload, error) {
+	var err error
+	var id string
+	{
+		id = userPatchID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var ifMatch string
+	{
+		ifMatch = userPatchIfMatch
+	}
+	var token *string
+	{
+		if userPatchToken != "" {
+			token = &userPatchToken
+		}
+	}
+	v := &user.PatchPayload{}
+	v.ID = id
+	v.IfMatch = ifMatch
 	v.Token = token
 
 	return v, nil
@@ -192,7 +228,7 @@ func BuildUpdatePayload(userUpdateBody string, userUpdateID string, userUpdateTo
 
 // BuildDeletePayload builds the payload for the user delete endpoint from CLI
 // flags.
-func BuildDeletePayload(userDeleteID string, userDeleteToken string) (*user.DeletePayload, error) {
+func BuildDeletePayload(userDeleteID string, userDeleteIfMatch string, userDeleteToken string) (*user.DeletePayload, error) {
 	var err error
 	var id string
 	{
@@ -202,6 +238,10 @@ func BuildDeletePayload(userDeleteID string, userDeleteToken string) (*user.Dele
 			return nil, err
 		}
 	}
+	var ifMatch string
+	{
+		ifMatch = userDeleteIfMatch
+	}
 	var token *string
 	{
 		if userDeleteToken != "" {
@@ -210,6 +250,87 @@ func BuildDeletePayload(userDeleteID string, userDeleteToken string) (*user.Dele
 	}
 	v := &user.DeletePayload{}
 	v.ID = id
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRestorePayload builds the payload for the user restore endpoint from
+// CLI flags.
+func BuildRestorePayload(userRestoreID string, userRestoreToken string) (*user.RestorePayload, error) {
+	var err error
+	var id string
+	{
+		id = userRestoreID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if userRestoreToken != "" {
+			token = &userRestoreToken
+		}
+	}
+	v := &user.RestorePayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListDeletedPayload builds the payload for the user listDeleted endpoint
+// from CLI flags.
+func BuildListDeletedPayload(userListDeletedLimit string, userListDeletedOffset string, userListDeletedToken string) (*user.ListDeletedPayload, error) {
+	var err error
+	var limit int
+	{
+		if userListDeletedLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(userListDeletedLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var offset int
+	{
+		if userListDeletedOffset != "" {
+			var v int64
+			v, err = strconv.ParseInt(userListDeletedOffset, 10, strconv.IntSize)
+			offset = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for offset, must be INT")
+			}
+			if offset < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("offset", offset, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if userListDeletedToken != "" {
+			token = &userListDeletedToken
+		}
+	}
+	v := &user.ListDeletedPayload{}
+	v.Limit = limit
+	v.Offset = offset
 	v.Token = token
 
 	return v, nil
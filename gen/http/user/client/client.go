@@ -29,9 +29,20 @@ type Client struct {
 	// Update Doer is the HTTP client used to make requests to the update endpoint.
 	UpdateDoer goahttp.Doer
 
+	// Patch Doer is the HTTP client used to make requests to the patch endpoint.
+	PatchDoer goahttp.Doer
+
 	// Delete Doer is the HTTP client used to make requests to the delete endpoint.
 	DeleteDoer goahttp.Doer
 
+	// Restore Doer is the HTTP client used to make requests to the restore
+	// endpoint.
+	RestoreDoer goahttp.Doer
+
+	// ListDeleted Doer is the HTTP client used to make requests to the listDeleted
+	// endpoint.
+	ListDeletedDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -56,7 +67,10 @@ func NewClient(
 		GetDoer:             doer,
 		ListDoer:            doer,
 		UpdateDoer:          doer,
+		PatchDoer:           doer,
 		DeleteDoer:          doer,
+		RestoreDoer:         doer,
+		ListDeletedDoer:     doer,
 		RestoreResponseBody: restoreBody,
 		scheme:              scheme,
 		host:                host,
@@ -161,6 +175,30 @@ func (c *Client) Update() goa.Endpoint {
 	}
 }
 
+// Patch returns an endpoint that makes HTTP requests to the user service patch
+// server.
+func (c *Client) Patch() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePatchRequest(c.encoder)
+		decodeResponse = DecodePatchResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPatchRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PatchDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("user", "patch", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // Delete returns an endpoint that makes HTTP requests to the user service
 // delete server.
 func (c *Client) Delete() goa.Endpoint {
@@ -184,3 +222,51 @@ func (c *Client) Delete() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// Restore returns an endpoint that makes HTTP requests to the user service
+// restore server.
+func (c *Client) Restore() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRestoreRequest(c.encoder)
+		decodeResponse = DecodeRestoreResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRestoreRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RestoreDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("user", "restore", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListDeleted returns an endpoint that makes HTTP requests to the user service
+// listDeleted server.
+func (c *Client) ListDeleted() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListDeletedRequest(c.encoder)
+		decodeResponse = DecodeListDeletedResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListDeletedRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListDeletedDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("user", "listDeleted", err)
+		}
+		return decodeResponse(resp)
+	}
+}
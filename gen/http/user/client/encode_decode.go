@@ -15,6 +15,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"strings"
 
 	goahttp "goa.design/goa/v3/http"
@@ -91,7 +92,14 @@ func DecodeCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 			if err != nil {
 				return nil, goahttp.ErrValidationError("user", "create", err)
 			}
-			res := NewCreateUserCreated(&body)
+			var (
+				etag *string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			res := NewCreateUserCreated(&body, etag)
 			return res, nil
 		default:
 			body, _ := io.ReadAll(resp.Body)
@@ -182,7 +190,14 @@ func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody
 			if err != nil {
 				return nil, goahttp.ErrValidationError("user", "get", err)
 			}
-			res := NewGetUserWithPlansOK(&body)
+			var (
+				etag *string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			res := NewGetUserWithPlansOK(&body, etag)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
@@ -379,6 +394,10 @@ func EncodeUpdateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 		if !ok {
 			return goahttp.ErrInvalidType("user", "update", "*user.UpdatePayload", v)
 		}
+		{
+			head := p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
 		if p.Token != nil {
 			head := *p.Token
 			if !strings.Contains(head, " ") {
@@ -403,6 +422,7 @@ func EncodeUpdateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 //   - "forbidden" (type *user.Forbidden): http.StatusForbidden
 //   - "internalServerError" (type *user.InternalServerError): http.StatusInternalServerError
 //   - "notFound" (type *user.NotFound): http.StatusNotFound
+//   - "preconditionFailed" (type *user.PreconditionFailed): http.StatusPreconditionFailed
 //   - "unauthorized" (type *user.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
 func DecodeUpdateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
@@ -433,7 +453,14 @@ func DecodeUpdateResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 			if err != nil {
 				return nil, goahttp.ErrValidationError("user", "update", err)
 			}
-			res := NewUpdateUserOK(&body)
+			var (
+				etag *string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			res := NewUpdateUserOK(&body, etag)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
@@ -491,6 +518,20 @@ func DecodeUpdateResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 				return nil, goahttp.ErrValidationError("user", "update", err)
 			}
 			return nil, NewUpdateNotFound(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body UpdatePreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "update", err)
+			}
+			err = ValidateUpdatePreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "update", err)
+			}
+			return nil, NewUpdatePreconditionFailed(&body)
 		case http.StatusUnauthorized:
 			var (
 				body UpdateUnauthorizedResponseBody
@@ -512,6 +553,211 @@ func DecodeUpdateResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 	}
 }
 
+// BuildPatchRequest instantiates a HTTP request object with method and path
+// set to call the "user" service "patch" endpoint
+func (c *Client) BuildPatchRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id   string
+		body io.Reader
+	)
+	{
+		rd, ok := v.(*user.PatchRequestData)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("user", "patch", "user.PatchRequestData", v)
+		}
+		p := rd.Payload
+		body = rd.Body
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PatchUserPath(id)}
+	req, err := http.NewRequest("PATCH", u.String(), body)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("user", "patch", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePatchRequest returns an encoder for requests sent to the user patch
+// server.
+func EncodePatchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		data, ok := v.(*user.PatchRequestData)
+		if !ok {
+			return goahttp.ErrInvalidType("user", "patch", "*user.PatchRequestData", v)
+		}
+		p := data.Payload
+		{
+			head := p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodePatchResponse returns a decoder for responses returned by the user
+// patch endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodePatchResponse may return the following errors:
+//   - "badRequest" (type *user.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *user.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *user.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *user.NotFound): http.StatusNotFound
+//   - "preconditionFailed" (type *user.PreconditionFailed): http.StatusPreconditionFailed
+//   - "unauthorized" (type *user.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodePatchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PatchResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "patch", err)
+			}
+			err = ValidatePatchResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "patch", err)
+			}
+			var (
+				etag *string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			res := NewPatchUserOK(&body, etag)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body PatchBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "patch", err)
+			}
+			err = ValidatePatchBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "patch", err)
+			}
+			return nil, NewPatchBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body PatchForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "patch", err)
+			}
+			err = ValidatePatchForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "patch", err)
+			}
+			return nil, NewPatchForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body PatchInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "patch", err)
+			}
+			err = ValidatePatchInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "patch", err)
+			}
+			return nil, NewPatchInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body PatchNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "patch", err)
+			}
+			err = ValidatePatchNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "patch", err)
+			}
+			return nil, NewPatchNotFound(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body PatchPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "patch", err)
+			}
+			err = ValidatePatchPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "patch", err)
+			}
+			return nil, NewPatchPreconditionFailed(&body)
+		case http.StatusUnauthorized:
+			var (
+				body PatchUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "patch", err)
+			}
+			err = ValidatePatchUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "patch", err)
+			}
+			return nil, NewPatchUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("user", "patch", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// // BuildPatchStreamPayload creates a streaming endpoint request payload from
+// the method payload and the path to the file to be streamed
+func BuildPatchStreamPayload(payload any, fpath string) (*user.PatchRequestData, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return &user.PatchRequestData{
+		Payload: payload.(*user.PatchPayload),
+		Body:    f,
+	}, nil
+}
+
 // BuildDeleteRequest instantiates a HTTP request object with method and path
 // set to call the "user" service "delete" endpoint
 func (c *Client) BuildDeleteRequest(ctx context.Context, v any) (*http.Request, error) {
@@ -545,6 +791,10 @@ func EncodeDeleteRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 		if !ok {
 			return goahttp.ErrInvalidType("user", "delete", "*user.DeletePayload", v)
 		}
+		{
+			head := p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
 		if p.Token != nil {
 			head := *p.Token
 			if !strings.Contains(head, " ") {
@@ -565,6 +815,7 @@ func EncodeDeleteRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 //   - "forbidden" (type *user.Forbidden): http.StatusForbidden
 //   - "internalServerError" (type *user.InternalServerError): http.StatusInternalServerError
 //   - "notFound" (type *user.NotFound): http.StatusNotFound
+//   - "preconditionFailed" (type *user.PreconditionFailed): http.StatusPreconditionFailed
 //   - "unauthorized" (type *user.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
 func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
@@ -640,6 +891,20 @@ func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 				return nil, goahttp.ErrValidationError("user", "delete", err)
 			}
 			return nil, NewDeleteNotFound(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body DeletePreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "delete", err)
+			}
+			err = ValidateDeletePreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "delete", err)
+			}
+			return nil, NewDeletePreconditionFailed(&body)
 		case http.StatusUnauthorized:
 			var (
 				body DeleteUnauthorizedResponseBody
@@ -661,6 +926,337 @@ func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 	}
 }
 
+// BuildRestoreRequest instantiates a HTTP request object with method and path
+// set to call the "user" service "restore" endpoint
+func (c *Client) BuildRestoreRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*user.RestorePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("user", "restore", "*user.RestorePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RestoreUserPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("user", "restore", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRestoreRequest returns an encoder for requests sent to the user
+// restore server.
+func EncodeRestoreRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*user.RestorePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("user", "restore", "*user.RestorePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeRestoreResponse returns a decoder for responses returned by the user
+// restore endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeRestoreResponse may return the following errors:
+//   - "badRequest" (type *user.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *user.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *user.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *user.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *user.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRestoreResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RestoreResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "restore", err)
+			}
+			err = ValidateRestoreResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "restore", err)
+			}
+			var (
+				etag *string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			res := NewRestoreUserOK(&body, etag)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body RestoreBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "restore", err)
+			}
+			err = ValidateRestoreBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "restore", err)
+			}
+			return nil, NewRestoreBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body RestoreForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "restore", err)
+			}
+			err = ValidateRestoreForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "restore", err)
+			}
+			return nil, NewRestoreForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RestoreInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "restore", err)
+			}
+			err = ValidateRestoreInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "restore", err)
+			}
+			return nil, NewRestoreInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body RestoreNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "restore", err)
+			}
+			err = ValidateRestoreNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "restore", err)
+			}
+			return nil, NewRestoreNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body RestoreUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "restore", err)
+			}
+			err = ValidateRestoreUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "restore", err)
+			}
+			return nil, NewRestoreUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("user", "restore", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListDeletedRequest instantiates a HTTP request object with method and
+// path set to call the "user" service "listDeleted" endpoint
+func (c *Client) BuildListDeletedRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListDeletedUserPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("user", "listDeleted", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListDeletedRequest returns an encoder for requests sent to the user
+// listDeleted server.
+func EncodeListDeletedRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*user.ListDeletedPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("user", "listDeleted", "*user.ListDeletedPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		values.Add("offset", fmt.Sprintf("%v", p.Offset))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListDeletedResponse returns a decoder for responses returned by the
+// user listDeleted endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeListDeletedResponse may return the following errors:
+//   - "badRequest" (type *user.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *user.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *user.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *user.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *user.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListDeletedResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListDeletedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "listDeleted", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateUserResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "listDeleted", err)
+			}
+			res := NewListDeletedUserOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListDeletedBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "listDeleted", err)
+			}
+			err = ValidateListDeletedBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "listDeleted", err)
+			}
+			return nil, NewListDeletedBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ListDeletedForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "listDeleted", err)
+			}
+			err = ValidateListDeletedForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "listDeleted", err)
+			}
+			return nil, NewListDeletedForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListDeletedInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "listDeleted", err)
+			}
+			err = ValidateListDeletedInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "listDeleted", err)
+			}
+			return nil, NewListDeletedInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListDeletedNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "listDeleted", err)
+			}
+			err = ValidateListDeletedNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "listDeleted", err)
+			}
+			return nil, NewListDeletedNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListDeletedUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "listDeleted", err)
+			}
+			err = ValidateListDeletedUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "listDeleted", err)
+			}
+			return nil, NewListDeletedUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("user", "listDeleted", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // unmarshalTrainingPlanResponseBodyToUserTrainingPlan builds a value of type
 // *user.TrainingPlan from a value of type *TrainingPlanResponseBody.
 func unmarshalTrainingPlanResponseBodyToUserTrainingPlan(v *TrainingPlanResponseBody) *user.TrainingPlan {
@@ -671,6 +1267,28 @@ func unmarshalTrainingPlanResponseBodyToUserTrainingPlan(v *TrainingPlanResponse
 		StartDate:   *v.StartDate,
 		EndDate:     *v.EndDate,
 		UserID:      *v.UserID,
+		DeletedAt:   v.DeletedAt,
+		Etag:        v.Etag,
+	}
+	if v.Warnings != nil {
+		res.Warnings = make([]string, len(v.Warnings))
+		for i, val := range v.Warnings {
+			res.Warnings[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalFieldErrorResponseBodyToUserFieldError builds a value of type
+// *user.FieldError from a value of type *FieldErrorResponseBody.
+func unmarshalFieldErrorResponseBodyToUserFieldError(v *FieldErrorResponseBody) *user.FieldError {
+	if v == nil {
+		return nil
+	}
+	res := &user.FieldError{
+		Field:   *v.Field,
+		Message: *v.Message,
 	}
 
 	return res
@@ -685,6 +1303,9 @@ func unmarshalUserResponseToUserUser(v *UserResponse) *user.User {
 		FirstName: *v.FirstName,
 		LastName:  *v.LastName,
 		Nickname:  v.Nickname,
+		Timezone:  v.Timezone,
+		DeletedAt: v.DeletedAt,
+		Etag:      v.Etag,
 	}
 	if v.Admin != nil {
 		res.Admin = *v.Admin
@@ -31,7 +31,22 @@ func UpdateUserPath(id string) string {
 	return fmt.Sprintf("/api/v1/user/%v", id)
 }
 
+// PatchUserPath returns the URL path to the user service patch HTTP endpoint.
+func PatchUserPath(id string) string {
+	return fmt.Sprintf("/api/v1/user/%v", id)
+}
+
 // DeleteUserPath returns the URL path to the user service delete HTTP endpoint.
 func DeleteUserPath(id string) string {
 	return fmt.Sprintf("/api/v1/user/%v", id)
 }
+
+// RestoreUserPath returns the URL path to the user service restore HTTP endpoint.
+func RestoreUserPath(id string) string {
+	return fmt.Sprintf("/api/v1/user/%v/restore", id)
+}
+
+// ListDeletedUserPath returns the URL path to the user service listDeleted HTTP endpoint.
+func ListDeletedUserPath() string {
+	return "/api/v1/user/deleted"
+}
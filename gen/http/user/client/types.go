@@ -39,6 +39,8 @@ type UpdateRequestBody struct {
 	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
 	// Is admin?
 	Admin bool `form:"admin" json:"admin" xml:"admin"`
+	// IANA time zone
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
 }
 
 // CreateResponseBody is the type of the "user" service "create" endpoint HTTP
@@ -56,6 +58,10 @@ type CreateResponseBody struct {
 	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
 	// Is the user an admin?
 	Admin *bool `form:"admin,omitempty" json:"admin,omitempty" xml:"admin,omitempty"`
+	// IANA time zone of the user
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// When the user was moved to the trash, set only for deleted users
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
 }
 
 // GetResponseBody is the type of the "user" service "get" endpoint HTTP
@@ -75,6 +81,10 @@ type GetResponseBody struct {
 	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
 	// Is the user an admin?
 	Admin *bool `form:"admin,omitempty" json:"admin,omitempty" xml:"admin,omitempty"`
+	// IANA time zone of the user
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// When the user was moved to the trash, set only for deleted users
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
 }
 
 // ListResponseBody is the type of the "user" service "list" endpoint HTTP
@@ -96,8 +106,58 @@ type UpdateResponseBody struct {
 	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
 	// Is the user an admin?
 	Admin *bool `form:"admin,omitempty" json:"admin,omitempty" xml:"admin,omitempty"`
+	// IANA time zone of the user
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// When the user was moved to the trash, set only for deleted users
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
 }
 
+// PatchResponseBody is the type of the "user" service "patch" endpoint HTTP
+// response body.
+type PatchResponseBody struct {
+	// Unique ID of the user
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Keycloak ID
+	KcID *string `form:"kcId,omitempty" json:"kcId,omitempty" xml:"kcId,omitempty"`
+	// First name of the user
+	FirstName *string `form:"firstName,omitempty" json:"firstName,omitempty" xml:"firstName,omitempty"`
+	// Last name of the user
+	LastName *string `form:"lastName,omitempty" json:"lastName,omitempty" xml:"lastName,omitempty"`
+	// Nickname
+	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
+	// Is the user an admin?
+	Admin *bool `form:"admin,omitempty" json:"admin,omitempty" xml:"admin,omitempty"`
+	// IANA time zone of the user
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// When the user was moved to the trash, set only for deleted users
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+}
+
+// RestoreResponseBody is the type of the "user" service "restore" endpoint
+// HTTP response body.
+type RestoreResponseBody struct {
+	// Unique ID of the user
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Keycloak ID
+	KcID *string `form:"kcId,omitempty" json:"kcId,omitempty" xml:"kcId,omitempty"`
+	// First name of the user
+	FirstName *string `form:"firstName,omitempty" json:"firstName,omitempty" xml:"firstName,omitempty"`
+	// Last name of the user
+	LastName *string `form:"lastName,omitempty" json:"lastName,omitempty" xml:"lastName,omitempty"`
+	// Nickname
+	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
+	// Is the user an admin?
+	Admin *bool `form:"admin,omitempty" json:"admin,omitempty" xml:"admin,omitempty"`
+	// IANA time zone of the user
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// When the user was moved to the trash, set only for deleted users
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+}
+
+// ListDeletedResponseBody is the type of the "user" service "listDeleted"
+// endpoint HTTP response body.
+type ListDeletedResponseBody []*UserResponse
+
 // GetBadRequestResponseBody is the type of the "user" service "get" endpoint
 // HTTP response body for the "badRequest" error.
 type GetBadRequestResponseBody struct {
@@ -113,6 +173,8 @@ type GetBadRequestResponseBody struct {
 	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
 	// Indica se l'errore è dovuto a un problema del server
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
 }
 
 // GetForbiddenResponseBody is the type of the "user" service "get" endpoint
@@ -158,6 +220,8 @@ type UpdateBadRequestResponseBody struct {
 	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
 	// Indica se l'errore è dovuto a un problema del server
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
 }
 
 // UpdateForbiddenResponseBody is the type of the "user" service "update"
@@ -181,6 +245,13 @@ type UpdateNotFoundResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// UpdatePreconditionFailedResponseBody is the type of the "user" service
+// "update" endpoint HTTP response body for the "preconditionFailed" error.
+type UpdatePreconditionFailedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // UpdateUnauthorizedResponseBody is the type of the "user" service "update"
 // endpoint HTTP response body for the "unauthorized" error.
 type UpdateUnauthorizedResponseBody struct {
@@ -188,6 +259,60 @@ type UpdateUnauthorizedResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// PatchBadRequestResponseBody is the type of the "user" service "patch"
+// endpoint HTTP response body for the "badRequest" error.
+type PatchBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// PatchForbiddenResponseBody is the type of the "user" service "patch"
+// endpoint HTTP response body for the "forbidden" error.
+type PatchForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PatchInternalServerErrorResponseBody is the type of the "user" service
+// "patch" endpoint HTTP response body for the "internalServerError" error.
+type PatchInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PatchNotFoundResponseBody is the type of the "user" service "patch" endpoint
+// HTTP response body for the "notFound" error.
+type PatchNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PatchPreconditionFailedResponseBody is the type of the "user" service
+// "patch" endpoint HTTP response body for the "preconditionFailed" error.
+type PatchPreconditionFailedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PatchUnauthorizedResponseBody is the type of the "user" service "patch"
+// endpoint HTTP response body for the "unauthorized" error.
+type PatchUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // DeleteBadRequestResponseBody is the type of the "user" service "delete"
 // endpoint HTTP response body for the "badRequest" error.
 type DeleteBadRequestResponseBody struct {
@@ -203,6 +328,8 @@ type DeleteBadRequestResponseBody struct {
 	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
 	// Indica se l'errore è dovuto a un problema del server
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
 }
 
 // DeleteForbiddenResponseBody is the type of the "user" service "delete"
@@ -226,6 +353,13 @@ type DeleteNotFoundResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// DeletePreconditionFailedResponseBody is the type of the "user" service
+// "delete" endpoint HTTP response body for the "preconditionFailed" error.
+type DeletePreconditionFailedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // DeleteUnauthorizedResponseBody is the type of the "user" service "delete"
 // endpoint HTTP response body for the "unauthorized" error.
 type DeleteUnauthorizedResponseBody struct {
@@ -233,6 +367,101 @@ type DeleteUnauthorizedResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// RestoreBadRequestResponseBody is the type of the "user" service "restore"
+// endpoint HTTP response body for the "badRequest" error.
+type RestoreBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// RestoreForbiddenResponseBody is the type of the "user" service "restore"
+// endpoint HTTP response body for the "forbidden" error.
+type RestoreForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RestoreInternalServerErrorResponseBody is the type of the "user" service
+// "restore" endpoint HTTP response body for the "internalServerError" error.
+type RestoreInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RestoreNotFoundResponseBody is the type of the "user" service "restore"
+// endpoint HTTP response body for the "notFound" error.
+type RestoreNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RestoreUnauthorizedResponseBody is the type of the "user" service "restore"
+// endpoint HTTP response body for the "unauthorized" error.
+type RestoreUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListDeletedBadRequestResponseBody is the type of the "user" service
+// "listDeleted" endpoint HTTP response body for the "badRequest" error.
+type ListDeletedBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListDeletedForbiddenResponseBody is the type of the "user" service
+// "listDeleted" endpoint HTTP response body for the "forbidden" error.
+type ListDeletedForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListDeletedInternalServerErrorResponseBody is the type of the "user" service
+// "listDeleted" endpoint HTTP response body for the "internalServerError"
+// error.
+type ListDeletedInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListDeletedNotFoundResponseBody is the type of the "user" service
+// "listDeleted" endpoint HTTP response body for the "notFound" error.
+type ListDeletedNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListDeletedUnauthorizedResponseBody is the type of the "user" service
+// "listDeleted" endpoint HTTP response body for the "unauthorized" error.
+type ListDeletedUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // TrainingPlanResponseBody is used to define fields on response body types.
 type TrainingPlanResponseBody struct {
 	// TrainingPlan ID
@@ -247,6 +476,21 @@ type TrainingPlanResponseBody struct {
 	EndDate *string `form:"endDate,omitempty" json:"endDate,omitempty" xml:"endDate,omitempty"`
 	// ID of the user who owns the plan
 	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+	// Entity tag of the current version, to send back in If-Match when changing it
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty" xml:"etag,omitempty"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+	// Motivo per cui il campo non è valido
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
 // UserResponse is used to define fields on response body types.
@@ -263,6 +507,12 @@ type UserResponse struct {
 	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
 	// Is the user an admin?
 	Admin *bool `form:"admin,omitempty" json:"admin,omitempty" xml:"admin,omitempty"`
+	// IANA time zone of the user
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// When the user was moved to the trash, set only for deleted users
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+	// Entity tag of the current version, to send back in If-Match when changing it
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty" xml:"etag,omitempty"`
 }
 
 // NewCreateRequestBody builds the HTTP request body from the payload of the
@@ -292,6 +542,7 @@ func NewUpdateRequestBody(p *user.UpdatePayload) *UpdateRequestBody {
 		LastName:  p.LastName,
 		Nickname:  p.Nickname,
 		Admin:     p.Admin,
+		Timezone:  p.Timezone,
 	}
 	{
 		var zero bool
@@ -304,13 +555,15 @@ func NewUpdateRequestBody(p *user.UpdatePayload) *UpdateRequestBody {
 
 // NewCreateUserCreated builds a "user" service "create" endpoint result from a
 // HTTP "Created" response.
-func NewCreateUserCreated(body *CreateResponseBody) *user.User {
+func NewCreateUserCreated(body *CreateResponseBody, etag *string) *user.User {
 	v := &user.User{
 		ID:        *body.ID,
 		KcID:      *body.KcID,
 		FirstName: *body.FirstName,
 		LastName:  *body.LastName,
 		Nickname:  body.Nickname,
+		Timezone:  body.Timezone,
+		DeletedAt: body.DeletedAt,
 	}
 	if body.Admin != nil {
 		v.Admin = *body.Admin
@@ -318,19 +571,22 @@ func NewCreateUserCreated(body *CreateResponseBody) *user.User {
 	if body.Admin == nil {
 		v.Admin = false
 	}
+	v.Etag = etag
 
 	return v
 }
 
 // NewGetUserWithPlansOK builds a "user" service "get" endpoint result from a
 // HTTP "OK" response.
-func NewGetUserWithPlansOK(body *GetResponseBody) *user.UserWithPlans {
+func NewGetUserWithPlansOK(body *GetResponseBody, etag *string) *user.UserWithPlans {
 	v := &user.UserWithPlans{
 		ID:        *body.ID,
 		KcID:      *body.KcID,
 		FirstName: *body.FirstName,
 		LastName:  *body.LastName,
 		Nickname:  body.Nickname,
+		Timezone:  body.Timezone,
+		DeletedAt: body.DeletedAt,
 	}
 	if body.Admin != nil {
 		v.Admin = *body.Admin
@@ -342,6 +598,7 @@ func NewGetUserWithPlansOK(body *GetResponseBody) *user.UserWithPlans {
 	if body.Admin == nil {
 		v.Admin = false
 	}
+	v.Etag = etag
 
 	return v
 }
@@ -356,6 +613,12 @@ func NewGetBadRequest(body *GetBadRequestResponseBody) *user.BadRequest {
 		Timeout:   *body.Timeout,
 		Fault:     *body.Fault,
 	}
+	if body.Fields != nil {
+		v.Fields = make([]*user.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToUserFieldError(val)
+		}
+	}
 
 	return v
 }
@@ -410,13 +673,15 @@ func NewListUserOK(body []*UserResponse) []*user.User {
 
 // NewUpdateUserOK builds a "user" service "update" endpoint result from a HTTP
 // "OK" response.
-func NewUpdateUserOK(body *UpdateResponseBody) *user.User {
+func NewUpdateUserOK(body *UpdateResponseBody, etag *string) *user.User {
 	v := &user.User{
 		ID:        *body.ID,
 		KcID:      *body.KcID,
 		FirstName: *body.FirstName,
 		LastName:  *body.LastName,
 		Nickname:  body.Nickname,
+		Timezone:  body.Timezone,
+		DeletedAt: body.DeletedAt,
 	}
 	if body.Admin != nil {
 		v.Admin = *body.Admin
@@ -424,6 +689,7 @@ func NewUpdateUserOK(body *UpdateResponseBody) *user.User {
 	if body.Admin == nil {
 		v.Admin = false
 	}
+	v.Etag = etag
 
 	return v
 }
@@ -438,6 +704,12 @@ func NewUpdateBadRequest(body *UpdateBadRequestResponseBody) *user.BadRequest {
 		Timeout:   *body.Timeout,
 		Fault:     *body.Fault,
 	}
+	if body.Fields != nil {
+		v.Fields = make([]*user.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToUserFieldError(val)
+		}
+	}
 
 	return v
 }
@@ -470,6 +742,16 @@ func NewUpdateNotFound(body *UpdateNotFoundResponseBody) *user.NotFound {
 	return v
 }
 
+// NewUpdatePreconditionFailed builds a user service update endpoint
+// preconditionFailed error.
+func NewUpdatePreconditionFailed(body *UpdatePreconditionFailedResponseBody) *user.PreconditionFailed {
+	v := &user.PreconditionFailed{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
 // NewUpdateUnauthorized builds a user service update endpoint unauthorized
 // error.
 func NewUpdateUnauthorized(body *UpdateUnauthorizedResponseBody) *user.Unauthorized {
@@ -480,6 +762,96 @@ func NewUpdateUnauthorized(body *UpdateUnauthorizedResponseBody) *user.Unauthori
 	return v
 }
 
+// NewPatchUserOK builds a "user" service "patch" endpoint result from a HTTP
+// "OK" response.
+func NewPatchUserOK(body *PatchResponseBody, etag *string) *user.User {
+	v := &user.User{
+		ID:        *body.ID,
+		KcID:      *body.KcID,
+		FirstName: *body.FirstName,
+		LastName:  *body.LastName,
+		Nickname:  body.Nickname,
+		Timezone:  body.Timezone,
+		DeletedAt: body.DeletedAt,
+	}
+	if body.Admin != nil {
+		v.Admin = *body.Admin
+	}
+	if body.Admin == nil {
+		v.Admin = false
+	}
+	v.Etag = etag
+
+	return v
+}
+
+// NewPatchBadRequest builds a user service patch endpoint badRequest error.
+func NewPatchBadRequest(body *PatchBadRequestResponseBody) *user.BadRequest {
+	v := &user.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*user.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToUserFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewPatchForbidden builds a user service patch endpoint forbidden error.
+func NewPatchForbidden(body *PatchForbiddenResponseBody) *user.Forbidden {
+	v := &user.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPatchInternalServerError builds a user service patch endpoint
+// internalServerError error.
+func NewPatchInternalServerError(body *PatchInternalServerErrorResponseBody) *user.InternalServerError {
+	v := &user.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPatchNotFound builds a user service patch endpoint notFound error.
+func NewPatchNotFound(body *PatchNotFoundResponseBody) *user.NotFound {
+	v := &user.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPatchPreconditionFailed builds a user service patch endpoint
+// preconditionFailed error.
+func NewPatchPreconditionFailed(body *PatchPreconditionFailedResponseBody) *user.PreconditionFailed {
+	v := &user.PreconditionFailed{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPatchUnauthorized builds a user service patch endpoint unauthorized error.
+func NewPatchUnauthorized(body *PatchUnauthorizedResponseBody) *user.Unauthorized {
+	v := &user.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
 // NewDeleteBadRequest builds a user service delete endpoint badRequest error.
 func NewDeleteBadRequest(body *DeleteBadRequestResponseBody) *user.BadRequest {
 	v := &user.BadRequest{
@@ -490,6 +862,12 @@ func NewDeleteBadRequest(body *DeleteBadRequestResponseBody) *user.BadRequest {
 		Timeout:   *body.Timeout,
 		Fault:     *body.Fault,
 	}
+	if body.Fields != nil {
+		v.Fields = make([]*user.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToUserFieldError(val)
+		}
+	}
 
 	return v
 }
@@ -522,6 +900,16 @@ func NewDeleteNotFound(body *DeleteNotFoundResponseBody) *user.NotFound {
 	return v
 }
 
+// NewDeletePreconditionFailed builds a user service delete endpoint
+// preconditionFailed error.
+func NewDeletePreconditionFailed(body *DeletePreconditionFailedResponseBody) *user.PreconditionFailed {
+	v := &user.PreconditionFailed{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
 // NewDeleteUnauthorized builds a user service delete endpoint unauthorized
 // error.
 func NewDeleteUnauthorized(body *DeleteUnauthorizedResponseBody) *user.Unauthorized {
@@ -532,25 +920,181 @@ func NewDeleteUnauthorized(body *DeleteUnauthorizedResponseBody) *user.Unauthori
 	return v
 }
 
-// ValidateCreateResponseBody runs the validations defined on CreateResponseBody
-func ValidateCreateResponseBody(body *CreateResponseBody) (err error) {
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
-	}
-	if body.KcID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("kcId", "body"))
-	}
-	if body.FirstName == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("firstName", "body"))
+// NewRestoreUserOK builds a "user" service "restore" endpoint result from a
+// HTTP "OK" response.
+func NewRestoreUserOK(body *RestoreResponseBody, etag *string) *user.User {
+	v := &user.User{
+		ID:        *body.ID,
+		KcID:      *body.KcID,
+		FirstName: *body.FirstName,
+		LastName:  *body.LastName,
+		Nickname:  body.Nickname,
+		Timezone:  body.Timezone,
+		DeletedAt: body.DeletedAt,
 	}
-	if body.LastName == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("lastName", "body"))
+	if body.Admin != nil {
+		v.Admin = *body.Admin
 	}
-	if body.ID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	if body.Admin == nil {
+		v.Admin = false
 	}
-	if body.KcID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.kcId", *body.KcID, goa.FormatUUID))
+	v.Etag = etag
+
+	return v
+}
+
+// NewRestoreBadRequest builds a user service restore endpoint badRequest error.
+func NewRestoreBadRequest(body *RestoreBadRequestResponseBody) *user.BadRequest {
+	v := &user.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*user.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToUserFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewRestoreForbidden builds a user service restore endpoint forbidden error.
+func NewRestoreForbidden(body *RestoreForbiddenResponseBody) *user.Forbidden {
+	v := &user.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRestoreInternalServerError builds a user service restore endpoint
+// internalServerError error.
+func NewRestoreInternalServerError(body *RestoreInternalServerErrorResponseBody) *user.InternalServerError {
+	v := &user.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRestoreNotFound builds a user service restore endpoint notFound error.
+func NewRestoreNotFound(body *RestoreNotFoundResponseBody) *user.NotFound {
+	v := &user.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRestoreUnauthorized builds a user service restore endpoint unauthorized
+// error.
+func NewRestoreUnauthorized(body *RestoreUnauthorizedResponseBody) *user.Unauthorized {
+	v := &user.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListDeletedUserOK builds a "user" service "listDeleted" endpoint result
+// from a HTTP "OK" response.
+func NewListDeletedUserOK(body []*UserResponse) []*user.User {
+	v := make([]*user.User, len(body))
+	for i, val := range body {
+		v[i] = unmarshalUserResponseToUserUser(val)
+	}
+
+	return v
+}
+
+// NewListDeletedBadRequest builds a user service listDeleted endpoint
+// badRequest error.
+func NewListDeletedBadRequest(body *ListDeletedBadRequestResponseBody) *user.BadRequest {
+	v := &user.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*user.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToUserFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewListDeletedForbidden builds a user service listDeleted endpoint forbidden
+// error.
+func NewListDeletedForbidden(body *ListDeletedForbiddenResponseBody) *user.Forbidden {
+	v := &user.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListDeletedInternalServerError builds a user service listDeleted endpoint
+// internalServerError error.
+func NewListDeletedInternalServerError(body *ListDeletedInternalServerErrorResponseBody) *user.InternalServerError {
+	v := &user.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListDeletedNotFound builds a user service listDeleted endpoint notFound
+// error.
+func NewListDeletedNotFound(body *ListDeletedNotFoundResponseBody) *user.NotFound {
+	v := &user.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListDeletedUnauthorized builds a user service listDeleted endpoint
+// unauthorized error.
+func NewListDeletedUnauthorized(body *ListDeletedUnauthorizedResponseBody) *user.Unauthorized {
+	v := &user.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// ValidateCreateResponseBody runs the validations defined on CreateResponseBody
+func ValidateCreateResponseBody(body *CreateResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.KcID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kcId", "body"))
+	}
+	if body.FirstName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("firstName", "body"))
+	}
+	if body.LastName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("lastName", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.KcID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.kcId", *body.KcID, goa.FormatUUID))
+	}
+	if body.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deletedAt", *body.DeletedAt, goa.FormatDateTime))
 	}
 	return
 }
@@ -585,6 +1129,9 @@ func ValidateGetResponseBody(body *GetResponseBody) (err error) {
 	if body.KcID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.kcId", *body.KcID, goa.FormatUUID))
 	}
+	if body.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deletedAt", *body.DeletedAt, goa.FormatDateTime))
+	}
 	return
 }
 
@@ -608,6 +1155,62 @@ func ValidateUpdateResponseBody(body *UpdateResponseBody) (err error) {
 	if body.KcID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.kcId", *body.KcID, goa.FormatUUID))
 	}
+	if body.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deletedAt", *body.DeletedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidatePatchResponseBody runs the validations defined on PatchResponseBody
+func ValidatePatchResponseBody(body *PatchResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.KcID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kcId", "body"))
+	}
+	if body.FirstName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("firstName", "body"))
+	}
+	if body.LastName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("lastName", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.KcID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.kcId", *body.KcID, goa.FormatUUID))
+	}
+	if body.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deletedAt", *body.DeletedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateRestoreResponseBody runs the validations defined on
+// RestoreResponseBody
+func ValidateRestoreResponseBody(body *RestoreResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.KcID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kcId", "body"))
+	}
+	if body.FirstName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("firstName", "body"))
+	}
+	if body.LastName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("lastName", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.KcID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.kcId", *body.KcID, goa.FormatUUID))
+	}
+	if body.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deletedAt", *body.DeletedAt, goa.FormatDateTime))
+	}
 	return
 }
 
@@ -632,6 +1235,13 @@ func ValidateGetBadRequestResponseBody(body *GetBadRequestResponseBody) (err err
 	if body.Fault == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
@@ -692,6 +1302,13 @@ func ValidateUpdateBadRequestResponseBody(body *UpdateBadRequestResponseBody) (e
 	if body.Fault == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
@@ -722,6 +1339,15 @@ func ValidateUpdateNotFoundResponseBody(body *UpdateNotFoundResponseBody) (err e
 	return
 }
 
+// ValidateUpdatePreconditionFailedResponseBody runs the validations defined on
+// update_preconditionFailed_response_body
+func ValidateUpdatePreconditionFailedResponseBody(body *UpdatePreconditionFailedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
 // ValidateUpdateUnauthorizedResponseBody runs the validations defined on
 // update_unauthorized_response_body
 func ValidateUpdateUnauthorizedResponseBody(body *UpdateUnauthorizedResponseBody) (err error) {
@@ -731,6 +1357,82 @@ func ValidateUpdateUnauthorizedResponseBody(body *UpdateUnauthorizedResponseBody
 	return
 }
 
+// ValidatePatchBadRequestResponseBody runs the validations defined on
+// patch_badRequest_response_body
+func ValidatePatchBadRequestResponseBody(body *PatchBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePatchForbiddenResponseBody runs the validations defined on
+// patch_forbidden_response_body
+func ValidatePatchForbiddenResponseBody(body *PatchForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchInternalServerErrorResponseBody runs the validations defined on
+// patch_internalServerError_response_body
+func ValidatePatchInternalServerErrorResponseBody(body *PatchInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchNotFoundResponseBody runs the validations defined on
+// patch_notFound_response_body
+func ValidatePatchNotFoundResponseBody(body *PatchNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchPreconditionFailedResponseBody runs the validations defined on
+// patch_preconditionFailed_response_body
+func ValidatePatchPreconditionFailedResponseBody(body *PatchPreconditionFailedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchUnauthorizedResponseBody runs the validations defined on
+// patch_unauthorized_response_body
+func ValidatePatchUnauthorizedResponseBody(body *PatchUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
 // ValidateDeleteBadRequestResponseBody runs the validations defined on
 // delete_badRequest_response_body
 func ValidateDeleteBadRequestResponseBody(body *DeleteBadRequestResponseBody) (err error) {
@@ -752,6 +1454,13 @@ func ValidateDeleteBadRequestResponseBody(body *DeleteBadRequestResponseBody) (e
 	if body.Fault == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
@@ -782,6 +1491,15 @@ func ValidateDeleteNotFoundResponseBody(body *DeleteNotFoundResponseBody) (err e
 	return
 }
 
+// ValidateDeletePreconditionFailedResponseBody runs the validations defined on
+// delete_preconditionFailed_response_body
+func ValidateDeletePreconditionFailedResponseBody(body *DeletePreconditionFailedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
 // ValidateDeleteUnauthorizedResponseBody runs the validations defined on
 // delete_unauthorized_response_body
 func ValidateDeleteUnauthorizedResponseBody(body *DeleteUnauthorizedResponseBody) (err error) {
@@ -791,6 +1509,140 @@ func ValidateDeleteUnauthorizedResponseBody(body *DeleteUnauthorizedResponseBody
 	return
 }
 
+// ValidateRestoreBadRequestResponseBody runs the validations defined on
+// restore_badRequest_response_body
+func ValidateRestoreBadRequestResponseBody(body *RestoreBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRestoreForbiddenResponseBody runs the validations defined on
+// restore_forbidden_response_body
+func ValidateRestoreForbiddenResponseBody(body *RestoreForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRestoreInternalServerErrorResponseBody runs the validations defined
+// on restore_internalServerError_response_body
+func ValidateRestoreInternalServerErrorResponseBody(body *RestoreInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRestoreNotFoundResponseBody runs the validations defined on
+// restore_notFound_response_body
+func ValidateRestoreNotFoundResponseBody(body *RestoreNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRestoreUnauthorizedResponseBody runs the validations defined on
+// restore_unauthorized_response_body
+func ValidateRestoreUnauthorizedResponseBody(body *RestoreUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListDeletedBadRequestResponseBody runs the validations defined on
+// listDeleted_badRequest_response_body
+func ValidateListDeletedBadRequestResponseBody(body *ListDeletedBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListDeletedForbiddenResponseBody runs the validations defined on
+// listDeleted_forbidden_response_body
+func ValidateListDeletedForbiddenResponseBody(body *ListDeletedForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListDeletedInternalServerErrorResponseBody runs the validations
+// defined on listDeleted_internalServerError_response_body
+func ValidateListDeletedInternalServerErrorResponseBody(body *ListDeletedInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListDeletedNotFoundResponseBody runs the validations defined on
+// listDeleted_notFound_response_body
+func ValidateListDeletedNotFoundResponseBody(body *ListDeletedNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListDeletedUnauthorizedResponseBody runs the validations defined on
+// listDeleted_unauthorized_response_body
+func ValidateListDeletedUnauthorizedResponseBody(body *ListDeletedUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
 // ValidateTrainingPlanResponseBody runs the validations defined on
 // TrainingPlanResponseBody
 func ValidateTrainingPlanResponseBody(body *TrainingPlanResponseBody) (err error) {
@@ -821,6 +1673,21 @@ func ValidateTrainingPlanResponseBody(body *TrainingPlanResponseBody) (err error
 	if body.UserID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
 	}
+	if body.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deletedAt", *body.DeletedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateFieldErrorResponseBody runs the validations defined on
+// FieldErrorResponseBody
+func ValidateFieldErrorResponseBody(body *FieldErrorResponseBody) (err error) {
+	if body.Field == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("field", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
 	return
 }
 
@@ -844,5 +1711,8 @@ func ValidateUserResponse(body *UserResponse) (err error) {
 	if body.KcID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.kcId", *body.KcID, goa.FormatUUID))
 	}
+	if body.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deletedAt", *body.DeletedAt, goa.FormatDateTime))
+	}
 	return
 }
@@ -18,12 +18,15 @@ import (
 
 // Server lists the user service endpoint HTTP handlers.
 type Server struct {
-	Mounts []*MountPoint
-	Create http.Handler
-	Get    http.Handler
-	List   http.Handler
-	Update http.Handler
-	Delete http.Handler
+	Mounts      []*MountPoint
+	Create      http.Handler
+	Get         http.Handler
+	List        http.Handler
+	Update      http.Handler
+	Patch       http.Handler
+	Delete      http.Handler
+	Restore     http.Handler
+	ListDeleted http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -57,13 +60,19 @@ func New(
 			{"Get", "GET", "/api/v1/user/{id}"},
 			{"List", "GET", "/api/v1/user"},
 			{"Update", "PUT", "/api/v1/user/{id}"},
+			{"Patch", "PATCH", "/api/v1/user/{id}"},
 			{"Delete", "DELETE", "/api/v1/user/{id}"},
+			{"Restore", "POST", "/api/v1/user/{id}/restore"},
+			{"ListDeleted", "GET", "/api/v1/user/deleted"},
 		},
-		Create: NewCreateHandler(e.Create, mux, decoder, encoder, errhandler, formatter),
-		Get:    NewGetHandler(e.Get, mux, decoder, encoder, errhandler, formatter),
-		List:   NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
-		Update: NewUpdateHandler(e.Update, mux, decoder, encoder, errhandler, formatter),
-		Delete: NewDeleteHandler(e.Delete, mux, decoder, encoder, errhandler, formatter),
+		Create:      NewCreateHandler(e.Create, mux, decoder, encoder, errhandler, formatter),
+		Get:         NewGetHandler(e.Get, mux, decoder, encoder, errhandler, formatter),
+		List:        NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
+		Update:      NewUpdateHandler(e.Update, mux, decoder, encoder, errhandler, formatter),
+		Patch:       NewPatchHandler(e.Patch, mux, decoder, encoder, errhandler, formatter),
+		Delete:      NewDeleteHandler(e.Delete, mux, decoder, encoder, errhandler, formatter),
+		Restore:     NewRestoreHandler(e.Restore, mux, decoder, encoder, errhandler, formatter),
+		ListDeleted: NewListDeletedHandler(e.ListDeleted, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -76,7 +85,10 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Get = m(s.Get)
 	s.List = m(s.List)
 	s.Update = m(s.Update)
+	s.Patch = m(s.Patch)
 	s.Delete = m(s.Delete)
+	s.Restore = m(s.Restore)
+	s.ListDeleted = m(s.ListDeleted)
 }
 
 // MethodNames returns the methods served.
@@ -88,7 +100,10 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountGetHandler(mux, h.Get)
 	MountListHandler(mux, h.List)
 	MountUpdateHandler(mux, h.Update)
+	MountPatchHandler(mux, h.Patch)
 	MountDeleteHandler(mux, h.Delete)
+	MountRestoreHandler(mux, h.Restore)
+	MountListDeletedHandler(mux, h.ListDeleted)
 }
 
 // Mount configures the mux to serve the user endpoints.
@@ -300,6 +315,58 @@ func NewUpdateHandler(
 	})
 }
 
+// MountPatchHandler configures the mux to serve the "user" service "patch"
+// endpoint.
+func MountPatchHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PATCH", "/api/v1/user/{id}", f)
+}
+
+// NewPatchHandler creates a HTTP handler which loads the HTTP request and
+// calls the "user" service "patch" endpoint.
+func NewPatchHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePatchRequest(mux, decoder)
+		encodeResponse = EncodePatchResponse(encoder)
+		encodeError    = EncodePatchError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "patch")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "user")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		data := &user.PatchRequestData{Payload: payload.(*user.PatchPayload), Body: r.Body}
+		res, err := endpoint(ctx, data)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountDeleteHandler configures the mux to serve the "user" service "delete"
 // endpoint.
 func MountDeleteHandler(mux goahttp.Muxer, h http.Handler) {
@@ -350,3 +417,105 @@ func NewDeleteHandler(
 		}
 	})
 }
+
+// MountRestoreHandler configures the mux to serve the "user" service "restore"
+// endpoint.
+func MountRestoreHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/v1/user/{id}/restore", f)
+}
+
+// NewRestoreHandler creates a HTTP handler which loads the HTTP request and
+// calls the "user" service "restore" endpoint.
+func NewRestoreHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRestoreRequest(mux, decoder)
+		encodeResponse = EncodeRestoreResponse(encoder)
+		encodeError    = EncodeRestoreError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "restore")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "user")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListDeletedHandler configures the mux to serve the "user" service
+// "listDeleted" endpoint.
+func MountListDeletedHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/v1/user/deleted", f)
+}
+
+// NewListDeletedHandler creates a HTTP handler which loads the HTTP request
+// and calls the "user" service "listDeleted" endpoint.
+func NewListDeletedHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListDeletedRequest(mux, decoder)
+		encodeResponse = EncodeListDeletedResponse(encoder)
+		encodeError    = EncodeListDeletedError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listDeleted")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "user")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
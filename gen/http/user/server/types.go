@@ -40,6 +40,8 @@ type UpdateRequestBody struct {
 	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
 	// Is admin?
 	Admin *bool `form:"admin,omitempty" json:"admin,omitempty" xml:"admin,omitempty"`
+	// IANA time zone
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
 }
 
 // CreateResponseBody is the type of the "user" service "create" endpoint HTTP
@@ -57,6 +59,10 @@ type CreateResponseBody struct {
 	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
 	// Is the user an admin?
 	Admin bool `form:"admin" json:"admin" xml:"admin"`
+	// IANA time zone of the user
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// When the user was moved to the trash, set only for deleted users
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
 }
 
 // GetResponseBody is the type of the "user" service "get" endpoint HTTP
@@ -76,6 +82,10 @@ type GetResponseBody struct {
 	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
 	// Is the user an admin?
 	Admin bool `form:"admin" json:"admin" xml:"admin"`
+	// IANA time zone of the user
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// When the user was moved to the trash, set only for deleted users
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
 }
 
 // ListResponseBody is the type of the "user" service "list" endpoint HTTP
@@ -97,8 +107,58 @@ type UpdateResponseBody struct {
 	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
 	// Is the user an admin?
 	Admin bool `form:"admin" json:"admin" xml:"admin"`
+	// IANA time zone of the user
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// When the user was moved to the trash, set only for deleted users
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
 }
 
+// PatchResponseBody is the type of the "user" service "patch" endpoint HTTP
+// response body.
+type PatchResponseBody struct {
+	// Unique ID of the user
+	ID string `form:"id" json:"id" xml:"id"`
+	// Keycloak ID
+	KcID string `form:"kcId" json:"kcId" xml:"kcId"`
+	// First name of the user
+	FirstName string `form:"firstName" json:"firstName" xml:"firstName"`
+	// Last name of the user
+	LastName string `form:"lastName" json:"lastName" xml:"lastName"`
+	// Nickname
+	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
+	// Is the user an admin?
+	Admin bool `form:"admin" json:"admin" xml:"admin"`
+	// IANA time zone of the user
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// When the user was moved to the trash, set only for deleted users
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+}
+
+// RestoreResponseBody is the type of the "user" service "restore" endpoint
+// HTTP response body.
+type RestoreResponseBody struct {
+	// Unique ID of the user
+	ID string `form:"id" json:"id" xml:"id"`
+	// Keycloak ID
+	KcID string `form:"kcId" json:"kcId" xml:"kcId"`
+	// First name of the user
+	FirstName string `form:"firstName" json:"firstName" xml:"firstName"`
+	// Last name of the user
+	LastName string `form:"lastName" json:"lastName" xml:"lastName"`
+	// Nickname
+	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
+	// Is the user an admin?
+	Admin bool `form:"admin" json:"admin" xml:"admin"`
+	// IANA time zone of the user
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// When the user was moved to the trash, set only for deleted users
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+}
+
+// ListDeletedResponseBody is the type of the "user" service "listDeleted"
+// endpoint HTTP response body.
+type ListDeletedResponseBody []*UserResponse
+
 // GetBadRequestResponseBody is the type of the "user" service "get" endpoint
 // HTTP response body for the "badRequest" error.
 type GetBadRequestResponseBody struct {
@@ -114,6 +174,8 @@ type GetBadRequestResponseBody struct {
 	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
 	// Indica se l'errore è dovuto a un problema del server
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
 }
 
 // GetForbiddenResponseBody is the type of the "user" service "get" endpoint
@@ -159,6 +221,8 @@ type UpdateBadRequestResponseBody struct {
 	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
 	// Indica se l'errore è dovuto a un problema del server
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
 }
 
 // UpdateForbiddenResponseBody is the type of the "user" service "update"
@@ -182,6 +246,13 @@ type UpdateNotFoundResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// UpdatePreconditionFailedResponseBody is the type of the "user" service
+// "update" endpoint HTTP response body for the "preconditionFailed" error.
+type UpdatePreconditionFailedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // UpdateUnauthorizedResponseBody is the type of the "user" service "update"
 // endpoint HTTP response body for the "unauthorized" error.
 type UpdateUnauthorizedResponseBody struct {
@@ -189,6 +260,60 @@ type UpdateUnauthorizedResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// PatchBadRequestResponseBody is the type of the "user" service "patch"
+// endpoint HTTP response body for the "badRequest" error.
+type PatchBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// PatchForbiddenResponseBody is the type of the "user" service "patch"
+// endpoint HTTP response body for the "forbidden" error.
+type PatchForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PatchInternalServerErrorResponseBody is the type of the "user" service
+// "patch" endpoint HTTP response body for the "internalServerError" error.
+type PatchInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PatchNotFoundResponseBody is the type of the "user" service "patch" endpoint
+// HTTP response body for the "notFound" error.
+type PatchNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PatchPreconditionFailedResponseBody is the type of the "user" service
+// "patch" endpoint HTTP response body for the "preconditionFailed" error.
+type PatchPreconditionFailedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PatchUnauthorizedResponseBody is the type of the "user" service "patch"
+// endpoint HTTP response body for the "unauthorized" error.
+type PatchUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // DeleteBadRequestResponseBody is the type of the "user" service "delete"
 // endpoint HTTP response body for the "badRequest" error.
 type DeleteBadRequestResponseBody struct {
@@ -204,6 +329,8 @@ type DeleteBadRequestResponseBody struct {
 	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
 	// Indica se l'errore è dovuto a un problema del server
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
 }
 
 // DeleteForbiddenResponseBody is the type of the "user" service "delete"
@@ -227,6 +354,13 @@ type DeleteNotFoundResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// DeletePreconditionFailedResponseBody is the type of the "user" service
+// "delete" endpoint HTTP response body for the "preconditionFailed" error.
+type DeletePreconditionFailedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // DeleteUnauthorizedResponseBody is the type of the "user" service "delete"
 // endpoint HTTP response body for the "unauthorized" error.
 type DeleteUnauthorizedResponseBody struct {
@@ -234,6 +368,101 @@ type DeleteUnauthorizedResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// RestoreBadRequestResponseBody is the type of the "user" service "restore"
+// endpoint HTTP response body for the "badRequest" error.
+type RestoreBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// RestoreForbiddenResponseBody is the type of the "user" service "restore"
+// endpoint HTTP response body for the "forbidden" error.
+type RestoreForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RestoreInternalServerErrorResponseBody is the type of the "user" service
+// "restore" endpoint HTTP response body for the "internalServerError" error.
+type RestoreInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RestoreNotFoundResponseBody is the type of the "user" service "restore"
+// endpoint HTTP response body for the "notFound" error.
+type RestoreNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RestoreUnauthorizedResponseBody is the type of the "user" service "restore"
+// endpoint HTTP response body for the "unauthorized" error.
+type RestoreUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListDeletedBadRequestResponseBody is the type of the "user" service
+// "listDeleted" endpoint HTTP response body for the "badRequest" error.
+type ListDeletedBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListDeletedForbiddenResponseBody is the type of the "user" service
+// "listDeleted" endpoint HTTP response body for the "forbidden" error.
+type ListDeletedForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListDeletedInternalServerErrorResponseBody is the type of the "user" service
+// "listDeleted" endpoint HTTP response body for the "internalServerError"
+// error.
+type ListDeletedInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListDeletedNotFoundResponseBody is the type of the "user" service
+// "listDeleted" endpoint HTTP response body for the "notFound" error.
+type ListDeletedNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListDeletedUnauthorizedResponseBody is the type of the "user" service
+// "listDeleted" endpoint HTTP response body for the "unauthorized" error.
+type ListDeletedUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // TrainingPlanResponseBody is used to define fields on response body types.
 type TrainingPlanResponseBody struct {
 	// TrainingPlan ID
@@ -248,6 +477,21 @@ type TrainingPlanResponseBody struct {
 	EndDate string `form:"endDate" json:"endDate" xml:"endDate"`
 	// ID of the user who owns the plan
 	UserID string `form:"userId" json:"userId" xml:"userId"`
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+	// Entity tag of the current version, to send back in If-Match when changing it
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty" xml:"etag,omitempty"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field string `form:"field" json:"field" xml:"field"`
+	// Motivo per cui il campo non è valido
+	Message string `form:"message" json:"message" xml:"message"`
 }
 
 // UserResponse is used to define fields on response body types.
@@ -264,6 +508,12 @@ type UserResponse struct {
 	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
 	// Is the user an admin?
 	Admin bool `form:"admin" json:"admin" xml:"admin"`
+	// IANA time zone of the user
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// When the user was moved to the trash, set only for deleted users
+	DeletedAt *string `form:"deletedAt,omitempty" json:"deletedAt,omitempty" xml:"deletedAt,omitempty"`
+	// Entity tag of the current version, to send back in If-Match when changing it
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty" xml:"etag,omitempty"`
 }
 
 // NewCreateResponseBody builds the HTTP response body from the result of the
@@ -276,6 +526,8 @@ func NewCreateResponseBody(res *user.User) *CreateResponseBody {
 		LastName:  res.LastName,
 		Nickname:  res.Nickname,
 		Admin:     res.Admin,
+		Timezone:  res.Timezone,
+		DeletedAt: res.DeletedAt,
 	}
 	{
 		var zero bool
@@ -296,6 +548,8 @@ func NewGetResponseBody(res *user.UserWithPlans) *GetResponseBody {
 		LastName:  res.LastName,
 		Nickname:  res.Nickname,
 		Admin:     res.Admin,
+		Timezone:  res.Timezone,
+		DeletedAt: res.DeletedAt,
 	}
 	if res.TrainingPlans != nil {
 		body.TrainingPlans = make([]*TrainingPlanResponseBody, len(res.TrainingPlans))
@@ -334,6 +588,52 @@ func NewUpdateResponseBody(res *user.User) *UpdateResponseBody {
 		LastName:  res.LastName,
 		Nickname:  res.Nickname,
 		Admin:     res.Admin,
+		Timezone:  res.Timezone,
+		DeletedAt: res.DeletedAt,
+	}
+	{
+		var zero bool
+		if body.Admin == zero {
+			body.Admin = false
+		}
+	}
+	return body
+}
+
+// NewPatchResponseBody builds the HTTP response body from the result of the
+// "patch" endpoint of the "user" service.
+func NewPatchResponseBody(res *user.User) *PatchResponseBody {
+	body := &PatchResponseBody{
+		ID:        res.ID,
+		KcID:      res.KcID,
+		FirstName: res.FirstName,
+		LastName:  res.LastName,
+		Nickname:  res.Nickname,
+		Admin:     res.Admin,
+		Timezone:  res.Timezone,
+		DeletedAt: res.DeletedAt,
+	}
+	{
+		var zero bool
+		if body.Admin == zero {
+			body.Admin = false
+		}
+	}
+	return body
+}
+
+// NewRestoreResponseBody builds the HTTP response body from the result of the
+// "restore" endpoint of the "user" service.
+func NewRestoreResponseBody(res *user.User) *RestoreResponseBody {
+	body := &RestoreResponseBody{
+		ID:        res.ID,
+		KcID:      res.KcID,
+		FirstName: res.FirstName,
+		LastName:  res.LastName,
+		Nickname:  res.Nickname,
+		Admin:     res.Admin,
+		Timezone:  res.Timezone,
+		DeletedAt: res.DeletedAt,
 	}
 	{
 		var zero bool
@@ -344,6 +644,16 @@ func NewUpdateResponseBody(res *user.User) *UpdateResponseBody {
 	return body
 }
 
+// NewListDeletedResponseBody builds the HTTP response body from the result of
+// the "listDeleted" endpoint of the "user" service.
+func NewListDeletedResponseBody(res []*user.User) ListDeletedResponseBody {
+	body := make([]*UserResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalUserUserToUserResponse(val)
+	}
+	return body
+}
+
 // NewGetBadRequestResponseBody builds the HTTP response body from the result
 // of the "get" endpoint of the "user" service.
 func NewGetBadRequestResponseBody(res *user.BadRequest) *GetBadRequestResponseBody {
@@ -355,6 +665,12 @@ func NewGetBadRequestResponseBody(res *user.BadRequest) *GetBadRequestResponseBo
 		Timeout:   res.Timeout,
 		Fault:     res.Fault,
 	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalUserFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
@@ -405,6 +721,12 @@ func NewUpdateBadRequestResponseBody(res *user.BadRequest) *UpdateBadRequestResp
 		Timeout:   res.Timeout,
 		Fault:     res.Fault,
 	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalUserFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
@@ -435,6 +757,15 @@ func NewUpdateNotFoundResponseBody(res *user.NotFound) *UpdateNotFoundResponseBo
 	return body
 }
 
+// NewUpdatePreconditionFailedResponseBody builds the HTTP response body from
+// the result of the "update" endpoint of the "user" service.
+func NewUpdatePreconditionFailedResponseBody(res *user.PreconditionFailed) *UpdatePreconditionFailedResponseBody {
+	body := &UpdatePreconditionFailedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
 // NewUpdateUnauthorizedResponseBody builds the HTTP response body from the
 // result of the "update" endpoint of the "user" service.
 func NewUpdateUnauthorizedResponseBody(res *user.Unauthorized) *UpdateUnauthorizedResponseBody {
@@ -444,6 +775,71 @@ func NewUpdateUnauthorizedResponseBody(res *user.Unauthorized) *UpdateUnauthoriz
 	return body
 }
 
+// NewPatchBadRequestResponseBody builds the HTTP response body from the result
+// of the "patch" endpoint of the "user" service.
+func NewPatchBadRequestResponseBody(res *user.BadRequest) *PatchBadRequestResponseBody {
+	body := &PatchBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalUserFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewPatchForbiddenResponseBody builds the HTTP response body from the result
+// of the "patch" endpoint of the "user" service.
+func NewPatchForbiddenResponseBody(res *user.Forbidden) *PatchForbiddenResponseBody {
+	body := &PatchForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPatchInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "patch" endpoint of the "user" service.
+func NewPatchInternalServerErrorResponseBody(res *user.InternalServerError) *PatchInternalServerErrorResponseBody {
+	body := &PatchInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPatchNotFoundResponseBody builds the HTTP response body from the result
+// of the "patch" endpoint of the "user" service.
+func NewPatchNotFoundResponseBody(res *user.NotFound) *PatchNotFoundResponseBody {
+	body := &PatchNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPatchPreconditionFailedResponseBody builds the HTTP response body from
+// the result of the "patch" endpoint of the "user" service.
+func NewPatchPreconditionFailedResponseBody(res *user.PreconditionFailed) *PatchPreconditionFailedResponseBody {
+	body := &PatchPreconditionFailedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPatchUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "patch" endpoint of the "user" service.
+func NewPatchUnauthorizedResponseBody(res *user.Unauthorized) *PatchUnauthorizedResponseBody {
+	body := &PatchUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
 // NewDeleteBadRequestResponseBody builds the HTTP response body from the
 // result of the "delete" endpoint of the "user" service.
 func NewDeleteBadRequestResponseBody(res *user.BadRequest) *DeleteBadRequestResponseBody {
@@ -455,6 +851,12 @@ func NewDeleteBadRequestResponseBody(res *user.BadRequest) *DeleteBadRequestResp
 		Timeout:   res.Timeout,
 		Fault:     res.Fault,
 	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalUserFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
@@ -485,6 +887,15 @@ func NewDeleteNotFoundResponseBody(res *user.NotFound) *DeleteNotFoundResponseBo
 	return body
 }
 
+// NewDeletePreconditionFailedResponseBody builds the HTTP response body from
+// the result of the "delete" endpoint of the "user" service.
+func NewDeletePreconditionFailedResponseBody(res *user.PreconditionFailed) *DeletePreconditionFailedResponseBody {
+	body := &DeletePreconditionFailedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
 // NewDeleteUnauthorizedResponseBody builds the HTTP response body from the
 // result of the "delete" endpoint of the "user" service.
 func NewDeleteUnauthorizedResponseBody(res *user.Unauthorized) *DeleteUnauthorizedResponseBody {
@@ -494,6 +905,118 @@ func NewDeleteUnauthorizedResponseBody(res *user.Unauthorized) *DeleteUnauthoriz
 	return body
 }
 
+// NewRestoreBadRequestResponseBody builds the HTTP response body from the
+// result of the "restore" endpoint of the "user" service.
+func NewRestoreBadRequestResponseBody(res *user.BadRequest) *RestoreBadRequestResponseBody {
+	body := &RestoreBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalUserFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewRestoreForbiddenResponseBody builds the HTTP response body from the
+// result of the "restore" endpoint of the "user" service.
+func NewRestoreForbiddenResponseBody(res *user.Forbidden) *RestoreForbiddenResponseBody {
+	body := &RestoreForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRestoreInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "restore" endpoint of the "user" service.
+func NewRestoreInternalServerErrorResponseBody(res *user.InternalServerError) *RestoreInternalServerErrorResponseBody {
+	body := &RestoreInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRestoreNotFoundResponseBody builds the HTTP response body from the result
+// of the "restore" endpoint of the "user" service.
+func NewRestoreNotFoundResponseBody(res *user.NotFound) *RestoreNotFoundResponseBody {
+	body := &RestoreNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRestoreUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "restore" endpoint of the "user" service.
+func NewRestoreUnauthorizedResponseBody(res *user.Unauthorized) *RestoreUnauthorizedResponseBody {
+	body := &RestoreUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListDeletedBadRequestResponseBody builds the HTTP response body from the
+// result of the "listDeleted" endpoint of the "user" service.
+func NewListDeletedBadRequestResponseBody(res *user.BadRequest) *ListDeletedBadRequestResponseBody {
+	body := &ListDeletedBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalUserFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewListDeletedForbiddenResponseBody builds the HTTP response body from the
+// result of the "listDeleted" endpoint of the "user" service.
+func NewListDeletedForbiddenResponseBody(res *user.Forbidden) *ListDeletedForbiddenResponseBody {
+	body := &ListDeletedForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListDeletedInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "listDeleted" endpoint of the "user" service.
+func NewListDeletedInternalServerErrorResponseBody(res *user.InternalServerError) *ListDeletedInternalServerErrorResponseBody {
+	body := &ListDeletedInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListDeletedNotFoundResponseBody builds the HTTP response body from the
+// result of the "listDeleted" endpoint of the "user" service.
+func NewListDeletedNotFoundResponseBody(res *user.NotFound) *ListDeletedNotFoundResponseBody {
+	body := &ListDeletedNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListDeletedUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "listDeleted" endpoint of the "user" service.
+func NewListDeletedUnauthorizedResponseBody(res *user.Unauthorized) *ListDeletedUnauthorizedResponseBody {
+	body := &ListDeletedUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
 // NewCreatePayload builds a user service create endpoint payload.
 func NewCreatePayload(body *CreateRequestBody, token *string) *user.CreatePayload {
 	v := &user.CreatePayload{
@@ -533,11 +1056,12 @@ func NewListPayload(limit int, offset int, token *string) *user.ListPayload {
 }
 
 // NewUpdatePayload builds a user service update endpoint payload.
-func NewUpdatePayload(body *UpdateRequestBody, id string, token *string) *user.UpdatePayload {
+func NewUpdatePayload(body *UpdateRequestBody, id string, ifMatch string, token *string) *user.UpdatePayload {
 	v := &user.UpdatePayload{
 		FirstName: *body.FirstName,
 		LastName:  *body.LastName,
 		Nickname:  body.Nickname,
+		Timezone:  body.Timezone,
 	}
 	if body.Admin != nil {
 		v.Admin = *body.Admin
@@ -546,15 +1070,46 @@ func NewUpdatePayload(body *UpdateRequestBody, id string, token *string) *user.U
 		v.Admin = false
 	}
 	v.ID = id
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v
+}
+
+// NewPatchPayload builds a user service patch endpoint payload.
+func NewPatchPayload(id string, ifMatch string, token *string) *user.PatchPayload {
+	v := &user.PatchPayload{}
+	v.ID = id
+	v.IfMatch = ifMatch
 	v.Token = token
 
 	return v
 }
 
 // NewDeletePayload builds a user service delete endpoint payload.
-func NewDeletePayload(id string, token *string) *user.DeletePayload {
+func NewDeletePayload(id string, ifMatch string, token *string) *user.DeletePayload {
 	v := &user.DeletePayload{}
 	v.ID = id
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v
+}
+
+// NewRestorePayload builds a user service restore endpoint payload.
+func NewRestorePayload(id string, token *string) *user.RestorePayload {
+	v := &user.RestorePayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewListDeletedPayload builds a user service listDeleted endpoint payload.
+func NewListDeletedPayload(limit int, offset int, token *string) *user.ListDeletedPayload {
+	v := &user.ListDeletedPayload{}
+	v.Limit = limit
+	v.Offset = offset
 	v.Token = token
 
 	return v
@@ -0,0 +1,220 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// webhook HTTP client CLI support package
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	webhook "be/gen/webhook"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCreatePayload builds the payload for the webhook create endpoint from
+// CLI flags.
+func BuildCreatePayload(webhookCreateBody string, webhookCreateToken string) (*webhook.CreatePayload, error) {
+	var err error
+	var body CreateRequestBody
+	{
+		err = json.Unmarshal([]byte(webhookCreateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"eventTypes\": [\n         \"SetLogged\"\n      ],\n      \"url\": \"https://coach.example.com/hooks/ld\"\n   }'")
+		}
+		if body.EventTypes == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("eventTypes", "body"))
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.url", body.URL, "^https://"))
+		if utf8.RuneCountInString(body.URL) > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.url", body.URL, utf8.RuneCountInString(body.URL), 2048, false))
+		}
+		if len(body.EventTypes) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.eventTypes", body.EventTypes, len(body.EventTypes), 1, true))
+		}
+		for _, e := range body.EventTypes {
+			if !(e == "UserCreated" || e == "UserUpdated" || e == "UserDeleted" || e == "UserRestored" || e == "TrainingPlanCreated" || e == "TrainingPlanUpdated" || e == "TrainingPlanDeleted" || e == "TrainingPlanRestored" || e == "WorkoutUpdated" || e == "SetLogged" || e == "WorkoutSessionStarted" || e == "WorkoutSessionFinished" || e == "WorkoutSessionAbandoned" || e == "PersonalRecordAchieved" || e == "AthleteInvited" || e == "CoachingStarted" || e == "CoachingRevoked" || e == "ProgressionApplied" || e == "HistoryImported") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.eventTypes[*]", e, []any{"UserCreated", "UserUpdated", "UserDeleted", "UserRestored", "TrainingPlanCreated", "TrainingPlanUpdated", "TrainingPlanDeleted", "TrainingPlanRestored", "WorkoutUpdated", "SetLogged", "WorkoutSessionStarted", "WorkoutSessionFinished", "WorkoutSessionAbandoned", "PersonalRecordAchieved", "AthleteInvited", "CoachingStarted", "CoachingRevoked", "ProgressionApplied", "HistoryImported"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if webhookCreateToken != "" {
+			token = &webhookCreateToken
+		}
+	}
+	v := &webhook.CreatePayload{
+		URL: body.URL,
+	}
+	if body.EventTypes != nil {
+		v.EventTypes = make([]string, len(body.EventTypes))
+		for i, val := range body.EventTypes {
+			v.EventTypes[i] = val
+		}
+	} else {
+		v.EventTypes = []string{}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListPayload builds the payload for the webhook list endpoint from CLI
+// flags.
+func BuildListPayload(webhookListToken string) (*webhook.ListPayload, error) {
+	var token *string
+	{
+		if webhookListToken != "" {
+			token = &webhookListToken
+		}
+	}
+	v := &webhook.ListPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDeletePayload builds the payload for the webhook delete endpoint from
+// CLI flags.
+func BuildDeletePayload(webhookDeleteID string, webhookDeleteToken string) (*webhook.DeletePayload, error) {
+	var err error
+	var id string
+	{
+		id = webhookDeleteID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if webhookDeleteToken != "" {
+			token = &webhookDeleteToken
+		}
+	}
+	v := &webhook.DeletePayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDeliveriesPayload builds the payload for the webhook deliveries
+// endpoint from CLI flags.
+func BuildDeliveriesPayload(webhookDeliveriesID string, webhookDeliveriesStatus string, webhookDeliveriesLimit string, webhookDeliveriesOffset string, webhookDeliveriesToken string) (*webhook.DeliveriesPayload, error) {
+	var err error
+	var id string
+	{
+		id = webhookDeliveriesID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var status *string
+	{
+		if webhookDeliveriesStatus != "" {
+			status = &webhookDeliveriesStatus
+			if !(*status == "pending" || *status == "delivered" || *status == "dead") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"pending", "delivered", "dead"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if webhookDeliveriesLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(webhookDeliveriesLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var offset int
+	{
+		if webhookDeliveriesOffset != "" {
+			var v int64
+			v, err = strconv.ParseInt(webhookDeliveriesOffset, 10, strconv.IntSize)
+			offset = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for offset, must be INT")
+			}
+			if offset < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("offset", offset, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if webhookDeliveriesToken != "" {
+			token = &webhookDeliveriesToken
+		}
+	}
+	v := &webhook.DeliveriesPayload{}
+	v.ID = id
+	v.Status = status
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRedeliverPayload builds the payload for the webhook redeliver endpoint
+// from CLI flags.
+func BuildRedeliverPayload(webhookRedeliverID string, webhookRedeliverDeliveryID string, webhookRedeliverToken string) (*webhook.RedeliverPayload, error) {
+	var err error
+	var id string
+	{
+		id = webhookRedeliverID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var deliveryID string
+	{
+		deliveryID = webhookRedeliverDeliveryID
+		err = goa.MergeErrors(err, goa.ValidateFormat("deliveryId", deliveryID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if webhookRedeliverToken != "" {
+			token = &webhookRedeliverToken
+		}
+	}
+	v := &webhook.RedeliverPayload{}
+	v.ID = id
+	v.DeliveryID = deliveryID
+	v.Token = token
+
+	return v, nil
+}
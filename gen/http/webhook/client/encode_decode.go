@@ -0,0 +1,876 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// webhook HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	webhook "be/gen/webhook"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCreateRequest instantiates a HTTP request object with method and path
+// set to call the "webhook" service "create" endpoint
+func (c *Client) BuildCreateRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateWebhookPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhook", "create", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateRequest returns an encoder for requests sent to the webhook
+// create server.
+func EncodeCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*webhook.CreatePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("webhook", "create", "*webhook.CreatePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCreateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("webhook", "create", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateResponse returns a decoder for responses returned by the webhook
+// create endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeCreateResponse may return the following errors:
+//   - "badRequest" (type *webhook.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *webhook.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *webhook.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *webhook.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *webhook.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "create", err)
+			}
+			err = ValidateCreateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "create", err)
+			}
+			res := NewCreatedWebhookSubscriptionCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "create", err)
+			}
+			err = ValidateCreateBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "create", err)
+			}
+			return nil, NewCreateBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "create", err)
+			}
+			err = ValidateCreateForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "create", err)
+			}
+			return nil, NewCreateForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "create", err)
+			}
+			err = ValidateCreateInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "create", err)
+			}
+			return nil, NewCreateInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body CreateNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "create", err)
+			}
+			err = ValidateCreateNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "create", err)
+			}
+			return nil, NewCreateNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CreateUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "create", err)
+			}
+			err = ValidateCreateUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "create", err)
+			}
+			return nil, NewCreateUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhook", "create", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "webhook" service "list" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWebhookPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhook", "list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListRequest returns an encoder for requests sent to the webhook list
+// server.
+func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*webhook.ListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("webhook", "list", "*webhook.ListPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListResponse returns a decoder for responses returned by the webhook
+// list endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeListResponse may return the following errors:
+//   - "badRequest" (type *webhook.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *webhook.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *webhook.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *webhook.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *webhook.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "list", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateWebhookSubscriptionResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "list", err)
+			}
+			res := NewListWebhookSubscriptionOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "list", err)
+			}
+			err = ValidateListBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "list", err)
+			}
+			return nil, NewListBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ListForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "list", err)
+			}
+			err = ValidateListForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "list", err)
+			}
+			return nil, NewListForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "list", err)
+			}
+			err = ValidateListInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "list", err)
+			}
+			return nil, NewListInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "list", err)
+			}
+			err = ValidateListNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "list", err)
+			}
+			return nil, NewListNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "list", err)
+			}
+			err = ValidateListUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "list", err)
+			}
+			return nil, NewListUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhook", "list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteRequest instantiates a HTTP request object with method and path
+// set to call the "webhook" service "delete" endpoint
+func (c *Client) BuildDeleteRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*webhook.DeletePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("webhook", "delete", "*webhook.DeletePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteWebhookPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhook", "delete", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteRequest returns an encoder for requests sent to the webhook
+// delete server.
+func EncodeDeleteRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*webhook.DeletePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("webhook", "delete", "*webhook.DeletePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteResponse returns a decoder for responses returned by the webhook
+// delete endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeDeleteResponse may return the following errors:
+//   - "badRequest" (type *webhook.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *webhook.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *webhook.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *webhook.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *webhook.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body DeleteBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "delete", err)
+			}
+			err = ValidateDeleteBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "delete", err)
+			}
+			return nil, NewDeleteBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "delete", err)
+			}
+			err = ValidateDeleteForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "delete", err)
+			}
+			return nil, NewDeleteForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "delete", err)
+			}
+			err = ValidateDeleteInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "delete", err)
+			}
+			return nil, NewDeleteInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "delete", err)
+			}
+			err = ValidateDeleteNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "delete", err)
+			}
+			return nil, NewDeleteNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeleteUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "delete", err)
+			}
+			err = ValidateDeleteUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "delete", err)
+			}
+			return nil, NewDeleteUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhook", "delete", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeliveriesRequest instantiates a HTTP request object with method and
+// path set to call the "webhook" service "deliveries" endpoint
+func (c *Client) BuildDeliveriesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*webhook.DeliveriesPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("webhook", "deliveries", "*webhook.DeliveriesPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeliveriesWebhookPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhook", "deliveries", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeliveriesRequest returns an encoder for requests sent to the webhook
+// deliveries server.
+func EncodeDeliveriesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*webhook.DeliveriesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("webhook", "deliveries", "*webhook.DeliveriesPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Status != nil {
+			values.Add("status", *p.Status)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		values.Add("offset", fmt.Sprintf("%v", p.Offset))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDeliveriesResponse returns a decoder for responses returned by the
+// webhook deliveries endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDeliveriesResponse may return the following errors:
+//   - "badRequest" (type *webhook.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *webhook.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *webhook.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *webhook.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *webhook.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeliveriesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DeliveriesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "deliveries", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateWebhookDeliveryResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "deliveries", err)
+			}
+			res := NewDeliveriesWebhookDeliveryOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body DeliveriesBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "deliveries", err)
+			}
+			err = ValidateDeliveriesBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "deliveries", err)
+			}
+			return nil, NewDeliveriesBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body DeliveriesForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "deliveries", err)
+			}
+			err = ValidateDeliveriesForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "deliveries", err)
+			}
+			return nil, NewDeliveriesForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeliveriesInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "deliveries", err)
+			}
+			err = ValidateDeliveriesInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "deliveries", err)
+			}
+			return nil, NewDeliveriesInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body DeliveriesNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "deliveries", err)
+			}
+			err = ValidateDeliveriesNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "deliveries", err)
+			}
+			return nil, NewDeliveriesNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeliveriesUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "deliveries", err)
+			}
+			err = ValidateDeliveriesUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "deliveries", err)
+			}
+			return nil, NewDeliveriesUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhook", "deliveries", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRedeliverRequest instantiates a HTTP request object with method and
+// path set to call the "webhook" service "redeliver" endpoint
+func (c *Client) BuildRedeliverRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id         string
+		deliveryID string
+	)
+	{
+		p, ok := v.(*webhook.RedeliverPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("webhook", "redeliver", "*webhook.RedeliverPayload", v)
+		}
+		id = p.ID
+		deliveryID = p.DeliveryID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RedeliverWebhookPath(id, deliveryID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhook", "redeliver", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRedeliverRequest returns an encoder for requests sent to the webhook
+// redeliver server.
+func EncodeRedeliverRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*webhook.RedeliverPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("webhook", "redeliver", "*webhook.RedeliverPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeRedeliverResponse returns a decoder for responses returned by the
+// webhook redeliver endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeRedeliverResponse may return the following errors:
+//   - "badRequest" (type *webhook.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *webhook.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *webhook.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *webhook.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *webhook.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRedeliverResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RedeliverResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "redeliver", err)
+			}
+			err = ValidateRedeliverResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "redeliver", err)
+			}
+			res := NewRedeliverWebhookDeliveryOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body RedeliverBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "redeliver", err)
+			}
+			err = ValidateRedeliverBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "redeliver", err)
+			}
+			return nil, NewRedeliverBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body RedeliverForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "redeliver", err)
+			}
+			err = ValidateRedeliverForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "redeliver", err)
+			}
+			return nil, NewRedeliverForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RedeliverInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "redeliver", err)
+			}
+			err = ValidateRedeliverInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "redeliver", err)
+			}
+			return nil, NewRedeliverInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body RedeliverNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "redeliver", err)
+			}
+			err = ValidateRedeliverNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "redeliver", err)
+			}
+			return nil, NewRedeliverNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body RedeliverUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhook", "redeliver", err)
+			}
+			err = ValidateRedeliverUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhook", "redeliver", err)
+			}
+			return nil, NewRedeliverUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhook", "redeliver", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalFieldErrorResponseBodyToWebhookFieldError builds a value of type
+// *webhook.FieldError from a value of type *FieldErrorResponseBody.
+func unmarshalFieldErrorResponseBodyToWebhookFieldError(v *FieldErrorResponseBody) *webhook.FieldError {
+	if v == nil {
+		return nil
+	}
+	res := &webhook.FieldError{
+		Field:   *v.Field,
+		Message: *v.Message,
+	}
+
+	return res
+}
+
+// unmarshalWebhookSubscriptionResponseToWebhookWebhookSubscription builds a
+// value of type *webhook.WebhookSubscription from a value of type
+// *WebhookSubscriptionResponse.
+func unmarshalWebhookSubscriptionResponseToWebhookWebhookSubscription(v *WebhookSubscriptionResponse) *webhook.WebhookSubscription {
+	res := &webhook.WebhookSubscription{
+		ID:        *v.ID,
+		URL:       *v.URL,
+		Active:    *v.Active,
+		CreatedAt: *v.CreatedAt,
+	}
+	res.EventTypes = make([]string, len(v.EventTypes))
+	for i, val := range v.EventTypes {
+		res.EventTypes[i] = val
+	}
+
+	return res
+}
+
+// unmarshalWebhookDeliveryResponseToWebhookWebhookDelivery builds a value of
+// type *webhook.WebhookDelivery from a value of type *WebhookDeliveryResponse.
+func unmarshalWebhookDeliveryResponseToWebhookWebhookDelivery(v *WebhookDeliveryResponse) *webhook.WebhookDelivery {
+	res := &webhook.WebhookDelivery{
+		ID:             *v.ID,
+		SubscriptionID: *v.SubscriptionID,
+		EventID:        *v.EventID,
+		EventType:      *v.EventType,
+		Status:         *v.Status,
+		Attempts:       *v.Attempts,
+		LastStatusCode: v.LastStatusCode,
+		LastError:      v.LastError,
+		NextAttemptAt:  v.NextAttemptAt,
+		DeliveredAt:    v.DeliveredAt,
+		CreatedAt:      *v.CreatedAt,
+	}
+
+	return res
+}
@@ -0,0 +1,1238 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// webhook HTTP client types
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	webhook "be/gen/webhook"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateRequestBody is the type of the "webhook" service "create" endpoint
+// HTTP request body.
+type CreateRequestBody struct {
+	// Callback URL, served over HTTPS from a public address
+	URL string `form:"url" json:"url" xml:"url"`
+	// Event types to deliver
+	EventTypes []string `form:"eventTypes" json:"eventTypes" xml:"eventTypes"`
+}
+
+// CreateResponseBody is the type of the "webhook" service "create" endpoint
+// HTTP response body.
+type CreateResponseBody struct {
+	// Secret used to sign deliveries with HMAC-SHA256
+	Secret *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	// Subscription ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Callback URL receiving the events
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// Event types delivered to the callback
+	EventTypes []string `form:"eventTypes,omitempty" json:"eventTypes,omitempty" xml:"eventTypes,omitempty"`
+	// Whether deliveries are enabled
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+	// Creation time
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// ListResponseBody is the type of the "webhook" service "list" endpoint HTTP
+// response body.
+type ListResponseBody []*WebhookSubscriptionResponse
+
+// DeliveriesResponseBody is the type of the "webhook" service "deliveries"
+// endpoint HTTP response body.
+type DeliveriesResponseBody []*WebhookDeliveryResponse
+
+// RedeliverResponseBody is the type of the "webhook" service "redeliver"
+// endpoint HTTP response body.
+type RedeliverResponseBody struct {
+	// Delivery ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Subscription ID
+	SubscriptionID *string `form:"subscriptionId,omitempty" json:"subscriptionId,omitempty" xml:"subscriptionId,omitempty"`
+	// ID of the delivered event
+	EventID *string `form:"eventId,omitempty" json:"eventId,omitempty" xml:"eventId,omitempty"`
+	// Type of the delivered event
+	EventType *string `form:"eventType,omitempty" json:"eventType,omitempty" xml:"eventType,omitempty"`
+	// Delivery status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Number of attempts made
+	Attempts *int `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// HTTP status of the last attempt
+	LastStatusCode *int `form:"lastStatusCode,omitempty" json:"lastStatusCode,omitempty" xml:"lastStatusCode,omitempty"`
+	// Error of the last failed attempt
+	LastError *string `form:"lastError,omitempty" json:"lastError,omitempty" xml:"lastError,omitempty"`
+	// When the next attempt is scheduled
+	NextAttemptAt *string `form:"nextAttemptAt,omitempty" json:"nextAttemptAt,omitempty" xml:"nextAttemptAt,omitempty"`
+	// When the delivery succeeded
+	DeliveredAt *string `form:"deliveredAt,omitempty" json:"deliveredAt,omitempty" xml:"deliveredAt,omitempty"`
+	// When the delivery was queued
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// CreateBadRequestResponseBody is the type of the "webhook" service "create"
+// endpoint HTTP response body for the "badRequest" error.
+type CreateBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// CreateForbiddenResponseBody is the type of the "webhook" service "create"
+// endpoint HTTP response body for the "forbidden" error.
+type CreateForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateInternalServerErrorResponseBody is the type of the "webhook" service
+// "create" endpoint HTTP response body for the "internalServerError" error.
+type CreateInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateNotFoundResponseBody is the type of the "webhook" service "create"
+// endpoint HTTP response body for the "notFound" error.
+type CreateNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateUnauthorizedResponseBody is the type of the "webhook" service "create"
+// endpoint HTTP response body for the "unauthorized" error.
+type CreateUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListBadRequestResponseBody is the type of the "webhook" service "list"
+// endpoint HTTP response body for the "badRequest" error.
+type ListBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListForbiddenResponseBody is the type of the "webhook" service "list"
+// endpoint HTTP response body for the "forbidden" error.
+type ListForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListInternalServerErrorResponseBody is the type of the "webhook" service
+// "list" endpoint HTTP response body for the "internalServerError" error.
+type ListInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListNotFoundResponseBody is the type of the "webhook" service "list"
+// endpoint HTTP response body for the "notFound" error.
+type ListNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListUnauthorizedResponseBody is the type of the "webhook" service "list"
+// endpoint HTTP response body for the "unauthorized" error.
+type ListUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteBadRequestResponseBody is the type of the "webhook" service "delete"
+// endpoint HTTP response body for the "badRequest" error.
+type DeleteBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// DeleteForbiddenResponseBody is the type of the "webhook" service "delete"
+// endpoint HTTP response body for the "forbidden" error.
+type DeleteForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteInternalServerErrorResponseBody is the type of the "webhook" service
+// "delete" endpoint HTTP response body for the "internalServerError" error.
+type DeleteInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteNotFoundResponseBody is the type of the "webhook" service "delete"
+// endpoint HTTP response body for the "notFound" error.
+type DeleteNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteUnauthorizedResponseBody is the type of the "webhook" service "delete"
+// endpoint HTTP response body for the "unauthorized" error.
+type DeleteUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeliveriesBadRequestResponseBody is the type of the "webhook" service
+// "deliveries" endpoint HTTP response body for the "badRequest" error.
+type DeliveriesBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// DeliveriesForbiddenResponseBody is the type of the "webhook" service
+// "deliveries" endpoint HTTP response body for the "forbidden" error.
+type DeliveriesForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeliveriesInternalServerErrorResponseBody is the type of the "webhook"
+// service "deliveries" endpoint HTTP response body for the
+// "internalServerError" error.
+type DeliveriesInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeliveriesNotFoundResponseBody is the type of the "webhook" service
+// "deliveries" endpoint HTTP response body for the "notFound" error.
+type DeliveriesNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeliveriesUnauthorizedResponseBody is the type of the "webhook" service
+// "deliveries" endpoint HTTP response body for the "unauthorized" error.
+type DeliveriesUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RedeliverBadRequestResponseBody is the type of the "webhook" service
+// "redeliver" endpoint HTTP response body for the "badRequest" error.
+type RedeliverBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// RedeliverForbiddenResponseBody is the type of the "webhook" service
+// "redeliver" endpoint HTTP response body for the "forbidden" error.
+type RedeliverForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RedeliverInternalServerErrorResponseBody is the type of the "webhook"
+// service "redeliver" endpoint HTTP response body for the
+// "internalServerError" error.
+type RedeliverInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RedeliverNotFoundResponseBody is the type of the "webhook" service
+// "redeliver" endpoint HTTP response body for the "notFound" error.
+type RedeliverNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RedeliverUnauthorizedResponseBody is the type of the "webhook" service
+// "redeliver" endpoint HTTP response body for the "unauthorized" error.
+type RedeliverUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+	// Motivo per cui il campo non è valido
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// WebhookSubscriptionResponse is used to define fields on response body types.
+type WebhookSubscriptionResponse struct {
+	// Subscription ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Callback URL receiving the events
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// Event types delivered to the callback
+	EventTypes []string `form:"eventTypes,omitempty" json:"eventTypes,omitempty" xml:"eventTypes,omitempty"`
+	// Whether deliveries are enabled
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+	// Creation time
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// WebhookDeliveryResponse is used to define fields on response body types.
+type WebhookDeliveryResponse struct {
+	// Delivery ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Subscription ID
+	SubscriptionID *string `form:"subscriptionId,omitempty" json:"subscriptionId,omitempty" xml:"subscriptionId,omitempty"`
+	// ID of the delivered event
+	EventID *string `form:"eventId,omitempty" json:"eventId,omitempty" xml:"eventId,omitempty"`
+	// Type of the delivered event
+	EventType *string `form:"eventType,omitempty" json:"eventType,omitempty" xml:"eventType,omitempty"`
+	// Delivery status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Number of attempts made
+	Attempts *int `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// HTTP status of the last attempt
+	LastStatusCode *int `form:"lastStatusCode,omitempty" json:"lastStatusCode,omitempty" xml:"lastStatusCode,omitempty"`
+	// Error of the last failed attempt
+	LastError *string `form:"lastError,omitempty" json:"lastError,omitempty" xml:"lastError,omitempty"`
+	// When the next attempt is scheduled
+	NextAttemptAt *string `form:"nextAttemptAt,omitempty" json:"nextAttemptAt,omitempty" xml:"nextAttemptAt,omitempty"`
+	// When the delivery succeeded
+	DeliveredAt *string `form:"deliveredAt,omitempty" json:"deliveredAt,omitempty" xml:"deliveredAt,omitempty"`
+	// When the delivery was queued
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// NewCreateRequestBody builds the HTTP request body from the payload of the
+// "create" endpoint of the "webhook" service.
+func NewCreateRequestBody(p *webhook.CreatePayload) *CreateRequestBody {
+	body := &CreateRequestBody{
+		URL: p.URL,
+	}
+	if p.EventTypes != nil {
+		body.EventTypes = make([]string, len(p.EventTypes))
+		for i, val := range p.EventTypes {
+			body.EventTypes[i] = val
+		}
+	} else {
+		body.EventTypes = []string{}
+	}
+	return body
+}
+
+// NewCreatedWebhookSubscriptionCreated builds a "webhook" service "create"
+// endpoint result from a HTTP "Created" response.
+func NewCreatedWebhookSubscriptionCreated(body *CreateResponseBody) *webhook.CreatedWebhookSubscription {
+	v := &webhook.CreatedWebhookSubscription{
+		Secret:    *body.Secret,
+		ID:        *body.ID,
+		URL:       *body.URL,
+		Active:    *body.Active,
+		CreatedAt: *body.CreatedAt,
+	}
+	v.EventTypes = make([]string, len(body.EventTypes))
+	for i, val := range body.EventTypes {
+		v.EventTypes[i] = val
+	}
+
+	return v
+}
+
+// NewCreateBadRequest builds a webhook service create endpoint badRequest
+// error.
+func NewCreateBadRequest(body *CreateBadRequestResponseBody) *webhook.BadRequest {
+	v := &webhook.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*webhook.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToWebhookFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewCreateForbidden builds a webhook service create endpoint forbidden error.
+func NewCreateForbidden(body *CreateForbiddenResponseBody) *webhook.Forbidden {
+	v := &webhook.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateInternalServerError builds a webhook service create endpoint
+// internalServerError error.
+func NewCreateInternalServerError(body *CreateInternalServerErrorResponseBody) *webhook.InternalServerError {
+	v := &webhook.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateNotFound builds a webhook service create endpoint notFound error.
+func NewCreateNotFound(body *CreateNotFoundResponseBody) *webhook.NotFound {
+	v := &webhook.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateUnauthorized builds a webhook service create endpoint unauthorized
+// error.
+func NewCreateUnauthorized(body *CreateUnauthorizedResponseBody) *webhook.Unauthorized {
+	v := &webhook.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListWebhookSubscriptionOK builds a "webhook" service "list" endpoint
+// result from a HTTP "OK" response.
+func NewListWebhookSubscriptionOK(body []*WebhookSubscriptionResponse) []*webhook.WebhookSubscription {
+	v := make([]*webhook.WebhookSubscription, len(body))
+	for i, val := range body {
+		v[i] = unmarshalWebhookSubscriptionResponseToWebhookWebhookSubscription(val)
+	}
+
+	return v
+}
+
+// NewListBadRequest builds a webhook service list endpoint badRequest error.
+func NewListBadRequest(body *ListBadRequestResponseBody) *webhook.BadRequest {
+	v := &webhook.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*webhook.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToWebhookFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewListForbidden builds a webhook service list endpoint forbidden error.
+func NewListForbidden(body *ListForbiddenResponseBody) *webhook.Forbidden {
+	v := &webhook.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListInternalServerError builds a webhook service list endpoint
+// internalServerError error.
+func NewListInternalServerError(body *ListInternalServerErrorResponseBody) *webhook.InternalServerError {
+	v := &webhook.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListNotFound builds a webhook service list endpoint notFound error.
+func NewListNotFound(body *ListNotFoundResponseBody) *webhook.NotFound {
+	v := &webhook.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListUnauthorized builds a webhook service list endpoint unauthorized
+// error.
+func NewListUnauthorized(body *ListUnauthorizedResponseBody) *webhook.Unauthorized {
+	v := &webhook.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteBadRequest builds a webhook service delete endpoint badRequest
+// error.
+func NewDeleteBadRequest(body *DeleteBadRequestResponseBody) *webhook.BadRequest {
+	v := &webhook.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*webhook.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToWebhookFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewDeleteForbidden builds a webhook service delete endpoint forbidden error.
+func NewDeleteForbidden(body *DeleteForbiddenResponseBody) *webhook.Forbidden {
+	v := &webhook.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteInternalServerError builds a webhook service delete endpoint
+// internalServerError error.
+func NewDeleteInternalServerError(body *DeleteInternalServerErrorResponseBody) *webhook.InternalServerError {
+	v := &webhook.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteNotFound builds a webhook service delete endpoint notFound error.
+func NewDeleteNotFound(body *DeleteNotFoundResponseBody) *webhook.NotFound {
+	v := &webhook.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteUnauthorized builds a webhook service delete endpoint unauthorized
+// error.
+func NewDeleteUnauthorized(body *DeleteUnauthorizedResponseBody) *webhook.Unauthorized {
+	v := &webhook.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeliveriesWebhookDeliveryOK builds a "webhook" service "deliveries"
+// endpoint result from a HTTP "OK" response.
+func NewDeliveriesWebhookDeliveryOK(body []*WebhookDeliveryResponse) []*webhook.WebhookDelivery {
+	v := make([]*webhook.WebhookDelivery, len(body))
+	for i, val := range body {
+		v[i] = unmarshalWebhookDeliveryResponseToWebhookWebhookDelivery(val)
+	}
+
+	return v
+}
+
+// NewDeliveriesBadRequest builds a webhook service deliveries endpoint
+// badRequest error.
+func NewDeliveriesBadRequest(body *DeliveriesBadRequestResponseBody) *webhook.BadRequest {
+	v := &webhook.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*webhook.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToWebhookFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewDeliveriesForbidden builds a webhook service deliveries endpoint
+// forbidden error.
+func NewDeliveriesForbidden(body *DeliveriesForbiddenResponseBody) *webhook.Forbidden {
+	v := &webhook.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeliveriesInternalServerError builds a webhook service deliveries
+// endpoint internalServerError error.
+func NewDeliveriesInternalServerError(body *DeliveriesInternalServerErrorResponseBody) *webhook.InternalServerError {
+	v := &webhook.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeliveriesNotFound builds a webhook service deliveries endpoint notFound
+// error.
+func NewDeliveriesNotFound(body *DeliveriesNotFoundResponseBody) *webhook.NotFound {
+	v := &webhook.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeliveriesUnauthorized builds a webhook service deliveries endpoint
+// unauthorized error.
+func NewDeliveriesUnauthorized(body *DeliveriesUnauthorizedResponseBody) *webhook.Unauthorized {
+	v := &webhook.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRedeliverWebhookDeliveryOK builds a "webhook" service "redeliver"
+// endpoint result from a HTTP "OK" response.
+func NewRedeliverWebhookDeliveryOK(body *RedeliverResponseBody) *webhook.WebhookDelivery {
+	v := &webhook.WebhookDelivery{
+		ID:             *body.ID,
+		SubscriptionID: *body.SubscriptionID,
+		EventID:        *body.EventID,
+		EventType:      *body.EventType,
+		Status:         *body.Status,
+		Attempts:       *body.Attempts,
+		LastStatusCode: body.LastStatusCode,
+		LastError:      body.LastError,
+		NextAttemptAt:  body.NextAttemptAt,
+		DeliveredAt:    body.DeliveredAt,
+		CreatedAt:      *body.CreatedAt,
+	}
+
+	return v
+}
+
+// NewRedeliverBadRequest builds a webhook service redeliver endpoint
+// badRequest error.
+func NewRedeliverBadRequest(body *RedeliverBadRequestResponseBody) *webhook.BadRequest {
+	v := &webhook.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*webhook.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToWebhookFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewRedeliverForbidden builds a webhook service redeliver endpoint forbidden
+// error.
+func NewRedeliverForbidden(body *RedeliverForbiddenResponseBody) *webhook.Forbidden {
+	v := &webhook.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRedeliverInternalServerError builds a webhook service redeliver endpoint
+// internalServerError error.
+func NewRedeliverInternalServerError(body *RedeliverInternalServerErrorResponseBody) *webhook.InternalServerError {
+	v := &webhook.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRedeliverNotFound builds a webhook service redeliver endpoint notFound
+// error.
+func NewRedeliverNotFound(body *RedeliverNotFoundResponseBody) *webhook.NotFound {
+	v := &webhook.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRedeliverUnauthorized builds a webhook service redeliver endpoint
+// unauthorized error.
+func NewRedeliverUnauthorized(body *RedeliverUnauthorizedResponseBody) *webhook.Unauthorized {
+	v := &webhook.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// ValidateCreateResponseBody runs the validations defined on CreateResponseBody
+func ValidateCreateResponseBody(body *CreateResponseBody) (err error) {
+	if body.Secret == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("secret", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.EventTypes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("eventTypes", "body"))
+	}
+	if body.Active == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateRedeliverResponseBody runs the validations defined on
+// RedeliverResponseBody
+func ValidateRedeliverResponseBody(body *RedeliverResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.SubscriptionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("subscriptionId", "body"))
+	}
+	if body.EventID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("eventId", "body"))
+	}
+	if body.EventType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("eventType", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.SubscriptionID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.subscriptionId", *body.SubscriptionID, goa.FormatUUID))
+	}
+	if body.EventID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.eventId", *body.EventID, goa.FormatUUID))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "delivered" || *body.Status == "dead") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "delivered", "dead"}))
+		}
+	}
+	if body.NextAttemptAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.nextAttemptAt", *body.NextAttemptAt, goa.FormatDateTime))
+	}
+	if body.DeliveredAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deliveredAt", *body.DeliveredAt, goa.FormatDateTime))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCreateBadRequestResponseBody runs the validations defined on
+// create_badRequest_response_body
+func ValidateCreateBadRequestResponseBody(body *CreateBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateForbiddenResponseBody runs the validations defined on
+// create_forbidden_response_body
+func ValidateCreateForbiddenResponseBody(body *CreateForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateInternalServerErrorResponseBody runs the validations defined
+// on create_internalServerError_response_body
+func ValidateCreateInternalServerErrorResponseBody(body *CreateInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateNotFoundResponseBody runs the validations defined on
+// create_notFound_response_body
+func ValidateCreateNotFoundResponseBody(body *CreateNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateUnauthorizedResponseBody runs the validations defined on
+// create_unauthorized_response_body
+func ValidateCreateUnauthorizedResponseBody(body *CreateUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListBadRequestResponseBody runs the validations defined on
+// list_badRequest_response_body
+func ValidateListBadRequestResponseBody(body *ListBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListForbiddenResponseBody runs the validations defined on
+// list_forbidden_response_body
+func ValidateListForbiddenResponseBody(body *ListForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListInternalServerErrorResponseBody runs the validations defined on
+// list_internalServerError_response_body
+func ValidateListInternalServerErrorResponseBody(body *ListInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListNotFoundResponseBody runs the validations defined on
+// list_notFound_response_body
+func ValidateListNotFoundResponseBody(body *ListNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListUnauthorizedResponseBody runs the validations defined on
+// list_unauthorized_response_body
+func ValidateListUnauthorizedResponseBody(body *ListUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteBadRequestResponseBody runs the validations defined on
+// delete_badRequest_response_body
+func ValidateDeleteBadRequestResponseBody(body *DeleteBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateDeleteForbiddenResponseBody runs the validations defined on
+// delete_forbidden_response_body
+func ValidateDeleteForbiddenResponseBody(body *DeleteForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteInternalServerErrorResponseBody runs the validations defined
+// on delete_internalServerError_response_body
+func ValidateDeleteInternalServerErrorResponseBody(body *DeleteInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteNotFoundResponseBody runs the validations defined on
+// delete_notFound_response_body
+func ValidateDeleteNotFoundResponseBody(body *DeleteNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteUnauthorizedResponseBody runs the validations defined on
+// delete_unauthorized_response_body
+func ValidateDeleteUnauthorizedResponseBody(body *DeleteUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeliveriesBadRequestResponseBody runs the validations defined on
+// deliveries_badRequest_response_body
+func ValidateDeliveriesBadRequestResponseBody(body *DeliveriesBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateDeliveriesForbiddenResponseBody runs the validations defined on
+// deliveries_forbidden_response_body
+func ValidateDeliveriesForbiddenResponseBody(body *DeliveriesForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeliveriesInternalServerErrorResponseBody runs the validations
+// defined on deliveries_internalServerError_response_body
+func ValidateDeliveriesInternalServerErrorResponseBody(body *DeliveriesInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeliveriesNotFoundResponseBody runs the validations defined on
+// deliveries_notFound_response_body
+func ValidateDeliveriesNotFoundResponseBody(body *DeliveriesNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeliveriesUnauthorizedResponseBody runs the validations defined on
+// deliveries_unauthorized_response_body
+func ValidateDeliveriesUnauthorizedResponseBody(body *DeliveriesUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRedeliverBadRequestResponseBody runs the validations defined on
+// redeliver_badRequest_response_body
+func ValidateRedeliverBadRequestResponseBody(body *RedeliverBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRedeliverForbiddenResponseBody runs the validations defined on
+// redeliver_forbidden_response_body
+func ValidateRedeliverForbiddenResponseBody(body *RedeliverForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRedeliverInternalServerErrorResponseBody runs the validations
+// defined on redeliver_internalServerError_response_body
+func ValidateRedeliverInternalServerErrorResponseBody(body *RedeliverInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRedeliverNotFoundResponseBody runs the validations defined on
+// redeliver_notFound_response_body
+func ValidateRedeliverNotFoundResponseBody(body *RedeliverNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRedeliverUnauthorizedResponseBody runs the validations defined on
+// redeliver_unauthorized_response_body
+func ValidateRedeliverUnauthorizedResponseBody(body *RedeliverUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFieldErrorResponseBody runs the validations defined on
+// FieldErrorResponseBody
+func ValidateFieldErrorResponseBody(body *FieldErrorResponseBody) (err error) {
+	if body.Field == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("field", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateWebhookSubscriptionResponse runs the validations defined on
+// WebhookSubscriptionResponse
+func ValidateWebhookSubscriptionResponse(body *WebhookSubscriptionResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.EventTypes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("eventTypes", "body"))
+	}
+	if body.Active == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateWebhookDeliveryResponse runs the validations defined on
+// WebhookDeliveryResponse
+func ValidateWebhookDeliveryResponse(body *WebhookDeliveryResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.SubscriptionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("subscriptionId", "body"))
+	}
+	if body.EventID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("eventId", "body"))
+	}
+	if body.EventType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("eventType", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.SubscriptionID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.subscriptionId", *body.SubscriptionID, goa.FormatUUID))
+	}
+	if body.EventID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.eventId", *body.EventID, goa.FormatUUID))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "delivered" || *body.Status == "dead") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "delivered", "dead"}))
+		}
+	}
+	if body.NextAttemptAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.nextAttemptAt", *body.NextAttemptAt, goa.FormatDateTime))
+	}
+	if body.DeliveredAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deliveredAt", *body.DeliveredAt, goa.FormatDateTime))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
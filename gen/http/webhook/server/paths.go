@@ -0,0 +1,37 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// HTTP request path constructors for the webhook service.
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	"fmt"
+)
+
+// CreateWebhookPath returns the URL path to the webhook service create HTTP endpoint.
+func CreateWebhookPath() string {
+	return "/api/v1/webhooks"
+}
+
+// ListWebhookPath returns the URL path to the webhook service list HTTP endpoint.
+func ListWebhookPath() string {
+	return "/api/v1/webhooks"
+}
+
+// DeleteWebhookPath returns the URL path to the webhook service delete HTTP endpoint.
+func DeleteWebhookPath(id string) string {
+	return fmt.Sprintf("/api/v1/webhooks/%v", id)
+}
+
+// DeliveriesWebhookPath returns the URL path to the webhook service deliveries HTTP endpoint.
+func DeliveriesWebhookPath(id string) string {
+	return fmt.Sprintf("/api/v1/webhooks/%v/deliveries", id)
+}
+
+// RedeliverWebhookPath returns the URL path to the webhook service redeliver HTTP endpoint.
+func RedeliverWebhookPath(id string, deliveryID string) string {
+	return fmt.Sprintf("/api/v1/webhooks/%v/deliveries/%v/redeliver", id, deliveryID)
+}
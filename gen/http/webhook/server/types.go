@@ -0,0 +1,781 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// webhook HTTP server types
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	webhook "be/gen/webhook"
+	"unicode/utf8"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateRequestBody is the type of the "webhook" service "create" endpoint
+// HTTP request body.
+type CreateRequestBody struct {
+	// Callback URL, served over HTTPS from a public address
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// Event types to deliver
+	EventTypes []string `form:"eventTypes,omitempty" json:"eventTypes,omitempty" xml:"eventTypes,omitempty"`
+}
+
+// CreateResponseBody is the type of the "webhook" service "create" endpoint
+// HTTP response body.
+type CreateResponseBody struct {
+	// Secret used to sign deliveries with HMAC-SHA256
+	Secret string `form:"secret" json:"secret" xml:"secret"`
+	// Subscription ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Callback URL receiving the events
+	URL string `form:"url" json:"url" xml:"url"`
+	// Event types delivered to the callback
+	EventTypes []string `form:"eventTypes" json:"eventTypes" xml:"eventTypes"`
+	// Whether deliveries are enabled
+	Active bool `form:"active" json:"active" xml:"active"`
+	// Creation time
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
+// ListResponseBody is the type of the "webhook" service "list" endpoint HTTP
+// response body.
+type ListResponseBody []*WebhookSubscriptionResponse
+
+// DeliveriesResponseBody is the type of the "webhook" service "deliveries"
+// endpoint HTTP response body.
+type DeliveriesResponseBody []*WebhookDeliveryResponse
+
+// RedeliverResponseBody is the type of the "webhook" service "redeliver"
+// endpoint HTTP response body.
+type RedeliverResponseBody struct {
+	// Delivery ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Subscription ID
+	SubscriptionID string `form:"subscriptionId" json:"subscriptionId" xml:"subscriptionId"`
+	// ID of the delivered event
+	EventID string `form:"eventId" json:"eventId" xml:"eventId"`
+	// Type of the delivered event
+	EventType string `form:"eventType" json:"eventType" xml:"eventType"`
+	// Delivery status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Number of attempts made
+	Attempts int `form:"attempts" json:"attempts" xml:"attempts"`
+	// HTTP status of the last attempt
+	LastStatusCode *int `form:"lastStatusCode,omitempty" json:"lastStatusCode,omitempty" xml:"lastStatusCode,omitempty"`
+	// Error of the last failed attempt
+	LastError *string `form:"lastError,omitempty" json:"lastError,omitempty" xml:"lastError,omitempty"`
+	// When the next attempt is scheduled
+	NextAttemptAt *string `form:"nextAttemptAt,omitempty" json:"nextAttemptAt,omitempty" xml:"nextAttemptAt,omitempty"`
+	// When the delivery succeeded
+	DeliveredAt *string `form:"deliveredAt,omitempty" json:"deliveredAt,omitempty" xml:"deliveredAt,omitempty"`
+	// When the delivery was queued
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
+// CreateBadRequestResponseBody is the type of the "webhook" service "create"
+// endpoint HTTP response body for the "badRequest" error.
+type CreateBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// CreateForbiddenResponseBody is the type of the "webhook" service "create"
+// endpoint HTTP response body for the "forbidden" error.
+type CreateForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateInternalServerErrorResponseBody is the type of the "webhook" service
+// "create" endpoint HTTP response body for the "internalServerError" error.
+type CreateInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateNotFoundResponseBody is the type of the "webhook" service "create"
+// endpoint HTTP response body for the "notFound" error.
+type CreateNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateUnauthorizedResponseBody is the type of the "webhook" service "create"
+// endpoint HTTP response body for the "unauthorized" error.
+type CreateUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListBadRequestResponseBody is the type of the "webhook" service "list"
+// endpoint HTTP response body for the "badRequest" error.
+type ListBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListForbiddenResponseBody is the type of the "webhook" service "list"
+// endpoint HTTP response body for the "forbidden" error.
+type ListForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListInternalServerErrorResponseBody is the type of the "webhook" service
+// "list" endpoint HTTP response body for the "internalServerError" error.
+type ListInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListNotFoundResponseBody is the type of the "webhook" service "list"
+// endpoint HTTP response body for the "notFound" error.
+type ListNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListUnauthorizedResponseBody is the type of the "webhook" service "list"
+// endpoint HTTP response body for the "unauthorized" error.
+type ListUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteBadRequestResponseBody is the type of the "webhook" service "delete"
+// endpoint HTTP response body for the "badRequest" error.
+type DeleteBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// DeleteForbiddenResponseBody is the type of the "webhook" service "delete"
+// endpoint HTTP response body for the "forbidden" error.
+type DeleteForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteInternalServerErrorResponseBody is the type of the "webhook" service
+// "delete" endpoint HTTP response body for the "internalServerError" error.
+type DeleteInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteNotFoundResponseBody is the type of the "webhook" service "delete"
+// endpoint HTTP response body for the "notFound" error.
+type DeleteNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteUnauthorizedResponseBody is the type of the "webhook" service "delete"
+// endpoint HTTP response body for the "unauthorized" error.
+type DeleteUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeliveriesBadRequestResponseBody is the type of the "webhook" service
+// "deliveries" endpoint HTTP response body for the "badRequest" error.
+type DeliveriesBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// DeliveriesForbiddenResponseBody is the type of the "webhook" service
+// "deliveries" endpoint HTTP response body for the "forbidden" error.
+type DeliveriesForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeliveriesInternalServerErrorResponseBody is the type of the "webhook"
+// service "deliveries" endpoint HTTP response body for the
+// "internalServerError" error.
+type DeliveriesInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeliveriesNotFoundResponseBody is the type of the "webhook" service
+// "deliveries" endpoint HTTP response body for the "notFound" error.
+type DeliveriesNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeliveriesUnauthorizedResponseBody is the type of the "webhook" service
+// "deliveries" endpoint HTTP response body for the "unauthorized" error.
+type DeliveriesUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RedeliverBadRequestResponseBody is the type of the "webhook" service
+// "redeliver" endpoint HTTP response body for the "badRequest" error.
+type RedeliverBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// RedeliverForbiddenResponseBody is the type of the "webhook" service
+// "redeliver" endpoint HTTP response body for the "forbidden" error.
+type RedeliverForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RedeliverInternalServerErrorResponseBody is the type of the "webhook"
+// service "redeliver" endpoint HTTP response body for the
+// "internalServerError" error.
+type RedeliverInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RedeliverNotFoundResponseBody is the type of the "webhook" service
+// "redeliver" endpoint HTTP response body for the "notFound" error.
+type RedeliverNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RedeliverUnauthorizedResponseBody is the type of the "webhook" service
+// "redeliver" endpoint HTTP response body for the "unauthorized" error.
+type RedeliverUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field string `form:"field" json:"field" xml:"field"`
+	// Motivo per cui il campo non è valido
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// WebhookSubscriptionResponse is used to define fields on response body types.
+type WebhookSubscriptionResponse struct {
+	// Subscription ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Callback URL receiving the events
+	URL string `form:"url" json:"url" xml:"url"`
+	// Event types delivered to the callback
+	EventTypes []string `form:"eventTypes" json:"eventTypes" xml:"eventTypes"`
+	// Whether deliveries are enabled
+	Active bool `form:"active" json:"active" xml:"active"`
+	// Creation time
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
+// WebhookDeliveryResponse is used to define fields on response body types.
+type WebhookDeliveryResponse struct {
+	// Delivery ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Subscription ID
+	SubscriptionID string `form:"subscriptionId" json:"subscriptionId" xml:"subscriptionId"`
+	// ID of the delivered event
+	EventID string `form:"eventId" json:"eventId" xml:"eventId"`
+	// Type of the delivered event
+	EventType string `form:"eventType" json:"eventType" xml:"eventType"`
+	// Delivery status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Number of attempts made
+	Attempts int `form:"attempts" json:"attempts" xml:"attempts"`
+	// HTTP status of the last attempt
+	LastStatusCode *int `form:"lastStatusCode,omitempty" json:"lastStatusCode,omitempty" xml:"lastStatusCode,omitempty"`
+	// Error of the last failed attempt
+	LastError *string `form:"lastError,omitempty" json:"lastError,omitempty" xml:"lastError,omitempty"`
+	// When the next attempt is scheduled
+	NextAttemptAt *string `form:"nextAttemptAt,omitempty" json:"nextAttemptAt,omitempty" xml:"nextAttemptAt,omitempty"`
+	// When the delivery succeeded
+	DeliveredAt *string `form:"deliveredAt,omitempty" json:"deliveredAt,omitempty" xml:"deliveredAt,omitempty"`
+	// When the delivery was queued
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
+// NewCreateResponseBody builds the HTTP response body from the result of the
+// "create" endpoint of the "webhook" service.
+func NewCreateResponseBody(res *webhook.CreatedWebhookSubscription) *CreateResponseBody {
+	body := &CreateResponseBody{
+		Secret:    res.Secret,
+		ID:        res.ID,
+		URL:       res.URL,
+		Active:    res.Active,
+		CreatedAt: res.CreatedAt,
+	}
+	if res.EventTypes != nil {
+		body.EventTypes = make([]string, len(res.EventTypes))
+		for i, val := range res.EventTypes {
+			body.EventTypes[i] = val
+		}
+	} else {
+		body.EventTypes = []string{}
+	}
+	return body
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "list" endpoint of the "webhook" service.
+func NewListResponseBody(res []*webhook.WebhookSubscription) ListResponseBody {
+	body := make([]*WebhookSubscriptionResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalWebhookWebhookSubscriptionToWebhookSubscriptionResponse(val)
+	}
+	return body
+}
+
+// NewDeliveriesResponseBody builds the HTTP response body from the result of
+// the "deliveries" endpoint of the "webhook" service.
+func NewDeliveriesResponseBody(res []*webhook.WebhookDelivery) DeliveriesResponseBody {
+	body := make([]*WebhookDeliveryResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalWebhookWebhookDeliveryToWebhookDeliveryResponse(val)
+	}
+	return body
+}
+
+// NewRedeliverResponseBody builds the HTTP response body from the result of
+// the "redeliver" endpoint of the "webhook" service.
+func NewRedeliverResponseBody(res *webhook.WebhookDelivery) *RedeliverResponseBody {
+	body := &RedeliverResponseBody{
+		ID:             res.ID,
+		SubscriptionID: res.SubscriptionID,
+		EventID:        res.EventID,
+		EventType:      res.EventType,
+		Status:         res.Status,
+		Attempts:       res.Attempts,
+		LastStatusCode: res.LastStatusCode,
+		LastError:      res.LastError,
+		NextAttemptAt:  res.NextAttemptAt,
+		DeliveredAt:    res.DeliveredAt,
+		CreatedAt:      res.CreatedAt,
+	}
+	return body
+}
+
+// NewCreateBadRequestResponseBody builds the HTTP response body from the
+// result of the "create" endpoint of the "webhook" service.
+func NewCreateBadRequestResponseBody(res *webhook.BadRequest) *CreateBadRequestResponseBody {
+	body := &CreateBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalWebhookFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewCreateForbiddenResponseBody builds the HTTP response body from the result
+// of the "create" endpoint of the "webhook" service.
+func NewCreateForbiddenResponseBody(res *webhook.Forbidden) *CreateForbiddenResponseBody {
+	body := &CreateForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "create" endpoint of the "webhook" service.
+func NewCreateInternalServerErrorResponseBody(res *webhook.InternalServerError) *CreateInternalServerErrorResponseBody {
+	body := &CreateInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateNotFoundResponseBody builds the HTTP response body from the result
+// of the "create" endpoint of the "webhook" service.
+func NewCreateNotFoundResponseBody(res *webhook.NotFound) *CreateNotFoundResponseBody {
+	body := &CreateNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "create" endpoint of the "webhook" service.
+func NewCreateUnauthorizedResponseBody(res *webhook.Unauthorized) *CreateUnauthorizedResponseBody {
+	body := &CreateUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListBadRequestResponseBody builds the HTTP response body from the result
+// of the "list" endpoint of the "webhook" service.
+func NewListBadRequestResponseBody(res *webhook.BadRequest) *ListBadRequestResponseBody {
+	body := &ListBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalWebhookFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewListForbiddenResponseBody builds the HTTP response body from the result
+// of the "list" endpoint of the "webhook" service.
+func NewListForbiddenResponseBody(res *webhook.Forbidden) *ListForbiddenResponseBody {
+	body := &ListForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "list" endpoint of the "webhook" service.
+func NewListInternalServerErrorResponseBody(res *webhook.InternalServerError) *ListInternalServerErrorResponseBody {
+	body := &ListInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListNotFoundResponseBody builds the HTTP response body from the result of
+// the "list" endpoint of the "webhook" service.
+func NewListNotFoundResponseBody(res *webhook.NotFound) *ListNotFoundResponseBody {
+	body := &ListNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "list" endpoint of the "webhook" service.
+func NewListUnauthorizedResponseBody(res *webhook.Unauthorized) *ListUnauthorizedResponseBody {
+	body := &ListUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteBadRequestResponseBody builds the HTTP response body from the
+// result of the "delete" endpoint of the "webhook" service.
+func NewDeleteBadRequestResponseBody(res *webhook.BadRequest) *DeleteBadRequestResponseBody {
+	body := &DeleteBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalWebhookFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewDeleteForbiddenResponseBody builds the HTTP response body from the result
+// of the "delete" endpoint of the "webhook" service.
+func NewDeleteForbiddenResponseBody(res *webhook.Forbidden) *DeleteForbiddenResponseBody {
+	body := &DeleteForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "delete" endpoint of the "webhook" service.
+func NewDeleteInternalServerErrorResponseBody(res *webhook.InternalServerError) *DeleteInternalServerErrorResponseBody {
+	body := &DeleteInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteNotFoundResponseBody builds the HTTP response body from the result
+// of the "delete" endpoint of the "webhook" service.
+func NewDeleteNotFoundResponseBody(res *webhook.NotFound) *DeleteNotFoundResponseBody {
+	body := &DeleteNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "delete" endpoint of the "webhook" service.
+func NewDeleteUnauthorizedResponseBody(res *webhook.Unauthorized) *DeleteUnauthorizedResponseBody {
+	body := &DeleteUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeliveriesBadRequestResponseBody builds the HTTP response body from the
+// result of the "deliveries" endpoint of the "webhook" service.
+func NewDeliveriesBadRequestResponseBody(res *webhook.BadRequest) *DeliveriesBadRequestResponseBody {
+	body := &DeliveriesBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalWebhookFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewDeliveriesForbiddenResponseBody builds the HTTP response body from the
+// result of the "deliveries" endpoint of the "webhook" service.
+func NewDeliveriesForbiddenResponseBody(res *webhook.Forbidden) *DeliveriesForbiddenResponseBody {
+	body := &DeliveriesForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeliveriesInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "deliveries" endpoint of the "webhook" service.
+func NewDeliveriesInternalServerErrorResponseBody(res *webhook.InternalServerError) *DeliveriesInternalServerErrorResponseBody {
+	body := &DeliveriesInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeliveriesNotFoundResponseBody builds the HTTP response body from the
+// result of the "deliveries" endpoint of the "webhook" service.
+func NewDeliveriesNotFoundResponseBody(res *webhook.NotFound) *DeliveriesNotFoundResponseBody {
+	body := &DeliveriesNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeliveriesUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "deliveries" endpoint of the "webhook" service.
+func NewDeliveriesUnauthorizedResponseBody(res *webhook.Unauthorized) *DeliveriesUnauthorizedResponseBody {
+	body := &DeliveriesUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRedeliverBadRequestResponseBody builds the HTTP response body from the
+// result of the "redeliver" endpoint of the "webhook" service.
+func NewRedeliverBadRequestResponseBody(res *webhook.BadRequest) *RedeliverBadRequestResponseBody {
+	body := &RedeliverBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalWebhookFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewRedeliverForbiddenResponseBody builds the HTTP response body from the
+// result of the "redeliver" endpoint of the "webhook" service.
+func NewRedeliverForbiddenResponseBody(res *webhook.Forbidden) *RedeliverForbiddenResponseBody {
+	body := &RedeliverForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRedeliverInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "redeliver" endpoint of the "webhook" service.
+func NewRedeliverInternalServerErrorResponseBody(res *webhook.InternalServerError) *RedeliverInternalServerErrorResponseBody {
+	body := &RedeliverInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRedeliverNotFoundResponseBody builds the HTTP response body from the
+// result of the "redeliver" endpoint of the "webhook" service.
+func NewRedeliverNotFoundResponseBody(res *webhook.NotFound) *RedeliverNotFoundResponseBody {
+	body := &RedeliverNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRedeliverUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "redeliver" endpoint of the "webhook" service.
+func NewRedeliverUnauthorizedResponseBody(res *webhook.Unauthorized) *RedeliverUnauthorizedResponseBody {
+	body := &RedeliverUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreatePayload builds a webhook service create endpoint payload.
+func NewCreatePayload(body *CreateRequestBody, token *string) *webhook.CreatePayload {
+	v := &webhook.CreatePayload{
+		URL: *body.URL,
+	}
+	v.EventTypes = make([]string, len(body.EventTypes))
+	for i, val := range body.EventTypes {
+		v.EventTypes[i] = val
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewListPayload builds a webhook service list endpoint payload.
+func NewListPayload(token *string) *webhook.ListPayload {
+	v := &webhook.ListPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewDeletePayload builds a webhook service delete endpoint payload.
+func NewDeletePayload(id string, token *string) *webhook.DeletePayload {
+	v := &webhook.DeletePayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewDeliveriesPayload builds a webhook service deliveries endpoint payload.
+func NewDeliveriesPayload(id string, status *string, limit int, offset int, token *string) *webhook.DeliveriesPayload {
+	v := &webhook.DeliveriesPayload{}
+	v.ID = id
+	v.Status = status
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v
+}
+
+// NewRedeliverPayload builds a webhook service redeliver endpoint payload.
+func NewRedeliverPayload(id string, deliveryID string, token *string) *webhook.RedeliverPayload {
+	v := &webhook.RedeliverPayload{}
+	v.ID = id
+	v.DeliveryID = deliveryID
+	v.Token = token
+
+	return v
+}
+
+// ValidateCreateRequestBody runs the validations defined on CreateRequestBody
+func ValidateCreateRequestBody(body *CreateRequestBody) (err error) {
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.EventTypes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("eventTypes", "body"))
+	}
+	if body.URL != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.url", *body.URL, "^https://"))
+	}
+	if body.URL != nil {
+		if utf8.RuneCountInString(*body.URL) > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.url", *body.URL, utf8.RuneCountInString(*body.URL), 2048, false))
+		}
+	}
+	if len(body.EventTypes) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.eventTypes", body.EventTypes, len(body.EventTypes), 1, true))
+	}
+	for _, e := range body.EventTypes {
+		if !(e == "UserCreated" || e == "UserUpdated" || e == "UserDeleted" || e == "UserRestored" || e == "TrainingPlanCreated" || e == "TrainingPlanUpdated" || e == "TrainingPlanDeleted" || e == "TrainingPlanRestored" || e == "WorkoutUpdated" || e == "SetLogged" || e == "WorkoutSessionStarted" || e == "WorkoutSessionFinished" || e == "WorkoutSessionAbandoned" || e == "PersonalRecordAchieved" || e == "AthleteInvited" || e == "CoachingStarted" || e == "CoachingRevoked" || e == "ProgressionApplied" || e == "HistoryImported") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.eventTypes[*]", e, []any{"UserCreated", "UserUpdated", "UserDeleted", "UserRestored", "TrainingPlanCreated", "TrainingPlanUpdated", "TrainingPlanDeleted", "TrainingPlanRestored", "WorkoutUpdated", "SetLogged", "WorkoutSessionStarted", "WorkoutSessionFinished", "WorkoutSessionAbandoned", "PersonalRecordAchieved", "AthleteInvited", "CoachingStarted", "CoachingRevoked", "ProgressionApplied", "HistoryImported"}))
+		}
+	}
+	return
+}
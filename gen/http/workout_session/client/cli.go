@@ -0,0 +1,286 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// workout_session HTTP client CLI support package
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	workoutsession "be/gen/workout_session"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildStartPayload builds the payload for the workout_session start endpoint
+// from CLI flags.
+func BuildStartPayload(workoutSessionStartBody string, workoutSessionStartToken string) (*workoutsession.StartPayload, error) {
+	var err error
+	var body StartRequestBody
+	{
+		err = json.Unmarshal([]byte(workoutSessionStartBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"notes\": \"Felt strong today\",\n      \"workoutId\": \"7a8b9c0d-1e2f-4a3b-9c4d-5e6f7a8b9c0d\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.workoutId", body.WorkoutID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if workoutSessionStartToken != "" {
+			token = &workoutSessionStartToken
+		}
+	}
+	v := &workoutsession.StartPayload{
+		WorkoutID: body.WorkoutID,
+		Notes:     body.Notes,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetPayload builds the payload for the workout_session get endpoint from
+// CLI flags.
+func BuildGetPayload(workoutSessionGetID string, workoutSessionGetToken string) (*workoutsession.GetPayload, error) {
+	var err error
+	var id string
+	{
+		id = workoutSessionGetID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if workoutSessionGetToken != "" {
+			token = &workoutSessionGetToken
+		}
+	}
+	v := &workoutsession.GetPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListPayload builds the payload for the workout_session list endpoint
+// from CLI flags.
+func BuildListPayload(workoutSessionListUserID string, workoutSessionListWorkoutID string, workoutSessionListStatus string, workoutSessionListLimit string, workoutSessionListOffset string, workoutSessionListToken string) (*workoutsession.ListPayload, error) {
+	var err error
+	var userID *string
+	{
+		if workoutSessionListUserID != "" {
+			userID = &workoutSessionListUserID
+			err = goa.MergeErrors(err, goa.ValidateFormat("userId", *userID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var workoutID *string
+	{
+		if workoutSessionListWorkoutID != "" {
+			workoutID = &workoutSessionListWorkoutID
+			err = goa.MergeErrors(err, goa.ValidateFormat("workoutId", *workoutID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var status *string
+	{
+		if workoutSessionListStatus != "" {
+			status = &workoutSessionListStatus
+			if !(*status == "in_progress" || *status == "finished" || *status == "abandoned") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"in_progress", "finished", "abandoned"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if workoutSessionListLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(workoutSessionListLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var offset int
+	{
+		if workoutSessionListOffset != "" {
+			var v int64
+			v, err = strconv.ParseInt(workoutSessionListOffset, 10, strconv.IntSize)
+			offset = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for offset, must be INT")
+			}
+			if offset < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("offset", offset, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if workoutSessionListToken != "" {
+			token = &workoutSessionListToken
+		}
+	}
+	v := &workoutsession.ListPayload{}
+	v.UserID = userID
+	v.WorkoutID = workoutID
+	v.Status = status
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildLogPayload builds the payload for the workout_session log endpoint from
+// CLI flags.
+func BuildLogPayload(workoutSessionLogBody string, workoutSessionLogID string, workoutSessionLogToken string) (*workoutsession.LogPayload, error) {
+	var err error
+	var body LogRequestBody
+	{
+		err = json.Unmarshal([]byte(workoutSessionLogBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"reps\": 7,\n      \"restTime\": 120,\n      \"sessionExerciseId\": \"49de5f7e-3319-43c3-a2f5-62687a21489e\",\n      \"setId\": \"03919ce0-f661-4001-bdc6-5b12dddfdfbb\",\n      \"weight\": 80\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.sessionExerciseId", body.SessionExerciseID, goa.FormatUUID))
+		if body.SetID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.setId", *body.SetID, goa.FormatUUID))
+		}
+		if body.Weight < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", body.Weight, 0, true))
+		}
+		if body.Reps < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.reps", body.Reps, 0, true))
+		}
+		if body.RestTime != nil {
+			if *body.RestTime < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.restTime", *body.RestTime, 0, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = workoutSessionLogID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if workoutSessionLogToken != "" {
+			token = &workoutSessionLogToken
+		}
+	}
+	v := &workoutsession.LogPayload{
+		SessionExerciseID: body.SessionExerciseID,
+		SetID:             body.SetID,
+		Weight:            body.Weight,
+		Reps:              body.Reps,
+		RestTime:          body.RestTime,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildFinishPayload builds the payload for the workout_session finish
+// endpoint from CLI flags.
+func BuildFinishPayload(workoutSessionFinishBody string, workoutSessionFinishID string, workoutSessionFinishToken string) (*workoutsession.FinishPayload, error) {
+	var err error
+	var body FinishRequestBody
+	{
+		err = json.Unmarshal([]byte(workoutSessionFinishBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"notes\": \"Last set was a grind\"\n   }'")
+		}
+	}
+	var id string
+	{
+		id = workoutSessionFinishID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if workoutSessionFinishToken != "" {
+			token = &workoutSessionFinishToken
+		}
+	}
+	v := &workoutsession.FinishPayload{
+		Notes: body.Notes,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildAbandonPayload builds the payload for the workout_session abandon
+// endpoint from CLI flags.
+func BuildAbandonPayload(workoutSessionAbandonBody string, workoutSessionAbandonID string, workoutSessionAbandonToken string) (*workoutsession.AbandonPayload, error) {
+	var err error
+	var body AbandonRequestBody
+	{
+		err = json.Unmarshal([]byte(workoutSessionAbandonBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"notes\": \"Shoulder pain\"\n   }'")
+		}
+	}
+	var id string
+	{
+		id = workoutSessionAbandonID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if workoutSessionAbandonToken != "" {
+			token = &workoutSessionAbandonToken
+		}
+	}
+	v := &workoutsession.AbandonPayload{
+		Notes: body.Notes,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
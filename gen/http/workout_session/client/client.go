@@ -0,0 +1,216 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// workout_session client HTTP transport
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the workout_session service endpoint HTTP clients.
+type Client struct {
+	// Start Doer is the HTTP client used to make requests to the start endpoint.
+	StartDoer goahttp.Doer
+
+	// Get Doer is the HTTP client used to make requests to the get endpoint.
+	GetDoer goahttp.Doer
+
+	// List Doer is the HTTP client used to make requests to the list endpoint.
+	ListDoer goahttp.Doer
+
+	// Log Doer is the HTTP client used to make requests to the log endpoint.
+	LogDoer goahttp.Doer
+
+	// Finish Doer is the HTTP client used to make requests to the finish endpoint.
+	FinishDoer goahttp.Doer
+
+	// Abandon Doer is the HTTP client used to make requests to the abandon
+	// endpoint.
+	AbandonDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the workout_session service
+// servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		StartDoer:           doer,
+		GetDoer:             doer,
+		ListDoer:            doer,
+		LogDoer:             doer,
+		FinishDoer:          doer,
+		AbandonDoer:         doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// Start returns an endpoint that makes HTTP requests to the workout_session
+// service start server.
+func (c *Client) Start() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeStartRequest(c.encoder)
+		decodeResponse = DecodeStartResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildStartRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.StartDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("workout_session", "start", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Get returns an endpoint that makes HTTP requests to the workout_session
+// service get server.
+func (c *Client) Get() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetRequest(c.encoder)
+		decodeResponse = DecodeGetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("workout_session", "get", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// List returns an endpoint that makes HTTP requests to the workout_session
+// service list server.
+func (c *Client) List() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListRequest(c.encoder)
+		decodeResponse = DecodeListResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("workout_session", "list", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Log returns an endpoint that makes HTTP requests to the workout_session
+// service log server.
+func (c *Client) Log() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeLogRequest(c.encoder)
+		decodeResponse = DecodeLogResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildLogRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.LogDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("workout_session", "log", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Finish returns an endpoint that makes HTTP requests to the workout_session
+// service finish server.
+func (c *Client) Finish() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeFinishRequest(c.encoder)
+		decodeResponse = DecodeFinishResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildFinishRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.FinishDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("workout_session", "finish", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Abandon returns an endpoint that makes HTTP requests to the workout_session
+// service abandon server.
+func (c *Client) Abandon() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAbandonRequest(c.encoder)
+		decodeResponse = DecodeAbandonResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAbandonRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AbandonDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("workout_session", "abandon", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,1142 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// workout_session HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	workoutsession "be/gen/workout_session"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildStartRequest instantiates a HTTP request object with method and path
+// set to call the "workout_session" service "start" endpoint
+func (c *Client) BuildStartRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: StartWorkoutSessionPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("workout_session", "start", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeStartRequest returns an encoder for requests sent to the
+// workout_session start server.
+func EncodeStartRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*workoutsession.StartPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("workout_session", "start", "*workoutsession.StartPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewStartRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("workout_session", "start", err)
+		}
+		return nil
+	}
+}
+
+// DecodeStartResponse returns a decoder for responses returned by the
+// workout_session start endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeStartResponse may return the following errors:
+//   - "badRequest" (type *workoutsession.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *workoutsession.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *workoutsession.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *workoutsession.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *workoutsession.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeStartResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body StartResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "start", err)
+			}
+			err = ValidateStartResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "start", err)
+			}
+			res := NewStartWorkoutSessionCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body StartBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "start", err)
+			}
+			err = ValidateStartBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "start", err)
+			}
+			return nil, NewStartBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body StartForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "start", err)
+			}
+			err = ValidateStartForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "start", err)
+			}
+			return nil, NewStartForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body StartInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "start", err)
+			}
+			err = ValidateStartInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "start", err)
+			}
+			return nil, NewStartInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body StartNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "start", err)
+			}
+			err = ValidateStartNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "start", err)
+			}
+			return nil, NewStartNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body StartUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "start", err)
+			}
+			err = ValidateStartUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "start", err)
+			}
+			return nil, NewStartUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("workout_session", "start", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetRequest instantiates a HTTP request object with method and path set
+// to call the "workout_session" service "get" endpoint
+func (c *Client) BuildGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*workoutsession.GetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("workout_session", "get", "*workoutsession.GetPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetWorkoutSessionPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("workout_session", "get", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetRequest returns an encoder for requests sent to the workout_session
+// get server.
+func EncodeGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*workoutsession.GetPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("workout_session", "get", "*workoutsession.GetPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetResponse returns a decoder for responses returned by the
+// workout_session get endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeGetResponse may return the following errors:
+//   - "badRequest" (type *workoutsession.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *workoutsession.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *workoutsession.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *workoutsession.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *workoutsession.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "get", err)
+			}
+			err = ValidateGetResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "get", err)
+			}
+			res := NewGetWorkoutSessionOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "get", err)
+			}
+			err = ValidateGetBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "get", err)
+			}
+			return nil, NewGetBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "get", err)
+			}
+			err = ValidateGetForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "get", err)
+			}
+			return nil, NewGetForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "get", err)
+			}
+			err = ValidateGetInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "get", err)
+			}
+			return nil, NewGetInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "get", err)
+			}
+			err = ValidateGetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "get", err)
+			}
+			return nil, NewGetNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "get", err)
+			}
+			err = ValidateGetUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "get", err)
+			}
+			return nil, NewGetUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("workout_session", "get", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "workout_session" service "list" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWorkoutSessionPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("workout_session", "list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListRequest returns an encoder for requests sent to the
+// workout_session list server.
+func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*workoutsession.ListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("workout_session", "list", "*workoutsession.ListPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.UserID != nil {
+			values.Add("userId", *p.UserID)
+		}
+		if p.WorkoutID != nil {
+			values.Add("workoutId", *p.WorkoutID)
+		}
+		if p.Status != nil {
+			values.Add("status", *p.Status)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		values.Add("offset", fmt.Sprintf("%v", p.Offset))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListResponse returns a decoder for responses returned by the
+// workout_session list endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListResponse may return the following errors:
+//   - "badRequest" (type *workoutsession.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *workoutsession.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *workoutsession.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *workoutsession.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *workoutsession.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "list", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateWorkoutSessionResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "list", err)
+			}
+			res := NewListWorkoutSessionOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "list", err)
+			}
+			err = ValidateListBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "list", err)
+			}
+			return nil, NewListBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ListForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "list", err)
+			}
+			err = ValidateListForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "list", err)
+			}
+			return nil, NewListForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "list", err)
+			}
+			err = ValidateListInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "list", err)
+			}
+			return nil, NewListInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "list", err)
+			}
+			err = ValidateListNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "list", err)
+			}
+			return nil, NewListNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "list", err)
+			}
+			err = ValidateListUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "list", err)
+			}
+			return nil, NewListUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("workout_session", "list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildLogRequest instantiates a HTTP request object with method and path set
+// to call the "workout_session" service "log" endpoint
+func (c *Client) BuildLogRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*workoutsession.LogPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("workout_session", "log", "*workoutsession.LogPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: LogWorkoutSessionPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("workout_session", "log", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeLogRequest returns an encoder for requests sent to the workout_session
+// log server.
+func EncodeLogRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*workoutsession.LogPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("workout_session", "log", "*workoutsession.LogPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewLogRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("workout_session", "log", err)
+		}
+		return nil
+	}
+}
+
+// DecodeLogResponse returns a decoder for responses returned by the
+// workout_session log endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeLogResponse may return the following errors:
+//   - "badRequest" (type *workoutsession.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *workoutsession.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *workoutsession.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *workoutsession.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *workoutsession.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeLogResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body LogResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "log", err)
+			}
+			err = ValidateLogResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "log", err)
+			}
+			res := NewLogSessionSetCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body LogBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "log", err)
+			}
+			err = ValidateLogBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "log", err)
+			}
+			return nil, NewLogBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body LogForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "log", err)
+			}
+			err = ValidateLogForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "log", err)
+			}
+			return nil, NewLogForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body LogInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "log", err)
+			}
+			err = ValidateLogInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "log", err)
+			}
+			return nil, NewLogInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body LogNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "log", err)
+			}
+			err = ValidateLogNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "log", err)
+			}
+			return nil, NewLogNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body LogUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "log", err)
+			}
+			err = ValidateLogUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "log", err)
+			}
+			return nil, NewLogUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("workout_session", "log", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildFinishRequest instantiates a HTTP request object with method and path
+// set to call the "workout_session" service "finish" endpoint
+func (c *Client) BuildFinishRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*workoutsession.FinishPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("workout_session", "finish", "*workoutsession.FinishPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: FinishWorkoutSessionPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("workout_session", "finish", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeFinishRequest returns an encoder for requests sent to the
+// workout_session finish server.
+func EncodeFinishRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*workoutsession.FinishPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("workout_session", "finish", "*workoutsession.FinishPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewFinishRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("workout_session", "finish", err)
+		}
+		return nil
+	}
+}
+
+// DecodeFinishResponse returns a decoder for responses returned by the
+// workout_session finish endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeFinishResponse may return the following errors:
+//   - "badRequest" (type *workoutsession.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *workoutsession.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *workoutsession.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *workoutsession.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *workoutsession.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeFinishResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body FinishResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "finish", err)
+			}
+			err = ValidateFinishResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "finish", err)
+			}
+			res := NewFinishWorkoutSessionOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body FinishBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "finish", err)
+			}
+			err = ValidateFinishBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "finish", err)
+			}
+			return nil, NewFinishBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body FinishForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "finish", err)
+			}
+			err = ValidateFinishForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "finish", err)
+			}
+			return nil, NewFinishForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body FinishInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "finish", err)
+			}
+			err = ValidateFinishInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "finish", err)
+			}
+			return nil, NewFinishInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body FinishNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "finish", err)
+			}
+			err = ValidateFinishNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "finish", err)
+			}
+			return nil, NewFinishNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body FinishUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "finish", err)
+			}
+			err = ValidateFinishUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "finish", err)
+			}
+			return nil, NewFinishUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("workout_session", "finish", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAbandonRequest instantiates a HTTP request object with method and path
+// set to call the "workout_session" service "abandon" endpoint
+func (c *Client) BuildAbandonRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*workoutsession.AbandonPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("workout_session", "abandon", "*workoutsession.AbandonPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AbandonWorkoutSessionPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("workout_session", "abandon", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAbandonRequest returns an encoder for requests sent to the
+// workout_session abandon server.
+func EncodeAbandonRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*workoutsession.AbandonPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("workout_session", "abandon", "*workoutsession.AbandonPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewAbandonRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("workout_session", "abandon", err)
+		}
+		return nil
+	}
+}
+
+// DecodeAbandonResponse returns a decoder for responses returned by the
+// workout_session abandon endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeAbandonResponse may return the following errors:
+//   - "badRequest" (type *workoutsession.BadRequest): http.StatusBadRequest
+//   - "forbidden" (type *workoutsession.Forbidden): http.StatusForbidden
+//   - "internalServerError" (type *workoutsession.InternalServerError): http.StatusInternalServerError
+//   - "notFound" (type *workoutsession.NotFound): http.StatusNotFound
+//   - "unauthorized" (type *workoutsession.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeAbandonResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body AbandonResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "abandon", err)
+			}
+			err = ValidateAbandonResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "abandon", err)
+			}
+			res := NewAbandonWorkoutSessionOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body AbandonBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "abandon", err)
+			}
+			err = ValidateAbandonBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "abandon", err)
+			}
+			return nil, NewAbandonBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body AbandonForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "abandon", err)
+			}
+			err = ValidateAbandonForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "abandon", err)
+			}
+			return nil, NewAbandonForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body AbandonInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "abandon", err)
+			}
+			err = ValidateAbandonInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "abandon", err)
+			}
+			return nil, NewAbandonInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body AbandonNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "abandon", err)
+			}
+			err = ValidateAbandonNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "abandon", err)
+			}
+			return nil, NewAbandonNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body AbandonUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("workout_session", "abandon", err)
+			}
+			err = ValidateAbandonUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("workout_session", "abandon", err)
+			}
+			return nil, NewAbandonUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("workout_session", "abandon", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalSessionExerciseResponseBodyToWorkoutsessionSessionExercise builds a
+// value of type *workoutsession.SessionExercise from a value of type
+// *SessionExerciseResponseBody.
+func unmarshalSessionExerciseResponseBodyToWorkoutsessionSessionExercise(v *SessionExerciseResponseBody) *workoutsession.SessionExercise {
+	if v == nil {
+		return nil
+	}
+	res := &workoutsession.SessionExercise{
+		ID:             *v.ID,
+		ExerciseID:     v.ExerciseID,
+		ExerciseTypeID: v.ExerciseTypeID,
+		Name:           *v.Name,
+		Position:       *v.Position,
+	}
+	res.Sets = make([]*workoutsession.SessionSet, len(v.Sets))
+	for i, val := range v.Sets {
+		res.Sets[i] = unmarshalSessionSetResponseBodyToWorkoutsessionSessionSet(val)
+	}
+
+	return res
+}
+
+// unmarshalSessionSetResponseBodyToWorkoutsessionSessionSet builds a value of
+// type *workoutsession.SessionSet from a value of type *SessionSetResponseBody.
+func unmarshalSessionSetResponseBodyToWorkoutsessionSessionSet(v *SessionSetResponseBody) *workoutsession.SessionSet {
+	res := &workoutsession.SessionSet{
+		ID:              *v.ID,
+		PlannedSetID:    v.PlannedSetID,
+		Position:        *v.Position,
+		PlannedWeight:   v.PlannedWeight,
+		PlannedReps:     v.PlannedReps,
+		PlannedRestTime: v.PlannedRestTime,
+		Weight:          v.Weight,
+		Reps:            v.Reps,
+		RestTime:        v.RestTime,
+		Completed:       *v.Completed,
+		LoggedAt:        v.LoggedAt,
+	}
+	if v.Records != nil {
+		res.Records = make([]string, len(v.Records))
+		for i, val := range v.Records {
+			res.Records[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalFieldErrorResponseBodyToWorkoutsessionFieldError builds a value of
+// type *workoutsession.FieldError from a value of type *FieldErrorResponseBody.
+func unmarshalFieldErrorResponseBodyToWorkoutsessionFieldError(v *FieldErrorResponseBody) *workoutsession.FieldError {
+	if v == nil {
+		return nil
+	}
+	res := &workoutsession.FieldError{
+		Field:   *v.Field,
+		Message: *v.Message,
+	}
+
+	return res
+}
+
+// unmarshalWorkoutSessionResponseToWorkoutsessionWorkoutSession builds a value
+// of type *workoutsession.WorkoutSession from a value of type
+// *WorkoutSessionResponse.
+func unmarshalWorkoutSessionResponseToWorkoutsessionWorkoutSession(v *WorkoutSessionResponse) *workoutsession.WorkoutSession {
+	res := &workoutsession.WorkoutSession{
+		ID:         *v.ID,
+		WorkoutID:  v.WorkoutID,
+		UserID:     *v.UserID,
+		Status:     *v.Status,
+		StartedAt:  *v.StartedAt,
+		FinishedAt: v.FinishedAt,
+		Notes:      v.Notes,
+	}
+	if v.Exercises != nil {
+		res.Exercises = make([]*workoutsession.SessionExercise, len(v.Exercises))
+		for i, val := range v.Exercises {
+			res.Exercises[i] = unmarshalSessionExerciseResponseToWorkoutsessionSessionExercise(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalSessionExerciseResponseToWorkoutsessionSessionExercise builds a
+// value of type *workoutsession.SessionExercise from a value of type
+// *SessionExerciseResponse.
+func unmarshalSessionExerciseResponseToWorkoutsessionSessionExercise(v *SessionExerciseResponse) *workoutsession.SessionExercise {
+	if v == nil {
+		return nil
+	}
+	res := &workoutsession.SessionExercise{
+		ID:             *v.ID,
+		ExerciseID:     v.ExerciseID,
+		ExerciseTypeID: v.ExerciseTypeID,
+		Name:           *v.Name,
+		Position:       *v.Position,
+	}
+	res.Sets = make([]*workoutsession.SessionSet, len(v.Sets))
+	for i, val := range v.Sets {
+		res.Sets[i] = unmarshalSessionSetResponseToWorkoutsessionSessionSet(val)
+	}
+
+	return res
+}
+
+// unmarshalSessionSetResponseToWorkoutsessionSessionSet builds a value of type
+// *workoutsession.SessionSet from a value of type *SessionSetResponse.
+func unmarshalSessionSetResponseToWorkoutsessionSessionSet(v *SessionSetResponse) *workoutsession.SessionSet {
+	res := &workoutsession.SessionSet{
+		ID:              *v.ID,
+		PlannedSetID:    v.PlannedSetID,
+		Position:        *v.Position,
+		PlannedWeight:   v.PlannedWeight,
+		PlannedReps:     v.PlannedReps,
+		PlannedRestTime: v.PlannedRestTime,
+		Weight:          v.Weight,
+		Reps:            v.Reps,
+		RestTime:        v.RestTime,
+		Completed:       *v.Completed,
+		LoggedAt:        v.LoggedAt,
+	}
+	if v.Records != nil {
+		res.Records = make([]string, len(v.Records))
+		for i, val := range v.Records {
+			res.Records[i] = val
+		}
+	}
+
+	return res
+}
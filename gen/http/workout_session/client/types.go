@@ -0,0 +1,1877 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// workout_session HTTP client types
+//
+// Command:
+// $ goa gen be/design
+
+package client
+
+import (
+	workoutsession "be/gen/workout_session"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// StartRequestBody is the type of the "workout_session" service "start"
+// endpoint HTTP request body.
+type StartRequestBody struct {
+	// Planned workout ID
+	WorkoutID string `form:"workoutId" json:"workoutId" xml:"workoutId"`
+	// Athlete notes
+	Notes *string `form:"notes,omitempty" json:"notes,omitempty" xml:"notes,omitempty"`
+}
+
+// LogRequestBody is the type of the "workout_session" service "log" endpoint
+// HTTP request body.
+type LogRequestBody struct {
+	// Session exercise the set belongs to
+	SessionExerciseID string `form:"sessionExerciseId" json:"sessionExerciseId" xml:"sessionExerciseId"`
+	// Session set to fill in; omit to add an extra set
+	SetID *string `form:"setId,omitempty" json:"setId,omitempty" xml:"setId,omitempty"`
+	// Performed weight in kg
+	Weight float64 `form:"weight" json:"weight" xml:"weight"`
+	// Performed repetitions
+	Reps int `form:"reps" json:"reps" xml:"reps"`
+	// Actual rest time in seconds
+	RestTime *int `form:"restTime,omitempty" json:"restTime,omitempty" xml:"restTime,omitempty"`
+}
+
+// FinishRequestBody is the type of the "workout_session" service "finish"
+// endpoint HTTP request body.
+type FinishRequestBody struct {
+	// Athlete notes
+	Notes *string `form:"notes,omitempty" json:"notes,omitempty" xml:"notes,omitempty"`
+}
+
+// AbandonRequestBody is the type of the "workout_session" service "abandon"
+// endpoint HTTP request body.
+type AbandonRequestBody struct {
+	// Athlete notes
+	Notes *string `form:"notes,omitempty" json:"notes,omitempty" xml:"notes,omitempty"`
+}
+
+// StartResponseBody is the type of the "workout_session" service "start"
+// endpoint HTTP response body.
+type StartResponseBody struct {
+	// Session ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Planned workout ID, absent once the workout is purged from the trash
+	WorkoutID *string `form:"workoutId,omitempty" json:"workoutId,omitempty" xml:"workoutId,omitempty"`
+	// Athlete performing the session
+	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Session status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Start time
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Finish or abandon time
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Athlete notes
+	Notes *string `form:"notes,omitempty" json:"notes,omitempty" xml:"notes,omitempty"`
+	// Exercises of the session
+	Exercises []*SessionExerciseResponseBody `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+}
+
+// GetResponseBody is the type of the "workout_session" service "get" endpoint
+// HTTP response body.
+type GetResponseBody struct {
+	// Session ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Planned workout ID, absent once the workout is purged from the trash
+	WorkoutID *string `form:"workoutId,omitempty" json:"workoutId,omitempty" xml:"workoutId,omitempty"`
+	// Athlete performing the session
+	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Session status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Start time
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Finish or abandon time
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Athlete notes
+	Notes *string `form:"notes,omitempty" json:"notes,omitempty" xml:"notes,omitempty"`
+	// Exercises of the session
+	Exercises []*SessionExerciseResponseBody `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+}
+
+// ListResponseBody is the type of the "workout_session" service "list"
+// endpoint HTTP response body.
+type ListResponseBody []*WorkoutSessionResponse
+
+// LogResponseBody is the type of the "workout_session" service "log" endpoint
+// HTTP response body.
+type LogResponseBody struct {
+	// Session set ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Planned exercise set this set was snapshotted from
+	PlannedSetID *string `form:"plannedSetId,omitempty" json:"plannedSetId,omitempty" xml:"plannedSetId,omitempty"`
+	// Order of the set within the exercise
+	Position *int `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	// Planned weight in kg
+	PlannedWeight *float64 `form:"plannedWeight,omitempty" json:"plannedWeight,omitempty" xml:"plannedWeight,omitempty"`
+	// Planned repetitions
+	PlannedReps *int `form:"plannedReps,omitempty" json:"plannedReps,omitempty" xml:"plannedReps,omitempty"`
+	// Planned rest time in seconds
+	PlannedRestTime *int `form:"plannedRestTime,omitempty" json:"plannedRestTime,omitempty" xml:"plannedRestTime,omitempty"`
+	// Performed weight in kg
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Performed repetitions
+	Reps *int `form:"reps,omitempty" json:"reps,omitempty" xml:"reps,omitempty"`
+	// Actual rest time in seconds
+	RestTime *int `form:"restTime,omitempty" json:"restTime,omitempty" xml:"restTime,omitempty"`
+	// Whether the set was performed
+	Completed *bool `form:"completed,omitempty" json:"completed,omitempty" xml:"completed,omitempty"`
+	// When the set was logged
+	LoggedAt *string `form:"loggedAt,omitempty" json:"loggedAt,omitempty" xml:"loggedAt,omitempty"`
+	// Personal record kinds set by this set, returned when it is logged
+	Records []string `form:"records,omitempty" json:"records,omitempty" xml:"records,omitempty"`
+}
+
+// FinishResponseBody is the type of the "workout_session" service "finish"
+// endpoint HTTP response body.
+type FinishResponseBody struct {
+	// Session ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Planned workout ID, absent once the workout is purged from the trash
+	WorkoutID *string `form:"workoutId,omitempty" json:"workoutId,omitempty" xml:"workoutId,omitempty"`
+	// Athlete performing the session
+	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Session status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Start time
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Finish or abandon time
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Athlete notes
+	Notes *string `form:"notes,omitempty" json:"notes,omitempty" xml:"notes,omitempty"`
+	// Exercises of the session
+	Exercises []*SessionExerciseResponseBody `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+}
+
+// AbandonResponseBody is the type of the "workout_session" service "abandon"
+// endpoint HTTP response body.
+type AbandonResponseBody struct {
+	// Session ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Planned workout ID, absent once the workout is purged from the trash
+	WorkoutID *string `form:"workoutId,omitempty" json:"workoutId,omitempty" xml:"workoutId,omitempty"`
+	// Athlete performing the session
+	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Session status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Start time
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Finish or abandon time
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Athlete notes
+	Notes *string `form:"notes,omitempty" json:"notes,omitempty" xml:"notes,omitempty"`
+	// Exercises of the session
+	Exercises []*SessionExerciseResponseBody `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+}
+
+// StartBadRequestResponseBody is the type of the "workout_session" service
+// "start" endpoint HTTP response body for the "badRequest" error.
+type StartBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// StartForbiddenResponseBody is the type of the "workout_session" service
+// "start" endpoint HTTP response body for the "forbidden" error.
+type StartForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// StartInternalServerErrorResponseBody is the type of the "workout_session"
+// service "start" endpoint HTTP response body for the "internalServerError"
+// error.
+type StartInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// StartNotFoundResponseBody is the type of the "workout_session" service
+// "start" endpoint HTTP response body for the "notFound" error.
+type StartNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// StartUnauthorizedResponseBody is the type of the "workout_session" service
+// "start" endpoint HTTP response body for the "unauthorized" error.
+type StartUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetBadRequestResponseBody is the type of the "workout_session" service "get"
+// endpoint HTTP response body for the "badRequest" error.
+type GetBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// GetForbiddenResponseBody is the type of the "workout_session" service "get"
+// endpoint HTTP response body for the "forbidden" error.
+type GetForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetInternalServerErrorResponseBody is the type of the "workout_session"
+// service "get" endpoint HTTP response body for the "internalServerError"
+// error.
+type GetInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetNotFoundResponseBody is the type of the "workout_session" service "get"
+// endpoint HTTP response body for the "notFound" error.
+type GetNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetUnauthorizedResponseBody is the type of the "workout_session" service
+// "get" endpoint HTTP response body for the "unauthorized" error.
+type GetUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListBadRequestResponseBody is the type of the "workout_session" service
+// "list" endpoint HTTP response body for the "badRequest" error.
+type ListBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListForbiddenResponseBody is the type of the "workout_session" service
+// "list" endpoint HTTP response body for the "forbidden" error.
+type ListForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListInternalServerErrorResponseBody is the type of the "workout_session"
+// service "list" endpoint HTTP response body for the "internalServerError"
+// error.
+type ListInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListNotFoundResponseBody is the type of the "workout_session" service "list"
+// endpoint HTTP response body for the "notFound" error.
+type ListNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListUnauthorizedResponseBody is the type of the "workout_session" service
+// "list" endpoint HTTP response body for the "unauthorized" error.
+type ListUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// LogBadRequestResponseBody is the type of the "workout_session" service "log"
+// endpoint HTTP response body for the "badRequest" error.
+type LogBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// LogForbiddenResponseBody is the type of the "workout_session" service "log"
+// endpoint HTTP response body for the "forbidden" error.
+type LogForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// LogInternalServerErrorResponseBody is the type of the "workout_session"
+// service "log" endpoint HTTP response body for the "internalServerError"
+// error.
+type LogInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// LogNotFoundResponseBody is the type of the "workout_session" service "log"
+// endpoint HTTP response body for the "notFound" error.
+type LogNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// LogUnauthorizedResponseBody is the type of the "workout_session" service
+// "log" endpoint HTTP response body for the "unauthorized" error.
+type LogUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FinishBadRequestResponseBody is the type of the "workout_session" service
+// "finish" endpoint HTTP response body for the "badRequest" error.
+type FinishBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// FinishForbiddenResponseBody is the type of the "workout_session" service
+// "finish" endpoint HTTP response body for the "forbidden" error.
+type FinishForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FinishInternalServerErrorResponseBody is the type of the "workout_session"
+// service "finish" endpoint HTTP response body for the "internalServerError"
+// error.
+type FinishInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FinishNotFoundResponseBody is the type of the "workout_session" service
+// "finish" endpoint HTTP response body for the "notFound" error.
+type FinishNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FinishUnauthorizedResponseBody is the type of the "workout_session" service
+// "finish" endpoint HTTP response body for the "unauthorized" error.
+type FinishUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AbandonBadRequestResponseBody is the type of the "workout_session" service
+// "abandon" endpoint HTTP response body for the "badRequest" error.
+type AbandonBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID dell'errore
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Descrizione dettagliata dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Indica se l'errore è temporaneo
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// AbandonForbiddenResponseBody is the type of the "workout_session" service
+// "abandon" endpoint HTTP response body for the "forbidden" error.
+type AbandonForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AbandonInternalServerErrorResponseBody is the type of the "workout_session"
+// service "abandon" endpoint HTTP response body for the "internalServerError"
+// error.
+type AbandonInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AbandonNotFoundResponseBody is the type of the "workout_session" service
+// "abandon" endpoint HTTP response body for the "notFound" error.
+type AbandonNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AbandonUnauthorizedResponseBody is the type of the "workout_session" service
+// "abandon" endpoint HTTP response body for the "unauthorized" error.
+type AbandonUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// SessionExerciseResponseBody is used to define fields on response body types.
+type SessionExerciseResponseBody struct {
+	// Session exercise ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Planned exercise ID
+	ExerciseID *string `form:"exerciseId,omitempty" json:"exerciseId,omitempty" xml:"exerciseId,omitempty"`
+	// Exercise type ID
+	ExerciseTypeID *string `form:"exerciseTypeId,omitempty" json:"exerciseTypeId,omitempty" xml:"exerciseTypeId,omitempty"`
+	// Exercise name at the time the session started
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Order of the exercise within the session
+	Position *int `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	// Planned and performed sets
+	Sets []*SessionSetResponseBody `form:"sets,omitempty" json:"sets,omitempty" xml:"sets,omitempty"`
+}
+
+// SessionSetResponseBody is used to define fields on response body types.
+type SessionSetResponseBody struct {
+	// Session set ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Planned exercise set this set was snapshotted from
+	PlannedSetID *string `form:"plannedSetId,omitempty" json:"plannedSetId,omitempty" xml:"plannedSetId,omitempty"`
+	// Order of the set within the exercise
+	Position *int `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	// Planned weight in kg
+	PlannedWeight *float64 `form:"plannedWeight,omitempty" json:"plannedWeight,omitempty" xml:"plannedWeight,omitempty"`
+	// Planned repetitions
+	PlannedReps *int `form:"plannedReps,omitempty" json:"plannedReps,omitempty" xml:"plannedReps,omitempty"`
+	// Planned rest time in seconds
+	PlannedRestTime *int `form:"plannedRestTime,omitempty" json:"plannedRestTime,omitempty" xml:"plannedRestTime,omitempty"`
+	// Performed weight in kg
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Performed repetitions
+	Reps *int `form:"reps,omitempty" json:"reps,omitempty" xml:"reps,omitempty"`
+	// Actual rest time in seconds
+	RestTime *int `form:"restTime,omitempty" json:"restTime,omitempty" xml:"restTime,omitempty"`
+	// Whether the set was performed
+	Completed *bool `form:"completed,omitempty" json:"completed,omitempty" xml:"completed,omitempty"`
+	// When the set was logged
+	LoggedAt *string `form:"loggedAt,omitempty" json:"loggedAt,omitempty" xml:"loggedAt,omitempty"`
+	// Personal record kinds set by this set, returned when it is logged
+	Records []string `form:"records,omitempty" json:"records,omitempty" xml:"records,omitempty"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+	// Motivo per cui il campo non è valido
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// WorkoutSessionResponse is used to define fields on response body types.
+type WorkoutSessionResponse struct {
+	// Session ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Planned workout ID, absent once the workout is purged from the trash
+	WorkoutID *string `form:"workoutId,omitempty" json:"workoutId,omitempty" xml:"workoutId,omitempty"`
+	// Athlete performing the session
+	UserID *string `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Session status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Start time
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Finish or abandon time
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Athlete notes
+	Notes *string `form:"notes,omitempty" json:"notes,omitempty" xml:"notes,omitempty"`
+	// Exercises of the session
+	Exercises []*SessionExerciseResponse `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+}
+
+// SessionExerciseResponse is used to define fields on response body types.
+type SessionExerciseResponse struct {
+	// Session exercise ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Planned exercise ID
+	ExerciseID *string `form:"exerciseId,omitempty" json:"exerciseId,omitempty" xml:"exerciseId,omitempty"`
+	// Exercise type ID
+	ExerciseTypeID *string `form:"exerciseTypeId,omitempty" json:"exerciseTypeId,omitempty" xml:"exerciseTypeId,omitempty"`
+	// Exercise name at the time the session started
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Order of the exercise within the session
+	Position *int `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	// Planned and performed sets
+	Sets []*SessionSetResponse `form:"sets,omitempty" json:"sets,omitempty" xml:"sets,omitempty"`
+}
+
+// SessionSetResponse is used to define fields on response body types.
+type SessionSetResponse struct {
+	// Session set ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Planned exercise set this set was snapshotted from
+	PlannedSetID *string `form:"plannedSetId,omitempty" json:"plannedSetId,omitempty" xml:"plannedSetId,omitempty"`
+	// Order of the set within the exercise
+	Position *int `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	// Planned weight in kg
+	PlannedWeight *float64 `form:"plannedWeight,omitempty" json:"plannedWeight,omitempty" xml:"plannedWeight,omitempty"`
+	// Planned repetitions
+	PlannedReps *int `form:"plannedReps,omitempty" json:"plannedReps,omitempty" xml:"plannedReps,omitempty"`
+	// Planned rest time in seconds
+	PlannedRestTime *int `form:"plannedRestTime,omitempty" json:"plannedRestTime,omitempty" xml:"plannedRestTime,omitempty"`
+	// Performed weight in kg
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Performed repetitions
+	Reps *int `form:"reps,omitempty" json:"reps,omitempty" xml:"reps,omitempty"`
+	// Actual rest time in seconds
+	RestTime *int `form:"restTime,omitempty" json:"restTime,omitempty" xml:"restTime,omitempty"`
+	// Whether the set was performed
+	Completed *bool `form:"completed,omitempty" json:"completed,omitempty" xml:"completed,omitempty"`
+	// When the set was logged
+	LoggedAt *string `form:"loggedAt,omitempty" json:"loggedAt,omitempty" xml:"loggedAt,omitempty"`
+	// Personal record kinds set by this set, returned when it is logged
+	Records []string `form:"records,omitempty" json:"records,omitempty" xml:"records,omitempty"`
+}
+
+// NewStartRequestBody builds the HTTP request body from the payload of the
+// "start" endpoint of the "workout_session" service.
+func NewStartRequestBody(p *workoutsession.StartPayload) *StartRequestBody {
+	body := &StartRequestBody{
+		WorkoutID: p.WorkoutID,
+		Notes:     p.Notes,
+	}
+	return body
+}
+
+// NewLogRequestBody builds the HTTP request body from the payload of the "log"
+// endpoint of the "workout_session" service.
+func NewLogRequestBody(p *workoutsession.LogPayload) *LogRequestBody {
+	body := &LogRequestBody{
+		SessionExerciseID: p.SessionExerciseID,
+		SetID:             p.SetID,
+		Weight:            p.Weight,
+		Reps:              p.Reps,
+		RestTime:          p.RestTime,
+	}
+	return body
+}
+
+// NewFinishRequestBody builds the HTTP request body from the payload of the
+// "finish" endpoint of the "workout_session" service.
+func NewFinishRequestBody(p *workoutsession.FinishPayload) *FinishRequestBody {
+	body := &FinishRequestBody{
+		Notes: p.Notes,
+	}
+	return body
+}
+
+// NewAbandonRequestBody builds the HTTP request body from the payload of the
+// "abandon" endpoint of the "workout_session" service.
+func NewAbandonRequestBody(p *workoutsession.AbandonPayload) *AbandonRequestBody {
+	body := &AbandonRequestBody{
+		Notes: p.Notes,
+	}
+	return body
+}
+
+// NewStartWorkoutSessionCreated builds a "workout_session" service "start"
+// endpoint result from a HTTP "Created" response.
+func NewStartWorkoutSessionCreated(body *StartResponseBody) *workoutsession.WorkoutSession {
+	v := &workoutsession.WorkoutSession{
+		ID:         *body.ID,
+		WorkoutID:  body.WorkoutID,
+		UserID:     *body.UserID,
+		Status:     *body.Status,
+		StartedAt:  *body.StartedAt,
+		FinishedAt: body.FinishedAt,
+		Notes:      body.Notes,
+	}
+	if body.Exercises != nil {
+		v.Exercises = make([]*workoutsession.SessionExercise, len(body.Exercises))
+		for i, val := range body.Exercises {
+			v.Exercises[i] = unmarshalSessionExerciseResponseBodyToWorkoutsessionSessionExercise(val)
+		}
+	}
+
+	return v
+}
+
+// NewStartBadRequest builds a workout_session service start endpoint
+// badRequest error.
+func NewStartBadRequest(body *StartBadRequestResponseBody) *workoutsession.BadRequest {
+	v := &workoutsession.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*workoutsession.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToWorkoutsessionFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewStartForbidden builds a workout_session service start endpoint forbidden
+// error.
+func NewStartForbidden(body *StartForbiddenResponseBody) *workoutsession.Forbidden {
+	v := &workoutsession.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewStartInternalServerError builds a workout_session service start endpoint
+// internalServerError error.
+func NewStartInternalServerError(body *StartInternalServerErrorResponseBody) *workoutsession.InternalServerError {
+	v := &workoutsession.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewStartNotFound builds a workout_session service start endpoint notFound
+// error.
+func NewStartNotFound(body *StartNotFoundResponseBody) *workoutsession.NotFound {
+	v := &workoutsession.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewStartUnauthorized builds a workout_session service start endpoint
+// unauthorized error.
+func NewStartUnauthorized(body *StartUnauthorizedResponseBody) *workoutsession.Unauthorized {
+	v := &workoutsession.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetWorkoutSessionOK builds a "workout_session" service "get" endpoint
+// result from a HTTP "OK" response.
+func NewGetWorkoutSessionOK(body *GetResponseBody) *workoutsession.WorkoutSession {
+	v := &workoutsession.WorkoutSession{
+		ID:         *body.ID,
+		WorkoutID:  body.WorkoutID,
+		UserID:     *body.UserID,
+		Status:     *body.Status,
+		StartedAt:  *body.StartedAt,
+		FinishedAt: body.FinishedAt,
+		Notes:      body.Notes,
+	}
+	if body.Exercises != nil {
+		v.Exercises = make([]*workoutsession.SessionExercise, len(body.Exercises))
+		for i, val := range body.Exercises {
+			v.Exercises[i] = unmarshalSessionExerciseResponseBodyToWorkoutsessionSessionExercise(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetBadRequest builds a workout_session service get endpoint badRequest
+// error.
+func NewGetBadRequest(body *GetBadRequestResponseBody) *workoutsession.BadRequest {
+	v := &workoutsession.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*workoutsession.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToWorkoutsessionFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetForbidden builds a workout_session service get endpoint forbidden
+// error.
+func NewGetForbidden(body *GetForbiddenResponseBody) *workoutsession.Forbidden {
+	v := &workoutsession.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetInternalServerError builds a workout_session service get endpoint
+// internalServerError error.
+func NewGetInternalServerError(body *GetInternalServerErrorResponseBody) *workoutsession.InternalServerError {
+	v := &workoutsession.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetNotFound builds a workout_session service get endpoint notFound error.
+func NewGetNotFound(body *GetNotFoundResponseBody) *workoutsession.NotFound {
+	v := &workoutsession.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetUnauthorized builds a workout_session service get endpoint
+// unauthorized error.
+func NewGetUnauthorized(body *GetUnauthorizedResponseBody) *workoutsession.Unauthorized {
+	v := &workoutsession.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListWorkoutSessionOK builds a "workout_session" service "list" endpoint
+// result from a HTTP "OK" response.
+func NewListWorkoutSessionOK(body []*WorkoutSessionResponse) []*workoutsession.WorkoutSession {
+	v := make([]*workoutsession.WorkoutSession, len(body))
+	for i, val := range body {
+		v[i] = unmarshalWorkoutSessionResponseToWorkoutsessionWorkoutSession(val)
+	}
+
+	return v
+}
+
+// NewListBadRequest builds a workout_session service list endpoint badRequest
+// error.
+func NewListBadRequest(body *ListBadRequestResponseBody) *workoutsession.BadRequest {
+	v := &workoutsession.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*workoutsession.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToWorkoutsessionFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewListForbidden builds a workout_session service list endpoint forbidden
+// error.
+func NewListForbidden(body *ListForbiddenResponseBody) *workoutsession.Forbidden {
+	v := &workoutsession.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListInternalServerError builds a workout_session service list endpoint
+// internalServerError error.
+func NewListInternalServerError(body *ListInternalServerErrorResponseBody) *workoutsession.InternalServerError {
+	v := &workoutsession.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListNotFound builds a workout_session service list endpoint notFound
+// error.
+func NewListNotFound(body *ListNotFoundResponseBody) *workoutsession.NotFound {
+	v := &workoutsession.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListUnauthorized builds a workout_session service list endpoint
+// unauthorized error.
+func NewListUnauthorized(body *ListUnauthorizedResponseBody) *workoutsession.Unauthorized {
+	v := &workoutsession.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewLogSessionSetCreated builds a "workout_session" service "log" endpoint
+// result from a HTTP "Created" response.
+func NewLogSessionSetCreated(body *LogResponseBody) *workoutsession.SessionSet {
+	v := &workoutsession.SessionSet{
+		ID:              *body.ID,
+		PlannedSetID:    body.PlannedSetID,
+		Position:        *body.Position,
+		PlannedWeight:   body.PlannedWeight,
+		PlannedReps:     body.PlannedReps,
+		PlannedRestTime: body.PlannedRestTime,
+		Weight:          body.Weight,
+		Reps:            body.Reps,
+		RestTime:        body.RestTime,
+		Completed:       *body.Completed,
+		LoggedAt:        body.LoggedAt,
+	}
+	if body.Records != nil {
+		v.Records = make([]string, len(body.Records))
+		for i, val := range body.Records {
+			v.Records[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewLogBadRequest builds a workout_session service log endpoint badRequest
+// error.
+func NewLogBadRequest(body *LogBadRequestResponseBody) *workoutsession.BadRequest {
+	v := &workoutsession.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*workoutsession.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToWorkoutsessionFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewLogForbidden builds a workout_session service log endpoint forbidden
+// error.
+func NewLogForbidden(body *LogForbiddenResponseBody) *workoutsession.Forbidden {
+	v := &workoutsession.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewLogInternalServerError builds a workout_session service log endpoint
+// internalServerError error.
+func NewLogInternalServerError(body *LogInternalServerErrorResponseBody) *workoutsession.InternalServerError {
+	v := &workoutsession.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewLogNotFound builds a workout_session service log endpoint notFound error.
+func NewLogNotFound(body *LogNotFoundResponseBody) *workoutsession.NotFound {
+	v := &workoutsession.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewLogUnauthorized builds a workout_session service log endpoint
+// unauthorized error.
+func NewLogUnauthorized(body *LogUnauthorizedResponseBody) *workoutsession.Unauthorized {
+	v := &workoutsession.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewFinishWorkoutSessionOK builds a "workout_session" service "finish"
+// endpoint result from a HTTP "OK" response.
+func NewFinishWorkoutSessionOK(body *FinishResponseBody) *workoutsession.WorkoutSession {
+	v := &workoutsession.WorkoutSession{
+		ID:         *body.ID,
+		WorkoutID:  body.WorkoutID,
+		UserID:     *body.UserID,
+		Status:     *body.Status,
+		StartedAt:  *body.StartedAt,
+		FinishedAt: body.FinishedAt,
+		Notes:      body.Notes,
+	}
+	if body.Exercises != nil {
+		v.Exercises = make([]*workoutsession.SessionExercise, len(body.Exercises))
+		for i, val := range body.Exercises {
+			v.Exercises[i] = unmarshalSessionExerciseResponseBodyToWorkoutsessionSessionExercise(val)
+		}
+	}
+
+	return v
+}
+
+// NewFinishBadRequest builds a workout_session service finish endpoint
+// badRequest error.
+func NewFinishBadRequest(body *FinishBadRequestResponseBody) *workoutsession.BadRequest {
+	v := &workoutsession.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*workoutsession.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToWorkoutsessionFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewFinishForbidden builds a workout_session service finish endpoint
+// forbidden error.
+func NewFinishForbidden(body *FinishForbiddenResponseBody) *workoutsession.Forbidden {
+	v := &workoutsession.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewFinishInternalServerError builds a workout_session service finish
+// endpoint internalServerError error.
+func NewFinishInternalServerError(body *FinishInternalServerErrorResponseBody) *workoutsession.InternalServerError {
+	v := &workoutsession.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewFinishNotFound builds a workout_session service finish endpoint notFound
+// error.
+func NewFinishNotFound(body *FinishNotFoundResponseBody) *workoutsession.NotFound {
+	v := &workoutsession.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewFinishUnauthorized builds a workout_session service finish endpoint
+// unauthorized error.
+func NewFinishUnauthorized(body *FinishUnauthorizedResponseBody) *workoutsession.Unauthorized {
+	v := &workoutsession.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewAbandonWorkoutSessionOK builds a "workout_session" service "abandon"
+// endpoint result from a HTTP "OK" response.
+func NewAbandonWorkoutSessionOK(body *AbandonResponseBody) *workoutsession.WorkoutSession {
+	v := &workoutsession.WorkoutSession{
+		ID:         *body.ID,
+		WorkoutID:  body.WorkoutID,
+		UserID:     *body.UserID,
+		Status:     *body.Status,
+		StartedAt:  *body.StartedAt,
+		FinishedAt: body.FinishedAt,
+		Notes:      body.Notes,
+	}
+	if body.Exercises != nil {
+		v.Exercises = make([]*workoutsession.SessionExercise, len(body.Exercises))
+		for i, val := range body.Exercises {
+			v.Exercises[i] = unmarshalSessionExerciseResponseBodyToWorkoutsessionSessionExercise(val)
+		}
+	}
+
+	return v
+}
+
+// NewAbandonBadRequest builds a workout_session service abandon endpoint
+// badRequest error.
+func NewAbandonBadRequest(body *AbandonBadRequestResponseBody) *workoutsession.BadRequest {
+	v := &workoutsession.BadRequest{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+	if body.Fields != nil {
+		v.Fields = make([]*workoutsession.FieldError, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = unmarshalFieldErrorResponseBodyToWorkoutsessionFieldError(val)
+		}
+	}
+
+	return v
+}
+
+// NewAbandonForbidden builds a workout_session service abandon endpoint
+// forbidden error.
+func NewAbandonForbidden(body *AbandonForbiddenResponseBody) *workoutsession.Forbidden {
+	v := &workoutsession.Forbidden{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewAbandonInternalServerError builds a workout_session service abandon
+// endpoint internalServerError error.
+func NewAbandonInternalServerError(body *AbandonInternalServerErrorResponseBody) *workoutsession.InternalServerError {
+	v := &workoutsession.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewAbandonNotFound builds a workout_session service abandon endpoint
+// notFound error.
+func NewAbandonNotFound(body *AbandonNotFoundResponseBody) *workoutsession.NotFound {
+	v := &workoutsession.NotFound{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewAbandonUnauthorized builds a workout_session service abandon endpoint
+// unauthorized error.
+func NewAbandonUnauthorized(body *AbandonUnauthorizedResponseBody) *workoutsession.Unauthorized {
+	v := &workoutsession.Unauthorized{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// ValidateStartResponseBody runs the validations defined on StartResponseBody
+func ValidateStartResponseBody(body *StartResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startedAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.WorkoutID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.workoutId", *body.WorkoutID, goa.FormatUUID))
+	}
+	if body.UserID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "in_progress" || *body.Status == "finished" || *body.Status == "abandoned") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"in_progress", "finished", "abandoned"}))
+		}
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startedAt", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finishedAt", *body.FinishedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.Exercises {
+		if e != nil {
+			if err2 := ValidateSessionExerciseResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetResponseBody runs the validations defined on GetResponseBody
+func ValidateGetResponseBody(body *GetResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startedAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.WorkoutID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.workoutId", *body.WorkoutID, goa.FormatUUID))
+	}
+	if body.UserID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "in_progress" || *body.Status == "finished" || *body.Status == "abandoned") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"in_progress", "finished", "abandoned"}))
+		}
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startedAt", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finishedAt", *body.FinishedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.Exercises {
+		if e != nil {
+			if err2 := ValidateSessionExerciseResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateLogResponseBody runs the validations defined on LogResponseBody
+func ValidateLogResponseBody(body *LogResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Position == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position", "body"))
+	}
+	if body.Completed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("completed", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.PlannedSetID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.plannedSetId", *body.PlannedSetID, goa.FormatUUID))
+	}
+	if body.LoggedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.loggedAt", *body.LoggedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateFinishResponseBody runs the validations defined on FinishResponseBody
+func ValidateFinishResponseBody(body *FinishResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startedAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.WorkoutID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.workoutId", *body.WorkoutID, goa.FormatUUID))
+	}
+	if body.UserID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "in_progress" || *body.Status == "finished" || *body.Status == "abandoned") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"in_progress", "finished", "abandoned"}))
+		}
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startedAt", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finishedAt", *body.FinishedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.Exercises {
+		if e != nil {
+			if err2 := ValidateSessionExerciseResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAbandonResponseBody runs the validations defined on
+// AbandonResponseBody
+func ValidateAbandonResponseBody(body *AbandonResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startedAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.WorkoutID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.workoutId", *body.WorkoutID, goa.FormatUUID))
+	}
+	if body.UserID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "in_progress" || *body.Status == "finished" || *body.Status == "abandoned") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"in_progress", "finished", "abandoned"}))
+		}
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startedAt", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finishedAt", *body.FinishedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.Exercises {
+		if e != nil {
+			if err2 := ValidateSessionExerciseResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateStartBadRequestResponseBody runs the validations defined on
+// start_badRequest_response_body
+func ValidateStartBadRequestResponseBody(body *StartBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateStartForbiddenResponseBody runs the validations defined on
+// start_forbidden_response_body
+func ValidateStartForbiddenResponseBody(body *StartForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateStartInternalServerErrorResponseBody runs the validations defined on
+// start_internalServerError_response_body
+func ValidateStartInternalServerErrorResponseBody(body *StartInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateStartNotFoundResponseBody runs the validations defined on
+// start_notFound_response_body
+func ValidateStartNotFoundResponseBody(body *StartNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateStartUnauthorizedResponseBody runs the validations defined on
+// start_unauthorized_response_body
+func ValidateStartUnauthorizedResponseBody(body *StartUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetBadRequestResponseBody runs the validations defined on
+// get_badRequest_response_body
+func ValidateGetBadRequestResponseBody(body *GetBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetForbiddenResponseBody runs the validations defined on
+// get_forbidden_response_body
+func ValidateGetForbiddenResponseBody(body *GetForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetInternalServerErrorResponseBody runs the validations defined on
+// get_internalServerError_response_body
+func ValidateGetInternalServerErrorResponseBody(body *GetInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetNotFoundResponseBody runs the validations defined on
+// get_notFound_response_body
+func ValidateGetNotFoundResponseBody(body *GetNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetUnauthorizedResponseBody runs the validations defined on
+// get_unauthorized_response_body
+func ValidateGetUnauthorizedResponseBody(body *GetUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListBadRequestResponseBody runs the validations defined on
+// list_badRequest_response_body
+func ValidateListBadRequestResponseBody(body *ListBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListForbiddenResponseBody runs the validations defined on
+// list_forbidden_response_body
+func ValidateListForbiddenResponseBody(body *ListForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListInternalServerErrorResponseBody runs the validations defined on
+// list_internalServerError_response_body
+func ValidateListInternalServerErrorResponseBody(body *ListInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListNotFoundResponseBody runs the validations defined on
+// list_notFound_response_body
+func ValidateListNotFoundResponseBody(body *ListNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListUnauthorizedResponseBody runs the validations defined on
+// list_unauthorized_response_body
+func ValidateListUnauthorizedResponseBody(body *ListUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateLogBadRequestResponseBody runs the validations defined on
+// log_badRequest_response_body
+func ValidateLogBadRequestResponseBody(body *LogBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateLogForbiddenResponseBody runs the validations defined on
+// log_forbidden_response_body
+func ValidateLogForbiddenResponseBody(body *LogForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateLogInternalServerErrorResponseBody runs the validations defined on
+// log_internalServerError_response_body
+func ValidateLogInternalServerErrorResponseBody(body *LogInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateLogNotFoundResponseBody runs the validations defined on
+// log_notFound_response_body
+func ValidateLogNotFoundResponseBody(body *LogNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateLogUnauthorizedResponseBody runs the validations defined on
+// log_unauthorized_response_body
+func ValidateLogUnauthorizedResponseBody(body *LogUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFinishBadRequestResponseBody runs the validations defined on
+// finish_badRequest_response_body
+func ValidateFinishBadRequestResponseBody(body *FinishBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateFinishForbiddenResponseBody runs the validations defined on
+// finish_forbidden_response_body
+func ValidateFinishForbiddenResponseBody(body *FinishForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFinishInternalServerErrorResponseBody runs the validations defined
+// on finish_internalServerError_response_body
+func ValidateFinishInternalServerErrorResponseBody(body *FinishInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFinishNotFoundResponseBody runs the validations defined on
+// finish_notFound_response_body
+func ValidateFinishNotFoundResponseBody(body *FinishNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFinishUnauthorizedResponseBody runs the validations defined on
+// finish_unauthorized_response_body
+func ValidateFinishUnauthorizedResponseBody(body *FinishUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateAbandonBadRequestResponseBody runs the validations defined on
+// abandon_badRequest_response_body
+func ValidateAbandonBadRequestResponseBody(body *AbandonBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAbandonForbiddenResponseBody runs the validations defined on
+// abandon_forbidden_response_body
+func ValidateAbandonForbiddenResponseBody(body *AbandonForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateAbandonInternalServerErrorResponseBody runs the validations defined
+// on abandon_internalServerError_response_body
+func ValidateAbandonInternalServerErrorResponseBody(body *AbandonInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateAbandonNotFoundResponseBody runs the validations defined on
+// abandon_notFound_response_body
+func ValidateAbandonNotFoundResponseBody(body *AbandonNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateAbandonUnauthorizedResponseBody runs the validations defined on
+// abandon_unauthorized_response_body
+func ValidateAbandonUnauthorizedResponseBody(body *AbandonUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSessionExerciseResponseBody runs the validations defined on
+// SessionExerciseResponseBody
+func ValidateSessionExerciseResponseBody(body *SessionExerciseResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Position == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position", "body"))
+	}
+	if body.Sets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sets", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.ExerciseID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.exerciseId", *body.ExerciseID, goa.FormatUUID))
+	}
+	if body.ExerciseTypeID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.exerciseTypeId", *body.ExerciseTypeID, goa.FormatUUID))
+	}
+	for _, e := range body.Sets {
+		if e != nil {
+			if err2 := ValidateSessionSetResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSessionSetResponseBody runs the validations defined on
+// SessionSetResponseBody
+func ValidateSessionSetResponseBody(body *SessionSetResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Position == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position", "body"))
+	}
+	if body.Completed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("completed", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.PlannedSetID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.plannedSetId", *body.PlannedSetID, goa.FormatUUID))
+	}
+	if body.LoggedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.loggedAt", *body.LoggedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateFieldErrorResponseBody runs the validations defined on
+// FieldErrorResponseBody
+func ValidateFieldErrorResponseBody(body *FieldErrorResponseBody) (err error) {
+	if body.Field == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("field", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateWorkoutSessionResponse runs the validations defined on
+// WorkoutSessionResponse
+func ValidateWorkoutSessionResponse(body *WorkoutSessionResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startedAt", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.WorkoutID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.workoutId", *body.WorkoutID, goa.FormatUUID))
+	}
+	if body.UserID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.userId", *body.UserID, goa.FormatUUID))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "in_progress" || *body.Status == "finished" || *body.Status == "abandoned") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"in_progress", "finished", "abandoned"}))
+		}
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startedAt", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finishedAt", *body.FinishedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.Exercises {
+		if e != nil {
+			if err2 := ValidateSessionExerciseResponse(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSessionExerciseResponse runs the validations defined on
+// SessionExerciseResponse
+func ValidateSessionExerciseResponse(body *SessionExerciseResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Position == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position", "body"))
+	}
+	if body.Sets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sets", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.ExerciseID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.exerciseId", *body.ExerciseID, goa.FormatUUID))
+	}
+	if body.ExerciseTypeID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.exerciseTypeId", *body.ExerciseTypeID, goa.FormatUUID))
+	}
+	for _, e := range body.Sets {
+		if e != nil {
+			if err2 := ValidateSessionSetResponse(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSessionSetResponse runs the validations defined on SessionSetResponse
+func ValidateSessionSetResponse(body *SessionSetResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Position == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position", "body"))
+	}
+	if body.Completed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("completed", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.PlannedSetID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.plannedSetId", *body.PlannedSetID, goa.FormatUUID))
+	}
+	if body.LoggedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.loggedAt", *body.LoggedAt, goa.FormatDateTime))
+	}
+	return
+}
@@ -0,0 +1,42 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// HTTP request path constructors for the workout_session service.
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	"fmt"
+)
+
+// StartWorkoutSessionPath returns the URL path to the workout_session service start HTTP endpoint.
+func StartWorkoutSessionPath() string {
+	return "/api/v1/workout-sessions"
+}
+
+// GetWorkoutSessionPath returns the URL path to the workout_session service get HTTP endpoint.
+func GetWorkoutSessionPath(id string) string {
+	return fmt.Sprintf("/api/v1/workout-sessions/%v", id)
+}
+
+// ListWorkoutSessionPath returns the URL path to the workout_session service list HTTP endpoint.
+func ListWorkoutSessionPath() string {
+	return "/api/v1/workout-sessions"
+}
+
+// LogWorkoutSessionPath returns the URL path to the workout_session service log HTTP endpoint.
+func LogWorkoutSessionPath(id string) string {
+	return fmt.Sprintf("/api/v1/workout-sessions/%v/sets", id)
+}
+
+// FinishWorkoutSessionPath returns the URL path to the workout_session service finish HTTP endpoint.
+func FinishWorkoutSessionPath(id string) string {
+	return fmt.Sprintf("/api/v1/workout-sessions/%v/finish", id)
+}
+
+// AbandonWorkoutSessionPath returns the URL path to the workout_session service abandon HTTP endpoint.
+func AbandonWorkoutSessionPath(id string) string {
+	return fmt.Sprintf("/api/v1/workout-sessions/%v/abandon", id)
+}
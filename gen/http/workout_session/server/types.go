@@ -0,0 +1,1144 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// workout_session HTTP server types
+//
+// Command:
+// $ goa gen be/design
+
+package server
+
+import (
+	workoutsession "be/gen/workout_session"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// StartRequestBody is the type of the "workout_session" service "start"
+// endpoint HTTP request body.
+type StartRequestBody struct {
+	// Planned workout ID
+	WorkoutID *string `form:"workoutId,omitempty" json:"workoutId,omitempty" xml:"workoutId,omitempty"`
+	// Athlete notes
+	Notes *string `form:"notes,omitempty" json:"notes,omitempty" xml:"notes,omitempty"`
+}
+
+// LogRequestBody is the type of the "workout_session" service "log" endpoint
+// HTTP request body.
+type LogRequestBody struct {
+	// Session exercise the set belongs to
+	SessionExerciseID *string `form:"sessionExerciseId,omitempty" json:"sessionExerciseId,omitempty" xml:"sessionExerciseId,omitempty"`
+	// Session set to fill in; omit to add an extra set
+	SetID *string `form:"setId,omitempty" json:"setId,omitempty" xml:"setId,omitempty"`
+	// Performed weight in kg
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Performed repetitions
+	Reps *int `form:"reps,omitempty" json:"reps,omitempty" xml:"reps,omitempty"`
+	// Actual rest time in seconds
+	RestTime *int `form:"restTime,omitempty" json:"restTime,omitempty" xml:"restTime,omitempty"`
+}
+
+// FinishRequestBody is the type of the "workout_session" service "finish"
+// endpoint HTTP request body.
+type FinishRequestBody struct {
+	// Athlete notes
+	Notes *string `form:"notes,omitempty" json:"notes,omitempty" xml:"notes,omitempty"`
+}
+
+// AbandonRequestBody is the type of the "workout_session" service "abandon"
+// endpoint HTTP request body.
+type AbandonRequestBody struct {
+	// Athlete notes
+	Notes *string `form:"notes,omitempty" json:"notes,omitempty" xml:"notes,omitempty"`
+}
+
+// StartResponseBody is the type of the "workout_session" service "start"
+// endpoint HTTP response body.
+type StartResponseBody struct {
+	// Session ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Planned workout ID, absent once the workout is purged from the trash
+	WorkoutID *string `form:"workoutId,omitempty" json:"workoutId,omitempty" xml:"workoutId,omitempty"`
+	// Athlete performing the session
+	UserID string `form:"userId" json:"userId" xml:"userId"`
+	// Session status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Start time
+	StartedAt string `form:"startedAt" json:"startedAt" xml:"startedAt"`
+	// Finish or abandon time
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Athlete notes
+	Notes *string `form:"notes,omitempty" json:"notes,omitempty" xml:"notes,omitempty"`
+	// Exercises of the session
+	Exercises []*SessionExerciseResponseBody `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+}
+
+// GetResponseBody is the type of the "workout_session" service "get" endpoint
+// HTTP response body.
+type GetResponseBody struct {
+	// Session ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Planned workout ID, absent once the workout is purged from the trash
+	WorkoutID *string `form:"workoutId,omitempty" json:"workoutId,omitempty" xml:"workoutId,omitempty"`
+	// Athlete performing the session
+	UserID string `form:"userId" json:"userId" xml:"userId"`
+	// Session status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Start time
+	StartedAt string `form:"startedAt" json:"startedAt" xml:"startedAt"`
+	// Finish or abandon time
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Athlete notes
+	Notes *string `form:"notes,omitempty" json:"notes,omitempty" xml:"notes,omitempty"`
+	// Exercises of the session
+	Exercises []*SessionExerciseResponseBody `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+}
+
+// ListResponseBody is the type of the "workout_session" service "list"
+// endpoint HTTP response body.
+type ListResponseBody []*WorkoutSessionResponse
+
+// LogResponseBody is the type of the "workout_session" service "log" endpoint
+// HTTP response body.
+type LogResponseBody struct {
+	// Session set ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Planned exercise set this set was snapshotted from
+	PlannedSetID *string `form:"plannedSetId,omitempty" json:"plannedSetId,omitempty" xml:"plannedSetId,omitempty"`
+	// Order of the set within the exercise
+	Position int `form:"position" json:"position" xml:"position"`
+	// Planned weight in kg
+	PlannedWeight *float64 `form:"plannedWeight,omitempty" json:"plannedWeight,omitempty" xml:"plannedWeight,omitempty"`
+	// Planned repetitions
+	PlannedReps *int `form:"plannedReps,omitempty" json:"plannedReps,omitempty" xml:"plannedReps,omitempty"`
+	// Planned rest time in seconds
+	PlannedRestTime *int `form:"plannedRestTime,omitempty" json:"plannedRestTime,omitempty" xml:"plannedRestTime,omitempty"`
+	// Performed weight in kg
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Performed repetitions
+	Reps *int `form:"reps,omitempty" json:"reps,omitempty" xml:"reps,omitempty"`
+	// Actual rest time in seconds
+	RestTime *int `form:"restTime,omitempty" json:"restTime,omitempty" xml:"restTime,omitempty"`
+	// Whether the set was performed
+	Completed bool `form:"completed" json:"completed" xml:"completed"`
+	// When the set was logged
+	LoggedAt *string `form:"loggedAt,omitempty" json:"loggedAt,omitempty" xml:"loggedAt,omitempty"`
+	// Personal record kinds set by this set, returned when it is logged
+	Records []string `form:"records,omitempty" json:"records,omitempty" xml:"records,omitempty"`
+}
+
+// FinishResponseBody is the type of the "workout_session" service "finish"
+// endpoint HTTP response body.
+type FinishResponseBody struct {
+	// Session ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Planned workout ID, absent once the workout is purged from the trash
+	WorkoutID *string `form:"workoutId,omitempty" json:"workoutId,omitempty" xml:"workoutId,omitempty"`
+	// Athlete performing the session
+	UserID string `form:"userId" json:"userId" xml:"userId"`
+	// Session status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Start time
+	StartedAt string `form:"startedAt" json:"startedAt" xml:"startedAt"`
+	// Finish or abandon time
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Athlete notes
+	Notes *string `form:"notes,omitempty" json:"notes,omitempty" xml:"notes,omitempty"`
+	// Exercises of the session
+	Exercises []*SessionExerciseResponseBody `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+}
+
+// AbandonResponseBody is the type of the "workout_session" service "abandon"
+// endpoint HTTP response body.
+type AbandonResponseBody struct {
+	// Session ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Planned workout ID, absent once the workout is purged from the trash
+	WorkoutID *string `form:"workoutId,omitempty" json:"workoutId,omitempty" xml:"workoutId,omitempty"`
+	// Athlete performing the session
+	UserID string `form:"userId" json:"userId" xml:"userId"`
+	// Session status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Start time
+	StartedAt string `form:"startedAt" json:"startedAt" xml:"startedAt"`
+	// Finish or abandon time
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Athlete notes
+	Notes *string `form:"notes,omitempty" json:"notes,omitempty" xml:"notes,omitempty"`
+	// Exercises of the session
+	Exercises []*SessionExerciseResponseBody `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+}
+
+// StartBadRequestResponseBody is the type of the "workout_session" service
+// "start" endpoint HTTP response body for the "badRequest" error.
+type StartBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// StartForbiddenResponseBody is the type of the "workout_session" service
+// "start" endpoint HTTP response body for the "forbidden" error.
+type StartForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// StartInternalServerErrorResponseBody is the type of the "workout_session"
+// service "start" endpoint HTTP response body for the "internalServerError"
+// error.
+type StartInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// StartNotFoundResponseBody is the type of the "workout_session" service
+// "start" endpoint HTTP response body for the "notFound" error.
+type StartNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// StartUnauthorizedResponseBody is the type of the "workout_session" service
+// "start" endpoint HTTP response body for the "unauthorized" error.
+type StartUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetBadRequestResponseBody is the type of the "workout_session" service "get"
+// endpoint HTTP response body for the "badRequest" error.
+type GetBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// GetForbiddenResponseBody is the type of the "workout_session" service "get"
+// endpoint HTTP response body for the "forbidden" error.
+type GetForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetInternalServerErrorResponseBody is the type of the "workout_session"
+// service "get" endpoint HTTP response body for the "internalServerError"
+// error.
+type GetInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetNotFoundResponseBody is the type of the "workout_session" service "get"
+// endpoint HTTP response body for the "notFound" error.
+type GetNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetUnauthorizedResponseBody is the type of the "workout_session" service
+// "get" endpoint HTTP response body for the "unauthorized" error.
+type GetUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListBadRequestResponseBody is the type of the "workout_session" service
+// "list" endpoint HTTP response body for the "badRequest" error.
+type ListBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// ListForbiddenResponseBody is the type of the "workout_session" service
+// "list" endpoint HTTP response body for the "forbidden" error.
+type ListForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListInternalServerErrorResponseBody is the type of the "workout_session"
+// service "list" endpoint HTTP response body for the "internalServerError"
+// error.
+type ListInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListNotFoundResponseBody is the type of the "workout_session" service "list"
+// endpoint HTTP response body for the "notFound" error.
+type ListNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListUnauthorizedResponseBody is the type of the "workout_session" service
+// "list" endpoint HTTP response body for the "unauthorized" error.
+type ListUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// LogBadRequestResponseBody is the type of the "workout_session" service "log"
+// endpoint HTTP response body for the "badRequest" error.
+type LogBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// LogForbiddenResponseBody is the type of the "workout_session" service "log"
+// endpoint HTTP response body for the "forbidden" error.
+type LogForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// LogInternalServerErrorResponseBody is the type of the "workout_session"
+// service "log" endpoint HTTP response body for the "internalServerError"
+// error.
+type LogInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// LogNotFoundResponseBody is the type of the "workout_session" service "log"
+// endpoint HTTP response body for the "notFound" error.
+type LogNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// LogUnauthorizedResponseBody is the type of the "workout_session" service
+// "log" endpoint HTTP response body for the "unauthorized" error.
+type LogUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FinishBadRequestResponseBody is the type of the "workout_session" service
+// "finish" endpoint HTTP response body for the "badRequest" error.
+type FinishBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// FinishForbiddenResponseBody is the type of the "workout_session" service
+// "finish" endpoint HTTP response body for the "forbidden" error.
+type FinishForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FinishInternalServerErrorResponseBody is the type of the "workout_session"
+// service "finish" endpoint HTTP response body for the "internalServerError"
+// error.
+type FinishInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FinishNotFoundResponseBody is the type of the "workout_session" service
+// "finish" endpoint HTTP response body for the "notFound" error.
+type FinishNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FinishUnauthorizedResponseBody is the type of the "workout_session" service
+// "finish" endpoint HTTP response body for the "unauthorized" error.
+type FinishUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AbandonBadRequestResponseBody is the type of the "workout_session" service
+// "abandon" endpoint HTTP response body for the "badRequest" error.
+type AbandonBadRequestResponseBody struct {
+	// Nome dell'errore
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID dell'errore
+	ID string `form:"id" json:"id" xml:"id"`
+	// Descrizione dettagliata dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+	// Indica se l'errore è temporaneo
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldErrorResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// AbandonForbiddenResponseBody is the type of the "workout_session" service
+// "abandon" endpoint HTTP response body for the "forbidden" error.
+type AbandonForbiddenResponseBody struct {
+	// Detailed description of the error
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AbandonInternalServerErrorResponseBody is the type of the "workout_session"
+// service "abandon" endpoint HTTP response body for the "internalServerError"
+// error.
+type AbandonInternalServerErrorResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AbandonNotFoundResponseBody is the type of the "workout_session" service
+// "abandon" endpoint HTTP response body for the "notFound" error.
+type AbandonNotFoundResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AbandonUnauthorizedResponseBody is the type of the "workout_session" service
+// "abandon" endpoint HTTP response body for the "unauthorized" error.
+type AbandonUnauthorizedResponseBody struct {
+	// Descrizione dell'errore
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// SessionExerciseResponseBody is used to define fields on response body types.
+type SessionExerciseResponseBody struct {
+	// Session exercise ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Planned exercise ID
+	ExerciseID *string `form:"exerciseId,omitempty" json:"exerciseId,omitempty" xml:"exerciseId,omitempty"`
+	// Exercise type ID
+	ExerciseTypeID *string `form:"exerciseTypeId,omitempty" json:"exerciseTypeId,omitempty" xml:"exerciseTypeId,omitempty"`
+	// Exercise name at the time the session started
+	Name string `form:"name" json:"name" xml:"name"`
+	// Order of the exercise within the session
+	Position int `form:"position" json:"position" xml:"position"`
+	// Planned and performed sets
+	Sets []*SessionSetResponseBody `form:"sets" json:"sets" xml:"sets"`
+}
+
+// SessionSetResponseBody is used to define fields on response body types.
+type SessionSetResponseBody struct {
+	// Session set ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Planned exercise set this set was snapshotted from
+	PlannedSetID *string `form:"plannedSetId,omitempty" json:"plannedSetId,omitempty" xml:"plannedSetId,omitempty"`
+	// Order of the set within the exercise
+	Position int `form:"position" json:"position" xml:"position"`
+	// Planned weight in kg
+	PlannedWeight *float64 `form:"plannedWeight,omitempty" json:"plannedWeight,omitempty" xml:"plannedWeight,omitempty"`
+	// Planned repetitions
+	PlannedReps *int `form:"plannedReps,omitempty" json:"plannedReps,omitempty" xml:"plannedReps,omitempty"`
+	// Planned rest time in seconds
+	PlannedRestTime *int `form:"plannedRestTime,omitempty" json:"plannedRestTime,omitempty" xml:"plannedRestTime,omitempty"`
+	// Performed weight in kg
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Performed repetitions
+	Reps *int `form:"reps,omitempty" json:"reps,omitempty" xml:"reps,omitempty"`
+	// Actual rest time in seconds
+	RestTime *int `form:"restTime,omitempty" json:"restTime,omitempty" xml:"restTime,omitempty"`
+	// Whether the set was performed
+	Completed bool `form:"completed" json:"completed" xml:"completed"`
+	// When the set was logged
+	LoggedAt *string `form:"loggedAt,omitempty" json:"loggedAt,omitempty" xml:"loggedAt,omitempty"`
+	// Personal record kinds set by this set, returned when it is logged
+	Records []string `form:"records,omitempty" json:"records,omitempty" xml:"records,omitempty"`
+}
+
+// FieldErrorResponseBody is used to define fields on response body types.
+type FieldErrorResponseBody struct {
+	// Nome del campo
+	Field string `form:"field" json:"field" xml:"field"`
+	// Motivo per cui il campo non è valido
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// WorkoutSessionResponse is used to define fields on response body types.
+type WorkoutSessionResponse struct {
+	// Session ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Planned workout ID, absent once the workout is purged from the trash
+	WorkoutID *string `form:"workoutId,omitempty" json:"workoutId,omitempty" xml:"workoutId,omitempty"`
+	// Athlete performing the session
+	UserID string `form:"userId" json:"userId" xml:"userId"`
+	// Session status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Start time
+	StartedAt string `form:"startedAt" json:"startedAt" xml:"startedAt"`
+	// Finish or abandon time
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Athlete notes
+	Notes *string `form:"notes,omitempty" json:"notes,omitempty" xml:"notes,omitempty"`
+	// Exercises of the session
+	Exercises []*SessionExerciseResponse `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+}
+
+// SessionExerciseResponse is used to define fields on response body types.
+type SessionExerciseResponse struct {
+	// Session exercise ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Planned exercise ID
+	ExerciseID *string `form:"exerciseId,omitempty" json:"exerciseId,omitempty" xml:"exerciseId,omitempty"`
+	// Exercise type ID
+	ExerciseTypeID *string `form:"exerciseTypeId,omitempty" json:"exerciseTypeId,omitempty" xml:"exerciseTypeId,omitempty"`
+	// Exercise name at the time the session started
+	Name string `form:"name" json:"name" xml:"name"`
+	// Order of the exercise within the session
+	Position int `form:"position" json:"position" xml:"position"`
+	// Planned and performed sets
+	Sets []*SessionSetResponse `form:"sets" json:"sets" xml:"sets"`
+}
+
+// SessionSetResponse is used to define fields on response body types.
+type SessionSetResponse struct {
+	// Session set ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Planned exercise set this set was snapshotted from
+	PlannedSetID *string `form:"plannedSetId,omitempty" json:"plannedSetId,omitempty" xml:"plannedSetId,omitempty"`
+	// Order of the set within the exercise
+	Position int `form:"position" json:"position" xml:"position"`
+	// Planned weight in kg
+	PlannedWeight *float64 `form:"plannedWeight,omitempty" json:"plannedWeight,omitempty" xml:"plannedWeight,omitempty"`
+	// Planned repetitions
+	PlannedReps *int `form:"plannedReps,omitempty" json:"plannedReps,omitempty" xml:"plannedReps,omitempty"`
+	// Planned rest time in seconds
+	PlannedRestTime *int `form:"plannedRestTime,omitempty" json:"plannedRestTime,omitempty" xml:"plannedRestTime,omitempty"`
+	// Performed weight in kg
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Performed repetitions
+	Reps *int `form:"reps,omitempty" json:"reps,omitempty" xml:"reps,omitempty"`
+	// Actual rest time in seconds
+	RestTime *int `form:"restTime,omitempty" json:"restTime,omitempty" xml:"restTime,omitempty"`
+	// Whether the set was performed
+	Completed bool `form:"completed" json:"completed" xml:"completed"`
+	// When the set was logged
+	LoggedAt *string `form:"loggedAt,omitempty" json:"loggedAt,omitempty" xml:"loggedAt,omitempty"`
+	// Personal record kinds set by this set, returned when it is logged
+	Records []string `form:"records,omitempty" json:"records,omitempty" xml:"records,omitempty"`
+}
+
+// NewStartResponseBody builds the HTTP response body from the result of the
+// "start" endpoint of the "workout_session" service.
+func NewStartResponseBody(res *workoutsession.WorkoutSession) *StartResponseBody {
+	body := &StartResponseBody{
+		ID:         res.ID,
+		WorkoutID:  res.WorkoutID,
+		UserID:     res.UserID,
+		Status:     res.Status,
+		StartedAt:  res.StartedAt,
+		FinishedAt: res.FinishedAt,
+		Notes:      res.Notes,
+	}
+	if res.Exercises != nil {
+		body.Exercises = make([]*SessionExerciseResponseBody, len(res.Exercises))
+		for i, val := range res.Exercises {
+			body.Exercises[i] = marshalWorkoutsessionSessionExerciseToSessionExerciseResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetResponseBody builds the HTTP response body from the result of the
+// "get" endpoint of the "workout_session" service.
+func NewGetResponseBody(res *workoutsession.WorkoutSession) *GetResponseBody {
+	body := &GetResponseBody{
+		ID:         res.ID,
+		WorkoutID:  res.WorkoutID,
+		UserID:     res.UserID,
+		Status:     res.Status,
+		StartedAt:  res.StartedAt,
+		FinishedAt: res.FinishedAt,
+		Notes:      res.Notes,
+	}
+	if res.Exercises != nil {
+		body.Exercises = make([]*SessionExerciseResponseBody, len(res.Exercises))
+		for i, val := range res.Exercises {
+			body.Exercises[i] = marshalWorkoutsessionSessionExerciseToSessionExerciseResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "list" endpoint of the "workout_session" service.
+func NewListResponseBody(res []*workoutsession.WorkoutSession) ListResponseBody {
+	body := make([]*WorkoutSessionResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalWorkoutsessionWorkoutSessionToWorkoutSessionResponse(val)
+	}
+	return body
+}
+
+// NewLogResponseBody builds the HTTP response body from the result of the
+// "log" endpoint of the "workout_session" service.
+func NewLogResponseBody(res *workoutsession.SessionSet) *LogResponseBody {
+	body := &LogResponseBody{
+		ID:              res.ID,
+		PlannedSetID:    res.PlannedSetID,
+		Position:        res.Position,
+		PlannedWeight:   res.PlannedWeight,
+		PlannedReps:     res.PlannedReps,
+		PlannedRestTime: res.PlannedRestTime,
+		Weight:          res.Weight,
+		Reps:            res.Reps,
+		RestTime:        res.RestTime,
+		Completed:       res.Completed,
+		LoggedAt:        res.LoggedAt,
+	}
+	if res.Records != nil {
+		body.Records = make([]string, len(res.Records))
+		for i, val := range res.Records {
+			body.Records[i] = val
+		}
+	}
+	return body
+}
+
+// NewFinishResponseBody builds the HTTP response body from the result of the
+// "finish" endpoint of the "workout_session" service.
+func NewFinishResponseBody(res *workoutsession.WorkoutSession) *FinishResponseBody {
+	body := &FinishResponseBody{
+		ID:         res.ID,
+		WorkoutID:  res.WorkoutID,
+		UserID:     res.UserID,
+		Status:     res.Status,
+		StartedAt:  res.StartedAt,
+		FinishedAt: res.FinishedAt,
+		Notes:      res.Notes,
+	}
+	if res.Exercises != nil {
+		body.Exercises = make([]*SessionExerciseResponseBody, len(res.Exercises))
+		for i, val := range res.Exercises {
+			body.Exercises[i] = marshalWorkoutsessionSessionExerciseToSessionExerciseResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewAbandonResponseBody builds the HTTP response body from the result of the
+// "abandon" endpoint of the "workout_session" service.
+func NewAbandonResponseBody(res *workoutsession.WorkoutSession) *AbandonResponseBody {
+	body := &AbandonResponseBody{
+		ID:         res.ID,
+		WorkoutID:  res.WorkoutID,
+		UserID:     res.UserID,
+		Status:     res.Status,
+		StartedAt:  res.StartedAt,
+		FinishedAt: res.FinishedAt,
+		Notes:      res.Notes,
+	}
+	if res.Exercises != nil {
+		body.Exercises = make([]*SessionExerciseResponseBody, len(res.Exercises))
+		for i, val := range res.Exercises {
+			body.Exercises[i] = marshalWorkoutsessionSessionExerciseToSessionExerciseResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewStartBadRequestResponseBody builds the HTTP response body from the result
+// of the "start" endpoint of the "workout_session" service.
+func NewStartBadRequestResponseBody(res *workoutsession.BadRequest) *StartBadRequestResponseBody {
+	body := &StartBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalWorkoutsessionFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewStartForbiddenResponseBody builds the HTTP response body from the result
+// of the "start" endpoint of the "workout_session" service.
+func NewStartForbiddenResponseBody(res *workoutsession.Forbidden) *StartForbiddenResponseBody {
+	body := &StartForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewStartInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "start" endpoint of the "workout_session" service.
+func NewStartInternalServerErrorResponseBody(res *workoutsession.InternalServerError) *StartInternalServerErrorResponseBody {
+	body := &StartInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewStartNotFoundResponseBody builds the HTTP response body from the result
+// of the "start" endpoint of the "workout_session" service.
+func NewStartNotFoundResponseBody(res *workoutsession.NotFound) *StartNotFoundResponseBody {
+	body := &StartNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewStartUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "start" endpoint of the "workout_session" service.
+func NewStartUnauthorizedResponseBody(res *workoutsession.Unauthorized) *StartUnauthorizedResponseBody {
+	body := &StartUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetBadRequestResponseBody builds the HTTP response body from the result
+// of the "get" endpoint of the "workout_session" service.
+func NewGetBadRequestResponseBody(res *workoutsession.BadRequest) *GetBadRequestResponseBody {
+	body := &GetBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalWorkoutsessionFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetForbiddenResponseBody builds the HTTP response body from the result of
+// the "get" endpoint of the "workout_session" service.
+func NewGetForbiddenResponseBody(res *workoutsession.Forbidden) *GetForbiddenResponseBody {
+	body := &GetForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetInternalServerErrorResponseBody builds the HTTP response body from the
+// result of the "get" endpoint of the "workout_session" service.
+func NewGetInternalServerErrorResponseBody(res *workoutsession.InternalServerError) *GetInternalServerErrorResponseBody {
+	body := &GetInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetNotFoundResponseBody builds the HTTP response body from the result of
+// the "get" endpoint of the "workout_session" service.
+func NewGetNotFoundResponseBody(res *workoutsession.NotFound) *GetNotFoundResponseBody {
+	body := &GetNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetUnauthorizedResponseBody builds the HTTP response body from the result
+// of the "get" endpoint of the "workout_session" service.
+func NewGetUnauthorizedResponseBody(res *workoutsession.Unauthorized) *GetUnauthorizedResponseBody {
+	body := &GetUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListBadRequestResponseBody builds the HTTP response body from the result
+// of the "list" endpoint of the "workout_session" service.
+func NewListBadRequestResponseBody(res *workoutsession.BadRequest) *ListBadRequestResponseBody {
+	body := &ListBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalWorkoutsessionFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewListForbiddenResponseBody builds the HTTP response body from the result
+// of the "list" endpoint of the "workout_session" service.
+func NewListForbiddenResponseBody(res *workoutsession.Forbidden) *ListForbiddenResponseBody {
+	body := &ListForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "list" endpoint of the "workout_session" service.
+func NewListInternalServerErrorResponseBody(res *workoutsession.InternalServerError) *ListInternalServerErrorResponseBody {
+	body := &ListInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListNotFoundResponseBody builds the HTTP response body from the result of
+// the "list" endpoint of the "workout_session" service.
+func NewListNotFoundResponseBody(res *workoutsession.NotFound) *ListNotFoundResponseBody {
+	body := &ListNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "list" endpoint of the "workout_session" service.
+func NewListUnauthorizedResponseBody(res *workoutsession.Unauthorized) *ListUnauthorizedResponseBody {
+	body := &ListUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewLogBadRequestResponseBody builds the HTTP response body from the result
+// of the "log" endpoint of the "workout_session" service.
+func NewLogBadRequestResponseBody(res *workoutsession.BadRequest) *LogBadRequestResponseBody {
+	body := &LogBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalWorkoutsessionFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewLogForbiddenResponseBody builds the HTTP response body from the result of
+// the "log" endpoint of the "workout_session" service.
+func NewLogForbiddenResponseBody(res *workoutsession.Forbidden) *LogForbiddenResponseBody {
+	body := &LogForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewLogInternalServerErrorResponseBody builds the HTTP response body from the
+// result of the "log" endpoint of the "workout_session" service.
+func NewLogInternalServerErrorResponseBody(res *workoutsession.InternalServerError) *LogInternalServerErrorResponseBody {
+	body := &LogInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewLogNotFoundResponseBody builds the HTTP response body from the result of
+// the "log" endpoint of the "workout_session" service.
+func NewLogNotFoundResponseBody(res *workoutsession.NotFound) *LogNotFoundResponseBody {
+	body := &LogNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewLogUnauthorizedResponseBody builds the HTTP response body from the result
+// of the "log" endpoint of the "workout_session" service.
+func NewLogUnauthorizedResponseBody(res *workoutsession.Unauthorized) *LogUnauthorizedResponseBody {
+	body := &LogUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewFinishBadRequestResponseBody builds the HTTP response body from the
+// result of the "finish" endpoint of the "workout_session" service.
+func NewFinishBadRequestResponseBody(res *workoutsession.BadRequest) *FinishBadRequestResponseBody {
+	body := &FinishBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalWorkoutsessionFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewFinishForbiddenResponseBody builds the HTTP response body from the result
+// of the "finish" endpoint of the "workout_session" service.
+func NewFinishForbiddenResponseBody(res *workoutsession.Forbidden) *FinishForbiddenResponseBody {
+	body := &FinishForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewFinishInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "finish" endpoint of the "workout_session" service.
+func NewFinishInternalServerErrorResponseBody(res *workoutsession.InternalServerError) *FinishInternalServerErrorResponseBody {
+	body := &FinishInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewFinishNotFoundResponseBody builds the HTTP response body from the result
+// of the "finish" endpoint of the "workout_session" service.
+func NewFinishNotFoundResponseBody(res *workoutsession.NotFound) *FinishNotFoundResponseBody {
+	body := &FinishNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewFinishUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "finish" endpoint of the "workout_session" service.
+func NewFinishUnauthorizedResponseBody(res *workoutsession.Unauthorized) *FinishUnauthorizedResponseBody {
+	body := &FinishUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAbandonBadRequestResponseBody builds the HTTP response body from the
+// result of the "abandon" endpoint of the "workout_session" service.
+func NewAbandonBadRequestResponseBody(res *workoutsession.BadRequest) *AbandonBadRequestResponseBody {
+	body := &AbandonBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldErrorResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalWorkoutsessionFieldErrorToFieldErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewAbandonForbiddenResponseBody builds the HTTP response body from the
+// result of the "abandon" endpoint of the "workout_session" service.
+func NewAbandonForbiddenResponseBody(res *workoutsession.Forbidden) *AbandonForbiddenResponseBody {
+	body := &AbandonForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAbandonInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "abandon" endpoint of the "workout_session" service.
+func NewAbandonInternalServerErrorResponseBody(res *workoutsession.InternalServerError) *AbandonInternalServerErrorResponseBody {
+	body := &AbandonInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAbandonNotFoundResponseBody builds the HTTP response body from the result
+// of the "abandon" endpoint of the "workout_session" service.
+func NewAbandonNotFoundResponseBody(res *workoutsession.NotFound) *AbandonNotFoundResponseBody {
+	body := &AbandonNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAbandonUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "abandon" endpoint of the "workout_session" service.
+func NewAbandonUnauthorizedResponseBody(res *workoutsession.Unauthorized) *AbandonUnauthorizedResponseBody {
+	body := &AbandonUnauthorizedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewStartPayload builds a workout_session service start endpoint payload.
+func NewStartPayload(body *StartRequestBody, token *string) *workoutsession.StartPayload {
+	v := &workoutsession.StartPayload{
+		WorkoutID: *body.WorkoutID,
+		Notes:     body.Notes,
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewGetPayload builds a workout_session service get endpoint payload.
+func NewGetPayload(id string, token *string) *workoutsession.GetPayload {
+	v := &workoutsession.GetPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewListPayload builds a workout_session service list endpoint payload.
+func NewListPayload(userID *string, workoutID *string, status *string, limit int, offset int, token *string) *workoutsession.ListPayload {
+	v := &workoutsession.ListPayload{}
+	v.UserID = userID
+	v.WorkoutID = workoutID
+	v.Status = status
+	v.Limit = limit
+	v.Offset = offset
+	v.Token = token
+
+	return v
+}
+
+// NewLogPayload builds a workout_session service log endpoint payload.
+func NewLogPayload(body *LogRequestBody, id string, token *string) *workoutsession.LogPayload {
+	v := &workoutsession.LogPayload{
+		SessionExerciseID: *body.SessionExerciseID,
+		SetID:             body.SetID,
+		Weight:            *body.Weight,
+		Reps:              *body.Reps,
+		RestTime:          body.RestTime,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewFinishPayload builds a workout_session service finish endpoint payload.
+func NewFinishPayload(body *FinishRequestBody, id string, token *string) *workoutsession.FinishPayload {
+	v := &workoutsession.FinishPayload{
+		Notes: body.Notes,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewAbandonPayload builds a workout_session service abandon endpoint payload.
+func NewAbandonPayload(body *AbandonRequestBody, id string, token *string) *workoutsession.AbandonPayload {
+	v := &workoutsession.AbandonPayload{
+		Notes: body.Notes,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// ValidateStartRequestBody runs the validations defined on StartRequestBody
+func ValidateStartRequestBody(body *StartRequestBody) (err error) {
+	if body.WorkoutID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("workoutId", "body"))
+	}
+	if body.WorkoutID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.workoutId", *body.WorkoutID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateLogRequestBody runs the validations defined on LogRequestBody
+func ValidateLogRequestBody(body *LogRequestBody) (err error) {
+	if body.SessionExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sessionExerciseId", "body"))
+	}
+	if body.Weight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("weight", "body"))
+	}
+	if body.Reps == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reps", "body"))
+	}
+	if body.SessionExerciseID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.sessionExerciseId", *body.SessionExerciseID, goa.FormatUUID))
+	}
+	if body.SetID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.setId", *body.SetID, goa.FormatUUID))
+	}
+	if body.Weight != nil {
+		if *body.Weight < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 0, true))
+		}
+	}
+	if body.Reps != nil {
+		if *body.Reps < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.reps", *body.Reps, 0, true))
+		}
+	}
+	if body.RestTime != nil {
+		if *body.RestTime < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.restTime", *body.RestTime, 0, true))
+		}
+	}
+	return
+}
@@ -0,0 +1,44 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// importer client
+//
+// Command:
+// $ goa gen be/design
+
+package importer
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "importer" service client.
+type Client struct {
+	HistoryEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "importer" service client given the endpoints.
+func NewClient(history goa.Endpoint) *Client {
+	return &Client{
+		HistoryEndpoint: history,
+	}
+}
+
+// History calls the "history" endpoint of the "importer" service.
+// History may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) History(ctx context.Context, p *HistoryPayload, req io.ReadCloser) (res *HistoryImport, err error) {
+	var ires any
+	ires, err = c.HistoryEndpoint(ctx, &HistoryRequestData{Payload: p, Body: req})
+	if err != nil {
+		return
+	}
+	return ires.(*HistoryImport), nil
+}
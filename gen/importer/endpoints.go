@@ -0,0 +1,74 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// importer endpoints
+//
+// Command:
+// $ goa gen be/design
+
+package importer
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// Endpoints wraps the "importer" service endpoints.
+type Endpoints struct {
+	History goa.Endpoint
+}
+
+// HistoryRequestData holds both the payload and the HTTP request body reader
+// of the "history" method.
+type HistoryRequestData struct {
+	// Payload is the method payload.
+	Payload *HistoryPayload
+	// Body streams the HTTP request body.
+	Body io.ReadCloser
+}
+
+// NewEndpoints wraps the methods of the "importer" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	// Casting service to Auther interface
+	a := s.(Auther)
+	return &Endpoints{
+		History: NewHistoryEndpoint(s, a.OAuth2Auth),
+	}
+}
+
+// Use applies the given middleware to all the "importer" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.History = m(e.History)
+}
+
+// NewHistoryEndpoint returns an endpoint function that calls the method
+// "history" of service "importer".
+func NewHistoryEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*HistoryRequestData)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if ep.Payload.Token != nil {
+			token = *ep.Payload.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.History(ctx, ep.Payload, ep.Body)
+	}
+}
@@ -0,0 +1,288 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// importer service
+//
+// Command:
+// $ goa gen be/design
+
+package importer
+
+import (
+	"context"
+	"io"
+
+	"goa.design/goa/v3/security"
+)
+
+// Import of workout history exported by other apps
+type Service interface {
+	// Import a CSV export of Strong or Hevy as finished workout sessions. The
+	// request body is the raw CSV file, of at most 10 MiB. With dryRun nothing is
+	// saved and the response previews the sessions and exercise matches.
+	History(context.Context, *HistoryPayload, io.ReadCloser) (res *HistoryImport, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// OAuth2Auth implements the authorization logic for the OAuth2 security scheme.
+	OAuth2Auth(ctx context.Context, token string, schema *security.OAuth2Scheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "be_service"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "importer"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [1]string{"history"}
+
+// Body di risposta per la richiesta non valida (400)
+type BadRequest struct {
+	// Nome dell'errore
+	Name string
+	// ID dell'errore
+	ID string
+	// Descrizione dettagliata dell'errore
+	Message string
+	// Indica se l'errore è temporaneo
+	Temporary bool
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldError
+}
+
+// Exercise type an imported exercise name was mapped to, or is suggested for
+type ExerciseMatch struct {
+	// Exercise name in the export
+	Name string
+	// Matched exercise type, missing when nothing matched
+	ExerciseTypeID *string
+	// Name of the matched exercise type
+	ExerciseTypeName *string
+	// Similarity between the names, from 0 to 1
+	Score float64
+	// Whether the names match once normalized
+	Exact bool
+	// Whether the imported sets are recorded against the exercise type. Only names
+	// holding the same words as the type, in any order, are applied; other matches
+	// are suggestions and the sets are imported without a type.
+	Applied bool
+}
+
+// Campo della richiesta non valido
+type FieldError struct {
+	// Nome del campo
+	Field string
+	// Motivo per cui il campo non è valido
+	Message string
+}
+
+// Cannot access the resource
+type Forbidden struct {
+	// Detailed description of the error
+	Message string
+}
+
+// HistoryImport is the result type of the importer service history method.
+type HistoryImport struct {
+	// Detected export format
+	Format string
+	// Whether nothing was saved
+	DryRun bool
+	// Plan holding the imported sessions, missing in a dry run
+	TrainingPlanID *string
+	// Sessions created, or that would be created
+	Created int
+	// Sessions skipped because they were already imported
+	Duplicates int
+	// Sets created, or that would be created
+	Sets int
+	// Personal records set by the imported sets
+	Records int
+	// Sessions read from the file
+	Sessions []*ImportedSession
+	// How each exercise name was mapped to the catalog
+	Matches []*ExerciseMatch
+	// Lines that could not be read, and why
+	SkippedRows []string
+}
+
+// HistoryPayload is the payload type of the importer service history method.
+type HistoryPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Export format, detected from the header when auto
+	Format string
+	// Preview the import without saving
+	DryRun bool
+	// Athlete receiving the history, defaults to the caller
+	UserID *string
+	// Unit of weights the export doesn't label
+	Unit string
+	// IANA zone of the export's times, defaults to the athlete's
+	Timezone *string
+}
+
+// Exercise of an imported session
+type ImportedExercise struct {
+	// Exercise name in the export
+	Name string
+	// Exercise type the sets are recorded against, missing when no match was
+	// applied
+	ExerciseTypeID *string
+	// Sets in order
+	Sets []*ImportedSet
+}
+
+// Workout session read from an export
+type ImportedSession struct {
+	// Start of the session
+	StartedAt string
+	// End of the session
+	FinishedAt string
+	// Workout name in the export
+	WorkoutName string
+	// Whether the session was already imported and is skipped
+	Duplicate bool
+	// Exercises in order
+	Exercises []*ImportedExercise
+}
+
+// Set of an imported session
+type ImportedSet struct {
+	// Weight lifted (kg)
+	Weight float64
+	// Repetitions
+	Reps int
+}
+
+// Errore nel server
+type InternalServerError struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// Dato non trovato all'interno del sistema
+type NotFound struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// User not authorized to access the resource
+type Unauthorized struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// Error returns an error description.
+func (e *BadRequest) Error() string {
+	return "Body di risposta per la richiesta non valida (400)"
+}
+
+// ErrorName returns "BadRequest".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *BadRequest) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "BadRequest".
+func (e *BadRequest) GoaErrorName() string {
+	return "badRequest"
+}
+
+// Error returns an error description.
+func (e *FieldError) Error() string {
+	return "Campo della richiesta non valido"
+}
+
+// ErrorName returns "FieldError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *FieldError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "FieldError".
+func (e *FieldError) GoaErrorName() string {
+	return "FieldError"
+}
+
+// Error returns an error description.
+func (e *Forbidden) Error() string {
+	return "Cannot access the resource"
+}
+
+// ErrorName returns "Forbidden".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Forbidden) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Forbidden".
+func (e *Forbidden) GoaErrorName() string {
+	return "forbidden"
+}
+
+// Error returns an error description.
+func (e *InternalServerError) Error() string {
+	return "Errore nel server"
+}
+
+// ErrorName returns "InternalServerError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *InternalServerError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "InternalServerError".
+func (e *InternalServerError) GoaErrorName() string {
+	return "internalServerError"
+}
+
+// Error returns an error description.
+func (e *NotFound) Error() string {
+	return "Dato non trovato all'interno del sistema "
+}
+
+// ErrorName returns "NotFound".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *NotFound) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "NotFound".
+func (e *NotFound) GoaErrorName() string {
+	return "notFound"
+}
+
+// Error returns an error description.
+func (e *Unauthorized) Error() string {
+	return "User not authorized to access the resource"
+}
+
+// ErrorName returns "Unauthorized".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Unauthorized) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Unauthorized".
+func (e *Unauthorized) GoaErrorName() string {
+	return "unauthorized"
+}
@@ -0,0 +1,96 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// progression client
+//
+// Command:
+// $ goa gen be/design
+
+package progression
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "progression" service client.
+type Client struct {
+	SetEndpoint     goa.Endpoint
+	GetEndpoint     goa.Endpoint
+	DeleteEndpoint  goa.Endpoint
+	HistoryEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "progression" service client given the endpoints.
+func NewClient(set, get, delete_, history goa.Endpoint) *Client {
+	return &Client{
+		SetEndpoint:     set,
+		GetEndpoint:     get,
+		DeleteEndpoint:  delete_,
+		HistoryEndpoint: history,
+	}
+}
+
+// Set calls the "set" endpoint of the "progression" service.
+// Set may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Set(ctx context.Context, p *SetPayload) (res *ProgressionRule, err error) {
+	var ires any
+	ires, err = c.SetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProgressionRule), nil
+}
+
+// Get calls the "get" endpoint of the "progression" service.
+// Get may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Get(ctx context.Context, p *GetPayload) (res *ProgressionRule, err error) {
+	var ires any
+	ires, err = c.GetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProgressionRule), nil
+}
+
+// Delete calls the "delete" endpoint of the "progression" service.
+// Delete may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Delete(ctx context.Context, p *DeletePayload) (err error) {
+	_, err = c.DeleteEndpoint(ctx, p)
+	return
+}
+
+// History calls the "history" endpoint of the "progression" service.
+// History may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) History(ctx context.Context, p *HistoryPayload) (res []*ProgressionChange, err error) {
+	var ires any
+	ires, err = c.HistoryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*ProgressionChange), nil
+}
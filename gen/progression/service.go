@@ -0,0 +1,298 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// progression service
+//
+// Command:
+// $ goa gen be/design
+
+package progression
+
+import (
+	"context"
+
+	"goa.design/goa/v3/security"
+)
+
+// Automatic progression of planned exercises
+type Service interface {
+	// Attach a progression rule to a planned exercise, replacing the current one
+	Set(context.Context, *SetPayload) (res *ProgressionRule, err error)
+	// Get the progression rule of a planned exercise
+	Get(context.Context, *GetPayload) (res *ProgressionRule, err error)
+	// Detach the progression rule of a planned exercise
+	Delete(context.Context, *DeletePayload) (err error)
+	// Changes made to the exercise's targets, newest first
+	History(context.Context, *HistoryPayload) (res []*ProgressionChange, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// OAuth2Auth implements the authorization logic for the OAuth2 security scheme.
+	OAuth2Auth(ctx context.Context, token string, schema *security.OAuth2Scheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "be_service"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "progression"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [4]string{"set", "get", "delete", "history"}
+
+// Body di risposta per la richiesta non valida (400)
+type BadRequest struct {
+	// Nome dell'errore
+	Name string
+	// ID dell'errore
+	ID string
+	// Descrizione dettagliata dell'errore
+	Message string
+	// Indica se l'errore è temporaneo
+	Temporary bool
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldError
+}
+
+// DeletePayload is the payload type of the progression service delete method.
+type DeletePayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Planned exercise ID
+	ID string
+}
+
+// Campo della richiesta non valido
+type FieldError struct {
+	// Nome del campo
+	Field string
+	// Motivo per cui il campo non è valido
+	Message string
+}
+
+// Cannot access the resource
+type Forbidden struct {
+	// Detailed description of the error
+	Message string
+}
+
+// GetPayload is the payload type of the progression service get method.
+type GetPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Planned exercise ID
+	ID string
+}
+
+// HistoryPayload is the payload type of the progression service history method.
+type HistoryPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Planned exercise ID
+	ID string
+	// Max number of results
+	Limit int
+	// Results to skip
+	Offset int
+}
+
+// Errore nel server
+type InternalServerError struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// Dato non trovato all'interno del sistema
+type NotFound struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// Target adjustment made after a session, with the reason
+type ProgressionChange struct {
+	// Change ID
+	ID string
+	// Session that triggered the change
+	SessionID string
+	// Effect on the target
+	Outcome string
+	// Previous target weight (kg)
+	PreviousWeight float64
+	// New target weight (kg)
+	NewWeight float64
+	// Previous target repetitions
+	PreviousReps int
+	// New target repetitions
+	NewReps int
+	// Why the change was made
+	Reason string
+	// When the change was made
+	CreatedAt string
+}
+
+// ProgressionRule is the result type of the progression service set method.
+type ProgressionRule struct {
+	// Rule ID
+	ID string
+	// Planned exercise
+	ExerciseID string
+	// Progression scheme
+	Kind string
+	// Weight added when the target is met (kg)
+	Increment float64
+	// Bottom of the rep range, for double progression
+	MinReps *int
+	// Top of the rep range, for double progression
+	MaxReps *int
+	// Fraction of the estimated one-rep max, for percentage_of_one_rep_max
+	Percentage *float64
+	// Consecutive missed sessions before a deload, 0 to never deload
+	DeloadAfter int
+	// Fraction of the weight removed by a deload
+	DeloadPercentage float64
+	// Consecutive sessions the target was missed
+	Misses int
+	// Last change of the rule
+	UpdatedAt string
+}
+
+// SetPayload is the payload type of the progression service set method.
+type SetPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Planned exercise ID
+	ID string
+	// Progression scheme
+	Kind string
+	// Weight added when the target is met (kg)
+	Increment float64
+	// Bottom of the rep range, required for double progression
+	MinReps *int
+	// Top of the rep range, required for double progression
+	MaxReps *int
+	// Fraction of the estimated one-rep max, required for percentage_of_one_rep_max
+	Percentage *float64
+	// Consecutive missed sessions before a deload, 0 to never deload
+	DeloadAfter int
+	// Fraction of the weight removed by a deload
+	DeloadPercentage float64
+}
+
+// User not authorized to access the resource
+type Unauthorized struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// Error returns an error description.
+func (e *BadRequest) Error() string {
+	return "Body di risposta per la richiesta non valida (400)"
+}
+
+// ErrorName returns "BadRequest".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *BadRequest) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "BadRequest".
+func (e *BadRequest) GoaErrorName() string {
+	return "badRequest"
+}
+
+// Error returns an error description.
+func (e *FieldError) Error() string {
+	return "Campo della richiesta non valido"
+}
+
+// ErrorName returns "FieldError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *FieldError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "FieldError".
+func (e *FieldError) GoaErrorName() string {
+	return "FieldError"
+}
+
+// Error returns an error description.
+func (e *Forbidden) Error() string {
+	return "Cannot access the resource"
+}
+
+// ErrorName returns "Forbidden".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Forbidden) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Forbidden".
+func (e *Forbidden) GoaErrorName() string {
+	return "forbidden"
+}
+
+// Error returns an error description.
+func (e *InternalServerError) Error() string {
+	return "Errore nel server"
+}
+
+// ErrorName returns "InternalServerError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *InternalServerError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "InternalServerError".
+func (e *InternalServerError) GoaErrorName() string {
+	return "internalServerError"
+}
+
+// Error returns an error description.
+func (e *NotFound) Error() string {
+	return "Dato non trovato all'interno del sistema "
+}
+
+// ErrorName returns "NotFound".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *NotFound) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "NotFound".
+func (e *NotFound) GoaErrorName() string {
+	return "notFound"
+}
+
+// Error returns an error description.
+func (e *Unauthorized) Error() string {
+	return "User not authorized to access the resource"
+}
+
+// ErrorName returns "Unauthorized".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Unauthorized) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Unauthorized".
+func (e *Unauthorized) GoaErrorName() string {
+	return "unauthorized"
+}
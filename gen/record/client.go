@@ -0,0 +1,62 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// record client
+//
+// Command:
+// $ goa gen be/design
+
+package record
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "record" service client.
+type Client struct {
+	ListEndpoint    goa.Endpoint
+	HistoryEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "record" service client given the endpoints.
+func NewClient(list, history goa.Endpoint) *Client {
+	return &Client{
+		ListEndpoint:    list,
+		HistoryEndpoint: history,
+	}
+}
+
+// List calls the "list" endpoint of the "record" service.
+// List may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) List(ctx context.Context, p *ListPayload) (res []*PersonalRecord, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*PersonalRecord), nil
+}
+
+// History calls the "history" endpoint of the "record" service.
+// History may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) History(ctx context.Context, p *HistoryPayload) (res []*PersonalRecord, err error) {
+	var ires any
+	ires, err = c.HistoryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*PersonalRecord), nil
+}
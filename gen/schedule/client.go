@@ -0,0 +1,136 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// schedule client
+//
+// Command:
+// $ goa gen be/design
+
+package schedule
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "schedule" service client.
+type Client struct {
+	SetEndpoint        goa.Endpoint
+	CalendarEndpoint   goa.Endpoint
+	TodayEndpoint      goa.Endpoint
+	CreateFeedEndpoint goa.Endpoint
+	RevokeFeedEndpoint goa.Endpoint
+	FeedEndpoint       goa.Endpoint
+}
+
+// NewClient initializes a "schedule" service client given the endpoints.
+func NewClient(set, calendar, today, createFeed, revokeFeed, feed goa.Endpoint) *Client {
+	return &Client{
+		SetEndpoint:        set,
+		CalendarEndpoint:   calendar,
+		TodayEndpoint:      today,
+		CreateFeedEndpoint: createFeed,
+		RevokeFeedEndpoint: revokeFeed,
+		FeedEndpoint:       feed,
+	}
+}
+
+// Set calls the "set" endpoint of the "schedule" service.
+// Set may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Set(ctx context.Context, p *SetPayload) (res *WorkoutSchedule, err error) {
+	var ires any
+	ires, err = c.SetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WorkoutSchedule), nil
+}
+
+// Calendar calls the "calendar" endpoint of the "schedule" service.
+// Calendar may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Calendar(ctx context.Context, p *CalendarPayload) (res []*CalendarEntry, err error) {
+	var ires any
+	ires, err = c.CalendarEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*CalendarEntry), nil
+}
+
+// Today calls the "today" endpoint of the "schedule" service.
+// Today may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Today(ctx context.Context, p *TodayPayload) (res *TodayWorkouts, err error) {
+	var ires any
+	ires, err = c.TodayEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TodayWorkouts), nil
+}
+
+// CreateFeed calls the "createFeed" endpoint of the "schedule" service.
+// CreateFeed may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) CreateFeed(ctx context.Context, p *CreateFeedPayload) (res *CalendarFeed, err error) {
+	var ires any
+	ires, err = c.CreateFeedEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CalendarFeed), nil
+}
+
+// RevokeFeed calls the "revokeFeed" endpoint of the "schedule" service.
+// RevokeFeed may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) RevokeFeed(ctx context.Context, p *RevokeFeedPayload) (err error) {
+	_, err = c.RevokeFeedEndpoint(ctx, p)
+	return
+}
+
+// Feed calls the "feed" endpoint of the "schedule" service.
+// Feed may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Feed(ctx context.Context, p *FeedPayload) (res *FeedResult, resp io.ReadCloser, err error) {
+	var ires any
+	ires, err = c.FeedEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*FeedResponseData)
+	return o.Result, o.Body, nil
+}
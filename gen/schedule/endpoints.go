@@ -0,0 +1,222 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// schedule endpoints
+//
+// Command:
+// $ goa gen be/design
+
+package schedule
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// Endpoints wraps the "schedule" service endpoints.
+type Endpoints struct {
+	Set        goa.Endpoint
+	Calendar   goa.Endpoint
+	Today      goa.Endpoint
+	CreateFeed goa.Endpoint
+	RevokeFeed goa.Endpoint
+	Feed       goa.Endpoint
+}
+
+// FeedResponseData holds both the result and the HTTP response body reader of
+// the "feed" method.
+type FeedResponseData struct {
+	// Result is the method result.
+	Result *FeedResult
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
+}
+
+// NewEndpoints wraps the methods of the "schedule" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	// Casting service to Auther interface
+	a := s.(Auther)
+	return &Endpoints{
+		Set:        NewSetEndpoint(s, a.OAuth2Auth),
+		Calendar:   NewCalendarEndpoint(s, a.OAuth2Auth),
+		Today:      NewTodayEndpoint(s, a.OAuth2Auth),
+		CreateFeed: NewCreateFeedEndpoint(s, a.OAuth2Auth),
+		RevokeFeed: NewRevokeFeedEndpoint(s, a.OAuth2Auth),
+		Feed:       NewFeedEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "schedule" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.Set = m(e.Set)
+	e.Calendar = m(e.Calendar)
+	e.Today = m(e.Today)
+	e.CreateFeed = m(e.CreateFeed)
+	e.RevokeFeed = m(e.RevokeFeed)
+	e.Feed = m(e.Feed)
+}
+
+// NewSetEndpoint returns an endpoint function that calls the method "set" of
+// service "schedule".
+func NewSetEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SetPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Set(ctx, p)
+	}
+}
+
+// NewCalendarEndpoint returns an endpoint function that calls the method
+// "calendar" of service "schedule".
+func NewCalendarEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CalendarPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Calendar(ctx, p)
+	}
+}
+
+// NewTodayEndpoint returns an endpoint function that calls the method "today"
+// of service "schedule".
+func NewTodayEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TodayPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Today(ctx, p)
+	}
+}
+
+// NewCreateFeedEndpoint returns an endpoint function that calls the method
+// "createFeed" of service "schedule".
+func NewCreateFeedEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateFeedPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateFeed(ctx, p)
+	}
+}
+
+// NewRevokeFeedEndpoint returns an endpoint function that calls the method
+// "revokeFeed" of service "schedule".
+func NewRevokeFeedEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RevokeFeedPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.RevokeFeed(ctx, p)
+	}
+}
+
+// NewFeedEndpoint returns an endpoint function that calls the method "feed" of
+// service "schedule".
+func NewFeedEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*FeedPayload)
+		res, body, err := s.Feed(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &FeedResponseData{Result: res, Body: body}, nil
+	}
+}
@@ -0,0 +1,316 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// schedule service
+//
+// Command:
+// $ goa gen be/design
+
+package schedule
+
+import (
+	"context"
+	"io"
+
+	"goa.design/goa/v3/security"
+)
+
+// Scheduling of workouts and the athlete's calendar
+type Service interface {
+	// Replace the schedule of a workout
+	Set(context.Context, *SetPayload) (res *WorkoutSchedule, err error)
+	// Expand the caller's scheduled workouts between two days
+	Calendar(context.Context, *CalendarPayload) (res []*CalendarEntry, err error)
+	// Workouts planned for the current day in the caller's time zone
+	Today(context.Context, *TodayPayload) (res *TodayWorkouts, err error)
+	// Create the caller's ICS feed token, revoking the previous one
+	CreateFeed(context.Context, *CreateFeedPayload) (res *CalendarFeed, err error)
+	// Revoke the caller's ICS feed token
+	RevokeFeed(context.Context, *RevokeFeedPayload) (err error)
+	// ICS feed of the scheduled workouts, authenticated by the feed token in the
+	// URL
+
+	// If body implements [io.WriterTo], that implementation will be used instead.
+	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
+	// implementations.
+	Feed(context.Context, *FeedPayload) (res *FeedResult, body io.ReadCloser, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// OAuth2Auth implements the authorization logic for the OAuth2 security scheme.
+	OAuth2Auth(ctx context.Context, token string, schema *security.OAuth2Scheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "be_service"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "schedule"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [6]string{"set", "calendar", "today", "createFeed", "revokeFeed", "feed"}
+
+// Body di risposta per la richiesta non valida (400)
+type BadRequest struct {
+	// Nome dell'errore
+	Name string
+	// ID dell'errore
+	ID string
+	// Descrizione dettagliata dell'errore
+	Message string
+	// Indica se l'errore è temporaneo
+	Temporary bool
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldError
+}
+
+type CalendarEntry struct {
+	// Day of the occurrence
+	Date string
+	// Workout ID
+	WorkoutID string
+	// Workout name
+	WorkoutName string
+	// Order among the workouts of the same day
+	Position int
+	// Training plan ID
+	TrainingPlanID string
+	// Training plan name
+	TrainingPlanName string
+}
+
+// CalendarFeed is the result type of the schedule service createFeed method.
+type CalendarFeed struct {
+	// Secret feed token, only returned when it is created
+	Token string
+	// Feed path to subscribe to from a calendar app
+	Path string
+	// Creation time
+	CreatedAt string
+}
+
+// CalendarPayload is the payload type of the schedule service calendar method.
+type CalendarPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// First day, inclusive
+	From string
+	// Last day, inclusive
+	To string
+}
+
+// CreateFeedPayload is the payload type of the schedule service createFeed
+// method.
+type CreateFeedPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+}
+
+// FeedPayload is the payload type of the schedule service feed method.
+type FeedPayload struct {
+	// Feed token, optionally followed by .ics
+	FeedToken string
+}
+
+// FeedResult is the result type of the schedule service feed method.
+type FeedResult struct {
+	// Content type of the feed
+	ContentType string
+}
+
+// Campo della richiesta non valido
+type FieldError struct {
+	// Nome del campo
+	Field string
+	// Motivo per cui il campo non è valido
+	Message string
+}
+
+// Cannot access the resource
+type Forbidden struct {
+	// Detailed description of the error
+	Message string
+}
+
+// Errore nel server
+type InternalServerError struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// Dato non trovato all'interno del sistema
+type NotFound struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// RevokeFeedPayload is the payload type of the schedule service revokeFeed
+// method.
+type RevokeFeedPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+}
+
+// SetPayload is the payload type of the schedule service set method.
+type SetPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Workout ID
+	ID string
+	// Order among the workouts of the same day
+	Position int
+	// Specific dates the workout is planned on
+	Dates []string
+	// RRULE-style recurrence within the plan range
+	Recurrence *string
+}
+
+// TodayPayload is the payload type of the schedule service today method.
+type TodayPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// IANA time zone overriding the user's one
+	Timezone *string
+}
+
+// TodayWorkouts is the result type of the schedule service today method.
+type TodayWorkouts struct {
+	// Current day in the user's time zone
+	Date string
+	// Time zone used to determine the day
+	Timezone string
+	// Workouts planned for the day
+	Workouts []*CalendarEntry
+}
+
+// User not authorized to access the resource
+type Unauthorized struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// WorkoutSchedule is the result type of the schedule service set method.
+type WorkoutSchedule struct {
+	// Workout ID
+	WorkoutID string
+	// Order among the workouts of the same day
+	Position int
+	// Specific dates the workout is planned on
+	Dates []string
+	// RRULE-style recurrence within the plan range (FREQ=DAILY|WEEKLY, INTERVAL,
+	// BYDAY, COUNT, UNTIL)
+	Recurrence *string
+}
+
+// Error returns an error description.
+func (e *BadRequest) Error() string {
+	return "Body di risposta per la richiesta non valida (400)"
+}
+
+// ErrorName returns "BadRequest".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *BadRequest) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "BadRequest".
+func (e *BadRequest) GoaErrorName() string {
+	return "badRequest"
+}
+
+// Error returns an error description.
+func (e *FieldError) Error() string {
+	return "Campo della richiesta non valido"
+}
+
+// ErrorName returns "FieldError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *FieldError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "FieldError".
+func (e *FieldError) GoaErrorName() string {
+	return "FieldError"
+}
+
+// Error returns an error description.
+func (e *Forbidden) Error() string {
+	return "Cannot access the resource"
+}
+
+// ErrorName returns "Forbidden".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Forbidden) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Forbidden".
+func (e *Forbidden) GoaErrorName() string {
+	return "forbidden"
+}
+
+// Error returns an error description.
+func (e *InternalServerError) Error() string {
+	return "Errore nel server"
+}
+
+// ErrorName returns "InternalServerError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *InternalServerError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "InternalServerError".
+func (e *InternalServerError) GoaErrorName() string {
+	return "internalServerError"
+}
+
+// Error returns an error description.
+func (e *NotFound) Error() string {
+	return "Dato non trovato all'interno del sistema "
+}
+
+// ErrorName returns "NotFound".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *NotFound) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "NotFound".
+func (e *NotFound) GoaErrorName() string {
+	return "notFound"
+}
+
+// Error returns an error description.
+func (e *Unauthorized) Error() string {
+	return "User not authorized to access the resource"
+}
+
+// ErrorName returns "Unauthorized".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Unauthorized) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Unauthorized".
+func (e *Unauthorized) GoaErrorName() string {
+	return "unauthorized"
+}
@@ -9,27 +9,44 @@ package trainingplan
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 )
 
 // Client is the "training_plan" service client.
 type Client struct {
-	CreateEndpoint goa.Endpoint
-	GetEndpoint    goa.Endpoint
-	ListEndpoint   goa.Endpoint
-	UpdateEndpoint goa.Endpoint
-	DeleteEndpoint goa.Endpoint
+	CreateEndpoint         goa.Endpoint
+	GetEndpoint            goa.Endpoint
+	ListEndpoint           goa.Endpoint
+	UpdateEndpoint         goa.Endpoint
+	PatchEndpoint          goa.Endpoint
+	DeleteEndpoint         goa.Endpoint
+	RestoreEndpoint        goa.Endpoint
+	ListDeletedEndpoint    goa.Endpoint
+	CloneEndpoint          goa.Endpoint
+	CreateTemplateEndpoint goa.Endpoint
+	ListTemplatesEndpoint  goa.Endpoint
+	ExportEndpoint         goa.Endpoint
+	ImportEndpoint         goa.Endpoint
 }
 
 // NewClient initializes a "training_plan" service client given the endpoints.
-func NewClient(create, get, list, update, delete_ goa.Endpoint) *Client {
+func NewClient(create, get, list, update, patch, delete_, restore, listDeleted, clone, createTemplate, listTemplates, export, import_ goa.Endpoint) *Client {
 	return &Client{
-		CreateEndpoint: create,
-		GetEndpoint:    get,
-		ListEndpoint:   list,
-		UpdateEndpoint: update,
-		DeleteEndpoint: delete_,
+		CreateEndpoint:         create,
+		GetEndpoint:            get,
+		ListEndpoint:           list,
+		UpdateEndpoint:         update,
+		PatchEndpoint:          patch,
+		DeleteEndpoint:         delete_,
+		RestoreEndpoint:        restore,
+		ListDeletedEndpoint:    listDeleted,
+		CloneEndpoint:          clone,
+		CreateTemplateEndpoint: createTemplate,
+		ListTemplatesEndpoint:  listTemplates,
+		ExportEndpoint:         export,
+		ImportEndpoint:         import_,
 	}
 }
 
@@ -38,6 +55,9 @@ func NewClient(create, get, list, update, delete_ goa.Endpoint) *Client {
 //   - "notFound" (type *NotFound)
 //   - "internalServerError" (type *InternalServerError)
 //   - "badRequest" (type *BadRequest)
+//   - "unauthorized" (type *Unauthorized)
+//   - "forbidden" (type *Forbidden)
+//   - "preconditionFailed" (type *PreconditionFailed)
 //   - error: internal error
 func (c *Client) Create(ctx context.Context, p *CreatePayload) (res *TrainingPlan, err error) {
 	var ires any
@@ -53,6 +73,9 @@ func (c *Client) Create(ctx context.Context, p *CreatePayload) (res *TrainingPla
 //   - "notFound" (type *NotFound)
 //   - "internalServerError" (type *InternalServerError)
 //   - "badRequest" (type *BadRequest)
+//   - "unauthorized" (type *Unauthorized)
+//   - "forbidden" (type *Forbidden)
+//   - "preconditionFailed" (type *PreconditionFailed)
 //   - error: internal error
 func (c *Client) Get(ctx context.Context, p *GetPayload) (res *TrainingPlan, err error) {
 	var ires any
@@ -68,6 +91,9 @@ func (c *Client) Get(ctx context.Context, p *GetPayload) (res *TrainingPlan, err
 //   - "notFound" (type *NotFound)
 //   - "internalServerError" (type *InternalServerError)
 //   - "badRequest" (type *BadRequest)
+//   - "unauthorized" (type *Unauthorized)
+//   - "forbidden" (type *Forbidden)
+//   - "preconditionFailed" (type *PreconditionFailed)
 //   - error: internal error
 func (c *Client) List(ctx context.Context, p *ListPayload) (res []*TrainingPlan, err error) {
 	var ires any
@@ -83,6 +109,9 @@ func (c *Client) List(ctx context.Context, p *ListPayload) (res []*TrainingPlan,
 //   - "notFound" (type *NotFound)
 //   - "internalServerError" (type *InternalServerError)
 //   - "badRequest" (type *BadRequest)
+//   - "unauthorized" (type *Unauthorized)
+//   - "forbidden" (type *Forbidden)
+//   - "preconditionFailed" (type *PreconditionFailed)
 //   - error: internal error
 func (c *Client) Update(ctx context.Context, p *UpdatePayload) (res *TrainingPlan, err error) {
 	var ires any
@@ -93,13 +122,163 @@ func (c *Client) Update(ctx context.Context, p *UpdatePayload) (res *TrainingPla
 	return ires.(*TrainingPlan), nil
 }
 
+// Patch calls the "patch" endpoint of the "training_plan" service.
+// Patch may return the following errors:
+//   - "notFound" (type *NotFound)
+//   - "internalServerError" (type *InternalServerError)
+//   - "badRequest" (type *BadRequest)
+//   - "unauthorized" (type *Unauthorized)
+//   - "forbidden" (type *Forbidden)
+//   - "preconditionFailed" (type *PreconditionFailed)
+//   - error: internal error
+func (c *Client) Patch(ctx context.Context, p *PatchPayload, req io.ReadCloser) (res *TrainingPlan, err error) {
+	var ires any
+	ires, err = c.PatchEndpoint(ctx, &PatchRequestData{Payload: p, Body: req})
+	if err != nil {
+		return
+	}
+	return ires.(*TrainingPlan), nil
+}
+
 // Delete calls the "delete" endpoint of the "training_plan" service.
 // Delete may return the following errors:
 //   - "notFound" (type *NotFound)
 //   - "internalServerError" (type *InternalServerError)
 //   - "badRequest" (type *BadRequest)
+//   - "unauthorized" (type *Unauthorized)
+//   - "forbidden" (type *Forbidden)
+//   - "preconditionFailed" (type *PreconditionFailed)
 //   - error: internal error
 func (c *Client) Delete(ctx context.Context, p *DeletePayload) (err error) {
 	_, err = c.DeleteEndpoint(ctx, p)
 	return
 }
+
+// Restore calls the "restore" endpoint of the "training_plan" service.
+// Restore may return the following errors:
+//   - "notFound" (type *NotFound)
+//   - "internalServerError" (type *InternalServerError)
+//   - "badRequest" (type *BadRequest)
+//   - "unauthorized" (type *Unauthorized)
+//   - "forbidden" (type *Forbidden)
+//   - "preconditionFailed" (type *PreconditionFailed)
+//   - error: internal error
+func (c *Client) Restore(ctx context.Context, p *RestorePayload) (res *TrainingPlan, err error) {
+	var ires any
+	ires, err = c.RestoreEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TrainingPlan), nil
+}
+
+// ListDeleted calls the "listDeleted" endpoint of the "training_plan" service.
+// ListDeleted may return the following errors:
+//   - "notFound" (type *NotFound)
+//   - "internalServerError" (type *InternalServerError)
+//   - "badRequest" (type *BadRequest)
+//   - "unauthorized" (type *Unauthorized)
+//   - "forbidden" (type *Forbidden)
+//   - "preconditionFailed" (type *PreconditionFailed)
+//   - error: internal error
+func (c *Client) ListDeleted(ctx context.Context, p *ListDeletedPayload) (res []*TrainingPlan, err error) {
+	var ires any
+	ires, err = c.ListDeletedEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*TrainingPlan), nil
+}
+
+// Clone calls the "clone" endpoint of the "training_plan" service.
+// Clone may return the following errors:
+//   - "notFound" (type *NotFound)
+//   - "internalServerError" (type *InternalServerError)
+//   - "badRequest" (type *BadRequest)
+//   - "unauthorized" (type *Unauthorized)
+//   - "forbidden" (type *Forbidden)
+//   - "preconditionFailed" (type *PreconditionFailed)
+//   - error: internal error
+func (c *Client) Clone(ctx context.Context, p *ClonePayload) (res *TrainingPlan, err error) {
+	var ires any
+	ires, err = c.CloneEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TrainingPlan), nil
+}
+
+// CreateTemplate calls the "createTemplate" endpoint of the "training_plan"
+// service.
+// CreateTemplate may return the following errors:
+//   - "notFound" (type *NotFound)
+//   - "internalServerError" (type *InternalServerError)
+//   - "badRequest" (type *BadRequest)
+//   - "unauthorized" (type *Unauthorized)
+//   - "forbidden" (type *Forbidden)
+//   - "preconditionFailed" (type *PreconditionFailed)
+//   - error: internal error
+func (c *Client) CreateTemplate(ctx context.Context, p *CreateTemplatePayload) (res *PlanTemplate, err error) {
+	var ires any
+	ires, err = c.CreateTemplateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*PlanTemplate), nil
+}
+
+// ListTemplates calls the "listTemplates" endpoint of the "training_plan"
+// service.
+// ListTemplates may return the following errors:
+//   - "notFound" (type *NotFound)
+//   - "internalServerError" (type *InternalServerError)
+//   - "badRequest" (type *BadRequest)
+//   - "unauthorized" (type *Unauthorized)
+//   - "forbidden" (type *Forbidden)
+//   - "preconditionFailed" (type *PreconditionFailed)
+//   - error: internal error
+func (c *Client) ListTemplates(ctx context.Context, p *ListTemplatesPayload) (res []*PlanTemplate, err error) {
+	var ires any
+	ires, err = c.ListTemplatesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*PlanTemplate), nil
+}
+
+// Export calls the "export" endpoint of the "training_plan" service.
+// Export may return the following errors:
+//   - "notFound" (type *NotFound)
+//   - "internalServerError" (type *InternalServerError)
+//   - "badRequest" (type *BadRequest)
+//   - "unauthorized" (type *Unauthorized)
+//   - "forbidden" (type *Forbidden)
+//   - "preconditionFailed" (type *PreconditionFailed)
+//   - error: internal error
+func (c *Client) Export(ctx context.Context, p *ExportPayload) (res *ExportResult, resp io.ReadCloser, err error) {
+	var ires any
+	ires, err = c.ExportEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*ExportResponseData)
+	return o.Result, o.Body, nil
+}
+
+// Import calls the "import" endpoint of the "training_plan" service.
+// Import may return the following errors:
+//   - "notFound" (type *NotFound)
+//   - "internalServerError" (type *InternalServerError)
+//   - "badRequest" (type *BadRequest)
+//   - "unauthorized" (type *Unauthorized)
+//   - "forbidden" (type *Forbidden)
+//   - "preconditionFailed" (type *PreconditionFailed)
+//   - error: internal error
+func (c *Client) Import(ctx context.Context, p *ImportPayload) (res *PlanImportReport, err error) {
+	var ires any
+	ires, err = c.ImportEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*PlanImportReport), nil
+}
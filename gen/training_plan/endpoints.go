@@ -9,6 +9,7 @@ package trainingplan
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 	"goa.design/goa/v3/security"
@@ -16,11 +17,37 @@ import (
 
 // Endpoints wraps the "training_plan" service endpoints.
 type Endpoints struct {
-	Create goa.Endpoint
-	Get    goa.Endpoint
-	List   goa.Endpoint
-	Update goa.Endpoint
-	Delete goa.Endpoint
+	Create         goa.Endpoint
+	Get            goa.Endpoint
+	List           goa.Endpoint
+	Update         goa.Endpoint
+	Patch          goa.Endpoint
+	Delete         goa.Endpoint
+	Restore        goa.Endpoint
+	ListDeleted    goa.Endpoint
+	Clone          goa.Endpoint
+	CreateTemplate goa.Endpoint
+	ListTemplates  goa.Endpoint
+	Export         goa.Endpoint
+	Import         goa.Endpoint
+}
+
+// PatchRequestData holds both the payload and the HTTP request body reader of
+// the "patch" method.
+type PatchRequestData struct {
+	// Payload is the method payload.
+	Payload *PatchPayload
+	// Body streams the HTTP request body.
+	Body io.ReadCloser
+}
+
+// ExportResponseData holds both the result and the HTTP response body reader
+// of the "export" method.
+type ExportResponseData struct {
+	// Result is the method result.
+	Result *ExportResult
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
 }
 
 // NewEndpoints wraps the methods of the "training_plan" service with endpoints.
@@ -28,11 +55,19 @@ func NewEndpoints(s Service) *Endpoints {
 	// Casting service to Auther interface
 	a := s.(Auther)
 	return &Endpoints{
-		Create: NewCreateEndpoint(s, a.OAuth2Auth),
-		Get:    NewGetEndpoint(s, a.OAuth2Auth),
-		List:   NewListEndpoint(s, a.OAuth2Auth),
-		Update: NewUpdateEndpoint(s, a.OAuth2Auth),
-		Delete: NewDeleteEndpoint(s, a.OAuth2Auth),
+		Create:         NewCreateEndpoint(s, a.OAuth2Auth),
+		Get:            NewGetEndpoint(s, a.OAuth2Auth),
+		List:           NewListEndpoint(s, a.OAuth2Auth),
+		Update:         NewUpdateEndpoint(s, a.OAuth2Auth),
+		Patch:          NewPatchEndpoint(s, a.OAuth2Auth),
+		Delete:         NewDeleteEndpoint(s, a.OAuth2Auth),
+		Restore:        NewRestoreEndpoint(s, a.OAuth2Auth),
+		ListDeleted:    NewListDeletedEndpoint(s, a.OAuth2Auth),
+		Clone:          NewCloneEndpoint(s, a.OAuth2Auth),
+		CreateTemplate: NewCreateTemplateEndpoint(s, a.OAuth2Auth),
+		ListTemplates:  NewListTemplatesEndpoint(s, a.OAuth2Auth),
+		Export:         NewExportEndpoint(s, a.OAuth2Auth),
+		Import:         NewImportEndpoint(s, a.OAuth2Auth),
 	}
 }
 
@@ -43,7 +78,15 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Get = m(e.Get)
 	e.List = m(e.List)
 	e.Update = m(e.Update)
+	e.Patch = m(e.Patch)
 	e.Delete = m(e.Delete)
+	e.Restore = m(e.Restore)
+	e.ListDeleted = m(e.ListDeleted)
+	e.Clone = m(e.Clone)
+	e.CreateTemplate = m(e.CreateTemplate)
+	e.ListTemplates = m(e.ListTemplates)
+	e.Export = m(e.Export)
+	e.Import = m(e.Import)
 }
 
 // NewCreateEndpoint returns an endpoint function that calls the method
@@ -162,6 +205,36 @@ func NewUpdateEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endp
 	}
 }
 
+// NewPatchEndpoint returns an endpoint function that calls the method "patch"
+// of service "training_plan".
+func NewPatchEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*PatchRequestData)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if ep.Payload.Token != nil {
+			token = *ep.Payload.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Patch(ctx, ep.Payload, ep.Body)
+	}
+}
+
 // NewDeleteEndpoint returns an endpoint function that calls the method
 // "delete" of service "training_plan".
 func NewDeleteEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
@@ -191,3 +264,217 @@ func NewDeleteEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endp
 		return nil, s.Delete(ctx, p)
 	}
 }
+
+// NewRestoreEndpoint returns an endpoint function that calls the method
+// "restore" of service "training_plan".
+func NewRestoreEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RestorePayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Restore(ctx, p)
+	}
+}
+
+// NewListDeletedEndpoint returns an endpoint function that calls the method
+// "listDeleted" of service "training_plan".
+func NewListDeletedEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListDeletedPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListDeleted(ctx, p)
+	}
+}
+
+// NewCloneEndpoint returns an endpoint function that calls the method "clone"
+// of service "training_plan".
+func NewCloneEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ClonePayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Clone(ctx, p)
+	}
+}
+
+// NewCreateTemplateEndpoint returns an endpoint function that calls the method
+// "createTemplate" of service "training_plan".
+func NewCreateTemplateEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateTemplatePayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateTemplate(ctx, p)
+	}
+}
+
+// NewListTemplatesEndpoint returns an endpoint function that calls the method
+// "listTemplates" of service "training_plan".
+func NewListTemplatesEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListTemplatesPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListTemplates(ctx, p)
+	}
+}
+
+// NewExportEndpoint returns an endpoint function that calls the method
+// "export" of service "training_plan".
+func NewExportEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExportPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, body, err := s.Export(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &ExportResponseData{Result: res, Body: body}, nil
+	}
+}
+
+// NewImportEndpoint returns an endpoint function that calls the method
+// "import" of service "training_plan".
+func NewImportEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ImportPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Import(ctx, p)
+	}
+}
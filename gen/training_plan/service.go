@@ -9,6 +9,7 @@ package trainingplan
 
 import (
 	"context"
+	"io"
 
 	"goa.design/goa/v3/security"
 )
@@ -23,8 +24,33 @@ type Service interface {
 	List(context.Context, *ListPayload) (res []*TrainingPlan, err error)
 	// Update implements update.
 	Update(context.Context, *UpdatePayload) (res *TrainingPlan, err error)
+	// Change some fields of a plan with a JSON Merge Patch (RFC 7396) body:
+	// members left out are unchanged and null clears description
+	Patch(context.Context, *PatchPayload, io.ReadCloser) (res *TrainingPlan, err error)
 	// Delete implements delete.
 	Delete(context.Context, *DeletePayload) (err error)
+	// Restore a deleted plan together with the workouts, exercises and sets
+	// deleted with it
+	Restore(context.Context, *RestorePayload) (res *TrainingPlan, err error)
+	// List the plans in the trash, most recently deleted first
+	ListDeleted(context.Context, *ListDeletedPayload) (res []*TrainingPlan, err error)
+	// Deep-copy a plan or template into a plan of the target user, shifting its
+	// dates to the new start date
+	Clone(context.Context, *ClonePayload) (res *TrainingPlan, err error)
+	// Save a copy of a plan as a template owned by the caller
+	CreateTemplate(context.Context, *CreateTemplatePayload) (res *PlanTemplate, err error)
+	// List the public templates and those owned by the caller
+	ListTemplates(context.Context, *ListTemplatesPayload) (res []*PlanTemplate, err error)
+	// Export a plan as a versioned JSON document of the full plan tree, or its
+	// logged sets as CSV
+
+	// If body implements [io.WriterTo], that implementation will be used instead.
+	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
+	// implementations.
+	Export(context.Context, *ExportPayload) (res *ExportResult, body io.ReadCloser, err error)
+	// Import a plan document in one transaction, mapping exercise names to the
+	// exercise type catalog
+	Import(context.Context, *ImportPayload) (res *PlanImportReport, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -47,7 +73,7 @@ const ServiceName = "training_plan"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [5]string{"create", "get", "list", "update", "delete"}
+var MethodNames = [13]string{"create", "get", "list", "update", "patch", "delete", "restore", "listDeleted", "clone", "createTemplate", "listTemplates", "export", "import"}
 
 // Body di risposta per la richiesta non valida (400)
 type BadRequest struct {
@@ -63,6 +89,22 @@ type BadRequest struct {
 	Timeout bool
 	// Indica se l'errore è dovuto a un problema del server
 	Fault bool
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldError
+}
+
+// ClonePayload is the payload type of the training_plan service clone method.
+type ClonePayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Plan or template to clone
+	ID string
+	// Athlete receiving the plan, defaults to the caller
+	UserID *string
+	// Start date of the new plan
+	StartDate string
+	// Name of the new plan, defaults to the source's
+	Name *string
 }
 
 // CreatePayload is the payload type of the training_plan service create method.
@@ -78,11 +120,58 @@ type CreatePayload struct {
 	UserID      string
 }
 
+// CreateTemplatePayload is the payload type of the training_plan service
+// createTemplate method.
+type CreateTemplatePayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Plan to copy
+	ID string
+	// Name of the template, defaults to the plan's
+	Name *string
+	// Whether every user can clone the template
+	Public bool
+}
+
 // DeletePayload is the payload type of the training_plan service delete method.
 type DeletePayload struct {
 	// OAuth2 access token used to perform authorization
 	Token *string
 	ID    string
+	// ETag of the version being changed, as returned by get
+	IfMatch string
+}
+
+// ExportPayload is the payload type of the training_plan service export method.
+type ExportPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Training plan ID
+	ID string
+	// json for the plan document, csv for the logged sets
+	Format string
+}
+
+// ExportResult is the result type of the training_plan service export method.
+type ExportResult struct {
+	// Content type of the export
+	ContentType string
+	// Attachment file name
+	ContentDisposition string
+}
+
+// Campo della richiesta non valido
+type FieldError struct {
+	// Nome del campo
+	Field string
+	// Motivo per cui il campo non è valido
+	Message string
+}
+
+// Cannot access the resource
+type Forbidden struct {
+	// Detailed description of the error
+	Message string
 }
 
 // GetPayload is the payload type of the training_plan service get method.
@@ -93,12 +182,38 @@ type GetPayload struct {
 	ID string
 }
 
+// ImportPayload is the payload type of the training_plan service import method.
+type ImportPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Athlete receiving the plan, defaults to the caller
+	UserID *string
+	// Skip exercises whose type is not in the catalog instead of rejecting the
+	// import
+	AllowUnmatched bool
+	// Plan document
+	Document *PlanDocument
+}
+
 // Errore nel server
 type InternalServerError struct {
 	// Descrizione dell'errore
 	Message string
 }
 
+// ListDeletedPayload is the payload type of the training_plan service
+// listDeleted method.
+type ListDeletedPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Filter by user ID
+	UserID *string
+	// Max number of results
+	Limit int
+	// Results to skip
+	Offset int
+}
+
 // ListPayload is the payload type of the training_plan service list method.
 type ListPayload struct {
 	// OAuth2 access token used to perform authorization
@@ -113,12 +228,156 @@ type ListPayload struct {
 	Offset int
 }
 
+// ListTemplatesPayload is the payload type of the training_plan service
+// listTemplates method.
+type ListTemplatesPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Max number of results
+	Limit int
+	// Results to skip
+	Offset int
+}
+
 // Dato non trovato all'interno del sistema
 type NotFound struct {
 	// Descrizione dell'errore
 	Message string
 }
 
+// PatchPayload is the payload type of the training_plan service patch method.
+type PatchPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	ID    string
+	// ETag of the version being changed, as returned by get
+	IfMatch string
+}
+
+// Versioned document of a training plan with its workouts, exercises and
+// planned sets, as produced by export
+type PlanDocument struct {
+	// Document format version
+	Version int
+	// When the document was exported
+	ExportedAt *string
+	// The plan
+	Plan *PlanDocumentPlan
+}
+
+type PlanDocumentExercise struct {
+	// Exercise name
+	Name string
+	// Name of the exercise type in the catalog, defaults to the exercise name
+	ExerciseType *string
+	// Planned sets
+	Sets []*PlanDocumentSet
+	// Progression rule of the exercise
+	Progression *PlanDocumentProgression
+}
+
+type PlanDocumentPlan struct {
+	// Name of the plan
+	Name string
+	// Description of the plan
+	Description *string
+	// Start date in ISO 8601
+	StartDate string
+	// End date in ISO 8601
+	EndDate string
+	// Workouts of the plan
+	Workouts []*PlanDocumentWorkout
+}
+
+type PlanDocumentProgression struct {
+	// Progression scheme
+	Kind string
+	// Weight added when the target is met (kg)
+	Increment float64
+	// Bottom of the rep range
+	MinReps *int
+	// Top of the rep range
+	MaxReps *int
+	// Fraction of the estimated one-rep max
+	Percentage *float64
+	// Consecutive missed sessions before a deload
+	DeloadAfter int
+	// Fraction of the weight removed by a deload
+	DeloadPercentage float64
+}
+
+type PlanDocumentSet struct {
+	// Weight in kg
+	Weight float64
+	// Repetitions
+	Reps int
+	// Rest time in seconds
+	RestTime int
+}
+
+type PlanDocumentWorkout struct {
+	// Workout name
+	Name string
+	// Order among the workouts of the same day
+	Position *int
+	// Specific dates the workout is planned on
+	ScheduledDates []string
+	// RRULE-style recurrence within the plan range
+	Recurrence *string
+	// Exercises of the workout
+	Exercises []*PlanDocumentExercise
+}
+
+// PlanImportReport is the result type of the training_plan service import
+// method.
+type PlanImportReport struct {
+	// The imported plan
+	Plan *TrainingPlan
+	// Workouts created
+	Workouts int
+	// Exercises created
+	Exercises int
+	// Planned sets created
+	Sets int
+	// Exercise type names not found in the catalog; their exercises were skipped
+	Unmatched []string
+}
+
+// PlanTemplate is the result type of the training_plan service createTemplate
+// method.
+type PlanTemplate struct {
+	// Template ID
+	ID string
+	// Name of the template
+	Name string
+	// Description of the template
+	Description *string
+	// Anchor date of the template's schedule
+	StartDate string
+	// End of the template's schedule
+	EndDate string
+	// Coach owning the template
+	OwnerID *string
+	// Whether every user can clone the template
+	Public bool
+}
+
+// La risorsa è stata modificata dopo la lettura: l'If-Match non corrisponde
+// all'ETag corrente
+type PreconditionFailed struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// RestorePayload is the payload type of the training_plan service restore
+// method.
+type RestorePayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// TrainingPlan ID
+	ID string
+}
+
 // TrainingPlan is the result type of the training_plan service create method.
 type TrainingPlan struct {
 	// TrainingPlan ID
@@ -133,6 +392,19 @@ type TrainingPlan struct {
 	EndDate string
 	// ID of the user who owns the plan
 	UserID string
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string
+	// Entity tag of the current version, to send back in If-Match when changing it
+	Etag *string
+}
+
+// User not authorized to access the resource
+type Unauthorized struct {
+	// Descrizione dell'errore
+	Message string
 }
 
 // UpdatePayload is the payload type of the training_plan service update method.
@@ -140,6 +412,8 @@ type UpdatePayload struct {
 	// OAuth2 access token used to perform authorization
 	Token *string
 	ID    string
+	// ETag of the version being changed, as returned by get
+	IfMatch string
 	// Name of the plan
 	Name string
 	// Description
@@ -166,6 +440,40 @@ func (e *BadRequest) GoaErrorName() string {
 	return "badRequest"
 }
 
+// Error returns an error description.
+func (e *FieldError) Error() string {
+	return "Campo della richiesta non valido"
+}
+
+// ErrorName returns "FieldError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *FieldError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "FieldError".
+func (e *FieldError) GoaErrorName() string {
+	return "FieldError"
+}
+
+// Error returns an error description.
+func (e *Forbidden) Error() string {
+	return "Cannot access the resource"
+}
+
+// ErrorName returns "Forbidden".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Forbidden) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Forbidden".
+func (e *Forbidden) GoaErrorName() string {
+	return "forbidden"
+}
+
 // Error returns an error description.
 func (e *InternalServerError) Error() string {
 	return "Errore nel server"
@@ -199,3 +507,37 @@ func (e *NotFound) ErrorName() string {
 func (e *NotFound) GoaErrorName() string {
 	return "notFound"
 }
+
+// Error returns an error description.
+func (e *PreconditionFailed) Error() string {
+	return "La risorsa è stata modificata dopo la lettura: l'If-Match non corrisponde all'ETag corrente"
+}
+
+// ErrorName returns "PreconditionFailed".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *PreconditionFailed) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "PreconditionFailed".
+func (e *PreconditionFailed) GoaErrorName() string {
+	return "preconditionFailed"
+}
+
+// Error returns an error description.
+func (e *Unauthorized) Error() string {
+	return "User not authorized to access the resource"
+}
+
+// ErrorName returns "Unauthorized".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Unauthorized) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Unauthorized".
+func (e *Unauthorized) GoaErrorName() string {
+	return "unauthorized"
+}
@@ -9,27 +9,34 @@ package user
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 )
 
 // Client is the "user" service client.
 type Client struct {
-	CreateEndpoint goa.Endpoint
-	GetEndpoint    goa.Endpoint
-	ListEndpoint   goa.Endpoint
-	UpdateEndpoint goa.Endpoint
-	DeleteEndpoint goa.Endpoint
+	CreateEndpoint      goa.Endpoint
+	GetEndpoint         goa.Endpoint
+	ListEndpoint        goa.Endpoint
+	UpdateEndpoint      goa.Endpoint
+	PatchEndpoint       goa.Endpoint
+	DeleteEndpoint      goa.Endpoint
+	RestoreEndpoint     goa.Endpoint
+	ListDeletedEndpoint goa.Endpoint
 }
 
 // NewClient initializes a "user" service client given the endpoints.
-func NewClient(create, get, list, update, delete_ goa.Endpoint) *Client {
+func NewClient(create, get, list, update, patch, delete_, restore, listDeleted goa.Endpoint) *Client {
 	return &Client{
-		CreateEndpoint: create,
-		GetEndpoint:    get,
-		ListEndpoint:   list,
-		UpdateEndpoint: update,
-		DeleteEndpoint: delete_,
+		CreateEndpoint:      create,
+		GetEndpoint:         get,
+		ListEndpoint:        list,
+		UpdateEndpoint:      update,
+		PatchEndpoint:       patch,
+		DeleteEndpoint:      delete_,
+		RestoreEndpoint:     restore,
+		ListDeletedEndpoint: listDeleted,
 	}
 }
 
@@ -40,6 +47,7 @@ func NewClient(create, get, list, update, delete_ goa.Endpoint) *Client {
 //   - "notFound" (type *NotFound): Not Found
 //   - "badRequest" (type *BadRequest): Invalid Request
 //   - "forbidden" (type *Forbidden): Accesso negato
+//   - "preconditionFailed" (type *PreconditionFailed): Precondition Failed
 //   - error: internal error
 func (c *Client) Create(ctx context.Context, p *CreatePayload) (res *User, err error) {
 	var ires any
@@ -57,6 +65,7 @@ func (c *Client) Create(ctx context.Context, p *CreatePayload) (res *User, err e
 //   - "notFound" (type *NotFound): Not Found
 //   - "badRequest" (type *BadRequest): Invalid Request
 //   - "forbidden" (type *Forbidden): Accesso negato
+//   - "preconditionFailed" (type *PreconditionFailed): Precondition Failed
 //   - error: internal error
 func (c *Client) Get(ctx context.Context, p *GetPayload) (res *UserWithPlans, err error) {
 	var ires any
@@ -74,6 +83,7 @@ func (c *Client) Get(ctx context.Context, p *GetPayload) (res *UserWithPlans, er
 //   - "notFound" (type *NotFound): Not Found
 //   - "badRequest" (type *BadRequest): Invalid Request
 //   - "forbidden" (type *Forbidden): Accesso negato
+//   - "preconditionFailed" (type *PreconditionFailed): Precondition Failed
 //   - error: internal error
 func (c *Client) List(ctx context.Context, p *ListPayload) (res []*User, err error) {
 	var ires any
@@ -91,6 +101,7 @@ func (c *Client) List(ctx context.Context, p *ListPayload) (res []*User, err err
 //   - "notFound" (type *NotFound): Not Found
 //   - "badRequest" (type *BadRequest): Invalid Request
 //   - "forbidden" (type *Forbidden): Accesso negato
+//   - "preconditionFailed" (type *PreconditionFailed): Precondition Failed
 //   - error: internal error
 func (c *Client) Update(ctx context.Context, p *UpdatePayload) (res *User, err error) {
 	var ires any
@@ -101,6 +112,24 @@ func (c *Client) Update(ctx context.Context, p *UpdatePayload) (res *User, err e
 	return ires.(*User), nil
 }
 
+// Patch calls the "patch" endpoint of the "user" service.
+// Patch may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - "preconditionFailed" (type *PreconditionFailed): Precondition Failed
+//   - error: internal error
+func (c *Client) Patch(ctx context.Context, p *PatchPayload, req io.ReadCloser) (res *User, err error) {
+	var ires any
+	ires, err = c.PatchEndpoint(ctx, &PatchRequestData{Payload: p, Body: req})
+	if err != nil {
+		return
+	}
+	return ires.(*User), nil
+}
+
 // Delete calls the "delete" endpoint of the "user" service.
 // Delete may return the following errors:
 //   - "unauthorized" (type *Unauthorized): Auth Failed
@@ -108,8 +137,45 @@ func (c *Client) Update(ctx context.Context, p *UpdatePayload) (res *User, err e
 //   - "notFound" (type *NotFound): Not Found
 //   - "badRequest" (type *BadRequest): Invalid Request
 //   - "forbidden" (type *Forbidden): Accesso negato
+//   - "preconditionFailed" (type *PreconditionFailed): Precondition Failed
 //   - error: internal error
 func (c *Client) Delete(ctx context.Context, p *DeletePayload) (err error) {
 	_, err = c.DeleteEndpoint(ctx, p)
 	return
 }
+
+// Restore calls the "restore" endpoint of the "user" service.
+// Restore may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - "preconditionFailed" (type *PreconditionFailed): Precondition Failed
+//   - error: internal error
+func (c *Client) Restore(ctx context.Context, p *RestorePayload) (res *User, err error) {
+	var ires any
+	ires, err = c.RestoreEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*User), nil
+}
+
+// ListDeleted calls the "listDeleted" endpoint of the "user" service.
+// ListDeleted may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - "preconditionFailed" (type *PreconditionFailed): Precondition Failed
+//   - error: internal error
+func (c *Client) ListDeleted(ctx context.Context, p *ListDeletedPayload) (res []*User, err error) {
+	var ires any
+	ires, err = c.ListDeletedEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*User), nil
+}
@@ -9,6 +9,7 @@ package user
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 	"goa.design/goa/v3/security"
@@ -16,11 +17,23 @@ import (
 
 // Endpoints wraps the "user" service endpoints.
 type Endpoints struct {
-	Create goa.Endpoint
-	Get    goa.Endpoint
-	List   goa.Endpoint
-	Update goa.Endpoint
-	Delete goa.Endpoint
+	Create      goa.Endpoint
+	Get         goa.Endpoint
+	List        goa.Endpoint
+	Update      goa.Endpoint
+	Patch       goa.Endpoint
+	Delete      goa.Endpoint
+	Restore     goa.Endpoint
+	ListDeleted goa.Endpoint
+}
+
+// PatchRequestData holds both the payload and the HTTP request body reader of
+// the "patch" method.
+type PatchRequestData struct {
+	// Payload is the method payload.
+	Payload *PatchPayload
+	// Body streams the HTTP request body.
+	Body io.ReadCloser
 }
 
 // NewEndpoints wraps the methods of the "user" service with endpoints.
@@ -28,11 +41,14 @@ func NewEndpoints(s Service) *Endpoints {
 	// Casting service to Auther interface
 	a := s.(Auther)
 	return &Endpoints{
-		Create: NewCreateEndpoint(s, a.OAuth2Auth),
-		Get:    NewGetEndpoint(s, a.OAuth2Auth),
-		List:   NewListEndpoint(s, a.OAuth2Auth),
-		Update: NewUpdateEndpoint(s, a.OAuth2Auth),
-		Delete: NewDeleteEndpoint(s, a.OAuth2Auth),
+		Create:      NewCreateEndpoint(s, a.OAuth2Auth),
+		Get:         NewGetEndpoint(s, a.OAuth2Auth),
+		List:        NewListEndpoint(s, a.OAuth2Auth),
+		Update:      NewUpdateEndpoint(s, a.OAuth2Auth),
+		Patch:       NewPatchEndpoint(s, a.OAuth2Auth),
+		Delete:      NewDeleteEndpoint(s, a.OAuth2Auth),
+		Restore:     NewRestoreEndpoint(s, a.OAuth2Auth),
+		ListDeleted: NewListDeletedEndpoint(s, a.OAuth2Auth),
 	}
 }
 
@@ -42,7 +58,10 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Get = m(e.Get)
 	e.List = m(e.List)
 	e.Update = m(e.Update)
+	e.Patch = m(e.Patch)
 	e.Delete = m(e.Delete)
+	e.Restore = m(e.Restore)
+	e.ListDeleted = m(e.ListDeleted)
 }
 
 // NewCreateEndpoint returns an endpoint function that calls the method
@@ -165,6 +184,36 @@ func NewUpdateEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endp
 	}
 }
 
+// NewPatchEndpoint returns an endpoint function that calls the method "patch"
+// of service "user".
+func NewPatchEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*PatchRequestData)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if ep.Payload.Token != nil {
+			token = *ep.Payload.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Patch(ctx, ep.Payload, ep.Body)
+	}
+}
+
 // NewDeleteEndpoint returns an endpoint function that calls the method
 // "delete" of service "user".
 func NewDeleteEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
@@ -194,3 +243,63 @@ func NewDeleteEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endp
 		return nil, s.Delete(ctx, p)
 	}
 }
+
+// NewRestoreEndpoint returns an endpoint function that calls the method
+// "restore" of service "user".
+func NewRestoreEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RestorePayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Restore(ctx, p)
+	}
+}
+
+// NewListDeletedEndpoint returns an endpoint function that calls the method
+// "listDeleted" of service "user".
+func NewListDeletedEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListDeletedPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"openid"},
+			RequiredScopes: []string{"openid"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:       "password",
+					TokenURL:   "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+					RefreshURL: "http://localhost:8080/realms/LastingDynamics/protocol/openid-connect/token",
+				},
+			},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authOAuth2Fn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListDeleted(ctx, p)
+	}
+}
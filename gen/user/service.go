@@ -9,6 +9,7 @@ package user
 
 import (
 	"context"
+	"io"
 
 	"goa.design/goa/v3/security"
 )
@@ -19,12 +20,22 @@ type Service interface {
 	Create(context.Context, *CreatePayload) (res *User, err error)
 	// Get a user by ID
 	Get(context.Context, *GetPayload) (res *UserWithPlans, err error)
-	// List all users with pagination
+	// List users with pagination: every user for admins, the athletes they coach
+	// for the others
 	List(context.Context, *ListPayload) (res []*User, err error)
-	// Update a user
+	// Update a user. Users update themselves and admins anyone; only admins change
+	// admin
 	Update(context.Context, *UpdatePayload) (res *User, err error)
+	// Change some fields of a user with a JSON Merge Patch (RFC 7396) body:
+	// members left out are unchanged, null clears nickname and resets timezone to
+	// UTC. Users patch themselves and admins anyone; only admins change admin
+	Patch(context.Context, *PatchPayload, io.ReadCloser) (res *User, err error)
 	// Delete a user
 	Delete(context.Context, *DeletePayload) (err error)
+	// Restore a deleted user together with the plans deleted with them (admin only)
+	Restore(context.Context, *RestorePayload) (res *User, err error)
+	// List the deleted users, most recently deleted first (admin only)
+	ListDeleted(context.Context, *ListDeletedPayload) (res []*User, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -47,7 +58,7 @@ const ServiceName = "user"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [5]string{"create", "get", "list", "update", "delete"}
+var MethodNames = [8]string{"create", "get", "list", "update", "patch", "delete", "restore", "listDeleted"}
 
 // Body di risposta per la richiesta non valida (400)
 type BadRequest struct {
@@ -63,6 +74,8 @@ type BadRequest struct {
 	Timeout bool
 	// Indica se l'errore è dovuto a un problema del server
 	Fault bool
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldError
 }
 
 // CreatePayload is the payload type of the user service create method.
@@ -87,6 +100,16 @@ type DeletePayload struct {
 	Token *string
 	// User ID
 	ID string
+	// ETag of the version being changed, as returned by get
+	IfMatch string
+}
+
+// Campo della richiesta non valido
+type FieldError struct {
+	// Nome del campo
+	Field string
+	// Motivo per cui il campo non è valido
+	Message string
 }
 
 // Cannot access the resource
@@ -109,6 +132,17 @@ type InternalServerError struct {
 	Message string
 }
 
+// ListDeletedPayload is the payload type of the user service listDeleted
+// method.
+type ListDeletedPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Number of users to return per page
+	Limit int
+	// Number of users to skip
+	Offset int
+}
+
 // ListPayload is the payload type of the user service list method.
 type ListPayload struct {
 	// OAuth2 access token used to perform authorization
@@ -125,6 +159,31 @@ type NotFound struct {
 	Message string
 }
 
+// PatchPayload is the payload type of the user service patch method.
+type PatchPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// User ID
+	ID string
+	// ETag of the version being changed, as returned by get
+	IfMatch string
+}
+
+// La risorsa è stata modificata dopo la lettura: l'If-Match non corrisponde
+// all'ETag corrente
+type PreconditionFailed struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// RestorePayload is the payload type of the user service restore method.
+type RestorePayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// User ID
+	ID string
+}
+
 type TrainingPlan struct {
 	// TrainingPlan ID
 	ID string
@@ -138,6 +197,13 @@ type TrainingPlan struct {
 	EndDate string
 	// ID of the user who owns the plan
 	UserID string
+	// Non-blocking validation warnings, such as overlaps with the user's other
+	// plans
+	Warnings []string
+	// When the plan was moved to the trash, set only for deleted plans
+	DeletedAt *string
+	// Entity tag of the current version, to send back in If-Match when changing it
+	Etag *string
 }
 
 // User not authorized to access the resource
@@ -160,6 +226,10 @@ type UpdatePayload struct {
 	Nickname *string
 	// Is admin?
 	Admin bool
+	// IANA time zone
+	Timezone *string
+	// ETag of the version being changed, as returned by get
+	IfMatch string
 }
 
 // User is the result type of the user service create method.
@@ -176,6 +246,12 @@ type User struct {
 	Nickname *string
 	// Is the user an admin?
 	Admin bool
+	// IANA time zone of the user
+	Timezone *string
+	// When the user was moved to the trash, set only for deleted users
+	DeletedAt *string
+	// Entity tag of the current version, to send back in If-Match when changing it
+	Etag *string
 }
 
 // UserWithPlans is the result type of the user service get method.
@@ -194,6 +270,12 @@ type UserWithPlans struct {
 	Nickname *string
 	// Is the user an admin?
 	Admin bool
+	// IANA time zone of the user
+	Timezone *string
+	// When the user was moved to the trash, set only for deleted users
+	DeletedAt *string
+	// Entity tag of the current version, to send back in If-Match when changing it
+	Etag *string
 }
 
 // Error returns an error description.
@@ -213,6 +295,23 @@ func (e *BadRequest) GoaErrorName() string {
 	return "badRequest"
 }
 
+// Error returns an error description.
+func (e *FieldError) Error() string {
+	return "Campo della richiesta non valido"
+}
+
+// ErrorName returns "FieldError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *FieldError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "FieldError".
+func (e *FieldError) GoaErrorName() string {
+	return "FieldError"
+}
+
 // Error returns an error description.
 func (e *Forbidden) Error() string {
 	return "Cannot access the resource"
@@ -264,6 +363,23 @@ func (e *NotFound) GoaErrorName() string {
 	return "notFound"
 }
 
+// Error returns an error description.
+func (e *PreconditionFailed) Error() string {
+	return "La risorsa è stata modificata dopo la lettura: l'If-Match non corrisponde all'ETag corrente"
+}
+
+// ErrorName returns "PreconditionFailed".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *PreconditionFailed) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "PreconditionFailed".
+func (e *PreconditionFailed) GoaErrorName() string {
+	return "preconditionFailed"
+}
+
 // Error returns an error description.
 func (e *Unauthorized) Error() string {
 	return "User not authorized to access the resource"
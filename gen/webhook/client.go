@@ -0,0 +1,115 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// webhook client
+//
+// Command:
+// $ goa gen be/design
+
+package webhook
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "webhook" service client.
+type Client struct {
+	CreateEndpoint     goa.Endpoint
+	ListEndpoint       goa.Endpoint
+	DeleteEndpoint     goa.Endpoint
+	DeliveriesEndpoint goa.Endpoint
+	RedeliverEndpoint  goa.Endpoint
+}
+
+// NewClient initializes a "webhook" service client given the endpoints.
+func NewClient(create, list, delete_, deliveries, redeliver goa.Endpoint) *Client {
+	return &Client{
+		CreateEndpoint:     create,
+		ListEndpoint:       list,
+		DeleteEndpoint:     delete_,
+		DeliveriesEndpoint: deliveries,
+		RedeliverEndpoint:  redeliver,
+	}
+}
+
+// Create calls the "create" endpoint of the "webhook" service.
+// Create may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Create(ctx context.Context, p *CreatePayload) (res *CreatedWebhookSubscription, err error) {
+	var ires any
+	ires, err = c.CreateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CreatedWebhookSubscription), nil
+}
+
+// List calls the "list" endpoint of the "webhook" service.
+// List may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) List(ctx context.Context, p *ListPayload) (res []*WebhookSubscription, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*WebhookSubscription), nil
+}
+
+// Delete calls the "delete" endpoint of the "webhook" service.
+// Delete may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Delete(ctx context.Context, p *DeletePayload) (err error) {
+	_, err = c.DeleteEndpoint(ctx, p)
+	return
+}
+
+// Deliveries calls the "deliveries" endpoint of the "webhook" service.
+// Deliveries may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Deliveries(ctx context.Context, p *DeliveriesPayload) (res []*WebhookDelivery, err error) {
+	var ires any
+	ires, err = c.DeliveriesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*WebhookDelivery), nil
+}
+
+// Redeliver calls the "redeliver" endpoint of the "webhook" service.
+// Redeliver may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Redeliver(ctx context.Context, p *RedeliverPayload) (res *WebhookDelivery, err error) {
+	var ires any
+	ires, err = c.RedeliverEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WebhookDelivery), nil
+}
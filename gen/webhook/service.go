@@ -0,0 +1,307 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// webhook service
+//
+// Command:
+// $ goa gen be/design
+
+package webhook
+
+import (
+	"context"
+
+	"goa.design/goa/v3/security"
+)
+
+// Outgoing webhook subscriptions with signed deliveries
+type Service interface {
+	// Register a callback URL for a set of event types
+	Create(context.Context, *CreatePayload) (res *CreatedWebhookSubscription, err error)
+	// List the caller's webhook subscriptions
+	List(context.Context, *ListPayload) (res []*WebhookSubscription, err error)
+	// Delete a webhook subscription
+	Delete(context.Context, *DeletePayload) (err error)
+	// Delivery history of a webhook subscription
+	Deliveries(context.Context, *DeliveriesPayload) (res []*WebhookDelivery, err error)
+	// Queue a dead-lettered delivery for another round of attempts
+	Redeliver(context.Context, *RedeliverPayload) (res *WebhookDelivery, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// OAuth2Auth implements the authorization logic for the OAuth2 security scheme.
+	OAuth2Auth(ctx context.Context, token string, schema *security.OAuth2Scheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "be_service"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "webhook"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [5]string{"create", "list", "delete", "deliveries", "redeliver"}
+
+// Body di risposta per la richiesta non valida (400)
+type BadRequest struct {
+	// Nome dell'errore
+	Name string
+	// ID dell'errore
+	ID string
+	// Descrizione dettagliata dell'errore
+	Message string
+	// Indica se l'errore è temporaneo
+	Temporary bool
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldError
+}
+
+// CreatePayload is the payload type of the webhook service create method.
+type CreatePayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Callback URL, served over HTTPS from a public address
+	URL string
+	// Event types to deliver
+	EventTypes []string
+}
+
+// CreatedWebhookSubscription is the result type of the webhook service create
+// method.
+type CreatedWebhookSubscription struct {
+	// Secret used to sign deliveries with HMAC-SHA256
+	Secret string
+	// Subscription ID
+	ID string
+	// Callback URL receiving the events
+	URL string
+	// Event types delivered to the callback
+	EventTypes []string
+	// Whether deliveries are enabled
+	Active bool
+	// Creation time
+	CreatedAt string
+}
+
+// DeletePayload is the payload type of the webhook service delete method.
+type DeletePayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Subscription ID
+	ID string
+}
+
+// DeliveriesPayload is the payload type of the webhook service deliveries
+// method.
+type DeliveriesPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Subscription ID
+	ID string
+	// Filter by delivery status
+	Status *string
+	// Max number of results
+	Limit int
+	// Results to skip
+	Offset int
+}
+
+// Campo della richiesta non valido
+type FieldError struct {
+	// Nome del campo
+	Field string
+	// Motivo per cui il campo non è valido
+	Message string
+}
+
+// Cannot access the resource
+type Forbidden struct {
+	// Detailed description of the error
+	Message string
+}
+
+// Errore nel server
+type InternalServerError struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// ListPayload is the payload type of the webhook service list method.
+type ListPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+}
+
+// Dato non trovato all'interno del sistema
+type NotFound struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// RedeliverPayload is the payload type of the webhook service redeliver method.
+type RedeliverPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Subscription ID
+	ID string
+	// Delivery ID
+	DeliveryID string
+}
+
+// User not authorized to access the resource
+type Unauthorized struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// WebhookDelivery is the result type of the webhook service redeliver method.
+type WebhookDelivery struct {
+	// Delivery ID
+	ID string
+	// Subscription ID
+	SubscriptionID string
+	// ID of the delivered event
+	EventID string
+	// Type of the delivered event
+	EventType string
+	// Delivery status
+	Status string
+	// Number of attempts made
+	Attempts int
+	// HTTP status of the last attempt
+	LastStatusCode *int
+	// Error of the last failed attempt
+	LastError *string
+	// When the next attempt is scheduled
+	NextAttemptAt *string
+	// When the delivery succeeded
+	DeliveredAt *string
+	// When the delivery was queued
+	CreatedAt string
+}
+
+type WebhookSubscription struct {
+	// Subscription ID
+	ID string
+	// Callback URL receiving the events
+	URL string
+	// Event types delivered to the callback
+	EventTypes []string
+	// Whether deliveries are enabled
+	Active bool
+	// Creation time
+	CreatedAt string
+}
+
+// Error returns an error description.
+func (e *BadRequest) Error() string {
+	return "Body di risposta per la richiesta non valida (400)"
+}
+
+// ErrorName returns "BadRequest".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *BadRequest) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "BadRequest".
+func (e *BadRequest) GoaErrorName() string {
+	return "badRequest"
+}
+
+// Error returns an error description.
+func (e *FieldError) Error() string {
+	return "Campo della richiesta non valido"
+}
+
+// ErrorName returns "FieldError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *FieldError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "FieldError".
+func (e *FieldError) GoaErrorName() string {
+	return "FieldError"
+}
+
+// Error returns an error description.
+func (e *Forbidden) Error() string {
+	return "Cannot access the resource"
+}
+
+// ErrorName returns "Forbidden".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Forbidden) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Forbidden".
+func (e *Forbidden) GoaErrorName() string {
+	return "forbidden"
+}
+
+// Error returns an error description.
+func (e *InternalServerError) Error() string {
+	return "Errore nel server"
+}
+
+// ErrorName returns "InternalServerError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *InternalServerError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "InternalServerError".
+func (e *InternalServerError) GoaErrorName() string {
+	return "internalServerError"
+}
+
+// Error returns an error description.
+func (e *NotFound) Error() string {
+	return "Dato non trovato all'interno del sistema "
+}
+
+// ErrorName returns "NotFound".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *NotFound) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "NotFound".
+func (e *NotFound) GoaErrorName() string {
+	return "notFound"
+}
+
+// Error returns an error description.
+func (e *Unauthorized) Error() string {
+	return "User not authorized to access the resource"
+}
+
+// ErrorName returns "Unauthorized".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Unauthorized) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Unauthorized".
+func (e *Unauthorized) GoaErrorName() string {
+	return "unauthorized"
+}
@@ -0,0 +1,138 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// workout_session client
+//
+// Command:
+// $ goa gen be/design
+
+package workoutsession
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "workout_session" service client.
+type Client struct {
+	StartEndpoint   goa.Endpoint
+	GetEndpoint     goa.Endpoint
+	ListEndpoint    goa.Endpoint
+	LogEndpoint     goa.Endpoint
+	FinishEndpoint  goa.Endpoint
+	AbandonEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "workout_session" service client given the endpoints.
+func NewClient(start, get, list, log, finish, abandon goa.Endpoint) *Client {
+	return &Client{
+		StartEndpoint:   start,
+		GetEndpoint:     get,
+		ListEndpoint:    list,
+		LogEndpoint:     log,
+		FinishEndpoint:  finish,
+		AbandonEndpoint: abandon,
+	}
+}
+
+// Start calls the "start" endpoint of the "workout_session" service.
+// Start may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Start(ctx context.Context, p *StartPayload) (res *WorkoutSession, err error) {
+	var ires any
+	ires, err = c.StartEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WorkoutSession), nil
+}
+
+// Get calls the "get" endpoint of the "workout_session" service.
+// Get may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Get(ctx context.Context, p *GetPayload) (res *WorkoutSession, err error) {
+	var ires any
+	ires, err = c.GetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WorkoutSession), nil
+}
+
+// List calls the "list" endpoint of the "workout_session" service.
+// List may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) List(ctx context.Context, p *ListPayload) (res []*WorkoutSession, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*WorkoutSession), nil
+}
+
+// Log calls the "log" endpoint of the "workout_session" service.
+// Log may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Log(ctx context.Context, p *LogPayload) (res *SessionSet, err error) {
+	var ires any
+	ires, err = c.LogEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SessionSet), nil
+}
+
+// Finish calls the "finish" endpoint of the "workout_session" service.
+// Finish may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Finish(ctx context.Context, p *FinishPayload) (res *WorkoutSession, err error) {
+	var ires any
+	ires, err = c.FinishEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WorkoutSession), nil
+}
+
+// Abandon calls the "abandon" endpoint of the "workout_session" service.
+// Abandon may return the following errors:
+//   - "unauthorized" (type *Unauthorized): Auth Failed
+//   - "internalServerError" (type *InternalServerError): Internal Server Error
+//   - "notFound" (type *NotFound): Not Found
+//   - "badRequest" (type *BadRequest): Invalid Request
+//   - "forbidden" (type *Forbidden): Accesso negato
+//   - error: internal error
+func (c *Client) Abandon(ctx context.Context, p *AbandonPayload) (res *WorkoutSession, err error) {
+	var ires any
+	ires, err = c.AbandonEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WorkoutSession), nil
+}
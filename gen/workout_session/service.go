@@ -0,0 +1,343 @@
+// Code generated by goa v3.20.0, DO NOT EDIT.
+//
+// workout_session service
+//
+// Command:
+// $ goa gen be/design
+
+package workoutsession
+
+import (
+	"context"
+
+	"goa.design/goa/v3/security"
+)
+
+// Tracking of performed workouts against the plan
+type Service interface {
+	// Start a session of a planned workout, snapshotting its exercises and sets
+	Start(context.Context, *StartPayload) (res *WorkoutSession, err error)
+	// Get a session with its planned and performed sets
+	Get(context.Context, *GetPayload) (res *WorkoutSession, err error)
+	// List the caller's sessions, or those of one of their athletes
+	List(context.Context, *ListPayload) (res []*WorkoutSession, err error)
+	// Log a performed set, either against a planned set or as an extra set
+	Log(context.Context, *LogPayload) (res *SessionSet, err error)
+	// Finish an in-progress session
+	Finish(context.Context, *FinishPayload) (res *WorkoutSession, err error)
+	// Abandon an in-progress session
+	Abandon(context.Context, *AbandonPayload) (res *WorkoutSession, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// OAuth2Auth implements the authorization logic for the OAuth2 security scheme.
+	OAuth2Auth(ctx context.Context, token string, schema *security.OAuth2Scheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "be_service"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "workout_session"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [6]string{"start", "get", "list", "log", "finish", "abandon"}
+
+// AbandonPayload is the payload type of the workout_session service abandon
+// method.
+type AbandonPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Session ID
+	ID string
+	// Athlete notes
+	Notes *string
+}
+
+// Body di risposta per la richiesta non valida (400)
+type BadRequest struct {
+	// Nome dell'errore
+	Name string
+	// ID dell'errore
+	ID string
+	// Descrizione dettagliata dell'errore
+	Message string
+	// Indica se l'errore è temporaneo
+	Temporary bool
+	// Indica se l'errore è dovuto a un timeout
+	Timeout bool
+	// Indica se l'errore è dovuto a un problema del server
+	Fault bool
+	// Campi non validi, quando l'errore riguarda i dati inviati
+	Fields []*FieldError
+}
+
+// Campo della richiesta non valido
+type FieldError struct {
+	// Nome del campo
+	Field string
+	// Motivo per cui il campo non è valido
+	Message string
+}
+
+// FinishPayload is the payload type of the workout_session service finish
+// method.
+type FinishPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Session ID
+	ID string
+	// Athlete notes
+	Notes *string
+}
+
+// Cannot access the resource
+type Forbidden struct {
+	// Detailed description of the error
+	Message string
+}
+
+// GetPayload is the payload type of the workout_session service get method.
+type GetPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Session ID
+	ID string
+}
+
+// Errore nel server
+type InternalServerError struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// ListPayload is the payload type of the workout_session service list method.
+type ListPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Athlete whose sessions to list, defaults to the caller
+	UserID *string
+	// Filter by planned workout
+	WorkoutID *string
+	// Filter by status
+	Status *string
+	// Max number of results
+	Limit int
+	// Results to skip
+	Offset int
+}
+
+// LogPayload is the payload type of the workout_session service log method.
+type LogPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Session ID
+	ID string
+	// Session exercise the set belongs to
+	SessionExerciseID string
+	// Session set to fill in; omit to add an extra set
+	SetID *string
+	// Performed weight in kg
+	Weight float64
+	// Performed repetitions
+	Reps int
+	// Actual rest time in seconds
+	RestTime *int
+}
+
+// Dato non trovato all'interno del sistema
+type NotFound struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// Snapshot of a planned exercise taken when the session started
+type SessionExercise struct {
+	// Session exercise ID
+	ID string
+	// Planned exercise ID
+	ExerciseID *string
+	// Exercise type ID
+	ExerciseTypeID *string
+	// Exercise name at the time the session started
+	Name string
+	// Order of the exercise within the session
+	Position int
+	// Planned and performed sets
+	Sets []*SessionSet
+}
+
+// SessionSet is the result type of the workout_session service log method.
+type SessionSet struct {
+	// Session set ID
+	ID string
+	// Planned exercise set this set was snapshotted from
+	PlannedSetID *string
+	// Order of the set within the exercise
+	Position int
+	// Planned weight in kg
+	PlannedWeight *float64
+	// Planned repetitions
+	PlannedReps *int
+	// Planned rest time in seconds
+	PlannedRestTime *int
+	// Performed weight in kg
+	Weight *float64
+	// Performed repetitions
+	Reps *int
+	// Actual rest time in seconds
+	RestTime *int
+	// Whether the set was performed
+	Completed bool
+	// When the set was logged
+	LoggedAt *string
+	// Personal record kinds set by this set, returned when it is logged
+	Records []string
+}
+
+// StartPayload is the payload type of the workout_session service start method.
+type StartPayload struct {
+	// OAuth2 access token used to perform authorization
+	Token *string
+	// Planned workout ID
+	WorkoutID string
+	// Athlete notes
+	Notes *string
+}
+
+// User not authorized to access the resource
+type Unauthorized struct {
+	// Descrizione dell'errore
+	Message string
+}
+
+// WorkoutSession is the result type of the workout_session service start
+// method.
+type WorkoutSession struct {
+	// Session ID
+	ID string
+	// Planned workout ID, absent once the workout is purged from the trash
+	WorkoutID *string
+	// Athlete performing the session
+	UserID string
+	// Session status
+	Status string
+	// Start time
+	StartedAt string
+	// Finish or abandon time
+	FinishedAt *string
+	// Athlete notes
+	Notes *string
+	// Exercises of the session
+	Exercises []*SessionExercise
+}
+
+// Error returns an error description.
+func (e *BadRequest) Error() string {
+	return "Body di risposta per la richiesta non valida (400)"
+}
+
+// ErrorName returns "BadRequest".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *BadRequest) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "BadRequest".
+func (e *BadRequest) GoaErrorName() string {
+	return "badRequest"
+}
+
+// Error returns an error description.
+func (e *FieldError) Error() string {
+	return "Campo della richiesta non valido"
+}
+
+// ErrorName returns "FieldError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *FieldError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "FieldError".
+func (e *FieldError) GoaErrorName() string {
+	return "FieldError"
+}
+
+// Error returns an error description.
+func (e *Forbidden) Error() string {
+	return "Cannot access the resource"
+}
+
+// ErrorName returns "Forbidden".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Forbidden) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Forbidden".
+func (e *Forbidden) GoaErrorName() string {
+	return "forbidden"
+}
+
+// Error returns an error description.
+func (e *InternalServerError) Error() string {
+	return "Errore nel server"
+}
+
+// ErrorName returns "InternalServerError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *InternalServerError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "InternalServerError".
+func (e *InternalServerError) GoaErrorName() string {
+	return "internalServerError"
+}
+
+// Error returns an error description.
+func (e *NotFound) Error() string {
+	return "Dato non trovato all'interno del sistema "
+}
+
+// ErrorName returns "NotFound".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *NotFound) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "NotFound".
+func (e *NotFound) GoaErrorName() string {
+	return "notFound"
+}
+
+// Error returns an error description.
+func (e *Unauthorized) Error() string {
+	return "User not authorized to access the resource"
+}
+
+// ErrorName returns "Unauthorized".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *Unauthorized) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "Unauthorized".
+func (e *Unauthorized) GoaErrorName() string {
+	return "unauthorized"
+}
@@ -0,0 +1,140 @@
+// Package certs serves HTTPS with certificates that are reloaded when their
+// files change or the process receives SIGHUP, so that renewed certificates
+// are picked up without restarting the server.
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Config holds the TLS settings of the server.
+type Config struct {
+	CertFile       string        `yaml:"certFile"`       // PEM certificate chain
+	KeyFile        string        `yaml:"keyFile"`        // PEM private key of the certificate
+	MinVersion     string        `yaml:"minVersion"`     // Oldest accepted version: 1.2 or 1.3
+	CipherSuites   []string      `yaml:"cipherSuites"`   // TLS 1.2 cipher suites by IANA name; empty keeps Go's defaults
+	ClientAuth     string        `yaml:"clientAuth"`     // Client certificate policy, see ClientAuths
+	ClientCAFile   string        `yaml:"clientCAFile"`   // PEM CAs the client certificates are verified against
+	ReloadInterval time.Duration `yaml:"reloadInterval"` // How often the files are checked for changes; 0 only reloads on SIGHUP
+}
+
+// Versions and ClientAuths list the values Config accepts.
+var (
+	Versions    = []string{"1.2", "1.3"}
+	ClientAuths = []string{"none", "request", "require", "verify-if-given", "require-and-verify"}
+)
+
+var (
+	versions = map[string]uint16{
+		"1.2": tls.VersionTLS12,
+		"1.3": tls.VersionTLS13,
+	}
+	clientAuths = map[string]tls.ClientAuthType{
+		"none":               tls.NoClientCert,
+		"request":            tls.RequestClientCert,
+		"require":            tls.RequireAnyClientCert,
+		"verify-if-given":    tls.VerifyClientCertIfGiven,
+		"require-and-verify": tls.RequireAndVerifyClientCert,
+	}
+)
+
+// Validate checks the settings and loads the files once, reporting every
+// problem at once.
+func (c Config) Validate() error {
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if c.CertFile == "" {
+		fail("certFile is required")
+	}
+	if c.KeyFile == "" {
+		fail("keyFile is required")
+	}
+	if _, ok := versions[c.MinVersion]; !ok {
+		fail("minVersion: %q is invalid (valid versions: %s)", c.MinVersion, strings.Join(Versions, "|"))
+	}
+	if _, err := cipherSuites(c.CipherSuites); err != nil {
+		fail("cipherSuites: %v", err)
+	}
+	if auth, ok := clientAuths[c.ClientAuth]; !ok {
+		fail("clientAuth: %q is invalid (valid values: %s)", c.ClientAuth, strings.Join(ClientAuths, "|"))
+	} else if auth >= tls.VerifyClientCertIfGiven && c.ClientCAFile == "" {
+		fail("clientCAFile is required to verify client certificates")
+	}
+	if c.ReloadInterval < 0 {
+		fail("reloadInterval must not be negative")
+	}
+
+	if len(errs) == 0 {
+		if _, err := c.load(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// load reads the files and builds the tls.Config serving them.
+func (c Config) load() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("certificate: %w", err)
+	}
+	suites, err := cipherSuites(c.CipherSuites)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   versions[c.MinVersion],
+		CipherSuites: suites,
+		ClientAuth:   clientAuths[c.ClientAuth],
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+	if c.ClientCAFile != "" {
+		pem, err := os.ReadFile(c.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("client CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("client CA: no certificate found in %s", c.ClientCAFile)
+		}
+		cfg.ClientCAs = pool
+	}
+	return cfg, nil
+}
+
+// cipherSuites returns the IDs of the named suites. Only the suites Go
+// considers secure are accepted.
+func cipherSuites(names []string) ([]uint16, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	var (
+		ids     []uint16
+		unknown []string
+	)
+	suites := tls.CipherSuites()
+	for _, name := range names {
+		i := slices.IndexFunc(suites, func(s *tls.CipherSuite) bool { return s.Name == name })
+		if i < 0 {
+			unknown = append(unknown, name)
+			continue
+		}
+		ids = append(ids, suites[i].ID)
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown or insecure cipher suites: %s", strings.Join(unknown, ", "))
+	}
+	return ids, nil
+}
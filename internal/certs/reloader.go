@@ -0,0 +1,124 @@
+package certs
+
+import (
+	"be/internal/utils"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"goa.design/clue/log"
+)
+
+// Reloader serves the certificates of Config, reloading them when the files
+// change or on SIGHUP. A failed reload keeps the previous certificates.
+type Reloader struct {
+	Config  Config
+	current atomic.Pointer[tls.Config]
+	stamp   string
+}
+
+// NewReloader loads the files of cfg.
+func NewReloader(cfg Config) (*Reloader, error) {
+	r := &Reloader{Config: cfg}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// TLSConfig returns the configuration for the http.Server. Every handshake
+// uses the certificates loaded last.
+func (r *Reloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: r.current.Load().MinVersion,
+		NextProtos: []string{"h2", "http/1.1"},
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return &r.current.Load().Certificates[0], nil
+		},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.current.Load(), nil
+		},
+	}
+}
+
+// Start watches the files in a goroutine until ctx is cancelled.
+func (r *Reloader) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		log.Printf(ctx, "Starting certificate reloader")
+		r.Run(ctx)
+		log.Printf(ctx, "Certificate reloader stopped")
+	}()
+}
+
+// Run reloads the certificates every Config.ReloadInterval when the files
+// changed, and on SIGHUP, until ctx is cancelled.
+func (r *Reloader) Run(ctx context.Context) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	var tick <-chan time.Time
+	if r.Config.ReloadInterval > 0 {
+		ticker := time.NewTicker(r.Config.ReloadInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			r.reloadLogged(ctx, "SIGHUP")
+		case <-tick:
+			if r.fingerprint() != r.stamp {
+				r.reloadLogged(ctx, "file change")
+			}
+		}
+	}
+}
+
+func (r *Reloader) reloadLogged(ctx context.Context, reason string) {
+	if err := r.reload(); err != nil {
+		utils.Log.Error(ctx, log.KV{K: "certs", V: reason}, err)
+		return
+	}
+	log.Printf(ctx, "Reloaded TLS certificates (%s)", reason)
+}
+
+func (r *Reloader) reload() error {
+	// Taken before reading so that a change made while loading is seen on
+	// the next check.
+	stamp := r.fingerprint()
+	cfg, err := r.Config.load()
+	if err != nil {
+		return err
+	}
+	r.current.Store(cfg)
+	r.stamp = stamp
+	return nil
+}
+
+// fingerprint identifies the current version of the files by their size
+// and modification time. Mounted secrets are swapped through symlinks,
+// which os.Stat follows.
+func (r *Reloader) fingerprint() string {
+	var stamp string
+	for _, path := range []string{r.Config.CertFile, r.Config.KeyFile, r.Config.ClientCAFile} {
+		if path == "" {
+			continue
+		}
+		if fi, err := os.Stat(path); err == nil {
+			stamp += fmt.Sprintf("%s:%d:%d;", path, fi.Size(), fi.ModTime().UnixNano())
+		}
+	}
+	return stamp
+}
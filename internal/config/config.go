@@ -0,0 +1,351 @@
+package config
+
+import (
+	"be/internal/certs"
+	"be/internal/database/db"
+	"be/internal/features/common"
+	"be/internal/features/ratelimit"
+	trainingplan "be/internal/features/trainingPlan"
+	"be/internal/middleware"
+	"be/internal/telemetry"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the whole configuration of the service. Load builds it from,
+// in increasing priority, the defaults, an optional YAML file, the
+// environment and the command-line flags.
+type Config struct {
+	Server      ServerConfig          `yaml:"server"`
+	Database    db.Config             `yaml:"database"`
+	Keycloak    common.KeycloakConfig `yaml:"keycloak"`
+	Plans       PlansConfig           `yaml:"plans"`
+	Trash       TrashConfig           `yaml:"trash"`
+	Idempotency IdempotencyConfig     `yaml:"idempotency"`
+	RateLimit   RateLimitConfig       `yaml:"rateLimit"`
+	Telemetry   telemetry.Config      `yaml:"telemetry"`
+	CORS        middleware.CORSConfig `yaml:"cors"`
+}
+
+// PlansConfig holds the training plan settings.
+type PlansConfig struct {
+	OverlapPolicy trainingplan.OverlapPolicy `yaml:"overlapPolicy"` // What to do when a user's plans overlap
+}
+
+// TrashConfig holds the soft delete settings.
+type TrashConfig struct {
+	RetentionDays int `yaml:"retentionDays"` // Days deleted rows stay restorable; 0 keeps them forever
+}
+
+// IdempotencyConfig holds the Idempotency-Key settings.
+type IdempotencyConfig struct {
+	KeyTTL time.Duration `yaml:"keyTTL"` // How long a key replays the response of its first request
+}
+
+// RateLimitConfig holds the rate limiting settings.
+type RateLimitConfig struct {
+	Store  string            `yaml:"store"`  // Where the buckets are kept: memory or postgres
+	Limits *ratelimit.Config `yaml:"limits"` // Limits by tier and route
+}
+
+// Defaults returns the configuration used for the settings that are not set.
+func Defaults() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Domain:             "development",
+			Host:               "0.0.0.0",
+			HTTPPort:           "9090",
+			ShutdownDrainDelay: 5 * time.Second,
+			TLS: certs.Config{
+				MinVersion:     "1.2",
+				ClientAuth:     "none",
+				ReloadInterval: 30 * time.Second,
+			},
+		},
+		Database: db.Config{
+			Port:    5432,
+			SSLMode: "disable",
+		},
+		Keycloak: common.KeycloakConfig{
+			URL: "http://keycloak:8080",
+		},
+		Plans:       PlansConfig{OverlapPolicy: trainingplan.OverlapWarn},
+		Trash:       TrashConfig{RetentionDays: 30},
+		Idempotency: IdempotencyConfig{KeyTTL: 24 * time.Hour},
+		RateLimit: RateLimitConfig{
+			Store:  "memory",
+			Limits: ratelimit.DefaultConfig(),
+		},
+		Telemetry: telemetry.Config{
+			Exporter:    "none",
+			Protocol:    "grpc",
+			ServiceName: "be",
+		},
+		CORS: middleware.DefaultCORSConfig(),
+	}
+}
+
+// loadFile reads the YAML file at path on top of cfg. Unknown keys are
+// rejected so that typos don't go unnoticed.
+func (cfg *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// loadEnv reads the environment on top of cfg. Every variable can be read
+// from a file instead, named by the variable with a _FILE suffix, which is
+// how container orchestrators mount secrets.
+func (cfg *Config) loadEnv() error {
+	env := &envLoader{}
+
+	env.string("SERVER_DOMAIN", &cfg.Server.Domain)
+	env.string("SERVER_HOST", &cfg.Server.Host)
+	env.string("HTTP_PORT", &cfg.Server.HTTPPort)
+	env.bool("SERVER_SECURE", &cfg.Server.Secure)
+	var debug bool
+	if env.bool("DEBUG", &debug) {
+		cfg.Server.Debug = &debug
+	}
+	env.duration("SHUTDOWN_DRAIN_DELAY", &cfg.Server.ShutdownDrainDelay)
+	env.string("METRICS_TOKEN", &cfg.Server.MetricsToken)
+	env.string("TLS_CERT_FILE", &cfg.Server.TLS.CertFile)
+	env.string("TLS_KEY_FILE", &cfg.Server.TLS.KeyFile)
+	env.string("TLS_MIN_VERSION", &cfg.Server.TLS.MinVersion)
+	env.list("TLS_CIPHER_SUITES", &cfg.Server.TLS.CipherSuites)
+	env.string("TLS_CLIENT_AUTH", &cfg.Server.TLS.ClientAuth)
+	env.string("TLS_CLIENT_CA_FILE", &cfg.Server.TLS.ClientCAFile)
+	env.duration("TLS_RELOAD_INTERVAL", &cfg.Server.TLS.ReloadInterval)
+
+	env.string("DB_HOST", &cfg.Database.Host)
+	env.int("DB_PORT", &cfg.Database.Port)
+	env.string("DB_USER", &cfg.Database.User)
+	env.string("DB_PASS", &cfg.Database.Password)
+	env.string("DB_NAME", &cfg.Database.Name)
+	env.string("DB_SSLMODE", &cfg.Database.SSLMode)
+
+	env.string("KC_URL", &cfg.Keycloak.URL)
+	env.string("KC_HOST", &cfg.Keycloak.PublicURL)
+	env.string("KC_REALM", &cfg.Keycloak.Realm)
+	env.string("KC_CLIENT_ID", &cfg.Keycloak.ClientID)
+	env.string("KC_CLIENT_SECRET", &cfg.Keycloak.ClientSecret)
+	env.string("KC_RSA_PUBLIC_KEY", &cfg.Keycloak.RSAPublicKey)
+
+	var policy string
+	if env.string("PLAN_OVERLAP_POLICY", &policy) {
+		cfg.Plans.OverlapPolicy = trainingplan.OverlapPolicy(policy)
+	}
+	env.int("TRASH_RETENTION_DAYS", &cfg.Trash.RetentionDays)
+	env.duration("IDEMPOTENCY_KEY_TTL", &cfg.Idempotency.KeyTTL)
+
+	env.string("RATE_LIMIT_STORE", &cfg.RateLimit.Store)
+	var limits string
+	if env.string("RATE_LIMITS", &limits) {
+		if err := json.Unmarshal([]byte(limits), cfg.RateLimit.Limits); err != nil {
+			env.errs = append(env.errs, fmt.Errorf("RATE_LIMITS: %w", err))
+		}
+	}
+
+	env.string("OTEL_TRACES_EXPORTER", &cfg.Telemetry.Exporter)
+	env.string("OTEL_EXPORTER_OTLP_PROTOCOL", &cfg.Telemetry.Protocol)
+	env.string("OTEL_SERVICE_NAME", &cfg.Telemetry.ServiceName)
+
+	env.list("CORS_ALLOWED_ORIGINS", &cfg.CORS.AllowedOrigins)
+	env.bool("CORS_ALLOW_CREDENTIALS", &cfg.CORS.AllowCredentials)
+	env.list("CORS_ALLOWED_METHODS", &cfg.CORS.AllowedMethods)
+	env.list("CORS_ALLOWED_HEADERS", &cfg.CORS.AllowedHeaders)
+	env.list("CORS_EXPOSED_HEADERS", &cfg.CORS.ExposedHeaders)
+	env.duration("CORS_MAX_AGE", &cfg.CORS.MaxAge)
+
+	return errors.Join(env.errs...)
+}
+
+// envLoader reads typed variables, collecting the errors.
+type envLoader struct {
+	errs []error
+}
+
+// lookup returns the value of the variable name, or the content of the file
+// named by name_FILE.
+func (l *envLoader) lookup(name string) (string, bool) {
+	value, ok := os.LookupEnv(name)
+	path, fromFile := os.LookupEnv(name + "_FILE")
+	if !fromFile {
+		return value, ok
+	}
+	if ok {
+		l.errs = append(l.errs, fmt.Errorf("%s and %s_FILE are both set", name, name))
+		return "", false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		l.errs = append(l.errs, fmt.Errorf("%s_FILE: %w", name, err))
+		return "", false
+	}
+	return strings.TrimRight(string(data), "\r\n"), true
+}
+
+func (l *envLoader) string(name string, dst *string) bool {
+	value, ok := l.lookup(name)
+	if ok {
+		*dst = value
+	}
+	return ok
+}
+
+// list reads a comma-separated list.
+func (l *envLoader) list(name string, dst *[]string) {
+	if value, ok := l.lookup(name); ok {
+		items := []string{}
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		*dst = items
+	}
+}
+
+func (l *envLoader) int(name string, dst *int) {
+	if value, ok := l.lookup(name); ok {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			l.errs = append(l.errs, fmt.Errorf("%s: %q is not an integer", name, value))
+			return
+		}
+		*dst = n
+	}
+}
+
+func (l *envLoader) bool(name string, dst *bool) bool {
+	value, ok := l.lookup(name)
+	if !ok {
+		return false
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		l.errs = append(l.errs, fmt.Errorf("%s: %q is not a boolean", name, value))
+		return false
+	}
+	*dst = b
+	return true
+}
+
+func (l *envLoader) duration(name string, dst *time.Duration) {
+	if value, ok := l.lookup(name); ok {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			l.errs = append(l.errs, fmt.Errorf("%s: %q is not a duration such as 30s or 24h", name, value))
+			return
+		}
+		*dst = d
+	}
+}
+
+// Validate checks the whole configuration and reports every invalid setting
+// at once. It normalizes the overlap policy.
+func (cfg *Config) Validate() error {
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+	required := func(value, name string) {
+		if value == "" {
+			fail("%s is required", name)
+		}
+	}
+	port := func(value int, name string) {
+		if value < 1 || value > 65535 {
+			fail("%s: %d is not a valid port", name, value)
+		}
+	}
+
+	if cfg.Server.Domain != "development" && cfg.Server.Domain != "production" {
+		fail("server.domain (SERVER_DOMAIN, -host): %q is invalid (valid values: development|production)", cfg.Server.Domain)
+	}
+	if p, err := strconv.Atoi(cfg.Server.HTTPPort); err != nil {
+		fail("server.httpPort (HTTP_PORT, -http-port): %q is not a valid port", cfg.Server.HTTPPort)
+	} else {
+		port(p, "server.httpPort (HTTP_PORT, -http-port)")
+	}
+	if cfg.Server.ShutdownDrainDelay < 0 {
+		fail("server.shutdownDrainDelay (SHUTDOWN_DRAIN_DELAY) must not be negative")
+	}
+	if cfg.Server.Secure {
+		if err, ok := cfg.Server.TLS.Validate().(interface{ Unwrap() []error }); ok {
+			for _, err := range err.Unwrap() {
+				fail("server.tls (TLS_*): %v", err)
+			}
+		}
+	}
+
+	required(cfg.Database.Host, "database.host (DB_HOST)")
+	required(cfg.Database.User, "database.user (DB_USER)")
+	required(cfg.Database.Name, "database.name (DB_NAME)")
+	port(cfg.Database.Port, "database.port (DB_PORT)")
+
+	if u, err := url.Parse(cfg.Keycloak.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		fail("keycloak.url (KC_URL): %q is not an http(s) URL", cfg.Keycloak.URL)
+	}
+	required(cfg.Keycloak.Realm, "keycloak.realm (KC_REALM)")
+	required(cfg.Keycloak.ClientID, "keycloak.clientId (KC_CLIENT_ID)")
+	required(cfg.Keycloak.ClientSecret, "keycloak.clientSecret (KC_CLIENT_SECRET)")
+	if cfg.Keycloak.RSAPublicKey == "" {
+		fail("keycloak.rsaPublicKey (KC_RSA_PUBLIC_KEY) is required")
+	} else if _, err := middleware.ParsePublicKey(cfg.Keycloak.RSAPublicKey); err != nil {
+		fail("keycloak.rsaPublicKey (KC_RSA_PUBLIC_KEY): %v", err)
+	}
+
+	if policy, err := trainingplan.ParseOverlapPolicy(string(cfg.Plans.OverlapPolicy)); err != nil {
+		fail("plans.overlapPolicy (PLAN_OVERLAP_POLICY): %v", err)
+	} else {
+		cfg.Plans.OverlapPolicy = policy
+	}
+	if cfg.Trash.RetentionDays < 0 {
+		fail("trash.retentionDays (TRASH_RETENTION_DAYS) must not be negative")
+	}
+	if cfg.Idempotency.KeyTTL <= 0 {
+		fail("idempotency.keyTTL (IDEMPOTENCY_KEY_TTL) must be positive")
+	}
+
+	if !slices.Contains(ratelimit.Stores, cfg.RateLimit.Store) {
+		fail("rateLimit.store (RATE_LIMIT_STORE): %q is invalid (valid stores: %s)", cfg.RateLimit.Store, strings.Join(ratelimit.Stores, "|"))
+	}
+	if cfg.RateLimit.Limits == nil {
+		fail("rateLimit.limits is required")
+	} else if err := cfg.RateLimit.Limits.Validate(); err != nil {
+		fail("rateLimit.limits (RATE_LIMITS): %v", err)
+	}
+
+	if !slices.Contains(telemetry.Exporters, cfg.Telemetry.Exporter) {
+		fail("telemetry.exporter (OTEL_TRACES_EXPORTER): %q is invalid (valid exporters: %s)", cfg.Telemetry.Exporter, strings.Join(telemetry.Exporters, "|"))
+	}
+	if !slices.Contains(telemetry.Protocols, cfg.Telemetry.Protocol) {
+		fail("telemetry.protocol (OTEL_EXPORTER_OTLP_PROTOCOL): %q is invalid (valid protocols: %s)", cfg.Telemetry.Protocol, strings.Join(telemetry.Protocols, "|"))
+	}
+
+	if err, ok := cfg.CORS.Validate().(interface{ Unwrap() []error }); ok {
+		for _, err := range err.Unwrap() {
+			fail("cors (CORS_*): %v", err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -1,43 +1,55 @@
 package config
 
 import (
+	"be/internal/certs"
 	"be/internal/utils"
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"net"
 	"net/url"
+	"os"
+	"time"
 
 	"goa.design/clue/log"
 )
 
 // ServerConfig holds server-related configurations such as domain, host, port, and security settings.
 type ServerConfig struct {
-	Domain   string // The server domain, e.g., "development" or "production"
-	Host     string // Host IP address or domain name for the server
-	HTTPPort string // Port for the HTTP server
-	Port     string // Optional custom port if needed for other services
-	Secure   bool   // Flag to use secure HTTPS connections
-	Debug    bool   // Debug mode flag to enable detailed logging
+	Domain             string        `yaml:"domain"`             // The server domain, e.g., "development" or "production"
+	Host               string        `yaml:"host"`               // Host IP address or domain name for the server
+	HTTPPort           string        `yaml:"httpPort"`           // Port for the HTTP server
+	Port               string        `yaml:"-"`                  // Optional custom port if needed for other services
+	Secure             bool          `yaml:"secure"`             // Flag to serve HTTPS with the TLS settings
+	Debug              *bool         `yaml:"debug"`              // Debug mode flag to enable detailed logging; on by default in development only
+	ShutdownDrainDelay time.Duration `yaml:"shutdownDrainDelay"` // How long readiness fails before the server shuts down
+	MetricsToken       string        `yaml:"metricsToken"`       // Bearer token the scraper sends to /metrics; /metrics is not served without it
+	TLS                certs.Config  `yaml:"tls"`                // Certificates and TLS settings used when Secure is set
 }
 
-// LoadServerConfig parses command-line flags and loads environment variables as needed.
-// This function sets up the server configuration based on the environment.
-func LoadServerConfig() *ServerConfig {
+// Load parses the command-line flags and builds the configuration from the
+// defaults, the YAML file named by -config or CONFIG_FILE, the environment
+// and the flags that were set, in increasing priority. Every invalid
+// setting is reported in the returned error.
+func Load() (*Config, error) {
+	cfg := Defaults()
 
 	var (
 		// Flag for selecting the host environment (development or production)
-		domainF = flag.String("host", "development", "Server host (valid values: development, production)")
+		domainF = flag.String("host", cfg.Server.Domain, "Server host (valid values: development, production)")
 		// Flag for specifying the host domain or IP address
-		hostF = flag.String("domain", "0.0.0.0", "Host domain name")
+		hostF = flag.String("domain", cfg.Server.Host, "Host domain name")
 		// Flag for setting the HTTP port
-		httpPortF = flag.String("http-port", "9090", "HTTP port")
+		httpPortF = flag.String("http-port", cfg.Server.HTTPPort, "HTTP port")
 		// Flag to enable secure connections (HTTPS)
-		secureF = flag.Bool("secure", false, "Use secure scheme (https or grpcs)")
+		secureF = flag.Bool("secure", cfg.Server.Secure, "Use secure scheme (https or grpcs)")
 		// Debug flag to log request and response bodies
-		dbgF = flag.Bool("debug", true, "Log request and response bodies")
+		dbgF = flag.Bool("debug", false, "Log request and response bodies (default true in development)")
 		// Flag to specify environment for loading the appropriate .env file
 		envF = flag.String("env", "develop", "load .env when outside a docker container")
+		// Flag for the YAML configuration file
+		configF = flag.String("config", "", "YAML configuration file (default $CONFIG_FILE)")
 	)
 
 	flag.Parse() // Parse command-line flags
@@ -52,14 +64,61 @@ func LoadServerConfig() *ServerConfig {
 		break // Panic if an unsupported environment is specified
 	}
 
-	// Return a ServerConfig instance populated with the parsed flag values
-	return &ServerConfig{
-		Host:     *hostF,
-		Domain:   *domainF,
-		HTTPPort: *httpPortF,
-		Secure:   *secureF,
-		Debug:    *dbgF,
+	var errs []error
+	path := *configF
+	if path == "" {
+		path = os.Getenv("CONFIG_FILE")
 	}
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := cfg.loadEnv(); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Only the flags given on the command line override the other sources.
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "host":
+			cfg.Server.Domain = *domainF
+		case "domain":
+			cfg.Server.Host = *hostF
+		case "http-port":
+			cfg.Server.HTTPPort = *httpPortF
+		case "secure":
+			cfg.Server.Secure = *secureF
+		case "debug":
+			cfg.Server.Debug = dbgF
+		}
+	})
+
+	// Development servers log the request and response bodies unless told
+	// otherwise, production ones only when told to.
+	if cfg.Server.Debug == nil {
+		debug := cfg.Server.Domain == "development"
+		cfg.Server.Debug = &debug
+	}
+
+	// Any origin may call a development server unless told otherwise,
+	// production ones only the origins they list.
+	if cfg.CORS.AllowedOrigins == nil && cfg.Server.Domain == "development" {
+		cfg.CORS.AllowedOrigins = []string{"*"}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	return cfg, nil
+}
+
+// DebugEnabled tells whether the debug mode is on.
+func (sc *ServerConfig) DebugEnabled() bool {
+	return sc.Debug != nil && *sc.Debug
 }
 
 // BuildServerURL constructs a URL based on the ServerConfig settings.
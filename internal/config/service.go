@@ -1,14 +1,45 @@
 package config
 
 import (
+	analyticsGen "be/gen/analytics"
+	analyticsService "be/internal/features/analytics"
+
+	recordGen "be/gen/record"
+	recordService "be/internal/features/personalRecord"
+
+	scheduleGen "be/gen/schedule"
+	scheduleService "be/internal/features/schedule"
+
+	coachingGen "be/gen/coaching"
+	coachingService "be/internal/features/coaching"
+
+	progressionGen "be/gen/progression"
+	progressionService "be/internal/features/progression"
+
+	importerGen "be/gen/importer"
+	importerService "be/internal/features/importer"
+
+	auditGen "be/gen/audit"
+	auditService "be/internal/features/audit"
+
+	webhookGen "be/gen/webhook"
+	webhookService "be/internal/features/webhook"
+
+	workoutSessionGen "be/gen/workout_session"
+	workoutSessionService "be/internal/features/workoutSession"
+
 	userGen "be/gen/user"
+	commonService "be/internal/features/common"
 	userService "be/internal/features/user"
 
 	trainingPlanGen "be/gen/training_plan"
 	trainingPlanService "be/internal/features/trainingPlan"
 
+	"be/internal/metrics"
+	"be/internal/telemetry"
 	"context"
 
+	"github.com/google/uuid"
 	"goa.design/clue/debug"
 	"goa.design/clue/log"
 )
@@ -16,8 +47,17 @@ import (
 type EndpointName string
 
 const (
-	TrainingPlanEndPoint EndpointName = "training-plan"
-	UserEndPoint         EndpointName = "user"
+	TrainingPlanEndPoint   EndpointName = "training-plan"
+	UserEndPoint           EndpointName = "user"
+	AuditEndPoint          EndpointName = "audit"
+	WebhookEndPoint        EndpointName = "webhook"
+	WorkoutSessionEndPoint EndpointName = "workout-session"
+	AnalyticsEndPoint      EndpointName = "analytics"
+	RecordEndPoint         EndpointName = "record"
+	ScheduleEndPoint       EndpointName = "schedule"
+	CoachingEndPoint       EndpointName = "coaching"
+	ProgressionEndPoint    EndpointName = "progression"
+	ImporterEndPoint       EndpointName = "importer"
 )
 
 type ServiceConfig struct {
@@ -26,38 +66,199 @@ type ServiceConfig struct {
 	NewEndpoints func(svc interface{}) interface{} // Function to create endpoints for the service
 }
 
-func withUserService() ServiceConfig {
+func withUserService(cfg *Config) ServiceConfig {
 	return ServiceConfig{
 		EndpointName: UserEndPoint,
-		NewService:   func() interface{} { return userService.NewService() },
+		NewService:   func() interface{} { return userService.NewService(commonService.NewKcClient(cfg.Keycloak)) },
 		NewEndpoints: func(svc interface{}) interface{} {
+			repo := svc.(*userService.Service).Repository
 			endpoints := userGen.NewEndpoints(svc.(userGen.Service))
+			endpoints.Use(auditService.Endpoint(func(ctx context.Context, id string) (any, error) {
+				return repo.FindByID(ctx, id)
+			}))
 			endpoints.Use(debug.LogPayloads())
 			endpoints.Use(log.Endpoint)
+			endpoints.Use(telemetry.Endpoint)
+			endpoints.Use(metrics.Endpoint)
 			return endpoints
 		},
 	}
 }
 
-func withTrainingPlanService() ServiceConfig {
+func withTrainingPlanService(cfg *Config) ServiceConfig {
 	return ServiceConfig{
 		EndpointName: TrainingPlanEndPoint,
-		NewService:   func() interface{} { return trainingPlanService.NewService() },
+		NewService:   func() interface{} { return trainingPlanService.NewService(cfg.Plans.OverlapPolicy) },
 		NewEndpoints: func(svc interface{}) interface{} {
+			repo := svc.(*trainingPlanService.Service).Repository
 			endpoints := trainingPlanGen.NewEndpoints(svc.(trainingPlanGen.Service))
+			endpoints.Use(auditService.Endpoint(func(ctx context.Context, id string) (any, error) {
+				planID, err := uuid.Parse(id)
+				if err != nil {
+					return nil, err
+				}
+				return repo.FindByID(ctx, planID)
+			}))
+			endpoints.Use(debug.LogPayloads())
+			endpoints.Use(log.Endpoint)
+			endpoints.Use(telemetry.Endpoint)
+			endpoints.Use(metrics.Endpoint)
+			return endpoints
+		},
+	}
+}
+
+func withAuditService() ServiceConfig {
+	return ServiceConfig{
+		EndpointName: AuditEndPoint,
+		NewService:   func() interface{} { return auditService.NewService() },
+		NewEndpoints: func(svc interface{}) interface{} {
+			endpoints := auditGen.NewEndpoints(svc.(auditGen.Service))
+			endpoints.Use(debug.LogPayloads())
+			endpoints.Use(log.Endpoint)
+			endpoints.Use(telemetry.Endpoint)
+			endpoints.Use(metrics.Endpoint)
+			return endpoints
+		},
+	}
+}
+
+func withWebhookService() ServiceConfig {
+	return ServiceConfig{
+		EndpointName: WebhookEndPoint,
+		NewService:   func() interface{} { return webhookService.NewService() },
+		NewEndpoints: func(svc interface{}) interface{} {
+			endpoints := webhookGen.NewEndpoints(svc.(webhookGen.Service))
+			endpoints.Use(auditService.Endpoint(nil))
+			endpoints.Use(debug.LogPayloads())
+			endpoints.Use(log.Endpoint)
+			endpoints.Use(telemetry.Endpoint)
+			endpoints.Use(metrics.Endpoint)
+			return endpoints
+		},
+	}
+}
+
+func withWorkoutSessionService() ServiceConfig {
+	return ServiceConfig{
+		EndpointName: WorkoutSessionEndPoint,
+		NewService:   func() interface{} { return workoutSessionService.NewService() },
+		NewEndpoints: func(svc interface{}) interface{} {
+			endpoints := workoutSessionGen.NewEndpoints(svc.(workoutSessionGen.Service))
+			endpoints.Use(debug.LogPayloads())
+			endpoints.Use(log.Endpoint)
+			endpoints.Use(telemetry.Endpoint)
+			endpoints.Use(metrics.Endpoint)
+			return endpoints
+		},
+	}
+}
+
+func withAnalyticsService() ServiceConfig {
+	return ServiceConfig{
+		EndpointName: AnalyticsEndPoint,
+		NewService:   func() interface{} { return analyticsService.NewService() },
+		NewEndpoints: func(svc interface{}) interface{} {
+			endpoints := analyticsGen.NewEndpoints(svc.(analyticsGen.Service))
+			endpoints.Use(debug.LogPayloads())
+			endpoints.Use(log.Endpoint)
+			endpoints.Use(telemetry.Endpoint)
+			endpoints.Use(metrics.Endpoint)
+			return endpoints
+		},
+	}
+}
+
+func withRecordService() ServiceConfig {
+	return ServiceConfig{
+		EndpointName: RecordEndPoint,
+		NewService:   func() interface{} { return recordService.NewService() },
+		NewEndpoints: func(svc interface{}) interface{} {
+			endpoints := recordGen.NewEndpoints(svc.(recordGen.Service))
+			endpoints.Use(debug.LogPayloads())
+			endpoints.Use(log.Endpoint)
+			endpoints.Use(telemetry.Endpoint)
+			endpoints.Use(metrics.Endpoint)
+			return endpoints
+		},
+	}
+}
+
+func withScheduleService() ServiceConfig {
+	return ServiceConfig{
+		EndpointName: ScheduleEndPoint,
+		NewService:   func() interface{} { return scheduleService.NewService() },
+		NewEndpoints: func(svc interface{}) interface{} {
+			endpoints := scheduleGen.NewEndpoints(svc.(scheduleGen.Service))
+			endpoints.Use(debug.LogPayloads())
+			endpoints.Use(log.Endpoint)
+			endpoints.Use(telemetry.Endpoint)
+			endpoints.Use(metrics.Endpoint)
+			return endpoints
+		},
+	}
+}
+
+func withCoachingService() ServiceConfig {
+	return ServiceConfig{
+		EndpointName: CoachingEndPoint,
+		NewService:   func() interface{} { return coachingService.NewService() },
+		NewEndpoints: func(svc interface{}) interface{} {
+			endpoints := coachingGen.NewEndpoints(svc.(coachingGen.Service))
+			endpoints.Use(debug.LogPayloads())
+			endpoints.Use(log.Endpoint)
+			endpoints.Use(telemetry.Endpoint)
+			endpoints.Use(metrics.Endpoint)
+			return endpoints
+		},
+	}
+}
+
+func withProgressionService() ServiceConfig {
+	return ServiceConfig{
+		EndpointName: ProgressionEndPoint,
+		NewService:   func() interface{} { return progressionService.NewService() },
+		NewEndpoints: func(svc interface{}) interface{} {
+			endpoints := progressionGen.NewEndpoints(svc.(progressionGen.Service))
+			endpoints.Use(debug.LogPayloads())
+			endpoints.Use(log.Endpoint)
+			endpoints.Use(telemetry.Endpoint)
+			endpoints.Use(metrics.Endpoint)
+			return endpoints
+		},
+	}
+}
+
+func withImporterService() ServiceConfig {
+	return ServiceConfig{
+		EndpointName: ImporterEndPoint,
+		NewService:   func() interface{} { return importerService.NewService() },
+		NewEndpoints: func(svc interface{}) interface{} {
+			endpoints := importerGen.NewEndpoints(svc.(importerGen.Service))
 			endpoints.Use(debug.LogPayloads())
 			endpoints.Use(log.Endpoint)
+			endpoints.Use(telemetry.Endpoint)
+			endpoints.Use(metrics.Endpoint)
 			return endpoints
 		},
 	}
 }
 
-func InitializeServices(ctx context.Context) map[EndpointName]interface{} {
+func InitializeServices(ctx context.Context, cfg *Config) map[EndpointName]interface{} {
 	epsMap := make(map[EndpointName]interface{})
 
 	services := []ServiceConfig{
-		withUserService(), 
-		withTrainingPlanService(),
+		withUserService(cfg),
+		withTrainingPlanService(cfg),
+		withAuditService(),
+		withWebhookService(),
+		withWorkoutSessionService(),
+		withAnalyticsService(),
+		withRecordService(),
+		withScheduleService(),
+		withCoachingService(),
+		withProgressionService(),
+		withImporterService(),
 	}
 	for _, serviceConfig := range services {
 		svc := serviceConfig.NewService()              // Create a new service instance
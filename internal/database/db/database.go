@@ -2,9 +2,12 @@ package db
 
 import (
 	"database/sql"
-	"fmt"
 	"log"
-	"os"
+	"net"
+	"net/url"
+	"strconv"
+
+	"be/internal/telemetry"
 
 	_ "github.com/lib/pq"
 
@@ -21,17 +24,30 @@ type DbInstance struct {
 
 var DB DbInstance
 
-func ConnectDb() {
-	dbHost := os.Getenv("DB_HOST")
-	dbUser := os.Getenv("DB_USER")
-	dbPass := os.Getenv("DB_PASS")
-	dbName := os.Getenv("DB_NAME")
-	dbPort := os.Getenv("DB_PORT")
+// Config holds the Postgres connection settings.
+type Config struct {
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	Name     string `yaml:"name"`
+	SSLMode  string `yaml:"sslMode"`
+}
 
-	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		dbHost, dbPort, dbUser, dbPass, dbName)
+// DSN returns the connection URL, which escapes the credentials.
+func (c Config) DSN() string {
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.User, c.Password),
+		Host:     net.JoinHostPort(c.Host, strconv.Itoa(c.Port)),
+		Path:     c.Name,
+		RawQuery: url.Values{"sslmode": {c.SSLMode}}.Encode(),
+	}
+	return u.String()
+}
 
-	sqlDB, err := sql.Open("postgres", dsn)
+func ConnectDb(cfg Config) {
+	sqlDB, err := telemetry.OpenDB("postgres", cfg.DSN())
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
@@ -1,24 +1,29 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 )
 
+// migrationsDir holds the migration files, relative to the working
+// directory the service is started from.
+var migrationsDir = filepath.Join(".", "internal", "database", "migrations")
+
 func RunMigrations(db *sql.DB) {
 	driver, err := postgres.WithInstance(db, &postgres.Config{})
 	if err != nil {
 		log.Fatalf("Migration driver error: %v", err)
 	}
 
-	migrationsDir := filepath.Join(".", "internal", "database", "migrations")
-
 	m, err := migrate.NewWithDatabaseInstance(
 		fmt.Sprintf("file://%s", migrationsDir),
 		"postgres", driver)
@@ -31,3 +36,38 @@ func RunMigrations(db *sql.DB) {
 		log.Fatalf("Migration failed: %v", err)
 	}
 }
+
+// MigrationStatus returns the schema version recorded by golang-migrate and
+// whether its migration failed half-way, leaving the schema dirty.
+func MigrationStatus(ctx context.Context, db *sql.DB) (uint, bool, error) {
+	var (
+		version uint
+		dirty   bool
+	)
+	err := db.QueryRowContext(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &dirty)
+	return version, dirty, err
+}
+
+// LatestMigration returns the version of the newest migration shipped with
+// the service. Finding no migration means the service doesn't run from
+// the directory holding them, which is an error.
+func LatestMigration() (uint, error) {
+	files, err := filepath.Glob(filepath.Join(migrationsDir, "*.up.sql"))
+	if err != nil {
+		return 0, err
+	}
+	if len(files) == 0 {
+		return 0, fmt.Errorf("no migration found in %s", migrationsDir)
+	}
+
+	var latest uint
+	for _, f := range files {
+		prefix, _, _ := strings.Cut(filepath.Base(f), "_")
+		v, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid migration file name %q", f)
+		}
+		latest = max(latest, uint(v))
+	}
+	return latest, nil
+}
@@ -1,4 +1,6 @@
+DROP TABLE IF EXISTS exercise_set;
 DROP TABLE IF EXISTS exercise;
+DROP TABLE IF EXISTS exercise_type;
 DROP TABLE IF EXISTS workout;
 DROP TABLE IF EXISTS training_plan;
 DROP TABLE IF EXISTS users;
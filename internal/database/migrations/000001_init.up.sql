@@ -33,6 +33,14 @@ CREATE TABLE IF NOT EXISTS workout (
     FOREIGN KEY (training_plan_id) REFERENCES training_plan(id) ON DELETE CASCADE
 );
 
+CREATE TABLE IF NOT EXISTS exercise_type (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
 CREATE TABLE IF NOT EXISTS exercise (
     id UUID PRIMARY KEY,
     name TEXT NOT NULL,
@@ -45,13 +53,6 @@ CREATE TABLE IF NOT EXISTS exercise (
     FOREIGN KEY (workout_id) REFERENCES workout(id) ON DELETE CASCADE
 );
 
-CREATE TABLE IF NO EXISTS exercise_type (
-    id UUID PRIMARY KEY,
-    name TEXT NOT NULL,
-    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
-    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
-    deleted_at TIMESTAMP
-);
 CREATE TABLE IF NOT EXISTS exercise_set (
     id UUID PRIMARY KEY,
     exercise_id UUID NOT NULL,
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS session_set;
+DROP TABLE IF EXISTS session_exercise;
+DROP TABLE IF EXISTS workout_session;
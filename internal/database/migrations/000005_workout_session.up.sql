@@ -0,0 +1,50 @@
+CREATE TABLE IF NOT EXISTS workout_session (
+    id UUID PRIMARY KEY,
+    workout_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    status TEXT NOT NULL DEFAULT 'in_progress',
+    notes TEXT,
+    started_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP,
+    FOREIGN KEY (workout_id) REFERENCES workout(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_workout_session_in_progress
+    ON workout_session (user_id, workout_id) WHERE status = 'in_progress' AND deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS session_exercise (
+    id UUID PRIMARY KEY,
+    session_id UUID NOT NULL,
+    exercise_id UUID,
+    name TEXT NOT NULL,
+    position INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (session_id) REFERENCES workout_session(id) ON DELETE CASCADE,
+    FOREIGN KEY (exercise_id) REFERENCES exercise(id) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS session_set (
+    id UUID PRIMARY KEY,
+    session_exercise_id UUID NOT NULL,
+    planned_set_id UUID,
+    position INT NOT NULL,
+    planned_weight DECIMAL(10, 2),
+    planned_reps INT,
+    planned_rest_time INT,
+    weight DECIMAL(10, 2),
+    reps INT,
+    rest_time INT,
+    completed BOOLEAN NOT NULL DEFAULT FALSE,
+    logged_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (session_exercise_id) REFERENCES session_exercise(id) ON DELETE CASCADE,
+    FOREIGN KEY (planned_set_id) REFERENCES exercise_set(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_session_exercise_session ON session_exercise (session_id);
+CREATE INDEX IF NOT EXISTS idx_session_set_exercise ON session_set (session_exercise_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_workout_session_user_started;
+ALTER TABLE session_exercise DROP COLUMN IF EXISTS exercise_type_id;
+ALTER TABLE exercise_type DROP COLUMN IF EXISTS muscle_group;
@@ -0,0 +1,10 @@
+ALTER TABLE exercise_type ADD COLUMN IF NOT EXISTS muscle_group TEXT;
+
+ALTER TABLE session_exercise ADD COLUMN IF NOT EXISTS exercise_type_id UUID REFERENCES exercise_type(id) ON DELETE SET NULL;
+
+UPDATE session_exercise se
+SET exercise_type_id = e.exercise_type_id
+FROM exercise e
+WHERE e.id = se.exercise_id AND se.exercise_type_id IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_workout_session_user_started ON workout_session (user_id, started_at);
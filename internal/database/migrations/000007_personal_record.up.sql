@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS personal_record (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    exercise_type_id UUID NOT NULL,
+    kind TEXT NOT NULL,
+    value DECIMAL(10, 2) NOT NULL,
+    previous_value DECIMAL(10, 2),
+    weight DECIMAL(10, 2),
+    reps INT,
+    session_id UUID,
+    set_id UUID,
+    achieved_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (exercise_type_id) REFERENCES exercise_type(id) ON DELETE CASCADE,
+    FOREIGN KEY (session_id) REFERENCES workout_session(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_personal_record_best
+    ON personal_record (user_id, exercise_type_id, kind, value DESC);
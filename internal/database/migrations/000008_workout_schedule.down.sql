@@ -0,0 +1,5 @@
+ALTER TABLE users DROP COLUMN IF EXISTS timezone;
+
+ALTER TABLE workout DROP COLUMN IF EXISTS recurrence;
+ALTER TABLE workout DROP COLUMN IF EXISTS scheduled_dates;
+ALTER TABLE workout DROP COLUMN IF EXISTS position;
@@ -0,0 +1,5 @@
+ALTER TABLE workout ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0;
+ALTER TABLE workout ADD COLUMN IF NOT EXISTS scheduled_dates DATE[] NOT NULL DEFAULT '{}';
+ALTER TABLE workout ADD COLUMN IF NOT EXISTS recurrence TEXT;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS timezone TEXT NOT NULL DEFAULT 'UTC';
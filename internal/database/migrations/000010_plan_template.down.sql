@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_training_plan_template;
+
+DELETE FROM training_plan WHERE is_template;
+
+ALTER TABLE training_plan DROP CONSTRAINT IF EXISTS training_plan_template_user;
+ALTER TABLE training_plan DROP COLUMN IF EXISTS public;
+ALTER TABLE training_plan DROP COLUMN IF EXISTS owner_id;
+ALTER TABLE training_plan DROP COLUMN IF EXISTS is_template;
+ALTER TABLE training_plan ALTER COLUMN user_id SET NOT NULL;
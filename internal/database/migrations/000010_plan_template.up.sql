@@ -0,0 +1,11 @@
+ALTER TABLE training_plan ALTER COLUMN user_id DROP NOT NULL;
+ALTER TABLE training_plan ADD COLUMN IF NOT EXISTS is_template BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE training_plan ADD COLUMN IF NOT EXISTS owner_id UUID REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE training_plan ADD COLUMN IF NOT EXISTS public BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Plans belong to an athlete, templates to nobody.
+ALTER TABLE training_plan ADD CONSTRAINT training_plan_template_user
+    CHECK ((is_template AND user_id IS NULL) OR (NOT is_template AND user_id IS NOT NULL));
+
+CREATE INDEX IF NOT EXISTS idx_training_plan_template
+    ON training_plan (owner_id, public) WHERE is_template AND deleted_at IS NULL;
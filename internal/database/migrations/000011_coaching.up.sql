@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS coach_athlete (
+    id UUID PRIMARY KEY,
+    coach_id UUID NOT NULL,
+    athlete_id UUID NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    accepted_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    FOREIGN KEY (coach_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (athlete_id) REFERENCES users(id) ON DELETE CASCADE,
+    CHECK (coach_id <> athlete_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_coach_athlete_open
+    ON coach_athlete (coach_id, athlete_id) WHERE status IN ('pending', 'active');
+CREATE INDEX IF NOT EXISTS idx_coach_athlete_athlete ON coach_athlete (athlete_id);
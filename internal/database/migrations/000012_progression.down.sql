@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS progression_change;
+DROP TABLE IF EXISTS progression_rule;
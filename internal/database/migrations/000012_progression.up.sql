@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS progression_rule (
+    id UUID PRIMARY KEY,
+    exercise_id UUID NOT NULL UNIQUE,
+    kind TEXT NOT NULL,
+    increment DECIMAL(10, 2) NOT NULL DEFAULT 2.5,
+    min_reps INT,
+    max_reps INT,
+    percentage DECIMAL(5, 4),
+    deload_after INT NOT NULL DEFAULT 2,
+    deload_percentage DECIMAL(5, 4) NOT NULL DEFAULT 0.1,
+    misses INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (exercise_id) REFERENCES exercise(id) ON DELETE CASCADE,
+    CHECK (kind IN ('linear', 'double_progression', 'percentage_of_one_rep_max')),
+    CHECK (min_reps IS NULL OR max_reps IS NULL OR min_reps <= max_reps)
+);
+
+CREATE TABLE IF NOT EXISTS progression_change (
+    id UUID PRIMARY KEY,
+    rule_id UUID NOT NULL,
+    exercise_id UUID NOT NULL,
+    session_id UUID NOT NULL,
+    outcome TEXT NOT NULL,
+    previous_weight DECIMAL(10, 2) NOT NULL,
+    new_weight DECIMAL(10, 2) NOT NULL,
+    previous_reps INT NOT NULL,
+    new_reps INT NOT NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (rule_id) REFERENCES progression_rule(id) ON DELETE CASCADE,
+    FOREIGN KEY (exercise_id) REFERENCES exercise(id) ON DELETE CASCADE,
+    FOREIGN KEY (session_id) REFERENCES workout_session(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_progression_change_exercise
+    ON progression_change (exercise_id, created_at DESC);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_workout_session_import;
+DROP INDEX IF EXISTS idx_training_plan_import;
+
+ALTER TABLE workout_session DROP COLUMN IF EXISTS import_key;
+ALTER TABLE workout_session DROP COLUMN IF EXISTS import_source;
+
+ALTER TABLE training_plan DROP COLUMN IF EXISTS import_source;
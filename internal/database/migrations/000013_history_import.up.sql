@@ -0,0 +1,10 @@
+ALTER TABLE training_plan ADD COLUMN IF NOT EXISTS import_source TEXT;
+
+ALTER TABLE workout_session ADD COLUMN IF NOT EXISTS import_source TEXT;
+ALTER TABLE workout_session ADD COLUMN IF NOT EXISTS import_key TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_training_plan_import
+    ON training_plan (user_id, import_source) WHERE import_source IS NOT NULL AND deleted_at IS NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_workout_session_import
+    ON workout_session (user_id, import_source, import_key) WHERE import_key IS NOT NULL;
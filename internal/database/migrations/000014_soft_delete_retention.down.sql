@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_exercise_set_deleted_at;
+DROP INDEX IF EXISTS idx_exercise_deleted_at;
+DROP INDEX IF EXISTS idx_workout_deleted_at;
+DROP INDEX IF EXISTS idx_training_plan_deleted_at;
+DROP INDEX IF EXISTS idx_users_deleted_at;
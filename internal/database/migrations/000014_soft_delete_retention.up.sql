@@ -0,0 +1,23 @@
+-- Cascade the deletions made before deletes cascaded, so that the plans of
+-- deleted users stop showing up.
+UPDATE training_plan tp SET deleted_at = u.deleted_at
+FROM users u
+WHERE u.id = tp.user_id AND u.deleted_at IS NOT NULL AND tp.deleted_at IS NULL;
+
+UPDATE workout w SET deleted_at = tp.deleted_at
+FROM training_plan tp
+WHERE tp.id = w.training_plan_id AND tp.deleted_at IS NOT NULL AND w.deleted_at IS NULL;
+
+UPDATE exercise e SET deleted_at = w.deleted_at
+FROM workout w
+WHERE w.id = e.workout_id AND w.deleted_at IS NOT NULL AND e.deleted_at IS NULL;
+
+UPDATE exercise_set es SET deleted_at = e.deleted_at
+FROM exercise e
+WHERE e.id = es.exercise_id AND e.deleted_at IS NOT NULL AND es.deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_training_plan_deleted_at ON training_plan (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_workout_deleted_at ON workout (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_exercise_deleted_at ON exercise (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_exercise_set_deleted_at ON exercise_set (deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE exercise DROP COLUMN IF EXISTS version;
+ALTER TABLE workout DROP COLUMN IF EXISTS version;
+ALTER TABLE training_plan DROP COLUMN IF EXISTS version;
+ALTER TABLE users DROP COLUMN IF EXISTS version;
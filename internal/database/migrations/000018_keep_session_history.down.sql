@@ -0,0 +1,5 @@
+DELETE FROM workout_session WHERE workout_id IS NULL;
+ALTER TABLE workout_session DROP CONSTRAINT IF EXISTS workout_session_workout_id_fkey;
+ALTER TABLE workout_session ADD CONSTRAINT workout_session_workout_id_fkey
+    FOREIGN KEY (workout_id) REFERENCES workout(id) ON DELETE CASCADE;
+ALTER TABLE workout_session ALTER COLUMN workout_id SET NOT NULL;
@@ -0,0 +1,6 @@
+-- Sessions are performed history: purging the workout they were logged
+-- against must keep them, only unlinked from the plan.
+ALTER TABLE workout_session ALTER COLUMN workout_id DROP NOT NULL;
+ALTER TABLE workout_session DROP CONSTRAINT IF EXISTS workout_session_workout_id_fkey;
+ALTER TABLE workout_session ADD CONSTRAINT workout_session_workout_id_fkey
+    FOREIGN KEY (workout_id) REFERENCES workout(id) ON DELETE SET NULL;
@@ -0,0 +1,180 @@
+package events
+
+import (
+	"be/internal/database/db"
+	"be/internal/utils"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"goa.design/clue/log"
+)
+
+const (
+	statusPending   = "pending"
+	statusDelivered = "delivered"
+	statusFailed    = "failed"
+)
+
+// Dispatcher polls the outbox and delivers pending events to its sinks,
+// retrying failed deliveries with exponential backoff.
+type Dispatcher struct {
+	DB           *sql.DB
+	Sinks        []Sink
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+}
+
+func NewDispatcher(sinks ...Sink) *Dispatcher {
+	return &Dispatcher{
+		DB:           db.DB.LD,
+		Sinks:        sinks,
+		PollInterval: 2 * time.Second,
+		BatchSize:    50,
+		MaxAttempts:  10,
+		BaseBackoff:  time.Second,
+		MaxBackoff:   10 * time.Minute,
+	}
+}
+
+// Start runs the dispatcher in a goroutine until ctx is cancelled.
+func (d *Dispatcher) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		log.Printf(ctx, "Starting outbox dispatcher")
+		d.Run(ctx)
+		log.Printf(ctx, "Outbox dispatcher stopped")
+	}()
+}
+
+// Run polls the outbox until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			n, err := d.DispatchBatch(ctx)
+			if err != nil {
+				utils.Log.Error(ctx, log.KV{K: "outbox", V: err}, err)
+				break
+			}
+			if n < d.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchBatch delivers one batch of due events and returns how many were
+// processed. Rows are locked with SKIP LOCKED so several replicas can run a
+// dispatcher against the same database.
+func (d *Dispatcher) DispatchBatch(ctx context.Context) (int, error) {
+	tx, err := d.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	query := `
+	SELECT id, event_type, aggregate_type, aggregate_id, user_id, payload, attempts, created_at
+	FROM outbox
+	WHERE status = $1 AND next_attempt_at <= NOW()
+	ORDER BY created_at
+	LIMIT $2
+	FOR UPDATE SKIP LOCKED`
+
+	rows, err := tx.QueryContext(ctx, query, statusPending, d.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var batch []Event
+	for rows.Next() {
+		var e Event
+		var payload []byte
+		if err := rows.Scan(&e.ID, &e.Type, &e.AggregateType, &e.AggregateID, &e.UserID, &payload, &e.Attempts, &e.CreatedAt); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		e.Payload = payload
+		batch = append(batch, e)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, e := range batch {
+		if err := d.deliver(ctx, e); err != nil {
+			if err := d.markFailed(ctx, tx, e, err); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE outbox SET status = $2, attempts = attempts + 1, last_error = NULL, delivered_at = NOW() WHERE id = $1`,
+			e.ID, statusDelivered); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(batch), nil
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, e Event) error {
+	var errs []string
+	for _, sink := range d.Sinks {
+		if err := sink.Deliver(ctx, e); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", sink.Name(), err))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (d *Dispatcher) markFailed(ctx context.Context, tx *sql.Tx, e Event, cause error) error {
+	attempts := e.Attempts + 1
+	status := statusPending
+	if attempts >= d.MaxAttempts {
+		status = statusFailed
+	}
+	utils.Log.Error(ctx, log.KV{K: "event", V: e.ID.String()}, cause)
+
+	_, err := tx.ExecContext(ctx,
+		`UPDATE outbox SET status = $2, attempts = $3, last_error = $4, next_attempt_at = $5 WHERE id = $1`,
+		e.ID, status, attempts, cause.Error(), time.Now().Add(d.backoff(attempts)))
+	return err
+}
+
+// backoff returns the delay before the given attempt, doubling from
+// BaseBackoff up to MaxBackoff.
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.BaseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= d.MaxBackoff {
+			return d.MaxBackoff
+		}
+	}
+	return delay
+}
@@ -0,0 +1,93 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Type identifies a domain event.
+type Type string
+
+const (
+	UserCreated  Type = "UserCreated"
+	UserUpdated  Type = "UserUpdated"
+	UserDeleted  Type = "UserDeleted"
+	UserRestored Type = "UserRestored"
+
+	TrainingPlanCreated  Type = "TrainingPlanCreated"
+	TrainingPlanUpdated  Type = "TrainingPlanUpdated"
+	TrainingPlanDeleted  Type = "TrainingPlanDeleted"
+	TrainingPlanRestored Type = "TrainingPlanRestored"
+
+	WorkoutUpdated Type = "WorkoutUpdated"
+
+	SetLogged Type = "SetLogged"
+
+	WorkoutSessionStarted   Type = "WorkoutSessionStarted"
+	WorkoutSessionFinished  Type = "WorkoutSessionFinished"
+	WorkoutSessionAbandoned Type = "WorkoutSessionAbandoned"
+
+	PersonalRecordAchieved Type = "PersonalRecordAchieved"
+
+	AthleteInvited  Type = "AthleteInvited"
+	CoachingStarted Type = "CoachingStarted"
+	CoachingRevoked Type = "CoachingRevoked"
+
+	ProgressionApplied Type = "ProgressionApplied"
+
+	HistoryImported Type = "HistoryImported"
+)
+
+// Event is a domain event stored in the outbox and delivered to the sinks.
+type Event struct {
+	ID            uuid.UUID
+	Type          Type
+	AggregateType string
+	AggregateID   uuid.UUID
+	UserID        *uuid.UUID // owner of the aggregate, when known
+	Payload       json.RawMessage
+	Attempts      int
+	CreatedAt     time.Time
+}
+
+// New builds an event for the given aggregate, encoding data as its payload.
+func New(t Type, aggregateType string, aggregateID uuid.UUID, userID *uuid.UUID, data any) (Event, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, err
+	}
+	return Event{
+		ID:            uuid.New(),
+		Type:          t,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		UserID:        userID,
+		Payload:       payload,
+		CreatedAt:     time.Now(),
+	}, nil
+}
+
+// Record writes the event to the outbox using tx, so that it is committed
+// atomically with the repository write that produced it.
+func Record(ctx context.Context, tx *sql.Tx, e Event) error {
+	query := `
+	INSERT INTO outbox (id, event_type, aggregate_type, aggregate_id, user_id, payload, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := tx.ExecContext(ctx, query,
+		e.ID, e.Type, e.AggregateType, e.AggregateID, e.UserID, []byte(e.Payload), e.CreatedAt)
+	return err
+}
+
+// Emit is a shorthand for New followed by Record.
+func Emit(ctx context.Context, tx *sql.Tx, t Type, aggregateType string, aggregateID uuid.UUID, userID *uuid.UUID, data any) error {
+	e, err := New(t, aggregateType, aggregateID, userID, data)
+	if err != nil {
+		return err
+	}
+	return Record(ctx, tx, e)
+}
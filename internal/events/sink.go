@@ -0,0 +1,40 @@
+package events
+
+import (
+	"be/internal/utils"
+	"context"
+
+	"goa.design/clue/log"
+)
+
+// Sink receives the events delivered by the Dispatcher. Deliver must be
+// idempotent: an event is redelivered to every sink when any of them fails.
+type Sink interface {
+	Name() string
+	Deliver(ctx context.Context, e Event) error
+}
+
+// LogSink writes every event to the application log.
+type LogSink struct{}
+
+func (LogSink) Name() string { return "log" }
+
+func (LogSink) Deliver(ctx context.Context, e Event) error {
+	utils.Log.Info(ctx, log.KV{K: "event", V: map[string]any{
+		"id":            e.ID.String(),
+		"type":          e.Type,
+		"aggregateType": e.AggregateType,
+		"aggregateId":   e.AggregateID.String(),
+	}})
+	return nil
+}
+
+// SinkFunc adapts a function to the Sink interface.
+type SinkFunc struct {
+	SinkName string
+	Fn       func(ctx context.Context, e Event) error
+}
+
+func (s SinkFunc) Name() string { return s.SinkName }
+
+func (s SinkFunc) Deliver(ctx context.Context, e Event) error { return s.Fn(ctx, e) }
@@ -0,0 +1,39 @@
+package analytics
+
+import "fmt"
+
+const (
+	FormulaEpley   = "epley"
+	FormulaBrzycki = "brzycki"
+)
+
+// EstimateOneRepMax returns the estimated one-rep max of a set of reps at
+// weight. A single rep is its own max; Brzycki is undefined from 37 reps on,
+// in which case ok is false.
+func EstimateOneRepMax(formula string, weight float64, reps int) (estimate float64, ok bool) {
+	switch {
+	case reps <= 0:
+		return 0, false
+	case reps == 1:
+		return weight, true
+	}
+
+	switch formula {
+	case FormulaBrzycki:
+		if reps >= 37 {
+			return 0, false
+		}
+		return weight * 36 / float64(37-reps), true
+	default:
+		return weight * (1 + float64(reps)/30), true
+	}
+}
+
+// oneRepMaxSQL is the SQL counterpart of EstimateOneRepMax over the given
+// weight and reps columns. It yields NULL where the estimate is undefined.
+func oneRepMaxSQL(formula, weight, reps string) string {
+	if formula == FormulaBrzycki {
+		return fmt.Sprintf(`CASE WHEN %[2]s = 1 THEN %[1]s WHEN %[2]s < 37 THEN %[1]s * 36.0 / (37 - %[2]s) END`, weight, reps)
+	}
+	return fmt.Sprintf(`CASE WHEN %[2]s = 1 THEN %[1]s ELSE %[1]s * (1 + %[2]s / 30.0) END`, weight, reps)
+}
@@ -0,0 +1,164 @@
+package analytics
+
+import (
+	"be/internal/database/db"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OneRepMaxPoint struct {
+	Bucket             time.Time
+	EstimatedOneRepMax float64
+	RelativeIntensity  float64
+	Sets               int
+}
+
+type TonnagePoint struct {
+	Bucket      time.Time
+	SessionID   *uuid.UUID
+	Tonnage     float64
+	Sets        int
+	Reps        int
+	AverageLoad float64
+}
+
+type MuscleVolumePoint struct {
+	Bucket      time.Time
+	MuscleGroup string
+	Sets        int
+	Tonnage     float64
+}
+
+type Repository struct {
+	DB *sql.DB
+}
+
+func NewRepository() *Repository {
+	return &Repository{DB: db.DB.LD}
+}
+
+// performedSets selects the completed sets of a user's sessions, with the
+// session start time as ws.started_at and the exercise type as et. $1 is the user, $2 and $3 the
+// optional time range.
+const performedSets = `
+	FROM session_set ss
+	JOIN session_exercise se ON se.id = ss.session_exercise_id
+	JOIN workout_session ws ON ws.id = se.session_id
+	LEFT JOIN exercise_type et ON et.id = se.exercise_type_id
+	WHERE ws.user_id = $1
+	AND ss.completed
+	AND ss.weight IS NOT NULL
+	AND ss.reps > 0
+	AND ($2::timestamptz IS NULL OR ws.started_at >= $2)
+	AND ($3::timestamptz IS NULL OR ws.started_at < $3)`
+
+func (r *Repository) OneRepMax(ctx context.Context, userID, exerciseTypeID uuid.UUID, formula, bucket string, from, to *string) ([]OneRepMaxPoint, error) {
+	query := fmt.Sprintf(`
+	WITH estimates AS (
+		SELECT date_trunc($5, ws.started_at) AS bucket, ss.weight, %s AS estimate
+		%s
+		AND se.exercise_type_id = $4
+	)
+	SELECT bucket, MAX(estimate), AVG(weight) / NULLIF(MAX(estimate), 0), COUNT(*)
+	FROM estimates
+	WHERE estimate IS NOT NULL
+	GROUP BY bucket
+	ORDER BY bucket`, oneRepMaxSQL(formula, "ss.weight", "ss.reps"), performedSets)
+
+	rows, err := r.DB.QueryContext(ctx, query, userID, from, to, exerciseTypeID, bucket)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var points []OneRepMaxPoint
+	for rows.Next() {
+		var p OneRepMaxPoint
+		var intensity sql.NullFloat64
+		if err := rows.Scan(&p.Bucket, &p.EstimatedOneRepMax, &intensity, &p.Sets); err != nil {
+			return nil, err
+		}
+		p.RelativeIntensity = intensity.Float64
+		points = append(points, p)
+	}
+	return points, rows.Err()
+}
+
+// Tonnage aggregates weight x reps per time bucket, or per session when
+// bucket is "session".
+func (r *Repository) Tonnage(ctx context.Context, userID uuid.UUID, exerciseTypeID *uuid.UUID, bucket string, from, to *string) ([]TonnagePoint, error) {
+	key, groupBy := "date_trunc($5, ws.started_at)", "1"
+	if bucket == "session" {
+		key, groupBy = "ws.started_at, ws.id", "1, 2"
+	}
+
+	query := fmt.Sprintf(`
+	SELECT %[1]s, SUM(ss.weight * ss.reps), COUNT(*), SUM(ss.reps)
+	%[2]s
+	AND ($4::uuid IS NULL OR se.exercise_type_id = $4)
+	GROUP BY %[3]s
+	ORDER BY 1`, key, performedSets, groupBy)
+
+	args := []any{userID, from, to, exerciseTypeID}
+	if bucket != "session" {
+		args = append(args, bucket)
+	}
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var points []TonnagePoint
+	for rows.Next() {
+		var p TonnagePoint
+		dest := []any{&p.Bucket}
+		if bucket == "session" {
+			p.SessionID = new(uuid.UUID)
+			dest = append(dest, p.SessionID)
+		}
+		dest = append(dest, &p.Tonnage, &p.Sets, &p.Reps)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		if p.Reps > 0 {
+			p.AverageLoad = p.Tonnage / float64(p.Reps)
+		}
+		points = append(points, p)
+	}
+	return points, rows.Err()
+}
+
+// MuscleVolume aggregates sets and tonnage per muscle group of the
+// exercise-type catalog. Sets without a muscle group are reported as
+// "unassigned".
+func (r *Repository) MuscleVolume(ctx context.Context, userID uuid.UUID, bucket string, from, to *string) ([]MuscleVolumePoint, error) {
+	query := fmt.Sprintf(`
+	SELECT date_trunc($4, ws.started_at) AS bucket,
+		COALESCE(et.muscle_group, 'unassigned') AS muscle_group,
+		COUNT(*), SUM(ss.weight * ss.reps)
+	%s
+	GROUP BY 1, 2
+	ORDER BY 1, 2`, performedSets)
+
+	rows, err := r.DB.QueryContext(ctx, query, userID, from, to, bucket)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var points []MuscleVolumePoint
+	for rows.Next() {
+		var p MuscleVolumePoint
+		if err := rows.Scan(&p.Bucket, &p.MuscleGroup, &p.Sets, &p.Tonnage); err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+	return points, rows.Err()
+}
@@ -0,0 +1,167 @@
+package analytics
+
+import (
+	analyticsService "be/gen/analytics"
+	"be/internal/features/coaching"
+	"be/internal/features/user"
+	"be/internal/middleware"
+	"be/internal/utils"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+type Service struct {
+	Repository *Repository
+	users      *user.Repository
+	access     *coaching.Authorizer
+}
+
+func NewService() *Service {
+	return &Service{
+		Repository: NewRepository(),
+		users:      user.NewRepository(),
+		access:     coaching.NewAuthorizer(),
+	}
+}
+
+func (s *Service) OAuth2Auth(ctx context.Context, token string, scheme *security.OAuth2Scheme) (context.Context, error) {
+	claims, err := middleware.ValidateToken(token)
+	if err != nil {
+		return ctx, &analyticsService.Unauthorized{Message: "Invalid token"}
+	}
+	ctx = context.WithValue(ctx, middleware.ClaimsKey, claims)
+
+	return ctx, nil
+}
+
+// athlete resolves the user whose sets are analysed: the caller by default,
+// or the requested user when the caller may access their data.
+func (s *Service) athlete(ctx context.Context, userID *string) (uuid.UUID, error) {
+	u, err := s.users.FindCurrent(ctx)
+	if err != nil {
+		return uuid.Nil, &analyticsService.Forbidden{Message: "Forbidden"}
+	}
+	if userID == nil {
+		return u.ID, nil
+	}
+
+	id, err := uuid.Parse(*userID)
+	if err != nil {
+		return uuid.Nil, badRequest("invalid_id", "invalid user ID")
+	}
+	ok, err := s.access.CanAccess(ctx, u.ID, u.Admin, id)
+	if err != nil {
+		return uuid.Nil, internalError(ctx, err)
+	}
+	if !ok {
+		return uuid.Nil, &analyticsService.Forbidden{Message: "Forbidden"}
+	}
+	return id, nil
+}
+
+func (s *Service) OneRepMax(ctx context.Context, payload *analyticsService.OneRepMaxPayload) ([]*analyticsService.OneRepMaxPoint, error) {
+	userID, err := s.athlete(ctx, payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	exerciseTypeID, err := uuid.Parse(payload.ExerciseTypeID)
+	if err != nil {
+		return nil, badRequest("invalid_id", "invalid exercise type ID")
+	}
+
+	points, err := s.Repository.OneRepMax(ctx, userID, exerciseTypeID, payload.Formula, payload.Bucket, payload.From, payload.To)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+
+	res := []*analyticsService.OneRepMaxPoint{}
+	for _, p := range points {
+		res = append(res, &analyticsService.OneRepMaxPoint{
+			Bucket:             p.Bucket.Format(time.RFC3339),
+			EstimatedOneRepMax: p.EstimatedOneRepMax,
+			RelativeIntensity:  p.RelativeIntensity,
+			Sets:               p.Sets,
+		})
+	}
+	return res, nil
+}
+
+func (s *Service) Tonnage(ctx context.Context, payload *analyticsService.TonnagePayload) ([]*analyticsService.TonnagePoint, error) {
+	userID, err := s.athlete(ctx, payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var exerciseTypeID *uuid.UUID
+	if payload.ExerciseTypeID != nil {
+		id, err := uuid.Parse(*payload.ExerciseTypeID)
+		if err != nil {
+			return nil, badRequest("invalid_id", "invalid exercise type ID")
+		}
+		exerciseTypeID = &id
+	}
+
+	points, err := s.Repository.Tonnage(ctx, userID, exerciseTypeID, payload.Bucket, payload.From, payload.To)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+
+	res := []*analyticsService.TonnagePoint{}
+	for _, p := range points {
+		point := &analyticsService.TonnagePoint{
+			Bucket:      p.Bucket.Format(time.RFC3339),
+			Tonnage:     p.Tonnage,
+			Sets:        p.Sets,
+			Reps:        p.Reps,
+			AverageLoad: p.AverageLoad,
+		}
+		if p.SessionID != nil {
+			sessionID := p.SessionID.String()
+			point.SessionID = &sessionID
+		}
+		res = append(res, point)
+	}
+	return res, nil
+}
+
+func (s *Service) MuscleVolume(ctx context.Context, payload *analyticsService.MuscleVolumePayload) ([]*analyticsService.MuscleVolumePoint, error) {
+	userID, err := s.athlete(ctx, payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := s.Repository.MuscleVolume(ctx, userID, payload.Bucket, payload.From, payload.To)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+
+	res := []*analyticsService.MuscleVolumePoint{}
+	for _, p := range points {
+		res = append(res, &analyticsService.MuscleVolumePoint{
+			Bucket:      p.Bucket.Format(time.RFC3339),
+			MuscleGroup: p.MuscleGroup,
+			Sets:        p.Sets,
+			Tonnage:     p.Tonnage,
+		})
+	}
+	return res, nil
+}
+
+func internalError(ctx context.Context, err error) error {
+	utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+	return &analyticsService.InternalServerError{Message: "Internal Server error"}
+}
+
+func badRequest(name, message string) *analyticsService.BadRequest {
+	return &analyticsService.BadRequest{
+		Name:    name,
+		ID:      goa.NewErrorID(),
+		Message: message,
+	}
+}
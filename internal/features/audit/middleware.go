@@ -0,0 +1,210 @@
+package audit
+
+import (
+	"be/internal/middleware"
+	"be/internal/utils"
+	"context"
+	"database/sql"
+	"reflect"
+
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+	goamiddleware "goa.design/goa/v3/middleware"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Snapshotter loads the current state of a resource so that the audit log can
+// record what it looked like before and after an operation.
+type Snapshotter func(ctx context.Context, id string) (any, error)
+
+// mutatingMethods lists the Goa methods that are recorded in the audit log.
+var mutatingMethods = map[string]bool{
+	"create":         true,
+	"update":         true,
+	"patch":          true,
+	"delete":         true,
+	"restore":        true,
+	"clone":          true,
+	"createTemplate": true,
+	"import":         true,
+}
+
+// copyMethods create a new resource from the one named by the ID of their
+// request; their entry is about the new resource.
+var copyMethods = map[string]bool{
+	"clone":          true,
+	"createTemplate": true,
+}
+
+// pendingKey is the context key of the entry of the call in progress.
+type pendingKey struct{}
+
+// pending is the entry of a mutating call, written by Record in the
+// transaction of the change.
+type pending struct {
+	entry    Entry
+	recorded bool
+}
+
+// Endpoint returns a Goa endpoint middleware that records every successful
+// mutating call of the service in the audit log. The repositories write the
+// entry with Record in the transaction of the change; the snapshot function,
+// when given, is used to capture the resource state around the call.
+func Endpoint(snapshot Snapshotter) func(goa.Endpoint) goa.Endpoint {
+	repo := NewRepository()
+	return func(e goa.Endpoint) goa.Endpoint {
+		return func(ctx context.Context, req any) (any, error) {
+			method, _ := ctx.Value(goa.MethodKey).(string)
+			if !mutatingMethods[method] {
+				return e(ctx, req)
+			}
+			resource, _ := ctx.Value(goa.ServiceKey).(string)
+
+			var resourceID string
+			if !copyMethods[method] {
+				resourceID = stringField(req, "ID")
+			}
+			var before map[string]any
+			if snapshot != nil && resourceID != "" {
+				before = takeSnapshot(ctx, snapshot, resourceID)
+			}
+
+			p := &pending{entry: Entry{
+				ID:        uuid.New(),
+				Resource:  resource,
+				Method:    method,
+				Before:    before,
+				RequestID: requestID(ctx),
+			}}
+			if resourceID != "" {
+				p.entry.ResourceID = &resourceID
+			}
+
+			res, err := e(context.WithValue(ctx, pendingKey{}, p), req)
+			if err != nil {
+				return res, err
+			}
+
+			if resourceID == "" && p.entry.ResourceID != nil {
+				resourceID = *p.entry.ResourceID
+			}
+			if resourceID == "" {
+				resourceID = stringField(res, "ID")
+			}
+			var after map[string]any
+			if snapshot != nil && resourceID != "" && method != "delete" {
+				after = takeSnapshot(ctx, snapshot, resourceID)
+			}
+			diff := Diff(before, after)
+
+			if p.recorded {
+				if after != nil || diff != nil {
+					if err := repo.Complete(ctx, p.entry.ID, after, diff); err != nil {
+						utils.Log.Error(ctx, log.KV{K: "audit", V: err}, err)
+					}
+				}
+				return res, nil
+			}
+
+			// The change went through no audited repository write, e.g. it
+			// only reached Keycloak: record it on its own.
+			entry := p.entry
+			entry.After, entry.Diff = after, diff
+			if resourceID != "" {
+				entry.ResourceID = &resourceID
+			}
+			if _, err := repo.Save(ctx, entry); err != nil {
+				utils.Log.Error(ctx, log.KV{K: "audit", V: err}, err)
+			}
+
+			return res, nil
+		}
+	}
+}
+
+// Record writes the entry of the mutating call in progress using tx, so
+// that it is committed atomically with the change, like the outbox events.
+// The actor is the subject of the claims the service authorized the call
+// with. Record does nothing outside an audited call and after the entry was
+// written.
+func Record(ctx context.Context, tx *sql.Tx, resourceID uuid.UUID) error {
+	p, ok := ctx.Value(pendingKey{}).(*pending)
+	if !ok || p.recorded {
+		return nil
+	}
+	p.entry.Actor, _ = middleware.SubjectFromContext(ctx)
+	if p.entry.ResourceID == nil && resourceID != uuid.Nil {
+		id := resourceID.String()
+		p.entry.ResourceID = &id
+	}
+	if _, err := insert(ctx, tx, p.entry); err != nil {
+		return err
+	}
+	p.recorded = true
+	return nil
+}
+
+// Diff returns the fields whose value differs between before and after, each
+// mapped to its {"before", "after"} pair.
+func Diff(before, after map[string]any) map[string]any {
+	diff := make(map[string]any)
+	for k, b := range before {
+		a, ok := after[k]
+		if !ok || !reflect.DeepEqual(a, b) {
+			diff[k] = map[string]any{"before": b, "after": a}
+		}
+	}
+	for k, a := range after {
+		if _, ok := before[k]; !ok {
+			diff[k] = map[string]any{"before": nil, "after": a}
+		}
+	}
+	if len(diff) == 0 {
+		return nil
+	}
+	return diff
+}
+
+func takeSnapshot(ctx context.Context, snapshot Snapshotter, id string) map[string]any {
+	v, err := snapshot(ctx, id)
+	if err != nil || v == nil {
+		return nil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil
+	}
+	return utils.Data.StructToKVMap(v)
+}
+
+func requestID(ctx context.Context) *string {
+	if id, ok := ctx.Value(goamiddleware.RequestIDKey).(string); ok && id != "" {
+		return &id
+	}
+	return nil
+}
+
+// stringField returns the value of the named string or *string field of the
+// struct pointed to by v, or "" when there is no such field.
+func stringField(v any, name string) string {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return ""
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return ""
+	}
+
+	f := rv.FieldByName(name)
+	switch {
+	case !f.IsValid():
+		return ""
+	case f.Kind() == reflect.String:
+		return f.String()
+	case f.Kind() == reflect.Ptr && !f.IsNil() && f.Elem().Kind() == reflect.String:
+		return f.Elem().String()
+	}
+	return ""
+}
@@ -0,0 +1,159 @@
+package audit
+
+import (
+	"be/internal/database/db"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Entry struct {
+	ID         uuid.UUID
+	Actor      string
+	Resource   string
+	Method     string
+	ResourceID *string
+	Before     map[string]any
+	After      map[string]any
+	Diff       map[string]any
+	RequestID  *string
+	CreatedAt  time.Time
+}
+
+type Repository struct {
+	DB *sql.DB
+}
+
+func NewRepository() *Repository {
+	return &Repository{DB: db.DB.LD}
+}
+
+// execer is implemented by *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func (r *Repository) Save(ctx context.Context, e Entry) (*Entry, error) {
+	return insert(ctx, r.DB, e)
+}
+
+func insert(ctx context.Context, ex execer, e Entry) (*Entry, error) {
+	query := `
+	INSERT INTO audit_log (id, actor, resource, method, resource_id, before, after, diff, request_id, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now()
+	}
+
+	before, err := marshalJSON(e.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := marshalJSON(e.After)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := marshalJSON(e.Diff)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ex.ExecContext(ctx, query,
+		e.ID, e.Actor, e.Resource, e.Method, e.ResourceID, before, after, diff, e.RequestID, e.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &e, nil
+}
+
+// Complete sets the state after the operation of an entry recorded in its
+// transaction.
+func (r *Repository) Complete(ctx context.Context, id uuid.UUID, after, diff map[string]any) error {
+	afterJSON, err := marshalJSON(after)
+	if err != nil {
+		return err
+	}
+	diffJSON, err := marshalJSON(diff)
+	if err != nil {
+		return err
+	}
+	_, err = r.DB.ExecContext(ctx, `UPDATE audit_log SET after = $2, diff = $3 WHERE id = $1`, id, afterJSON, diffJSON)
+	return err
+}
+
+func (r *Repository) List(ctx context.Context, limit, offset int, actor, resource, resourceID, from, to *string) ([]Entry, error) {
+	query := `
+	SELECT id, actor, resource, method, resource_id, before, after, diff, request_id, created_at
+	FROM audit_log
+	WHERE ($3::text IS NULL OR actor = $3)
+	  AND ($4::text IS NULL OR resource = $4)
+	  AND ($5::text IS NULL OR resource_id = $5)
+	  AND ($6::timestamp IS NULL OR created_at >= $6)
+	  AND ($7::timestamp IS NULL OR created_at < $7)
+	ORDER BY created_at DESC
+	LIMIT $1 OFFSET $2`
+
+	rows, err := r.DB.QueryContext(ctx, query, limit, offset, actor, resource, resourceID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []Entry
+	for rows.Next() {
+		var (
+			e                   Entry
+			before, after, diff []byte
+		)
+		err := rows.Scan(&e.ID, &e.Actor, &e.Resource, &e.Method, &e.ResourceID, &before, &after, &diff, &e.RequestID, &e.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if e.Before, err = unmarshalJSON(before); err != nil {
+			return nil, err
+		}
+		if e.After, err = unmarshalJSON(after); err != nil {
+			return nil, err
+		}
+		if e.Diff, err = unmarshalJSON(diff); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// IsAdmin tells whether the user of the Keycloak ID kcID is an admin. The
+// audit log is a dependency of the users, it reads the table directly.
+func (r *Repository) IsAdmin(ctx context.Context, kcID string) (bool, error) {
+	var admin bool
+	err := r.DB.QueryRowContext(ctx, `SELECT admin FROM users WHERE kc_id = $1 AND deleted_at IS NULL`, kcID).Scan(&admin)
+	return admin, err
+}
+
+// marshalJSON encodes m for a JSONB column, keeping nil maps as SQL NULL.
+func marshalJSON(m map[string]any) ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return json.Marshal(m)
+}
+
+func unmarshalJSON(b []byte) (map[string]any, error) {
+	if b == nil {
+		return nil, nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,76 @@
+package audit
+
+import (
+	auditService "be/gen/audit"
+	"be/internal/middleware"
+	"be/internal/utils"
+	"context"
+	"time"
+
+	"goa.design/clue/log"
+	"goa.design/goa/v3/security"
+)
+
+type Service struct {
+	Repository *Repository
+}
+
+func NewService() *Service {
+	return &Service{
+		Repository: NewRepository(),
+	}
+}
+
+func (s *Service) OAuth2Auth(ctx context.Context, token string, scheme *security.OAuth2Scheme) (context.Context, error) {
+	claims, err := middleware.ValidateToken(token)
+	if err != nil {
+		return ctx, &auditService.Unauthorized{Message: "Invalid token"}
+	}
+	ctx = context.WithValue(ctx, middleware.ClaimsKey, claims)
+
+	return ctx, nil
+}
+
+// requireAdmin ensures the caller is a registered admin user.
+func (s *Service) requireAdmin(ctx context.Context) error {
+	sub, ok := middleware.SubjectFromContext(ctx)
+	if !ok {
+		return &auditService.Unauthorized{Message: "Invalid token"}
+	}
+
+	admin, err := s.Repository.IsAdmin(ctx, sub)
+	if err != nil || !admin {
+		return &auditService.Forbidden{Message: "Forbidden"}
+	}
+	return nil
+}
+
+func (s *Service) List(ctx context.Context, payload *auditService.ListPayload) ([]*auditService.AuditEntry, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.Repository.List(ctx, payload.Limit, payload.Offset,
+		payload.Actor, payload.Resource, payload.ResourceID, payload.From, payload.To)
+	if err != nil {
+		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+		return nil, &auditService.InternalServerError{Message: "Internal Server error"}
+	}
+
+	var res []*auditService.AuditEntry
+	for _, e := range entries {
+		res = append(res, &auditService.AuditEntry{
+			ID:         e.ID.String(),
+			Actor:      e.Actor,
+			Resource:   e.Resource,
+			Method:     e.Method,
+			ResourceID: e.ResourceID,
+			Before:     e.Before,
+			After:      e.After,
+			Diff:       e.Diff,
+			RequestID:  e.RequestID,
+			CreatedAt:  e.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,30 @@
+package coaching
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Authorizer decides whether a caller may read and edit the resources
+// (plans, sessions, analytics) owned by a user: their own, anyone's for
+// admins, and their active athletes' for coaches.
+type Authorizer struct {
+	repo *Repository
+}
+
+func NewAuthorizer() *Authorizer {
+	return &Authorizer{repo: NewRepository()}
+}
+
+func (a *Authorizer) CanAccess(ctx context.Context, callerID uuid.UUID, admin bool, ownerID uuid.UUID) (bool, error) {
+	if admin || callerID == ownerID {
+		return true, nil
+	}
+	return a.repo.IsCoachOf(ctx, callerID, ownerID)
+}
+
+// Athletes returns the athletes the caller actively coaches.
+func (a *Authorizer) Athletes(ctx context.Context, coachID uuid.UUID) ([]uuid.UUID, error) {
+	return a.repo.AthleteIDs(ctx, coachID)
+}
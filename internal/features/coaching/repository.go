@@ -0,0 +1,229 @@
+package coaching
+
+import (
+	"be/internal/database/db"
+	"be/internal/events"
+	"be/internal/middleware"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	StatusPending = "pending"
+	StatusActive  = "active"
+	StatusRevoked = "revoked"
+)
+
+var (
+	ErrRelationshipNotFound = errors.New("coaching relationship not found")
+	ErrAlreadyExists        = errors.New("a pending or active relationship already exists")
+	ErrInvalidState         = errors.New("coaching relationship cannot change to this status")
+	ErrAthleteNotFound      = errors.New("athlete not found")
+)
+
+type Relationship struct {
+	ID         uuid.UUID
+	CoachID    uuid.UUID
+	AthleteID  uuid.UUID
+	Status     string
+	CreatedAt  time.Time
+	AcceptedAt sql.NullTime
+	RevokedAt  sql.NullTime
+}
+
+type Repository struct {
+	DB *sql.DB
+}
+
+func NewRepository() *Repository {
+	return &Repository{DB: db.DB.LD}
+}
+
+// Caller is the authenticated user. The user package depends on this one,
+// so the caller is resolved here rather than through the user repository.
+type Caller struct {
+	ID    uuid.UUID
+	Admin bool
+}
+
+// FindCaller resolves the user matching the JWT subject of the context.
+func (r *Repository) FindCaller(ctx context.Context) (*Caller, error) {
+	sub, ok := middleware.SubjectFromContext(ctx)
+	if !ok {
+		return nil, errors.New("missing subject")
+	}
+
+	var c Caller
+	err := r.DB.QueryRowContext(ctx, `
+	SELECT id, admin FROM users WHERE kc_id = $1 AND deleted_at IS NULL`, sub).Scan(&c.ID, &c.Admin)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+const relationshipColumns = `id, coach_id, athlete_id, status, created_at, accepted_at, revoked_at`
+
+func scanRelationship(row interface{ Scan(...any) error }, rel *Relationship) error {
+	return row.Scan(&rel.ID, &rel.CoachID, &rel.AthleteID, &rel.Status, &rel.CreatedAt, &rel.AcceptedAt, &rel.RevokedAt)
+}
+
+func (r *Repository) FindByID(ctx context.Context, id uuid.UUID) (*Relationship, error) {
+	var rel Relationship
+	err := scanRelationship(r.DB.QueryRowContext(ctx, `
+	SELECT `+relationshipColumns+` FROM coach_athlete WHERE id = $1`, id), &rel)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRelationshipNotFound
+		}
+		return nil, err
+	}
+	return &rel, nil
+}
+
+// List returns the relationships where the user is the coach or the athlete.
+func (r *Repository) List(ctx context.Context, limit, offset int, userID uuid.UUID, role, status *string) ([]Relationship, error) {
+	query := `
+	SELECT ` + relationshipColumns + `
+	FROM coach_athlete
+	WHERE (($4::text IS NULL OR $4 = 'coach') AND coach_id = $3
+	    OR ($4::text IS NULL OR $4 = 'athlete') AND athlete_id = $3)
+	  AND ($5::text IS NULL OR status = $5)
+	ORDER BY created_at DESC
+	LIMIT $1 OFFSET $2`
+
+	rows, err := r.DB.QueryContext(ctx, query, limit, offset, userID, role, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rels []Relationship
+	for rows.Next() {
+		var rel Relationship
+		if err := scanRelationship(rows, &rel); err != nil {
+			return nil, err
+		}
+		rels = append(rels, rel)
+	}
+	return rels, rows.Err()
+}
+
+// Invite creates a pending relationship between the coach and the athlete.
+func (r *Repository) Invite(ctx context.Context, coachID, athleteID uuid.UUID) (*Relationship, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, `
+	SELECT EXISTS (SELECT 1 FROM users WHERE id = $1 AND deleted_at IS NULL)`, athleteID).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrAthleteNotFound
+	}
+
+	var rel Relationship
+	err = scanRelationship(tx.QueryRowContext(ctx, `
+	INSERT INTO coach_athlete (id, coach_id, athlete_id, status)
+	VALUES ($1, $2, $3, $4)
+	RETURNING `+relationshipColumns, uuid.New(), coachID, athleteID, StatusPending), &rel)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, ErrAlreadyExists
+		}
+		return nil, err
+	}
+
+	if err := events.Emit(ctx, tx, events.AthleteInvited, "coach_athlete", rel.ID, &athleteID, rel); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &rel, nil
+}
+
+// Transition moves a relationship to the active or revoked status.
+func (r *Repository) Transition(ctx context.Context, id uuid.UUID, status string) (*Relationship, error) {
+	query := `
+	UPDATE coach_athlete
+	SET status = $2,
+		accepted_at = CASE WHEN $2 = 'active' THEN NOW() ELSE accepted_at END,
+		revoked_at = CASE WHEN $2 = 'revoked' THEN NOW() ELSE revoked_at END
+	WHERE id = $1 AND status = ANY($3)
+	RETURNING ` + relationshipColumns
+
+	from := []string{StatusPending}
+	eventType := events.CoachingStarted
+	if status == StatusRevoked {
+		from = append(from, StatusActive)
+		eventType = events.CoachingRevoked
+	}
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var rel Relationship
+	if err := scanRelationship(tx.QueryRowContext(ctx, query, id, status, pq.Array(from)), &rel); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidState
+		}
+		return nil, err
+	}
+
+	if err := events.Emit(ctx, tx, eventType, "coach_athlete", rel.ID, &rel.AthleteID, rel); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &rel, nil
+}
+
+// IsCoachOf reports whether coachID actively coaches athleteID.
+func (r *Repository) IsCoachOf(ctx context.Context, coachID, athleteID uuid.UUID) (bool, error) {
+	var ok bool
+	err := r.DB.QueryRowContext(ctx, `
+	SELECT EXISTS (
+		SELECT 1 FROM coach_athlete
+		WHERE coach_id = $1 AND athlete_id = $2 AND status = 'active'
+	)`, coachID, athleteID).Scan(&ok)
+	return ok, err
+}
+
+// AthleteIDs returns the athletes actively coached by coachID.
+func (r *Repository) AthleteIDs(ctx context.Context, coachID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := r.DB.QueryContext(ctx, `
+	SELECT athlete_id FROM coach_athlete
+	WHERE coach_id = $1 AND status = 'active'`, coachID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
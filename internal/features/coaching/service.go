@@ -0,0 +1,168 @@
+package coaching
+
+import (
+	coachingService "be/gen/coaching"
+	"be/internal/middleware"
+	"be/internal/utils"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+type Service struct {
+	Repository *Repository
+}
+
+func NewService() *Service {
+	return &Service{
+		Repository: NewRepository(),
+	}
+}
+
+func (s *Service) OAuth2Auth(ctx context.Context, token string, scheme *security.OAuth2Scheme) (context.Context, error) {
+	claims, err := middleware.ValidateToken(token)
+	if err != nil {
+		return ctx, &coachingService.Unauthorized{Message: "Invalid token"}
+	}
+	ctx = context.WithValue(ctx, middleware.ClaimsKey, claims)
+
+	return ctx, nil
+}
+
+func (s *Service) Invite(ctx context.Context, payload *coachingService.InvitePayload) (*coachingService.CoachingRelationship, error) {
+	u, err := s.Repository.FindCaller(ctx)
+	if err != nil {
+		return nil, &coachingService.Forbidden{Message: "Forbidden"}
+	}
+
+	athleteID, err := uuid.Parse(payload.AthleteID)
+	if err != nil {
+		return nil, badRequest("invalid_id", "invalid athlete ID")
+	}
+	if athleteID == u.ID {
+		return nil, badRequest("invalid_athlete", "coaches cannot invite themselves")
+	}
+
+	rel, err := s.Repository.Invite(ctx, u.ID, athleteID)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	return toResult(rel), nil
+}
+
+func (s *Service) Accept(ctx context.Context, payload *coachingService.AcceptPayload) (*coachingService.CoachingRelationship, error) {
+	u, rel, err := s.relationship(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+	if rel.AthleteID != u.ID {
+		return nil, &coachingService.Forbidden{Message: "Forbidden"}
+	}
+
+	accepted, err := s.Repository.Transition(ctx, rel.ID, StatusActive)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	return toResult(accepted), nil
+}
+
+func (s *Service) Revoke(ctx context.Context, payload *coachingService.RevokePayload) (*coachingService.CoachingRelationship, error) {
+	u, rel, err := s.relationship(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+	if rel.AthleteID != u.ID && rel.CoachID != u.ID {
+		return nil, &coachingService.Forbidden{Message: "Forbidden"}
+	}
+
+	revoked, err := s.Repository.Transition(ctx, rel.ID, StatusRevoked)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	return toResult(revoked), nil
+}
+
+func (s *Service) List(ctx context.Context, payload *coachingService.ListPayload) ([]*coachingService.CoachingRelationship, error) {
+	u, err := s.Repository.FindCaller(ctx)
+	if err != nil {
+		return nil, &coachingService.Forbidden{Message: "Forbidden"}
+	}
+
+	rels, err := s.Repository.List(ctx, payload.Limit, payload.Offset, u.ID, payload.Role, payload.Status)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+
+	res := []*coachingService.CoachingRelationship{}
+	for i := range rels {
+		res = append(res, toResult(&rels[i]))
+	}
+	return res, nil
+}
+
+// relationship resolves the caller and the relationship they act on.
+func (s *Service) relationship(ctx context.Context, id string) (*Caller, *Relationship, error) {
+	u, err := s.Repository.FindCaller(ctx)
+	if err != nil {
+		return nil, nil, &coachingService.Forbidden{Message: "Forbidden"}
+	}
+
+	relID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, nil, badRequest("invalid_id", "invalid relationship ID")
+	}
+
+	rel, err := s.Repository.FindByID(ctx, relID)
+	if err != nil {
+		return nil, nil, s.mapError(ctx, err)
+	}
+	return u, rel, nil
+}
+
+// mapError converts repository errors to the service error types.
+func (s *Service) mapError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, ErrRelationshipNotFound):
+		return &coachingService.NotFound{Message: "Coaching relationship not found"}
+	case errors.Is(err, ErrAthleteNotFound):
+		return &coachingService.NotFound{Message: "Athlete not found"}
+	case errors.Is(err, ErrAlreadyExists):
+		return badRequest("already_exists", err.Error())
+	case errors.Is(err, ErrInvalidState):
+		return badRequest("invalid_state", err.Error())
+	}
+	utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+	return &coachingService.InternalServerError{Message: "Internal Server error"}
+}
+
+func toResult(rel *Relationship) *coachingService.CoachingRelationship {
+	res := &coachingService.CoachingRelationship{
+		ID:        rel.ID.String(),
+		CoachID:   rel.CoachID.String(),
+		AthleteID: rel.AthleteID.String(),
+		Status:    rel.Status,
+		CreatedAt: rel.CreatedAt.Format(time.RFC3339),
+	}
+	if rel.AcceptedAt.Valid {
+		accepted := rel.AcceptedAt.Time.Format(time.RFC3339)
+		res.AcceptedAt = &accepted
+	}
+	if rel.RevokedAt.Valid {
+		revoked := rel.RevokedAt.Time.Format(time.RFC3339)
+		res.RevokedAt = &revoked
+	}
+	return res
+}
+
+func badRequest(name, message string) *coachingService.BadRequest {
+	return &coachingService.BadRequest{
+		Name:    name,
+		ID:      goa.NewErrorID(),
+		Message: message,
+	}
+}
@@ -1,16 +1,28 @@
 package common
 
 import (
+	"be/internal/metrics"
+	"be/internal/telemetry"
 	"be/internal/utils"
 
 	"context"
 	"errors"
-	"os"
+	"net/http"
 
 	"github.com/Nerzal/gocloak/v13"
 	"goa.design/clue/log"
 )
 
+// KeycloakConfig holds the Keycloak connection settings.
+type KeycloakConfig struct {
+	URL          string `yaml:"url"`          // Base URL the service calls
+	PublicURL    string `yaml:"publicUrl"`    // Base URL browsers reach, for the Swagger UI login
+	Realm        string `yaml:"realm"`        // Realm of the users
+	ClientID     string `yaml:"clientId"`     // Client of the service
+	ClientSecret string `yaml:"clientSecret"` // Secret of the client
+	RSAPublicKey string `yaml:"rsaPublicKey"` // PEM key the access tokens are signed with
+}
+
 type KcClient struct {
 	clientID     string
 	clientSecret string
@@ -19,24 +31,22 @@ type KcClient struct {
 	UserAccess   *UserAccess
 }
 
-func NewKcClient() *KcClient {
-	var (
-		client   = gocloak.NewClient("http://keycloak:8080")
-		kcClient = os.Getenv("KC_CLIENT_ID")
-		kcSecret = os.Getenv("KC_CLIENT_SECRET")
-		kcRealm  = os.Getenv("KC_REALM")
-	)
+func NewKcClient(cfg KeycloakConfig) *KcClient {
+	client := gocloak.NewClient(cfg.URL)
+	client.RestyClient().SetTransport(telemetry.Transport(http.DefaultTransport))
 	return &KcClient{
 		client:       client,
-		clientID:     kcClient,
-		clientSecret: kcSecret,
-		realm:        kcRealm,
+		clientID:     cfg.ClientID,
+		clientSecret: cfg.ClientSecret,
+		realm:        cfg.Realm,
 		UserAccess:   NewUserAccess(),
 	}
 }
 
 func (s *KcClient) GetToken(ctx context.Context) (*gocloak.JWT, error) {
+	done := metrics.Keycloak("login_client")
 	token, err := s.client.LoginClient(ctx, s.clientID, s.clientSecret, s.realm)
+	done(err)
 	if err != nil {
 		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
 		rsp := errors.New("errore di comunicazione [DB-FU]")
@@ -60,13 +70,18 @@ func (s *KcClient) KcCreate(ctx context.Context, firstName, lastName, nickName,
 		LastName:      gocloak.StringP(lastName),
 	}
 
+	done := metrics.Keycloak("create_user")
 	userID, err := s.client.CreateUser(ctx, token.AccessToken, s.realm, kcUser)
+	done(err)
 	if err != nil {
 		utils.Log.Error(ctx, log.KV{K: "KC_UC", V: err}, err)
 		return nil, errors.New("user already exists")
 	}
 
-	if err := s.client.SetPassword(ctx, token.AccessToken, userID, s.realm, password, false); err != nil {
+	done = metrics.Keycloak("set_password")
+	err = s.client.SetPassword(ctx, token.AccessToken, userID, s.realm, password, false)
+	done(err)
+	if err != nil {
 		return nil, errors.New("communication error [KC-SP]")
 	}
 
@@ -90,7 +105,10 @@ func (s *KcClient) KcUpdate(ctx context.Context, firstName, lastName *string, uu
 		kcUser.LastName = lastName
 	}
 
-	if err := s.client.UpdateUser(ctx, token.AccessToken, s.realm, kcUser); err != nil {
+	done := metrics.Keycloak("update_user")
+	err = s.client.UpdateUser(ctx, token.AccessToken, s.realm, kcUser)
+	done(err)
+	if err != nil {
 		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
 		return errors.New("communication error [KC-UU]")
 	}
@@ -105,7 +123,10 @@ func (s *KcClient) KcDelete(ctx context.Context, uuid string) error {
 		return errors.New("communication error [KC-TK]")
 	}
 
-	if err := s.client.DeleteUser(ctx, token.AccessToken, s.realm, uuid); err != nil {
+	done := metrics.Keycloak("delete_user")
+	err = s.client.DeleteUser(ctx, token.AccessToken, s.realm, uuid)
+	done(err)
+	if err != nil {
 		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
 		return errors.New("communication error [KC-DU]")
 	}
@@ -119,7 +140,9 @@ func (s *KcClient) KcGetUser(ctx context.Context, uuid string) (*gocloak.User, e
 		return nil, errors.New("communication error [KC-TK]")
 	}
 
+	done := metrics.Keycloak("get_user")
 	user, err := s.client.GetUserByID(ctx, token.AccessToken, s.realm, uuid)
+	done(err)
 	if err != nil {
 		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
 		return nil, errors.New("communication error [KC-GU]")
@@ -163,14 +186,18 @@ func (s *KcClient) KcGetUserGroups(ctx context.Context, uuid string) ([]*gocloak
 		return nil, errors.New("communication error [KC-GT]")
 	}
 
+	done := metrics.Keycloak("get_user_groups")
 	groups, err := s.client.GetUserGroups(ctx, token.AccessToken, s.realm, uuid, gocloak.GetGroupsParams{})
+	done(err)
 	if err != nil {
 		utils.Log.Error(ctx, log.KV{K: "KC-FG", V: err}, err)
 		return nil, errors.New("communication error [KC-GG]")
 	}
 
 	for _, group := range groups {
+		done := metrics.Keycloak("get_group")
 		fullGroup, err := s.client.GetGroup(ctx, token.AccessToken, s.realm, *group.ID)
+		done(err)
 		if err != nil {
 			utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
 			continue
@@ -180,3 +207,11 @@ func (s *KcClient) KcGetUserGroups(ctx context.Context, uuid string) ([]*gocloak
 	}
 	return groups, nil
 }
+
+// Ping checks that Keycloak serves the realm.
+func (s *KcClient) Ping(ctx context.Context) error {
+	done := metrics.Keycloak("get_issuer")
+	_, err := s.client.GetIssuer(ctx, s.realm)
+	done(err)
+	return err
+}
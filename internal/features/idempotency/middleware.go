@@ -0,0 +1,189 @@
+package idempotency
+
+import (
+	"be/internal/middleware"
+	"be/internal/utils"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"goa.design/clue/log"
+	goa "goa.design/goa/v3/pkg"
+)
+
+const (
+	// HeaderKey is the request header carrying the client's key.
+	HeaderKey = "Idempotency-Key"
+	// HeaderReplayed marks a response replayed from a previous request.
+	HeaderReplayed = "Idempotent-Replayed"
+
+	maxKeyLength = 255
+	// maxBodySize bounds the request bodies read to be hashed.
+	maxBodySize = 10 << 20
+)
+
+// replayedHeaders are the response headers stored with the body.
+var replayedHeaders = []string{"Content-Type", "Etag", "Location", "Content-Disposition"}
+
+// Middleware makes POST requests carrying an Idempotency-Key safe to retry:
+// the first response is stored and replayed to later requests with the same
+// key and body, for TTL. The keys are scoped to the token's subject; requests
+// without a valid token are left to the services to reject.
+type Middleware struct {
+	Repository *Repository
+	TTL        time.Duration
+}
+
+func NewMiddleware(ttl time.Duration) *Middleware {
+	return &Middleware{
+		Repository: NewRepository(),
+		TTL:        ttl,
+	}
+}
+
+// Handler wraps h with the idempotency checks.
+func (m *Middleware) Handler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(HeaderKey)
+		if r.Method != http.MethodPost || key == "" {
+			h.ServeHTTP(w, r)
+			return
+		}
+		subject, ok := subject(r)
+		if !ok {
+			h.ServeHTTP(w, r)
+			return
+		}
+		if len(key) > maxKeyLength {
+			writeError(w, http.StatusBadRequest, "invalid_idempotency_key", "Idempotency-Key must be at most 255 characters")
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+		r.Body.Close()
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_body", "cannot read the request body")
+			return
+		}
+		if len(body) > maxBodySize {
+			writeError(w, http.StatusRequestEntityTooLarge, "body_too_large", "the request body is too large to be replayed")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := r.Context()
+		hash := requestHash(r, body)
+		claimed, stored, err := m.Repository.Begin(ctx, subject, key, hash, m.TTL)
+		if err != nil {
+			utils.Log.Error(ctx, log.KV{K: "idempotency", V: err}, err)
+			writeError(w, http.StatusInternalServerError, "internal_error", "Internal Server error")
+			return
+		}
+		if !claimed {
+			switch {
+			case stored.RequestHash != hash:
+				writeError(w, http.StatusUnprocessableEntity, "idempotency_key_reused", "the Idempotency-Key was already used for a different request")
+			case stored.StatusCode == nil:
+				writeError(w, http.StatusConflict, "idempotency_key_in_use", "a request with this Idempotency-Key is still being processed")
+			default:
+				replay(w, stored)
+			}
+			return
+		}
+
+		rec := &recorder{ResponseWriter: w, status: http.StatusOK}
+		h.ServeHTTP(rec, r)
+
+		// The request may have been cancelled by now, but its outcome must be
+		// recorded or the key released all the same.
+		ctx = context.WithoutCancel(ctx)
+		if rec.status >= http.StatusInternalServerError {
+			err = m.Repository.Release(ctx, subject, key)
+		} else {
+			err = m.Repository.Complete(ctx, subject, key, rec.status, storedHeaders(rec.Header()), rec.body.Bytes())
+		}
+		if err != nil {
+			utils.Log.Error(ctx, log.KV{K: "idempotency", V: err}, err)
+		}
+	})
+}
+
+// subject returns the subject of the bearer token, which the keys are
+// scoped to.
+func subject(r *http.Request) (string, bool) {
+	claims, ok := middleware.ClaimsFromRequest(r)
+	if !ok {
+		return "", false
+	}
+	sub, ok := claims["sub"].(string)
+	return sub, ok && sub != ""
+}
+
+// requestHash identifies a request by its method, URL and body.
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	io.WriteString(h, r.Method+" "+r.URL.RequestURI()+"\n")
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func storedHeaders(h http.Header) http.Header {
+	stored := http.Header{}
+	for _, name := range replayedHeaders {
+		if v := h.Values(name); len(v) > 0 {
+			stored[name] = v
+		}
+	}
+	return stored
+}
+
+func replay(w http.ResponseWriter, res *Response) {
+	for name, values := range res.Headers {
+		for _, v := range values {
+			w.Header().Add(name, v)
+		}
+	}
+	w.Header().Set(HeaderReplayed, "true")
+	w.WriteHeader(*res.StatusCode)
+	_, _ = w.Write(res.Body)
+}
+
+// writeError writes an error with the body of the services' BadRequest.
+func writeError(w http.ResponseWriter, status int, name, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"name":      name,
+		"id":        goa.NewErrorID(),
+		"message":   message,
+		"temporary": status == http.StatusConflict,
+		"timeout":   false,
+		"fault":     status >= http.StatusInternalServerError,
+	})
+}
+
+// recorder copies the response written by the services.
+type recorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (r *recorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status, r.wroteHeader = status, true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
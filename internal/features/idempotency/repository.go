@@ -0,0 +1,105 @@
+package idempotency
+
+import (
+	"be/internal/database/db"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// staleAfter is how long a request may hold its key without completing
+// before a retry takes the key over, as after a crash.
+const staleAfter = time.Minute
+
+// Response is the stored outcome of a request. StatusCode is nil while the
+// request is still being processed.
+type Response struct {
+	RequestHash string
+	StatusCode  *int
+	Headers     http.Header
+	Body        []byte
+}
+
+type Repository struct {
+	DB *sql.DB
+}
+
+func NewRepository() *Repository {
+	return &Repository{DB: db.DB.LD}
+}
+
+// Begin claims key for a request hashing to hash. When the key is already
+// held, by a completed request, one in progress or a different request, the
+// stored response is returned instead.
+func (r *Repository) Begin(ctx context.Context, subject, key, hash string, ttl time.Duration) (bool, *Response, error) {
+	var claimed bool
+	err := r.DB.QueryRowContext(ctx, `
+	INSERT INTO idempotency_key (subject, key, request_hash, expires_at)
+	VALUES ($1, $2, $3, NOW() + $4::float8 * INTERVAL '1 second')
+	ON CONFLICT (subject, key) DO UPDATE SET
+		request_hash = EXCLUDED.request_hash,
+		status_code = NULL,
+		response_headers = NULL,
+		response_body = NULL,
+		created_at = NOW(),
+		expires_at = EXCLUDED.expires_at
+	WHERE idempotency_key.expires_at < NOW()
+	   OR (idempotency_key.status_code IS NULL
+	       AND idempotency_key.request_hash = EXCLUDED.request_hash
+	       AND idempotency_key.created_at < NOW() - $5::float8 * INTERVAL '1 second')
+	RETURNING TRUE`, subject, key, hash, ttl.Seconds(), staleAfter.Seconds()).Scan(&claimed)
+	if err == nil {
+		return true, nil, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return false, nil, err
+	}
+
+	var (
+		res     Response
+		headers []byte
+	)
+	err = r.DB.QueryRowContext(ctx, `
+	SELECT request_hash, status_code, response_headers, response_body
+	FROM idempotency_key WHERE subject = $1 AND key = $2`, subject, key).
+		Scan(&res.RequestHash, &res.StatusCode, &headers, &res.Body)
+	if err != nil {
+		return false, nil, err
+	}
+	if headers != nil {
+		if err := json.Unmarshal(headers, &res.Headers); err != nil {
+			return false, nil, err
+		}
+	}
+	return false, &res, nil
+}
+
+// Complete stores the response of the request holding key.
+func (r *Repository) Complete(ctx context.Context, subject, key string, status int, headers http.Header, body []byte) error {
+	encoded, err := json.Marshal(headers)
+	if err != nil {
+		return err
+	}
+	_, err = r.DB.ExecContext(ctx, `
+	UPDATE idempotency_key SET status_code = $3, response_headers = $4, response_body = $5
+	WHERE subject = $1 AND key = $2`, subject, key, status, encoded, body)
+	return err
+}
+
+// Release frees key so that the request can be retried.
+func (r *Repository) Release(ctx context.Context, subject, key string) error {
+	_, err := r.DB.ExecContext(ctx, `DELETE FROM idempotency_key WHERE subject = $1 AND key = $2`, subject, key)
+	return err
+}
+
+// DeleteExpired removes the keys past their expiry.
+func (r *Repository) DeleteExpired(ctx context.Context) (int64, error) {
+	res, err := r.DB.ExecContext(ctx, `DELETE FROM idempotency_key WHERE expires_at < NOW()`)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,55 @@
+package idempotency
+
+import (
+	"be/internal/utils"
+	"context"
+	"sync"
+	"time"
+
+	"goa.design/clue/log"
+)
+
+// Sweeper deletes the expired keys every Interval.
+type Sweeper struct {
+	Repository *Repository
+	Interval   time.Duration
+}
+
+func NewSweeper() *Sweeper {
+	return &Sweeper{
+		Repository: NewRepository(),
+		Interval:   time.Hour,
+	}
+}
+
+// Start runs the sweeper in a goroutine until ctx is cancelled.
+func (s *Sweeper) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		log.Printf(ctx, "Starting idempotency key sweeper")
+		s.Run(ctx)
+		log.Printf(ctx, "Idempotency key sweeper stopped")
+	}()
+}
+
+// Run deletes the expired keys until ctx is cancelled.
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := s.Repository.DeleteExpired(ctx)
+		if err != nil {
+			utils.Log.Error(ctx, log.KV{K: "idempotency", V: err}, err)
+		} else if n > 0 {
+			log.Printf(ctx, "Deleted %d expired idempotency keys", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package importer
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unrecognized CSV layout")
+	ErrEmptyFile     = errors.New("the file has no rows")
+)
+
+const lbToKg = 0.45359237
+
+// Row is a set read from an export, normalized across formats.
+type Row struct {
+	Line       int
+	StartedAt  time.Time
+	FinishedAt *time.Time
+	Workout    string
+	Exercise   string
+	SetIndex   int     // order within the exercise, -1 when the format has none
+	Weight     float64 // in WeightUnit
+	WeightUnit string  // "kg", "lb", or "" when the export doesn't say
+	Reps       int
+}
+
+// Options tune how rows are read.
+type Options struct {
+	Location *time.Location // zone of the export's local times
+	Unit     string         // unit of weights the export doesn't label, kg or lb
+}
+
+// Format maps the CSV layout of one app to rows.
+type Format interface {
+	// Name identifies the format, and the source of the sessions it imports.
+	Name() string
+	// Detect reports whether a header belongs to this format.
+	Detect(header Header) bool
+	// Map converts a record to a row. Rows carrying no set, such as cardio
+	// entries, return ErrSkipRow.
+	Map(rec Record, opts Options) (Row, error)
+}
+
+// ErrSkipRow marks records that hold no strength set.
+var ErrSkipRow = errors.New("no weight or reps")
+
+var formats = []Format{Strong{}, Hevy{}}
+
+// Register adds a format, checked after the built-in ones.
+func Register(f Format) {
+	formats = append(formats, f)
+}
+
+// Lookup returns the registered format called name.
+func Lookup(name string) (Format, bool) {
+	for _, f := range formats {
+		if f.Name() == name {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// Header indexes the columns of a CSV export by lowercased name.
+type Header map[string]int
+
+// Has reports whether all the columns are present.
+func (h Header) Has(cols ...string) bool {
+	for _, c := range cols {
+		if _, ok := h[c]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Record is a CSV line read through its header.
+type Record struct {
+	header Header
+	values []string
+}
+
+// Get returns the trimmed value of a column, or "" when it is missing.
+func (r Record) Get(col string) string {
+	i, ok := r.header[col]
+	if !ok || i >= len(r.values) {
+		return ""
+	}
+	return strings.TrimSpace(r.values[i])
+}
+
+// RowError is a record that could not be read.
+type RowError struct {
+	Line int
+	Err  error
+}
+
+func (e RowError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Read parses an export. When format is empty the layout is detected from
+// the header. Records that cannot be read are returned as RowErrors rather
+// than failing the whole file.
+func Read(r io.Reader, format string, opts Options) (Format, []Row, []RowError, error) {
+	br := bufio.NewReader(r)
+	cr := csv.NewReader(br)
+	cr.Comma = sniffDelimiter(br)
+	cr.FieldsPerRecord = -1
+
+	names, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, nil, ErrEmptyFile
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	header := Header{}
+	for i, n := range names {
+		n = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(n, "\ufeff")))
+		header[n] = i
+	}
+
+	var f Format
+	if format != "" {
+		var ok bool
+		if f, ok = Lookup(format); !ok || !f.Detect(header) {
+			return nil, nil, nil, ErrUnknownFormat
+		}
+	} else {
+		for _, candidate := range formats {
+			if candidate.Detect(header) {
+				f = candidate
+				break
+			}
+		}
+		if f == nil {
+			return nil, nil, nil, ErrUnknownFormat
+		}
+	}
+
+	var (
+		rows    []Row
+		skipped []RowError
+	)
+	for {
+		values, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var perr *csv.ParseError
+			if !errors.As(err, &perr) {
+				return nil, nil, nil, err
+			}
+			skipped = append(skipped, RowError{perr.StartLine, perr.Err})
+			continue
+		}
+		line, _ := cr.FieldPos(0)
+
+		row, err := f.Map(Record{header: header, values: values}, opts)
+		if err != nil {
+			skipped = append(skipped, RowError{line, err})
+			continue
+		}
+		row.Line = line
+		if row.WeightUnit == "" {
+			row.WeightUnit = opts.Unit
+		}
+		if row.WeightUnit == "lb" {
+			row.Weight, row.WeightUnit = row.Weight*lbToKg, "kg"
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 && len(skipped) == 0 {
+		return nil, nil, nil, ErrEmptyFile
+	}
+	return f, rows, skipped, nil
+}
+
+// sniffDelimiter picks ; or , from the header line: Strong writes either
+// depending on the device locale.
+func sniffDelimiter(br *bufio.Reader) rune {
+	line, _ := br.Peek(4096)
+	if i := strings.IndexByte(string(line), '\n'); i >= 0 {
+		line = line[:i]
+	}
+	if strings.Count(string(line), ";") > strings.Count(string(line), ",") {
+		return ';'
+	}
+	return ','
+}
+
+// parseTime tries the layouts in the given location.
+func parseTime(value string, loc *time.Location, layouts ...string) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
@@ -0,0 +1,185 @@
+package importer
+
+import (
+	"bufio"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+const strongHeader = "Date,Workout Name,Duration,Exercise Name,Set Order,Weight,Reps,Distance,Seconds,Notes,Workout Notes,RPE"
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		csv     string
+		format  string
+		unit    string
+		want    string    // detected format
+		weights []float64 // in kg, in row order
+		skipped []int     // lines of the skipped records
+		err     error
+	}{
+		{
+			name: "strong with commas",
+			csv: strongHeader + "\n" +
+				"2026-03-02 07:30:00,Push,1h 5m,Bench Press (Barbell),1,60,8,0,0,,,\n" +
+				"2026-03-02 07:30:00,Push,1h 5m,Bench Press (Barbell),2,62.5,6,0,0,,,\n",
+			unit:    "kg",
+			want:    "strong",
+			weights: []float64{60, 62.5},
+		},
+		{
+			name: "strong with semicolons and decimal commas",
+			csv: strings.ReplaceAll(strongHeader, ",", ";") + "\n" +
+				"2026-03-02 07:30:00;Push;45m;Bench Press (Barbell);1;62,5;8;0;0;;;\n",
+			unit:    "kg",
+			want:    "strong",
+			weights: []float64{62.5},
+		},
+		{
+			name: "strong in pounds from the unit option",
+			csv: strongHeader + "\n" +
+				"2026-03-02 07:30:00,Push,45m,Bench Press (Barbell),1,100,8,0,0,,,\n",
+			unit:    "lb",
+			want:    "strong",
+			weights: []float64{45.359237},
+		},
+		{
+			name: "strong weight unit column overrides the option",
+			csv: strongHeader + ",Weight Unit\n" +
+				"2026-03-02 07:30:00,Push,45m,Bench Press (Barbell),1,100,8,0,0,,,,kg\n" +
+				"2026-03-02 07:30:00,Push,45m,Squat (Barbell),1,100,8,0,0,,,,lbs\n",
+			unit:    "lb",
+			want:    "strong",
+			weights: []float64{100, 45.359237},
+		},
+		{
+			name: "hevy in kg",
+			csv: "title,start_time,end_time,exercise_title,set_index,weight_kg,reps\n" +
+				"Push,\"2 Mar 2026, 07:30\",\"2 Mar 2026, 08:35\",Bench Press (Barbell),0,60,8\n",
+			unit:    "lb",
+			want:    "hevy",
+			weights: []float64{60},
+		},
+		{
+			name: "hevy in pounds",
+			csv: "title,start_time,end_time,exercise_title,set_index,weight_lbs,reps\n" +
+				"Push,\"2 Mar 2026, 07:30\",\"2 Mar 2026, 08:35\",Bench Press (Barbell),0,100,8\n",
+			unit:    "kg",
+			want:    "hevy",
+			weights: []float64{45.359237},
+		},
+		{
+			name: "byte order mark before the header",
+			csv: "\ufeff" + strongHeader + "\n" +
+				"2026-03-02 07:30:00,Push,45m,Bench Press (Barbell),1,60,8,0,0,,,\n",
+			unit:    "kg",
+			want:    "strong",
+			weights: []float64{60},
+		},
+		{
+			name: "unreadable and cardio records are skipped",
+			csv: strongHeader + "\n" +
+				"2026-03-02 07:30:00,Push,45m,Bench Press (Barbell),1,60,8,0,0,,,\n" +
+				"2026-03-02 07:30:00,Push,45m,Bench Press (Barbell),2,heavy,8,0,0,,,\n" +
+				"2026-03-02 07:30:00,Push,45m,Rowing (Machine),1,0,0,2000,480,,,\n" +
+				"yesterday,Push,45m,Bench Press (Barbell),3,60,8,0,0,,,\n",
+			unit:    "kg",
+			want:    "strong",
+			weights: []float64{60},
+			skipped: []int{3, 4, 5},
+		},
+		{
+			name:    "explicit format",
+			csv:     strongHeader + "\n2026-03-02 07:30:00,Push,45m,Bench Press (Barbell),1,60,8,0,0,,,\n",
+			format:  "strong",
+			unit:    "kg",
+			want:    "strong",
+			weights: []float64{60},
+		},
+		{
+			name:   "explicit format not matching the header",
+			csv:    strongHeader + "\n2026-03-02 07:30:00,Push,45m,Bench Press (Barbell),1,60,8,0,0,,,\n",
+			format: "hevy",
+			err:    ErrUnknownFormat,
+		},
+		{
+			name: "unknown header",
+			csv:  "day,lift,kilos\n2026-03-02,bench,60\n",
+			err:  ErrUnknownFormat,
+		},
+		{
+			name: "empty file",
+			csv:  "",
+			err:  ErrEmptyFile,
+		},
+		{
+			name: "header only",
+			csv:  strongHeader + "\n",
+			err:  ErrEmptyFile,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, rows, skipped, err := Read(strings.NewReader(tt.csv), tt.format, Options{Location: time.UTC, Unit: tt.unit})
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("Read() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+			if f.Name() != tt.want {
+				t.Errorf("format = %q, want %q", f.Name(), tt.want)
+			}
+			if len(rows) != len(tt.weights) {
+				t.Fatalf("got %d rows, want %d", len(rows), len(tt.weights))
+			}
+			for i, r := range rows {
+				if r.WeightUnit != "kg" {
+					t.Errorf("row %d unit = %q, want kg", i, r.WeightUnit)
+				}
+				if math.Abs(r.Weight-tt.weights[i]) > 1e-9 {
+					t.Errorf("row %d weight = %v, want %v", i, r.Weight, tt.weights[i])
+				}
+			}
+			if len(skipped) != len(tt.skipped) {
+				t.Fatalf("skipped = %v, want lines %v", skipped, tt.skipped)
+			}
+			for i, s := range skipped {
+				if s.Line != tt.skipped[i] {
+					t.Errorf("skipped[%d] line = %d, want %d", i, s.Line, tt.skipped[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSniffDelimiter(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want rune
+	}{
+		{"commas", "Date,Workout Name,Weight\n", ','},
+		{"semicolons", "Date;Workout Name;Weight\n", ';'},
+		{"no line break", "Date;Workout Name;Weight", ';'},
+		{"only the header line counts", "Date,Workout Name,Weight\n1;2;3;4;5\n", ','},
+		{"quoted commas in a semicolon header", "\"Weight, kg\";Reps;Date\n", ';'},
+		{"empty", "", ','},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sniffDelimiter(bufio.NewReader(strings.NewReader(tt.in))); got != tt.want {
+				t.Errorf("sniffDelimiter(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
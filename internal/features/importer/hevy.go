@@ -0,0 +1,61 @@
+package importer
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Hevy reads the CSV export of the Hevy app: one row per set, with the
+// workout's local start and end times and weights in kg or lb columns.
+type Hevy struct{}
+
+func (Hevy) Name() string { return "hevy" }
+
+func (Hevy) Detect(h Header) bool {
+	return h.Has("title", "start_time", "exercise_title", "set_index", "reps") &&
+		(h.Has("weight_kg") || h.Has("weight_lbs"))
+}
+
+var hevyLayouts = []string{"2 Jan 2006, 15:04", "2006-01-02 15:04:05", time.RFC3339}
+
+func (Hevy) Map(rec Record, opts Options) (Row, error) {
+	started, err := parseTime(rec.Get("start_time"), opts.Location, hevyLayouts...)
+	if err != nil {
+		return Row{}, err
+	}
+
+	row := Row{
+		StartedAt:  started,
+		Workout:    rec.Get("title"),
+		Exercise:   rec.Get("exercise_title"),
+		SetIndex:   -1,
+		WeightUnit: "kg",
+	}
+	weight := rec.Get("weight_kg")
+	if weight == "" && rec.Get("weight_lbs") != "" {
+		weight, row.WeightUnit = rec.Get("weight_lbs"), "lb"
+	}
+	if row.Weight, err = parseNumber(weight); err != nil {
+		return Row{}, fmt.Errorf("invalid weight %q", weight)
+	}
+	reps, err := parseNumber(rec.Get("reps"))
+	if err != nil {
+		return Row{}, fmt.Errorf("invalid reps %q", rec.Get("reps"))
+	}
+	if reps <= 0 {
+		return Row{}, ErrSkipRow
+	}
+	row.Reps = int(reps)
+
+	// set_index is zero-based.
+	if i, err := strconv.Atoi(rec.Get("set_index")); err == nil {
+		row.SetIndex = i + 1
+	}
+	if end := rec.Get("end_time"); end != "" {
+		if finished, err := parseTime(end, opts.Location, hevyLayouts...); err == nil {
+			row.FinishedAt = &finished
+		}
+	}
+	return row, nil
+}
@@ -0,0 +1,85 @@
+package importer
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHevyMap(t *testing.T) {
+	const header = "title;start_time;end_time;exercise_title;set_index;weight_kg;weight_lbs;reps"
+	started := time.Date(2026, 3, 2, 7, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		line     string
+		started  time.Time
+		finished time.Duration
+		setIndex int
+		weight   float64
+		unit     string
+		err      error
+	}{
+		{
+			name:     "kilograms",
+			line:     "Push;2 Mar 2026, 07:30;2 Mar 2026, 08:35;Bench Press;0;60;;8",
+			started:  started,
+			finished: time.Hour + 5*time.Minute,
+			setIndex: 1,
+			weight:   60,
+			unit:     "kg",
+		},
+		{
+			name:     "pounds",
+			line:     "Push;2026-03-02 07:30:00;;Bench Press;2;;135;8",
+			started:  started,
+			setIndex: 3,
+			weight:   135,
+			unit:     "lb",
+		},
+		{
+			name:     "RFC 3339 times",
+			line:     "Push;2026-03-02T07:30:00Z;2026-03-02T08:00:00Z;Pull Up;0;;;10",
+			started:  started,
+			finished: 30 * time.Minute,
+			setIndex: 1,
+			unit:     "kg",
+		},
+		{
+			name:     "unreadable end time",
+			line:     "Push;2 Mar 2026, 07:30;later;Bench Press;0;60;;8",
+			started:  started,
+			setIndex: 1,
+			weight:   60,
+			unit:     "kg",
+		},
+		{
+			name: "no reps",
+			line: "Push;2 Mar 2026, 07:30;;Plank;0;;;",
+			err:  ErrSkipRow,
+		},
+		{
+			name: "invalid start time",
+			line: "Push;03/02/2026;;Bench Press;0;60;;8",
+			err:  errAny,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row, err := Hevy{}.Map(record(";", header, tt.line), Options{Location: time.UTC})
+			if checkErr(t, err, tt.err) {
+				return
+			}
+			if !row.StartedAt.Equal(tt.started) {
+				t.Errorf("StartedAt = %v, want %v", row.StartedAt, tt.started)
+			}
+			checkFinished(t, row, tt.finished)
+			if row.SetIndex != tt.setIndex {
+				t.Errorf("SetIndex = %d, want %d", row.SetIndex, tt.setIndex)
+			}
+			if row.Weight != tt.weight || row.WeightUnit != tt.unit {
+				t.Errorf("weight = %v %s, want %v %s", row.Weight, row.WeightUnit, tt.weight, tt.unit)
+			}
+		})
+	}
+}
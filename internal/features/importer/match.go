@@ -0,0 +1,118 @@
+package importer
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// minScore is the similarity below which a name is left unmatched.
+const minScore = 0.75
+
+// ExerciseType is an entry of the exercise type catalog.
+type ExerciseType struct {
+	ID   uuid.UUID
+	Name string
+}
+
+// Match is the catalog entry an imported exercise name was mapped to. Type
+// is nil when nothing scored at least minScore; Exact is set when one of the
+// name's variants equals the catalog name. Applied is set when a variant
+// holds the same words as the catalog name, in any order: only those matches
+// are recorded on the imported sets, the others are suggestions, since a
+// close spelling can be another exercise, as "Incline Bench Press" is to
+// "Decline Bench Press".
+type Match struct {
+	Name    string
+	Type    *ExerciseType
+	Score   float64
+	Exact   bool
+	Applied bool
+}
+
+var (
+	nonAlnum      = regexp.MustCompile(`[^a-z0-9]+`)
+	parenthetical = regexp.MustCompile(`\(([^)]*)\)`)
+)
+
+func normalize(s string) string {
+	return strings.TrimSpace(nonAlnum.ReplaceAllString(strings.ToLower(s), " "))
+}
+
+// variants lists the spellings an app name is compared under. Apps put the
+// equipment in parentheses, as in "Bench Press (Barbell)", which catalogs
+// either drop or write first.
+func variants(name string) []string {
+	vs := []string{normalize(name)}
+	if m := parenthetical.FindStringSubmatch(name); m != nil {
+		base := normalize(parenthetical.ReplaceAllString(name, " "))
+		vs = append(vs, base, normalize(m[1]+" "+base))
+	}
+	return vs
+}
+
+// MatchName finds the catalog entry closest to name, preferring entries with
+// the same words over closer spellings.
+func MatchName(name string, catalog []ExerciseType) Match {
+	best := Match{Name: name}
+	for i := range catalog {
+		target := normalize(catalog[i].Name)
+		for _, v := range variants(name) {
+			score := similarity(v, target)
+			applied := sortedWords(v) == sortedWords(target)
+			if applied && !best.Applied || applied == best.Applied && score > best.Score {
+				best.Score, best.Type, best.Applied = score, &catalog[i], applied
+			}
+		}
+	}
+	best.Exact = best.Score == 1
+	if best.Score < minScore {
+		best.Type = nil
+	}
+	return best
+}
+
+// similarity scores two normalized names from 0 to 1: the edit distance
+// relative to the longer name, or a near-perfect score when they hold the
+// same words in a different order.
+func similarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	if a == "" || b == "" {
+		return 0
+	}
+	if sortedWords(a) == sortedWords(b) {
+		return 0.95
+	}
+	n := max(len(a), len(b))
+	return 1 - float64(levenshtein(a, b))/float64(n)
+}
+
+func sortedWords(s string) string {
+	words := strings.Fields(s)
+	sort.Strings(words)
+	return strings.Join(words, " ")
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
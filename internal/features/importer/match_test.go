@@ -0,0 +1,77 @@
+package importer
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestMatchName(t *testing.T) {
+	catalog := []ExerciseType{
+		{ID: uuid.New(), Name: "Bench Press"},
+		{ID: uuid.New(), Name: "Barbell Squat"},
+		{ID: uuid.New(), Name: "Decline Bench Press"},
+		{ID: uuid.New(), Name: "Lat Pulldown"},
+		{ID: uuid.New(), Name: "Romanian Deadlift"},
+	}
+
+	tests := []struct {
+		name    string
+		want    string // catalog name, "" when nothing matched
+		exact   bool
+		applied bool
+	}{
+		{name: "Bench Press", want: "Bench Press", exact: true, applied: true},
+		{name: "bench-press", want: "Bench Press", exact: true, applied: true},
+		{name: "Bench Press (Barbell)", want: "Bench Press", exact: true, applied: true},
+		{name: "Squat (Barbell)", want: "Barbell Squat", exact: true, applied: true},
+		{name: "Squat Barbell", want: "Barbell Squat", applied: true},
+		{name: "Pulldown Lat", want: "Lat Pulldown", applied: true},
+		{name: "Incline Bench Press", want: "Decline Bench Press"},
+		{name: "Romanian Deadlifts", want: "Romanian Deadlift"},
+		{name: "Deadlift (Romanian)", want: "Romanian Deadlift", exact: true, applied: true},
+		{name: "Plank", want: ""},
+		{name: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := MatchName(tt.name, catalog)
+			got := ""
+			if m.Type != nil {
+				got = m.Type.Name
+			}
+			if got != tt.want {
+				t.Errorf("MatchName(%q) = %q (score %.2f), want %q", tt.name, got, m.Score, tt.want)
+			}
+			if m.Exact != tt.exact {
+				t.Errorf("MatchName(%q).Exact = %v, want %v", tt.name, m.Exact, tt.exact)
+			}
+			if m.Applied != tt.applied {
+				t.Errorf("MatchName(%q).Applied = %v, want %v", tt.name, m.Applied, tt.applied)
+			}
+		})
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		min, max float64
+	}{
+		{"bench press", "bench press", 1, 1},
+		{"press bench", "bench press", 0.95, 0.95},
+		{"bench presses", "bench press", 0.8, 0.9},
+		{"incline bench press", "decline bench press", 0.85, 0.9},
+		{"plank", "bench press", 0, 0.5},
+		{"", "bench press", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"|"+tt.b, func(t *testing.T) {
+			if got := similarity(tt.a, tt.b); got < tt.min || got > tt.max {
+				t.Errorf("similarity(%q, %q) = %.3f, want between %v and %v", tt.a, tt.b, got, tt.min, tt.max)
+			}
+		})
+	}
+}
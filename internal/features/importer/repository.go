@@ -0,0 +1,234 @@
+package importer
+
+import (
+	"be/internal/database/db"
+	"be/internal/events"
+	personalrecord "be/internal/features/personalRecord"
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Result counts what an import created.
+type Result struct {
+	PlanID   uuid.UUID
+	Sessions int
+	Sets     int
+	Records  int
+}
+
+type Repository struct {
+	DB      *sql.DB
+	records *personalrecord.Repository
+}
+
+func NewRepository() *Repository {
+	return &Repository{
+		DB:      db.DB.LD,
+		records: personalrecord.NewRepository(),
+	}
+}
+
+func (r *Repository) Catalog(ctx context.Context) ([]ExerciseType, error) {
+	rows, err := r.DB.QueryContext(ctx, `
+	SELECT id, name FROM exercise_type WHERE deleted_at IS NULL ORDER BY created_at, id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var catalog []ExerciseType
+	for rows.Next() {
+		var t ExerciseType
+		if err := rows.Scan(&t.ID, &t.Name); err != nil {
+			return nil, err
+		}
+		catalog = append(catalog, t)
+	}
+	return catalog, rows.Err()
+}
+
+// MarkDuplicates flags the sessions the user already imported from source.
+func (r *Repository) MarkDuplicates(ctx context.Context, userID uuid.UUID, source string, sessions []Session) error {
+	keys := make([]string, len(sessions))
+	for i, s := range sessions {
+		keys[i] = s.Key
+	}
+
+	rows, err := r.DB.QueryContext(ctx, `
+	SELECT import_key FROM workout_session
+	WHERE user_id = $1 AND import_source = $2 AND import_key = ANY($3)`, userID, source, pq.Array(keys))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	existing := map[string]bool{}
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return err
+		}
+		existing[key] = true
+	}
+	for i := range sessions {
+		sessions[i].Duplicate = existing[sessions[i].Key]
+	}
+	return rows.Err()
+}
+
+// Import stores the sessions as finished sessions of the user, in one
+// transaction. They are attached to a plan holding the history imported
+// from source, with one workout per workout name. Sessions already
+// imported are skipped. Exercise names are mapped to types through types;
+// sets of mapped exercises are checked for personal records.
+func (r *Repository) Import(ctx context.Context, userID uuid.UUID, source string, sessions []Session, types map[string]*uuid.UUID) (*Result, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res := &Result{}
+	if len(sessions) == 0 {
+		return res, nil
+	}
+
+	from, to := sessions[0].StartedAt, sessions[len(sessions)-1].StartedAt
+	if res.PlanID, err = historyPlan(ctx, tx, userID, source, from, to); err != nil {
+		return nil, err
+	}
+
+	workouts := map[string]uuid.UUID{}
+	for _, s := range sessions {
+		workoutID, ok := workouts[s.Workout]
+		if !ok {
+			if workoutID, err = historyWorkout(ctx, tx, res.PlanID, s.Workout); err != nil {
+				return nil, err
+			}
+			workouts[s.Workout] = workoutID
+		}
+
+		sessionID := uuid.New()
+		err := tx.QueryRowContext(ctx, `
+		INSERT INTO workout_session (id, workout_id, user_id, status, started_at, finished_at, import_source, import_key)
+		VALUES ($1, $2, $3, 'finished', $4, $5, $6, $7)
+		ON CONFLICT (user_id, import_source, import_key) WHERE import_key IS NOT NULL DO NOTHING
+		RETURNING id`,
+			sessionID, workoutID, userID, s.StartedAt.UTC(), s.FinishedAt.UTC(), source, s.Key).Scan(&sessionID)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		res.Sessions++
+
+		volumes := map[uuid.UUID]float64{}
+		for i, e := range s.Exercises {
+			typeID := types[e.Name]
+			sessionExerciseID := uuid.New()
+			_, err := tx.ExecContext(ctx, `
+			INSERT INTO session_exercise (id, session_id, exercise_type_id, name, position)
+			VALUES ($1, $2, $3, $4, $5)`, sessionExerciseID, sessionID, typeID, e.Name, i+1)
+			if err != nil {
+				return nil, err
+			}
+
+			for j, set := range e.Sets {
+				setID := uuid.New()
+				_, err := tx.ExecContext(ctx, `
+				INSERT INTO session_set (id, session_exercise_id, position, weight, reps, completed, logged_at)
+				VALUES ($1, $2, $3, $4, $5, TRUE, $6)`,
+					setID, sessionExerciseID, j+1, set.Weight, set.Reps, s.StartedAt.UTC())
+				if err != nil {
+					return nil, err
+				}
+				res.Sets++
+
+				if typeID == nil {
+					continue
+				}
+				volumes[*typeID] += set.Weight * float64(set.Reps)
+				records, err := r.records.Detect(ctx, tx, personalrecord.Performance{
+					UserID:         userID,
+					ExerciseTypeID: *typeID,
+					SessionID:      &sessionID,
+					SetID:          setID,
+					Weight:         set.Weight,
+					Reps:           set.Reps,
+					Volume:         volumes[*typeID],
+					AchievedAt:     s.StartedAt.UTC(),
+				})
+				if err != nil {
+					return nil, err
+				}
+				res.Records += len(records)
+			}
+		}
+	}
+
+	data := map[string]any{
+		"Source":   source,
+		"PlanID":   res.PlanID,
+		"Sessions": res.Sessions,
+		"Sets":     res.Sets,
+	}
+	if err := events.Emit(ctx, tx, events.HistoryImported, "training_plan", res.PlanID, &userID, data); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// historyPlan returns the user's plan of history imported from source,
+// creating it or widening its dates to cover from and to.
+func historyPlan(ctx context.Context, tx *sql.Tx, userID uuid.UUID, source string, from, to time.Time) (uuid.UUID, error) {
+	var planID uuid.UUID
+	err := tx.QueryRowContext(ctx, `
+	UPDATE training_plan
+	SET start_date = LEAST(start_date, $3), end_date = GREATEST(end_date, $4), updated_at = NOW()
+	WHERE user_id = $1 AND import_source = $2 AND deleted_at IS NULL
+	RETURNING id`, userID, source, from.UTC(), to.UTC()).Scan(&planID)
+	if err == nil || !errors.Is(err, sql.ErrNoRows) {
+		return planID, err
+	}
+
+	planID = uuid.New()
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO training_plan (id, name, start_date, end_date, user_id, import_source)
+	VALUES ($1, $2, $3, $4, $5, $6)`,
+		planID, "Imported from "+strings.ToUpper(source[:1])+source[1:], from.UTC(), to.UTC(), userID, source)
+	return planID, err
+}
+
+// historyWorkout returns the workout of the history plan called name,
+// creating it when needed.
+func historyWorkout(ctx context.Context, tx *sql.Tx, planID uuid.UUID, name string) (uuid.UUID, error) {
+	if name == "" {
+		name = "Workout"
+	}
+
+	var workoutID uuid.UUID
+	err := tx.QueryRowContext(ctx, `
+	SELECT id FROM workout WHERE training_plan_id = $1 AND name = $2 AND deleted_at IS NULL
+	ORDER BY created_at LIMIT 1`, planID, name).Scan(&workoutID)
+	if err == nil || !errors.Is(err, sql.ErrNoRows) {
+		return workoutID, err
+	}
+
+	workoutID = uuid.New()
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO workout (id, name, training_plan_id, position)
+	VALUES ($1, $2, $3, (SELECT COUNT(*) + 1 FROM workout WHERE training_plan_id = $3))`,
+		workoutID, name, planID)
+	return workoutID, err
+}
@@ -0,0 +1,238 @@
+package importer
+
+import (
+	importerService "be/gen/importer"
+	"be/internal/features/coaching"
+	"be/internal/features/user"
+	"be/internal/middleware"
+	"be/internal/utils"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// maxFileSize bounds the CSV read from a request body.
+const maxFileSize = 10 << 20
+
+type Service struct {
+	Repository *Repository
+	users      *user.Repository
+	access     *coaching.Authorizer
+}
+
+func NewService() *Service {
+	return &Service{
+		Repository: NewRepository(),
+		users:      user.NewRepository(),
+		access:     coaching.NewAuthorizer(),
+	}
+}
+
+func (s *Service) OAuth2Auth(ctx context.Context, token string, scheme *security.OAuth2Scheme) (context.Context, error) {
+	claims, err := middleware.ValidateToken(token)
+	if err != nil {
+		return ctx, &importerService.Unauthorized{Message: "Invalid token"}
+	}
+	ctx = context.WithValue(ctx, middleware.ClaimsKey, claims)
+
+	return ctx, nil
+}
+
+// athlete resolves the user receiving the history, checking the caller may
+// manage them, and returns their time zone.
+func (s *Service) athlete(ctx context.Context, id *string) (uuid.UUID, string, error) {
+	caller, err := s.users.FindCurrent(ctx)
+	if err != nil {
+		return uuid.Nil, "", &importerService.Forbidden{Message: "Forbidden"}
+	}
+	if id == nil {
+		return caller.ID, caller.Timezone, nil
+	}
+
+	userID, err := uuid.Parse(*id)
+	if err != nil {
+		return uuid.Nil, "", badRequest("invalid_user_id", "invalid user ID")
+	}
+	ok, err := s.access.CanAccess(ctx, caller.ID, caller.Admin, userID)
+	if err != nil {
+		return uuid.Nil, "", s.mapError(ctx, err)
+	}
+	if !ok {
+		return uuid.Nil, "", &importerService.Forbidden{Message: "Forbidden"}
+	}
+
+	u, err := s.users.FindByID(ctx, userID.String())
+	if err != nil {
+		return uuid.Nil, "", &importerService.NotFound{Message: "User not found"}
+	}
+	return u.ID, u.Timezone, nil
+}
+
+func (s *Service) History(ctx context.Context, payload *importerService.HistoryPayload, body io.ReadCloser) (*importerService.HistoryImport, error) {
+	defer body.Close()
+
+	userID, timezone, err := s.athlete(ctx, payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if payload.Timezone != nil {
+		timezone = *payload.Timezone
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, badRequest("invalid_timezone", "timezone must be an IANA time zone name")
+	}
+
+	format := payload.Format
+	if format == "auto" {
+		format = ""
+	}
+	// One byte past the limit tells an oversize file from one of exactly
+	// maxFileSize, rather than importing a truncated history.
+	data, err := io.ReadAll(io.LimitReader(body, maxFileSize+1))
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	if len(data) > maxFileSize {
+		return nil, badRequest("file_too_large", fmt.Sprintf("the file is larger than %d MiB", maxFileSize>>20))
+	}
+	f, rows, skipped, err := Read(bytes.NewReader(data), format, Options{Location: loc, Unit: payload.Unit})
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	sessions := Group(rows)
+
+	catalog, err := s.Repository.Catalog(ctx)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	var (
+		matches []Match
+		types   = map[string]*uuid.UUID{}
+	)
+	for _, session := range sessions {
+		for _, e := range session.Exercises {
+			if _, ok := types[e.Name]; ok {
+				continue
+			}
+			m := MatchName(e.Name, catalog)
+			matches = append(matches, m)
+			types[e.Name] = nil
+			if m.Applied {
+				types[e.Name] = &m.Type.ID
+			}
+		}
+	}
+
+	if err := s.Repository.MarkDuplicates(ctx, userID, f.Name(), sessions); err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+
+	res := toHistoryResult(f.Name(), payload.DryRun, sessions, matches, types, skipped)
+	if payload.DryRun {
+		return res, nil
+	}
+
+	fresh := make([]Session, 0, len(sessions))
+	for _, session := range sessions {
+		if !session.Duplicate {
+			fresh = append(fresh, session)
+		}
+	}
+	result, err := s.Repository.Import(ctx, userID, f.Name(), fresh, types)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	if result.PlanID != uuid.Nil {
+		planID := result.PlanID.String()
+		res.TrainingPlanID = &planID
+	}
+	// Sessions imported concurrently since the preview are skipped too.
+	res.Duplicates += len(fresh) - result.Sessions
+	res.Created, res.Sets, res.Records = result.Sessions, result.Sets, result.Records
+	return res, nil
+}
+
+// mapError converts importer and repository errors to the service error types.
+func (s *Service) mapError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, ErrUnknownFormat):
+		return badRequest("unknown_format", "the file is not a Strong or Hevy CSV export")
+	case errors.Is(err, ErrEmptyFile):
+		return badRequest("empty_file", err.Error())
+	}
+	utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+	return &importerService.InternalServerError{Message: "Internal Server error"}
+}
+
+func toHistoryResult(format string, dryRun bool, sessions []Session, matches []Match, types map[string]*uuid.UUID, skipped []RowError) *importerService.HistoryImport {
+	res := &importerService.HistoryImport{
+		Format:      format,
+		DryRun:      dryRun,
+		Sessions:    []*importerService.ImportedSession{},
+		Matches:     []*importerService.ExerciseMatch{},
+		SkippedRows: []string{},
+	}
+
+	for _, session := range sessions {
+		s := &importerService.ImportedSession{
+			StartedAt:   session.StartedAt.Format(time.RFC3339),
+			FinishedAt:  session.FinishedAt.Format(time.RFC3339),
+			WorkoutName: session.Workout,
+			Duplicate:   session.Duplicate,
+			Exercises:   []*importerService.ImportedExercise{},
+		}
+		if session.Duplicate {
+			res.Duplicates++
+		} else {
+			res.Created++
+		}
+
+		for _, e := range session.Exercises {
+			ex := &importerService.ImportedExercise{Name: e.Name, Sets: []*importerService.ImportedSet{}}
+			if typeID := types[e.Name]; typeID != nil {
+				id := typeID.String()
+				ex.ExerciseTypeID = &id
+			}
+			for _, set := range e.Sets {
+				ex.Sets = append(ex.Sets, &importerService.ImportedSet{Weight: set.Weight, Reps: set.Reps})
+				if !session.Duplicate {
+					res.Sets++
+				}
+			}
+			s.Exercises = append(s.Exercises, ex)
+		}
+		res.Sessions = append(res.Sessions, s)
+	}
+
+	for _, m := range matches {
+		match := &importerService.ExerciseMatch{Name: m.Name, Score: m.Score, Exact: m.Exact, Applied: m.Applied}
+		if m.Type != nil {
+			id := m.Type.ID.String()
+			match.ExerciseTypeID = &id
+			match.ExerciseTypeName = &m.Type.Name
+		}
+		res.Matches = append(res.Matches, match)
+	}
+
+	for _, e := range skipped {
+		res.SkippedRows = append(res.SkippedRows, e.Error())
+	}
+	return res
+}
+
+func badRequest(name, message string) *importerService.BadRequest {
+	return &importerService.BadRequest{
+		Name:    name,
+		ID:      goa.NewErrorID(),
+		Message: message,
+	}
+}
@@ -0,0 +1,99 @@
+package importer
+
+import (
+	"sort"
+	"time"
+)
+
+// Session is an imported workout: the rows sharing a start time and
+// workout name.
+type Session struct {
+	Key        string // identifies the session across imports of the same file
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Workout    string
+	Exercises  []Exercise
+	Duplicate  bool // already imported
+}
+
+type Exercise struct {
+	Name string
+	Sets []Set
+}
+
+type Set struct {
+	Weight float64
+	Reps   int
+}
+
+// Group builds the sessions of rows in chronological order. Exercises keep
+// the order they first appear in; sets are ordered by their index when the
+// format has one, and by file order otherwise.
+func Group(rows []Row) []Session {
+	type exerciseRows struct {
+		name string
+		rows []Row
+	}
+	type sessionRows struct {
+		session   Session
+		exercises []*exerciseRows
+		byName    map[string]*exerciseRows
+	}
+
+	byKey := map[string]*sessionRows{}
+	var order []*sessionRows
+	for _, r := range rows {
+		key := r.StartedAt.UTC().Format(time.RFC3339) + "|" + r.Workout
+		s, ok := byKey[key]
+		if !ok {
+			s = &sessionRows{
+				session: Session{Key: key, StartedAt: r.StartedAt, FinishedAt: r.StartedAt, Workout: r.Workout},
+				byName:  map[string]*exerciseRows{},
+			}
+			byKey[key] = s
+			order = append(order, s)
+		}
+		if r.FinishedAt != nil && r.FinishedAt.After(s.session.FinishedAt) {
+			s.session.FinishedAt = *r.FinishedAt
+		}
+
+		e, ok := s.byName[r.Exercise]
+		if !ok {
+			e = &exerciseRows{name: r.Exercise}
+			s.byName[r.Exercise] = e
+			s.exercises = append(s.exercises, e)
+		}
+		e.rows = append(e.rows, r)
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return order[i].session.StartedAt.Before(order[j].session.StartedAt)
+	})
+
+	sessions := make([]Session, 0, len(order))
+	for _, s := range order {
+		for _, e := range s.exercises {
+			if indexed(e.rows) {
+				sort.SliceStable(e.rows, func(i, j int) bool {
+					return e.rows[i].SetIndex < e.rows[j].SetIndex
+				})
+			}
+			ex := Exercise{Name: e.name}
+			for _, r := range e.rows {
+				ex.Sets = append(ex.Sets, Set{Weight: r.Weight, Reps: r.Reps})
+			}
+			s.session.Exercises = append(s.session.Exercises, ex)
+		}
+		sessions = append(sessions, s.session)
+	}
+	return sessions
+}
+
+func indexed(rows []Row) bool {
+	for _, r := range rows {
+		if r.SetIndex < 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,116 @@
+package importer
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGroup(t *testing.T) {
+	monday := time.Date(2026, 3, 2, 7, 30, 0, 0, time.UTC)
+	wednesday := monday.AddDate(0, 0, 2)
+	end := func(t time.Time, d time.Duration) *time.Time {
+		t = t.Add(d)
+		return &t
+	}
+
+	type session struct {
+		workout   string
+		startedAt time.Time
+		finished  time.Time
+		exercises []Exercise
+	}
+	tests := []struct {
+		name string
+		rows []Row
+		want []session
+	}{
+		{
+			name: "no rows",
+		},
+		{
+			name: "sets ordered by index",
+			rows: []Row{
+				{StartedAt: monday, Workout: "Push", Exercise: "Bench", SetIndex: 2, Weight: 62.5, Reps: 6},
+				{StartedAt: monday, Workout: "Push", Exercise: "Bench", SetIndex: 1, Weight: 60, Reps: 8},
+			},
+			want: []session{{"Push", monday, monday, []Exercise{
+				{Name: "Bench", Sets: []Set{{60, 8}, {62.5, 6}}},
+			}}},
+		},
+		{
+			name: "sets in file order without an index",
+			rows: []Row{
+				{StartedAt: monday, Workout: "Push", Exercise: "Bench", SetIndex: 2, Weight: 62.5, Reps: 6},
+				{StartedAt: monday, Workout: "Push", Exercise: "Bench", SetIndex: -1, Weight: 40, Reps: 10},
+			},
+			want: []session{{"Push", monday, monday, []Exercise{
+				{Name: "Bench", Sets: []Set{{62.5, 6}, {40, 10}}},
+			}}},
+		},
+		{
+			name: "exercises in order of appearance",
+			rows: []Row{
+				{StartedAt: monday, Workout: "Push", Exercise: "Bench", SetIndex: 1, Weight: 60, Reps: 8},
+				{StartedAt: monday, Workout: "Push", Exercise: "Dips", SetIndex: 1, Weight: 0, Reps: 12},
+				{StartedAt: monday, Workout: "Push", Exercise: "Bench", SetIndex: 2, Weight: 60, Reps: 8},
+			},
+			want: []session{{"Push", monday, monday, []Exercise{
+				{Name: "Bench", Sets: []Set{{60, 8}, {60, 8}}},
+				{Name: "Dips", Sets: []Set{{0, 12}}},
+			}}},
+		},
+		{
+			name: "sessions split by start and workout, in chronological order",
+			rows: []Row{
+				{StartedAt: wednesday, Workout: "Push", Exercise: "Bench", SetIndex: 1, Weight: 65, Reps: 5},
+				{StartedAt: monday, Workout: "Push", Exercise: "Bench", SetIndex: 1, Weight: 60, Reps: 8},
+				{StartedAt: monday, Workout: "Legs", Exercise: "Squat", SetIndex: 1, Weight: 100, Reps: 5},
+			},
+			want: []session{
+				{"Push", monday, monday, []Exercise{{Name: "Bench", Sets: []Set{{60, 8}}}}},
+				{"Legs", monday, monday, []Exercise{{Name: "Squat", Sets: []Set{{100, 5}}}}},
+				{"Push", wednesday, wednesday, []Exercise{{Name: "Bench", Sets: []Set{{65, 5}}}}},
+			},
+		},
+		{
+			name: "same instant in another zone",
+			rows: []Row{
+				{StartedAt: monday, Workout: "Push", Exercise: "Bench", SetIndex: 1, Weight: 60, Reps: 8},
+				{StartedAt: monday.In(time.FixedZone("CET", 3600)), Workout: "Push", Exercise: "Bench", SetIndex: 2, Weight: 60, Reps: 8},
+			},
+			want: []session{{"Push", monday, monday, []Exercise{
+				{Name: "Bench", Sets: []Set{{60, 8}, {60, 8}}},
+			}}},
+		},
+		{
+			name: "latest finish time",
+			rows: []Row{
+				{StartedAt: monday, FinishedAt: end(monday, 40*time.Minute), Workout: "Push", Exercise: "Bench", SetIndex: 1, Weight: 60, Reps: 8},
+				{StartedAt: monday, FinishedAt: end(monday, time.Hour), Workout: "Push", Exercise: "Bench", SetIndex: 2, Weight: 60, Reps: 8},
+				{StartedAt: monday, Workout: "Push", Exercise: "Bench", SetIndex: 3, Weight: 60, Reps: 8},
+			},
+			want: []session{{"Push", monday, monday.Add(time.Hour), []Exercise{
+				{Name: "Bench", Sets: []Set{{60, 8}, {60, 8}, {60, 8}}},
+			}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Group(tt.rows)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d sessions, want %d", len(got), len(tt.want))
+			}
+			for i, s := range got {
+				w := tt.want[i]
+				if s.Workout != w.workout || !s.StartedAt.Equal(w.startedAt) || !s.FinishedAt.Equal(w.finished) {
+					t.Errorf("session %d = %s %v-%v, want %s %v-%v", i, s.Workout, s.StartedAt, s.FinishedAt, w.workout, w.startedAt, w.finished)
+				}
+				if !reflect.DeepEqual(s.Exercises, w.exercises) {
+					t.Errorf("session %d exercises = %+v, want %+v", i, s.Exercises, w.exercises)
+				}
+			}
+		})
+	}
+}
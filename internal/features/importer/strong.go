@@ -0,0 +1,94 @@
+package importer
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Strong reads the CSV export of the Strong app: one row per set, with the
+// workout's local start time in Date. Weights are in the unit set in the app,
+// which newer exports write in a Weight Unit column.
+type Strong struct{}
+
+func (Strong) Name() string { return "strong" }
+
+func (Strong) Detect(h Header) bool {
+	return h.Has("date", "workout name", "exercise name", "set order", "weight", "reps")
+}
+
+func (Strong) Map(rec Record, opts Options) (Row, error) {
+	started, err := parseTime(rec.Get("date"), opts.Location, "2006-01-02 15:04:05", "2006-01-02 15:04")
+	if err != nil {
+		return Row{}, err
+	}
+	weight, err := parseNumber(rec.Get("weight"))
+	if err != nil {
+		return Row{}, fmt.Errorf("invalid weight %q", rec.Get("weight"))
+	}
+	reps, err := parseNumber(rec.Get("reps"))
+	if err != nil {
+		return Row{}, fmt.Errorf("invalid reps %q", rec.Get("reps"))
+	}
+	if reps <= 0 {
+		return Row{}, ErrSkipRow
+	}
+
+	row := Row{
+		StartedAt: started,
+		Workout:   rec.Get("workout name"),
+		Exercise:  rec.Get("exercise name"),
+		SetIndex:  -1,
+		Weight:    weight,
+		Reps:      int(reps),
+	}
+	// Warm-up and drop sets are marked with letters instead of a number.
+	if i, err := strconv.Atoi(rec.Get("set order")); err == nil {
+		row.SetIndex = i
+	}
+	switch strings.ToLower(rec.Get("weight unit")) {
+	case "kg", "kgs":
+		row.WeightUnit = "kg"
+	case "lb", "lbs":
+		row.WeightUnit = "lb"
+	}
+	if d, ok := parseDuration(rec.Get("duration")); ok {
+		finished := started.Add(d)
+		row.FinishedAt = &finished
+	}
+	return row, nil
+}
+
+var durationPart = regexp.MustCompile(`(\d+)\s*([hms])`)
+
+// parseDuration reads Strong durations such as "1h 5m" or "45m".
+func parseDuration(s string) (time.Duration, bool) {
+	parts := durationPart.FindAllStringSubmatch(s, -1)
+	if len(parts) == 0 {
+		return 0, false
+	}
+	var d time.Duration
+	for _, p := range parts {
+		n, _ := strconv.Atoi(p[1])
+		switch p[2] {
+		case "h":
+			d += time.Duration(n) * time.Hour
+		case "m":
+			d += time.Duration(n) * time.Minute
+		case "s":
+			d += time.Duration(n) * time.Second
+		}
+	}
+	return d, true
+}
+
+// parseNumber reads a decimal written with either separator. Empty values
+// are zero.
+func parseNumber(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(strings.Replace(s, ",", ".", 1), 64)
+}
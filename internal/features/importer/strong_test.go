@@ -0,0 +1,128 @@
+package importer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// record reads a line through a header, both separated by sep.
+func record(sep, header, line string) Record {
+	h := Header{}
+	for i, n := range strings.Split(header, sep) {
+		h[strings.ToLower(n)] = i
+	}
+	return Record{header: h, values: strings.Split(line, sep)}
+}
+
+func TestStrongMap(t *testing.T) {
+	const header = "Date,Workout Name,Duration,Exercise Name,Set Order,Weight,Reps,Weight Unit"
+	paris, _ := time.LoadLocation("Europe/Paris")
+	started := time.Date(2026, 3, 2, 7, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		line     string
+		loc      *time.Location
+		started  time.Time
+		finished time.Duration // after the start; 0 when there is no duration
+		setIndex int
+		unit     string
+		err      error
+	}{
+		{
+			name:     "full row",
+			line:     "2026-03-02 07:30:00,Push,1h 5m,Bench Press,2,60,8,kg",
+			started:  started,
+			finished: time.Hour + 5*time.Minute,
+			setIndex: 2,
+			unit:     "kg",
+		},
+		{
+			name:     "local time of the export",
+			line:     "2026-03-02 07:30:00,Push,45m,Bench Press,1,60,8,",
+			loc:      paris,
+			started:  started.Add(-time.Hour),
+			finished: 45 * time.Minute,
+			setIndex: 1,
+		},
+		{
+			name:     "minutes precision and seconds duration",
+			line:     "2026-03-02 07:30,Push,50m 30s,Bench Press,1,60,8,lbs",
+			started:  started,
+			finished: 50*time.Minute + 30*time.Second,
+			setIndex: 1,
+			unit:     "lb",
+		},
+		{
+			name:     "warm-up set without an order",
+			line:     "2026-03-02 07:30:00,Push,,Bench Press,W,40,10,",
+			started:  started,
+			setIndex: -1,
+		},
+		{
+			name: "no reps",
+			line: "2026-03-02 07:30:00,Push,45m,Rowing,1,0,0,",
+			err:  ErrSkipRow,
+		},
+		{
+			name: "invalid date",
+			line: "02/03/2026,Push,45m,Bench Press,1,60,8,",
+			err:  errAny,
+		},
+		{
+			name: "invalid weight",
+			line: "2026-03-02 07:30:00,Push,45m,Bench Press,1,sixty,8,",
+			err:  errAny,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row, err := Strong{}.Map(record(",", header, tt.line), Options{Location: tt.loc})
+			if checkErr(t, err, tt.err) {
+				return
+			}
+			if !row.StartedAt.Equal(tt.started) {
+				t.Errorf("StartedAt = %v, want %v", row.StartedAt, tt.started)
+			}
+			checkFinished(t, row, tt.finished)
+			if row.SetIndex != tt.setIndex {
+				t.Errorf("SetIndex = %d, want %d", row.SetIndex, tt.setIndex)
+			}
+			if row.WeightUnit != tt.unit {
+				t.Errorf("WeightUnit = %q, want %q", row.WeightUnit, tt.unit)
+			}
+		})
+	}
+}
+
+// errAny stands for any error in the test tables.
+var errAny = errors.New("any error")
+
+// checkErr compares err to the expected one and reports whether the test
+// case ends there.
+func checkErr(t *testing.T, err, want error) bool {
+	t.Helper()
+	switch {
+	case want == nil && err != nil:
+		t.Fatalf("Map() error = %v", err)
+	case want == errAny && err == nil, want != nil && want != errAny && !errors.Is(err, want):
+		t.Fatalf("Map() error = %v, want %v", err, want)
+	}
+	return want != nil
+}
+
+func checkFinished(t *testing.T, row Row, after time.Duration) {
+	t.Helper()
+	if after == 0 {
+		if row.FinishedAt != nil {
+			t.Errorf("FinishedAt = %v, want none", row.FinishedAt)
+		}
+		return
+	}
+	if row.FinishedAt == nil || row.FinishedAt.Sub(row.StartedAt) != after {
+		t.Errorf("FinishedAt = %v, want %v after the start", row.FinishedAt, after)
+	}
+}
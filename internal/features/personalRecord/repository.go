@@ -0,0 +1,216 @@
+package personalrecord
+
+import (
+	"be/internal/database/db"
+	"be/internal/events"
+	"be/internal/features/analytics"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	KindMaxWeight     = "max_weight"
+	KindMaxReps       = "max_reps"
+	KindOneRepMax     = "estimated_one_rep_max"
+	KindSessionVolume = "session_volume"
+)
+
+type Record struct {
+	ID             uuid.UUID
+	UserID         uuid.UUID
+	ExerciseTypeID uuid.UUID
+	Kind           string
+	Value          float64
+	PreviousValue  *float64
+	Weight         *float64 // weight the reps were performed at, for max_reps
+	Reps           *int
+	SessionID      *uuid.UUID
+	SetID          *uuid.UUID
+	AchievedAt     time.Time
+}
+
+// Performance is a logged set checked for new records. Volume is the total
+// weight x reps of the exercise type within the same session, including the
+// set itself.
+type Performance struct {
+	UserID         uuid.UUID
+	ExerciseTypeID uuid.UUID
+	SessionID      *uuid.UUID
+	SetID          uuid.UUID
+	Weight         float64
+	Reps           int
+	Volume         float64
+	AchievedAt     time.Time
+}
+
+type Repository struct {
+	DB *sql.DB
+}
+
+func NewRepository() *Repository {
+	return &Repository{DB: db.DB.LD}
+}
+
+// candidates lists the records p could set.
+func (p Performance) candidates() []Record {
+	if p.Reps <= 0 {
+		return nil
+	}
+
+	reps, weight, setID := p.Reps, p.Weight, p.SetID
+	base := Record{
+		UserID:         p.UserID,
+		ExerciseTypeID: p.ExerciseTypeID,
+		Reps:           &reps,
+		SessionID:      p.SessionID,
+		SetID:          &setID,
+		AchievedAt:     p.AchievedAt,
+	}
+
+	maxReps := base
+	maxReps.Kind, maxReps.Value, maxReps.Weight = KindMaxReps, float64(p.Reps), &weight
+	records := []Record{maxReps}
+
+	if p.Weight > 0 {
+		maxWeight := base
+		maxWeight.Kind, maxWeight.Value = KindMaxWeight, p.Weight
+		records = append(records, maxWeight)
+
+		if estimate, ok := analytics.EstimateOneRepMax(analytics.FormulaEpley, p.Weight, p.Reps); ok {
+			oneRepMax := base
+			oneRepMax.Kind, oneRepMax.Value = KindOneRepMax, estimate
+			records = append(records, oneRepMax)
+		}
+	}
+	if p.Volume > 0 {
+		volume := base
+		volume.Kind, volume.Value = KindSessionVolume, p.Volume
+		records = append(records, volume)
+	}
+	return records
+}
+
+// Detect compares p with the user's current bests and stores the records it
+// beats, emitting a PersonalRecordAchieved event for each. It runs in the
+// transaction that logged the set so records and sets are committed together.
+func (r *Repository) Detect(ctx context.Context, tx *sql.Tx, p Performance) ([]Record, error) {
+	// Serialize detection per user and exercise type, so that two sets
+	// logged at once cannot both be recorded against the same previous best.
+	_, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1::text || $2::text))`,
+		p.UserID, p.ExerciseTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	var achieved []Record
+	for _, rec := range p.candidates() {
+		var (
+			bestID      uuid.UUID
+			best        float64
+			bestSession *uuid.UUID
+		)
+		err := tx.QueryRowContext(ctx, `
+		SELECT id, value, session_id
+		FROM personal_record
+		WHERE user_id = $1 AND exercise_type_id = $2 AND kind = $3
+		  AND ($4::decimal IS NULL OR weight = $4)
+		ORDER BY value DESC, achieved_at DESC
+		LIMIT 1`, rec.UserID, rec.ExerciseTypeID, rec.Kind, rec.Weight).
+			Scan(&bestID, &best, &bestSession)
+
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+		case err != nil:
+			return nil, err
+		case rec.Value <= best:
+			continue
+		case rec.Kind == KindSessionVolume && bestSession != nil && p.SessionID != nil && *bestSession == *p.SessionID:
+			// The session already holds the volume record: raise it
+			// instead of adding a history entry per set.
+			_, err := tx.ExecContext(ctx, `
+			UPDATE personal_record SET value = $2, set_id = $3, reps = $4, achieved_at = $5
+			WHERE id = $1`, bestID, rec.Value, rec.SetID, rec.Reps, rec.AchievedAt)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		default:
+			previous := best
+			rec.PreviousValue = &previous
+		}
+
+		rec.ID = uuid.New()
+		_, err = tx.ExecContext(ctx, `
+		INSERT INTO personal_record (id, user_id, exercise_type_id, kind, value, previous_value, weight, reps, session_id, set_id, achieved_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+			rec.ID, rec.UserID, rec.ExerciseTypeID, rec.Kind, rec.Value, rec.PreviousValue,
+			rec.Weight, rec.Reps, rec.SessionID, rec.SetID, rec.AchievedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := events.Emit(ctx, tx, events.PersonalRecordAchieved, "personal_record", rec.ID, &rec.UserID, rec); err != nil {
+			return nil, err
+		}
+		achieved = append(achieved, rec)
+	}
+	return achieved, nil
+}
+
+const recordColumns = `id, user_id, exercise_type_id, kind, value, previous_value, weight, reps, session_id, set_id, achieved_at`
+
+func scanRecords(rows *sql.Rows) ([]Record, error) {
+	defer rows.Close()
+
+	var records []Record
+	for rows.Next() {
+		var rec Record
+		err := rows.Scan(&rec.ID, &rec.UserID, &rec.ExerciseTypeID, &rec.Kind, &rec.Value, &rec.PreviousValue,
+			&rec.Weight, &rec.Reps, &rec.SessionID, &rec.SetID, &rec.AchievedAt)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+	return records, rows.Err()
+}
+
+// Current returns the user's standing records: the best per exercise type
+// and kind, and per weight for max_reps.
+func (r *Repository) Current(ctx context.Context, userID uuid.UUID, exerciseTypeID *uuid.UUID, kind *string) ([]Record, error) {
+	query := `
+	SELECT DISTINCT ON (exercise_type_id, kind, weight) ` + recordColumns + `
+	FROM personal_record
+	WHERE user_id = $1
+	  AND ($2::uuid IS NULL OR exercise_type_id = $2)
+	  AND ($3::text IS NULL OR kind = $3)
+	ORDER BY exercise_type_id, kind, weight, value DESC, achieved_at DESC`
+
+	rows, err := r.DB.QueryContext(ctx, query, userID, exerciseTypeID, kind)
+	if err != nil {
+		return nil, err
+	}
+	return scanRecords(rows)
+}
+
+// History returns every record the user set, newest first.
+func (r *Repository) History(ctx context.Context, limit, offset int, userID uuid.UUID, exerciseTypeID *uuid.UUID, kind *string) ([]Record, error) {
+	query := `
+	SELECT ` + recordColumns + `
+	FROM personal_record
+	WHERE user_id = $3
+	  AND ($4::uuid IS NULL OR exercise_type_id = $4)
+	  AND ($5::text IS NULL OR kind = $5)
+	ORDER BY achieved_at DESC
+	LIMIT $1 OFFSET $2`
+
+	rows, err := r.DB.QueryContext(ctx, query, limit, offset, userID, exerciseTypeID, kind)
+	if err != nil {
+		return nil, err
+	}
+	return scanRecords(rows)
+}
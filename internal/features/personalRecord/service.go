@@ -0,0 +1,139 @@
+package personalrecord
+
+import (
+	recordService "be/gen/record"
+	"be/internal/features/coaching"
+	"be/internal/features/user"
+	"be/internal/middleware"
+	"be/internal/utils"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+type Service struct {
+	Repository *Repository
+	users      *user.Repository
+	access     *coaching.Authorizer
+}
+
+func NewService() *Service {
+	return &Service{
+		Repository: NewRepository(),
+		users:      user.NewRepository(),
+		access:     coaching.NewAuthorizer(),
+	}
+}
+
+func (s *Service) OAuth2Auth(ctx context.Context, token string, scheme *security.OAuth2Scheme) (context.Context, error) {
+	claims, err := middleware.ValidateToken(token)
+	if err != nil {
+		return ctx, &recordService.Unauthorized{Message: "Invalid token"}
+	}
+	ctx = context.WithValue(ctx, middleware.ClaimsKey, claims)
+
+	return ctx, nil
+}
+
+// filters resolves the athlete and the exercise type of a records request.
+// Other users' records are readable by admins and their coaches.
+func (s *Service) filters(ctx context.Context, userID, exerciseTypeID *string) (uuid.UUID, *uuid.UUID, error) {
+	u, err := s.users.FindCurrent(ctx)
+	if err != nil {
+		return uuid.Nil, nil, &recordService.Forbidden{Message: "Forbidden"}
+	}
+
+	athlete := u.ID
+	if userID != nil {
+		id, err := uuid.Parse(*userID)
+		if err != nil {
+			return uuid.Nil, nil, badRequest("invalid_id", "invalid user ID")
+		}
+		ok, err := s.access.CanAccess(ctx, u.ID, u.Admin, id)
+		if err != nil {
+			utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+			return uuid.Nil, nil, &recordService.InternalServerError{Message: "Internal Server error"}
+		}
+		if !ok {
+			return uuid.Nil, nil, &recordService.Forbidden{Message: "Forbidden"}
+		}
+		athlete = id
+	}
+
+	var typeID *uuid.UUID
+	if exerciseTypeID != nil {
+		id, err := uuid.Parse(*exerciseTypeID)
+		if err != nil {
+			return uuid.Nil, nil, badRequest("invalid_id", "invalid exercise type ID")
+		}
+		typeID = &id
+	}
+	return athlete, typeID, nil
+}
+
+func (s *Service) List(ctx context.Context, payload *recordService.ListPayload) ([]*recordService.PersonalRecord, error) {
+	userID, exerciseTypeID, err := s.filters(ctx, payload.UserID, payload.ExerciseTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.Repository.Current(ctx, userID, exerciseTypeID, payload.Kind)
+	if err != nil {
+		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+		return nil, &recordService.InternalServerError{Message: "Internal Server error"}
+	}
+	return toResults(records), nil
+}
+
+func (s *Service) History(ctx context.Context, payload *recordService.HistoryPayload) ([]*recordService.PersonalRecord, error) {
+	userID, exerciseTypeID, err := s.filters(ctx, payload.UserID, payload.ExerciseTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.Repository.History(ctx, payload.Limit, payload.Offset, userID, exerciseTypeID, payload.Kind)
+	if err != nil {
+		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+		return nil, &recordService.InternalServerError{Message: "Internal Server error"}
+	}
+	return toResults(records), nil
+}
+
+func toResults(records []Record) []*recordService.PersonalRecord {
+	res := []*recordService.PersonalRecord{}
+	for _, rec := range records {
+		r := &recordService.PersonalRecord{
+			ID:             rec.ID.String(),
+			UserID:         rec.UserID.String(),
+			ExerciseTypeID: rec.ExerciseTypeID.String(),
+			Kind:           rec.Kind,
+			Value:          rec.Value,
+			PreviousValue:  rec.PreviousValue,
+			Weight:         rec.Weight,
+			Reps:           rec.Reps,
+			AchievedAt:     rec.AchievedAt.Format(time.RFC3339),
+		}
+		if rec.SessionID != nil {
+			sessionID := rec.SessionID.String()
+			r.SessionID = &sessionID
+		}
+		if rec.SetID != nil {
+			setID := rec.SetID.String()
+			r.SetID = &setID
+		}
+		res = append(res, r)
+	}
+	return res
+}
+
+func badRequest(name, message string) *recordService.BadRequest {
+	return &recordService.BadRequest{
+		Name:    name,
+		ID:      goa.NewErrorID(),
+		Message: message,
+	}
+}
@@ -0,0 +1,268 @@
+package progression
+
+import (
+	"be/internal/database/db"
+	"be/internal/events"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrRuleNotFound     = errors.New("progression rule not found")
+	ErrExerciseNotFound = errors.New("exercise not found")
+)
+
+// Change records a target adjustment made by a rule and why it was made.
+type Change struct {
+	ID             uuid.UUID
+	RuleID         uuid.UUID
+	ExerciseID     uuid.UUID
+	SessionID      uuid.UUID
+	Outcome        string
+	PreviousWeight float64
+	NewWeight      float64
+	PreviousReps   int
+	NewReps        int
+	Reason         string
+	CreatedAt      time.Time
+}
+
+type Repository struct {
+	DB *sql.DB
+}
+
+func NewRepository() *Repository {
+	return &Repository{DB: db.DB.LD}
+}
+
+const ruleColumns = `id, exercise_id, kind, increment, min_reps, max_reps, percentage,
+	deload_after, deload_percentage, misses, created_at, updated_at`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanRule(row rowScanner, r *Rule) error {
+	return row.Scan(&r.ID, &r.ExerciseID, &r.Kind, &r.Increment, &r.MinReps, &r.MaxReps, &r.Percentage,
+		&r.DeloadAfter, &r.DeloadPercentage, &r.Misses, &r.CreatedAt, &r.UpdatedAt)
+}
+
+// ExerciseOwner returns the user owning the plan of the exercise, or the
+// owner of the template it belongs to.
+func (r *Repository) ExerciseOwner(ctx context.Context, exerciseID uuid.UUID) (uuid.UUID, error) {
+	query := `
+	SELECT COALESCE(tp.user_id, tp.owner_id)
+	FROM exercise e
+	JOIN workout w ON w.id = e.workout_id
+	JOIN training_plan tp ON tp.id = w.training_plan_id
+	WHERE e.id = $1 AND e.deleted_at IS NULL AND w.deleted_at IS NULL AND tp.deleted_at IS NULL`
+
+	var owner *uuid.UUID
+	err := r.DB.QueryRowContext(ctx, query, exerciseID).Scan(&owner)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, ErrExerciseNotFound
+		}
+		return uuid.Nil, err
+	}
+	if owner == nil {
+		return uuid.Nil, ErrExerciseNotFound
+	}
+	return *owner, nil
+}
+
+func (r *Repository) FindRule(ctx context.Context, exerciseID uuid.UUID) (*Rule, error) {
+	var rule Rule
+	err := scanRule(r.DB.QueryRowContext(ctx, `
+	SELECT `+ruleColumns+` FROM progression_rule WHERE exercise_id = $1`, exerciseID), &rule)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRuleNotFound
+		}
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// SaveRule creates or replaces the rule of the exercise. Replacing a rule
+// resets its miss count.
+func (r *Repository) SaveRule(ctx context.Context, rule Rule) (*Rule, error) {
+	var saved Rule
+	err := scanRule(r.DB.QueryRowContext(ctx, `
+	INSERT INTO progression_rule (id, exercise_id, kind, increment, min_reps, max_reps, percentage, deload_after, deload_percentage)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	ON CONFLICT (exercise_id) DO UPDATE SET
+		kind = EXCLUDED.kind,
+		increment = EXCLUDED.increment,
+		min_reps = EXCLUDED.min_reps,
+		max_reps = EXCLUDED.max_reps,
+		percentage = EXCLUDED.percentage,
+		deload_after = EXCLUDED.deload_after,
+		deload_percentage = EXCLUDED.deload_percentage,
+		misses = 0,
+		updated_at = NOW()
+	RETURNING `+ruleColumns,
+		uuid.New(), rule.ExerciseID, rule.Kind, rule.Increment, rule.MinReps, rule.MaxReps, rule.Percentage,
+		rule.DeloadAfter, rule.DeloadPercentage), &saved)
+	if err != nil {
+		return nil, err
+	}
+	return &saved, nil
+}
+
+func (r *Repository) DeleteRule(ctx context.Context, exerciseID uuid.UUID) error {
+	res, err := r.DB.ExecContext(ctx, `DELETE FROM progression_rule WHERE exercise_id = $1`, exerciseID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrRuleNotFound
+	}
+	return nil
+}
+
+// History lists the changes made to the exercise's targets, newest first.
+func (r *Repository) History(ctx context.Context, exerciseID uuid.UUID, limit, offset int) ([]Change, error) {
+	rows, err := r.DB.QueryContext(ctx, `
+	SELECT id, rule_id, exercise_id, session_id, outcome, previous_weight, new_weight,
+		previous_reps, new_reps, reason, created_at
+	FROM progression_change
+	WHERE exercise_id = $1
+	ORDER BY created_at DESC
+	LIMIT $2 OFFSET $3`, exerciseID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := []Change{}
+	for rows.Next() {
+		var c Change
+		err := rows.Scan(&c.ID, &c.RuleID, &c.ExerciseID, &c.SessionID, &c.Outcome, &c.PreviousWeight, &c.NewWeight,
+			&c.PreviousReps, &c.NewReps, &c.Reason, &c.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, c)
+	}
+	return changes, rows.Err()
+}
+
+// Apply runs the rules of the exercises of a finished session and writes
+// the next targets to their planned sets, recording each change and
+// emitting a ProgressionApplied event. It runs in the transaction that
+// finishes the session.
+func (r *Repository) Apply(ctx context.Context, tx *sql.Tx, sessionID, userID uuid.UUID) ([]Change, error) {
+	type sessionRule struct {
+		sessionExerciseID uuid.UUID
+		rule              Rule
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+	SELECT se.id, pr.id, pr.exercise_id, pr.kind, pr.increment, pr.min_reps, pr.max_reps, pr.percentage,
+		pr.deload_after, pr.deload_percentage, pr.misses, pr.created_at, pr.updated_at
+	FROM session_exercise se
+	JOIN progression_rule pr ON pr.exercise_id = se.exercise_id
+	WHERE se.session_id = $1
+	ORDER BY se.position
+	FOR UPDATE OF pr`, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	var rules []sessionRule
+	for rows.Next() {
+		var sr sessionRule
+		err := rows.Scan(&sr.sessionExerciseID, &sr.rule.ID, &sr.rule.ExerciseID, &sr.rule.Kind, &sr.rule.Increment,
+			&sr.rule.MinReps, &sr.rule.MaxReps, &sr.rule.Percentage, &sr.rule.DeloadAfter, &sr.rule.DeloadPercentage,
+			&sr.rule.Misses, &sr.rule.CreatedAt, &sr.rule.UpdatedAt)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		rules = append(rules, sr)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	for _, sr := range rules {
+		sets, err := performedSets(ctx, tx, sr.sessionExerciseID)
+		if err != nil {
+			return nil, err
+		}
+		d, ok := sr.rule.Decide(sets)
+		if !ok {
+			continue
+		}
+
+		_, err = tx.ExecContext(ctx, `
+		UPDATE exercise_set SET weight = $2, reps = $3, updated_at = NOW()
+		WHERE exercise_id = $1 AND deleted_at IS NULL`, sr.rule.ExerciseID, d.Next.Weight, d.Next.Reps)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+		UPDATE progression_rule SET misses = $2, updated_at = NOW() WHERE id = $1`, sr.rule.ID, d.Misses)
+		if err != nil {
+			return nil, err
+		}
+
+		c := Change{
+			ID:             uuid.New(),
+			RuleID:         sr.rule.ID,
+			ExerciseID:     sr.rule.ExerciseID,
+			SessionID:      sessionID,
+			Outcome:        d.Outcome,
+			PreviousWeight: d.Previous.Weight,
+			NewWeight:      d.Next.Weight,
+			PreviousReps:   d.Previous.Reps,
+			NewReps:        d.Next.Reps,
+			Reason:         d.Reason,
+		}
+		err = tx.QueryRowContext(ctx, `
+		INSERT INTO progression_change (id, rule_id, exercise_id, session_id, outcome, previous_weight, new_weight,
+			previous_reps, new_reps, reason)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING created_at`,
+			c.ID, c.RuleID, c.ExerciseID, c.SessionID, c.Outcome, c.PreviousWeight, c.NewWeight,
+			c.PreviousReps, c.NewReps, c.Reason).Scan(&c.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := events.Emit(ctx, tx, events.ProgressionApplied, "exercise", c.ExerciseID, &userID, c); err != nil {
+			return nil, err
+		}
+		changes = append(changes, c)
+	}
+	return changes, nil
+}
+
+func performedSets(ctx context.Context, tx *sql.Tx, sessionExerciseID uuid.UUID) ([]PerformedSet, error) {
+	rows, err := tx.QueryContext(ctx, `
+	SELECT planned_weight, planned_reps, weight, reps, completed
+	FROM session_set
+	WHERE session_exercise_id = $1
+	ORDER BY position`, sessionExerciseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sets []PerformedSet
+	for rows.Next() {
+		var s PerformedSet
+		if err := rows.Scan(&s.PlannedWeight, &s.PlannedReps, &s.Weight, &s.Reps, &s.Completed); err != nil {
+			return nil, err
+		}
+		sets = append(sets, s)
+	}
+	return sets, rows.Err()
+}
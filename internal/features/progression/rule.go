@@ -0,0 +1,221 @@
+package progression
+
+import (
+	"be/internal/features/analytics"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	KindLinear            = "linear"
+	KindDoubleProgression = "double_progression"
+	KindPercentage        = "percentage_of_one_rep_max"
+
+	OutcomeIncrease = "increase"
+	OutcomeHold     = "hold"
+	OutcomeDecrease = "decrease"
+	OutcomeDeload   = "deload"
+)
+
+// weightStep is the plate increment next targets are rounded to (kg).
+const weightStep = 0.5
+
+// Rule is the progression attached to a planned exercise. Rules prescribe
+// straight sets: the next target is written to every planned set.
+type Rule struct {
+	ID               uuid.UUID
+	ExerciseID       uuid.UUID
+	Kind             string
+	Increment        float64  // kg added when the target is met
+	MinReps          *int     // rep range of double progression
+	MaxReps          *int     // rep range of double progression
+	Percentage       *float64 // fraction of the estimated 1RM, for percentage_of_one_rep_max
+	DeloadAfter      int      // consecutive misses before a deload
+	DeloadPercentage float64  // fraction of the weight removed by a deload
+	Misses           int      // consecutive sessions the target was missed
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// PerformedSet is a set of a finished session with its planned targets.
+type PerformedSet struct {
+	PlannedWeight *float64
+	PlannedReps   *int
+	Weight        *float64
+	Reps          *int
+	Completed     bool
+}
+
+// Target is the weight and reps prescribed for each set of an exercise.
+type Target struct {
+	Weight float64
+	Reps   int
+}
+
+// Decision is the outcome of applying a rule to a finished session.
+type Decision struct {
+	Outcome  string
+	Previous Target
+	Next     Target
+	Misses   int
+	Reason   string
+}
+
+// Decide computes the next session's target from the sets performed in a
+// session. ok is false when the session has no planned set to progress from.
+func (r Rule) Decide(sets []PerformedSet) (d Decision, ok bool) {
+	planned := 0
+	for _, s := range sets {
+		if s.PlannedWeight == nil || s.PlannedReps == nil {
+			continue
+		}
+		// The heaviest planned set is the reference target.
+		if planned == 0 || *s.PlannedWeight > d.Previous.Weight {
+			d.Previous = Target{Weight: *s.PlannedWeight, Reps: *s.PlannedReps}
+		}
+		planned++
+	}
+	if planned == 0 {
+		return Decision{}, false
+	}
+
+	switch r.Kind {
+	case KindDoubleProgression:
+		return r.doubleProgression(sets, d), true
+	case KindPercentage:
+		return r.percentage(sets, d), true
+	default:
+		return r.linear(sets, d), true
+	}
+}
+
+func (r Rule) linear(sets []PerformedSet, d Decision) Decision {
+	hit, total := countHit(sets, 0)
+	if hit < total {
+		return r.miss(d, hit, total)
+	}
+	d.Outcome, d.Misses = OutcomeIncrease, 0
+	d.Next = Target{Weight: roundWeight(d.Previous.Weight + r.Increment), Reps: d.Previous.Reps}
+	d.Reason = fmt.Sprintf("all %d sets completed at %s kg x %d: +%s kg",
+		total, formatWeight(d.Previous.Weight), d.Previous.Reps, formatWeight(r.Increment))
+	return d
+}
+
+func (r Rule) doubleProgression(sets []PerformedSet, d Decision) Decision {
+	minReps, maxReps := d.Previous.Reps, d.Previous.Reps
+	if r.MinReps != nil {
+		minReps = *r.MinReps
+	}
+	if r.MaxReps != nil {
+		maxReps = *r.MaxReps
+	}
+
+	if hit, total := countHit(sets, maxReps); hit == total {
+		d.Outcome, d.Misses = OutcomeIncrease, 0
+		d.Next = Target{Weight: roundWeight(d.Previous.Weight + r.Increment), Reps: minReps}
+		d.Reason = fmt.Sprintf("all %d sets reached the top of the %d-%d rep range: +%s kg, back to %d reps",
+			total, minReps, maxReps, formatWeight(r.Increment), minReps)
+		return d
+	}
+
+	hit, total := countHit(sets, 0)
+	if hit < total {
+		d = r.miss(d, hit, total)
+		if d.Outcome == OutcomeDeload {
+			d.Next.Reps = minReps
+		}
+		return d
+	}
+	d.Outcome, d.Misses = OutcomeIncrease, 0
+	d.Next = Target{Weight: d.Previous.Weight, Reps: min(d.Previous.Reps+1, maxReps)}
+	d.Reason = fmt.Sprintf("all %d sets completed at %d reps: %d reps at %s kg",
+		total, d.Previous.Reps, d.Next.Reps, formatWeight(d.Next.Weight))
+	return d
+}
+
+func (r Rule) percentage(sets []PerformedSet, d Decision) Decision {
+	var best float64
+	for _, s := range sets {
+		if !s.Completed || s.Weight == nil || s.Reps == nil {
+			continue
+		}
+		if estimate, ok := analytics.EstimateOneRepMax(analytics.FormulaEpley, *s.Weight, *s.Reps); ok && estimate > best {
+			best = estimate
+		}
+	}
+
+	d.Misses, d.Next = 0, d.Previous
+	if best == 0 || r.Percentage == nil {
+		d.Outcome = OutcomeHold
+		d.Reason = "no completed set to estimate the one-rep max from: target unchanged"
+		return d
+	}
+
+	d.Next.Weight = roundWeight(best * *r.Percentage)
+	switch {
+	case d.Next.Weight > d.Previous.Weight:
+		d.Outcome = OutcomeIncrease
+	case d.Next.Weight < d.Previous.Weight:
+		d.Outcome = OutcomeDecrease
+	default:
+		d.Outcome = OutcomeHold
+	}
+	d.Reason = fmt.Sprintf("estimated one-rep max %s kg: %s%% is %s kg",
+		formatWeight(best), formatWeight(*r.Percentage*100), formatWeight(d.Next.Weight))
+	return d
+}
+
+// miss holds the target after a missed session, or deloads it once the
+// target has been missed DeloadAfter times in a row.
+func (r Rule) miss(d Decision, hit, total int) Decision {
+	d.Misses = r.Misses + 1
+	d.Next = d.Previous
+
+	if r.DeloadAfter > 0 && d.Misses >= r.DeloadAfter {
+		d.Outcome, d.Misses = OutcomeDeload, 0
+		d.Next.Weight = roundWeight(d.Previous.Weight * (1 - r.DeloadPercentage))
+		d.Reason = fmt.Sprintf("target missed in %d consecutive sessions (%d/%d sets): deload %s%% to %s kg",
+			r.Misses+1, hit, total, formatWeight(r.DeloadPercentage*100), formatWeight(d.Next.Weight))
+		return d
+	}
+
+	d.Outcome = OutcomeHold
+	d.Reason = fmt.Sprintf("target missed (%d/%d sets completed): %s kg x %d kept",
+		hit, total, formatWeight(d.Previous.Weight), d.Previous.Reps)
+	if r.DeloadAfter > 0 {
+		d.Reason += fmt.Sprintf(", %d of %d misses before a deload", d.Misses, r.DeloadAfter)
+	}
+	return d
+}
+
+// countHit counts the planned sets completed at their planned weight with at
+// least reps repetitions, or their planned reps when reps is 0.
+func countHit(sets []PerformedSet, reps int) (hit, total int) {
+	for _, s := range sets {
+		if s.PlannedWeight == nil || s.PlannedReps == nil {
+			continue
+		}
+		total++
+
+		want := reps
+		if want == 0 {
+			want = *s.PlannedReps
+		}
+		if s.Completed && s.Weight != nil && s.Reps != nil &&
+			*s.Weight >= *s.PlannedWeight && *s.Reps >= want {
+			hit++
+		}
+	}
+	return hit, total
+}
+
+func roundWeight(w float64) float64 {
+	return math.Max(0, math.Round(w/weightStep)*weightStep)
+}
+
+func formatWeight(w float64) string {
+	return fmt.Sprintf("%g", math.Round(w*100)/100)
+}
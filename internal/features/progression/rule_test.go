@@ -0,0 +1,273 @@
+package progression
+
+import (
+	"testing"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+// done is a completed set planned at weight x reps.
+func done(plannedWeight float64, plannedReps int, weight float64, reps int) PerformedSet {
+	return PerformedSet{
+		PlannedWeight: &plannedWeight,
+		PlannedReps:   &plannedReps,
+		Weight:        &weight,
+		Reps:          &reps,
+		Completed:     true,
+	}
+}
+
+// repeat returns n copies of s.
+func repeat(n int, s PerformedSet) []PerformedSet {
+	sets := make([]PerformedSet, n)
+	for i := range sets {
+		sets[i] = s
+	}
+	return sets
+}
+
+func TestRuleDecide(t *testing.T) {
+	linear := Rule{Kind: KindLinear, Increment: 2.5, DeloadAfter: 3, DeloadPercentage: 0.1}
+	double := Rule{Kind: KindDoubleProgression, Increment: 2.5, MinReps: ptr(8), MaxReps: ptr(12), DeloadAfter: 2, DeloadPercentage: 0.1}
+	percentage := Rule{Kind: KindPercentage, Percentage: ptr(0.8)}
+	with := func(r Rule, modify func(r *Rule)) Rule {
+		modify(&r)
+		return r
+	}
+
+	tests := []struct {
+		name    string
+		rule    Rule
+		sets    []PerformedSet
+		outcome string
+		next    Target
+		misses  int
+		noPlan  bool
+	}{
+		{
+			name:   "no planned set",
+			rule:   linear,
+			sets:   []PerformedSet{{Weight: ptr(100.0), Reps: ptr(5), Completed: true}},
+			noPlan: true,
+		},
+		{
+			name:    "linear all sets completed",
+			rule:    linear,
+			sets:    repeat(3, done(100, 5, 100, 5)),
+			outcome: OutcomeIncrease,
+			next:    Target{102.5, 5},
+		},
+		{
+			name:    "linear heavier than planned",
+			rule:    linear,
+			sets:    repeat(3, done(100, 5, 105, 6)),
+			outcome: OutcomeIncrease,
+			next:    Target{102.5, 5},
+		},
+		{
+			name:    "linear reference is the heaviest planned set",
+			rule:    linear,
+			sets:    []PerformedSet{done(60, 8, 60, 8), done(100, 5, 100, 5), done(100, 5, 100, 5)},
+			outcome: OutcomeIncrease,
+			next:    Target{102.5, 5},
+		},
+		{
+			name:    "linear unplanned sets are ignored",
+			rule:    linear,
+			sets:    []PerformedSet{done(100, 5, 100, 5), {Weight: ptr(100.0), Reps: ptr(2), Completed: true}},
+			outcome: OutcomeIncrease,
+			next:    Target{102.5, 5},
+		},
+		{
+			name:    "linear set short of reps",
+			rule:    linear,
+			sets:    []PerformedSet{done(100, 5, 100, 5), done(100, 5, 100, 5), done(100, 5, 100, 4)},
+			outcome: OutcomeHold,
+			next:    Target{100, 5},
+			misses:  1,
+		},
+		{
+			name: "linear set not completed",
+			rule: linear,
+			sets: []PerformedSet{done(100, 5, 100, 5), func() PerformedSet {
+				s := done(100, 5, 100, 5)
+				s.Completed = false
+				return s
+			}()},
+			outcome: OutcomeHold,
+			next:    Target{100, 5},
+			misses:  1,
+		},
+		{
+			name:    "linear miss before the deload",
+			rule:    with(linear, func(r *Rule) { r.Misses = 1 }),
+			sets:    repeat(3, done(100, 5, 100, 3)),
+			outcome: OutcomeHold,
+			next:    Target{100, 5},
+			misses:  2,
+		},
+		{
+			name:    "linear deload after consecutive misses",
+			rule:    with(linear, func(r *Rule) { r.Misses = 2 }),
+			sets:    repeat(3, done(100, 5, 100, 3)),
+			outcome: OutcomeDeload,
+			next:    Target{90, 5},
+		},
+		{
+			name:    "linear without deload",
+			rule:    with(linear, func(r *Rule) { r.DeloadAfter, r.Misses = 0, 7 }),
+			sets:    repeat(3, done(100, 5, 100, 3)),
+			outcome: OutcomeHold,
+			next:    Target{100, 5},
+			misses:  8,
+		},
+		{
+			name:    "linear success resets the misses",
+			rule:    with(linear, func(r *Rule) { r.Misses = 2 }),
+			sets:    repeat(3, done(100, 5, 100, 5)),
+			outcome: OutcomeIncrease,
+			next:    Target{102.5, 5},
+		},
+		{
+			name:    "linear increment rounded to the plate step",
+			rule:    with(linear, func(r *Rule) { r.Increment = 1.2 }),
+			sets:    repeat(3, done(100, 5, 100, 5)),
+			outcome: OutcomeIncrease,
+			next:    Target{101, 5},
+		},
+		{
+			name:    "linear deload rounded to the plate step",
+			rule:    with(linear, func(r *Rule) { r.Misses = 2 }),
+			sets:    repeat(3, done(62.5, 5, 62.5, 4)),
+			outcome: OutcomeDeload,
+			next:    Target{56.5, 5},
+		},
+		{
+			name:    "double progression top of the range",
+			rule:    double,
+			sets:    repeat(3, done(60, 10, 60, 12)),
+			outcome: OutcomeIncrease,
+			next:    Target{62.5, 8},
+		},
+		{
+			name:    "double progression adds a rep",
+			rule:    double,
+			sets:    repeat(3, done(60, 10, 60, 10)),
+			outcome: OutcomeIncrease,
+			next:    Target{60, 11},
+		},
+		{
+			name:    "double progression reps capped at the range",
+			rule:    double,
+			sets:    []PerformedSet{done(60, 11, 60, 12), done(60, 11, 60, 11)},
+			outcome: OutcomeIncrease,
+			next:    Target{60, 12},
+		},
+		{
+			name:    "double progression miss",
+			rule:    double,
+			sets:    []PerformedSet{done(60, 10, 60, 10), done(60, 10, 60, 9)},
+			outcome: OutcomeHold,
+			next:    Target{60, 10},
+			misses:  1,
+		},
+		{
+			name:    "double progression deload back to the bottom of the range",
+			rule:    with(double, func(r *Rule) { r.Misses = 1 }),
+			sets:    []PerformedSet{done(60, 10, 60, 10), done(60, 10, 60, 9)},
+			outcome: OutcomeDeload,
+			next:    Target{54, 8},
+		},
+		{
+			name:    "double progression without a range",
+			rule:    with(double, func(r *Rule) { r.MinReps, r.MaxReps = nil, nil }),
+			sets:    repeat(3, done(60, 10, 60, 10)),
+			outcome: OutcomeIncrease,
+			next:    Target{62.5, 10},
+		},
+		{
+			name:    "percentage increase",
+			rule:    percentage,
+			sets:    []PerformedSet{done(100, 5, 120, 5)},
+			outcome: OutcomeIncrease,
+			next:    Target{112, 5},
+		},
+		{
+			name:    "percentage decrease rounded to the plate step",
+			rule:    percentage,
+			sets:    []PerformedSet{done(100, 5, 100, 5)},
+			outcome: OutcomeDecrease,
+			next:    Target{93.5, 5},
+		},
+		{
+			name:    "percentage of a single",
+			rule:    percentage,
+			sets:    []PerformedSet{done(100, 5, 125, 1)},
+			outcome: OutcomeHold,
+			next:    Target{100, 5},
+		},
+		{
+			name:    "percentage from the best set",
+			rule:    percentage,
+			sets:    []PerformedSet{done(100, 5, 100, 5), done(100, 5, 120, 5), done(100, 5, 90, 8)},
+			outcome: OutcomeIncrease,
+			next:    Target{112, 5},
+		},
+		{
+			name: "percentage without a completed set",
+			rule: with(percentage, func(r *Rule) { r.Misses = 2 }),
+			sets: []PerformedSet{
+				{PlannedWeight: ptr(100.0), PlannedReps: ptr(5), Weight: ptr(120.0), Reps: ptr(5)},
+				{PlannedWeight: ptr(100.0), PlannedReps: ptr(5), Completed: true},
+			},
+			outcome: OutcomeHold,
+			next:    Target{100, 5},
+		},
+		{
+			name:    "percentage without a percentage",
+			rule:    with(percentage, func(r *Rule) { r.Percentage = nil }),
+			sets:    []PerformedSet{done(100, 5, 120, 5)},
+			outcome: OutcomeHold,
+			next:    Target{100, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, ok := tt.rule.Decide(tt.sets)
+			if ok == tt.noPlan {
+				t.Fatalf("Decide() ok = %v, want %v", ok, !tt.noPlan)
+			}
+			if !ok {
+				return
+			}
+			if d.Outcome != tt.outcome || d.Next != tt.next || d.Misses != tt.misses {
+				t.Errorf("Decide() = %s %v with %d misses, want %s %v with %d misses (%s)",
+					d.Outcome, d.Next, d.Misses, tt.outcome, tt.next, tt.misses, d.Reason)
+			}
+			if d.Reason == "" {
+				t.Error("Decide() gave no reason")
+			}
+		})
+	}
+}
+
+func TestRoundWeight(t *testing.T) {
+	tests := []struct {
+		in, want float64
+	}{
+		{100, 100},
+		{101.2, 101},
+		{101.25, 101.5},
+		{101.3, 101.5},
+		{101.74, 101.5},
+		{0.2, 0},
+		{-2.5, 0},
+	}
+
+	for _, tt := range tests {
+		if got := roundWeight(tt.in); got != tt.want {
+			t.Errorf("roundWeight(%v) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package progression
+
+import (
+	progressionService "be/gen/progression"
+	"be/internal/features/coaching"
+	"be/internal/features/user"
+	"be/internal/middleware"
+	"be/internal/utils"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+type Service struct {
+	Repository *Repository
+	users      *user.Repository
+	access     *coaching.Authorizer
+}
+
+func NewService() *Service {
+	return &Service{
+		Repository: NewRepository(),
+		users:      user.NewRepository(),
+		access:     coaching.NewAuthorizer(),
+	}
+}
+
+func (s *Service) OAuth2Auth(ctx context.Context, token string, scheme *security.OAuth2Scheme) (context.Context, error) {
+	claims, err := middleware.ValidateToken(token)
+	if err != nil {
+		return ctx, &progressionService.Unauthorized{Message: "Invalid token"}
+	}
+	ctx = context.WithValue(ctx, middleware.ClaimsKey, claims)
+
+	return ctx, nil
+}
+
+// exercise resolves the planned exercise of a request, checking the caller
+// may manage its plan: as its athlete, their coach or an admin.
+func (s *Service) exercise(ctx context.Context, id string) (uuid.UUID, error) {
+	u, err := s.users.FindCurrent(ctx)
+	if err != nil {
+		return uuid.Nil, &progressionService.Forbidden{Message: "Forbidden"}
+	}
+
+	exerciseID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, badRequest("invalid_id", "invalid exercise ID")
+	}
+
+	owner, err := s.Repository.ExerciseOwner(ctx, exerciseID)
+	if err != nil {
+		return uuid.Nil, s.mapError(ctx, err)
+	}
+	ok, err := s.access.CanAccess(ctx, u.ID, u.Admin, owner)
+	if err != nil {
+		return uuid.Nil, s.mapError(ctx, err)
+	}
+	if !ok {
+		return uuid.Nil, &progressionService.Forbidden{Message: "Forbidden"}
+	}
+	return exerciseID, nil
+}
+
+func (s *Service) Set(ctx context.Context, payload *progressionService.SetPayload) (*progressionService.ProgressionRule, error) {
+	exerciseID, err := s.exercise(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := Rule{
+		ExerciseID:       exerciseID,
+		Kind:             payload.Kind,
+		Increment:        payload.Increment,
+		DeloadAfter:      payload.DeloadAfter,
+		DeloadPercentage: payload.DeloadPercentage,
+	}
+	switch payload.Kind {
+	case KindDoubleProgression:
+		if payload.MinReps == nil || payload.MaxReps == nil {
+			return nil, badRequest("missing_rep_range", "minReps and maxReps are required for double progression")
+		}
+		if *payload.MinReps > *payload.MaxReps {
+			return nil, badRequest("invalid_rep_range", "minReps must not exceed maxReps")
+		}
+		rule.MinReps, rule.MaxReps = payload.MinReps, payload.MaxReps
+	case KindPercentage:
+		if payload.Percentage == nil {
+			return nil, badRequest("missing_percentage", "percentage is required for percentage_of_one_rep_max")
+		}
+		rule.Percentage = payload.Percentage
+	}
+
+	saved, err := s.Repository.SaveRule(ctx, rule)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	return toRuleResult(saved), nil
+}
+
+func (s *Service) Get(ctx context.Context, payload *progressionService.GetPayload) (*progressionService.ProgressionRule, error) {
+	exerciseID, err := s.exercise(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := s.Repository.FindRule(ctx, exerciseID)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	return toRuleResult(rule), nil
+}
+
+func (s *Service) Delete(ctx context.Context, payload *progressionService.DeletePayload) error {
+	exerciseID, err := s.exercise(ctx, payload.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Repository.DeleteRule(ctx, exerciseID); err != nil {
+		return s.mapError(ctx, err)
+	}
+	return nil
+}
+
+func (s *Service) History(ctx context.Context, payload *progressionService.HistoryPayload) ([]*progressionService.ProgressionChange, error) {
+	exerciseID, err := s.exercise(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.Repository.History(ctx, exerciseID, payload.Limit, payload.Offset)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+
+	res := []*progressionService.ProgressionChange{}
+	for _, c := range changes {
+		res = append(res, &progressionService.ProgressionChange{
+			ID:             c.ID.String(),
+			SessionID:      c.SessionID.String(),
+			Outcome:        c.Outcome,
+			PreviousWeight: c.PreviousWeight,
+			NewWeight:      c.NewWeight,
+			PreviousReps:   c.PreviousReps,
+			NewReps:        c.NewReps,
+			Reason:         c.Reason,
+			CreatedAt:      c.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return res, nil
+}
+
+// mapError converts repository errors to the service error types.
+func (s *Service) mapError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, ErrExerciseNotFound):
+		return &progressionService.NotFound{Message: "Exercise not found"}
+	case errors.Is(err, ErrRuleNotFound):
+		return &progressionService.NotFound{Message: "Progression rule not found"}
+	}
+	utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+	return &progressionService.InternalServerError{Message: "Internal Server error"}
+}
+
+func toRuleResult(r *Rule) *progressionService.ProgressionRule {
+	return &progressionService.ProgressionRule{
+		ID:               r.ID.String(),
+		ExerciseID:       r.ExerciseID.String(),
+		Kind:             r.Kind,
+		Increment:        r.Increment,
+		MinReps:          r.MinReps,
+		MaxReps:          r.MaxReps,
+		Percentage:       r.Percentage,
+		DeloadAfter:      r.DeloadAfter,
+		DeloadPercentage: r.DeloadPercentage,
+		Misses:           r.Misses,
+		UpdatedAt:        r.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func badRequest(name, message string) *progressionService.BadRequest {
+	return &progressionService.BadRequest{
+		Name:    name,
+		ID:      goa.NewErrorID(),
+		Message: message,
+	}
+}
@@ -0,0 +1,128 @@
+package ratelimit
+
+import (
+	importerGenSvr "be/gen/http/importer/server"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// AnonymousTier is the tier of requests without a valid token.
+const AnonymousTier = "anonymous"
+
+// Limit is a token bucket holding up to Burst requests and refilled with
+// Rate requests per second.
+type Limit struct {
+	Rate  float64 `json:"rate" yaml:"rate"`
+	Burst int     `json:"burst" yaml:"burst"`
+}
+
+// Route overrides the limits for the requests whose path starts with Path
+// and, when Method is set, with that method. Routes have buckets of their
+// own, so their requests don't count against the default limits.
+type Route struct {
+	Method string           `json:"method" yaml:"method"`
+	Path   string           `json:"path" yaml:"path"`
+	Limit  `yaml:",inline"` // applied to the tiers missing from Tiers
+	Tiers  map[string]Limit `json:"tiers" yaml:"tiers"`
+}
+
+// Config holds the limits by route and subscription tier. The tier of a
+// caller is read from the TierClaim claim of their token.
+type Config struct {
+	Default   Limit            `json:"default" yaml:"default"`
+	Tiers     map[string]Limit `json:"tiers" yaml:"tiers"`
+	Routes    []Route          `json:"routes" yaml:"routes"`
+	TierClaim string           `json:"tierClaim" yaml:"tierClaim"`
+	// Exempt lists the path prefixes that are never limited.
+	Exempt []string `json:"exempt" yaml:"exempt"`
+	// TrustedProxies is the number of proxies in front of the service
+	// appending the address of their peer to X-Forwarded-For. The client
+	// address is the entry appended by the outermost one, the entries before
+	// it being set by the client. 0 ignores the header.
+	TrustedProxies int `json:"trustedProxies" yaml:"trustedProxies"`
+}
+
+// DefaultConfig holds the limits applied unless configured otherwise.
+func DefaultConfig() *Config {
+	return &Config{
+		Default: Limit{Rate: 10, Burst: 50},
+		Tiers: map[string]Limit{
+			AnonymousTier: {Rate: 2, Burst: 20},
+		},
+		Routes: []Route{
+			{Method: http.MethodPost, Path: importerGenSvr.HistoryImporterPath(), Limit: Limit{Rate: 1.0 / 60, Burst: 5}},
+		},
+		TierClaim: "tier",
+		Exempt:    []string{"/healthz", "/livez", "/readyz", "/metrics", "/docs", "/redoc", "/swagger-ui/", "/openapi3.yaml"},
+	}
+}
+
+func (l Limit) validate(name string) error {
+	if l.Rate <= 0 || l.Burst < 1 {
+		return fmt.Errorf("%s: rate must be positive and burst at least 1", name)
+	}
+	return nil
+}
+
+// Validate checks that every limit can let requests through.
+func (c *Config) Validate() error {
+	if err := c.Default.validate("default"); err != nil {
+		return err
+	}
+	if c.TrustedProxies < 0 {
+		return fmt.Errorf("trustedProxies must not be negative")
+	}
+	for tier, l := range c.Tiers {
+		if err := l.validate("tier " + tier); err != nil {
+			return err
+		}
+	}
+	for _, route := range c.Routes {
+		name := strings.TrimSpace(route.Method + " " + route.Path)
+		if route.Path == "" {
+			return fmt.Errorf("route %q: path is required", name)
+		}
+		if err := route.Limit.validate("route " + name); err != nil {
+			return err
+		}
+		for tier, l := range route.Tiers {
+			if err := l.validate("route " + name + " tier " + tier); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// exempt reports whether requests to path are never limited.
+func (c *Config) exempt(path string) bool {
+	for _, prefix := range c.Exempt {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// limit returns the limit of a request of tier and the name of its bucket:
+// the first matching route, or the defaults.
+func (c *Config) limit(r *http.Request, tier string) (string, Limit) {
+	for _, route := range c.Routes {
+		if route.Method != "" && route.Method != r.Method {
+			continue
+		}
+		if !strings.HasPrefix(r.URL.Path, route.Path) {
+			continue
+		}
+		name := route.Method + " " + route.Path
+		if l, ok := route.Tiers[tier]; ok {
+			return name, l
+		}
+		return name, route.Limit
+	}
+	if l, ok := c.Tiers[tier]; ok {
+		return "default", l
+	}
+	return "default", c.Default
+}
@@ -0,0 +1,118 @@
+package ratelimit
+
+import (
+	"be/internal/middleware"
+	"be/internal/utils"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"goa.design/clue/log"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Middleware limits the requests of each caller with token buckets. Callers
+// are told apart by the subject of their token or, failing that, their
+// address.
+type Middleware struct {
+	Config *Config
+	Store  Store
+}
+
+// Stores lists the names of the stores NewMiddleware accepts.
+var Stores = []string{"memory", "postgres"}
+
+// NewMiddleware applies cfg keeping the buckets in the named store: memory,
+// for a single replica, or postgres to share them between replicas.
+func NewMiddleware(store string, cfg *Config) (*Middleware, error) {
+	m := &Middleware{Config: cfg}
+	switch store {
+	case "memory":
+		m.Store = NewMemoryStore()
+	case "postgres":
+		m.Store = NewPostgresStore()
+	default:
+		return nil, fmt.Errorf("invalid rate limit store %q (valid stores: memory|postgres)", store)
+	}
+	return m, nil
+}
+
+// Handler wraps h with the rate limits.
+func (m *Middleware) Handler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodOptions || m.Config.exempt(r.URL.Path) {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		caller, tier := m.identify(r)
+		name, limit := m.Config.limit(r, tier)
+		res, err := m.Store.Take(ctx, name+"|"+caller, limit, time.Now())
+		if err != nil {
+			// Failing open: an unavailable store must not take the API down.
+			utils.Log.Error(ctx, log.KV{K: "ratelimit", V: err}, err)
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		header := w.Header()
+		header.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", limit.Burst, int(seconds(float64(limit.Burst)/limit.Rate).Seconds())))
+		header.Set("RateLimit-Limit", strconv.Itoa(res.Limit))
+		header.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		header.Set("RateLimit-Reset", strconv.Itoa(int(res.Reset.Seconds())))
+		if !res.Allowed {
+			header.Set("Retry-After", strconv.Itoa(int(res.RetryAfter.Seconds())))
+			header.Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"name":      "rate_limited",
+				"id":        goa.NewErrorID(),
+				"message":   "Too many requests, retry later",
+				"temporary": true,
+				"timeout":   false,
+				"fault":     false,
+			})
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// identify returns the key of the caller's buckets and their tier.
+func (m *Middleware) identify(r *http.Request) (string, string) {
+	if claims, ok := middleware.ClaimsFromRequest(r); ok {
+		if sub, _ := claims["sub"].(string); sub != "" {
+			tier, _ := claims[m.Config.TierClaim].(string)
+			return "user:" + sub, tier
+		}
+	}
+	return "ip:" + m.clientIP(r), AnonymousTier
+}
+
+// clientIP returns the address of the client, as seen by the outermost
+// trusted proxy when there are some. Requests carrying fewer X-Forwarded-For
+// entries than there are proxies didn't come through them and are keyed by
+// their peer address.
+func (m *Middleware) clientIP(r *http.Request) string {
+	if hops := m.Config.TrustedProxies; hops > 0 {
+		var entries []string
+		for _, header := range r.Header.Values("X-Forwarded-For") {
+			entries = append(entries, strings.Split(header, ",")...)
+		}
+		if len(entries) >= hops {
+			if ip := strings.TrimSpace(entries[len(entries)-hops]); ip != "" {
+				return ip
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,76 @@
+package ratelimit
+
+import (
+	"be/internal/database/db"
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+)
+
+// PostgresStore keeps the buckets in Postgres so that the replicas share
+// them.
+type PostgresStore struct {
+	DB *sql.DB
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+func NewPostgresStore() *PostgresStore {
+	return &PostgresStore{DB: db.DB.LD}
+}
+
+func (s *PostgresStore) Take(ctx context.Context, key string, limit Limit, now time.Time) (Result, error) {
+	if err := s.sweep(ctx, now); err != nil {
+		return Result{}, err
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return Result{}, err
+	}
+	defer tx.Rollback()
+
+	// The bucket is created full, then locked for the read-modify-write.
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO rate_limit_bucket (key, tokens, updated_at, full_at)
+	VALUES ($1, $2, $3, $3)
+	ON CONFLICT (key) DO NOTHING`, key, float64(limit.Burst), now)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var (
+		tokens float64
+		last   time.Time
+	)
+	err = tx.QueryRowContext(ctx, `
+	SELECT tokens, updated_at FROM rate_limit_bucket WHERE key = $1 FOR UPDATE`, key).Scan(&tokens, &last)
+	if err != nil {
+		return Result{}, err
+	}
+
+	tokens, res := take(limit, tokens, last, now)
+	_, err = tx.ExecContext(ctx, `
+	UPDATE rate_limit_bucket SET tokens = $2, updated_at = $3, full_at = $4 WHERE key = $1`,
+		key, tokens, now, now.Add(res.Reset))
+	if err != nil {
+		return Result{}, err
+	}
+	return res, tx.Commit()
+}
+
+// sweep deletes the full buckets once a minute.
+func (s *PostgresStore) sweep(ctx context.Context, now time.Time) error {
+	s.mu.Lock()
+	if now.Sub(s.lastSweep) < time.Minute {
+		s.mu.Unlock()
+		return nil
+	}
+	s.lastSweep = now
+	s.mu.Unlock()
+
+	_, err := s.DB.ExecContext(ctx, `DELETE FROM rate_limit_bucket WHERE full_at < $1`, now)
+	return err
+}
@@ -0,0 +1,84 @@
+package retention
+
+import (
+	"be/internal/database/db"
+	"be/internal/utils"
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"goa.design/clue/log"
+)
+
+// tables are purged children first, although the foreign keys would cascade
+// anyway, so that the counts reflect the rows each table held in the trash.
+var tables = []string{"exercise_set", "exercise", "workout", "training_plan", "users"}
+
+// Purger hard-deletes the rows that have been soft-deleted for longer than
+// Retention. The sessions logged on a purged workout are kept, unlinked
+// from it, since they are the athlete's performed history.
+type Purger struct {
+	DB        *sql.DB
+	Retention time.Duration
+	Interval  time.Duration
+}
+
+// NewPurger purges the rows deleted more than retentionDays ago; 0 keeps
+// deleted rows forever.
+func NewPurger(retentionDays int) *Purger {
+	return &Purger{
+		DB:        db.DB.LD,
+		Retention: time.Duration(retentionDays) * 24 * time.Hour,
+		Interval:  time.Hour,
+	}
+}
+
+// Start runs the purger in a goroutine until ctx is cancelled.
+func (p *Purger) Start(ctx context.Context, wg *sync.WaitGroup) {
+	if p.Retention == 0 {
+		log.Printf(ctx, "Trash retention disabled, deleted rows are kept")
+		return
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		log.Printf(ctx, "Starting trash purger (retention %s)", p.Retention)
+		p.Run(ctx)
+		log.Printf(ctx, "Trash purger stopped")
+	}()
+}
+
+// Run purges the trash every Interval until ctx is cancelled.
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.Purge(ctx); err != nil {
+			utils.Log.Error(ctx, log.KV{K: "retention", V: err}, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge hard-deletes the rows deleted before the retention period.
+func (p *Purger) Purge(ctx context.Context) error {
+	cutoff := time.Now().UTC().Add(-p.Retention)
+	for _, table := range tables {
+		res, err := p.DB.ExecContext(ctx, `DELETE FROM `+table+` WHERE deleted_at < $1`, cutoff)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err == nil && n > 0 {
+			log.Printf(ctx, "Purged %d deleted rows from %s", n, table)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package schedule
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	feedTokenPrefix = "calf_"
+	feedPathPrefix  = "/api/v1/calendar/feed/"
+
+	// The feed covers past workouts for history and upcoming ones for
+	// planning, without expanding open-ended plans indefinitely.
+	feedPastDays   = 180
+	feedFutureDays = 365
+)
+
+// NewFeedToken returns a random feed token.
+func NewFeedToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return feedTokenPrefix + hex.EncodeToString(b), nil
+}
+
+// HashFeedToken returns the stored form of a feed token. Calendar apps send
+// the token as part of the URL, so an optional ".ics" suffix is ignored.
+func HashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSuffix(token, ".ics")))
+	return hex.EncodeToString(sum[:])
+}
+
+func feedPath(token string) string {
+	return feedPathPrefix + token + ".ics"
+}
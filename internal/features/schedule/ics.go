@@ -0,0 +1,110 @@
+package schedule
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	icsDateLayout  = "20060102"
+	icsStampLayout = "20060102T150405Z"
+	icsUIDDomain   = "ld-be"
+	icsLineLimit   = 75
+)
+
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// UID returns the stable identifier of the event of a workout. It doesn't
+// depend on the days, so that calendar apps update the event in place when
+// the workout is rescheduled instead of keeping a stale copy.
+func UID(w ScheduledWorkout) string {
+	return fmt.Sprintf("%s@%s", w.ID, icsUIDDomain)
+}
+
+// WriteICS writes the occurrences as an RFC 5545 calendar of all-day events:
+// one event per workout, starting on the first day the workout is planned
+// on and repeating on the occurrences with RDATE. DTSTART doesn't follow the
+// window the occurrences were expanded in, so that it stays the same from one
+// refresh of the feed to the next. SEQUENCE grows with every change of the
+// workout.
+func WriteICS(w io.Writer, name string, occurrences []Occurrence, now time.Time) error {
+	bw := bufio.NewWriter(w)
+	line := func(format string, args ...any) {
+		writeFolded(bw, fmt.Sprintf(format, args...))
+	}
+
+	// Group the days by workout, keeping the order of the first ones.
+	var (
+		workouts []ScheduledWorkout
+		days     = map[uuid.UUID][]string{}
+	)
+	for _, o := range occurrences {
+		if _, ok := days[o.Workout.ID]; !ok {
+			workouts = append(workouts, o.Workout)
+		}
+		days[o.Workout.ID] = append(days[o.Workout.ID], o.Date.Format(icsDateLayout))
+	}
+
+	stamp := now.UTC().Format(icsStampLayout)
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//Lasting Dynamics//Training Calendar//EN")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+	line("X-WR-CALNAME:%s", icsEscaper.Replace(name))
+	for _, wo := range workouts {
+		start := days[wo.ID][0]
+		if day, ok := FirstDay(wo); ok {
+			start = day.Format(icsDateLayout)
+		}
+		first, err := time.Parse(icsDateLayout, start)
+		if err != nil {
+			return err
+		}
+		var rest []string
+		for _, day := range days[wo.ID] {
+			if day != start {
+				rest = append(rest, day)
+			}
+		}
+		line("BEGIN:VEVENT")
+		line("UID:%s", UID(wo))
+		line("SEQUENCE:%d", max(wo.UpdatedAt.Unix(), 0))
+		line("DTSTAMP:%s", stamp)
+		line("LAST-MODIFIED:%s", wo.UpdatedAt.UTC().Format(icsStampLayout))
+		line("DTSTART;VALUE=DATE:%s", start)
+		line("DTEND;VALUE=DATE:%s", first.AddDate(0, 0, 1).Format(icsDateLayout))
+		if len(rest) > 0 {
+			line("RDATE;VALUE=DATE:%s", strings.Join(rest, ","))
+		}
+		line("SUMMARY:%s", icsEscaper.Replace(wo.Name))
+		line("DESCRIPTION:%s", icsEscaper.Replace(wo.TrainingPlanName))
+		line("TRANSP:TRANSPARENT")
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+	return bw.Flush()
+}
+
+// writeFolded writes a content line, folding it at 75 octets without
+// splitting UTF-8 sequences.
+func writeFolded(w *bufio.Writer, s string) {
+	limit := icsLineLimit
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && s[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.WriteString(s[:cut])
+		w.WriteString("\r\n ")
+		s = s[cut:]
+		limit = icsLineLimit - 1 // the leading space counts
+	}
+	w.WriteString(s)
+	w.WriteString("\r\n")
+}
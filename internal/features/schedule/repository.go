@@ -0,0 +1,269 @@
+package schedule
+
+import (
+	"be/internal/database/db"
+	"be/internal/events"
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrWorkoutNotFound = errors.New("workout not found")
+	ErrFeedNotFound    = errors.New("calendar feed not found")
+)
+
+// ScheduledWorkout is a workout with its schedule and the range of its plan.
+type ScheduledWorkout struct {
+	ID               uuid.UUID
+	Name             string
+	Position         int
+	ScheduledDates   []string
+	Recurrence       *string
+	TrainingPlanID   uuid.UUID
+	TrainingPlanName string
+	UserID           uuid.UUID // athlete, or owner of a template
+	PlanStart        time.Time
+	PlanEnd          time.Time
+	UpdatedAt        time.Time
+}
+
+// Feed is a calendar feed token. Only its hash is stored.
+type Feed struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	CreatedAt time.Time
+}
+
+// Occurrence is a workout planned on a given day.
+type Occurrence struct {
+	Date    time.Time
+	Workout ScheduledWorkout
+}
+
+type Repository struct {
+	DB *sql.DB
+}
+
+func NewRepository() *Repository {
+	return &Repository{DB: db.DB.LD}
+}
+
+const scheduledColumns = `
+	w.id, w.name, w.position, w.scheduled_dates, w.recurrence,
+	tp.id, tp.name, COALESCE(tp.user_id, tp.owner_id), tp.start_date, tp.end_date, w.updated_at`
+
+func scanScheduled(row interface{ Scan(...any) error }, w *ScheduledWorkout) error {
+	return row.Scan(&w.ID, &w.Name, &w.Position, pq.Array(&w.ScheduledDates), &w.Recurrence,
+		&w.TrainingPlanID, &w.TrainingPlanName, &w.UserID, &w.PlanStart, &w.PlanEnd, &w.UpdatedAt)
+}
+
+func (r *Repository) FindWorkout(ctx context.Context, id uuid.UUID) (*ScheduledWorkout, error) {
+	query := `
+	SELECT ` + scheduledColumns + `
+	FROM workout w
+	JOIN training_plan tp ON tp.id = w.training_plan_id AND tp.deleted_at IS NULL
+	WHERE w.id = $1 AND w.deleted_at IS NULL`
+
+	var w ScheduledWorkout
+	if err := scanScheduled(r.DB.QueryRowContext(ctx, query, id), &w); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWorkoutNotFound
+		}
+		return nil, err
+	}
+	return &w, nil
+}
+
+// SetSchedule replaces the schedule of a workout.
+func (r *Repository) SetSchedule(ctx context.Context, w ScheduledWorkout) (*ScheduledWorkout, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	dates := w.ScheduledDates
+	if dates == nil {
+		dates = []string{}
+	}
+	res, err := tx.ExecContext(ctx, `
+	UPDATE workout
+	SET position = $2, scheduled_dates = $3, recurrence = $4, updated_at = NOW()
+	WHERE id = $1 AND deleted_at IS NULL`, w.ID, w.Position, pq.Array(dates), w.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return nil, ErrWorkoutNotFound
+	}
+
+	data := map[string]any{
+		"ID":             w.ID,
+		"Position":       w.Position,
+		"ScheduledDates": dates,
+		"Recurrence":     w.Recurrence,
+	}
+	if err := events.Emit(ctx, tx, events.WorkoutUpdated, "workout", w.ID, &w.UserID, data); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return r.FindWorkout(ctx, w.ID)
+}
+
+// ListScheduled returns the scheduled workouts of the user's plans that
+// overlap the [from, to] day range.
+func (r *Repository) ListScheduled(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]ScheduledWorkout, error) {
+	query := `
+	SELECT ` + scheduledColumns + `
+	FROM workout w
+	JOIN training_plan tp ON tp.id = w.training_plan_id AND tp.deleted_at IS NULL
+	WHERE tp.user_id = $1
+	  AND w.deleted_at IS NULL
+	  AND (cardinality(w.scheduled_dates) > 0 OR w.recurrence IS NOT NULL)
+	  AND tp.start_date::date <= $3::date
+	  AND tp.end_date::date >= $2::date`
+
+	rows, err := r.DB.QueryContext(ctx, query, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var workouts []ScheduledWorkout
+	for rows.Next() {
+		var w ScheduledWorkout
+		if err := scanScheduled(rows, &w); err != nil {
+			return nil, err
+		}
+		workouts = append(workouts, w)
+	}
+	return workouts, rows.Err()
+}
+
+// CreateFeed stores a new feed token for the user, revoking the active one.
+func (r *Repository) CreateFeed(ctx context.Context, userID uuid.UUID, tokenHash string) (*Feed, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE calendar_feed SET revoked_at = NOW()
+	WHERE user_id = $1 AND revoked_at IS NULL`, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	feed := Feed{ID: uuid.New(), UserID: userID}
+	err = tx.QueryRowContext(ctx, `
+	INSERT INTO calendar_feed (id, user_id, token_hash)
+	VALUES ($1, $2, $3)
+	RETURNING created_at`, feed.ID, userID, tokenHash).Scan(&feed.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &feed, nil
+}
+
+// RevokeFeed revokes the user's active feed token.
+func (r *Repository) RevokeFeed(ctx context.Context, userID uuid.UUID) error {
+	res, err := r.DB.ExecContext(ctx, `
+	UPDATE calendar_feed SET revoked_at = NOW()
+	WHERE user_id = $1 AND revoked_at IS NULL`, userID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrFeedNotFound
+	}
+	return nil
+}
+
+// FindFeed returns the active feed with the given token hash.
+func (r *Repository) FindFeed(ctx context.Context, tokenHash string) (*Feed, error) {
+	var feed Feed
+	err := r.DB.QueryRowContext(ctx, `
+	SELECT id, user_id, created_at
+	FROM calendar_feed
+	WHERE token_hash = $1 AND revoked_at IS NULL`, tokenHash).
+		Scan(&feed.ID, &feed.UserID, &feed.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrFeedNotFound
+		}
+		return nil, err
+	}
+	return &feed, nil
+}
+
+// Expand returns the occurrences of the workouts between from and to, both
+// inclusive days, ordered by date and position. Occurrences are limited to
+// the range of the workout's plan; workouts with an invalid rule only
+// contribute their specific dates.
+func Expand(workouts []ScheduledWorkout, from, to time.Time) []Occurrence {
+	var occurrences []Occurrence
+	for _, w := range workouts {
+		start, end := truncateDay(w.PlanStart), truncateDay(w.PlanEnd)
+		lo, hi := truncateDay(from), truncateDay(to)
+		if lo.Before(start) {
+			lo = start
+		}
+		if hi.After(end) {
+			hi = end
+		}
+
+		seen := map[time.Time]bool{}
+		add := func(day time.Time) {
+			if day.Before(lo) || day.After(hi) || seen[day] {
+				return
+			}
+			seen[day] = true
+			occurrences = append(occurrences, Occurrence{Date: day, Workout: w})
+		}
+
+		for _, d := range w.ScheduledDates {
+			if day, err := time.Parse(dateLayout, d[:min(len(d), len(dateLayout))]); err == nil {
+				add(day)
+			}
+		}
+		if w.Recurrence != nil {
+			if rule, err := ParseRule(*w.Recurrence); err == nil {
+				for _, day := range rule.Occurrences(start, end, lo, hi) {
+					add(day)
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		if !occurrences[i].Date.Equal(occurrences[j].Date) {
+			return occurrences[i].Date.Before(occurrences[j].Date)
+		}
+		return occurrences[i].Workout.Position < occurrences[j].Workout.Position
+	})
+	return occurrences
+}
+
+// FirstDay returns the first day the workout is planned on over the whole
+// range of its plan, or false when it is planned on none.
+func FirstDay(w ScheduledWorkout) (time.Time, bool) {
+	occurrences := Expand([]ScheduledWorkout{w}, w.PlanStart, w.PlanEnd)
+	if len(occurrences) == 0 {
+		return time.Time{}, false
+	}
+	return occurrences[0].Date, true
+}
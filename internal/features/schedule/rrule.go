@@ -0,0 +1,161 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Rule is the subset of RFC 5545 recurrence rules supported for workouts:
+// FREQ=DAILY or WEEKLY with INTERVAL, BYDAY, COUNT and UNTIL. With DAILY,
+// BYDAY limits the days to the listed weekdays.
+type Rule struct {
+	Freq     string
+	Interval int
+	ByDay    []time.Weekday
+	Count    int
+	Until    *time.Time
+}
+
+// ParseRule parses a rule such as "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,WE,FR".
+// An optional "RRULE:" prefix is accepted.
+func ParseRule(s string) (*Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	rule := &Rule{Interval: 1}
+
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.Freq = strings.ToUpper(value)
+			if rule.Freq != "DAILY" && rule.Freq != "WEEKLY" {
+				return nil, fmt.Errorf("unsupported frequency %q", value)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid interval %q", value)
+			}
+			rule.Interval = n
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				wd, ok := weekdays[strings.ToUpper(day)]
+				if !ok {
+					return nil, fmt.Errorf("invalid day %q", day)
+				}
+				rule.ByDay = append(rule.ByDay, wd)
+			}
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid count %q", value)
+			}
+			rule.Count = n
+		case "UNTIL":
+			until, err := parseUntil(value)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = &until
+		default:
+			return nil, fmt.Errorf("unsupported rule part %q", key)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, fmt.Errorf("missing FREQ")
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, fmt.Errorf("COUNT and UNTIL can't be used together")
+	}
+	return rule, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return truncateDay(t), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid until %q", value)
+}
+
+// Occurrences expands the rule from start up to end, both inclusive days,
+// and returns the occurrences falling within [from, to]. COUNT is counted
+// from start, so occurrences before from still consume it.
+func (r *Rule) Occurrences(start, end, from, to time.Time) []time.Time {
+	start, end = truncateDay(start), truncateDay(end)
+	from, to = truncateDay(from), truncateDay(to)
+	if r.Until != nil && r.Until.Before(end) {
+		end = *r.Until
+	}
+	if to.Before(end) && r.Count == 0 {
+		end = to
+	}
+
+	byDay := r.ByDay
+	if len(byDay) == 0 {
+		byDay = []time.Weekday{start.Weekday()}
+	}
+	weekStart := start.AddDate(0, 0, -int((start.Weekday()+6)%7))
+
+	var (
+		dates []time.Time
+		count int
+	)
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		if !r.matches(day, start, weekStart, byDay) {
+			continue
+		}
+		count++
+		if r.Count > 0 && count > r.Count {
+			break
+		}
+		if !day.Before(from) && !day.After(to) {
+			dates = append(dates, day)
+		}
+	}
+	return dates
+}
+
+func (r *Rule) matches(day, start, weekStart time.Time, byDay []time.Weekday) bool {
+	switch r.Freq {
+	case "DAILY":
+		return daysBetween(start, day)%r.Interval == 0 && (len(r.ByDay) == 0 || onWeekday(day, r.ByDay))
+	default:
+		return (daysBetween(weekStart, day)/7)%r.Interval == 0 && onWeekday(day, byDay)
+	}
+}
+
+func onWeekday(day time.Time, weekdays []time.Weekday) bool {
+	for _, wd := range weekdays {
+		if day.Weekday() == wd {
+			return true
+		}
+	}
+	return false
+}
+
+func daysBetween(a, b time.Time) int {
+	return int(b.Sub(a).Hours() / 24)
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,177 @@
+package schedule
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// march returns a day of March 2026, which starts on a Sunday.
+func march(day int) time.Time {
+	return time.Date(2026, time.March, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParseRule(t *testing.T) {
+	until := march(31)
+
+	tests := []struct {
+		in      string
+		want    *Rule
+		wantErr bool
+	}{
+		{in: "FREQ=DAILY", want: &Rule{Freq: "DAILY", Interval: 1}},
+		{in: "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE,FR", want: &Rule{
+			Freq: "WEEKLY", Interval: 2, ByDay: []time.Weekday{time.Monday, time.Wednesday, time.Friday},
+		}},
+		{in: "freq=weekly;byday=su", want: &Rule{Freq: "WEEKLY", Interval: 1, ByDay: []time.Weekday{time.Sunday}}},
+		{in: "FREQ=DAILY;COUNT=10", want: &Rule{Freq: "DAILY", Interval: 1, Count: 10}},
+		{in: "FREQ=DAILY;UNTIL=20260331", want: &Rule{Freq: "DAILY", Interval: 1, Until: &until}},
+		{in: "FREQ=DAILY;UNTIL=20260331T183000Z", want: &Rule{Freq: "DAILY", Interval: 1, Until: &until}},
+		{in: "", wantErr: true},
+		{in: "INTERVAL=2", wantErr: true},
+		{in: "FREQ=MONTHLY", wantErr: true},
+		{in: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{in: "FREQ=DAILY;INTERVAL=two", wantErr: true},
+		{in: "FREQ=WEEKLY;BYDAY=MO,XX", wantErr: true},
+		{in: "FREQ=WEEKLY;BYDAY=1MO", wantErr: true},
+		{in: "FREQ=DAILY;COUNT=0", wantErr: true},
+		{in: "FREQ=DAILY;UNTIL=2026-03-31", wantErr: true},
+		{in: "FREQ=DAILY;COUNT=3;UNTIL=20260331", wantErr: true},
+		{in: "FREQ=DAILY;BYMONTH=3", wantErr: true},
+		{in: "FREQ=DAILY;INTERVAL", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseRule(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseRule(%q) = %+v, want an error", tt.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRule(%q) error = %v", tt.in, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseRule(%q) = %+v, want %+v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOccurrences(t *testing.T) {
+	tests := []struct {
+		name       string
+		rule       string
+		start, end time.Time
+		from, to   time.Time
+		want       []int // days of March
+	}{
+		{
+			name:  "daily",
+			rule:  "FREQ=DAILY",
+			start: march(2), end: march(8), from: march(2), to: march(8),
+			want: []int{2, 3, 4, 5, 6, 7, 8},
+		},
+		{
+			name:  "daily every other day",
+			rule:  "FREQ=DAILY;INTERVAL=2",
+			start: march(2), end: march(8), from: march(2), to: march(8),
+			want: []int{2, 4, 6, 8},
+		},
+		{
+			name:  "daily on weekdays",
+			rule:  "FREQ=DAILY;BYDAY=MO,WE,FR",
+			start: march(2), end: march(15), from: march(2), to: march(15),
+			want: []int{2, 4, 6, 9, 11, 13},
+		},
+		{
+			name:  "daily interval and weekdays",
+			rule:  "FREQ=DAILY;INTERVAL=2;BYDAY=MO,TU,WE",
+			start: march(2), end: march(15), from: march(2), to: march(15),
+			want: []int{2, 4, 10},
+		},
+		{
+			name:  "weekly on the start weekday",
+			rule:  "FREQ=WEEKLY",
+			start: march(4), end: march(25), from: march(1), to: march(31),
+			want: []int{4, 11, 18, 25},
+		},
+		{
+			name:  "weekly days before the start are skipped",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,FR",
+			start: march(4), end: march(16), from: march(1), to: march(31),
+			want: []int{6, 9, 13, 16},
+		},
+		{
+			name:  "every other week from the start week",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR",
+			start: march(4), end: march(31), from: march(1), to: march(31),
+			want: []int{6, 16, 20, 30},
+		},
+		{
+			name:  "weeks start on Monday",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=SU,MO",
+			start: march(8), end: march(23), from: march(1), to: march(31),
+			want: []int{8, 16, 22},
+		},
+		{
+			name:  "count consumed before the window",
+			rule:  "FREQ=DAILY;COUNT=3",
+			start: march(2), end: march(31), from: march(3), to: march(31),
+			want: []int{3, 4},
+		},
+		{
+			name:  "count past the window",
+			rule:  "FREQ=DAILY;COUNT=10",
+			start: march(2), end: march(31), from: march(2), to: march(4),
+			want: []int{2, 3, 4},
+		},
+		{
+			name:  "weekly count",
+			rule:  "FREQ=WEEKLY;BYDAY=TU,TH;COUNT=3",
+			start: march(2), end: march(31), from: march(1), to: march(31),
+			want: []int{3, 5, 10},
+		},
+		{
+			name:  "until before the plan end",
+			rule:  "FREQ=DAILY;UNTIL=20260305",
+			start: march(2), end: march(31), from: march(1), to: march(31),
+			want: []int{2, 3, 4, 5},
+		},
+		{
+			name:  "until after the plan end",
+			rule:  "FREQ=DAILY;UNTIL=20261231",
+			start: march(2), end: march(4), from: march(1), to: march(31),
+			want: []int{2, 3, 4},
+		},
+		{
+			name:  "window inside the plan",
+			rule:  "FREQ=DAILY;INTERVAL=3",
+			start: march(2), end: march(31), from: march(6), to: march(12),
+			want: []int{8, 11},
+		},
+		{
+			name:  "window after the plan",
+			rule:  "FREQ=DAILY",
+			start: march(2), end: march(4), from: march(10), to: march(12),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseRule(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRule(%q) error = %v", tt.rule, err)
+			}
+			var got []int
+			for _, day := range rule.Occurrences(tt.start, tt.end, tt.from, tt.to) {
+				got = append(got, day.Day())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Occurrences() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package schedule
+
+import (
+	scheduleService "be/gen/schedule"
+	"be/internal/features/coaching"
+	"be/internal/features/user"
+	"be/internal/middleware"
+	"be/internal/utils"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// maxCalendarDays bounds the range a single calendar request may expand.
+const maxCalendarDays = 366
+
+type Service struct {
+	Repository *Repository
+	users      *user.Repository
+	access     *coaching.Authorizer
+}
+
+func NewService() *Service {
+	return &Service{
+		Repository: NewRepository(),
+		users:      user.NewRepository(),
+		access:     coaching.NewAuthorizer(),
+	}
+}
+
+func (s *Service) OAuth2Auth(ctx context.Context, token string, scheme *security.OAuth2Scheme) (context.Context, error) {
+	claims, err := middleware.ValidateToken(token)
+	if err != nil {
+		return ctx, &scheduleService.Unauthorized{Message: "Invalid token"}
+	}
+	ctx = context.WithValue(ctx, middleware.ClaimsKey, claims)
+
+	return ctx, nil
+}
+
+func (s *Service) Set(ctx context.Context, payload *scheduleService.SetPayload) (*scheduleService.WorkoutSchedule, error) {
+	u, err := s.users.FindCurrent(ctx)
+	if err != nil {
+		return nil, &scheduleService.Forbidden{Message: "Forbidden"}
+	}
+
+	workoutID, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return nil, badRequest("invalid_id", "invalid workout ID")
+	}
+
+	w, err := s.Repository.FindWorkout(ctx, workoutID)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	ok, err := s.access.CanAccess(ctx, u.ID, u.Admin, w.UserID)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	if !ok {
+		return nil, &scheduleService.Forbidden{Message: "Forbidden"}
+	}
+
+	start, end := truncateDay(w.PlanStart), truncateDay(w.PlanEnd)
+	seen := map[string]bool{}
+	var dates []string
+	for _, d := range payload.Dates {
+		day, err := time.Parse(dateLayout, d)
+		if err != nil {
+			return nil, badRequest("invalid_date", "invalid date "+d)
+		}
+		if day.Before(start) || day.After(end) {
+			return nil, badRequest("date_out_of_range", "date "+d+" is outside the training plan")
+		}
+		if !seen[d] {
+			seen[d] = true
+			dates = append(dates, d)
+		}
+	}
+	sort.Strings(dates)
+
+	if payload.Recurrence != nil {
+		if _, err := ParseRule(*payload.Recurrence); err != nil {
+			return nil, badRequest("invalid_recurrence", err.Error())
+		}
+	}
+
+	w.Position = payload.Position
+	w.ScheduledDates = dates
+	w.Recurrence = payload.Recurrence
+
+	saved, err := s.Repository.SetSchedule(ctx, *w)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+
+	res := &scheduleService.WorkoutSchedule{
+		WorkoutID:  saved.ID.String(),
+		Position:   saved.Position,
+		Dates:      []string{},
+		Recurrence: saved.Recurrence,
+	}
+	for _, d := range saved.ScheduledDates {
+		res.Dates = append(res.Dates, d[:min(len(d), len(dateLayout))])
+	}
+	return res, nil
+}
+
+func (s *Service) Calendar(ctx context.Context, payload *scheduleService.CalendarPayload) ([]*scheduleService.CalendarEntry, error) {
+	u, err := s.users.FindCurrent(ctx)
+	if err != nil {
+		return nil, &scheduleService.Forbidden{Message: "Forbidden"}
+	}
+
+	from, err := time.Parse(dateLayout, payload.From)
+	if err != nil {
+		return nil, badRequest("invalid_date", "invalid from date")
+	}
+	to, err := time.Parse(dateLayout, payload.To)
+	if err != nil {
+		return nil, badRequest("invalid_date", "invalid to date")
+	}
+	if to.Before(from) {
+		return nil, badRequest("invalid_range", "to must not be before from")
+	}
+	if daysBetween(from, to) >= maxCalendarDays {
+		return nil, badRequest("invalid_range", "the range must not exceed one year")
+	}
+
+	return s.occurrences(ctx, u.ID, from, to)
+}
+
+func (s *Service) Today(ctx context.Context, payload *scheduleService.TodayPayload) (*scheduleService.TodayWorkouts, error) {
+	u, err := s.users.FindCurrent(ctx)
+	if err != nil {
+		return nil, &scheduleService.Forbidden{Message: "Forbidden"}
+	}
+
+	timezone := u.Timezone
+	if payload.Timezone != nil {
+		timezone = *payload.Timezone
+	}
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, badRequest("invalid_timezone", "unknown time zone "+timezone)
+	}
+
+	today := truncateDay(time.Now().In(loc))
+	entries, err := s.occurrences(ctx, u.ID, today, today)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduleService.TodayWorkouts{
+		Date:     today.Format(dateLayout),
+		Timezone: timezone,
+		Workouts: entries,
+	}, nil
+}
+
+func (s *Service) CreateFeed(ctx context.Context, payload *scheduleService.CreateFeedPayload) (*scheduleService.CalendarFeed, error) {
+	u, err := s.users.FindCurrent(ctx)
+	if err != nil {
+		return nil, &scheduleService.Forbidden{Message: "Forbidden"}
+	}
+
+	token, err := NewFeedToken()
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+
+	feed, err := s.Repository.CreateFeed(ctx, u.ID, HashFeedToken(token))
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+
+	return &scheduleService.CalendarFeed{
+		Token:     token,
+		Path:      feedPath(token),
+		CreatedAt: feed.CreatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+func (s *Service) RevokeFeed(ctx context.Context, payload *scheduleService.RevokeFeedPayload) error {
+	u, err := s.users.FindCurrent(ctx)
+	if err != nil {
+		return &scheduleService.Forbidden{Message: "Forbidden"}
+	}
+
+	if err := s.Repository.RevokeFeed(ctx, u.ID); err != nil {
+		return s.mapError(ctx, err)
+	}
+	return nil
+}
+
+// Feed serves the ICS calendar of the feed token's owner. It is not
+// protected by OAuth2: the token in the URL is the credential.
+func (s *Service) Feed(ctx context.Context, payload *scheduleService.FeedPayload) (*scheduleService.FeedResult, io.ReadCloser, error) {
+	feed, err := s.Repository.FindFeed(ctx, HashFeedToken(payload.FeedToken))
+	if err != nil {
+		return nil, nil, s.mapError(ctx, err)
+	}
+
+	now := time.Now()
+	today := truncateDay(now)
+	from, to := today.AddDate(0, 0, -feedPastDays), today.AddDate(0, 0, feedFutureDays)
+
+	workouts, err := s.Repository.ListScheduled(ctx, feed.UserID, from, to)
+	if err != nil {
+		return nil, nil, s.mapError(ctx, err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteICS(&buf, "Training", Expand(workouts, from, to), now); err != nil {
+		return nil, nil, s.mapError(ctx, err)
+	}
+
+	return &scheduleService.FeedResult{ContentType: "text/calendar; charset=utf-8"}, io.NopCloser(&buf), nil
+}
+
+func (s *Service) occurrences(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]*scheduleService.CalendarEntry, error) {
+	workouts, err := s.Repository.ListScheduled(ctx, userID, from, to)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+
+	res := []*scheduleService.CalendarEntry{}
+	for _, o := range Expand(workouts, from, to) {
+		res = append(res, &scheduleService.CalendarEntry{
+			Date:             o.Date.Format(dateLayout),
+			WorkoutID:        o.Workout.ID.String(),
+			WorkoutName:      o.Workout.Name,
+			Position:         o.Workout.Position,
+			TrainingPlanID:   o.Workout.TrainingPlanID.String(),
+			TrainingPlanName: o.Workout.TrainingPlanName,
+		})
+	}
+	return res, nil
+}
+
+// mapError converts repository errors to the service error types.
+func (s *Service) mapError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, ErrWorkoutNotFound):
+		return &scheduleService.NotFound{Message: "Workout not found"}
+	case errors.Is(err, ErrFeedNotFound):
+		return &scheduleService.NotFound{Message: "Calendar feed not found"}
+	}
+	utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+	return &scheduleService.InternalServerError{Message: "Internal Server error"}
+}
+
+func badRequest(name, message string) *scheduleService.BadRequest {
+	return &scheduleService.BadRequest{
+		Name:    name,
+		ID:      goa.NewErrorID(),
+		Message: message,
+	}
+}
@@ -0,0 +1,318 @@
+package trainingplan
+
+import (
+	"be/internal/events"
+	"be/internal/features/audit"
+	"be/internal/middleware"
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPlanNotFound    = errors.New("training plan not found")
+	ErrCallerNotFound  = errors.New("caller not found")
+	ErrVersionMismatch = errors.New("training plan was modified concurrently")
+)
+
+// Template is a user-less training plan that coaches clone into their
+// athletes' plans. Its dates only anchor the workouts' schedule.
+type Template struct {
+	ID          uuid.UUID
+	Name        string
+	Description *string
+	StartDate   time.Time
+	EndDate     time.Time
+	OwnerID     *uuid.UUID
+	Public      bool
+	CreatedAt   time.Time
+}
+
+// Source is a plan or template about to be cloned.
+type Source struct {
+	ID          uuid.UUID
+	Name        string
+	Description *string
+	StartDate   time.Time
+	EndDate     time.Time
+	UserID      *uuid.UUID
+	OwnerID     *uuid.UUID
+	IsTemplate  bool
+	Public      bool
+}
+
+// CloneTarget describes the copy to create: a plan of UserID, or a template
+// of OwnerID when IsTemplate is set.
+type CloneTarget struct {
+	Name        string
+	Description *string
+	StartDate   time.Time
+	UserID      *uuid.UUID
+	OwnerID     *uuid.UUID
+	IsTemplate  bool
+	Public      bool
+}
+
+// Caller is the authenticated user as seen by the training plan service.
+type Caller struct {
+	ID    uuid.UUID
+	Admin bool
+}
+
+// FindCaller resolves the user matching the JWT subject of the context.
+func (r *Repository) FindCaller(ctx context.Context) (*Caller, error) {
+	sub, ok := middleware.SubjectFromContext(ctx)
+	if !ok {
+		return nil, ErrCallerNotFound
+	}
+
+	var c Caller
+	err := r.DB.QueryRowContext(ctx, `
+	SELECT id, admin FROM users WHERE kc_id = $1 AND deleted_at IS NULL`, sub).Scan(&c.ID, &c.Admin)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCallerNotFound
+		}
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *Repository) FindSource(ctx context.Context, id uuid.UUID) (*Source, error) {
+	var src Source
+	err := r.DB.QueryRowContext(ctx, `
+	SELECT id, name, description, start_date, end_date, user_id, owner_id, is_template, public
+	FROM training_plan
+	WHERE id = $1 AND deleted_at IS NULL`, id).
+		Scan(&src.ID, &src.Name, &src.Description, &src.StartDate, &src.EndDate, &src.UserID, &src.OwnerID, &src.IsTemplate, &src.Public)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPlanNotFound
+		}
+		return nil, err
+	}
+	return &src, nil
+}
+
+// ListTemplates returns the public templates and those owned by ownerID.
+func (r *Repository) ListTemplates(ctx context.Context, limit, offset int, ownerID uuid.UUID) ([]Template, error) {
+	query := `
+	SELECT id, name, description, start_date, end_date, owner_id, public, created_at
+	FROM training_plan
+	WHERE is_template AND deleted_at IS NULL
+	  AND (public OR owner_id = $3)
+	ORDER BY name
+	LIMIT $1 OFFSET $2`
+
+	rows, err := r.DB.QueryContext(ctx, query, limit, offset, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []Template
+	for rows.Next() {
+		var t Template
+		err := rows.Scan(&t.ID, &t.Name, &t.Description, &t.StartDate, &t.EndDate, &t.OwnerID, &t.Public, &t.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, t)
+	}
+	return templates, rows.Err()
+}
+
+// CanRead reports whether the caller may clone the source: their own plans
+// and templates, public templates, or anything for admins.
+func (src *Source) CanRead(c *Caller) bool {
+	switch {
+	case c.Admin:
+		return true
+	case src.IsTemplate:
+		return src.Public || (src.OwnerID != nil && *src.OwnerID == c.ID)
+	default:
+		return src.UserID != nil && *src.UserID == c.ID
+	}
+}
+
+// Clone deep-copies the source plan, its workouts, exercises and planned
+// sets into a new plan starting at target.StartDate. The end date and the
+// workouts' scheduled dates are shifted by the same number of days.
+func (r *Repository) Clone(ctx context.Context, src Source, target CloneTarget, guards ...Guard) (uuid.UUID, error) {
+	shift := daysBetween(src.StartDate, target.StartDate)
+	endDate := src.EndDate.AddDate(0, 0, shift)
+	planID := uuid.New()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	defer tx.Rollback()
+
+	if target.UserID != nil {
+		if err := guard(ctx, tx, *target.UserID, guards); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO training_plan (id, name, description, start_date, end_date, user_id, owner_id, is_template, public)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		planID, target.Name, target.Description, target.StartDate, endDate,
+		target.UserID, target.OwnerID, target.IsTemplate, target.Public)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	workouts, err := r.sourceWorkouts(ctx, tx, src.ID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	for _, w := range workouts {
+		if err := cloneWorkout(ctx, tx, w, planID, shift); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	actor := target.UserID
+	if actor == nil {
+		actor = target.OwnerID
+	}
+	data := map[string]any{
+		"ID":         planID,
+		"SourceID":   src.ID,
+		"Name":       target.Name,
+		"StartDate":  target.StartDate,
+		"EndDate":    endDate,
+		"IsTemplate": target.IsTemplate,
+	}
+	if err := events.Emit(ctx, tx, events.TrainingPlanCreated, "training_plan", planID, actor, data); err != nil {
+		return uuid.Nil, err
+	}
+	if err := audit.Record(ctx, tx, planID); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return uuid.Nil, err
+	}
+	return planID, nil
+}
+
+type sourceWorkout struct {
+	ID         uuid.UUID
+	Recurrence *string
+}
+
+func (r *Repository) sourceWorkouts(ctx context.Context, tx *sql.Tx, planID uuid.UUID) ([]sourceWorkout, error) {
+	rows, err := tx.QueryContext(ctx, `
+	SELECT id, recurrence FROM workout
+	WHERE training_plan_id = $1 AND deleted_at IS NULL`, planID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var workouts []sourceWorkout
+	for rows.Next() {
+		var w sourceWorkout
+		if err := rows.Scan(&w.ID, &w.Recurrence); err != nil {
+			return nil, err
+		}
+		workouts = append(workouts, w)
+	}
+	return workouts, rows.Err()
+}
+
+func cloneWorkout(ctx context.Context, tx *sql.Tx, w sourceWorkout, planID uuid.UUID, shift int) error {
+	workoutID := uuid.New()
+
+	var recurrence *string
+	if w.Recurrence != nil {
+		shifted := shiftUntil(*w.Recurrence, shift)
+		recurrence = &shifted
+	}
+
+	_, err := tx.ExecContext(ctx, `
+	INSERT INTO workout (id, name, training_plan_id, position, scheduled_dates, recurrence)
+	SELECT $2, name, $3, position,
+		ARRAY(SELECT d + $4::int FROM unnest(scheduled_dates) d ORDER BY 1)::date[],
+		$5
+	FROM workout WHERE id = $1`, w.ID, workoutID, planID, shift, recurrence)
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+	SELECT id FROM exercise WHERE workout_id = $1 AND deleted_at IS NULL`, w.ID)
+	if err != nil {
+		return err
+	}
+	var exerciseIDs []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		exerciseIDs = append(exerciseIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, exerciseID := range exerciseIDs {
+		newID := uuid.New()
+		_, err := tx.ExecContext(ctx, `
+		INSERT INTO exercise (id, name, workout_id, exercise_type_id)
+		SELECT $2, name, $3, exercise_type_id FROM exercise WHERE id = $1`, exerciseID, newID, workoutID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+		INSERT INTO exercise_set (id, exercise_id, weight, reps, rest_time)
+		SELECT gen_random_uuid(), $2, weight, reps, rest_time
+		FROM exercise_set WHERE exercise_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at`, exerciseID, newID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+		INSERT INTO progression_rule (id, exercise_id, kind, increment, min_reps, max_reps, percentage, deload_after, deload_percentage)
+		SELECT gen_random_uuid(), $2, kind, increment, min_reps, max_reps, percentage, deload_after, deload_percentage
+		FROM progression_rule WHERE exercise_id = $1`, exerciseID, newID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var untilPattern = regexp.MustCompile(`UNTIL=(\d{8})(T\d{6}Z)?`)
+
+// shiftUntil moves the UNTIL date of a recurrence rule by shift days, so the
+// recurrence ends at the same point of the cloned plan.
+func shiftUntil(rule string, shift int) string {
+	return untilPattern.ReplaceAllStringFunc(rule, func(m string) string {
+		parts := untilPattern.FindStringSubmatch(m)
+		until, err := time.Parse("20060102", parts[1])
+		if err != nil {
+			return m
+		}
+		return "UNTIL=" + until.AddDate(0, 0, shift).Format("20060102") + parts[2]
+	})
+}
+
+// daysBetween returns the number of calendar days from a to b.
+func daysBetween(a, b time.Time) int {
+	from := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from).Hours() / 24)
+}
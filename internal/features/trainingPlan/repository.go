@@ -2,12 +2,15 @@ package trainingplan
 
 import (
 	"be/internal/database/db"
+	"be/internal/events"
+	"be/internal/features/audit"
 	"context"
 	"database/sql"
 	"errors"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type TrainingPlan struct {
@@ -17,6 +20,7 @@ type TrainingPlan struct {
 	StartDate   time.Time
 	EndDate     time.Time
 	UserID      uuid.UUID
+	Version     int // incremented on every change, for optimistic concurrency
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	DeletedAt   sql.NullTime
@@ -31,12 +35,12 @@ func NewRepository() *Repository {
 }
 
 func (r *Repository) FindByID(ctx context.Context, id uuid.UUID) (*TrainingPlan, error) {
-	query := `SELECT id, name, description, start_date, end_date, user_id FROM training_plan WHERE id = $1 AND deleted_at IS NULL`
+	query := `SELECT id, name, description, start_date, end_date, user_id, version FROM training_plan WHERE id = $1 AND deleted_at IS NULL AND NOT is_template`
 
 	row := r.DB.QueryRowContext(ctx, query, id)
 
 	var tp TrainingPlan
-	err := row.Scan(&tp.ID, &tp.Name, &tp.Description, &tp.StartDate, &tp.EndDate, &tp.UserID)
+	err := row.Scan(&tp.ID, &tp.Name, &tp.Description, &tp.StartDate, &tp.EndDate, &tp.UserID, &tp.Version)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -47,7 +51,9 @@ func (r *Repository) FindByID(ctx context.Context, id uuid.UUID) (*TrainingPlan,
 	return &tp, nil
 }
 
-func (r *Repository) List(ctx context.Context, limit, offset int, startDate, userID *string) ([]TrainingPlan, error) {
+// List returns the plans matching the filters. When userIDs is not nil only
+// the plans of those users are returned.
+func (r *Repository) List(ctx context.Context, limit, offset int, startDate, userID *string, userIDs []uuid.UUID) ([]TrainingPlan, error) {
 	query := `
 	SELECT 
 		id, 
@@ -55,19 +61,24 @@ func (r *Repository) List(ctx context.Context, limit, offset int, startDate, use
 		description, 
 		start_date, 
 		end_date, 
-		user_id 
+		user_id,
+		version
 	FROM training_plan 
 	WHERE 
 		deleted_at IS NULL 
 			AND 
+		NOT is_template
+			AND 
 		($3::uuid IS NULL OR user_id = $3)
 			AND
 		($4::timestamp IS NULL OR start_date >= $4)
+			AND
+		($5::uuid[] IS NULL OR user_id = ANY($5))
 	ORDER BY start_date 
 	LIMIT $1 OFFSET $2
 	`
 
-	rows, err := r.DB.QueryContext(ctx, query, limit, offset, userID, startDate)
+	rows, err := r.DB.QueryContext(ctx, query, limit, offset, userID, startDate, pq.Array(userIDs))
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +87,7 @@ func (r *Repository) List(ctx context.Context, limit, offset int, startDate, use
 	var plans []TrainingPlan
 	for rows.Next() {
 		var tp TrainingPlan
-		err := rows.Scan(&tp.ID, &tp.Name, &tp.Description, &tp.StartDate, &tp.EndDate, &tp.UserID)
+		err := rows.Scan(&tp.ID, &tp.Name, &tp.Description, &tp.StartDate, &tp.EndDate, &tp.UserID, &tp.Version)
 		if err != nil {
 			return nil, err
 		}
@@ -85,7 +96,7 @@ func (r *Repository) List(ctx context.Context, limit, offset int, startDate, use
 	return plans, nil
 }
 
-func (r *Repository) Save(ctx context.Context, tp TrainingPlan) (*TrainingPlan, error) {
+func (r *Repository) Save(ctx context.Context, tp TrainingPlan, guards ...Guard) (*TrainingPlan, error) {
 	query := `INSERT INTO training_plan (id, name, description, start_date, end_date, user_id)
 	          VALUES ($1, $2, $3, $4, $5, $6)
 	          ON CONFLICT (id) DO UPDATE SET
@@ -93,33 +104,144 @@ func (r *Repository) Save(ctx context.Context, tp TrainingPlan) (*TrainingPlan,
 				description = EXCLUDED.description,
 				start_date = EXCLUDED.start_date,
 				end_date = EXCLUDED.end_date,
-				user_id = EXCLUDED.user_id`
+				user_id = EXCLUDED.user_id,
+				version = training_plan.version + 1
+	          WHERE training_plan.version = $7
+	          RETURNING (xmax = 0), version`
 
 	if tp.ID == uuid.Nil {
 		tp.ID = uuid.New()
 	}
 
-	_, err := r.DB.ExecContext(ctx, query,
-		tp.ID, tp.Name, tp.Description, tp.StartDate, tp.EndDate, tp.UserID)
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := guard(ctx, tx, tp.UserID, guards); err != nil {
+		return nil, err
+	}
+
+	var inserted bool
+	err = tx.QueryRowContext(ctx, query,
+		tp.ID, tp.Name, tp.Description, tp.StartDate, tp.EndDate, tp.UserID, tp.Version).Scan(&inserted, &tp.Version)
 
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrVersionMismatch
+		}
+		return nil, err
+	}
+
+	eventType := events.TrainingPlanUpdated
+	if inserted {
+		eventType = events.TrainingPlanCreated
+	}
+	if err := events.Emit(ctx, tx, eventType, "training_plan", tp.ID, &tp.UserID, tp); err != nil {
+		return nil, err
+	}
+	if err := audit.Record(ctx, tx, tp.ID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
 	return &tp, nil
 }
 
-func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `UPDATE training_plan SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
-	res, err := r.DB.ExecContext(ctx, query, id)
+// Delete moves the plan to the trash, provided it is still at version.
+func (r *Repository) Delete(ctx context.Context, id uuid.UUID, version int) error {
+	query := `
+	UPDATE training_plan SET deleted_at = NOW(), version = version + 1
+	WHERE id = $1 AND deleted_at IS NULL AND version = $2
+	RETURNING user_id`
+
+	tx, err := r.DB.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
 
-	count, err := res.RowsAffected()
-	if err == nil && count == 0 {
-		return errors.New("training plan not found or already deleted")
+	var userID uuid.UUID
+	err = tx.QueryRowContext(ctx, query, id, version).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return r.missing(ctx, tx, id)
+		}
+		return err
+	}
+	if err := CascadeDelete(ctx, tx, []uuid.UUID{id}); err != nil {
+		return err
 	}
 
+	if err := events.Emit(ctx, tx, events.TrainingPlanDeleted, "training_plan", id, &userID, map[string]any{"ID": id}); err != nil {
+		return err
+	}
+	if err := audit.Record(ctx, tx, id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// missing tells why a plan could not be changed: it is gone, or it is at
+// another version.
+func (r *Repository) missing(ctx context.Context, tx *sql.Tx, id uuid.UUID) error {
+	var exists bool
+	err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM training_plan WHERE id = $1 AND deleted_at IS NULL)`, id).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrVersionMismatch
+	}
+	return ErrPlanNotFound
+}
+
+// guard locks the plans of userID against concurrent writes, by locking
+// the user, and runs guards in tx.
+func guard(ctx context.Context, tx *sql.Tx, userID uuid.UUID, guards []Guard) error {
+	if len(guards) == 0 {
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, `SELECT 1 FROM users WHERE id = $1 FOR NO KEY UPDATE`, userID); err != nil {
+		return err
+	}
+	for _, g := range guards {
+		if err := g(ctx, tx); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// Overlapping returns the other plans of the user whose dates intersect
+// [start, end], excluding the plan excludeID, reading them with tx.
+func (r *Repository) Overlapping(ctx context.Context, tx *sql.Tx, userID uuid.UUID, start, end time.Time, excludeID uuid.UUID) ([]TrainingPlan, error) {
+	query := `
+	SELECT id, name, description, start_date, end_date, user_id
+	FROM training_plan
+	WHERE user_id = $1 AND id <> $4
+	  AND deleted_at IS NULL AND NOT is_template
+	  AND start_date <= $3 AND end_date >= $2
+	ORDER BY start_date`
+
+	rows, err := tx.QueryContext(ctx, query, userID, start, end, excludeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var plans []TrainingPlan
+	for rows.Next() {
+		var tp TrainingPlan
+		if err := rows.Scan(&tp.ID, &tp.Name, &tp.Description, &tp.StartDate, &tp.EndDate, &tp.UserID); err != nil {
+			return nil, err
+		}
+		plans = append(plans, tp)
+	}
+	return plans, rows.Err()
+}
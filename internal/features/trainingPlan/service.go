@@ -2,25 +2,37 @@ package trainingplan
 
 import (
 	trainingplanService "be/gen/training_plan"
+	"be/internal/features/coaching"
+	"be/internal/helpers/etag"
+	"be/internal/helpers/mergepatch"
 	"be/internal/middleware"
 	"be/internal/utils"
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"time"
 
 	"github.com/google/uuid"
 	"goa.design/clue/log"
+	goa "goa.design/goa/v3/pkg"
 	"goa.design/goa/v3/security"
-	"gorm.io/gorm"
 )
 
 type Service struct {
 	Repository *Repository
+	access     *coaching.Authorizer
+	validator  *Validator
 }
 
-func NewService() *Service {
+func NewService(policy OverlapPolicy) *Service {
+	repo := NewRepository()
 	return &Service{
-		Repository: NewRepository(),
+		Repository: repo,
+		access:     coaching.NewAuthorizer(),
+		validator:  NewValidator(repo, policy),
 	}
 }
 
@@ -43,129 +55,626 @@ func (s *Service) OAuth2Auth(ctx context.Context, token string, scheme *security
 }
 
 func (s *Service) Create(ctx context.Context, payload *trainingplanService.CreatePayload) (*trainingplanService.TrainingPlan, error) {
-	startDate, err := parseDate(payload.StartDate)
+	tp, err := Parse(PlanInput{
+		Name:        payload.Name,
+		Description: payload.Description,
+		StartDate:   payload.StartDate,
+		EndDate:     payload.EndDate,
+		UserID:      payload.UserID,
+	})
 	if err != nil {
-		return nil, errors.New("invalid startDate format")
+		return nil, s.mapError(ctx, err)
+	}
+	if err := s.authorize(ctx, tp.UserID); err != nil {
+		return nil, err
 	}
 
-	endDate, err := parseDate(payload.StartDate)
+	var warnings []string
+	saved, err := s.Repository.Save(ctx, tp, s.validator.Guard(tp, &warnings))
 	if err != nil {
-		return nil, errors.New("invalid endDate format")
+		return nil, s.mapError(ctx, err)
 	}
 
-	tp := TrainingPlan{
-		Name:        payload.Name,
-		Description: payload.Description,
-		StartDate:   startDate,
-		EndDate:     endDate,
-		UserID:      uuid.MustParse(payload.UserID),
+	res := toPlanResult(saved)
+	res.Warnings = warnings
+	return res, nil
+}
+
+func (s *Service) Get(ctx context.Context, payload *trainingplanService.GetPayload) (*trainingplanService.TrainingPlan, error) {
+	tp, err := s.plan(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+	return toPlanResult(tp), nil
+}
+
+func (s *Service) List(ctx context.Context, payload *trainingplanService.ListPayload) ([]*trainingplanService.TrainingPlan, error) {
+	caller, err := s.Repository.FindCaller(ctx)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+
+	// Without a userId filter, non-admins see their own plans and those of
+	// the athletes they coach.
+	var userIDs []uuid.UUID
+	if payload.UserID != nil {
+		userID, err := uuid.Parse(*payload.UserID)
+		if err != nil {
+			return nil, badRequest("invalid_id", "invalid user ID")
+		}
+		if err := s.authorize(ctx, userID); err != nil {
+			return nil, err
+		}
+	} else if !caller.Admin {
+		athletes, err := s.access.Athletes(ctx, caller.ID)
+		if err != nil {
+			return nil, s.mapError(ctx, err)
+		}
+		userIDs = append(athletes, caller.ID)
+	}
+
+	tps, err := s.Repository.List(ctx, payload.Limit, payload.Offset, payload.StartAfter, payload.UserID, userIDs)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
 	}
 
-	saved, err := s.Repository.Save(ctx, tp)
+	var res []*trainingplanService.TrainingPlan
+	for i := range tps {
+		res = append(res, toPlanResult(&tps[i]))
+	}
+	return res, nil
+}
+
+func (s *Service) Update(ctx context.Context, payload *trainingplanService.UpdatePayload) (*trainingplanService.TrainingPlan, error) {
+	current, err := s.plan(ctx, payload.ID)
 	if err != nil {
 		return nil, err
 	}
+	if !etag.Match(payload.IfMatch, current.Version) {
+		return nil, s.mapError(ctx, ErrVersionMismatch)
+	}
 
-	return &trainingplanService.TrainingPlan{
-		ID:          saved.ID.String(),
-		Name:        saved.Name,
-		Description: saved.Description,
-		StartDate:   saved.StartDate.Format(time.RFC3339),
-		EndDate:     saved.EndDate.Format(time.RFC3339),
-		UserID:      saved.UserID.String(),
-	}, nil
+	tp, err := Parse(PlanInput{
+		Name:        payload.Name,
+		Description: payload.Description,
+		StartDate:   payload.StartDate,
+		EndDate:     payload.EndDate,
+		UserID:      payload.UserID,
+	})
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	return s.replace(ctx, current, tp)
 }
 
-func (s *Service) Get(ctx context.Context, payload *trainingplanService.GetPayload) (*trainingplanService.TrainingPlan, error) {
-	id, err := uuid.Parse(payload.ID)
+func (s *Service) Patch(ctx context.Context, payload *trainingplanService.PatchPayload, body io.ReadCloser) (*trainingplanService.TrainingPlan, error) {
+	defer body.Close()
+
+	current, err := s.plan(ctx, payload.ID)
 	if err != nil {
-		return nil, errors.New("invalid ID format")
+		return nil, err
+	}
+	if !etag.Match(payload.IfMatch, current.Version) {
+		return nil, s.mapError(ctx, ErrVersionMismatch)
+	}
+
+	patch, err := mergepatch.Decode(body, "name", "description", "startDate", "endDate", "userId")
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	in := PlanInput{
+		Name:        current.Name,
+		Description: current.Description,
+		StartDate:   current.StartDate.Format(time.RFC3339),
+		EndDate:     current.EndDate.Format(time.RFC3339),
+		UserID:      current.UserID.String(),
+	}
+	patch.String("name", &in.Name)
+	patch.NullableString("description", &in.Description)
+	patch.String("startDate", &in.StartDate)
+	patch.String("endDate", &in.EndDate)
+	patch.String("userId", &in.UserID)
+	if err := patch.Err(); err != nil {
+		return nil, s.mapError(ctx, err)
 	}
 
-	tp, err := s.Repository.FindByID(ctx, id)
+	tp, err := Parse(in)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, &trainingplanService.NotFound{Message: "Piano non trovato"}
+		return nil, s.mapError(ctx, err)
+	}
+	return s.replace(ctx, current, tp)
+}
+
+// replace saves tp over the current version of a plan, checking the caller
+// may move it to another user and that it doesn't overlap their other plans.
+func (s *Service) replace(ctx context.Context, current *TrainingPlan, tp TrainingPlan) (*trainingplanService.TrainingPlan, error) {
+	tp.ID = current.ID
+	tp.Version = current.Version
+	if tp.UserID != current.UserID {
+		if err := s.authorize(ctx, tp.UserID); err != nil {
+			return nil, err
 		}
-		return nil, err
 	}
 
-	return &trainingplanService.TrainingPlan{
-		ID:          tp.ID.String(),
-		Name:        tp.Name,
-		Description: tp.Description,
-		StartDate:   tp.StartDate.Format(time.RFC3339),
-		EndDate:     tp.EndDate.Format(time.RFC3339),
-		UserID:      tp.UserID.String(),
-	}, nil
+	var warnings []string
+	saved, err := s.Repository.Save(ctx, tp, s.validator.Guard(tp, &warnings))
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+
+	res := toPlanResult(saved)
+	res.Warnings = warnings
+	return res, nil
 }
 
-func (s *Service) List(ctx context.Context, payload *trainingplanService.ListPayload) ([]*trainingplanService.TrainingPlan, error) {
-	tps, err := s.Repository.List(ctx, payload.Limit, payload.Offset, payload.StartAfter, payload.UserID)
+func (s *Service) Delete(ctx context.Context, payload *trainingplanService.DeletePayload) error {
+	tp, err := s.plan(ctx, payload.ID)
 	if err != nil {
+		return err
+	}
+	if !etag.Match(payload.IfMatch, tp.Version) {
+		return s.mapError(ctx, ErrVersionMismatch)
+	}
+	if err := s.Repository.Delete(ctx, tp.ID, tp.Version); err != nil {
+		return s.mapError(ctx, err)
+	}
+	return nil
+}
+
+func (s *Service) Restore(ctx context.Context, payload *trainingplanService.RestorePayload) (*trainingplanService.TrainingPlan, error) {
+	planID, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return nil, badRequest("invalid_id", "invalid ID format")
+	}
+
+	tp, err := s.Repository.FindDeleted(ctx, planID)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	if tp == nil {
+		return nil, &trainingplanService.NotFound{Message: "Piano non trovato"}
+	}
+	if err := s.authorize(ctx, tp.UserID); err != nil {
 		return nil, err
 	}
 
-	var res []*trainingplanService.TrainingPlan
-	for _, tp := range tps {
-		res = append(res, &trainingplanService.TrainingPlan{
-			ID:          tp.ID.String(),
-			Name:        tp.Name,
-			Description: tp.Description,
-			StartDate:   tp.StartDate.Format(time.RFC3339),
-			EndDate:     tp.EndDate.Format(time.RFC3339),
-			UserID:      tp.UserID.String(),
-		})
+	var warnings []string
+	restored, err := s.Repository.Restore(ctx, tp.ID, s.validator.Guard(*tp, &warnings))
+	if err != nil {
+		return nil, s.mapError(ctx, err)
 	}
+
+	res := toPlanResult(restored)
+	res.Warnings = warnings
 	return res, nil
 }
 
-func (s *Service) Update(ctx context.Context, payload *trainingplanService.UpdatePayload) (*trainingplanService.TrainingPlan, error) {
-	id, err := uuid.Parse(payload.ID)
+func (s *Service) ListDeleted(ctx context.Context, payload *trainingplanService.ListDeletedPayload) ([]*trainingplanService.TrainingPlan, error) {
+	caller, err := s.Repository.FindCaller(ctx)
 	if err != nil {
-		return nil, errors.New("invalid ID format")
+		return nil, s.mapError(ctx, err)
 	}
 
-	tp, err := s.Repository.FindByID(ctx, id)
+	// As in List, non-admins see their own trash and their athletes'.
+	var userIDs []uuid.UUID
+	if payload.UserID != nil {
+		userID, err := uuid.Parse(*payload.UserID)
+		if err != nil {
+			return nil, badRequest("invalid_id", "invalid user ID")
+		}
+		if err := s.authorize(ctx, userID); err != nil {
+			return nil, err
+		}
+		userIDs = []uuid.UUID{userID}
+	} else if !caller.Admin {
+		athletes, err := s.access.Athletes(ctx, caller.ID)
+		if err != nil {
+			return nil, s.mapError(ctx, err)
+		}
+		userIDs = append(athletes, caller.ID)
+	}
+
+	tps, err := s.Repository.ListDeleted(ctx, payload.Limit, payload.Offset, userIDs)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+
+	res := []*trainingplanService.TrainingPlan{}
+	for i := range tps {
+		res = append(res, toPlanResult(&tps[i]))
+	}
+	return res, nil
+}
+
+func (s *Service) Clone(ctx context.Context, payload *trainingplanService.ClonePayload) (*trainingplanService.TrainingPlan, error) {
+	caller, src, err := s.cloneSource(ctx, payload.ID)
 	if err != nil {
 		return nil, err
 	}
 
 	startDate, err := parseDate(payload.StartDate)
 	if err != nil {
-		return nil, errors.New("invalid startDate format")
+		return nil, badRequest("invalid_start_date", "invalid startDate format")
+	}
+
+	userID := caller.ID
+	if payload.UserID != nil {
+		if userID, err = uuid.Parse(*payload.UserID); err != nil {
+			return nil, badRequest("invalid_id", "invalid user ID")
+		}
+	}
+	if ok, err := s.access.CanAccess(ctx, caller.ID, caller.Admin, userID); err != nil {
+		return nil, s.mapError(ctx, err)
+	} else if !ok {
+		return nil, &trainingplanService.Forbidden{Message: "Forbidden"}
+	}
+
+	target := CloneTarget{
+		Name:        src.Name,
+		Description: src.Description,
+		StartDate:   startDate,
+		UserID:      &userID,
+	}
+	if payload.Name != nil {
+		target.Name = *payload.Name
+	}
+
+	var warnings []string
+	planID, err := s.Repository.Clone(ctx, *src, target, s.validator.Guard(TrainingPlan{
+		StartDate: startDate,
+		EndDate:   startDate.AddDate(0, 0, daysBetween(src.StartDate, src.EndDate)),
+		UserID:    userID,
+	}, &warnings))
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+
+	tp, err := s.Repository.FindByID(ctx, planID)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	if tp == nil {
+		return nil, &trainingplanService.NotFound{Message: "Piano non trovato"}
+	}
+
+	res := toPlanResult(tp)
+	res.Warnings = warnings
+	return res, nil
+}
+
+func (s *Service) CreateTemplate(ctx context.Context, payload *trainingplanService.CreateTemplatePayload) (*trainingplanService.PlanTemplate, error) {
+	caller, src, err := s.cloneSource(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+	if src.IsTemplate {
+		return nil, badRequest("invalid_source", "the source is already a template")
+	}
+
+	target := CloneTarget{
+		Name:        src.Name,
+		Description: src.Description,
+		StartDate:   src.StartDate,
+		OwnerID:     &caller.ID,
+		IsTemplate:  true,
+		Public:      payload.Public,
+	}
+	if payload.Name != nil {
+		target.Name = *payload.Name
+	}
+
+	templateID, err := s.Repository.Clone(ctx, *src, target)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+
+	return &trainingplanService.PlanTemplate{
+		ID:          templateID.String(),
+		Name:        target.Name,
+		Description: target.Description,
+		StartDate:   src.StartDate.Format(time.RFC3339),
+		EndDate:     src.EndDate.Format(time.RFC3339),
+		OwnerID:     stringPtr(caller.ID),
+		Public:      target.Public,
+	}, nil
+}
+
+func (s *Service) ListTemplates(ctx context.Context, payload *trainingplanService.ListTemplatesPayload) ([]*trainingplanService.PlanTemplate, error) {
+	caller, err := s.Repository.FindCaller(ctx)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+
+	templates, err := s.Repository.ListTemplates(ctx, payload.Limit, payload.Offset, caller.ID)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+
+	res := []*trainingplanService.PlanTemplate{}
+	for _, t := range templates {
+		tmpl := &trainingplanService.PlanTemplate{
+			ID:          t.ID.String(),
+			Name:        t.Name,
+			Description: t.Description,
+			StartDate:   t.StartDate.Format(time.RFC3339),
+			EndDate:     t.EndDate.Format(time.RFC3339),
+			Public:      t.Public,
+		}
+		if t.OwnerID != nil {
+			tmpl.OwnerID = stringPtr(*t.OwnerID)
+		}
+		res = append(res, tmpl)
 	}
+	return res, nil
+}
 
-	endDate, err := parseDate(payload.StartDate)
+func (s *Service) Export(ctx context.Context, payload *trainingplanService.ExportPayload) (*trainingplanService.ExportResult, io.ReadCloser, error) {
+	tp, err := s.plan(ctx, payload.ID)
 	if err != nil {
-		return nil, errors.New("invalid endDate format")
+		return nil, nil, err
+	}
+
+	var buf bytes.Buffer
+	res := &trainingplanService.ExportResult{}
+	if payload.Format == "csv" {
+		if err := s.Repository.WriteLoggedSetsCSV(ctx, &buf, tp.ID); err != nil {
+			return nil, nil, s.mapError(ctx, err)
+		}
+		res.ContentType = "text/csv; charset=utf-8"
+		res.ContentDisposition = fmt.Sprintf(`attachment; filename="plan-%s-sets.csv"`, tp.ID)
+	} else {
+		doc, err := s.Repository.Export(ctx, tp)
+		if err != nil {
+			return nil, nil, s.mapError(ctx, err)
+		}
+		enc := json.NewEncoder(&buf)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(doc); err != nil {
+			return nil, nil, s.mapError(ctx, err)
+		}
+		res.ContentType = "application/json"
+		res.ContentDisposition = fmt.Sprintf(`attachment; filename="plan-%s.json"`, tp.ID)
 	}
+	return res, io.NopCloser(&buf), nil
+}
 
-	tp.Name = payload.Name
-	tp.Description = payload.Description
-	tp.StartDate = startDate
-	tp.EndDate = endDate
-	tp.UserID = uuid.MustParse(payload.UserID)
+func (s *Service) Import(ctx context.Context, payload *trainingplanService.ImportPayload) (*trainingplanService.PlanImportReport, error) {
+	caller, err := s.Repository.FindCaller(ctx)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	userID := caller.ID.String()
+	if payload.UserID != nil {
+		userID = *payload.UserID
+	}
 
-	saved, err := s.Repository.Save(ctx, *tp)
+	doc := toDocument(payload.Document)
+	tp, err := Parse(PlanInput{
+		Name:        doc.Plan.Name,
+		Description: doc.Plan.Description,
+		StartDate:   doc.Plan.StartDate,
+		EndDate:     doc.Plan.EndDate,
+		UserID:      userID,
+	})
 	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	if err := s.authorize(ctx, tp.UserID); err != nil {
 		return nil, err
 	}
+	if err := CheckDocument(doc, tp); err != nil {
+		return nil, s.mapError(ctx, err)
+	}
 
-	return &trainingplanService.TrainingPlan{
-		ID:          saved.ID.String(),
-		Name:        saved.Name,
-		Description: saved.Description,
-		StartDate:   saved.StartDate.Format(time.RFC3339),
-		EndDate:     saved.EndDate.Format(time.RFC3339),
-		UserID:      saved.UserID.String(),
+	catalog, err := s.Repository.ExerciseTypeCatalog(ctx)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	unmatched, fields := MatchExerciseTypes(doc, catalog)
+	if len(fields) > 0 && !payload.AllowUnmatched {
+		return nil, s.mapError(ctx, &ValidationError{Name: "unmatched_exercise_types", Fields: fields})
+	}
+
+	var warnings []string
+	result, err := s.Repository.Import(ctx, tp, doc, s.validator.Guard(tp, &warnings))
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	tp.ID = result.PlanID
+
+	plan := toPlanResult(&tp)
+	plan.Warnings = warnings
+	if unmatched == nil {
+		unmatched = []string{}
+	}
+	return &trainingplanService.PlanImportReport{
+		Plan:      plan,
+		Workouts:  result.Workouts,
+		Exercises: result.Exercises,
+		Sets:      result.Sets,
+		Unmatched: unmatched,
 	}, nil
 }
 
-func (s *Service) Delete(ctx context.Context, payload *trainingplanService.DeletePayload) error {
-	id, err := uuid.Parse(payload.ID)
+// cloneSource resolves the caller and the plan or template they want to
+// copy, checking they are allowed to read it.
+func (s *Service) cloneSource(ctx context.Context, id string) (*Caller, *Source, error) {
+	caller, err := s.Repository.FindCaller(ctx)
+	if err != nil {
+		return nil, nil, s.mapError(ctx, err)
+	}
+
+	sourceID, err := uuid.Parse(id)
 	if err != nil {
-		return errors.New("invalid ID format")
+		return nil, nil, badRequest("invalid_id", "invalid ID format")
+	}
+
+	src, err := s.Repository.FindSource(ctx, sourceID)
+	if err != nil {
+		return nil, nil, s.mapError(ctx, err)
+	}
+	if src.CanRead(caller) {
+		return caller, src, nil
+	}
+	// Coaches may also copy their athletes' plans.
+	if !src.IsTemplate && src.UserID != nil {
+		ok, err := s.access.CanAccess(ctx, caller.ID, caller.Admin, *src.UserID)
+		if err != nil {
+			return nil, nil, s.mapError(ctx, err)
+		}
+		if ok {
+			return caller, src, nil
+		}
+	}
+	return nil, nil, &trainingplanService.Forbidden{Message: "Forbidden"}
+}
+
+// plan loads the plan of a request, checking the caller may access it.
+func (s *Service) plan(ctx context.Context, id string) (*TrainingPlan, error) {
+	planID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, badRequest("invalid_id", "invalid ID format")
+	}
+
+	tp, err := s.Repository.FindByID(ctx, planID)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	if tp == nil {
+		return nil, &trainingplanService.NotFound{Message: "Piano non trovato"}
+	}
+	if err := s.authorize(ctx, tp.UserID); err != nil {
+		return nil, err
+	}
+	return tp, nil
+}
+
+// authorize checks the caller may read and edit the plans of ownerID: as
+// that user, their coach or an admin.
+func (s *Service) authorize(ctx context.Context, ownerID uuid.UUID) error {
+	caller, err := s.Repository.FindCaller(ctx)
+	if err != nil {
+		return s.mapError(ctx, err)
+	}
+	ok, err := s.access.CanAccess(ctx, caller.ID, caller.Admin, ownerID)
+	if err != nil {
+		return s.mapError(ctx, err)
+	}
+	if !ok {
+		return &trainingplanService.Forbidden{Message: "Forbidden"}
+	}
+	return nil
+}
+
+// mapError converts repository and validation errors to the service error
+// types.
+func (s *Service) mapError(ctx context.Context, err error) error {
+	var verr *ValidationError
+	if errors.As(err, &verr) {
+		res := badRequest(verr.Name, verr.Error())
+		for _, f := range verr.Fields {
+			res.Fields = append(res.Fields, &trainingplanService.FieldError{Field: f.Field, Message: f.Message})
+		}
+		return res
+	}
+	var perr *mergepatch.Error
+	if errors.As(err, &perr) {
+		res := badRequest("invalid_fields", perr.Error())
+		for _, f := range perr.Fields {
+			res.Fields = append(res.Fields, &trainingplanService.FieldError{Field: f.Field, Message: f.Message})
+		}
+		return res
+	}
+
+	switch {
+	case errors.Is(err, ErrPlanNotFound):
+		return &trainingplanService.NotFound{Message: "Piano non trovato"}
+	case errors.Is(err, ErrCallerNotFound):
+		return &trainingplanService.Forbidden{Message: "Forbidden"}
+	case errors.Is(err, mergepatch.ErrNotObject):
+		return badRequest("invalid_patch", err.Error())
+	case errors.Is(err, ErrVersionMismatch):
+		return &trainingplanService.PreconditionFailed{Message: "Il piano è stato modificato da un'altra richiesta"}
+	case errors.Is(err, ErrOwnerDeleted):
+		return badRequest("owner_deleted", "the user of the plan is deleted, restore the user instead")
+	}
+	utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+	return &trainingplanService.InternalServerError{Message: "Internal Server error"}
+}
+
+func toPlanResult(tp *TrainingPlan) *trainingplanService.TrainingPlan {
+	tag := etag.Format(tp.Version)
+	res := &trainingplanService.TrainingPlan{
+		ID:          tp.ID.String(),
+		Name:        tp.Name,
+		Description: tp.Description,
+		StartDate:   tp.StartDate.Format(time.RFC3339),
+		EndDate:     tp.EndDate.Format(time.RFC3339),
+		UserID:      tp.UserID.String(),
+		Etag:        &tag,
+	}
+	if tp.DeletedAt.Valid {
+		deletedAt := tp.DeletedAt.Time.Format(time.RFC3339)
+		res.DeletedAt = &deletedAt
+	}
+	return res
+}
+
+func toDocument(p *trainingplanService.PlanDocument) *Document {
+	doc := &Document{Version: p.Version}
+	if p.Plan == nil {
+		return doc
+	}
+	doc.Plan = DocumentPlan{
+		Name:        p.Plan.Name,
+		Description: p.Plan.Description,
+		StartDate:   p.Plan.StartDate,
+		EndDate:     p.Plan.EndDate,
+	}
+	for _, w := range p.Plan.Workouts {
+		dw := DocumentWorkout{
+			Name:           w.Name,
+			ScheduledDates: w.ScheduledDates,
+			Recurrence:     w.Recurrence,
+		}
+		if w.Position != nil {
+			dw.Position = *w.Position
+		}
+		for _, e := range w.Exercises {
+			de := DocumentExercise{Name: e.Name}
+			if e.ExerciseType != nil {
+				de.ExerciseType = *e.ExerciseType
+			}
+			for _, set := range e.Sets {
+				de.Sets = append(de.Sets, DocumentSet{Weight: set.Weight, Reps: set.Reps, RestTime: set.RestTime})
+			}
+			if pr := e.Progression; pr != nil {
+				de.Progression = &DocumentProgression{
+					Kind:             pr.Kind,
+					Increment:        pr.Increment,
+					MinReps:          pr.MinReps,
+					MaxReps:          pr.MaxReps,
+					Percentage:       pr.Percentage,
+					DeloadAfter:      pr.DeloadAfter,
+					DeloadPercentage: pr.DeloadPercentage,
+				}
+			}
+			dw.Exercises = append(dw.Exercises, de)
+		}
+		doc.Plan.Workouts = append(doc.Plan.Workouts, dw)
+	}
+	return doc
+}
+
+func stringPtr(id uuid.UUID) *string {
+	s := id.String()
+	return &s
+}
+
+func badRequest(name, message string) *trainingplanService.BadRequest {
+	return &trainingplanService.BadRequest{
+		Name:    name,
+		ID:      goa.NewErrorID(),
+		Message: message,
 	}
-	return s.Repository.Delete(ctx, id)
 }
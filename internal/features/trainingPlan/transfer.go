@@ -0,0 +1,488 @@
+package trainingplan
+
+import (
+	"be/internal/events"
+	"be/internal/features/audit"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// DocumentVersion is the version of the plan document format written by
+// export. Import rejects other versions.
+const DocumentVersion = 1
+
+const dateLayout = "2006-01-02"
+
+// Document is the portable form of a training plan: its workouts, exercises,
+// planned sets and progression rules, with exercise types referenced by name.
+type Document struct {
+	Version    int          `json:"version"`
+	ExportedAt string       `json:"exportedAt"`
+	Plan       DocumentPlan `json:"plan"`
+}
+
+type DocumentPlan struct {
+	Name        string            `json:"name"`
+	Description *string           `json:"description,omitempty"`
+	StartDate   string            `json:"startDate"`
+	EndDate     string            `json:"endDate"`
+	Workouts    []DocumentWorkout `json:"workouts"`
+}
+
+type DocumentWorkout struct {
+	Name           string             `json:"name"`
+	Position       int                `json:"position"`
+	ScheduledDates []string           `json:"scheduledDates"`
+	Recurrence     *string            `json:"recurrence,omitempty"`
+	Exercises      []DocumentExercise `json:"exercises"`
+}
+
+type DocumentExercise struct {
+	Name         string               `json:"name"`
+	ExerciseType string               `json:"exerciseType"`
+	Sets         []DocumentSet        `json:"sets"`
+	Progression  *DocumentProgression `json:"progression,omitempty"`
+
+	typeID *uuid.UUID // resolved by MatchExerciseTypes
+}
+
+type DocumentSet struct {
+	Weight   float64 `json:"weight"`
+	Reps     int     `json:"reps"`
+	RestTime int     `json:"restTime"`
+}
+
+type DocumentProgression struct {
+	Kind             string   `json:"kind"`
+	Increment        float64  `json:"increment"`
+	MinReps          *int     `json:"minReps,omitempty"`
+	MaxReps          *int     `json:"maxReps,omitempty"`
+	Percentage       *float64 `json:"percentage,omitempty"`
+	DeloadAfter      int      `json:"deloadAfter"`
+	DeloadPercentage float64  `json:"deloadPercentage"`
+}
+
+// ImportResult counts what an import created.
+type ImportResult struct {
+	PlanID    uuid.UUID
+	Workouts  int
+	Exercises int
+	Sets      int
+}
+
+// normalizeName is the key exercise type names are matched on.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// CheckDocument validates the workouts of doc against the plan parsed from
+// it, reporting fields by their path in the document.
+func CheckDocument(doc *Document, tp TrainingPlan) error {
+	var fields []FieldError
+	if doc.Version != DocumentVersion {
+		fields = append(fields, FieldError{"version", fmt.Sprintf("unsupported version %d", doc.Version)})
+	}
+
+	start := time.Date(tp.StartDate.Year(), tp.StartDate.Month(), tp.StartDate.Day(), 0, 0, 0, 0, time.UTC)
+	end := time.Date(tp.EndDate.Year(), tp.EndDate.Month(), tp.EndDate.Day(), 0, 0, 0, 0, time.UTC)
+	for i, w := range doc.Plan.Workouts {
+		path := fmt.Sprintf("plan.workouts[%d]", i)
+		if strings.TrimSpace(w.Name) == "" {
+			fields = append(fields, FieldError{path + ".name", "must not be empty"})
+		}
+		for j, d := range w.ScheduledDates {
+			day, err := time.Parse(dateLayout, d)
+			switch {
+			case err != nil:
+				fields = append(fields, FieldError{fmt.Sprintf("%s.scheduledDates[%d]", path, j), "must be a date (YYYY-MM-DD)"})
+			case day.Before(start) || day.After(end):
+				fields = append(fields, FieldError{fmt.Sprintf("%s.scheduledDates[%d]", path, j), "must be within the plan dates"})
+			}
+		}
+		for j, e := range w.Exercises {
+			if strings.TrimSpace(e.Name) == "" {
+				fields = append(fields, FieldError{fmt.Sprintf("%s.exercises[%d].name", path, j), "must not be empty"})
+			}
+			if p := e.Progression; p != nil {
+				field := fmt.Sprintf("%s.exercises[%d].progression", path, j)
+				switch {
+				case p.Kind == "double_progression" && (p.MinReps == nil || p.MaxReps == nil):
+					fields = append(fields, FieldError{field, "minReps and maxReps are required for double progression"})
+				case p.MinReps != nil && p.MaxReps != nil && *p.MinReps > *p.MaxReps:
+					fields = append(fields, FieldError{field + ".minReps", "must not exceed maxReps"})
+				case p.Kind == "percentage_of_one_rep_max" && p.Percentage == nil:
+					fields = append(fields, FieldError{field + ".percentage", "is required for percentage_of_one_rep_max"})
+				}
+			}
+		}
+	}
+
+	if len(fields) > 0 {
+		return &ValidationError{Name: "invalid_document", Fields: fields}
+	}
+	return nil
+}
+
+// MatchExerciseTypes resolves the exercise type of every exercise of doc
+// against catalog, keyed by normalizeName. An exercise without a type name
+// is matched on its own name. It returns the names that matched nothing,
+// each once, and the fields referencing them.
+func MatchExerciseTypes(doc *Document, catalog map[string]uuid.UUID) ([]string, []FieldError) {
+	var (
+		unmatched []string
+		fields    []FieldError
+		seen      = map[string]bool{}
+	)
+	for i := range doc.Plan.Workouts {
+		for j := range doc.Plan.Workouts[i].Exercises {
+			e := &doc.Plan.Workouts[i].Exercises[j]
+			name, field := e.ExerciseType, "exerciseType"
+			if strings.TrimSpace(name) == "" {
+				name, field = e.Name, "name"
+			}
+
+			if id, ok := catalog[normalizeName(name)]; ok {
+				e.typeID = &id
+				continue
+			}
+			fields = append(fields, FieldError{
+				fmt.Sprintf("plan.workouts[%d].exercises[%d].%s", i, j, field),
+				fmt.Sprintf("no exercise type named %q", name),
+			})
+			if key := normalizeName(name); !seen[key] {
+				seen[key] = true
+				unmatched = append(unmatched, name)
+			}
+		}
+	}
+	return unmatched, fields
+}
+
+// ExerciseTypeCatalog returns the exercise types keyed by normalizeName.
+func (r *Repository) ExerciseTypeCatalog(ctx context.Context) (map[string]uuid.UUID, error) {
+	rows, err := r.DB.QueryContext(ctx, `
+	SELECT id, name FROM exercise_type WHERE deleted_at IS NULL ORDER BY created_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	catalog := map[string]uuid.UUID{}
+	for rows.Next() {
+		var (
+			id   uuid.UUID
+			name string
+		)
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+		if key := normalizeName(name); key != "" {
+			if _, dup := catalog[key]; !dup {
+				catalog[key] = id
+			}
+		}
+	}
+	return catalog, rows.Err()
+}
+
+// Export builds the document of the plan.
+func (r *Repository) Export(ctx context.Context, tp *TrainingPlan) (*Document, error) {
+	doc := &Document{
+		Version:    DocumentVersion,
+		ExportedAt: time.Now().UTC().Format(time.RFC3339),
+		Plan: DocumentPlan{
+			Name:        tp.Name,
+			Description: tp.Description,
+			StartDate:   tp.StartDate.Format(time.RFC3339),
+			EndDate:     tp.EndDate.Format(time.RFC3339),
+			Workouts:    []DocumentWorkout{},
+		},
+	}
+
+	rows, err := r.DB.QueryContext(ctx, `
+	SELECT id, name, position, scheduled_dates, recurrence
+	FROM workout
+	WHERE training_plan_id = $1 AND deleted_at IS NULL
+	ORDER BY position, created_at, id`, tp.ID)
+	if err != nil {
+		return nil, err
+	}
+	workouts := map[uuid.UUID]int{}
+	for rows.Next() {
+		var (
+			id uuid.UUID
+			w  = DocumentWorkout{Exercises: []DocumentExercise{}}
+		)
+		if err := rows.Scan(&id, &w.Name, &w.Position, pq.Array(&w.ScheduledDates), &w.Recurrence); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if w.ScheduledDates == nil {
+			w.ScheduledDates = []string{}
+		}
+		workouts[id] = len(doc.Plan.Workouts)
+		doc.Plan.Workouts = append(doc.Plan.Workouts, w)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = r.DB.QueryContext(ctx, `
+	SELECT e.id, e.workout_id, e.name, COALESCE(et.name, ''),
+		pr.kind, pr.increment, pr.min_reps, pr.max_reps, pr.percentage, pr.deload_after, pr.deload_percentage
+	FROM exercise e
+	JOIN workout w ON w.id = e.workout_id
+	LEFT JOIN exercise_type et ON et.id = e.exercise_type_id
+	LEFT JOIN progression_rule pr ON pr.exercise_id = e.id
+	WHERE w.training_plan_id = $1 AND w.deleted_at IS NULL AND e.deleted_at IS NULL
+	ORDER BY e.created_at, e.id`, tp.ID)
+	if err != nil {
+		return nil, err
+	}
+	type exerciseRef struct{ workout, exercise int }
+	exercises := map[uuid.UUID]exerciseRef{}
+	for rows.Next() {
+		var (
+			id, workoutID    uuid.UUID
+			e                = DocumentExercise{Sets: []DocumentSet{}}
+			kind             *string
+			increment        *float64
+			minReps, maxReps *int
+			percentage       *float64
+			deloadAfter      *int
+			deloadPercentage *float64
+		)
+		err := rows.Scan(&id, &workoutID, &e.Name, &e.ExerciseType,
+			&kind, &increment, &minReps, &maxReps, &percentage, &deloadAfter, &deloadPercentage)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if kind != nil {
+			e.Progression = &DocumentProgression{
+				Kind:             *kind,
+				Increment:        *increment,
+				MinReps:          minReps,
+				MaxReps:          maxReps,
+				Percentage:       percentage,
+				DeloadAfter:      *deloadAfter,
+				DeloadPercentage: *deloadPercentage,
+			}
+		}
+		wi := workouts[workoutID]
+		exercises[id] = exerciseRef{wi, len(doc.Plan.Workouts[wi].Exercises)}
+		doc.Plan.Workouts[wi].Exercises = append(doc.Plan.Workouts[wi].Exercises, e)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = r.DB.QueryContext(ctx, `
+	SELECT es.exercise_id, es.weight, es.reps, es.rest_time
+	FROM exercise_set es
+	JOIN exercise e ON e.id = es.exercise_id
+	JOIN workout w ON w.id = e.workout_id
+	WHERE w.training_plan_id = $1 AND w.deleted_at IS NULL AND e.deleted_at IS NULL AND es.deleted_at IS NULL
+	ORDER BY es.created_at, es.id`, tp.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			exerciseID uuid.UUID
+			s          DocumentSet
+		)
+		if err := rows.Scan(&exerciseID, &s.Weight, &s.Reps, &s.RestTime); err != nil {
+			return nil, err
+		}
+		ref, ok := exercises[exerciseID]
+		if !ok {
+			continue
+		}
+		ex := &doc.Plan.Workouts[ref.workout].Exercises[ref.exercise]
+		ex.Sets = append(ex.Sets, s)
+	}
+	return doc, rows.Err()
+}
+
+var loggedSetsHeader = []string{
+	"date", "session_id", "workout", "exercise", "exercise_type", "set",
+	"planned_weight", "planned_reps", "weight", "reps", "rest_time", "completed",
+}
+
+// WriteLoggedSetsCSV writes the sets performed in the sessions of the plan's
+// workouts as CSV, one row per set.
+func (r *Repository) WriteLoggedSetsCSV(ctx context.Context, w io.Writer, planID uuid.UUID) error {
+	rows, err := r.DB.QueryContext(ctx, `
+	SELECT ws.started_at, ws.id, wo.name, se.name, COALESCE(et.name, ''), ss.position,
+		ss.planned_weight, ss.planned_reps, ss.weight, ss.reps, ss.rest_time, ss.completed
+	FROM session_set ss
+	JOIN session_exercise se ON se.id = ss.session_exercise_id
+	JOIN workout_session ws ON ws.id = se.session_id
+	JOIN workout wo ON wo.id = ws.workout_id
+	LEFT JOIN exercise_type et ON et.id = se.exercise_type_id
+	WHERE wo.training_plan_id = $1 AND ws.deleted_at IS NULL AND ss.logged_at IS NOT NULL
+	ORDER BY ws.started_at, se.position, ss.position`, planID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(loggedSetsHeader); err != nil {
+		return err
+	}
+	for rows.Next() {
+		var (
+			startedAt                   time.Time
+			sessionID                   uuid.UUID
+			workout, exercise, typeName string
+			position                    int
+			plannedWeight, weight       *float64
+			plannedReps, reps, restTime *int
+			completed                   bool
+		)
+		err := rows.Scan(&startedAt, &sessionID, &workout, &exercise, &typeName, &position,
+			&plannedWeight, &plannedReps, &weight, &reps, &restTime, &completed)
+		if err != nil {
+			return err
+		}
+		err = cw.Write([]string{
+			startedAt.Format(time.RFC3339), sessionID.String(), workout, exercise, typeName, strconv.Itoa(position),
+			formatFloat(plannedWeight), formatInt(plannedReps), formatFloat(weight), formatInt(reps), formatInt(restTime),
+			strconv.FormatBool(completed),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Import inserts the plan and the workouts, exercises, sets and progression
+// rules of doc in one transaction. Exercises whose type was not resolved by
+// MatchExerciseTypes are skipped.
+func (r *Repository) Import(ctx context.Context, tp TrainingPlan, doc *Document, guards ...Guard) (*ImportResult, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := guard(ctx, tx, tp.UserID, guards); err != nil {
+		return nil, err
+	}
+
+	res := &ImportResult{PlanID: uuid.New()}
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO training_plan (id, name, description, start_date, end_date, user_id)
+	VALUES ($1, $2, $3, $4, $5, $6)`,
+		res.PlanID, tp.Name, tp.Description, tp.StartDate, tp.EndDate, tp.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, w := range doc.Plan.Workouts {
+		workoutID := uuid.New()
+		position := w.Position
+		if position == 0 {
+			position = i + 1
+		}
+		dates := w.ScheduledDates
+		if dates == nil {
+			dates = []string{}
+		}
+		_, err := tx.ExecContext(ctx, `
+		INSERT INTO workout (id, name, training_plan_id, position, scheduled_dates, recurrence)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+			workoutID, strings.TrimSpace(w.Name), res.PlanID, position, pq.Array(dates), w.Recurrence)
+		if err != nil {
+			return nil, err
+		}
+		res.Workouts++
+
+		for _, e := range w.Exercises {
+			if e.typeID == nil {
+				continue
+			}
+			exerciseID := uuid.New()
+			_, err := tx.ExecContext(ctx, `
+			INSERT INTO exercise (id, name, workout_id, exercise_type_id)
+			VALUES ($1, $2, $3, $4)`, exerciseID, strings.TrimSpace(e.Name), workoutID, *e.typeID)
+			if err != nil {
+				return nil, err
+			}
+			res.Exercises++
+
+			// Offset created_at so the sets keep their document order.
+			for k, s := range e.Sets {
+				_, err := tx.ExecContext(ctx, `
+				INSERT INTO exercise_set (id, exercise_id, weight, reps, rest_time, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, NOW() + $6::int * INTERVAL '1 microsecond', NOW())`,
+					uuid.New(), exerciseID, s.Weight, s.Reps, s.RestTime, k)
+				if err != nil {
+					return nil, err
+				}
+				res.Sets++
+			}
+
+			if p := e.Progression; p != nil {
+				_, err := tx.ExecContext(ctx, `
+				INSERT INTO progression_rule (id, exercise_id, kind, increment, min_reps, max_reps, percentage, deload_after, deload_percentage)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+					uuid.New(), exerciseID, p.Kind, p.Increment, p.MinReps, p.MaxReps, p.Percentage, p.DeloadAfter, p.DeloadPercentage)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	data := map[string]any{
+		"ID":        res.PlanID,
+		"Name":      tp.Name,
+		"StartDate": tp.StartDate,
+		"EndDate":   tp.EndDate,
+		"Imported":  true,
+	}
+	if err := events.Emit(ctx, tx, events.TrainingPlanCreated, "training_plan", res.PlanID, &tp.UserID, data); err != nil {
+		return nil, err
+	}
+	if err := audit.Record(ctx, tx, res.PlanID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func formatFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
+
+func formatInt(v *int) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.Itoa(*v)
+}
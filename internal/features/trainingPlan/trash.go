@@ -0,0 +1,169 @@
+package trainingplan
+
+import (
+	"be/internal/events"
+	"be/internal/features/audit"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ErrOwnerDeleted is returned when restoring a plan whose user is deleted
+// too: the user has to be restored instead.
+var ErrOwnerDeleted = errors.New("the owner of the plan is deleted")
+
+// CascadeDelete soft-deletes the workouts, exercises and sets of the plans.
+// They are stamped with the transaction's NOW(), the deletion time of the
+// plans, which is how CascadeRestore tells them from rows deleted earlier
+// on their own.
+func CascadeDelete(ctx context.Context, tx *sql.Tx, planIDs []uuid.UUID) error {
+	queries := []string{
+		`UPDATE workout SET deleted_at = NOW()
+		WHERE training_plan_id = ANY($1) AND deleted_at IS NULL`,
+		`UPDATE exercise SET deleted_at = NOW()
+		WHERE workout_id IN (SELECT id FROM workout WHERE training_plan_id = ANY($1))
+		  AND deleted_at IS NULL`,
+		`UPDATE exercise_set SET deleted_at = NOW()
+		WHERE exercise_id IN (
+			SELECT e.id FROM exercise e JOIN workout w ON w.id = e.workout_id
+			WHERE w.training_plan_id = ANY($1)
+		) AND deleted_at IS NULL`,
+	}
+	for _, q := range queries {
+		if _, err := tx.ExecContext(ctx, q, pq.Array(planIDs)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CascadeRestore restores the workouts, exercises and sets of the plans that
+// were deleted along with them at deletedAt.
+func CascadeRestore(ctx context.Context, tx *sql.Tx, planIDs []uuid.UUID, deletedAt time.Time) error {
+	queries := []string{
+		`UPDATE workout SET deleted_at = NULL
+		WHERE training_plan_id = ANY($1) AND deleted_at = $2`,
+		`UPDATE exercise SET deleted_at = NULL
+		WHERE workout_id IN (SELECT id FROM workout WHERE training_plan_id = ANY($1))
+		  AND deleted_at = $2`,
+		`UPDATE exercise_set SET deleted_at = NULL
+		WHERE exercise_id IN (
+			SELECT e.id FROM exercise e JOIN workout w ON w.id = e.workout_id
+			WHERE w.training_plan_id = ANY($1)
+		) AND deleted_at = $2`,
+	}
+	for _, q := range queries {
+		if _, err := tx.ExecContext(ctx, q, pq.Array(planIDs), deletedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindDeleted returns a plan in the trash, or nil when there is none with id.
+func (r *Repository) FindDeleted(ctx context.Context, id uuid.UUID) (*TrainingPlan, error) {
+	query := `
+	SELECT id, name, description, start_date, end_date, user_id, version, deleted_at
+	FROM training_plan
+	WHERE id = $1 AND deleted_at IS NOT NULL AND NOT is_template`
+
+	var tp TrainingPlan
+	err := r.DB.QueryRowContext(ctx, query, id).
+		Scan(&tp.ID, &tp.Name, &tp.Description, &tp.StartDate, &tp.EndDate, &tp.UserID, &tp.Version, &tp.DeletedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &tp, nil
+}
+
+// ListDeleted returns the plans in the trash, most recently deleted first.
+// When userIDs is not nil only the plans of those users are returned.
+func (r *Repository) ListDeleted(ctx context.Context, limit, offset int, userIDs []uuid.UUID) ([]TrainingPlan, error) {
+	query := `
+	SELECT id, name, description, start_date, end_date, user_id, version, deleted_at
+	FROM training_plan
+	WHERE deleted_at IS NOT NULL
+	  AND NOT is_template
+	  AND ($3::uuid[] IS NULL OR user_id = ANY($3))
+	ORDER BY deleted_at DESC, id
+	LIMIT $1 OFFSET $2`
+
+	rows, err := r.DB.QueryContext(ctx, query, limit, offset, pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var plans []TrainingPlan
+	for rows.Next() {
+		var tp TrainingPlan
+		if err := rows.Scan(&tp.ID, &tp.Name, &tp.Description, &tp.StartDate, &tp.EndDate, &tp.UserID, &tp.Version, &tp.DeletedAt); err != nil {
+			return nil, err
+		}
+		plans = append(plans, tp)
+	}
+	return plans, rows.Err()
+}
+
+// Restore takes a plan out of the trash together with the workouts,
+// exercises and sets deleted with it.
+func (r *Repository) Restore(ctx context.Context, id uuid.UUID, guards ...Guard) (*TrainingPlan, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var (
+		tp           TrainingPlan
+		ownerDeleted bool
+	)
+	err = tx.QueryRowContext(ctx, `
+	SELECT tp.id, tp.name, tp.description, tp.start_date, tp.end_date, tp.user_id, tp.version, tp.deleted_at,
+	       u.deleted_at IS NOT NULL
+	FROM training_plan tp
+	JOIN users u ON u.id = tp.user_id
+	WHERE tp.id = $1 AND tp.deleted_at IS NOT NULL AND NOT tp.is_template
+	FOR UPDATE OF tp`, id).
+		Scan(&tp.ID, &tp.Name, &tp.Description, &tp.StartDate, &tp.EndDate, &tp.UserID, &tp.Version, &tp.DeletedAt, &ownerDeleted)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPlanNotFound
+		}
+		return nil, err
+	}
+	if ownerDeleted {
+		return nil, ErrOwnerDeleted
+	}
+	if err := guard(ctx, tx, tp.UserID, guards); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE training_plan SET deleted_at = NULL, version = version + 1, updated_at = NOW() WHERE id = $1`, id); err != nil {
+		return nil, err
+	}
+	if err := CascadeRestore(ctx, tx, []uuid.UUID{id}, tp.DeletedAt.Time); err != nil {
+		return nil, err
+	}
+	tp.DeletedAt = sql.NullTime{}
+	tp.Version++
+
+	if err := events.Emit(ctx, tx, events.TrainingPlanRestored, "training_plan", id, &tp.UserID, tp); err != nil {
+		return nil, err
+	}
+	if err := audit.Record(ctx, tx, id); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &tp, nil
+}
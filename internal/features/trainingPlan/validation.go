@@ -0,0 +1,158 @@
+package trainingplan
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OverlapPolicy decides what happens when a plan overlaps another plan of
+// the same user.
+type OverlapPolicy string
+
+const (
+	OverlapReject OverlapPolicy = "reject"
+	OverlapWarn   OverlapPolicy = "warn"
+	OverlapAllow  OverlapPolicy = "allow"
+)
+
+// ParseOverlapPolicy reads a policy name, case insensitively.
+func ParseOverlapPolicy(s string) (OverlapPolicy, error) {
+	switch p := OverlapPolicy(strings.ToLower(s)); p {
+	case OverlapReject, OverlapWarn, OverlapAllow:
+		return p, nil
+	default:
+		return "", fmt.Errorf("invalid overlap policy %q (valid policies: reject|warn|allow)", s)
+	}
+}
+
+// FieldError is an invalid input field and why it was rejected.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// ValidationError lists the fields a plan was rejected for.
+type ValidationError struct {
+	Name   string
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Field + ": " + f.Message
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// PlanInput is the raw plan data of a create or update request.
+type PlanInput struct {
+	Name        string
+	Description *string
+	StartDate   string
+	EndDate     string
+	UserID      string
+}
+
+// Validator checks plans before they are saved: field formats, the date
+// range and, following its policy, overlaps with the user's other plans.
+type Validator struct {
+	repo   *Repository
+	policy OverlapPolicy
+}
+
+func NewValidator(repo *Repository, policy OverlapPolicy) *Validator {
+	return &Validator{repo: repo, policy: policy}
+}
+
+// Parse validates the fields of in and returns the plan they describe. All
+// invalid fields are reported at once.
+func Parse(in PlanInput) (TrainingPlan, error) {
+	var (
+		tp     = TrainingPlan{Name: strings.TrimSpace(in.Name), Description: in.Description}
+		fields []FieldError
+		err    error
+	)
+
+	if tp.Name == "" {
+		fields = append(fields, FieldError{"name", "must not be empty"})
+	}
+	startOK, endOK := true, true
+	if tp.StartDate, err = parseDate(in.StartDate); err != nil {
+		fields = append(fields, FieldError{"startDate", "must be an RFC 3339 date-time"})
+		startOK = false
+	}
+	if tp.EndDate, err = parseDate(in.EndDate); err != nil {
+		fields = append(fields, FieldError{"endDate", "must be an RFC 3339 date-time"})
+		endOK = false
+	}
+	if startOK && endOK && tp.EndDate.Before(tp.StartDate) {
+		fields = append(fields, FieldError{"endDate", "must not be before startDate"})
+	}
+	if tp.UserID, err = uuid.Parse(in.UserID); err != nil {
+		fields = append(fields, FieldError{"userId", "must be a UUID"})
+	}
+
+	if len(fields) > 0 {
+		return TrainingPlan{}, &ValidationError{Name: "invalid_fields", Fields: fields}
+	}
+	return tp, nil
+}
+
+// OverlapFinder returns the other plans of a user whose dates intersect
+// [start, end], excluding the plan excludeID.
+type OverlapFinder func(ctx context.Context, userID uuid.UUID, start, end time.Time, excludeID uuid.UUID) ([]TrainingPlan, error)
+
+// Guard checks a plan in the transaction writing it, once the plans of its
+// user are locked against concurrent writes.
+type Guard func(ctx context.Context, tx *sql.Tx) error
+
+// Guard returns the overlap check of tp to run in the transaction saving
+// it, so that two concurrent writes can't both pass it. The warnings are
+// stored in warnings.
+func (v *Validator) Guard(tp TrainingPlan, warnings *[]string) Guard {
+	return func(ctx context.Context, tx *sql.Tx) error {
+		find := func(ctx context.Context, userID uuid.UUID, start, end time.Time, excludeID uuid.UUID) ([]TrainingPlan, error) {
+			return v.repo.Overlapping(ctx, tx, userID, start, end, excludeID)
+		}
+		w, err := v.CheckOverlap(ctx, tp, find)
+		*warnings = w
+		return err
+	}
+}
+
+// CheckOverlap looks for other plans of tp's user overlapping its dates
+// with find. It fails under the reject policy and returns warnings under
+// the warn policy.
+func (v *Validator) CheckOverlap(ctx context.Context, tp TrainingPlan, find OverlapFinder) ([]string, error) {
+	if v.policy == OverlapAllow {
+		return nil, nil
+	}
+
+	overlapping, err := find(ctx, tp.UserID, tp.StartDate, tp.EndDate, tp.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(overlapping) == 0 {
+		return nil, nil
+	}
+
+	msgs := make([]string, len(overlapping))
+	for i, o := range overlapping {
+		msgs[i] = fmt.Sprintf("overlaps plan %q (%s to %s)",
+			o.Name, o.StartDate.Format(time.DateOnly), o.EndDate.Format(time.DateOnly))
+	}
+	if v.policy == OverlapReject {
+		fields := make([]FieldError, len(msgs))
+		for i, m := range msgs {
+			fields[i] = FieldError{"startDate", m}
+		}
+		return nil, &ValidationError{Name: "plan_overlap", Fields: fields}
+	}
+	return msgs, nil
+}
@@ -0,0 +1,170 @@
+package trainingplan
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestParse(t *testing.T) {
+	userID := uuid.New()
+	valid := PlanInput{
+		Name:      "Strength block",
+		StartDate: "2026-03-02T00:00:00Z",
+		EndDate:   "2026-04-26T00:00:00Z",
+		UserID:    userID.String(),
+	}
+
+	tests := []struct {
+		name   string
+		modify func(in *PlanInput)
+		fields []string // rejected fields, in order; none when valid
+	}{
+		{
+			name:   "valid",
+			modify: func(in *PlanInput) {},
+		},
+		{
+			name:   "same start and end",
+			modify: func(in *PlanInput) { in.EndDate = in.StartDate },
+		},
+		{
+			name:   "end before start",
+			modify: func(in *PlanInput) { in.EndDate = "2026-03-01T00:00:00Z" },
+			fields: []string{"endDate"},
+		},
+		{
+			name:   "malformed start date",
+			modify: func(in *PlanInput) { in.StartDate = "2026-03-02" },
+			fields: []string{"startDate"},
+		},
+		{
+			name:   "malformed end date",
+			modify: func(in *PlanInput) { in.EndDate = "26/04/2026" },
+			fields: []string{"endDate"},
+		},
+		{
+			name:   "malformed user UUID",
+			modify: func(in *PlanInput) { in.UserID = "not-a-uuid" },
+			fields: []string{"userId"},
+		},
+		{
+			name:   "empty name",
+			modify: func(in *PlanInput) { in.Name = "" },
+			fields: []string{"name"},
+		},
+		{
+			name:   "blank name",
+			modify: func(in *PlanInput) { in.Name = "   " },
+			fields: []string{"name"},
+		},
+		{
+			name: "every field invalid",
+			modify: func(in *PlanInput) {
+				*in = PlanInput{StartDate: "x", EndDate: "y", UserID: "z"}
+			},
+			fields: []string{"name", "startDate", "endDate", "userId"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := valid
+			tt.modify(&in)
+
+			tp, err := Parse(in)
+
+			if len(tt.fields) == 0 {
+				if err != nil {
+					t.Fatalf("Parse() error = %v, want none", err)
+				}
+				if tp.UserID != userID || tp.Name != "Strength block" {
+					t.Errorf("Parse() = %+v, want the plan of the input", tp)
+				}
+				return
+			}
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("Parse() error = %v, want a *ValidationError", err)
+			}
+			var fields []string
+			for _, f := range verr.Fields {
+				fields = append(fields, f.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("rejected fields = %v, want %v", fields, tt.fields)
+			}
+		})
+	}
+}
+
+func TestCheckOverlap(t *testing.T) {
+	day := func(s string) time.Time {
+		d, _ := time.Parse(time.DateOnly, s)
+		return d
+	}
+	tp := TrainingPlan{ID: uuid.New(), UserID: uuid.New(), StartDate: day("2026-03-01"), EndDate: day("2026-03-31")}
+	other := TrainingPlan{ID: uuid.New(), Name: "Base", StartDate: day("2026-02-15"), EndDate: day("2026-03-10")}
+
+	tests := []struct {
+		name        string
+		policy      OverlapPolicy
+		overlapping []TrainingPlan
+		wantQuery   bool
+		wantWarns   int
+		wantErr     bool
+	}{
+		{name: "allow skips the lookup", policy: OverlapAllow, overlapping: []TrainingPlan{other}},
+		{name: "warn without overlap", policy: OverlapWarn, wantQuery: true},
+		{name: "warn with overlap", policy: OverlapWarn, overlapping: []TrainingPlan{other}, wantQuery: true, wantWarns: 1},
+		{name: "reject without overlap", policy: OverlapReject, wantQuery: true},
+		{name: "reject with overlap", policy: OverlapReject, overlapping: []TrainingPlan{other, other}, wantQuery: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queried := false
+			find := func(_ context.Context, userID uuid.UUID, start, end time.Time, excludeID uuid.UUID) ([]TrainingPlan, error) {
+				queried = true
+				if userID != tp.UserID || !start.Equal(tp.StartDate) || !end.Equal(tp.EndDate) || excludeID != tp.ID {
+					t.Errorf("find(%s, %s, %s, %s), want the user, dates and ID of the plan", userID, start, end, excludeID)
+				}
+				return tt.overlapping, nil
+			}
+
+			warnings, err := NewValidator(nil, tt.policy).CheckOverlap(context.Background(), tp, find)
+
+			if queried != tt.wantQuery {
+				t.Errorf("queried = %t, want %t", queried, tt.wantQuery)
+			}
+			if len(warnings) != tt.wantWarns {
+				t.Errorf("warnings = %q, want %d", warnings, tt.wantWarns)
+			}
+			var verr *ValidationError
+			if tt.wantErr {
+				if !errors.As(err, &verr) || verr.Name != "plan_overlap" || len(verr.Fields) != len(tt.overlapping) {
+					t.Errorf("error = %v, want a plan_overlap error per overlapping plan", err)
+				}
+			} else if err != nil {
+				t.Errorf("error = %v, want none", err)
+			}
+		})
+	}
+}
+
+func TestCheckOverlapLookupError(t *testing.T) {
+	lookupErr := errors.New("connection reset")
+	find := func(context.Context, uuid.UUID, time.Time, time.Time, uuid.UUID) ([]TrainingPlan, error) {
+		return nil, lookupErr
+	}
+
+	_, err := NewValidator(nil, OverlapReject).CheckOverlap(context.Background(), TrainingPlan{}, find)
+
+	if !errors.Is(err, lookupErr) {
+		t.Errorf("error = %v, want %v", err, lookupErr)
+	}
+}
@@ -2,7 +2,10 @@ package user
 
 import (
 	"be/internal/database/db"
+	"be/internal/events"
+	"be/internal/features/audit"
 	trainingplan "be/internal/features/trainingPlan"
+	"be/internal/middleware"
 	"be/internal/utils"
 	"context"
 	"database/sql"
@@ -11,6 +14,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type User struct {
@@ -20,8 +24,11 @@ type User struct {
 	LastName  string
 	Nickname  string
 	Admin     bool
+	Timezone  string // IANA time zone name
+	Version   int    // incremented on every change, for optimistic concurrency
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt sql.NullTime
 }
 
 type UserWithPlans struct {
@@ -31,11 +38,17 @@ type UserWithPlans struct {
 	LastName      string
 	Nickname      string
 	Admin         bool
+	Timezone      string
+	Version       int
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 	TrainingPlans []trainingplan.TrainingPlan
 }
 
+// ErrVersionMismatch is returned when changing a user modified since they
+// were read.
+var ErrVersionMismatch = errors.New("user was modified concurrently")
+
 type Repository struct {
 	DB *sql.DB
 }
@@ -73,14 +86,14 @@ func (r *Repository) FindByID(ctx context.Context, userID string) (*UserWithPlan
 	// `
 
 	userQuery := `
-		SELECT id, kc_id, first_name, last_name, nickname, admin, created_at, updated_at
+		SELECT id, kc_id, first_name, last_name, nickname, admin, timezone, version, created_at, updated_at
 		FROM users
 		WHERE id = $1 AND deleted_at IS NULL
 	`
 
 	var user UserWithPlans
 	err := r.DB.QueryRowContext(ctx, userQuery, userID).
-		Scan(&user.ID, &user.KcID, &user.FirstName, &user.LastName, &user.Nickname, &user.Admin, &user.CreatedAt, &user.UpdatedAt)
+		Scan(&user.ID, &user.KcID, &user.FirstName, &user.LastName, &user.Nickname, &user.Admin, &user.Timezone, &user.Version, &user.CreatedAt, &user.UpdatedAt)
 
 	if err != nil {
 		utils.Log.Error(ctx, userID, err)
@@ -91,7 +104,7 @@ func (r *Repository) FindByID(ctx context.Context, userID string) (*UserWithPlan
 	}
 
 	trainingPlanQuery := `
-		SELECT id, name, description, start_date, end_date, created_at, updated_at, user_id
+		SELECT id, name, description, start_date, end_date, created_at, updated_at, user_id, version
 		FROM training_plan
 		WHERE user_id = $1 AND deleted_at IS NULL
 		ORDER BY start_date ASC
@@ -106,7 +119,7 @@ func (r *Repository) FindByID(ctx context.Context, userID string) (*UserWithPlan
 
 	for rows.Next() {
 		var tp trainingplan.TrainingPlan
-		err := rows.Scan(&tp.ID, &tp.Name, &tp.Description, &tp.StartDate, &tp.EndDate, &tp.CreatedAt, &tp.UpdatedAt, &tp.UserID)
+		err := rows.Scan(&tp.ID, &tp.Name, &tp.Description, &tp.StartDate, &tp.EndDate, &tp.CreatedAt, &tp.UpdatedAt, &tp.UserID, &tp.Version)
 		if err != nil {
 			return nil, fmt.Errorf("errore nel parsing dei training plan: %w", err)
 		}
@@ -116,12 +129,45 @@ func (r *Repository) FindByID(ctx context.Context, userID string) (*UserWithPlan
 	return &user, nil
 }
 
-func (r *Repository) List(ctx context.Context, limit, offset int) ([]User, error) {
-	query := `SELECT id, kc_id, first_name, last_name, nickname, admin, created_at, updated_at 
+func (r *Repository) FindByKcID(ctx context.Context, kcID string) (*User, error) {
+	query := `
+		SELECT id, kc_id, first_name, last_name, nickname, admin, timezone, version, created_at, updated_at
+		FROM users
+		WHERE kc_id = $1 AND deleted_at IS NULL
+	`
+
+	var user User
+	err := r.DB.QueryRowContext(ctx, query, kcID).
+		Scan(&user.ID, &user.KcID, &user.FirstName, &user.LastName, &user.Nickname, &user.Admin, &user.Timezone, &user.Version, &user.CreatedAt, &user.UpdatedAt)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("user not found")
+		}
+		utils.Log.Error(ctx, kcID, err)
+		return nil, errors.New("errore di comunicazione [DB-FK]")
+	}
+
+	return &user, nil
+}
+
+// FindCurrent returns the user matching the JWT subject stored in the context.
+func (r *Repository) FindCurrent(ctx context.Context) (*User, error) {
+	sub, ok := middleware.SubjectFromContext(ctx)
+	if !ok {
+		return nil, errors.New("missing subject")
+	}
+	return r.FindByKcID(ctx, sub)
+}
+
+// List returns the users, restricted to ids when it is not nil.
+func (r *Repository) List(ctx context.Context, limit, offset int, ids []uuid.UUID) ([]User, error) {
+	query := `SELECT id, kc_id, first_name, last_name, nickname, admin, timezone, version, created_at, updated_at 
 		  FROM users WHERE deleted_at IS NULL
+		  AND ($3::uuid[] IS NULL OR id = ANY($3))
 		  ORDER BY created_at DESC LIMIT $1 OFFSET $2`
 
-	rows, err := r.DB.QueryContext(ctx, query, limit, offset)
+	rows, err := r.DB.QueryContext(ctx, query, limit, offset, pq.Array(ids))
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +176,7 @@ func (r *Repository) List(ctx context.Context, limit, offset int) ([]User, error
 	var users []User
 	for rows.Next() {
 		var user User
-		err := rows.Scan(&user.ID, &user.KcID, &user.FirstName, &user.LastName, &user.Nickname, &user.Admin, &user.CreatedAt, &user.UpdatedAt)
+		err := rows.Scan(&user.ID, &user.KcID, &user.FirstName, &user.LastName, &user.Nickname, &user.Admin, &user.Timezone, &user.Version, &user.CreatedAt, &user.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -142,40 +188,99 @@ func (r *Repository) List(ctx context.Context, limit, offset int) ([]User, error
 
 func (r *Repository) SaveUser(ctx context.Context, user UserWithPlans) (*UserWithPlans, error) {
 	query := `
-		INSERT INTO users (id, kc_id, first_name, last_name, nickname, admin, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO users (id, kc_id, first_name, last_name, nickname, admin, timezone, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		ON CONFLICT (id) DO UPDATE SET
 			kc_id = EXCLUDED.kc_id,
 			first_name = EXCLUDED.first_name,
 			last_name = EXCLUDED.last_name,
 			nickname = EXCLUDED.nickname,
 			admin = EXCLUDED.admin,
-			updated_at = EXCLUDED.updated_at
+			timezone = EXCLUDED.timezone,
+			updated_at = EXCLUDED.updated_at,
+			version = users.version + 1
+		WHERE users.version = $10
+		RETURNING (xmax = 0), version
 	`
 
 	if user.ID == uuid.Nil {
 		user.ID = uuid.New()
 	}
+	if user.Timezone == "" {
+		user.Timezone = "UTC"
+	}
 	now := time.Now()
 	user.UpdatedAt = now
 	if user.CreatedAt.IsZero() {
 		user.CreatedAt = now
 	}
 
-	_, err := r.DB.ExecContext(ctx, query,
-		user.ID, nil, user.FirstName, user.LastName, user.Nickname, user.Admin, user.CreatedAt, user.UpdatedAt)
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		utils.Log.Error(ctx, user, err)
+		return nil, errors.New("errore di comunicazione [DB-TX]")
+	}
+	defer tx.Rollback()
+
+	var inserted bool
+	err = tx.QueryRowContext(ctx, query,
+		user.ID, uuid.NullUUID{UUID: user.KcID, Valid: user.KcID != uuid.Nil}, user.FirstName, user.LastName, user.Nickname, user.Admin, user.Timezone, user.CreatedAt, user.UpdatedAt, user.Version).
+		Scan(&inserted, &user.Version)
 
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrVersionMismatch
+		}
 		utils.Log.Error(ctx, user, err)
 		return nil, errors.New("errore di comunicazione [DB-UP]")
 	}
 
+	eventType := events.UserUpdated
+	if inserted {
+		eventType = events.UserCreated
+	}
+	payload := User{
+		ID:        user.ID,
+		KcID:      user.KcID,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Nickname:  user.Nickname,
+		Admin:     user.Admin,
+		Timezone:  user.Timezone,
+		Version:   user.Version,
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}
+	if err := events.Emit(ctx, tx, eventType, "user", user.ID, &user.ID, payload); err != nil {
+		utils.Log.Error(ctx, user, err)
+		return nil, errors.New("errore di comunicazione [DB-EV]")
+	}
+	if err := audit.Record(ctx, tx, user.ID); err != nil {
+		utils.Log.Error(ctx, user, err)
+		return nil, errors.New("errore di comunicazione [DB-AU]")
+	}
+
+	if err := tx.Commit(); err != nil {
+		utils.Log.Error(ctx, user, err)
+		return nil, errors.New("errore di comunicazione [DB-TX]")
+	}
+
 	return &user, nil
 }
 
-func (r *Repository) DeleteUser(ctx context.Context, userID string) error {
-	query := `UPDATE users SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
-	res, err := r.DB.ExecContext(ctx, query, userID)
+// DeleteUser moves the user to the trash, provided they are still at
+// version, along with their plans.
+func (r *Repository) DeleteUser(ctx context.Context, userID string, version int) error {
+	query := `UPDATE users SET deleted_at = NOW(), version = version + 1 WHERE id = $1 AND deleted_at IS NULL AND version = $2`
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		utils.Log.Error(ctx, query, err)
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, query, userID, version)
 	if err != nil {
 		utils.Log.Error(ctx, query, err)
 		return err
@@ -183,9 +288,34 @@ func (r *Repository) DeleteUser(ctx context.Context, userID string) error {
 
 	count, err := res.RowsAffected()
 	if err == nil && count == 0 {
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM users WHERE id = $1 AND deleted_at IS NULL)`, userID).Scan(&exists); err != nil {
+			utils.Log.Error(ctx, query, err)
+			return err
+		}
+		if exists {
+			return ErrVersionMismatch
+		}
 		utils.Log.Error(ctx, res, err)
 		return errors.New("user not found or already deleted")
 	}
 
-	return nil
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return err
+	}
+	if err := deletePlans(ctx, tx, id); err != nil {
+		utils.Log.Error(ctx, query, err)
+		return err
+	}
+	if err := events.Emit(ctx, tx, events.UserDeleted, "user", id, &id, map[string]any{"ID": id}); err != nil {
+		utils.Log.Error(ctx, query, err)
+		return err
+	}
+	if err := audit.Record(ctx, tx, id); err != nil {
+		utils.Log.Error(ctx, query, err)
+		return err
+	}
+
+	return tx.Commit()
 }
@@ -3,14 +3,21 @@ package user
 import (
 	"context"
 	"errors"
+	"io"
+	"time"
 
 	userService "be/gen/user"
+	"be/internal/features/coaching"
 	common "be/internal/features/common"
+	"be/internal/helpers/etag"
+	"be/internal/helpers/mergepatch"
 	"be/internal/middleware"
 	"be/internal/utils"
 
 	"github.com/Nerzal/gocloak/v13"
+	"github.com/google/uuid"
 	"goa.design/clue/log"
+	goa "goa.design/goa/v3/pkg"
 	"gorm.io/gorm"
 
 	"goa.design/goa/v3/security"
@@ -19,12 +26,14 @@ import (
 type Service struct {
 	Repository *Repository
 	kc         *common.KcClient
+	access     *coaching.Authorizer
 }
 
-func NewService() *Service {
+func NewService(kc *common.KcClient) *Service {
 	return &Service{
 		Repository: NewRepository(),
-		kc:         common.NewKcClient(),
+		kc:         kc,
+		access:     coaching.NewAuthorizer(),
 	}
 }
 
@@ -111,6 +120,8 @@ func (s *Service) Create(ctx context.Context, payload *userService.CreatePayload
 		LastName:  savedModel.LastName,
 		Nickname:  &savedModel.Nickname,
 		Admin:     savedModel.Admin,
+		Timezone:  &savedModel.Timezone,
+		Etag:      stringPtr(etag.Format(savedModel.Version)),
 	}, nil
 }
 
@@ -134,6 +145,7 @@ func (s *Service) Get(ctx context.Context, payload *userService.GetPayload) (*us
 			StartDate:   plan.StartDate.Format("2006-01-02T15:04:05Z"),
 			EndDate:     plan.EndDate.Format("2006-01-02T15:04:05Z"),
 			UserID:      plan.UserID.String(),
+			Etag:        stringPtr(etag.Format(plan.Version)),
 		})
 	}
 
@@ -144,31 +156,38 @@ func (s *Service) Get(ctx context.Context, payload *userService.GetPayload) (*us
 		LastName:      user.LastName,
 		Nickname:      &user.Nickname,
 		Admin:         user.Admin,
+		Timezone:      &user.Timezone,
+		Etag:          stringPtr(etag.Format(user.Version)),
 		TrainingPlans: trainingPlans,
 	}, nil
 }
 
 func (s *Service) List(ctx context.Context, payload *userService.ListPayload) ([]*userService.User, error) {
-	if !s.kc.UserAccess.List {
+	// Admins see every user, the others only the athletes they coach,
+	// which may be none.
+	caller, err := s.Repository.FindCurrent(ctx)
+	if err != nil {
 		return nil, &userService.Forbidden{Message: "Forbidden"}
 	}
+	var ids []uuid.UUID
+	if !caller.Admin {
+		athletes, err := s.access.Athletes(ctx, caller.ID)
+		if err != nil {
+			utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+			return nil, &userService.InternalServerError{Message: "Internal Server error"}
+		}
+		ids = append([]uuid.UUID{}, athletes...)
+	}
 
-	users, err := s.Repository.List(ctx, payload.Limit, payload.Offset)
+	users, err := s.Repository.List(ctx, payload.Limit, payload.Offset, ids)
 	if err != nil {
 		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
 		return nil, err
 	}
 
 	var response []*userService.User
-	for _, user := range users {
-		response = append(response, &userService.User{
-			ID:        user.ID.String(),
-			KcID:      user.KcID.String(),
-			FirstName: user.FirstName,
-			LastName:  user.LastName,
-			Nickname:  &user.Nickname,
-			Admin:     user.Admin,
-		})
+	for i := range users {
+		response = append(response, toUserResult(&users[i]))
 	}
 	return response, nil
 }
@@ -183,16 +202,32 @@ func (s *Service) Update(ctx context.Context, payload *userService.UpdatePayload
 		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
 		return nil, err
 	}
+	if !etag.Match(payload.IfMatch, user.Version) {
+		return nil, &userService.PreconditionFailed{Message: "Utente modificato da un'altra richiesta"}
+	}
 
 	user.FirstName = payload.FirstName
 	user.LastName = payload.LastName
 	if payload.Nickname != nil {
 		user.Nickname = *payload.Nickname
 	}
+	wasAdmin := user.Admin
 	user.Admin = payload.Admin
+	if err := s.authorizeEdit(ctx, user.ID, wasAdmin, user.Admin); err != nil {
+		return nil, err
+	}
+	if payload.Timezone != nil {
+		if _, err := time.LoadLocation(*payload.Timezone); err != nil {
+			return nil, &userService.BadRequest{Name: "invalid_timezone", ID: goa.NewErrorID(), Message: "unknown time zone"}
+		}
+		user.Timezone = *payload.Timezone
+	}
 
-	_, err = s.Repository.SaveUser(ctx, *user)
+	saved, err := s.Repository.SaveUser(ctx, *user)
 	if err != nil {
+		if errors.Is(err, ErrVersionMismatch) {
+			return nil, &userService.PreconditionFailed{Message: "Utente modificato da un'altra richiesta"}
+		}
 		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
 		return nil, err
 	}
@@ -205,15 +240,95 @@ func (s *Service) Update(ctx context.Context, payload *userService.UpdatePayload
 	// }
 
 	return &userService.User{
-		ID:        user.KcID.String(),
-		KcID:      user.KcID.String(),
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		Nickname:  &user.Nickname,
-		Admin:     user.Admin,
+		ID:        saved.ID.String(),
+		KcID:      saved.KcID.String(),
+		FirstName: saved.FirstName,
+		LastName:  saved.LastName,
+		Nickname:  &saved.Nickname,
+		Admin:     saved.Admin,
+		Timezone:  &saved.Timezone,
+		Etag:      stringPtr(etag.Format(saved.Version)),
 	}, nil
 }
 
+func (s *Service) Patch(ctx context.Context, payload *userService.PatchPayload, body io.ReadCloser) (*userService.User, error) {
+	defer body.Close()
+
+	user, err := s.Repository.FindByID(ctx, payload.ID)
+	if err != nil {
+		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+		return nil, &userService.NotFound{Message: "Utente non trovato"}
+	}
+	if !etag.Match(payload.IfMatch, user.Version) {
+		return nil, &userService.PreconditionFailed{Message: "Utente modificato da un'altra richiesta"}
+	}
+
+	patch, err := mergepatch.Decode(body, "firstName", "lastName", "nickname", "admin", "timezone")
+	if err != nil {
+		return nil, &userService.BadRequest{Name: "invalid_patch", ID: goa.NewErrorID(), Message: err.Error()}
+	}
+	nickname, timezone, wasAdmin := stringPtr(user.Nickname), stringPtr(user.Timezone), user.Admin
+	patch.String("firstName", &user.FirstName)
+	patch.String("lastName", &user.LastName)
+	patch.NullableString("nickname", &nickname)
+	patch.Bool("admin", &user.Admin)
+	patch.NullableString("timezone", &timezone)
+	if err := s.authorizeEdit(ctx, user.ID, wasAdmin, user.Admin); err != nil {
+		return nil, err
+	}
+
+	// Cleared optional fields fall back to their defaults.
+	user.Nickname, user.Timezone = "", "UTC"
+	if nickname != nil {
+		user.Nickname = *nickname
+	}
+	if timezone != nil {
+		user.Timezone = *timezone
+	}
+
+	var fields []*userService.FieldError
+	if perr, ok := patch.Err().(*mergepatch.Error); ok {
+		for _, f := range perr.Fields {
+			fields = append(fields, &userService.FieldError{Field: f.Field, Message: f.Message})
+		}
+	}
+	if patch.Has("firstName") && user.FirstName == "" {
+		fields = append(fields, &userService.FieldError{Field: "firstName", Message: "must not be empty"})
+	}
+	if patch.Has("lastName") && user.LastName == "" {
+		fields = append(fields, &userService.FieldError{Field: "lastName", Message: "must not be empty"})
+	}
+	if len([]rune(user.Nickname)) > 16 {
+		fields = append(fields, &userService.FieldError{Field: "nickname", Message: "must be at most 16 characters"})
+	}
+	if _, err := time.LoadLocation(user.Timezone); err != nil {
+		fields = append(fields, &userService.FieldError{Field: "timezone", Message: "unknown time zone"})
+	}
+	if len(fields) > 0 {
+		return nil, &userService.BadRequest{Name: "invalid_fields", ID: goa.NewErrorID(), Message: "invalid fields", Fields: fields}
+	}
+
+	saved, err := s.Repository.SaveUser(ctx, *user)
+	if err != nil {
+		if errors.Is(err, ErrVersionMismatch) {
+			return nil, &userService.PreconditionFailed{Message: "Utente modificato da un'altra richiesta"}
+		}
+		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+		return nil, &userService.InternalServerError{Message: "Internal Server error"}
+	}
+
+	return toUserResult(&User{
+		ID:        saved.ID,
+		KcID:      saved.KcID,
+		FirstName: saved.FirstName,
+		LastName:  saved.LastName,
+		Nickname:  saved.Nickname,
+		Admin:     saved.Admin,
+		Timezone:  saved.Timezone,
+		Version:   saved.Version,
+	}), nil
+}
+
 func (s *Service) Delete(ctx context.Context, payload *userService.DeletePayload) error {
 	user, err := s.Repository.FindByID(ctx, payload.ID)
 	if err != nil {
@@ -232,11 +347,104 @@ func (s *Service) Delete(ctx context.Context, payload *userService.DeletePayload
 	// 	return err
 	// }
 
-	err = s.Repository.DeleteUser(ctx, user.ID.String())
+	if !etag.Match(payload.IfMatch, user.Version) {
+		return &userService.PreconditionFailed{Message: "Utente modificato da un'altra richiesta"}
+	}
+
+	err = s.Repository.DeleteUser(ctx, user.ID.String(), user.Version)
 	if err != nil {
+		if errors.Is(err, ErrVersionMismatch) {
+			return &userService.PreconditionFailed{Message: "Utente modificato da un'altra richiesta"}
+		}
 		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
 		return err
 	}
 
 	return nil
 }
+
+// authorizeEdit checks the caller may edit the user userID: users edit
+// themselves and admins anyone. Only admins may change the admin flag.
+func (s *Service) authorizeEdit(ctx context.Context, userID uuid.UUID, wasAdmin, admin bool) error {
+	caller, err := s.Repository.FindCurrent(ctx)
+	if err != nil {
+		return &userService.Forbidden{Message: "Forbidden"}
+	}
+	if caller.Admin {
+		return nil
+	}
+	if caller.ID != userID || wasAdmin != admin {
+		return &userService.Forbidden{Message: "Forbidden"}
+	}
+	return nil
+}
+
+// admin checks the caller is an admin, as required to manage the trash.
+func (s *Service) admin(ctx context.Context) error {
+	u, err := s.Repository.FindCurrent(ctx)
+	if err != nil || !u.Admin {
+		return &userService.Forbidden{Message: "Forbidden"}
+	}
+	return nil
+}
+
+func (s *Service) Restore(ctx context.Context, payload *userService.RestorePayload) (*userService.User, error) {
+	if err := s.admin(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return nil, &userService.BadRequest{Name: "invalid_id", ID: goa.NewErrorID(), Message: "invalid user ID"}
+	}
+
+	user, err := s.Repository.RestoreUser(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrDeletedUserNotFound) {
+			return nil, &userService.NotFound{Message: "Utente non trovato"}
+		}
+		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+		return nil, &userService.InternalServerError{Message: "Internal Server error"}
+	}
+	return toUserResult(user), nil
+}
+
+func (s *Service) ListDeleted(ctx context.Context, payload *userService.ListDeletedPayload) ([]*userService.User, error) {
+	if err := s.admin(ctx); err != nil {
+		return nil, err
+	}
+
+	users, err := s.Repository.ListDeleted(ctx, payload.Limit, payload.Offset)
+	if err != nil {
+		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+		return nil, &userService.InternalServerError{Message: "Internal Server error"}
+	}
+
+	response := []*userService.User{}
+	for i := range users {
+		response = append(response, toUserResult(&users[i]))
+	}
+	return response, nil
+}
+
+func toUserResult(user *User) *userService.User {
+	res := &userService.User{
+		ID:        user.ID.String(),
+		KcID:      user.KcID.String(),
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Nickname:  &user.Nickname,
+		Admin:     user.Admin,
+		Timezone:  &user.Timezone,
+		Etag:      stringPtr(etag.Format(user.Version)),
+	}
+	if user.DeletedAt.Valid {
+		deletedAt := user.DeletedAt.Time.Format(time.RFC3339)
+		res.DeletedAt = &deletedAt
+	}
+	return res
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,149 @@
+package user
+
+import (
+	"be/internal/events"
+	"be/internal/features/audit"
+	trainingplan "be/internal/features/trainingPlan"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrDeletedUserNotFound = errors.New("deleted user not found")
+
+// deletePlans soft-deletes the plans and templates of a user being deleted,
+// down to their sets, stamping them with the user's deletion time.
+func deletePlans(ctx context.Context, tx *sql.Tx, userID uuid.UUID) error {
+	rows, err := tx.QueryContext(ctx, `
+	UPDATE training_plan SET deleted_at = NOW(), version = version + 1
+	WHERE (user_id = $1 OR owner_id = $1) AND deleted_at IS NULL
+	RETURNING id`, userID)
+	if err != nil {
+		return err
+	}
+	planIDs, err := scanIDs(rows)
+	if err != nil {
+		return err
+	}
+	if err := trainingplan.CascadeDelete(ctx, tx, planIDs); err != nil {
+		return err
+	}
+
+	for _, id := range planIDs {
+		if err := events.Emit(ctx, tx, events.TrainingPlanDeleted, "training_plan", id, &userID, map[string]any{"ID": id}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restorePlans restores the plans and templates deleted with the user at
+// deletedAt, down to their sets.
+func restorePlans(ctx context.Context, tx *sql.Tx, userID uuid.UUID, deletedAt time.Time) error {
+	rows, err := tx.QueryContext(ctx, `
+	UPDATE training_plan SET deleted_at = NULL, version = version + 1
+	WHERE (user_id = $1 OR owner_id = $1) AND deleted_at = $2
+	RETURNING id`, userID, deletedAt)
+	if err != nil {
+		return err
+	}
+	planIDs, err := scanIDs(rows)
+	if err != nil {
+		return err
+	}
+	if err := trainingplan.CascadeRestore(ctx, tx, planIDs, deletedAt); err != nil {
+		return err
+	}
+
+	for _, id := range planIDs {
+		if err := events.Emit(ctx, tx, events.TrainingPlanRestored, "training_plan", id, &userID, map[string]any{"ID": id}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func scanIDs(rows *sql.Rows) ([]uuid.UUID, error) {
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// ListDeleted returns the deleted users, most recently deleted first.
+func (r *Repository) ListDeleted(ctx context.Context, limit, offset int) ([]User, error) {
+	query := `SELECT id, kc_id, first_name, last_name, nickname, admin, timezone, version, created_at, updated_at, deleted_at
+		  FROM users WHERE deleted_at IS NOT NULL
+		  ORDER BY deleted_at DESC, id LIMIT $1 OFFSET $2`
+
+	rows, err := r.DB.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []User
+	for rows.Next() {
+		var u User
+		err := rows.Scan(&u.ID, &u.KcID, &u.FirstName, &u.LastName, &u.Nickname, &u.Admin, &u.Timezone, &u.Version, &u.CreatedAt, &u.UpdatedAt, &u.DeletedAt)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, rows.Err()
+}
+
+// RestoreUser takes a user out of the trash together with the plans,
+// workouts, exercises and sets deleted with them.
+func (r *Repository) RestoreUser(ctx context.Context, userID uuid.UUID) (*User, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var u User
+	err = tx.QueryRowContext(ctx, `
+	SELECT id, kc_id, first_name, last_name, nickname, admin, timezone, version, created_at, updated_at, deleted_at
+	FROM users WHERE id = $1 AND deleted_at IS NOT NULL
+	FOR UPDATE`, userID).
+		Scan(&u.ID, &u.KcID, &u.FirstName, &u.LastName, &u.Nickname, &u.Admin, &u.Timezone, &u.Version, &u.CreatedAt, &u.UpdatedAt, &u.DeletedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrDeletedUserNotFound
+		}
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE users SET deleted_at = NULL, version = version + 1, updated_at = NOW() WHERE id = $1`, userID); err != nil {
+		return nil, err
+	}
+	if err := restorePlans(ctx, tx, userID, u.DeletedAt.Time); err != nil {
+		return nil, err
+	}
+	u.DeletedAt = sql.NullTime{}
+	u.Version++
+
+	if err := events.Emit(ctx, tx, events.UserRestored, "user", userID, &userID, u); err != nil {
+		return nil, err
+	}
+	if err := audit.Record(ctx, tx, userID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
@@ -0,0 +1,242 @@
+package webhook
+
+import (
+	"be/internal/database/db"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusDead      = "dead"
+)
+
+type Subscription struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	URL        string
+	EventTypes []string
+	Secret     string
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeletedAt  sql.NullTime
+}
+
+type Delivery struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	EventID        uuid.UUID
+	EventType      string
+	Payload        json.RawMessage
+	Status         string
+	Attempts       int
+	LastStatusCode *int
+	LastError      *string
+	NextAttemptAt  time.Time
+	DeliveredAt    sql.NullTime
+	CreatedAt      time.Time
+}
+
+type Repository struct {
+	DB *sql.DB
+}
+
+func NewRepository() *Repository {
+	return &Repository{DB: db.DB.LD}
+}
+
+func (r *Repository) FindSubscription(ctx context.Context, id uuid.UUID) (*Subscription, error) {
+	query := `
+	SELECT id, user_id, url, event_types, secret, active, created_at, updated_at
+	FROM webhook_subscription
+	WHERE id = $1 AND deleted_at IS NULL`
+
+	var s Subscription
+	err := r.DB.QueryRowContext(ctx, query, id).
+		Scan(&s.ID, &s.UserID, &s.URL, pq.Array(&s.EventTypes), &s.Secret, &s.Active, &s.CreatedAt, &s.UpdatedAt)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("webhook subscription not found")
+		}
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (r *Repository) ListSubscriptions(ctx context.Context, userID uuid.UUID) ([]Subscription, error) {
+	query := `
+	SELECT id, user_id, url, event_types, secret, active, created_at, updated_at
+	FROM webhook_subscription
+	WHERE user_id = $1 AND deleted_at IS NULL
+	ORDER BY created_at DESC`
+
+	return r.querySubscriptions(ctx, query, userID)
+}
+
+// FindActiveByEvent returns the enabled subscriptions listening to
+// eventType of userID and of the coaches of userID, so that coaches hear
+// about the workouts their athletes log.
+func (r *Repository) FindActiveByEvent(ctx context.Context, userID uuid.UUID, eventType string) ([]Subscription, error) {
+	query := `
+	SELECT id, user_id, url, event_types, secret, active, created_at, updated_at
+	FROM webhook_subscription
+	WHERE (user_id = $1 OR user_id IN (
+	        SELECT coach_id FROM coach_athlete WHERE athlete_id = $1 AND status = 'active'))
+	  AND $2 = ANY(event_types) AND active AND deleted_at IS NULL`
+
+	return r.querySubscriptions(ctx, query, userID, eventType)
+}
+
+func (r *Repository) querySubscriptions(ctx context.Context, query string, args ...any) ([]Subscription, error) {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subs []Subscription
+	for rows.Next() {
+		var s Subscription
+		err := rows.Scan(&s.ID, &s.UserID, &s.URL, pq.Array(&s.EventTypes), &s.Secret, &s.Active, &s.CreatedAt, &s.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, s)
+	}
+	return subs, nil
+}
+
+func (r *Repository) SaveSubscription(ctx context.Context, s Subscription) (*Subscription, error) {
+	query := `
+	INSERT INTO webhook_subscription (id, user_id, url, event_types, secret, active)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	ON CONFLICT (id) DO UPDATE SET
+		url = EXCLUDED.url,
+		event_types = EXCLUDED.event_types,
+		active = EXCLUDED.active,
+		updated_at = NOW()
+	RETURNING created_at, updated_at`
+
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+
+	err := r.DB.QueryRowContext(ctx, query, s.ID, s.UserID, s.URL, pq.Array(s.EventTypes), s.Secret, s.Active).
+		Scan(&s.CreatedAt, &s.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (r *Repository) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE webhook_subscription SET deleted_at = NOW(), active = FALSE WHERE id = $1 AND deleted_at IS NULL`
+	res, err := r.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	count, err := res.RowsAffected()
+	if err == nil && count == 0 {
+		return errors.New("webhook subscription not found or already deleted")
+	}
+
+	return nil
+}
+
+// Enqueue queues a delivery of the event body to the subscription. Enqueuing
+// the same event twice is a no-op, so the outbox can safely redeliver.
+func (r *Repository) Enqueue(ctx context.Context, subscriptionID, eventID uuid.UUID, eventType string, body []byte) error {
+	query := `
+	INSERT INTO webhook_delivery (id, subscription_id, event_id, event_type, payload)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (subscription_id, event_id) DO NOTHING`
+
+	_, err := r.DB.ExecContext(ctx, query, uuid.New(), subscriptionID, eventID, eventType, body)
+	return err
+}
+
+func (r *Repository) FindDelivery(ctx context.Context, id uuid.UUID) (*Delivery, error) {
+	query := `
+	SELECT id, subscription_id, event_id, event_type, payload, status, attempts,
+		last_status_code, last_error, next_attempt_at, delivered_at, created_at
+	FROM webhook_delivery
+	WHERE id = $1`
+
+	var d Delivery
+	err := scanDelivery(r.DB.QueryRowContext(ctx, query, id), &d)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("webhook delivery not found")
+		}
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func (r *Repository) ListDeliveries(ctx context.Context, limit, offset int, subscriptionID uuid.UUID, status *string) ([]Delivery, error) {
+	query := `
+	SELECT id, subscription_id, event_id, event_type, payload, status, attempts,
+		last_status_code, last_error, next_attempt_at, delivered_at, created_at
+	FROM webhook_delivery
+	WHERE subscription_id = $3
+	  AND ($4::text IS NULL OR status = $4)
+	ORDER BY created_at DESC
+	LIMIT $1 OFFSET $2`
+
+	rows, err := r.DB.QueryContext(ctx, query, limit, offset, subscriptionID, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []Delivery
+	for rows.Next() {
+		var d Delivery
+		if err := scanDelivery(rows, &d); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, nil
+}
+
+// Requeue moves a delivery back to pending with a fresh attempt budget.
+func (r *Repository) Requeue(ctx context.Context, id uuid.UUID) (*Delivery, error) {
+	query := `
+	UPDATE webhook_delivery
+	SET status = $2, attempts = 0, last_error = NULL, next_attempt_at = NOW()
+	WHERE id = $1`
+
+	if _, err := r.DB.ExecContext(ctx, query, id, StatusPending); err != nil {
+		return nil, err
+	}
+	return r.FindDelivery(ctx, id)
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanDelivery(row rowScanner, d *Delivery) error {
+	var payload []byte
+	err := row.Scan(&d.ID, &d.SubscriptionID, &d.EventID, &d.EventType, &payload, &d.Status, &d.Attempts,
+		&d.LastStatusCode, &d.LastError, &d.NextAttemptAt, &d.DeliveredAt, &d.CreatedAt)
+	if err != nil {
+		return err
+	}
+	d.Payload = payload
+	return nil
+}
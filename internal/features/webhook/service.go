@@ -0,0 +1,212 @@
+package webhook
+
+import (
+	webhookService "be/gen/webhook"
+	"be/internal/features/user"
+	"be/internal/middleware"
+	"be/internal/utils"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+type Service struct {
+	Repository *Repository
+	users      *user.Repository
+}
+
+func NewService() *Service {
+	return &Service{
+		Repository: NewRepository(),
+		users:      user.NewRepository(),
+	}
+}
+
+func (s *Service) OAuth2Auth(ctx context.Context, token string, scheme *security.OAuth2Scheme) (context.Context, error) {
+	claims, err := middleware.ValidateToken(token)
+	if err != nil {
+		return ctx, &webhookService.Unauthorized{Message: "Invalid token"}
+	}
+	ctx = context.WithValue(ctx, middleware.ClaimsKey, claims)
+
+	return ctx, nil
+}
+
+// ownedSubscription loads the subscription and checks it belongs to the caller.
+func (s *Service) ownedSubscription(ctx context.Context, id string) (*Subscription, error) {
+	u, err := s.users.FindCurrent(ctx)
+	if err != nil {
+		return nil, &webhookService.Forbidden{Message: "Forbidden"}
+	}
+
+	subID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, badRequest("invalid_id", "invalid subscription ID")
+	}
+
+	sub, err := s.Repository.FindSubscription(ctx, subID)
+	if err != nil {
+		return nil, &webhookService.NotFound{Message: "Webhook subscription not found"}
+	}
+	if sub.UserID != u.ID {
+		return nil, &webhookService.Forbidden{Message: "Forbidden"}
+	}
+	return sub, nil
+}
+
+func (s *Service) Create(ctx context.Context, payload *webhookService.CreatePayload) (*webhookService.CreatedWebhookSubscription, error) {
+	u, err := s.users.FindCurrent(ctx)
+	if err != nil {
+		return nil, &webhookService.Forbidden{Message: "Forbidden"}
+	}
+
+	if err := checkCallback(payload.URL); err != nil {
+		return nil, badRequest("invalid_url", err.Error())
+	}
+
+	secret, err := NewSecret()
+	if err != nil {
+		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+		return nil, &webhookService.InternalServerError{Message: "Internal Server error"}
+	}
+
+	saved, err := s.Repository.SaveSubscription(ctx, Subscription{
+		UserID:     u.ID,
+		URL:        payload.URL,
+		EventTypes: payload.EventTypes,
+		Secret:     secret,
+		Active:     true,
+	})
+	if err != nil {
+		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+		return nil, &webhookService.InternalServerError{Message: "Internal Server error"}
+	}
+
+	return &webhookService.CreatedWebhookSubscription{
+		ID:         saved.ID.String(),
+		URL:        saved.URL,
+		EventTypes: saved.EventTypes,
+		Active:     saved.Active,
+		CreatedAt:  saved.CreatedAt.Format(time.RFC3339),
+		Secret:     saved.Secret,
+	}, nil
+}
+
+func (s *Service) List(ctx context.Context, payload *webhookService.ListPayload) ([]*webhookService.WebhookSubscription, error) {
+	u, err := s.users.FindCurrent(ctx)
+	if err != nil {
+		return nil, &webhookService.Forbidden{Message: "Forbidden"}
+	}
+
+	subs, err := s.Repository.ListSubscriptions(ctx, u.ID)
+	if err != nil {
+		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+		return nil, &webhookService.InternalServerError{Message: "Internal Server error"}
+	}
+
+	var res []*webhookService.WebhookSubscription
+	for _, sub := range subs {
+		res = append(res, &webhookService.WebhookSubscription{
+			ID:         sub.ID.String(),
+			URL:        sub.URL,
+			EventTypes: sub.EventTypes,
+			Active:     sub.Active,
+			CreatedAt:  sub.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return res, nil
+}
+
+func (s *Service) Delete(ctx context.Context, payload *webhookService.DeletePayload) error {
+	sub, err := s.ownedSubscription(ctx, payload.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Repository.DeleteSubscription(ctx, sub.ID); err != nil {
+		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+		return &webhookService.InternalServerError{Message: "Internal Server error"}
+	}
+	return nil
+}
+
+func (s *Service) Deliveries(ctx context.Context, payload *webhookService.DeliveriesPayload) ([]*webhookService.WebhookDelivery, error) {
+	sub, err := s.ownedSubscription(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.Repository.ListDeliveries(ctx, payload.Limit, payload.Offset, sub.ID, payload.Status)
+	if err != nil {
+		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+		return nil, &webhookService.InternalServerError{Message: "Internal Server error"}
+	}
+
+	var res []*webhookService.WebhookDelivery
+	for _, d := range deliveries {
+		res = append(res, toDeliveryResult(d))
+	}
+	return res, nil
+}
+
+func (s *Service) Redeliver(ctx context.Context, payload *webhookService.RedeliverPayload) (*webhookService.WebhookDelivery, error) {
+	sub, err := s.ownedSubscription(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveryID, err := uuid.Parse(payload.DeliveryID)
+	if err != nil {
+		return nil, badRequest("invalid_id", "invalid delivery ID")
+	}
+
+	d, err := s.Repository.FindDelivery(ctx, deliveryID)
+	if err != nil || d.SubscriptionID != sub.ID {
+		return nil, &webhookService.NotFound{Message: "Webhook delivery not found"}
+	}
+	if d.Status != StatusDead {
+		return nil, badRequest("invalid_state", "only dead deliveries can be redelivered")
+	}
+
+	requeued, err := s.Repository.Requeue(ctx, d.ID)
+	if err != nil {
+		utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+		return nil, &webhookService.InternalServerError{Message: "Internal Server error"}
+	}
+	return toDeliveryResult(*requeued), nil
+}
+
+func toDeliveryResult(d Delivery) *webhookService.WebhookDelivery {
+	res := &webhookService.WebhookDelivery{
+		ID:             d.ID.String(),
+		SubscriptionID: d.SubscriptionID.String(),
+		EventID:        d.EventID.String(),
+		EventType:      d.EventType,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt.Format(time.RFC3339),
+	}
+	if d.Status == StatusPending {
+		next := d.NextAttemptAt.Format(time.RFC3339)
+		res.NextAttemptAt = &next
+	}
+	if d.DeliveredAt.Valid {
+		delivered := d.DeliveredAt.Time.Format(time.RFC3339)
+		res.DeliveredAt = &delivered
+	}
+	return res
+}
+
+func badRequest(name, message string) *webhookService.BadRequest {
+	return &webhookService.BadRequest{
+		Name:    name,
+		ID:      goa.NewErrorID(),
+		Message: message,
+	}
+}
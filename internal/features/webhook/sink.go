@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"be/internal/events"
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// envelope is the JSON body POSTed to the callback URLs.
+type envelope struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateId"`
+	CreatedAt     string          `json:"createdAt"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// Sink is an outbox sink that queues a webhook delivery for every active
+// subscription of the event owner, or of their coaches, listening to the
+// event type.
+type Sink struct {
+	Repository *Repository
+}
+
+func NewSink() *Sink {
+	return &Sink{Repository: NewRepository()}
+}
+
+func (s *Sink) Name() string { return "webhook" }
+
+func (s *Sink) Deliver(ctx context.Context, e events.Event) error {
+	if e.UserID == nil {
+		return nil
+	}
+
+	subs, err := s.Repository.FindActiveByEvent(ctx, *e.UserID, string(e.Type))
+	if err != nil || len(subs) == 0 {
+		return err
+	}
+
+	body, err := json.Marshal(envelope{
+		ID:            e.ID.String(),
+		Type:          string(e.Type),
+		AggregateType: e.AggregateType,
+		AggregateID:   e.AggregateID.String(),
+		CreatedAt:     e.CreatedAt.UTC().Format(time.RFC3339),
+		Data:          e.Payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range subs {
+		if err := s.Repository.Enqueue(ctx, sub.ID, e.ID, string(e.Type), body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
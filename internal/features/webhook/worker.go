@@ -0,0 +1,251 @@
+package webhook
+
+import (
+	"be/internal/utils"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+)
+
+// claimedDelivery is a pending delivery together with its target.
+type claimedDelivery struct {
+	Delivery
+	URL    string
+	Secret string
+	Active bool
+}
+
+// Worker POSTs pending deliveries to the subscription URLs, retrying with
+// exponential backoff and dead-lettering after MaxAttempts failures.
+type Worker struct {
+	Repository   *Repository
+	Client       *http.Client
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	Lease        time.Duration // how long a claimed delivery is hidden from other workers
+}
+
+func NewWorker() *Worker {
+	return &Worker{
+		Repository:   NewRepository(),
+		Client:       NewClient(10 * time.Second),
+		PollInterval: 2 * time.Second,
+		BatchSize:    20,
+		MaxAttempts:  8,
+		BaseBackoff:  30 * time.Second,
+		MaxBackoff:   6 * time.Hour,
+		Lease:        time.Minute,
+	}
+}
+
+// Start runs the worker in a goroutine until ctx is cancelled.
+func (w *Worker) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		log.Printf(ctx, "Starting webhook delivery worker")
+		w.Run(ctx)
+		log.Printf(ctx, "Webhook delivery worker stopped")
+	}()
+}
+
+// Run processes deliveries until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			n, err := w.ProcessBatch(ctx)
+			if err != nil {
+				utils.Log.Error(ctx, log.KV{K: "webhook", V: err}, err)
+				break
+			}
+			if n < w.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// outcome is the result of a delivery attempt: delivered, dead-lettered or
+// pending a retry at Next.
+type outcome struct {
+	Status   string
+	Attempts int
+	Code     *int
+	Err      string
+	Next     time.Time
+}
+
+// ProcessBatch attempts one batch of due deliveries and returns how many
+// were attempted.
+func (w *Worker) ProcessBatch(ctx context.Context) (int, error) {
+	batch, err := w.claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, d := range batch {
+		o := w.attempt(ctx, d)
+		if o.Status == StatusPending {
+			w.retry(ctx, d.ID, o.Attempts, o.Code, o.Err, o.Next)
+			continue
+		}
+		w.finish(ctx, d.ID, o.Status, o.Attempts, o.Code, o.Err)
+	}
+
+	return len(batch), nil
+}
+
+// attempt sends d and tells what becomes of it.
+func (w *Worker) attempt(ctx context.Context, d claimedDelivery) outcome {
+	if !d.Active {
+		return outcome{Status: StatusDead, Attempts: d.Attempts, Err: "subscription disabled"}
+	}
+
+	code, err := w.Send(ctx, d.URL, d.Secret, d.Delivery)
+	attempts := d.Attempts + 1
+	if err == nil {
+		return outcome{Status: StatusDelivered, Attempts: attempts, Code: &code}
+	}
+
+	o := outcome{Status: StatusPending, Attempts: attempts, Err: err.Error()}
+	if code != 0 {
+		o.Code = &code
+	}
+	if attempts >= w.MaxAttempts {
+		o.Status = StatusDead
+		return o
+	}
+	o.Next = time.Now().Add(w.backoff(attempts))
+	return o
+}
+
+// Send POSTs the delivery payload to url, signed with secret, and returns the
+// HTTP status code. Any non-2xx response is reported as an error.
+func (w *Worker) Send(ctx context.Context, url, secret string, d Delivery) (int, error) {
+	if !strings.HasPrefix(url, "https://") {
+		return 0, fmt.Errorf("callback URL is not https")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	ts := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "be-webhooks/1.0")
+	req.Header.Set(EventHeader, d.EventType)
+	req.Header.Set(DeliveryHeader, d.ID.String())
+	req.Header.Set(TimestampHeader, strconv.FormatInt(ts, 10))
+	req.Header.Set(SignatureHeader, Sign(secret, ts, d.Payload))
+
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// claim leases a batch of due deliveries so that concurrent workers skip them.
+func (w *Worker) claim(ctx context.Context) ([]claimedDelivery, error) {
+	query := `
+	WITH due AS (
+		SELECT id FROM webhook_delivery
+		WHERE status = $1 AND next_attempt_at <= NOW()
+		ORDER BY next_attempt_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	)
+	UPDATE webhook_delivery d
+	SET next_attempt_at = NOW() + $3 * INTERVAL '1 second'
+	FROM due, webhook_subscription s
+	WHERE d.id = due.id AND s.id = d.subscription_id
+	RETURNING d.id, d.subscription_id, d.event_id, d.event_type, d.payload, d.status, d.attempts,
+		d.last_status_code, d.last_error, d.next_attempt_at, d.delivered_at, d.created_at,
+		s.url, s.secret, (s.active AND s.deleted_at IS NULL)`
+
+	rows, err := w.Repository.DB.QueryContext(ctx, query, StatusPending, w.BatchSize, int(w.Lease.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var batch []claimedDelivery
+	for rows.Next() {
+		var (
+			c       claimedDelivery
+			payload []byte
+		)
+		err := rows.Scan(&c.ID, &c.SubscriptionID, &c.EventID, &c.EventType, &payload, &c.Status, &c.Attempts,
+			&c.LastStatusCode, &c.LastError, &c.NextAttemptAt, &c.DeliveredAt, &c.CreatedAt,
+			&c.URL, &c.Secret, &c.Active)
+		if err != nil {
+			return nil, err
+		}
+		c.Payload = payload
+		batch = append(batch, c)
+	}
+	return batch, rows.Err()
+}
+
+func (w *Worker) finish(ctx context.Context, id uuid.UUID, status string, attempts int, code *int, lastErr string) {
+	query := `
+	UPDATE webhook_delivery
+	SET status = $2, attempts = $3, last_status_code = $4, last_error = NULLIF($5, ''),
+		delivered_at = CASE WHEN $2 = 'delivered' THEN NOW() ELSE delivered_at END
+	WHERE id = $1`
+
+	if _, err := w.Repository.DB.ExecContext(ctx, query, id, status, attempts, code, lastErr); err != nil {
+		utils.Log.Error(ctx, log.KV{K: "delivery", V: id.String()}, err)
+	}
+}
+
+func (w *Worker) retry(ctx context.Context, id uuid.UUID, attempts int, code *int, lastErr string, next time.Time) {
+	query := `
+	UPDATE webhook_delivery
+	SET attempts = $2, last_status_code = $3, last_error = $4, next_attempt_at = $5
+	WHERE id = $1`
+
+	if _, err := w.Repository.DB.ExecContext(ctx, query, id, attempts, code, lastErr, next); err != nil {
+		utils.Log.Error(ctx, log.KV{K: "delivery", V: id.String()}, err)
+	}
+}
+
+// backoff returns the delay before the next attempt, doubling from
+// BaseBackoff up to MaxBackoff.
+func (w *Worker) backoff(attempts int) time.Duration {
+	delay := w.BaseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= w.MaxBackoff {
+			return w.MaxBackoff
+		}
+	}
+	return delay
+}
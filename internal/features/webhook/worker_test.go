@@ -0,0 +1,214 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// receiver is a local webhook endpoint answering with status and keeping
+// the last request.
+type receiver struct {
+	*httptest.Server
+	status  atomic.Int32
+	calls   atomic.Int32
+	header  http.Header
+	payload []byte
+}
+
+func newReceiver(t *testing.T, status int) *receiver {
+	t.Helper()
+	r := &receiver{}
+	r.status.Store(int32(status))
+	r.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.calls.Add(1)
+		r.header = req.Header.Clone()
+		r.payload, _ = io.ReadAll(req.Body)
+		w.WriteHeader(int(r.status.Load()))
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func testWorker(client *http.Client) *Worker {
+	return &Worker{
+		Client:      client,
+		MaxAttempts: 3,
+		BaseBackoff: time.Minute,
+		MaxBackoff:  time.Hour,
+	}
+}
+
+func testDelivery(url string, attempts int) claimedDelivery {
+	return claimedDelivery{
+		Delivery: Delivery{
+			ID:        uuid.New(),
+			EventType: "SetLogged",
+			Payload:   []byte(`{"type":"SetLogged"}`),
+			Attempts:  attempts,
+		},
+		URL:    url,
+		Secret: "whsec_test",
+		Active: true,
+	}
+}
+
+func TestAttemptSignsDelivery(t *testing.T) {
+	rcv := newReceiver(t, http.StatusNoContent)
+	w := testWorker(rcv.Client())
+	d := testDelivery(rcv.URL, 0)
+
+	o := w.attempt(context.Background(), d)
+
+	if o.Status != StatusDelivered || o.Attempts != 1 || o.Code == nil || *o.Code != http.StatusNoContent {
+		t.Fatalf("outcome = %+v, want delivered after 1 attempt with 204", o)
+	}
+	ts, err := strconv.ParseInt(rcv.header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		t.Fatalf("timestamp header %q: %v", rcv.header.Get(TimestampHeader), err)
+	}
+	if !Verify(d.Secret, ts, rcv.payload, rcv.header.Get(SignatureHeader)) {
+		t.Errorf("signature %q doesn't verify", rcv.header.Get(SignatureHeader))
+	}
+	if Verify("whsec_other", ts, rcv.payload, rcv.header.Get(SignatureHeader)) {
+		t.Error("signature verifies with another secret")
+	}
+	if got := rcv.header.Get(EventHeader); got != d.EventType {
+		t.Errorf("%s = %q, want %q", EventHeader, got, d.EventType)
+	}
+	if got := rcv.header.Get(DeliveryHeader); got != d.ID.String() {
+		t.Errorf("%s = %q, want %q", DeliveryHeader, got, d.ID)
+	}
+}
+
+func TestAttemptRetriesWithBackoff(t *testing.T) {
+	rcv := newReceiver(t, http.StatusServiceUnavailable)
+	w := testWorker(rcv.Client())
+
+	tests := []struct {
+		attempts int
+		backoff  time.Duration
+	}{
+		{attempts: 0, backoff: time.Minute},
+		{attempts: 1, backoff: 2 * time.Minute},
+	}
+	for _, tt := range tests {
+		start := time.Now()
+		o := w.attempt(context.Background(), testDelivery(rcv.URL, tt.attempts))
+
+		if o.Status != StatusPending || o.Attempts != tt.attempts+1 {
+			t.Fatalf("after %d attempts: outcome = %+v, want pending", tt.attempts, o)
+		}
+		if o.Code == nil || *o.Code != http.StatusServiceUnavailable {
+			t.Errorf("after %d attempts: code = %v, want 503", tt.attempts, o.Code)
+		}
+		if next := o.Next.Sub(start); next < tt.backoff || next > tt.backoff+time.Second {
+			t.Errorf("after %d attempts: next attempt in %s, want %s", tt.attempts, next, tt.backoff)
+		}
+	}
+}
+
+func TestAttemptDeadLettersAfterMaxAttempts(t *testing.T) {
+	rcv := newReceiver(t, http.StatusInternalServerError)
+	w := testWorker(rcv.Client())
+
+	o := w.attempt(context.Background(), testDelivery(rcv.URL, w.MaxAttempts-1))
+
+	if o.Status != StatusDead || o.Attempts != w.MaxAttempts || o.Err == "" {
+		t.Fatalf("outcome = %+v, want dead after %d attempts with an error", o, w.MaxAttempts)
+	}
+}
+
+func TestAttemptDisabledSubscription(t *testing.T) {
+	rcv := newReceiver(t, http.StatusOK)
+	d := testDelivery(rcv.URL, 2)
+	d.Active = false
+
+	o := testWorker(rcv.Client()).attempt(context.Background(), d)
+
+	if o.Status != StatusDead || o.Attempts != 2 || rcv.calls.Load() != 0 {
+		t.Fatalf("outcome = %+v with %d calls, want dead without calling", o, rcv.calls.Load())
+	}
+}
+
+func TestBackoffIsCapped(t *testing.T) {
+	w := testWorker(nil)
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{4, 8 * time.Minute},
+		{10, time.Hour},
+	}
+	for _, tt := range tests {
+		if got := w.backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestClientRefusesInternalAddresses(t *testing.T) {
+	rcv := newReceiver(t, http.StatusOK)
+	w := testWorker(NewClient(time.Second))
+
+	o := w.attempt(context.Background(), testDelivery(rcv.URL, 0))
+
+	if o.Status != StatusPending || rcv.calls.Load() != 0 {
+		t.Fatalf("outcome = %+v with %d calls, want the loopback receiver not reached", o, rcv.calls.Load())
+	}
+}
+
+func TestCheckAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		ok      bool
+	}{
+		{"93.184.216.34:443", true},
+		{"[2606:2800:220:1:248:1893:25c8:1946]:443", true},
+		{"127.0.0.1:443", false},
+		{"[::1]:443", false},
+		{"10.1.2.3:443", false},
+		{"172.16.0.1:443", false},
+		{"192.168.1.1:443", false},
+		{"169.254.169.254:80", false},
+		{"100.64.0.1:443", false},
+		{"0.0.0.0:443", false},
+		{"[fd00:ec2::254]:80", false},
+		{"[fe80::1]:443", false},
+		{"[::ffff:127.0.0.1]:443", false},
+	}
+	for _, tt := range tests {
+		if err := checkAddress(tt.address); (err == nil) != tt.ok {
+			t.Errorf("checkAddress(%s) = %v, want ok %t", tt.address, err, tt.ok)
+		}
+	}
+}
+
+func TestCheckCallback(t *testing.T) {
+	tests := []struct {
+		url string
+		ok  bool
+	}{
+		{"https://coach.example.com/hooks/ld", true},
+		{"http://coach.example.com/hooks/ld", false},
+		{"https://localhost/hooks", false},
+		{"https://keycloak:8080/", false},
+		{"https://127.0.0.1/hooks", false},
+		{"https://169.254.169.254/latest/meta-data", false},
+		{"https://[::1]/hooks", false},
+	}
+	for _, tt := range tests {
+		if err := checkCallback(tt.url); (err == nil) != tt.ok {
+			t.Errorf("checkCallback(%s) = %v, want ok %t", tt.url, err, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,439 @@
+package workoutsession
+
+import (
+	"be/internal/database/db"
+	"be/internal/events"
+	personalrecord "be/internal/features/personalRecord"
+	"be/internal/features/progression"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	StatusInProgress = "in_progress"
+	StatusFinished   = "finished"
+	StatusAbandoned  = "abandoned"
+)
+
+var (
+	ErrSessionNotFound   = errors.New("workout session not found")
+	ErrWorkoutNotFound   = errors.New("workout not found")
+	ErrSetNotFound       = errors.New("session set not found")
+	ErrNotInProgress     = errors.New("workout session is not in progress")
+	ErrAlreadyInProgress = errors.New("a session of this workout is already in progress")
+)
+
+type Session struct {
+	ID         uuid.UUID
+	WorkoutID  *uuid.UUID // nil once the workout is purged, the session is kept
+	UserID     uuid.UUID
+	Status     string
+	Notes      *string
+	StartedAt  time.Time
+	FinishedAt sql.NullTime
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Exercises  []SessionExercise
+}
+
+type SessionExercise struct {
+	ID             uuid.UUID
+	SessionID      uuid.UUID
+	ExerciseID     *uuid.UUID
+	ExerciseTypeID *uuid.UUID
+	Name           string
+	Position       int
+	Sets           []SessionSet
+}
+
+type SessionSet struct {
+	ID                uuid.UUID
+	SessionExerciseID uuid.UUID
+	PlannedSetID      *uuid.UUID
+	Position          int
+	PlannedWeight     *float64
+	PlannedReps       *int
+	PlannedRestTime   *int
+	Weight            *float64
+	Reps              *int
+	RestTime          *int
+	Completed         bool
+	LoggedAt          sql.NullTime
+	Records           []personalrecord.Record // records set when the set was logged
+}
+
+type Repository struct {
+	DB          *sql.DB
+	records     *personalrecord.Repository
+	progression *progression.Repository
+}
+
+func NewRepository() *Repository {
+	return &Repository{
+		DB:          db.DB.LD,
+		records:     personalrecord.NewRepository(),
+		progression: progression.NewRepository(),
+	}
+}
+
+// WorkoutOwner returns the user owning the training plan of the workout.
+// Workouts of templates cannot be performed and are reported as not found.
+func (r *Repository) WorkoutOwner(ctx context.Context, workoutID uuid.UUID) (uuid.UUID, error) {
+	query := `
+	SELECT tp.user_id
+	FROM workout w
+	JOIN training_plan tp ON tp.id = w.training_plan_id
+	WHERE w.id = $1 AND w.deleted_at IS NULL AND tp.deleted_at IS NULL AND NOT tp.is_template`
+
+	var userID uuid.UUID
+	err := r.DB.QueryRowContext(ctx, query, workoutID).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, ErrWorkoutNotFound
+		}
+		return uuid.Nil, err
+	}
+	return userID, nil
+}
+
+// Start creates an in-progress session of the workout, snapshotting its
+// planned exercises and sets so later plan edits don't change the session.
+func (r *Repository) Start(ctx context.Context, workoutID, userID uuid.UUID, notes *string) (*Session, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	s := Session{
+		ID:        uuid.New(),
+		WorkoutID: &workoutID,
+		UserID:    userID,
+		Status:    StatusInProgress,
+		Notes:     notes,
+	}
+
+	err = tx.QueryRowContext(ctx, `
+	INSERT INTO workout_session (id, workout_id, user_id, status, notes)
+	VALUES ($1, $2, $3, $4, $5)
+	RETURNING started_at, created_at, updated_at`,
+		s.ID, s.WorkoutID, s.UserID, s.Status, s.Notes).
+		Scan(&s.StartedAt, &s.CreatedAt, &s.UpdatedAt)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, ErrAlreadyInProgress
+		}
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO session_exercise (id, session_id, exercise_id, exercise_type_id, name, position)
+	SELECT gen_random_uuid(), $1, e.id, e.exercise_type_id, e.name, ROW_NUMBER() OVER (ORDER BY e.created_at, e.id)
+	FROM exercise e
+	WHERE e.workout_id = $2 AND e.deleted_at IS NULL`,
+		s.ID, workoutID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO session_set (id, session_exercise_id, planned_set_id, position, planned_weight, planned_reps, planned_rest_time)
+	SELECT gen_random_uuid(), se.id, es.id,
+		ROW_NUMBER() OVER (PARTITION BY se.id ORDER BY es.created_at, es.id),
+		es.weight, es.reps, es.rest_time
+	FROM session_exercise se
+	JOIN exercise_set es ON es.exercise_id = se.exercise_id AND es.deleted_at IS NULL
+	WHERE se.session_id = $1`,
+		s.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := events.Emit(ctx, tx, events.WorkoutSessionStarted, "workout_session", s.ID, &s.UserID, s); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.FindByID(ctx, s.ID)
+}
+
+func (r *Repository) FindByID(ctx context.Context, id uuid.UUID) (*Session, error) {
+	query := `
+	SELECT id, workout_id, user_id, status, notes, started_at, finished_at, created_at, updated_at
+	FROM workout_session
+	WHERE id = $1 AND deleted_at IS NULL`
+
+	var s Session
+	err := r.DB.QueryRowContext(ctx, query, id).
+		Scan(&s.ID, &s.WorkoutID, &s.UserID, &s.Status, &s.Notes, &s.StartedAt, &s.FinishedAt, &s.CreatedAt, &s.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	exercises, err := r.listExercises(ctx, s.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.Exercises = exercises
+
+	return &s, nil
+}
+
+func (r *Repository) listExercises(ctx context.Context, sessionID uuid.UUID) ([]SessionExercise, error) {
+	rows, err := r.DB.QueryContext(ctx, `
+	SELECT id, session_id, exercise_id, exercise_type_id, name, position
+	FROM session_exercise
+	WHERE session_id = $1
+	ORDER BY position`, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		exercises []SessionExercise
+		index     = make(map[uuid.UUID]int)
+	)
+	for rows.Next() {
+		var se SessionExercise
+		if err := rows.Scan(&se.ID, &se.SessionID, &se.ExerciseID, &se.ExerciseTypeID, &se.Name, &se.Position); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		index[se.ID] = len(exercises)
+		exercises = append(exercises, se)
+	}
+	rows.Close()
+
+	rows, err = r.DB.QueryContext(ctx, `
+	SELECT ss.id, ss.session_exercise_id, ss.planned_set_id, ss.position, ss.planned_weight, ss.planned_reps,
+		ss.planned_rest_time, ss.weight, ss.reps, ss.rest_time, ss.completed, ss.logged_at
+	FROM session_set ss
+	JOIN session_exercise se ON se.id = ss.session_exercise_id
+	WHERE se.session_id = $1
+	ORDER BY se.position, ss.position`, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ss SessionSet
+		if err := scanSet(rows, &ss); err != nil {
+			return nil, err
+		}
+		if i, ok := index[ss.SessionExerciseID]; ok {
+			exercises[i].Sets = append(exercises[i].Sets, ss)
+		}
+	}
+	return exercises, nil
+}
+
+func (r *Repository) List(ctx context.Context, limit, offset int, userID uuid.UUID, workoutID, status *string) ([]Session, error) {
+	query := `
+	SELECT id, workout_id, user_id, status, notes, started_at, finished_at, created_at, updated_at
+	FROM workout_session
+	WHERE deleted_at IS NULL
+	  AND user_id = $3
+	  AND ($4::uuid IS NULL OR workout_id = $4)
+	  AND ($5::text IS NULL OR status = $5)
+	ORDER BY started_at DESC
+	LIMIT $1 OFFSET $2`
+
+	rows, err := r.DB.QueryContext(ctx, query, limit, offset, userID, workoutID, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []Session
+	for rows.Next() {
+		var s Session
+		err := rows.Scan(&s.ID, &s.WorkoutID, &s.UserID, &s.Status, &s.Notes, &s.StartedAt, &s.FinishedAt, &s.CreatedAt, &s.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	return sessions, nil
+}
+
+// LogSet records a performed set. When setID is given the planned set is
+// filled in, otherwise an extra set is appended to the session exercise.
+func (r *Repository) LogSet(ctx context.Context, sessionID, sessionExerciseID uuid.UUID, setID *uuid.UUID, weight float64, reps int, restTime *int) (*SessionSet, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var (
+		status string
+		userID uuid.UUID
+	)
+	err = tx.QueryRowContext(ctx, `
+	SELECT s.status, s.user_id
+	FROM workout_session s
+	JOIN session_exercise se ON se.session_id = s.id
+	WHERE s.id = $1 AND se.id = $2 AND s.deleted_at IS NULL
+	FOR UPDATE OF s`, sessionID, sessionExerciseID).Scan(&status, &userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+	if status != StatusInProgress {
+		return nil, ErrNotInProgress
+	}
+
+	const returning = `
+	RETURNING id, session_exercise_id, planned_set_id, position, planned_weight, planned_reps,
+		planned_rest_time, weight, reps, rest_time, completed, logged_at`
+
+	var ss SessionSet
+	if setID != nil {
+		err = scanSet(tx.QueryRowContext(ctx, `
+		UPDATE session_set
+		SET weight = $3, reps = $4, rest_time = $5, completed = TRUE, logged_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND session_exercise_id = $2`+returning,
+			*setID, sessionExerciseID, weight, reps, restTime), &ss)
+	} else {
+		err = scanSet(tx.QueryRowContext(ctx, `
+		INSERT INTO session_set (id, session_exercise_id, position, weight, reps, rest_time, completed, logged_at)
+		SELECT $1, $2, COALESCE(MAX(position), 0) + 1, $3, $4, $5, TRUE, NOW()
+		FROM session_set WHERE session_exercise_id = $2`+returning,
+			uuid.New(), sessionExerciseID, weight, reps, restTime), &ss)
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSetNotFound
+		}
+		return nil, err
+	}
+
+	if err := events.Emit(ctx, tx, events.SetLogged, "session_set", ss.ID, &userID, ss); err != nil {
+		return nil, err
+	}
+
+	if ss.Records, err = r.detectRecords(ctx, tx, sessionID, sessionExerciseID, userID, ss); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &ss, nil
+}
+
+// detectRecords checks a logged set for new personal records. Sets of
+// exercises without an exercise type are not tracked.
+func (r *Repository) detectRecords(ctx context.Context, tx *sql.Tx, sessionID, sessionExerciseID, userID uuid.UUID, ss SessionSet) ([]personalrecord.Record, error) {
+	var (
+		exerciseTypeID *uuid.UUID
+		volume         float64
+	)
+	err := tx.QueryRowContext(ctx, `
+	SELECT se.exercise_type_id, (
+		SELECT COALESCE(SUM(s.weight * s.reps), 0)
+		FROM session_set s
+		JOIN session_exercise e ON e.id = s.session_exercise_id
+		WHERE e.session_id = se.session_id
+		  AND e.exercise_type_id = se.exercise_type_id
+		  AND s.completed
+	)
+	FROM session_exercise se
+	WHERE se.id = $1`, sessionExerciseID).Scan(&exerciseTypeID, &volume)
+	if err != nil {
+		return nil, err
+	}
+	if exerciseTypeID == nil || ss.Weight == nil || ss.Reps == nil {
+		return nil, nil
+	}
+
+	return r.records.Detect(ctx, tx, personalrecord.Performance{
+		UserID:         userID,
+		ExerciseTypeID: *exerciseTypeID,
+		SessionID:      &sessionID,
+		SetID:          ss.ID,
+		Weight:         *ss.Weight,
+		Reps:           *ss.Reps,
+		Volume:         volume,
+		AchievedAt:     ss.LoggedAt.Time,
+	})
+}
+
+// Close moves an in-progress session to the finished or abandoned status.
+func (r *Repository) Close(ctx context.Context, id uuid.UUID, status string, notes *string) (*Session, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var (
+		current string
+		userID  uuid.UUID
+	)
+	err = tx.QueryRowContext(ctx, `
+	SELECT status, user_id FROM workout_session
+	WHERE id = $1 AND deleted_at IS NULL
+	FOR UPDATE`, id).Scan(&current, &userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+	if current != StatusInProgress {
+		return nil, ErrNotInProgress
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE workout_session
+	SET status = $2, notes = COALESCE($3, notes), finished_at = NOW(), updated_at = NOW()
+	WHERE id = $1`, id, status, notes)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType := events.WorkoutSessionFinished
+	if status == StatusAbandoned {
+		eventType = events.WorkoutSessionAbandoned
+	}
+	if status == StatusFinished {
+		// Finishing a session moves its exercises' targets forward.
+		if _, err := r.progression.Apply(ctx, tx, id, userID); err != nil {
+			return nil, err
+		}
+	}
+	if err := events.Emit(ctx, tx, eventType, "workout_session", id, &userID, map[string]any{"ID": id, "Status": status}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.FindByID(ctx, id)
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSet(row rowScanner, ss *SessionSet) error {
+	return row.Scan(&ss.ID, &ss.SessionExerciseID, &ss.PlannedSetID, &ss.Position, &ss.PlannedWeight, &ss.PlannedReps,
+		&ss.PlannedRestTime, &ss.Weight, &ss.Reps, &ss.RestTime, &ss.Completed, &ss.LoggedAt)
+}
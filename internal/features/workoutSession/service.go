@@ -0,0 +1,277 @@
+package workoutsession
+
+import (
+	sessionService "be/gen/workout_session"
+	"be/internal/features/coaching"
+	"be/internal/features/user"
+	"be/internal/middleware"
+	"be/internal/utils"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+type Service struct {
+	Repository *Repository
+	users      *user.Repository
+	access     *coaching.Authorizer
+}
+
+func NewService() *Service {
+	return &Service{
+		Repository: NewRepository(),
+		users:      user.NewRepository(),
+		access:     coaching.NewAuthorizer(),
+	}
+}
+
+func (s *Service) OAuth2Auth(ctx context.Context, token string, scheme *security.OAuth2Scheme) (context.Context, error) {
+	claims, err := middleware.ValidateToken(token)
+	if err != nil {
+		return ctx, &sessionService.Unauthorized{Message: "Invalid token"}
+	}
+	ctx = context.WithValue(ctx, middleware.ClaimsKey, claims)
+
+	return ctx, nil
+}
+
+// ownedSession loads the session and checks the caller may access it: as
+// its athlete, their coach or an admin.
+func (s *Service) ownedSession(ctx context.Context, id string) (*Session, error) {
+	u, err := s.users.FindCurrent(ctx)
+	if err != nil {
+		return nil, &sessionService.Forbidden{Message: "Forbidden"}
+	}
+
+	sessionID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, badRequest("invalid_id", "invalid session ID")
+	}
+
+	session, err := s.Repository.FindByID(ctx, sessionID)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	if err := s.authorize(ctx, u, session.UserID); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (s *Service) Start(ctx context.Context, payload *sessionService.StartPayload) (*sessionService.WorkoutSession, error) {
+	u, err := s.users.FindCurrent(ctx)
+	if err != nil {
+		return nil, &sessionService.Forbidden{Message: "Forbidden"}
+	}
+
+	workoutID, err := uuid.Parse(payload.WorkoutID)
+	if err != nil {
+		return nil, badRequest("invalid_id", "invalid workout ID")
+	}
+
+	owner, err := s.Repository.WorkoutOwner(ctx, workoutID)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	if err := s.authorize(ctx, u, owner); err != nil {
+		return nil, err
+	}
+
+	session, err := s.Repository.Start(ctx, workoutID, owner, payload.Notes)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	return toSessionResult(session), nil
+}
+
+func (s *Service) Get(ctx context.Context, payload *sessionService.GetPayload) (*sessionService.WorkoutSession, error) {
+	session, err := s.ownedSession(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+	return toSessionResult(session), nil
+}
+
+func (s *Service) List(ctx context.Context, payload *sessionService.ListPayload) ([]*sessionService.WorkoutSession, error) {
+	u, err := s.users.FindCurrent(ctx)
+	if err != nil {
+		return nil, &sessionService.Forbidden{Message: "Forbidden"}
+	}
+
+	userID := u.ID
+	if payload.UserID != nil {
+		if userID, err = uuid.Parse(*payload.UserID); err != nil {
+			return nil, badRequest("invalid_id", "invalid user ID")
+		}
+		if err := s.authorize(ctx, u, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	sessions, err := s.Repository.List(ctx, payload.Limit, payload.Offset, userID, payload.WorkoutID, payload.Status)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+
+	var res []*sessionService.WorkoutSession
+	for i := range sessions {
+		res = append(res, toSessionResult(&sessions[i]))
+	}
+	return res, nil
+}
+
+func (s *Service) Log(ctx context.Context, payload *sessionService.LogPayload) (*sessionService.SessionSet, error) {
+	session, err := s.ownedSession(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	exerciseID, err := uuid.Parse(payload.SessionExerciseID)
+	if err != nil {
+		return nil, badRequest("invalid_id", "invalid session exercise ID")
+	}
+
+	var setID *uuid.UUID
+	if payload.SetID != nil {
+		id, err := uuid.Parse(*payload.SetID)
+		if err != nil {
+			return nil, badRequest("invalid_id", "invalid set ID")
+		}
+		setID = &id
+	}
+
+	ss, err := s.Repository.LogSet(ctx, session.ID, exerciseID, setID, payload.Weight, payload.Reps, payload.RestTime)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	return toSetResult(*ss), nil
+}
+
+func (s *Service) Finish(ctx context.Context, payload *sessionService.FinishPayload) (*sessionService.WorkoutSession, error) {
+	return s.close(ctx, payload.ID, StatusFinished, payload.Notes)
+}
+
+func (s *Service) Abandon(ctx context.Context, payload *sessionService.AbandonPayload) (*sessionService.WorkoutSession, error) {
+	return s.close(ctx, payload.ID, StatusAbandoned, payload.Notes)
+}
+
+func (s *Service) close(ctx context.Context, id, status string, notes *string) (*sessionService.WorkoutSession, error) {
+	session, err := s.ownedSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	closed, err := s.Repository.Close(ctx, session.ID, status, notes)
+	if err != nil {
+		return nil, s.mapError(ctx, err)
+	}
+	return toSessionResult(closed), nil
+}
+
+// authorize checks the caller may act on the sessions of ownerID.
+func (s *Service) authorize(ctx context.Context, u *user.User, ownerID uuid.UUID) error {
+	ok, err := s.access.CanAccess(ctx, u.ID, u.Admin, ownerID)
+	if err != nil {
+		return s.mapError(ctx, err)
+	}
+	if !ok {
+		return &sessionService.Forbidden{Message: "Forbidden"}
+	}
+	return nil
+}
+
+// mapError converts repository errors to the service error types.
+func (s *Service) mapError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, ErrSessionNotFound):
+		return &sessionService.NotFound{Message: "Workout session not found"}
+	case errors.Is(err, ErrWorkoutNotFound):
+		return &sessionService.NotFound{Message: "Workout not found"}
+	case errors.Is(err, ErrSetNotFound):
+		return &sessionService.NotFound{Message: "Session set not found"}
+	case errors.Is(err, ErrNotInProgress):
+		return badRequest("invalid_state", err.Error())
+	case errors.Is(err, ErrAlreadyInProgress):
+		return badRequest("already_in_progress", err.Error())
+	}
+	utils.Log.Error(ctx, log.KV{K: "error", V: err}, err)
+	return &sessionService.InternalServerError{Message: "Internal Server error"}
+}
+
+func toSessionResult(s *Session) *sessionService.WorkoutSession {
+	res := &sessionService.WorkoutSession{
+		ID:        s.ID.String(),
+		UserID:    s.UserID.String(),
+		Status:    s.Status,
+		StartedAt: s.StartedAt.Format(time.RFC3339),
+		Notes:     s.Notes,
+	}
+	if s.WorkoutID != nil {
+		workoutID := s.WorkoutID.String()
+		res.WorkoutID = &workoutID
+	}
+	if s.FinishedAt.Valid {
+		finished := s.FinishedAt.Time.Format(time.RFC3339)
+		res.FinishedAt = &finished
+	}
+	for _, se := range s.Exercises {
+		ex := &sessionService.SessionExercise{
+			ID:       se.ID.String(),
+			Name:     se.Name,
+			Position: se.Position,
+			Sets:     []*sessionService.SessionSet{},
+		}
+		if se.ExerciseID != nil {
+			exerciseID := se.ExerciseID.String()
+			ex.ExerciseID = &exerciseID
+		}
+		if se.ExerciseTypeID != nil {
+			exerciseTypeID := se.ExerciseTypeID.String()
+			ex.ExerciseTypeID = &exerciseTypeID
+		}
+		for _, ss := range se.Sets {
+			ex.Sets = append(ex.Sets, toSetResult(ss))
+		}
+		res.Exercises = append(res.Exercises, ex)
+	}
+	return res
+}
+
+func toSetResult(ss SessionSet) *sessionService.SessionSet {
+	res := &sessionService.SessionSet{
+		ID:              ss.ID.String(),
+		Position:        ss.Position,
+		PlannedWeight:   ss.PlannedWeight,
+		PlannedReps:     ss.PlannedReps,
+		PlannedRestTime: ss.PlannedRestTime,
+		Weight:          ss.Weight,
+		Reps:            ss.Reps,
+		RestTime:        ss.RestTime,
+		Completed:       ss.Completed,
+	}
+	if ss.PlannedSetID != nil {
+		planned := ss.PlannedSetID.String()
+		res.PlannedSetID = &planned
+	}
+	if ss.LoggedAt.Valid {
+		logged := ss.LoggedAt.Time.Format(time.RFC3339)
+		res.LoggedAt = &logged
+	}
+	for _, rec := range ss.Records {
+		res.Records = append(res.Records, rec.Kind)
+	}
+	return res
+}
+
+func badRequest(name, message string) *sessionService.BadRequest {
+	return &sessionService.BadRequest{
+		Name:    name,
+		ID:      goa.NewErrorID(),
+		Message: message,
+	}
+}
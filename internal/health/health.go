@@ -0,0 +1,152 @@
+// Package health serves the liveness and readiness probes.
+package health
+
+import (
+	"be/internal/database/db"
+	"be/internal/features/common"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	statusOK       = "ok"
+	statusFailing  = "failing"
+	statusDraining = "draining"
+)
+
+// Check is a dependency readiness depends on. Fn must return within Timeout.
+type Check struct {
+	Name    string
+	Timeout time.Duration
+	Fn      func(ctx context.Context) error
+}
+
+// CheckResult is the outcome of a Check in the readiness report.
+type CheckResult struct {
+	Name       string  `json:"name"`
+	Status     string  `json:"status"`
+	DurationMs float64 `json:"durationMs"`
+	Error      string  `json:"error,omitempty"`
+}
+
+// Report is the body of the probes.
+type Report struct {
+	Status string        `json:"status"`
+	Uptime string        `json:"uptime"`
+	Checks []CheckResult `json:"checks,omitempty"`
+}
+
+// Checker runs the readiness checks. Once Drain is called readiness fails,
+// so that load balancers stop routing requests before the server shuts down.
+type Checker struct {
+	Checks   []Check
+	started  time.Time
+	draining atomic.Bool
+}
+
+// NewChecker checks the database connection, its schema version and
+// Keycloak.
+func NewChecker(kc *common.KcClient) *Checker {
+	return &Checker{
+		Checks: []Check{
+			{Name: "database", Timeout: 2 * time.Second, Fn: func(ctx context.Context) error {
+				return db.DB.LD.PingContext(ctx)
+			}},
+			{Name: "migrations", Timeout: 2 * time.Second, Fn: func(ctx context.Context) error {
+				return checkMigrations(ctx, db.DB.LD)
+			}},
+			{Name: "keycloak", Timeout: 3 * time.Second, Fn: kc.Ping},
+		},
+		started: time.Now(),
+	}
+}
+
+// checkMigrations fails when the last migration left the schema dirty or
+// the schema is older than the migrations shipped with the service.
+func checkMigrations(ctx context.Context, sqlDB *sql.DB) error {
+	version, dirty, err := db.MigrationStatus(ctx, sqlDB)
+	if err != nil {
+		return err
+	}
+	if dirty {
+		return fmt.Errorf("schema version %d is dirty", version)
+	}
+	latest, err := db.LatestMigration()
+	if err != nil {
+		return err
+	}
+	if version < latest {
+		return fmt.Errorf("schema version %d is behind %d", version, latest)
+	}
+	return nil
+}
+
+// Drain makes readiness fail from now on.
+func (c *Checker) Drain() {
+	c.draining.Store(true)
+}
+
+// Live reports that the process is serving requests. It doesn't check the
+// dependencies: restarting the service wouldn't bring them back.
+func (c *Checker) Live(w http.ResponseWriter, r *http.Request) {
+	c.write(w, http.StatusOK, Report{Status: statusOK})
+}
+
+// Ready runs the checks concurrently and fails when any of them does or the
+// server is draining.
+func (c *Checker) Ready(w http.ResponseWriter, r *http.Request) {
+	if c.draining.Load() {
+		c.write(w, http.StatusServiceUnavailable, Report{Status: statusDraining})
+		return
+	}
+
+	results := make([]CheckResult, len(c.Checks))
+	var wg sync.WaitGroup
+	for i, check := range c.Checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = run(r.Context(), check)
+		}()
+	}
+	wg.Wait()
+
+	report, status := Report{Status: statusOK, Checks: results}, http.StatusOK
+	for _, res := range results {
+		if res.Status != statusOK {
+			report.Status, status = statusFailing, http.StatusServiceUnavailable
+		}
+	}
+	c.write(w, status, report)
+}
+
+func run(ctx context.Context, check Check) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, check.Timeout)
+	defer cancel()
+
+	started := time.Now()
+	err := check.Fn(ctx)
+	res := CheckResult{
+		Name:       check.Name,
+		Status:     statusOK,
+		DurationMs: float64(time.Since(started).Microseconds()) / 1000,
+	}
+	if err != nil {
+		res.Status, res.Error = statusFailing, err.Error()
+	}
+	return res
+}
+
+func (c *Checker) write(w http.ResponseWriter, status int, report Report) {
+	report.Uptime = time.Since(c.started).Round(time.Second).String()
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(report)
+}
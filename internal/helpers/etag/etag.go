@@ -0,0 +1,25 @@
+package etag
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Format returns the entity tag of a row version, as sent in the ETag header.
+func Format(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// Match reports whether an If-Match header value accepts version: either "*"
+// or a list of tags holding its own. If-Match uses the strong comparison, so
+// weak tags never match.
+func Match(ifMatch string, version int) bool {
+	tag := Format(version)
+	for _, t := range strings.Split(ifMatch, ",") {
+		t = strings.TrimSpace(t)
+		if t == "*" || t == tag {
+			return true
+		}
+	}
+	return false
+}
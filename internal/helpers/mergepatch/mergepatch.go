@@ -0,0 +1,141 @@
+package mergepatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+)
+
+// maxSize bounds the patch documents read from request bodies.
+const maxSize = 1 << 20
+
+// FieldError is a member of a patch that cannot be applied.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// Error lists the members a patch was rejected for.
+type Error struct {
+	Fields []FieldError
+}
+
+func (e *Error) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Field + ": " + f.Message
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ErrNotObject is returned for bodies that are not a JSON object. RFC 7396
+// lets any other value replace the whole target, which resources don't
+// allow.
+var ErrNotObject = errors.New("the patch must be a JSON object")
+
+// Patch is an RFC 7396 merge patch of a flat resource: members absent from
+// the document are left unchanged and null members are removed, which for
+// a resource means cleared.
+type Patch struct {
+	members map[string]json.RawMessage
+	errs    []FieldError
+}
+
+// Decode reads a patch, rejecting members other than fields.
+func Decode(r io.Reader, fields ...string) (*Patch, error) {
+	body, err := io.ReadAll(io.LimitReader(r, maxSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(body, &members); err != nil || members == nil {
+		return nil, ErrNotObject
+	}
+
+	p := &Patch{members: members}
+	known := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		known[f] = true
+	}
+	var unknown []string
+	for name := range members {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		p.errs = append(p.errs, FieldError{name, "unknown field"})
+	}
+	return p, nil
+}
+
+// Has reports whether the patch sets or clears field.
+func (p *Patch) Has(field string) bool {
+	_, ok := p.members[field]
+	return ok
+}
+
+// String applies field to dst. Required fields cannot be cleared.
+func (p *Patch) String(field string, dst *string) {
+	raw, ok := p.members[field]
+	if !ok {
+		return
+	}
+	if isNull(raw) {
+		p.errs = append(p.errs, FieldError{field, "cannot be null"})
+		return
+	}
+	if err := json.Unmarshal(raw, dst); err != nil {
+		p.errs = append(p.errs, FieldError{field, "must be a string"})
+	}
+}
+
+// NullableString applies field to dst, null clearing it to nil.
+func (p *Patch) NullableString(field string, dst **string) {
+	raw, ok := p.members[field]
+	if !ok {
+		return
+	}
+	if isNull(raw) {
+		*dst = nil
+		return
+	}
+	var v string
+	if err := json.Unmarshal(raw, &v); err != nil {
+		p.errs = append(p.errs, FieldError{field, "must be a string or null"})
+		return
+	}
+	*dst = &v
+}
+
+// Bool applies field to dst. Booleans cannot be cleared.
+func (p *Patch) Bool(field string, dst *bool) {
+	raw, ok := p.members[field]
+	if !ok {
+		return
+	}
+	if isNull(raw) {
+		p.errs = append(p.errs, FieldError{field, "cannot be null"})
+		return
+	}
+	if err := json.Unmarshal(raw, dst); err != nil {
+		p.errs = append(p.errs, FieldError{field, "must be a boolean"})
+	}
+}
+
+// Err returns the members that could not be applied, as an *Error, or nil.
+func (p *Patch) Err() error {
+	if len(p.errs) == 0 {
+		return nil
+	}
+	return &Error{Fields: p.errs}
+}
+
+func isNull(raw json.RawMessage) bool {
+	return bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+}
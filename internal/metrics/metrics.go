@@ -0,0 +1,170 @@
+// Package metrics exposes Prometheus metrics: HTTP requests by Goa service,
+// method and status, database pool stats, Keycloak calls and business
+// counters fed by the domain events.
+package metrics
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	goa "goa.design/goa/v3/pkg"
+)
+
+const namespace = "be"
+
+// Registry holds the metrics served by Handler.
+var Registry = prometheus.NewRegistry()
+
+var (
+	factory = promauto.With(Registry)
+
+	httpRequests = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "HTTP requests by Goa service, method and status code.",
+	}, []string{"service", "method", "code"})
+
+	httpDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by Goa service, method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "method", "code"})
+
+	keycloakRequests = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "keycloak",
+		Name:      "requests_total",
+		Help:      "Keycloak calls by operation.",
+	}, []string{"operation"})
+
+	keycloakErrors = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "keycloak",
+		Name:      "errors_total",
+		Help:      "Failed Keycloak calls by operation.",
+	}, []string{"operation"})
+
+	keycloakDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "keycloak",
+		Name:      "request_duration_seconds",
+		Help:      "Keycloak call latency by operation.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// RegisterDB exports the connection pool stats of db, from DB.Stats().
+func RegisterDB(db *sql.DB, name string) {
+	Registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// Handler serves the metrics in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// Protect lets through the requests sending token as a bearer token in the
+// Authorization header, and answers 401 to the others.
+func Protect(token string, h http.Handler) http.Handler {
+	want := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), want) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="metrics"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+type contextKey string
+
+const routeKey contextKey = "metrics-route"
+
+// route is filled by Endpoint with the Goa service and method serving the
+// request, which HTTP has no other way to know.
+type route struct {
+	service string
+	method  string
+}
+
+// HTTP counts the requests and observes their latency. Requests that don't
+// reach a Goa endpoint, such as the docs, are labelled with "-".
+func HTTP(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rt := &route{service: "-", method: "-"}
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		started := time.Now()
+		h.ServeHTTP(rec, r.WithContext(context.WithValue(r.Context(), routeKey, rt)))
+
+		code := strconv.Itoa(rec.status)
+		httpRequests.WithLabelValues(rt.service, rt.method, code).Inc()
+		httpDuration.WithLabelValues(rt.service, rt.method, code).Observe(time.Since(started).Seconds())
+	})
+}
+
+// Endpoint is a Goa endpoint middleware that labels the request measured by
+// HTTP with the service and method.
+func Endpoint(e goa.Endpoint) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		if rt, ok := ctx.Value(routeKey).(*route); ok {
+			rt.service, _ = ctx.Value(goa.ServiceKey).(string)
+			rt.method, _ = ctx.Value(goa.MethodKey).(string)
+		}
+		return e(ctx, req)
+	}
+}
+
+// Keycloak starts timing a Keycloak call; the returned function records its
+// outcome.
+func Keycloak(operation string) func(error) {
+	started := time.Now()
+	return func(err error) {
+		keycloakRequests.WithLabelValues(operation).Inc()
+		keycloakDuration.WithLabelValues(operation).Observe(time.Since(started).Seconds())
+		if err != nil {
+			keycloakErrors.WithLabelValues(operation).Inc()
+		}
+	}
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status, r.wroteHeader = status, true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
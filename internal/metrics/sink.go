@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"be/internal/events"
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	domainEvents = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "domain_events_total",
+		Help:      "Domain events delivered, by type.",
+	}, []string{"type"})
+
+	plansCreated = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "plans_created_total",
+		Help:      "Training plans created.",
+	})
+
+	setsLogged = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sets_logged_total",
+		Help:      "Sets logged, in plans or workout sessions.",
+	})
+)
+
+// Sink counts the domain events as they are dispatched, so that only
+// committed changes are counted. An event redelivered because another sink
+// failed is counted again.
+type Sink struct{}
+
+func (Sink) Name() string { return "metrics" }
+
+func (Sink) Deliver(ctx context.Context, e events.Event) error {
+	domainEvents.WithLabelValues(string(e.Type)).Inc()
+	switch e.Type {
+	case events.TrainingPlanCreated:
+		plansCreated.Inc()
+	case events.SetLogged:
+		setsLogged.Inc()
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CORSConfig is the cross-origin policy of the API.
+type CORSConfig struct {
+	// AllowedOrigins lists the origins allowed to call the API, such as
+	// https://app.example.com. An entry may contain * wildcards, such as
+	// https://*.example.com, and a lone * allows any origin.
+	AllowedOrigins   []string      `yaml:"allowedOrigins"`
+	AllowCredentials bool          `yaml:"allowCredentials"` // Let browsers send cookies and the Authorization header they manage
+	AllowedMethods   []string      `yaml:"allowedMethods"`   // Methods allowed in cross-origin requests
+	AllowedHeaders   []string      `yaml:"allowedHeaders"`   // Request headers allowed in cross-origin requests
+	ExposedHeaders   []string      `yaml:"exposedHeaders"`   // Response headers readable by the calling scripts
+	MaxAge           time.Duration `yaml:"maxAge"`           // How long browsers may cache a preflight response; 0 leaves it to them
+}
+
+// DefaultCORSConfig returns the methods and headers the API uses. The
+// allowed origins are left to the environment.
+func DefaultCORSConfig() CORSConfig {
+	return CORSConfig{
+		AllowedMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
+		AllowedHeaders: []string{"Content-Type", "Authorization", "If-Match", "Idempotency-Key", "X-Request-ID", "traceparent", "tracestate"},
+		ExposedHeaders: []string{"ETag", "X-Request-ID", "Idempotent-Replayed", "RateLimit-Policy", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After"},
+		MaxAge:         10 * time.Minute,
+	}
+}
+
+// Validate checks the origins and the max age.
+func (c CORSConfig) Validate() error {
+	var errs []error
+	for _, origin := range c.AllowedOrigins {
+		if origin == "*" {
+			if c.AllowCredentials {
+				errs = append(errs, errors.New("the * origin can't be allowed with credentials"))
+			}
+			continue
+		}
+		if _, err := path.Match(origin, ""); err != nil {
+			errs = append(errs, fmt.Errorf("origin %q: %w", origin, err))
+			continue
+		}
+		u, err := url.Parse(strings.ReplaceAll(origin, "*", "x"))
+		if err != nil || u.Scheme == "" || u.Host == "" || (u.Path != "" && u.Path != "/") || u.RawQuery != "" {
+			errs = append(errs, fmt.Errorf("origin %q is not a scheme://host[:port] origin", origin))
+		}
+	}
+	if c.MaxAge < 0 {
+		errs = append(errs, errors.New("maxAge must not be negative"))
+	}
+	return errors.Join(errs...)
+}
+
+// CORS applies the cross-origin policy of cfg. Preflight requests are
+// answered only for the routes that exist, as told by routes; the other
+// OPTIONS requests reach the handler.
+func CORS(cfg CORSConfig, routes func(method, path string) bool) func(http.Handler) http.Handler {
+	var (
+		methods   = strings.Join(cfg.AllowedMethods, ", ")
+		headers   = strings.Join(cfg.AllowedHeaders, ", ")
+		exposed   = strings.Join(cfg.ExposedHeaders, ", ")
+		maxAge    = strconv.Itoa(int(cfg.MaxAge.Seconds()))
+		anyOrigin = slices.Contains(cfg.AllowedOrigins, "*")
+	)
+	allowed := func(origin string) bool {
+		if anyOrigin {
+			return true
+		}
+		for _, pattern := range cfg.AllowedOrigins {
+			if ok, _ := path.Match(pattern, strings.TrimSuffix(origin, "/")); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The response depends on the origin, caches must key on it.
+			w.Header().Add("Vary", "Origin")
+			origin := r.Header.Get("Origin")
+			if origin == "" {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			requestMethod := r.Header.Get("Access-Control-Request-Method")
+			if r.Method == http.MethodOptions && requestMethod != "" {
+				if !routes(requestMethod, r.URL.Path) {
+					h.ServeHTTP(w, r)
+					return
+				}
+				w.Header().Add("Vary", "Access-Control-Request-Method")
+				w.Header().Add("Vary", "Access-Control-Request-Headers")
+				if !allowed(origin) || !slices.Contains(cfg.AllowedMethods, requestMethod) {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				setAllowOrigin(w, origin, anyOrigin, cfg.AllowCredentials)
+				w.Header().Set("Access-Control-Allow-Methods", methods)
+				if headers != "" {
+					w.Header().Set("Access-Control-Allow-Headers", headers)
+				}
+				if cfg.MaxAge > 0 {
+					w.Header().Set("Access-Control-Max-Age", maxAge)
+				}
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			if allowed(origin) {
+				setAllowOrigin(w, origin, anyOrigin, cfg.AllowCredentials)
+				if exposed != "" {
+					w.Header().Set("Access-Control-Expose-Headers", exposed)
+				}
+			}
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+func setAllowOrigin(w http.ResponseWriter, origin string, anyOrigin, credentials bool) {
+	if anyOrigin {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		return
+	}
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	if credentials {
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+}
@@ -7,14 +7,13 @@ import (
 	"encoding/pem"
 	"fmt"
 	"net/http"
-	"os"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
-// secretKey is used to verify the JWT signature, loaded from an environment variable.
-var secretKey = os.Getenv("KC_RSA_PUBLIC_KEY")
+// secretKey is used to verify the JWT signature, set by SetPublicKey.
+var secretKey string
 var rsaPublicKey *rsa.PublicKey
 
 // contextKey is a type alias for string, used for defining context keys in a type-safe way.
@@ -74,27 +73,33 @@ func AuthMiddleware(next http.Handler) http.Handler {
 	})
 }
 
-func init() {
-	keyStr := os.Getenv("KC_RSA_PUBLIC_KEY")
-	if keyStr == "" {
-		panic("KC_RSA_PUBLIC_KEY is not set")
-	}
-
+// ParsePublicKey parses the PEM encoded RSA key the access tokens are signed with.
+func ParsePublicKey(keyStr string) (*rsa.PublicKey, error) {
 	block, _ := pem.Decode([]byte(keyStr))
 	if block == nil {
-		panic("failed to decode RSA public key PEM")
+		return nil, fmt.Errorf("failed to decode RSA public key PEM")
 	}
 
 	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
 	if err != nil {
-		panic(fmt.Sprintf("failed to parse RSA public key: %v", err))
+		return nil, fmt.Errorf("failed to parse RSA public key: %w", err)
 	}
 
-	var ok bool
-	rsaPublicKey, ok = pub.(*rsa.PublicKey)
+	key, ok := pub.(*rsa.PublicKey)
 	if !ok {
-		panic("provided key is not an RSA public key")
+		return nil, fmt.Errorf("provided key is not an RSA public key")
+	}
+	return key, nil
+}
+
+// SetPublicKey sets the key ValidateToken verifies the access tokens with.
+func SetPublicKey(keyStr string) error {
+	key, err := ParsePublicKey(keyStr)
+	if err != nil {
+		return err
 	}
+	secretKey, rsaPublicKey = keyStr, key
+	return nil
 }
 
 func ValidateToken(tokenString string) (jwt.MapClaims, error) {
@@ -116,3 +121,27 @@ func ValidateToken(tokenString string) (jwt.MapClaims, error) {
 
 	return claims, nil
 }
+
+// SubjectFromContext returns the JWT subject stored in the context by the OAuth2Auth handlers.
+func SubjectFromContext(ctx context.Context) (string, bool) {
+	claims, ok := ctx.Value(ClaimsKey).(jwt.MapClaims)
+	if !ok {
+		return "", false
+	}
+	sub, ok := claims["sub"].(string)
+	return sub, ok && sub != ""
+}
+
+// ClaimsFromRequest validates the bearer token of r, for the HTTP handlers
+// that run before the services authorize the request.
+func ClaimsFromRequest(r *http.Request) (jwt.MapClaims, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") {
+		return nil, false
+	}
+	claims, err := ValidateToken(token)
+	if err != nil {
+		return nil, false
+	}
+	return claims, true
+}
@@ -0,0 +1,177 @@
+// Package telemetry configures OpenTelemetry tracing: spans for the HTTP
+// requests, the Goa endpoints, the database queries and the Keycloak calls,
+// with W3C trace context propagation.
+package telemetry
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/XSAM/otelsql"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"goa.design/clue/clue"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+const (
+	serviceName    = "be"
+	serviceVersion = "1.0.0"
+	tracerName     = "be"
+)
+
+// Config selects where the spans are exported.
+type Config struct {
+	// Exporter is otlp, console to print the spans to stdout, or none,
+	// which still propagates the incoming trace context.
+	Exporter string `yaml:"exporter"`
+	// Protocol of the OTLP exporter: grpc or http/protobuf. The collector
+	// is set with the standard OTEL_EXPORTER_OTLP_* variables.
+	Protocol    string `yaml:"protocol"`
+	ServiceName string `yaml:"serviceName"`
+}
+
+// Exporters and Protocols list the values Config accepts.
+var (
+	Exporters = []string{"otlp", "console", "none"}
+	Protocols = []string{"grpc", "http/protobuf"}
+)
+
+// Setup installs the tracer provider exporting to the exporter of cfg. The
+// returned function flushes the pending spans.
+func Setup(ctx context.Context, cfg Config) (func(), error) {
+	var (
+		exporter sdktrace.SpanExporter
+		shutdown = func() {}
+		err      error
+	)
+	switch cfg.Exporter {
+	case "none":
+	case "console":
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case "otlp":
+		if cfg.Protocol == "http/protobuf" {
+			exporter, shutdown, err = clue.NewHTTPSpanExporter(ctx)
+		} else {
+			exporter, shutdown, err = clue.NewGRPCSpanExporter(ctx)
+		}
+	default:
+		return nil, fmt.Errorf("invalid traces exporter %q (valid exporters: otlp|console|none)", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	name := cfg.ServiceName
+	if name == "" {
+		name = serviceName
+	}
+	clueCfg, err := clue.NewConfig(ctx, name, serviceVersion, nil, exporter)
+	if err != nil {
+		return nil, err
+	}
+	clue.ConfigureOpenTelemetry(ctx, clueCfg)
+
+	return func() {
+		if tp, ok := clueCfg.TracerProvider.(*sdktrace.TracerProvider); ok {
+			_ = tp.Shutdown(context.Background())
+		}
+		shutdown()
+	}, nil
+}
+
+// HTTP starts a span for every request, continuing the trace of the
+// traceparent header. The span is named after the method until Route names
+// it after the route.
+func HTTP(h http.Handler) http.Handler {
+	return otelhttp.NewHandler(h, serviceName,
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method
+		}))
+}
+
+// sensitiveVar matches the names of the path variables holding secrets,
+// such as the calendar feed token.
+var sensitiveVar = regexp.MustCompile(`(?i)token|secret|key`)
+
+// Route returns a middleware for mux naming the span of the request after
+// its route pattern, so that span names don't hold resource IDs. It
+// replaces the path recorded by HTTP with the path rebuilt from the
+// pattern, where the variables holding secrets are redacted. The path is
+// overwritten under both its old and its stable semantic convention names,
+// http.target and url.path, as OTEL_SEMCONV_STABILITY_OPT_IN selects either
+// or both.
+func Route(mux goahttp.ResolverMuxer) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if pattern := mux.ResolvePattern(r); pattern != "" {
+				span := trace.SpanFromContext(r.Context())
+				span.SetName(r.Method + " " + pattern)
+				path := redactPath(pattern, mux.Vars(r))
+				span.SetAttributes(
+					semconv.HTTPRoute(pattern),
+					attribute.String("http.target", path),
+					attribute.String("url.path", path),
+				)
+			}
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+var patternVar = regexp.MustCompile(`{\*?([a-zA-Z0-9_]+)}`)
+
+// redactPath fills the variables of pattern with vars, except the ones
+// whose name is sensitive.
+func redactPath(pattern string, vars map[string]string) string {
+	return patternVar.ReplaceAllStringFunc(pattern, func(v string) string {
+		name := patternVar.FindStringSubmatch(v)[1]
+		if sensitiveVar.MatchString(name) {
+			return "REDACTED"
+		}
+		return vars[name]
+	})
+}
+
+// Transport traces the outgoing requests sent through t and propagates the
+// trace context to the called service.
+func Transport(t http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(t)
+}
+
+// Endpoint is a Goa endpoint middleware that wraps the endpoint in a span
+// named after the service and method.
+func Endpoint(e goa.Endpoint) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		svc, _ := ctx.Value(goa.ServiceKey).(string)
+		method, _ := ctx.Value(goa.MethodKey).(string)
+		ctx, span := otel.Tracer(tracerName).Start(ctx, svc+"."+method,
+			trace.WithAttributes(
+				attribute.String("goa.service", svc),
+				attribute.String("goa.method", method),
+			))
+		defer span.End()
+
+		res, err := e(ctx, req)
+		if err != nil {
+			span.RecordError(err)
+		}
+		return res, err
+	}
+}
+
+// OpenDB opens a database whose queries are traced.
+func OpenDB(driverName, dsn string) (*sql.DB, error) {
+	return otelsql.Open(driverName, dsn,
+		otelsql.WithAttributes(semconv.DBSystemPostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{OmitConnResetSession: true, OmitRows: true}))
+}